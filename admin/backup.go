@@ -0,0 +1,124 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"archive/tar"
+	"context"
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	// ErrIncompleteBackup is returned when a backup archive ends without its
+	// manifest or misses the documents listed in the manifest.
+	ErrIncompleteBackup = errors.New("incomplete backup")
+)
+
+// BackupProject writes the backup of the documents of the given project to
+// the given writer as a tar archive. If includeChanges is true, the change
+// logs of the documents are backed up together.
+func (c *Client) BackupProject(
+	ctx context.Context,
+	projectName string,
+	includeChanges bool,
+	w io.Writer,
+) error {
+	stream, err := c.client.BackupProject(ctx, &api.BackupProjectRequest{
+		ProjectName:    projectName,
+		IncludeChanges: includeChanges,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(resp.Data); err != nil {
+			return fmt.Errorf("write backup: %w", err)
+		}
+	}
+}
+
+// RestoreProject imports the documents in the given backup archive into the
+// given project, and returns the manifest of the archive. The documents must
+// not exist in the project. The documents are imported while the archive is
+// read, so the documents before an error are kept imported.
+func (c *Client) RestoreProject(
+	ctx context.Context,
+	projectName string,
+	r io.Reader,
+) (*types.BackupManifest, error) {
+	restored := make(map[key.Key]bool)
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no manifest: %w", ErrIncompleteBackup)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read backup: %w", err)
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", header.Name, err)
+		}
+
+		if header.Name == types.BackupManifestName {
+			manifest := &types.BackupManifest{}
+			if err := gojson.Unmarshal(data, manifest); err != nil {
+				return nil, fmt.Errorf("unmarshal backup manifest: %w", err)
+			}
+			for _, doc := range manifest.Documents {
+				if !restored[doc.Key] {
+					return nil, fmt.Errorf("no %s: %w", doc.Key, ErrIncompleteBackup)
+				}
+			}
+
+			return manifest, nil
+		}
+
+		if !strings.HasPrefix(header.Name, types.BackupDocumentDir) {
+			continue
+		}
+
+		export := &api.DocumentExport{}
+		if err := export.Unmarshal(data); err != nil {
+			return nil, fmt.Errorf("unmarshal %s: %w", header.Name, err)
+		}
+		docKey := key.Key(export.DocumentKey)
+		if _, err := c.importDocument(ctx, projectName, docKey, export); err != nil {
+			return nil, err
+		}
+		restored[docKey] = true
+	}
+}
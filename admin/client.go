@@ -36,6 +36,10 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 )
 
+// rebuildPageSize is the number of changes that RebuildDocument fetches at
+// once.
+const rebuildPageSize = 1000
+
 // Option configures Options.
 type Option func(*Options)
 
@@ -248,15 +252,29 @@ func (c *Client) UpdateProject(
 func (c *Client) ListDocuments(
 	ctx context.Context,
 	projectName string,
+	filter types.DocumentFilter,
 	previousID string,
 	pageSize int32,
 	isForward bool,
 	includeSnapshot bool,
 ) ([]*types.DocumentSummary, error) {
+	updatedAfter, err := converter.ToOptionalTimestamp(filter.UpdatedAfter)
+	if err != nil {
+		return nil, err
+	}
+	updatedBefore, err := converter.ToOptionalTimestamp(filter.UpdatedBefore)
+	if err != nil {
+		return nil, err
+	}
+
 	response, err := c.client.ListDocuments(
 		ctx,
 		&api.ListDocumentsRequest{
 			ProjectName:     projectName,
+			LabelSelector:   filter.Labels.String(),
+			KeyPrefix:       filter.KeyPrefix,
+			UpdatedAfter:    updatedAfter,
+			UpdatedBefore:   updatedBefore,
 			PreviousId:      previousID,
 			PageSize:        pageSize,
 			IsForward:       isForward,
@@ -294,6 +312,54 @@ func (c *Client) RemoveDocument(
 	return err
 }
 
+// RemoveDocuments removes the documents that match the given label selector
+// and returns the keys of the removed documents.
+func (c *Client) RemoveDocuments(
+	ctx context.Context,
+	projectName string,
+	labelSelector string,
+	force bool,
+) ([]string, error) {
+	project, err := c.GetProject(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.RemoveDocumentsByAdmin(
+		withShardKey(ctx, project.PublicKey),
+		&api.RemoveDocumentsByAdminRequest{
+			ProjectName:   projectName,
+			LabelSelector: labelSelector,
+			Force:         force,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.DocumentKeys, nil
+}
+
+// GetSnapshot returns the snapshot of the given document at the given server
+// sequence.
+func (c *Client) GetSnapshot(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	serverSeq int64,
+) ([]byte, error) {
+	resp, err := c.client.GetSnapshotMeta(ctx, &api.GetSnapshotMetaRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		ServerSeq:   serverSeq,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Snapshot, nil
+}
+
 // ListChangeSummaries returns the change summaries of the given document.
 func (c *Client) ListChangeSummaries(
 	ctx context.Context,
@@ -363,6 +429,373 @@ func (c *Client) ListChangeSummaries(
 	return summaries, nil
 }
 
+// RebuildDocument rebuilds the given document on the caller by applying its
+// change log to the snapshot before the first change that the server keeps.
+// It returns the document and the server sequence of its last change.
+func (c *Client) RebuildDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) (*document.InternalDocument, int64, error) {
+	doc := document.NewInternalDocument(key)
+	var serverSeq int64
+	for {
+		resp, err := c.client.ListChanges(ctx, &api.ListChangesRequest{
+			ProjectName: projectName,
+			DocumentKey: key.String(),
+			PreviousSeq: serverSeq,
+			PageSize:    rebuildPageSize,
+			IsForward:   true,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		changes, err := converter.FromChanges(resp.Changes)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(changes) == 0 {
+			return doc, serverSeq, nil
+		}
+
+		// NOTE: The changes before the first one may have been purged, so the
+		// document starts from the snapshot right before it.
+		if serverSeq == 0 {
+			seq := changes[0].ServerSeq() - 1
+			snapshotMeta, err := c.client.GetSnapshotMeta(ctx, &api.GetSnapshotMetaRequest{
+				ProjectName: projectName,
+				DocumentKey: key.String(),
+				ServerSeq:   seq,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+
+			doc, err = document.NewInternalDocumentFromSnapshot(
+				key,
+				seq,
+				snapshotMeta.Lamport,
+				snapshotMeta.Snapshot,
+			)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+
+		if _, err := doc.ApplyChanges(changes...); err != nil {
+			return nil, 0, err
+		}
+		serverSeq = changes[len(changes)-1].ServerSeq()
+	}
+}
+
+// VerifyDocument rebuilds the given document from its change log on the
+// server and compares it against the latest stored snapshot.
+func (c *Client) VerifyDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) (*types.DocumentVerification, error) {
+	resp, err := c.client.VerifyDocument(ctx, &api.VerifyDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentVerification{
+		SnapshotServerSeq: resp.SnapshotServerSeq,
+		SnapshotHash:      resp.SnapshotHash,
+		RebuiltHash:       resp.RebuiltHash,
+	}, nil
+}
+
+// RestoreDocument reverts the given document to its state at the given server
+// sequence by a new change, and returns the server sequence of the document
+// after the restore.
+func (c *Client) RestoreDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	serverSeq int64,
+) (int64, error) {
+	resp, err := c.client.RestoreDocument(ctx, &api.RestoreDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		ServerSeq:   serverSeq,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.ServerSeq, nil
+}
+
+// ExportDocument dumps the latest state of the given document, with its full
+// change history if includeChanges is true. The returned data can be loaded
+// into another cluster by ImportDocument.
+func (c *Client) ExportDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	includeChanges bool,
+) ([]byte, error) {
+	resp, err := c.client.ExportDocument(ctx, &api.ExportDocumentRequest{
+		ProjectName:    projectName,
+		DocumentKey:    key.String(),
+		IncludeChanges: includeChanges,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := resp.Export.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal export of %s: %w", key, err)
+	}
+
+	return data, nil
+}
+
+// ImportDocument creates the given document from the data dumped by
+// ExportDocument, and returns the server sequence of the imported document.
+func (c *Client) ImportDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	data []byte,
+) (int64, error) {
+	export := &api.DocumentExport{}
+	if err := export.Unmarshal(data); err != nil {
+		return 0, fmt.Errorf("unmarshal export of %s: %w", key, err)
+	}
+
+	return c.importDocument(ctx, projectName, key, export)
+}
+
+// importDocument creates the given document from the given export.
+func (c *Client) importDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	export *api.DocumentExport,
+) (int64, error) {
+	resp, err := c.client.ImportDocument(ctx, &api.ImportDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		Export:      export,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.ServerSeq, nil
+}
+
+// EvictDocument detaches the document from all the clients attaching it and
+// returns the IDs of the clients.
+func (c *Client) EvictDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	reason string,
+) ([]string, error) {
+	resp, err := c.client.EvictDocument(ctx, &api.EvictDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		Reason:      reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ClientIds, nil
+}
+
+// GCDocument purges the garbage of the given document and returns the number
+// of the purged elements with the server seq of the snapshot without them.
+func (c *Client) GCDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) (int, int64, error) {
+	resp, err := c.client.GCDocument(ctx, &api.GCDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int(resp.RemovedElements), resp.ServerSeq, nil
+}
+
+// UpdateDocumentACL updates the access control list of the given document.
+func (c *Client) UpdateDocumentACL(
+	ctx context.Context,
+	projectName string,
+	documentKey key.Key,
+	acl *types.DocumentACL,
+) (*types.DocumentACL, error) {
+	resp, err := c.client.UpdateDocumentACL(ctx, &api.UpdateDocumentACLRequest{
+		ProjectName: projectName,
+		DocumentKey: documentKey.String(),
+		Acl:         converter.ToDocumentACL(acl),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentACL(resp.Acl), nil
+}
+
+// UpdateDocumentLabels replaces the labels of the given document.
+func (c *Client) UpdateDocumentLabels(
+	ctx context.Context,
+	projectName string,
+	documentKey key.Key,
+	labels map[string]string,
+) (map[string]string, error) {
+	resp, err := c.client.UpdateDocumentLabels(ctx, &api.UpdateDocumentLabelsRequest{
+		ProjectName: projectName,
+		DocumentKey: documentKey.String(),
+		Labels:      labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Labels, nil
+}
+
+// UpdateDocumentSnapshotConfig updates the snapshot threshold and interval
+// that override the ones of the project for the given document. Zero values
+// remove the overrides.
+func (c *Client) UpdateDocumentSnapshotConfig(
+	ctx context.Context,
+	projectName string,
+	documentKey key.Key,
+	threshold int64,
+	interval int64,
+) error {
+	_, err := c.client.UpdateDocumentSnapshotConfig(ctx, &api.UpdateDocumentSnapshotConfigRequest{
+		ProjectName:       projectName,
+		DocumentKey:       documentKey.String(),
+		SnapshotThreshold: threshold,
+		SnapshotInterval:  interval,
+	})
+	return err
+}
+
+// ListDocumentMemories lists the documents of the project that the server
+// holds the most memory for.
+func (c *Client) ListDocumentMemories(
+	ctx context.Context,
+	projectName string,
+	limit int32,
+) ([]*types.DocumentMemory, error) {
+	resp, err := c.client.ListDocumentMemories(ctx, &api.ListDocumentMemoriesRequest{
+		ProjectName: projectName,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentMemories(resp.Documents), nil
+}
+
+// ListClients lists clients of the given project.
+func (c *Client) ListClients(
+	ctx context.Context,
+	projectName string,
+	previousID string,
+	pageSize int32,
+	isForward bool,
+) ([]*types.ClientSummary, error) {
+	response, err := c.client.ListClients(
+		ctx,
+		&api.ListClientsRequest{
+			ProjectName: projectName,
+			PreviousId:  previousID,
+			PageSize:    pageSize,
+			IsForward:   isForward,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromClientSummaries(response.Clients)
+}
+
+// RegisterDocumentTemplate registers the template of the given collection.
+// The root is the JSON representation of the root object of the documents.
+func (c *Client) RegisterDocumentTemplate(
+	ctx context.Context,
+	projectName string,
+	collection string,
+	root string,
+) (*types.DocumentTemplate, error) {
+	resp, err := c.client.RegisterDocumentTemplate(ctx, &api.RegisterDocumentTemplateRequest{
+		ProjectName: projectName,
+		Collection:  collection,
+		Root:        root,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentTemplate(resp.Template)
+}
+
+// ListDocumentTemplates lists the templates of the given project.
+func (c *Client) ListDocumentTemplates(
+	ctx context.Context,
+	projectName string,
+) ([]*types.DocumentTemplate, error) {
+	resp, err := c.client.ListDocumentTemplates(ctx, &api.ListDocumentTemplatesRequest{
+		ProjectName: projectName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentTemplates(resp.Templates)
+}
+
+// RemoveDocumentTemplate removes the template of the given collection.
+func (c *Client) RemoveDocumentTemplate(
+	ctx context.Context,
+	projectName string,
+	collection string,
+) error {
+	_, err := c.client.RemoveDocumentTemplate(ctx, &api.RemoveDocumentTemplateRequest{
+		ProjectName: projectName,
+		Collection:  collection,
+	})
+	return err
+}
+
+// UpdateLogLevels updates the log levels of the given modules of the server,
+// e.g. {"packs": "debug"}, and returns the levels of the modules that have
+// their own levels. An empty level resets the module to the global level.
+func (c *Client) UpdateLogLevels(
+	ctx context.Context,
+	levels map[string]string,
+) (map[string]string, error) {
+	response, err := c.client.UpdateLogLevels(ctx, &api.UpdateLogLevelsRequest{
+		Levels: levels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Levels, nil
+}
+
 /**
  * withShardKey returns a context with the given shard key in metadata.
  */
@@ -0,0 +1,214 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/internal/cbor"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+)
+
+const (
+	// SnapshotCodecProtobuf is the name of the codec that encodes snapshots
+	// in protocol buffers.
+	SnapshotCodecProtobuf = "protobuf"
+
+	// SnapshotCodecCBOR is the name of the codec that encodes snapshots in
+	// CBOR.
+	SnapshotCodecCBOR = "cbor"
+)
+
+// NOTE: The format bytes of the codecs are below maxSnapshotFormat, which
+// cannot be the first byte of a snapshot in protocol buffers since the field
+// number zero is invalid. So the snapshots stored without the format byte
+// before the codecs are read as protocol buffers.
+const maxSnapshotFormat = 0x08
+
+// SnapshotCodec encodes the snapshots of documents for storing them. The
+// encoded snapshots are prefixed with the format byte of the codec, so that
+// the snapshots stored with different codecs are read together and a new
+// format can be added without breaking the old snapshots.
+type SnapshotCodec interface {
+	// Name returns the name of the codec that is used in the config.
+	Name() string
+
+	// Format returns the format byte of the codec, which should be between 1
+	// and 7.
+	Format() byte
+
+	// Encode encodes the snapshot of the given root and presences.
+	Encode(obj *crdt.Object, presences map[string]innerpresence.Presence) ([]byte, error)
+
+	// Decode decodes the given encoded snapshot into protocol buffers, which
+	// is converted to the document.
+	Decode(data []byte) (*api.Snapshot, error)
+}
+
+var (
+	snapshotCodecsMu       sync.RWMutex
+	snapshotCodecsByName   = make(map[string]SnapshotCodec)
+	snapshotCodecsByFormat = make(map[byte]SnapshotCodec)
+)
+
+func init() {
+	RegisterSnapshotCodec(protobufCodec{})
+	RegisterSnapshotCodec(cborCodec{})
+}
+
+// RegisterSnapshotCodec makes the given codec available by its name and its
+// format byte. It panics if the name or the format byte is already taken, or
+// if the format byte is out of range.
+func RegisterSnapshotCodec(codec SnapshotCodec) {
+	snapshotCodecsMu.Lock()
+	defer snapshotCodecsMu.Unlock()
+
+	if codec.Format() == 0 || codec.Format() >= maxSnapshotFormat {
+		panic(fmt.Sprintf("converter: invalid snapshot format %d", codec.Format()))
+	}
+	if _, dup := snapshotCodecsByName[codec.Name()]; dup {
+		panic("converter: RegisterSnapshotCodec called twice for " + codec.Name())
+	}
+	if _, dup := snapshotCodecsByFormat[codec.Format()]; dup {
+		panic(fmt.Sprintf("converter: RegisterSnapshotCodec called twice for format %d", codec.Format()))
+	}
+
+	snapshotCodecsByName[codec.Name()] = codec
+	snapshotCodecsByFormat[codec.Format()] = codec
+}
+
+// SnapshotCodecByName returns the codec registered with the given name.
+func SnapshotCodecByName(name string) (SnapshotCodec, error) {
+	snapshotCodecsMu.RLock()
+	defer snapshotCodecsMu.RUnlock()
+
+	codec, ok := snapshotCodecsByName[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", name, ErrUnsupportedSnapshotCodec)
+	}
+	return codec, nil
+}
+
+// EncodeSnapshot encodes the snapshot of the given root and presences with
+// the codec of the given name for storing it, and the empty name means
+// protocol buffers. Unlike SnapshotToBytes, which is for the snapshots sent
+// to the clients, the result has the format byte of the codec.
+func EncodeSnapshot(
+	obj *crdt.Object,
+	presences map[string]innerpresence.Presence,
+	codecName string,
+) ([]byte, error) {
+	if codecName == "" {
+		codecName = SnapshotCodecProtobuf
+	}
+
+	codec, err := SnapshotCodecByName(codecName)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := codec.Encode(obj, presences)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{codec.Format()}, data...), nil
+}
+
+// decodeSnapshot decodes the given snapshot encoded by EncodeSnapshot or by
+// SnapshotToBytes into protocol buffers.
+func decodeSnapshot(snapshot []byte) (*api.Snapshot, error) {
+	if len(snapshot) == 0 || snapshot[0] >= maxSnapshotFormat {
+		pbSnapshot := &api.Snapshot{}
+		if err := proto.Unmarshal(snapshot, pbSnapshot); err != nil {
+			return nil, fmt.Errorf("unmarshal snapshot: %w", err)
+		}
+		return pbSnapshot, nil
+	}
+
+	snapshotCodecsMu.RLock()
+	codec, ok := snapshotCodecsByFormat[snapshot[0]]
+	snapshotCodecsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("format %d: %w", snapshot[0], ErrUnsupportedSnapshotCodec)
+	}
+
+	return codec.Decode(snapshot[1:])
+}
+
+// protobufCodec is the codec that encodes snapshots in protocol buffers.
+type protobufCodec struct{}
+
+func (protobufCodec) Name() string {
+	return SnapshotCodecProtobuf
+}
+
+func (protobufCodec) Format() byte {
+	return 1
+}
+
+func (protobufCodec) Encode(obj *crdt.Object, presences map[string]innerpresence.Presence) ([]byte, error) {
+	return SnapshotToBytes(obj, presences)
+}
+
+func (protobufCodec) Decode(data []byte) (*api.Snapshot, error) {
+	pbSnapshot := &api.Snapshot{}
+	if err := proto.Unmarshal(data, pbSnapshot); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+	return pbSnapshot, nil
+}
+
+// cborCodec is the codec that encodes snapshots in CBOR.
+type cborCodec struct{}
+
+func (cborCodec) Name() string {
+	return SnapshotCodecCBOR
+}
+
+func (cborCodec) Format() byte {
+	return 2
+}
+
+func (cborCodec) Encode(obj *crdt.Object, presences map[string]innerpresence.Presence) ([]byte, error) {
+	root, err := toJSONElement(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := cbor.Marshal(&api.Snapshot{
+		Root:      root,
+		Presences: ToPresences(presences),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot: %w", err)
+	}
+	return data, nil
+}
+
+func (cborCodec) Decode(data []byte) (*api.Snapshot, error) {
+	pbSnapshot := &api.Snapshot{}
+	if err := cbor.Unmarshal(data, pbSnapshot); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+	return pbSnapshot, nil
+}
@@ -0,0 +1,235 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"fmt"
+
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+)
+
+// CompactChangePack encodes the tickets of the given pack compactly in place.
+// Actor IDs are interned into the actor table of the pack, and lamports and
+// server sequences are replaced with deltas, which are encoded as short
+// varints in most cases.
+//
+// NOTE: Only the changes of the pack are compacted. The snapshot
+// and min synced ticket are left as they are.
+func CompactChangePack(pbPack *api.ChangePack) {
+	if pbPack == nil || pbPack.IsCompact {
+		return
+	}
+
+	actorIndexes := make(map[string]uint32)
+	internActor := func(actorID []byte) uint32 {
+		if idx, ok := actorIndexes[string(actorID)]; ok {
+			return idx
+		}
+		idx := uint32(len(pbPack.ActorIds))
+		actorIndexes[string(actorID)] = idx
+		pbPack.ActorIds = append(pbPack.ActorIds, actorID)
+		return idx
+	}
+
+	var prevServerSeq, prevLamport int64
+	for _, pbChange := range pbPack.Changes {
+		id := pbChange.Id
+		if id == nil {
+			continue
+		}
+
+		lamport := id.Lamport
+		for _, ticket := range collectTickets(pbChange) {
+			ticket.ActorIndex = internActor(ticket.ActorId)
+			ticket.LamportDelta = ticket.Lamport - lamport
+			ticket.ActorId = nil
+			ticket.Lamport = 0
+		}
+
+		id.ActorIndex = internActor(id.ActorId)
+		id.ServerSeqDelta = id.ServerSeq - prevServerSeq
+		id.LamportDelta = id.Lamport - prevLamport
+		prevServerSeq, prevLamport = id.ServerSeq, id.Lamport
+		id.ActorId = nil
+		id.ServerSeq = 0
+		id.Lamport = 0
+	}
+
+	pbPack.IsCompact = true
+}
+
+// expandChangePack decodes the compactly encoded tickets of the given pack in
+// place. It is the inverse of CompactChangePack.
+func expandChangePack(pbPack *api.ChangePack) error {
+	if !pbPack.IsCompact {
+		return nil
+	}
+
+	actorAt := func(idx uint32) ([]byte, error) {
+		if int(idx) >= len(pbPack.ActorIds) {
+			return nil, fmt.Errorf("%d of %d actors: %w", idx, len(pbPack.ActorIds), ErrInvalidActorIndex)
+		}
+		return pbPack.ActorIds[idx], nil
+	}
+
+	var prevServerSeq, prevLamport int64
+	for _, pbChange := range pbPack.Changes {
+		id := pbChange.Id
+		if id == nil {
+			continue
+		}
+
+		actorID, err := actorAt(id.ActorIndex)
+		if err != nil {
+			return err
+		}
+		id.ActorId = actorID
+		id.ServerSeq = prevServerSeq + id.ServerSeqDelta
+		id.Lamport = prevLamport + id.LamportDelta
+		prevServerSeq, prevLamport = id.ServerSeq, id.Lamport
+		id.ActorIndex, id.ServerSeqDelta, id.LamportDelta = 0, 0, 0
+
+		for _, ticket := range collectTickets(pbChange) {
+			actorID, err := actorAt(ticket.ActorIndex)
+			if err != nil {
+				return err
+			}
+			ticket.ActorId = actorID
+			ticket.Lamport = id.Lamport + ticket.LamportDelta
+			ticket.ActorIndex, ticket.LamportDelta = 0, 0
+		}
+	}
+
+	pbPack.IsCompact = false
+	pbPack.ActorIds = nil
+	return nil
+}
+
+// collectTickets returns the tickets in the operations of the given change.
+// Each ticket is returned only once even if it is referenced several times.
+func collectTickets(pbChange *api.Change) []*api.TimeTicket {
+	var tickets []*api.TimeTicket
+	seen := make(map[*api.TimeTicket]struct{})
+	add := func(ts ...*api.TimeTicket) {
+		for _, t := range ts {
+			if t == nil {
+				continue
+			}
+			if _, ok := seen[t]; ok {
+				continue
+			}
+			seen[t] = struct{}{}
+			tickets = append(tickets, t)
+		}
+	}
+	addElement := func(elem *api.JSONElementSimple) {
+		if elem != nil {
+			add(elem.CreatedAt, elem.MovedAt, elem.RemovedAt)
+		}
+	}
+	addTextNodePos := func(pos *api.TextNodePos) {
+		if pos != nil {
+			add(pos.CreatedAt)
+		}
+	}
+	addTreeNodeID := func(id *api.TreeNodeID) {
+		if id != nil {
+			add(id.CreatedAt)
+		}
+	}
+	addTreePos := func(pos *api.TreePos) {
+		if pos != nil {
+			addTreeNodeID(pos.ParentId)
+			addTreeNodeID(pos.LeftSiblingId)
+		}
+	}
+	addTicketMap := func(m map[string]*api.TimeTicket) {
+		for _, t := range m {
+			add(t)
+		}
+	}
+
+	for _, pbOp := range pbChange.Operations {
+		switch op := pbOp.Body.(type) {
+		case *api.Operation_Set_:
+			add(op.Set.ParentCreatedAt, op.Set.ExecutedAt)
+			addElement(op.Set.Value)
+		case *api.Operation_Add_:
+			add(op.Add.ParentCreatedAt, op.Add.PrevCreatedAt, op.Add.ExecutedAt)
+			addElement(op.Add.Value)
+		case *api.Operation_Move_:
+			add(op.Move.ParentCreatedAt, op.Move.PrevCreatedAt, op.Move.CreatedAt, op.Move.ExecutedAt)
+		case *api.Operation_Remove_:
+			add(op.Remove.ParentCreatedAt, op.Remove.CreatedAt, op.Remove.ExecutedAt)
+		case *api.Operation_Edit_:
+			add(op.Edit.ParentCreatedAt, op.Edit.ExecutedAt)
+			addTextNodePos(op.Edit.From)
+			addTextNodePos(op.Edit.To)
+			addTicketMap(op.Edit.CreatedAtMapByActor)
+		case *api.Operation_Select_:
+			add(op.Select.ParentCreatedAt, op.Select.ExecutedAt)
+			addTextNodePos(op.Select.From)
+			addTextNodePos(op.Select.To)
+		case *api.Operation_Style_:
+			add(op.Style.ParentCreatedAt, op.Style.ExecutedAt)
+			addTextNodePos(op.Style.From)
+			addTextNodePos(op.Style.To)
+			addTicketMap(op.Style.CreatedAtMapByActor)
+		case *api.Operation_AddAnnotation_:
+			add(op.AddAnnotation.ParentCreatedAt, op.AddAnnotation.ExecutedAt)
+			addTextNodePos(op.AddAnnotation.From)
+			addTextNodePos(op.AddAnnotation.To)
+		case *api.Operation_RemoveAnnotation_:
+			add(op.RemoveAnnotation.ParentCreatedAt, op.RemoveAnnotation.ExecutedAt)
+		case *api.Operation_Increase_:
+			add(op.Increase.ParentCreatedAt, op.Increase.ExecutedAt)
+			addElement(op.Increase.Value)
+		case *api.Operation_SetAdd_:
+			add(op.SetAdd.ParentCreatedAt, op.SetAdd.ExecutedAt)
+			addElement(op.SetAdd.Value)
+		case *api.Operation_SetRemove_:
+			add(op.SetRemove.ParentCreatedAt, op.SetRemove.ExecutedAt)
+			addElement(op.SetRemove.Value)
+			addTicketMap(op.SetRemove.CreatedAtMapByActor)
+		case *api.Operation_TreeEdit_:
+			add(op.TreeEdit.ParentCreatedAt, op.TreeEdit.ExecutedAt)
+			addTreePos(op.TreeEdit.From)
+			addTreePos(op.TreeEdit.To)
+			addTicketMap(op.TreeEdit.CreatedAtMapByActor)
+			for _, nodes := range op.TreeEdit.Contents {
+				for _, node := range nodes.Content {
+					addTreeNodeID(node.Id)
+					addTreeNodeID(node.InsPrevId)
+					addTreeNodeID(node.InsNextId)
+					add(node.RemovedAt)
+					for _, attr := range node.Attributes {
+						add(attr.UpdatedAt)
+					}
+				}
+			}
+		case *api.Operation_TreeStyle_:
+			add(op.TreeStyle.ParentCreatedAt, op.TreeStyle.ExecutedAt)
+			addTreePos(op.TreeStyle.From)
+			addTreePos(op.TreeStyle.To)
+		case *api.Operation_EditReverse_:
+			add(op.EditReverse.ParentCreatedAt, op.EditReverse.ExecutedAt)
+			addTicketMap(op.EditReverse.CreatedAtMapByActor)
+		}
+	}
+
+	return tickets
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"errors"
+	"fmt"
+
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/internal/compression"
+)
+
+// CompressChangePack compresses the changes and the snapshot of the given pack
+// in place with the given compressor. The empty compressor leaves the pack as
+// it is, and so do the packs whose payloads are below the minimum size of the
+// compression package, since compressing them costs more than it saves.
+//
+// NOTE: It should be called after CompactChangePack, since the compact
+// encoding makes the changes smaller before they are compressed.
+func CompressChangePack(pbPack *api.ChangePack, compressor string) error {
+	if pbPack == nil || compressor == "" || pbPack.Compression != "" {
+		return nil
+	}
+
+	encodedChanges, err := (&api.ChangePack{Changes: pbPack.Changes}).Marshal()
+	if err != nil {
+		return fmt.Errorf("marshal changes: %w", err)
+	}
+	if compression.IsSmall(len(encodedChanges) + len(pbPack.Snapshot)) {
+		return nil
+	}
+
+	var compressedChanges, compressedSnapshot []byte
+	if len(encodedChanges) > 0 {
+		if compressedChanges, err = compression.Compress(compressor, encodedChanges); err != nil {
+			return err
+		}
+	}
+	if len(pbPack.Snapshot) > 0 {
+		if compressedSnapshot, err = compression.Compress(compressor, pbPack.Snapshot); err != nil {
+			return err
+		}
+	}
+
+	pbPack.Compression = compressor
+	pbPack.CompressedChanges = compressedChanges
+	pbPack.Changes = nil
+	pbPack.Snapshot = compressedSnapshot
+	return nil
+}
+
+// decompressChangePack decompresses the changes and the snapshot of the given
+// pack in place. It is the inverse of CompressChangePack. Each of them should
+// not exceed maxBytes after decompression, where zero means no limit.
+func decompressChangePack(pbPack *api.ChangePack, maxBytes uint64) error {
+	if pbPack.Compression == "" {
+		return nil
+	}
+	if err := compression.Validate(pbPack.Compression); err != nil {
+		return fmt.Errorf("%s: %w", err.Error(), ErrInvalidCompressedPack)
+	}
+
+	changes := &api.ChangePack{}
+	if len(pbPack.CompressedChanges) > 0 {
+		encodedChanges, err := decompress(pbPack.Compression, pbPack.CompressedChanges, maxBytes)
+		if err != nil {
+			return err
+		}
+		if err := changes.Unmarshal(encodedChanges); err != nil {
+			return fmt.Errorf("unmarshal changes: %s: %w", err.Error(), ErrInvalidCompressedPack)
+		}
+	}
+
+	if len(pbPack.Snapshot) > 0 {
+		snapshot, err := decompress(pbPack.Compression, pbPack.Snapshot, maxBytes)
+		if err != nil {
+			return err
+		}
+		pbPack.Snapshot = snapshot
+	}
+
+	pbPack.Changes = changes.Changes
+	pbPack.Compression = ""
+	pbPack.CompressedChanges = nil
+	return nil
+}
+
+// decompress decompresses the given data, converting the errors of the
+// compression package into the ones of this package.
+func decompress(compressor string, data []byte, maxBytes uint64) ([]byte, error) {
+	decompressed, err := compression.Decompress(compressor, data, maxBytes)
+	if errors.Is(err, compression.ErrTooLarge) {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrPackTooLarge)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidCompressedPack)
+	}
+
+	return decompressed, nil
+}
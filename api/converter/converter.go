@@ -28,6 +28,10 @@ var (
 	// passed.
 	ErrCheckpointRequired = errors.New("checkpoint required")
 
+	// ErrTimeTicketRequired is returned when a time ticket that identifies an
+	// element or an operation is empty.
+	ErrTimeTicketRequired = errors.New("time ticket required")
+
 	// ErrUnsupportedOperation is returned when the given operation is not
 	// supported yet.
 	ErrUnsupportedOperation = errors.New("unsupported operation")
@@ -47,4 +51,41 @@ var (
 	// ErrUnsupportedCounterType is returned when the given counter type is not
 	// supported yet.
 	ErrUnsupportedCounterType = errors.New("unsupported counter type")
+
+	// ErrUnsupportedConflictPolicy is returned when the given conflict policy
+	// of an object is not supported yet.
+	ErrUnsupportedConflictPolicy = errors.New("unsupported conflict policy")
+
+	// ErrInvalidActorIndex is returned when a ticket of a compact pack refers
+	// to an actor that is not in the actor table of the pack.
+	ErrInvalidActorIndex = errors.New("invalid actor index")
+
+	// ErrTooManyOperations is returned when a change has more operations than
+	// the limit.
+	ErrTooManyOperations = errors.New("too many operations in a change")
+
+	// ErrTooDeep is returned when the elements of a change are nested deeper
+	// than the limit.
+	ErrTooDeep = errors.New("elements nested too deep")
+
+	// ErrStringTooLong is returned when a string of an operation is longer
+	// than the limit.
+	ErrStringTooLong = errors.New("string too long")
+
+	// ErrPackTooLarge is returned when the change pack assembled from the
+	// chunks of a streamed request, or the payload of a compressed change
+	// pack after decompression, exceeds the max size.
+	ErrPackTooLarge = errors.New("change pack too large")
+
+	// ErrInvalidCompressedPack is returned when the payload of a compressed
+	// change pack cannot be decompressed.
+	ErrInvalidCompressedPack = errors.New("invalid compressed change pack")
+
+	// ErrUnsupportedSnapshotCodec is returned when the given codec or the
+	// format of a stored snapshot is not supported.
+	ErrUnsupportedSnapshotCodec = errors.New("unsupported snapshot codec")
+
+	// ErrInvalidSnapshotDelta is returned when a snapshot delta does not
+	// match the base snapshot that it is applied to.
+	ErrInvalidSnapshotDelta = errors.New("invalid snapshot delta")
 )
@@ -18,6 +18,7 @@ package converter_test
 
 import (
 	"math"
+	"strings"
 	"testing"
 	gotime "time"
 
@@ -25,7 +26,9 @@ import (
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/internal/compression"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
@@ -64,6 +67,63 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, `{"k1":[{"val":"B"}]}`, obj.Marshal())
 	})
 
+	t.Run("parallel object encoding test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("k1").SetString("k1.1", "v1").SetNewArray("k1.2").AddInteger(1, 2)
+			root.SetNewArray("k2").AddString("a", "b")
+			root.SetNewText("k3").Edit(0, 0, "ABC")
+			root.SetNewCounter("k4", crdt.IntegerCnt, 0).Increase(3)
+			root.SetInteger("k5", 5)
+			root.Delete("k5")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+
+		// the assembled bytes should be the same as marshaling at once.
+		pbElem := &api.JSONElement{}
+		assert.NoError(t, pbElem.Unmarshal(bytes))
+		expected, err := pbElem.Marshal()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, bytes)
+
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+
+		snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.InternalDocument().AllPresences())
+		assert.NoError(t, err)
+		obj, _, err = converter.BytesToSnapshot(snapshot)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+	})
+
+	t.Run("array move snapshot test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			arr := root.SetNewArray("k1").AddInteger(0, 1, 2)
+			arr.MoveFront(arr.Get(2).CreatedAt())
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[2,0,1]}`, doc.Marshal())
+		assert.Equal(t, 1, doc.GarbageLen())
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[2,0,1]}`, obj.Marshal())
+		assert.Len(t, obj.Get("k1").(*crdt.Array).RGANodes(), 4)
+
+		assert.Equal(t, 1, doc.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, doc.GarbageLen())
+		assert.Equal(t, `{"k1":[2,0,1]}`, doc.Marshal())
+	})
+
 	t.Run("root snapshot test", func(t *testing.T) {
 		doc := document.New("d1")
 
@@ -113,6 +173,9 @@ func TestConverter(t *testing.T) {
 			root.SetNewCounter("k5", crdt.LongCnt, 0).
 				Increase(10).
 				Increase(math.MaxInt64)
+			root.SetNewCounter("k6", crdt.DoubleCnt, 0).
+				Increase(1.5).
+				Decrease(0.25)
 
 			// tree
 			root.SetNewTree("k5").
@@ -201,6 +264,337 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, d1.Marshal(), d2.Marshal())
 	})
 
+	t.Run("text annotation test", func(t *testing.T) {
+		d1 := document.New("d1")
+		err := d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").
+				Edit(0, 0, "Hello World").
+				AddAnnotation("c1", 6, 11, "comment").
+				AddAnnotation("c2", 0, 5, "removed").
+				RemoveAnnotation("c2")
+			return nil
+		})
+		assert.NoError(t, err)
+		expected := []crdt.TextAnnotationRange{{Name: "c1", From: 6, To: 11, Value: "comment"}}
+
+		// 01. Annotations in changes.
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		d2 := document.New("d1")
+		assert.NoError(t, d2.ApplyChangePack(pack))
+		assert.Equal(t, expected, d2.Root().GetText("k1").Annotations())
+
+		// 02. Annotations in snapshots.
+		bytes, err := converter.ObjectToBytes(d1.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		text := obj.Get("k1").(*crdt.Text)
+		assert.Equal(t, expected, text.Annotations())
+		assert.Len(t, text.AllAnnotations(), 2)
+	})
+
+	t.Run("style without created at map test", func(t *testing.T) {
+		d1 := document.New("d1")
+		err := d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello").Style(0, 5, map[string]string{"b": "1"})
+			return nil
+		})
+		assert.NoError(t, err)
+
+		// 01. Remove the map as Style of the previous versions does not carry it.
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		for _, pbChange := range pbPack.Changes {
+			for _, pbOp := range pbChange.Operations {
+				if style := pbOp.GetStyle(); style != nil {
+					assert.NotEmpty(t, style.CreatedAtMapByActor)
+					style.CreatedAtMapByActor = nil
+				}
+			}
+		}
+
+		// 02. The style is still applied to all nodes in the range.
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		d2 := document.New("d1")
+		assert.NoError(t, d2.ApplyChangePack(pack))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("set and conflict policy test", func(t *testing.T) {
+		d1 := document.New("d1")
+		err := d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewSet("k1").Add("a").Add(int64(1)).Add("b").Delete("a")
+			root.SetNewObject("k2", crdt.Merge).SetString("k2.1", "v")
+			return nil
+		})
+		assert.NoError(t, err)
+		expected := `{"k1":[1,"b"],"k2":{"k2.1":"v"}}`
+
+		// 01. Sets and policies in changes.
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		d2 := document.New("d1")
+		assert.NoError(t, d2.ApplyChangePack(pack))
+		assert.Equal(t, expected, d2.Marshal())
+		assert.Equal(t, crdt.Merge, d2.Root().GetObject("k2").Policy())
+
+		// 02. Sets and policies in snapshots.
+		bytes, err := converter.ObjectToBytes(d1.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, obj.Marshal())
+		assert.Equal(t, crdt.Merge, obj.Get("k2").(*crdt.Object).Policy())
+		assert.True(t, obj.Get("k1").(*crdt.Set).Has(crdt.NewPrimitive("b", nil)))
+	})
+
+	t.Run("merge policy snapshot test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		// 01. A and B set objects with the merge policy to the same key
+		// concurrently, and edit their own objects before seeing the other.
+		setAndEdit := func(actor *time.ActorID, member string) ([]*change.Change, []*change.Change) {
+			doc := document.New("d1")
+			doc.SetActor(actor)
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetNewObject("k", crdt.Merge).SetString(member, "1")
+				return nil
+			}))
+			set := doc.CreateChangePack().Changes
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.GetObject("k").SetString("z"+member, "1")
+				return nil
+			}))
+			return set, doc.CreateChangePack().Changes[len(set):]
+		}
+		setA, editA := setAndEdit(actorA, "x")
+		setB, editB := setAndEdit(actorB, "y")
+
+		// 02. a replica merges the objects, and a snapshot is taken from it.
+		merged := document.NewInternalDocument("d1")
+		_, err = merged.ApplyChanges(append(setA, setB...)...)
+		assert.NoError(t, err)
+		snapshot, err := converter.SnapshotToBytes(merged.RootObject(), merged.AllPresences())
+		assert.NoError(t, err)
+		loaded, err := document.NewInternalDocumentFromSnapshot("d1", 2, 2, snapshot)
+		assert.NoError(t, err)
+		copied, err := merged.RootObject().DeepCopy()
+		assert.NoError(t, err)
+		copiedRoot := crdt.NewRoot(copied.(*crdt.Object))
+
+		// 03. the edits made concurrently with the merge are applied to the
+		// replicas loaded from the snapshot and copied as well.
+		edits := append(editA, editB...)
+		_, err = merged.ApplyChanges(edits...)
+		assert.NoError(t, err)
+		_, err = loaded.ApplyChanges(edits...)
+		assert.NoError(t, err)
+		for _, c := range edits {
+			assert.NoError(t, c.Execute(copiedRoot, innerpresence.NewMap()))
+		}
+
+		expected := `{"k":{"x":"1","y":"1","zx":"1","zy":"1"}}`
+		assert.Equal(t, expected, merged.Marshal())
+		assert.Equal(t, expected, loaded.Marshal())
+		assert.Equal(t, expected, copiedRoot.Object().Marshal())
+	})
+
+	t.Run("compact change pack test", func(t *testing.T) {
+		actorID, err := time.ActorIDFromHex("0123456789abcdef01234567")
+		assert.NoError(t, err)
+		d1 := document.New("d1")
+		d1.SetActor(actorID)
+
+		for i := 0; i < 3; i++ {
+			err := d1.Update(func(root *json.Object, p *presence.Presence) error {
+				if i == 0 {
+					root.SetNewText("k1")
+					root.SetNewTree("k2", &json.TreeNode{Type: "r"})
+					root.SetNewArray("k3").AddString("a", "b")
+				}
+				root.GetText("k1").Edit(0, 0, "Hello").Style(0, 2, map[string]string{"b": "1"})
+				root.GetTree("k2").Edit(0, 0, &json.TreeNode{
+					Type:     "p",
+					Children: []json.TreeNode{{Type: "text", Value: "ab"}},
+				})
+				root.GetArray("k3").Delete(0)
+				return nil
+			})
+			assert.NoError(t, err)
+		}
+
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		original := pbPack.Size()
+
+		converter.CompactChangePack(pbPack)
+		assert.True(t, pbPack.IsCompact)
+		assert.Len(t, pbPack.ActorIds, 2) // the initial actor of the root and d1
+		assert.Less(t, pbPack.Size(), original)
+
+		bytes, err := pbPack.Marshal()
+		assert.NoError(t, err)
+		decoded := &api.ChangePack{}
+		assert.NoError(t, decoded.Unmarshal(bytes))
+
+		pack, err := converter.FromChangePack(decoded)
+		assert.NoError(t, err)
+		pack.MinSyncedTicket = time.MaxTicket
+
+		d2 := document.New("d1")
+		assert.NoError(t, d2.ApplyChangePack(pack))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// an actor index out of the actor table should be rejected.
+		pbPack.ActorIds = nil
+		_, err = converter.FromChangePack(pbPack)
+		assert.ErrorIs(t, err, converter.ErrInvalidActorIndex)
+	})
+
+	t.Run("compress change pack test", func(t *testing.T) {
+		d1 := document.New("d1")
+		err := d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("Hello Yorkie ", 200))
+			return nil
+		})
+		assert.NoError(t, err)
+
+		for _, compressor := range []string{compression.Gzip, compression.Zstd} {
+			pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+			assert.NoError(t, err)
+			pbPack.Snapshot = []byte(strings.Repeat("snapshot ", 200))
+			original := pbPack.Size()
+
+			assert.NoError(t, converter.CompressChangePack(pbPack, compressor))
+			assert.Equal(t, compressor, pbPack.Compression)
+			assert.Len(t, pbPack.Changes, 0)
+			assert.Less(t, pbPack.Size(), original/5)
+
+			pack, err := converter.FromChangePackWithLimits(pbPack, converter.Limits{MaxDecompressedBytes: 1 << 20})
+			assert.NoError(t, err)
+			assert.Equal(t, []byte(strings.Repeat("snapshot ", 200)), pack.Snapshot)
+			pack.Snapshot = nil
+			pack.MinSyncedTicket = time.MaxTicket
+
+			d2 := document.New("d1")
+			assert.NoError(t, d2.ApplyChangePack(pack))
+			assert.Equal(t, d1.Marshal(), d2.Marshal())
+		}
+
+		// the payloads below the min size are not compressed.
+		pbPack, err := converter.ToChangePack(document.New("d2").CreateChangePack())
+		assert.NoError(t, err)
+		assert.NoError(t, converter.CompressChangePack(pbPack, compression.Zstd))
+		assert.Equal(t, "", pbPack.Compression)
+
+		// the decompressed payloads should not exceed the limit.
+		pbPack, err = converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		assert.NoError(t, converter.CompressChangePack(pbPack, compression.Gzip))
+		_, err = converter.FromChangePackWithLimits(pbPack, converter.Limits{MaxDecompressedBytes: 100})
+		assert.ErrorIs(t, err, converter.ErrPackTooLarge)
+
+		// the corrupted payloads should be rejected.
+		pbPack.CompressedChanges = []byte("corrupted")
+		_, err = converter.FromChangePack(pbPack)
+		assert.ErrorIs(t, err, converter.ErrInvalidCompressedPack)
+	})
+
+	t.Run("snapshot codec test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello Yorkie")
+			root.SetNewArray("k2").AddInteger(1, 2, 3)
+			root.SetNewCounter("k3", crdt.IntegerCnt, 10)
+			p.Set("name", "alice")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		// the snapshots of SnapshotToBytes without the format byte are read
+		// with the stored snapshots of the codecs.
+		legacy, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+		assert.NoError(t, err)
+		obj, _, err := converter.BytesToSnapshot(legacy)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+
+		for _, name := range []string{"", converter.SnapshotCodecProtobuf, converter.SnapshotCodecCBOR} {
+			snapshot, err := converter.EncodeSnapshot(doc.RootObject(), doc.AllPresences(), name)
+			assert.NoError(t, err)
+
+			obj, presences, err := converter.BytesToSnapshot(snapshot)
+			assert.NoError(t, err)
+			assert.Equal(t, doc.Marshal(), obj.Marshal())
+			for clientID, p := range doc.AllPresences() {
+				assert.Equal(t, p, presences.Load(clientID))
+			}
+
+			// the deltas are encoded from the snapshots of any codec.
+			delta, err := converter.SnapshotToDelta(legacy, snapshot)
+			assert.NoError(t, err)
+			rebuilt, err := converter.DeltaToSnapshot(snapshot, delta)
+			assert.NoError(t, err)
+			obj, _, err = converter.BytesToSnapshot(rebuilt)
+			assert.NoError(t, err)
+			assert.Equal(t, doc.Marshal(), obj.Marshal())
+		}
+
+		_, err = converter.EncodeSnapshot(doc.RootObject(), doc.AllPresences(), "json")
+		assert.ErrorIs(t, err, converter.ErrUnsupportedSnapshotCodec)
+		_, _, err = converter.BytesToSnapshot([]byte{7})
+		assert.ErrorIs(t, err, converter.ErrUnsupportedSnapshotCodec)
+	})
+
+	t.Run("snapshot delta test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("Hello Yorkie ", 100))
+			root.SetNewObject("k2").SetInteger("k2.1", 1)
+			p.Set("name", "alice")
+			return nil
+		})
+		assert.NoError(t, err)
+		base, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+		assert.NoError(t, err)
+
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("k2").SetInteger("k2.1", 2)
+			root.SetString("k3", "v3")
+			return nil
+		})
+		assert.NoError(t, err)
+		snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+		assert.NoError(t, err)
+
+		// the unmodified members refer to the base snapshot.
+		delta, err := converter.SnapshotToDelta(base, snapshot)
+		assert.NoError(t, err)
+		assert.Less(t, len(delta), len(snapshot)/2)
+
+		rebuilt, err := converter.DeltaToSnapshot(base, delta)
+		assert.NoError(t, err)
+		obj, presences, err := converter.BytesToSnapshot(rebuilt)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Root().Marshal(), obj.Marshal())
+		for clientID, p := range doc.AllPresences() {
+			assert.Equal(t, p, presences.Load(clientID))
+		}
+
+		// the delta should not be applied to another base.
+		_, err = converter.DeltaToSnapshot(nil, delta)
+		assert.ErrorIs(t, err, converter.ErrInvalidSnapshotDelta)
+	})
+
 	t.Run("change pack error test", func(t *testing.T) {
 		_, err := converter.FromChangePack(nil)
 		assert.ErrorIs(t, err, converter.ErrPackRequired)
@@ -209,6 +603,66 @@ func TestConverter(t *testing.T) {
 		assert.ErrorIs(t, err, converter.ErrCheckpointRequired)
 	})
 
+	t.Run("change pack limits test", func(t *testing.T) {
+		d1 := document.New("d1")
+		err := d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("k1").SetNewObject("k2").SetNewArray("k3")
+			root.SetString("k4", "abcd")
+			root.SetNewText("k5").Edit(0, 0, "abcd")
+			return nil
+		})
+		assert.NoError(t, err)
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+
+		_, err = converter.FromChangePackWithLimits(pbPack, converter.Limits{
+			MaxOperationsPerChange: 6,
+			MaxDepth:               3,
+			MaxStringLength:        4,
+		})
+		assert.NoError(t, err)
+
+		_, err = converter.FromChangePackWithLimits(pbPack, converter.Limits{MaxOperationsPerChange: 5})
+		assert.ErrorIs(t, err, converter.ErrTooManyOperations)
+
+		_, err = converter.FromChangePackWithLimits(pbPack, converter.Limits{MaxDepth: 2})
+		assert.ErrorIs(t, err, converter.ErrTooDeep)
+
+		_, err = converter.FromChangePackWithLimits(pbPack, converter.Limits{MaxStringLength: 3})
+		assert.ErrorIs(t, err, converter.ErrStringTooLong)
+	})
+
+	t.Run("tree change pack limits test", func(t *testing.T) {
+		d1 := document.New("d1")
+		err := d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{Type: "r"}).Edit(0, 0, &json.TreeNode{
+				Type:     "p",
+				Children: []json.TreeNode{{Type: "text", Value: "ab"}},
+			})
+			return nil
+		})
+		assert.NoError(t, err)
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+
+		_, err = converter.FromChangePackWithLimits(pbPack, converter.Limits{MaxDepth: 2, MaxStringLength: 2})
+		assert.NoError(t, err)
+
+		_, err = converter.FromChangePackWithLimits(pbPack, converter.Limits{MaxDepth: 1})
+		assert.ErrorIs(t, err, converter.ErrTooDeep)
+
+		_, err = converter.FromChangePackWithLimits(pbPack, converter.Limits{MaxStringLength: 1})
+		assert.ErrorIs(t, err, converter.ErrStringTooLong)
+	})
+
+	t.Run("presence limits test", func(t *testing.T) {
+		limits := converter.Limits{MaxStringLength: 4}
+		assert.NoError(t, limits.CheckPresence(map[string]string{"name": "abcd"}))
+		assert.ErrorIs(t, limits.CheckPresence(map[string]string{"name": "abcde"}), converter.ErrStringTooLong)
+		assert.ErrorIs(t, limits.CheckPresence(map[string]string{"color": "red"}), converter.ErrStringTooLong)
+		assert.NoError(t, converter.Limits{}.CheckPresence(map[string]string{"color": "red"}))
+	})
+
 	t.Run("tree converting test", func(t *testing.T) {
 		root := helper.BuildTreeNode(&json.TreeNode{
 			Type: "r",
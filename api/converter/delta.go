@@ -0,0 +1,129 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+)
+
+// SnapshotToDelta encodes the given snapshot as the difference from the given
+// base snapshot. The members of the root object whose encodings are the same
+// as in the base snapshot are encoded as references to the base snapshot.
+func SnapshotToDelta(base, snapshot []byte) ([]byte, error) {
+	pbBase, err := decodeSnapshot(base)
+	if err != nil {
+		return nil, err
+	}
+	pbSnapshot, err := decodeSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make(map[string]int)
+	for i, node := range pbBase.GetRoot().GetJsonObject().GetNodes() {
+		bytes, err := node.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("marshal base node: %w", err)
+		}
+		indexes[string(bytes)] = i
+	}
+
+	root := pbSnapshot.GetRoot().GetJsonObject()
+	if root == nil {
+		return nil, fmt.Errorf("root: %w", ErrInvalidSnapshotDelta)
+	}
+
+	pbDelta := &api.SnapshotDelta{
+		Root: &api.JSONElement{Body: &api.JSONElement_JsonObject{JsonObject: &api.JSONElement_JSONObject{
+			CreatedAt: root.CreatedAt,
+			MovedAt:   root.MovedAt,
+			RemovedAt: root.RemovedAt,
+			Policy:    root.Policy,
+			Merged:    root.Merged,
+		}}},
+		Presences: pbSnapshot.Presences,
+	}
+	for _, node := range root.Nodes {
+		bytes, err := node.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("marshal node: %w", err)
+		}
+
+		if i, ok := indexes[string(bytes)]; ok {
+			pbDelta.Nodes = append(pbDelta.Nodes, &api.SnapshotDeltaNode{BaseIndex: uint32(i + 1)})
+			continue
+		}
+		pbDelta.Nodes = append(pbDelta.Nodes, &api.SnapshotDeltaNode{Node: node})
+	}
+
+	delta, err := proto.Marshal(pbDelta)
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot delta: %w", err)
+	}
+
+	return delta, nil
+}
+
+// DeltaToSnapshot rebuilds the snapshot from the given base snapshot and the
+// delta that was encoded from it by SnapshotToDelta.
+func DeltaToSnapshot(base, delta []byte) ([]byte, error) {
+	pbBase, err := decodeSnapshot(base)
+	if err != nil {
+		return nil, err
+	}
+	pbDelta := &api.SnapshotDelta{}
+	if err := proto.Unmarshal(delta, pbDelta); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot delta: %w", err)
+	}
+
+	root := pbDelta.GetRoot().GetJsonObject()
+	if root == nil {
+		return nil, fmt.Errorf("root: %w", ErrInvalidSnapshotDelta)
+	}
+	baseNodes := pbBase.GetRoot().GetJsonObject().GetNodes()
+
+	nodes := make([]*api.RHTNode, 0, len(pbDelta.Nodes))
+	for _, node := range pbDelta.Nodes {
+		if node.BaseIndex == 0 {
+			if node.Node == nil {
+				return nil, fmt.Errorf("empty node: %w", ErrInvalidSnapshotDelta)
+			}
+			nodes = append(nodes, node.Node)
+			continue
+		}
+
+		if int(node.BaseIndex) > len(baseNodes) {
+			return nil, fmt.Errorf("base index %d: %w", node.BaseIndex, ErrInvalidSnapshotDelta)
+		}
+		nodes = append(nodes, baseNodes[node.BaseIndex-1])
+	}
+
+	root.Nodes = nodes
+	snapshot, err := proto.Marshal(&api.Snapshot{
+		Root:      pbDelta.Root,
+		Presences: pbDelta.Presences,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
@@ -23,29 +23,47 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
-// BytesToSnapshot creates a Snapshot from the given byte array.
+// BytesToSnapshot creates a Snapshot from the given byte array. It reads both
+// the snapshots of SnapshotToBytes and the stored snapshots of EncodeSnapshot.
 func BytesToSnapshot(snapshot []byte) (*crdt.Object, *innerpresence.Map, error) {
 	if snapshot == nil {
 		return crdt.NewObject(crdt.NewElementRHT(), time.InitialTicket), innerpresence.NewMap(), nil
 	}
 
-	pbSnapshot := &api.Snapshot{}
-	if err := proto.Unmarshal(snapshot, pbSnapshot); err != nil {
-		return nil, nil, fmt.Errorf("unmarshal snapshot: %w", err)
+	pbSnapshot, err := decodeSnapshot(snapshot)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	obj, err := fromJSONElement(pbSnapshot.GetRoot())
+	d := newDecoder()
+	obj, err := d.fromJSONElement(pbSnapshot.GetRoot())
 	if err != nil {
 		return nil, nil, err
 	}
 
+	root, ok := obj.(*crdt.Object)
+	if !ok {
+		return nil, nil, fmt.Errorf("root %s: %w", obj.Marshal(), ErrUnsupportedElement)
+	}
+
 	presences := fromPresences(pbSnapshot.GetPresences())
-	return obj.(*crdt.Object), presences, nil
+	return root, presences, nil
+}
+
+// BytesToChangePack creates a ChangePack from the given byte array.
+func BytesToChangePack(bytes []byte) (*change.Pack, error) {
+	pbPack := &api.ChangePack{}
+	if err := proto.Unmarshal(bytes, pbPack); err != nil {
+		return nil, fmt.Errorf("unmarshal change pack: %w", err)
+	}
+
+	return FromChangePack(pbPack)
 }
 
 // BytesToObject creates an Object from the given byte array.
@@ -59,7 +77,8 @@ func BytesToObject(snapshot []byte) (*crdt.Object, error) {
 		return nil, fmt.Errorf("unmarshal element: %w", err)
 	}
 
-	obj, err := fromJSONObject(pbElem.GetJsonObject())
+	d := newDecoder()
+	obj, err := d.fromJSONObject(pbElem.GetJsonObject())
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +88,11 @@ func BytesToObject(snapshot []byte) (*crdt.Object, error) {
 
 // BytesToTree creates a Tree from the given byte array.
 func BytesToTree(snapshot []byte) (*crdt.Tree, error) {
+	return newDecoder().bytesToTree(snapshot)
+}
+
+// bytesToTree creates a Tree from the given byte array.
+func (d *decoder) bytesToTree(snapshot []byte) (*crdt.Tree, error) {
 	if snapshot == nil {
 		return nil, errors.New("snapshot should not be nil")
 	}
@@ -78,7 +102,7 @@ func BytesToTree(snapshot []byte) (*crdt.Tree, error) {
 		return nil, fmt.Errorf("unmarshal tree: %w", err)
 	}
 
-	tree, err := fromJSONTree(pbTree.GetTree())
+	tree, err := d.fromJSONTree(pbTree.GetTree())
 	if err != nil {
 		return nil, err
 	}
@@ -86,81 +110,128 @@ func BytesToTree(snapshot []byte) (*crdt.Tree, error) {
 	return tree, nil
 }
 
-func fromJSONElement(pbElem *api.JSONElement) (crdt.Element, error) {
-	switch decoded := pbElem.Body.(type) {
+func (d *decoder) fromJSONElement(pbElem *api.JSONElement) (crdt.Element, error) {
+	switch decoded := pbElem.GetBody().(type) {
 	case *api.JSONElement_JsonObject:
-		return fromJSONObject(decoded.JsonObject)
+		return d.fromJSONObject(decoded.JsonObject)
 	case *api.JSONElement_JsonArray:
-		return fromJSONArray(decoded.JsonArray)
+		return d.fromJSONArray(decoded.JsonArray)
 	case *api.JSONElement_Primitive_:
-		return fromJSONPrimitive(decoded.Primitive)
+		return d.fromJSONPrimitive(decoded.Primitive)
 	case *api.JSONElement_Text_:
-		return fromJSONText(decoded.Text)
+		return d.fromJSONText(decoded.Text)
 	case *api.JSONElement_Counter_:
-		return fromJSONCounter(decoded.Counter)
+		return d.fromJSONCounter(decoded.Counter)
 	case *api.JSONElement_Tree_:
-		return fromJSONTree(decoded.Tree)
+		return d.fromJSONTree(decoded.Tree)
+	case *api.JSONElement_Set_:
+		return d.fromJSONSet(decoded.Set)
 	default:
 		return nil, fmt.Errorf("%s: %w", decoded, ErrUnsupportedElement)
 	}
 }
 
-func fromJSONObject(pbObj *api.JSONElement_JSONObject) (*crdt.Object, error) {
+func (d *decoder) fromJSONObject(pbObj *api.JSONElement_JSONObject) (*crdt.Object, error) {
 	members := crdt.NewElementRHT()
 	for _, pbNode := range pbObj.Nodes {
-		elem, err := fromJSONElement(pbNode.Element)
+		elem, err := d.fromJSONElement(pbNode.Element)
 		if err != nil {
 			return nil, err
 		}
 		members.Set(pbNode.Key, elem)
 	}
 
-	createdAt, err := fromTimeTicket(pbObj.CreatedAt)
+	createdAt, err := d.fromRequiredTimeTicket(pbObj.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	movedAt, err := d.fromTimeTicket(pbObj.MovedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	movedAt, err := fromTimeTicket(pbObj.MovedAt)
+	removedAt, err := d.fromTimeTicket(pbObj.RemovedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	removedAt, err := fromTimeTicket(pbObj.RemovedAt)
+	policy, err := fromConflictPolicy(pbObj.Policy)
 	if err != nil {
 		return nil, err
 	}
 
+	var merged []*time.Ticket
+	for _, pbTicket := range pbObj.Merged {
+		ticket, err := d.fromRequiredTimeTicket(pbTicket)
+		if err != nil {
+			return nil, err
+		}
+		merged = append(merged, ticket)
+	}
+
 	obj := crdt.NewObject(
 		members,
 		createdAt,
 	)
+	obj.SetPolicy(policy)
 	obj.SetMovedAt(movedAt)
 	obj.SetRemovedAt(removedAt)
+	obj.SetMerged(merged)
 
 	return obj, nil
 }
 
-func fromJSONArray(pbArr *api.JSONElement_JSONArray) (*crdt.Array, error) {
+func (d *decoder) fromJSONArray(pbArr *api.JSONElement_JSONArray) (*crdt.Array, error) {
 	elements := crdt.NewRGATreeList()
 	for _, pbNode := range pbArr.Nodes {
-		elem, err := fromJSONElement(pbNode.Element)
+		if pbNode.Element == nil {
+			positionedAt, err := d.fromRequiredTimeTicket(pbNode.PositionedAt)
+			if err != nil {
+				return nil, err
+			}
+			vacatedAt, err := d.fromRequiredTimeTicket(pbNode.VacatedAt)
+			if err != nil {
+				return nil, err
+			}
+			if err = elements.AddVacated(positionedAt, vacatedAt); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		elem, err := d.fromJSONElement(pbNode.Element)
 		if err != nil {
 			return nil, err
 		}
-		if err = elements.Add(elem); err != nil {
+		positionedAt, err := d.fromTimeTicket(pbNode.PositionedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE: The position is omitted for elements that have never been
+		// moved, and in snapshots written before positions were encoded. The
+		// position is the time the element was last moved in that case.
+		if positionedAt == nil {
+			positionedAt = elem.CreatedAt()
+			if elem.MovedAt() != nil {
+				positionedAt = elem.MovedAt()
+			}
+		}
+		if err = elements.AddAt(elem, positionedAt); err != nil {
 			return nil, err
 		}
 	}
 
-	createdAt, err := fromTimeTicket(pbArr.CreatedAt)
+	createdAt, err := d.fromRequiredTimeTicket(pbArr.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	movedAt, err := fromTimeTicket(pbArr.MovedAt)
+	movedAt, err := d.fromTimeTicket(pbArr.MovedAt)
 	if err != nil {
 		return nil, err
 	}
-	removedAt, err := fromTimeTicket(pbArr.RemovedAt)
+	removedAt, err := d.fromTimeTicket(pbArr.RemovedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -174,18 +245,18 @@ func fromJSONArray(pbArr *api.JSONElement_JSONArray) (*crdt.Array, error) {
 	return arr, nil
 }
 
-func fromJSONPrimitive(
+func (d *decoder) fromJSONPrimitive(
 	pbPrim *api.JSONElement_Primitive,
 ) (*crdt.Primitive, error) {
-	createdAt, err := fromTimeTicket(pbPrim.CreatedAt)
+	createdAt, err := d.fromRequiredTimeTicket(pbPrim.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	movedAt, err := fromTimeTicket(pbPrim.MovedAt)
+	movedAt, err := d.fromTimeTicket(pbPrim.MovedAt)
 	if err != nil {
 		return nil, err
 	}
-	removedAt, err := fromTimeTicket(pbPrim.RemovedAt)
+	removedAt, err := d.fromTimeTicket(pbPrim.RemovedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -203,18 +274,18 @@ func fromJSONPrimitive(
 	return primitive, nil
 }
 
-func fromJSONText(
+func (d *decoder) fromJSONText(
 	pbText *api.JSONElement_Text,
 ) (*crdt.Text, error) {
-	createdAt, err := fromTimeTicket(pbText.CreatedAt)
+	createdAt, err := d.fromRequiredTimeTicket(pbText.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	movedAt, err := fromTimeTicket(pbText.MovedAt)
+	movedAt, err := d.fromTimeTicket(pbText.MovedAt)
 	if err != nil {
 		return nil, err
 	}
-	removedAt, err := fromTimeTicket(pbText.RemovedAt)
+	removedAt, err := d.fromTimeTicket(pbText.RemovedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -222,12 +293,12 @@ func fromJSONText(
 	rgaTreeSplit := crdt.NewRGATreeSplit(crdt.InitialTextNode())
 	current := rgaTreeSplit.InitialHead()
 	for _, pbNode := range pbText.Nodes {
-		textNode, err := fromTextNode(pbNode)
+		textNode, err := d.fromTextNode(pbNode)
 		if err != nil {
 			return nil, err
 		}
 		current = rgaTreeSplit.InsertAfter(current, textNode)
-		insPrevID, err := fromTextNodeID(pbNode.InsPrevId)
+		insPrevID, err := d.fromTextNodeID(pbNode.InsPrevId)
 		if err != nil {
 			return nil, err
 		}
@@ -247,19 +318,51 @@ func fromJSONText(
 	text.SetMovedAt(movedAt)
 	text.SetRemovedAt(removedAt)
 
+	for _, pbAnnotation := range pbText.Annotations {
+		annotation, err := d.fromTextAnnotation(pbAnnotation)
+		if err != nil {
+			return nil, err
+		}
+		text.SetAnnotation(annotation)
+	}
+
 	return text, nil
 }
 
-func fromJSONCounter(pbCnt *api.JSONElement_Counter) (*crdt.Counter, error) {
-	createdAt, err := fromTimeTicket(pbCnt.CreatedAt)
+func (d *decoder) fromTextAnnotation(pbAnnotation *api.TextAnnotation) (*crdt.TextAnnotation, error) {
+	from, err := d.fromTextNodePos(pbAnnotation.From)
 	if err != nil {
 		return nil, err
 	}
-	movedAt, err := fromTimeTicket(pbCnt.MovedAt)
+	to, err := d.fromTextNodePos(pbAnnotation.To)
 	if err != nil {
 		return nil, err
 	}
-	removedAt, err := fromTimeTicket(pbCnt.RemovedAt)
+	updatedAt, err := d.fromRequiredTimeTicket(pbAnnotation.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return crdt.NewTextAnnotation(
+		pbAnnotation.Name,
+		from,
+		to,
+		pbAnnotation.Value,
+		updatedAt,
+		pbAnnotation.IsRemoved,
+	), nil
+}
+
+func (d *decoder) fromJSONCounter(pbCnt *api.JSONElement_Counter) (*crdt.Counter, error) {
+	createdAt, err := d.fromRequiredTimeTicket(pbCnt.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	movedAt, err := d.fromTimeTicket(pbCnt.MovedAt)
+	if err != nil {
+		return nil, err
+	}
+	removedAt, err := d.fromTimeTicket(pbCnt.RemovedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -286,29 +389,65 @@ func fromJSONCounter(pbCnt *api.JSONElement_Counter) (*crdt.Counter, error) {
 	return counter, nil
 }
 
-func fromTextNode(
+func (d *decoder) fromJSONSet(pbSet *api.JSONElement_Set) (*crdt.Set, error) {
+	createdAt, err := d.fromRequiredTimeTicket(pbSet.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	movedAt, err := d.fromTimeTicket(pbSet.MovedAt)
+	if err != nil {
+		return nil, err
+	}
+	removedAt, err := d.fromTimeTicket(pbSet.RemovedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	set := crdt.NewSet(createdAt)
+	for _, pbMember := range pbSet.Members {
+		valueType, err := fromPrimitiveValueType(pbMember.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		var value *crdt.Primitive
+		for _, pbTag := range pbMember.Tags {
+			tag, err := d.fromRequiredTimeTicket(pbTag)
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				value = crdt.NewPrimitive(crdt.ValueFromBytes(valueType, pbMember.Value), tag)
+			}
+			set.Add(value, tag)
+		}
+	}
+	set.SetMovedAt(movedAt)
+	set.SetRemovedAt(removedAt)
+
+	return set, nil
+}
+
+func (d *decoder) fromTextNode(
 	pbNode *api.TextNode,
 ) (*crdt.RGATreeSplitNode[*crdt.TextValue], error) {
-	id, err := fromTextNodeID(pbNode.Id)
+	id, err := d.fromTextNodeID(pbNode.Id)
 	if err != nil {
 		return nil, err
 	}
 
 	attrs := crdt.NewRHT()
 	for key, pbAttr := range pbNode.Attributes {
-		updatedAt, err := fromTimeTicket(pbAttr.UpdatedAt)
+		updatedAt, err := d.fromRequiredTimeTicket(pbAttr.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
 		attrs.Set(key, pbAttr.Value, updatedAt)
 	}
 
-	textNode := crdt.NewRGATreeSplitNode(
-		id,
-		crdt.NewTextValue(pbNode.Value, attrs),
-	)
+	textNode := d.elements.NewTextNode(id, pbNode.Value, attrs)
 	if pbNode.RemovedAt != nil {
-		removedAt, err := fromTimeTicket(pbNode.RemovedAt)
+		removedAt, err := d.fromTimeTicket(pbNode.RemovedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -317,40 +456,40 @@ func fromTextNode(
 	return textNode, nil
 }
 
-func fromTextNodeID(
+func (d *decoder) fromTextNodeID(
 	pbTextNodeID *api.TextNodeID,
 ) (*crdt.RGATreeSplitNodeID, error) {
 	if pbTextNodeID == nil {
 		return nil, nil
 	}
 
-	createdAt, err := fromTimeTicket(pbTextNodeID.CreatedAt)
+	createdAt, err := d.fromRequiredTimeTicket(pbTextNodeID.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	return crdt.NewRGATreeSplitNodeID(
+	return d.elements.NewRGATreeSplitNodeID(
 		createdAt,
 		int(pbTextNodeID.Offset),
 	), nil
 }
 
-func fromJSONTree(
+func (d *decoder) fromJSONTree(
 	pbTree *api.JSONElement_Tree,
 ) (*crdt.Tree, error) {
-	createdAt, err := fromTimeTicket(pbTree.CreatedAt)
+	createdAt, err := d.fromRequiredTimeTicket(pbTree.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	movedAt, err := fromTimeTicket(pbTree.MovedAt)
+	movedAt, err := d.fromTimeTicket(pbTree.MovedAt)
 	if err != nil {
 		return nil, err
 	}
-	removedAt, err := fromTimeTicket(pbTree.RemovedAt)
+	removedAt, err := d.fromTimeTicket(pbTree.RemovedAt)
 	if err != nil {
 		return nil, err
 	}
-	root, err := FromTreeNodes(pbTree.Nodes)
+	root, err := d.fromTreeNodes(pbTree.Nodes)
 	if err != nil {
 		return nil, err
 	}
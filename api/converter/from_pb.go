@@ -18,6 +18,7 @@ package converter
 
 import (
 	"fmt"
+	gotime "time"
 
 	protoTypes "github.com/gogo/protobuf/types"
 
@@ -31,6 +32,27 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// decoder converts the Protobuf formats of a document to model formats. The
+// values of the document are allocated from the arenas of the decoder, so a
+// decoder is created for each change pack or snapshot, and the memory of a
+// document is freed with the document instead of being pinned by the others.
+type decoder struct {
+	times      *time.Arena
+	elements   *crdt.Arena
+	changes    *change.Arena
+	operations *operations.Arena
+}
+
+// newDecoder creates a new instance of decoder.
+func newDecoder() *decoder {
+	return &decoder{
+		times:      time.NewArena(),
+		elements:   crdt.NewArena(),
+		changes:    change.NewArena(),
+		operations: operations.NewArena(),
+	}
+}
+
 // FromUser converts the given Protobuf formats to model format.
 func FromUser(pbUser *api.User) (*types.User, error) {
 	createdAt, err := protoTypes.TimestampFromProto(pbUser.CreatedAt)
@@ -74,6 +96,17 @@ func FromProject(pbProject *api.Project) (*types.Project, error) {
 		Name:                      pbProject.Name,
 		AuthWebhookURL:            pbProject.AuthWebhookUrl,
 		AuthWebhookMethods:        pbProject.AuthWebhookMethods,
+		AuthJWTKey:                pbProject.AuthJwtKey,
+		AuthJWKSURL:               pbProject.AuthJwksUrl,
+		SensitivePresenceKeys:     pbProject.SensitivePresenceKeys,
+		EventWebhookURL:           pbProject.EventWebhookUrl,
+		EventWebhookEvents:        pbProject.EventWebhookEvents,
+		DocumentSizeSoftLimit:     pbProject.DocumentSizeSoftLimit,
+		DocumentSizeHardLimit:     pbProject.DocumentSizeHardLimit,
+		ChangeLogSoftLimit:        pbProject.ChangeLogSoftLimit,
+		ChangeLogHardLimit:        pbProject.ChangeLogHardLimit,
+		SnapshotThreshold:         pbProject.SnapshotThreshold,
+		SnapshotInterval:          pbProject.SnapshotInterval,
 		ClientDeactivateThreshold: pbProject.ClientDeactivateThreshold,
 		PublicKey:                 pbProject.PublicKey,
 		SecretKey:                 pbProject.SecretKey,
@@ -116,11 +149,124 @@ func FromDocumentSummary(pbSummary *api.DocumentSummary) (*types.DocumentSummary
 		AccessedAt: accessedAt,
 		UpdatedAt:  updatedAt,
 		Snapshot:   pbSummary.Snapshot,
+		Labels:     pbSummary.Labels,
+	}, nil
+}
+
+// FromClientSummaries converts the given Protobuf formats to model format.
+func FromClientSummaries(pbSummaries []*api.ClientSummary) ([]*types.ClientSummary, error) {
+	var summaries []*types.ClientSummary
+	for _, pbSummary := range pbSummaries {
+		summary, err := FromClientSummary(pbSummary)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// FromClientSummary converts the given Protobuf formats to model format.
+func FromClientSummary(pbSummary *api.ClientSummary) (*types.ClientSummary, error) {
+	createdAt, err := protoTypes.TimestampFromProto(pbSummary.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("convert createdAt to timestamp: %w", err)
+	}
+	updatedAt, err := protoTypes.TimestampFromProto(pbSummary.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("convert updatedAt to timestamp: %w", err)
+	}
+
+	summary := &types.ClientSummary{
+		ID:        types.ID(pbSummary.Id),
+		Key:       pbSummary.Key,
+		Status:    pbSummary.Status,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+	if pbSummary.Connection != nil {
+		summary.Connection = types.ConnectionInfo{
+			IP:        pbSummary.Connection.Ip,
+			UserAgent: pbSummary.Connection.UserAgent,
+			Source:    pbSummary.Connection.Source,
+		}
+	}
+	return summary, nil
+}
+
+// FromDocumentTemplates converts the given Protobuf formats to model format.
+func FromDocumentTemplates(pbTemplates []*api.DocumentTemplate) ([]*types.DocumentTemplate, error) {
+	var templates []*types.DocumentTemplate
+	for _, pbTemplate := range pbTemplates {
+		template, err := FromDocumentTemplate(pbTemplate)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// FromDocumentTemplate converts the given Protobuf formats to model format.
+func FromDocumentTemplate(pbTemplate *api.DocumentTemplate) (*types.DocumentTemplate, error) {
+	createdAt, err := protoTypes.TimestampFromProto(pbTemplate.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("convert createdAt to timestamp: %w", err)
+	}
+	updatedAt, err := protoTypes.TimestampFromProto(pbTemplate.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("convert updatedAt to timestamp: %w", err)
+	}
+
+	return &types.DocumentTemplate{
+		Collection: pbTemplate.Collection,
+		Root:       pbTemplate.Root,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
 	}, nil
 }
 
+// FromDocumentACL converts the given Protobuf formats to model format.
+func FromDocumentACL(pbACL *api.DocumentACL) *types.DocumentACL {
+	if pbACL == nil {
+		return nil
+	}
+
+	return &types.DocumentACL{
+		Readers:    pbACL.Readers,
+		Writers:    pbACL.Writers,
+		Admins:     pbACL.Admins,
+		PublicRead: pbACL.PublicRead,
+	}
+}
+
+// FromDocumentMemories converts the given Protobuf formats to model format.
+func FromDocumentMemories(pbMemories []*api.DocumentMemory) []*types.DocumentMemory {
+	var memories []*types.DocumentMemory
+	for _, pbMemory := range pbMemories {
+		memories = append(memories, &types.DocumentMemory{
+			ID:                types.ID(pbMemory.Id),
+			Key:               key.Key(pbMemory.Key),
+			Subscriptions:     int(pbMemory.Subscriptions),
+			SubscriptionBytes: pbMemory.SubscriptionBytes,
+			Locks:             int(pbMemory.Locks),
+			LockBytes:         pbMemory.LockBytes,
+			Snapshots:         int(pbMemory.Snapshots),
+			SnapshotBytes:     pbMemory.SnapshotBytes,
+		})
+	}
+	return memories
+}
+
 // FromChangePack converts the given Protobuf formats to model format.
 func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
+	return FromChangePackWithLimits(pbPack, Limits{})
+}
+
+// FromChangePackWithLimits converts the given Protobuf formats to model
+// format. It returns an error if the changes of the pack exceed the given
+// limits.
+func FromChangePackWithLimits(pbPack *api.ChangePack, limits Limits) (*change.Pack, error) {
 	if pbPack == nil {
 		return nil, ErrPackRequired
 	}
@@ -128,12 +274,23 @@ func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
 		return nil, ErrCheckpointRequired
 	}
 
-	changes, err := FromChanges(pbPack.Changes)
+	if err := decompressChangePack(pbPack, limits.MaxDecompressedBytes); err != nil {
+		return nil, err
+	}
+	if err := expandChangePack(pbPack); err != nil {
+		return nil, err
+	}
+	if err := limits.Check(pbPack); err != nil {
+		return nil, err
+	}
+
+	d := newDecoder()
+	changes, err := d.fromChanges(pbPack.Changes)
 	if err != nil {
 		return nil, err
 	}
 
-	minSyncedTicket, err := fromTimeTicket(pbPack.MinSyncedTicket)
+	minSyncedTicket, err := d.fromTimeTicket(pbPack.MinSyncedTicket)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +302,7 @@ func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
 		Snapshot:        pbPack.Snapshot,
 		MinSyncedTicket: minSyncedTicket,
 		IsRemoved:       pbPack.IsRemoved,
+		IdempotencyKey:  pbPack.IdempotencyKey,
 	}, nil
 }
 
@@ -157,29 +315,35 @@ func fromCheckpoint(pbCheckpoint *api.Checkpoint) change.Checkpoint {
 
 // FromChanges converts the given Protobuf formats to model format.
 func FromChanges(pbChanges []*api.Change) ([]*change.Change, error) {
+	return newDecoder().fromChanges(pbChanges)
+}
+
+// fromChanges converts the given Protobuf formats to model format.
+func (d *decoder) fromChanges(pbChanges []*api.Change) ([]*change.Change, error) {
 	var changes []*change.Change
 	for _, pbChange := range pbChanges {
-		changeID, err := fromChangeID(pbChange.Id)
+		changeID, err := d.fromChangeID(pbChange.Id)
 		if err != nil {
 			return nil, err
 		}
-		ops, err := FromOperations(pbChange.Operations)
+		ops, err := d.fromOperations(pbChange.Operations)
 		if err != nil {
 			return nil, err
 		}
-		changes = append(changes, change.New(
+		c := d.changes.New(
 			changeID,
 			pbChange.Message,
 			ops,
 			FromPresenceChange(pbChange.PresenceChange),
-		))
+		)
+		changes = append(changes, c)
 	}
 
 	return changes, nil
 }
 
-func fromChangeID(id *api.ChangeID) (change.ID, error) {
-	actorID, err := time.ActorIDFromBytes(id.ActorId)
+func (d *decoder) fromChangeID(id *api.ChangeID) (change.ID, error) {
+	actorID, err := d.times.ActorIDFromBytes(id.ActorId)
 	if err != nil {
 		return change.InitialID, err
 	}
@@ -191,6 +355,16 @@ func fromChangeID(id *api.ChangeID) (change.ID, error) {
 	), nil
 }
 
+// FromOptionalTimestamp converts the given Protobuf format to model format.
+// The zero time is returned if the timestamp is not given.
+func FromOptionalTimestamp(pbTimestamp *protoTypes.Timestamp) (gotime.Time, error) {
+	if pbTimestamp == nil {
+		return gotime.Time{}, nil
+	}
+
+	return protoTypes.TimestampFromProto(pbTimestamp)
+}
+
 // FromDocumentID converts the given Protobuf formats to model format.
 func FromDocumentID(pbID string) (types.ID, error) {
 	id := types.ID(pbID)
@@ -210,40 +384,61 @@ func FromEventType(pbDocEventType api.DocEventType) (types.DocEventType, error)
 		return types.DocumentWatchedEvent, nil
 	case api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_UNWATCHED:
 		return types.DocumentUnwatchedEvent, nil
+	case api.DocEventType_DOC_EVENT_TYPE_PEERS_CHANGED:
+		return types.PeersChangedEvent, nil
+	case api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_READ_ONLY:
+		return types.DocumentReadOnlyEvent, nil
+	case api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_WRITABLE:
+		return types.DocumentWritableEvent, nil
+	case api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_EVICTED:
+		return types.DocumentEvictedEvent, nil
 	}
 	return "", fmt.Errorf("%v: %w", pbDocEventType, ErrUnsupportedEventType)
 }
 
 // FromOperations converts the given Protobuf formats to model format.
 func FromOperations(pbOps []*api.Operation) ([]operations.Operation, error) {
+	return newDecoder().fromOperations(pbOps)
+}
+
+// fromOperations converts the given Protobuf formats to model format.
+func (d *decoder) fromOperations(pbOps []*api.Operation) ([]operations.Operation, error) {
 	var ops []operations.Operation
 	for _, pbOp := range pbOps {
 		var op operations.Operation
 		var err error
 		switch decoded := pbOp.Body.(type) {
 		case *api.Operation_Set_:
-			op, err = fromSet(decoded.Set)
+			op, err = d.fromSet(decoded.Set)
 		case *api.Operation_Add_:
-			op, err = fromAdd(decoded.Add)
+			op, err = d.fromAdd(decoded.Add)
 		case *api.Operation_Move_:
-			op, err = fromMove(decoded.Move)
+			op, err = d.fromMove(decoded.Move)
 		case *api.Operation_Remove_:
-			op, err = fromRemove(decoded.Remove)
+			op, err = d.fromRemove(decoded.Remove)
 		case *api.Operation_Edit_:
-			op, err = fromEdit(decoded.Edit)
+			op, err = d.fromEdit(decoded.Edit)
 		case *api.Operation_EditReverse_:
-			op, err = fromEditReverse(decoded.EditReverse)
+			op, err = d.fromEditReverse(decoded.EditReverse)
 		case *api.Operation_Style_:
-			op, err = fromStyle(decoded.Style)
+			op, err = d.fromStyle(decoded.Style)
+		case *api.Operation_AddAnnotation_:
+			op, err = d.fromAddAnnotation(decoded.AddAnnotation)
+		case *api.Operation_RemoveAnnotation_:
+			op, err = d.fromRemoveAnnotation(decoded.RemoveAnnotation)
 		case *api.Operation_Select_:
 			// NOTE(hackerwins): Operation_Select is deprecated.
 			continue
 		case *api.Operation_Increase_:
-			op, err = fromIncrease(decoded.Increase)
+			op, err = d.fromIncrease(decoded.Increase)
+		case *api.Operation_SetAdd_:
+			op, err = d.fromSetAdd(decoded.SetAdd)
+		case *api.Operation_SetRemove_:
+			op, err = d.fromSetRemove(decoded.SetRemove)
 		case *api.Operation_TreeEdit_:
-			op, err = fromTreeEdit(decoded.TreeEdit)
+			op, err = d.fromTreeEdit(decoded.TreeEdit)
 		case *api.Operation_TreeStyle_:
-			op, err = fromTreeStyle(decoded.TreeStyle)
+			op, err = d.fromTreeStyle(decoded.TreeStyle)
 		default:
 			return nil, ErrUnsupportedOperation
 		}
@@ -303,21 +498,21 @@ func FromPresenceChange(pbPresenceChange *api.PresenceChange) *innerpresence.Pre
 	return &p
 }
 
-func fromSet(pbSet *api.Operation_Set) (*operations.Set, error) {
-	parentCreatedAt, err := fromTimeTicket(pbSet.ParentCreatedAt)
+func (d *decoder) fromSet(pbSet *api.Operation_Set) (*operations.Set, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbSet.ParentCreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbSet.ExecutedAt)
+	executedAt, err := d.fromRequiredTimeTicket(pbSet.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
-	elem, err := fromElement(pbSet.Value)
+	elem, err := d.fromElement(pbSet.Value)
 	if err != nil {
 		return nil, err
 	}
 
-	return operations.NewSet(
+	return d.operations.NewSet(
 		parentCreatedAt,
 		pbSet.Key,
 		elem,
@@ -325,24 +520,24 @@ func fromSet(pbSet *api.Operation_Set) (*operations.Set, error) {
 	), nil
 }
 
-func fromAdd(pbAdd *api.Operation_Add) (*operations.Add, error) {
-	parentCreatedAt, err := fromTimeTicket(pbAdd.ParentCreatedAt)
+func (d *decoder) fromAdd(pbAdd *api.Operation_Add) (*operations.Add, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbAdd.ParentCreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	prevCreatedAt, err := fromTimeTicket(pbAdd.PrevCreatedAt)
+	prevCreatedAt, err := d.fromRequiredTimeTicket(pbAdd.PrevCreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	elem, err := fromElement(pbAdd.Value)
+	elem, err := d.fromElement(pbAdd.Value)
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbAdd.ExecutedAt)
+	executedAt, err := d.fromRequiredTimeTicket(pbAdd.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
-	return operations.NewAdd(
+	return d.operations.NewAdd(
 		parentCreatedAt,
 		prevCreatedAt,
 		elem,
@@ -350,24 +545,24 @@ func fromAdd(pbAdd *api.Operation_Add) (*operations.Add, error) {
 	), nil
 }
 
-func fromMove(pbMove *api.Operation_Move) (*operations.Move, error) {
-	parentCreatedAt, err := fromTimeTicket(pbMove.ParentCreatedAt)
+func (d *decoder) fromMove(pbMove *api.Operation_Move) (*operations.Move, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbMove.ParentCreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	prevCreatedAt, err := fromTimeTicket(pbMove.PrevCreatedAt)
+	prevCreatedAt, err := d.fromRequiredTimeTicket(pbMove.PrevCreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	createdAt, err := fromTimeTicket(pbMove.CreatedAt)
+	createdAt, err := d.fromRequiredTimeTicket(pbMove.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbMove.ExecutedAt)
+	executedAt, err := d.fromRequiredTimeTicket(pbMove.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
-	return operations.NewMove(
+	return d.operations.NewMove(
 		parentCreatedAt,
 		prevCreatedAt,
 		createdAt,
@@ -375,50 +570,50 @@ func fromMove(pbMove *api.Operation_Move) (*operations.Move, error) {
 	), nil
 }
 
-func fromRemove(pbRemove *api.Operation_Remove) (*operations.Remove, error) {
-	parentCreatedAt, err := fromTimeTicket(pbRemove.ParentCreatedAt)
+func (d *decoder) fromRemove(pbRemove *api.Operation_Remove) (*operations.Remove, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbRemove.ParentCreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	createdAt, err := fromTimeTicket(pbRemove.CreatedAt)
+	createdAt, err := d.fromRequiredTimeTicket(pbRemove.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbRemove.ExecutedAt)
+	executedAt, err := d.fromRequiredTimeTicket(pbRemove.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
-	return operations.NewRemove(
+	return d.operations.NewRemove(
 		parentCreatedAt,
 		createdAt,
 		executedAt,
 	), nil
 }
 
-func fromEdit(pbEdit *api.Operation_Edit) (*operations.Edit, error) {
-	parentCreatedAt, err := fromTimeTicket(pbEdit.ParentCreatedAt)
+func (d *decoder) fromEdit(pbEdit *api.Operation_Edit) (*operations.Edit, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbEdit.ParentCreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	from, err := fromTextNodePos(pbEdit.From)
+	from, err := d.fromTextNodePos(pbEdit.From)
 	if err != nil {
 		return nil, err
 	}
-	to, err := fromTextNodePos(pbEdit.To)
+	to, err := d.fromTextNodePos(pbEdit.To)
 	if err != nil {
 		return nil, err
 	}
-	createdAtMapByActor, err := fromCreatedAtMapByActor(
+	createdAtMapByActor, err := d.fromCreatedAtMapByActor(
 		pbEdit.CreatedAtMapByActor,
 	)
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbEdit.ExecutedAt)
+	executedAt, err := d.fromRequiredTimeTicket(pbEdit.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
-	return operations.NewEdit(
+	return d.operations.NewEdit(
 		parentCreatedAt,
 		from,
 		to,
@@ -429,23 +624,23 @@ func fromEdit(pbEdit *api.Operation_Edit) (*operations.Edit, error) {
 	), nil
 }
 
-func fromEditReverse(pbEditReverse *api.Operation_EditReverse) (*operations.EditReverse, error) {
-	parentCreatedAt, err := fromTimeTicket(pbEditReverse.ParentCreatedAt)
+func (d *decoder) fromEditReverse(pbEditReverse *api.Operation_EditReverse) (*operations.EditReverse, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbEditReverse.ParentCreatedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	createdAtMapByActor, err := fromCreatedAtMapByActor(
+	createdAtMapByActor, err := d.fromCreatedAtMapByActor(
 		pbEditReverse.CreatedAtMapByActor,
 	)
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbEditReverse.ExecutedAt)
+	executedAt, err := d.fromRequiredTimeTicket(pbEditReverse.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
-	return operations.NewEditReverse(
+	return d.operations.NewEditReverse(
 		parentCreatedAt,
 		pbEditReverse.FromIdx,
 		pbEditReverse.ToIdx,
@@ -456,86 +651,189 @@ func fromEditReverse(pbEditReverse *api.Operation_EditReverse) (*operations.Edit
 	), nil
 }
 
-func fromStyle(pbStyle *api.Operation_Style) (*operations.Style, error) {
-	parentCreatedAt, err := fromTimeTicket(pbStyle.ParentCreatedAt)
+func (d *decoder) fromStyle(pbStyle *api.Operation_Style) (*operations.Style, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbStyle.ParentCreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	from, err := fromTextNodePos(pbStyle.From)
+	from, err := d.fromTextNodePos(pbStyle.From)
 	if err != nil {
 		return nil, err
 	}
-	to, err := fromTextNodePos(pbStyle.To)
+	to, err := d.fromTextNodePos(pbStyle.To)
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbStyle.ExecutedAt)
+
+	// NOTE(hackerwins): Style of the previous versions does not carry the map.
+	// It is left nil so that all nodes in the range are styled as before.
+	var createdAtMapByActor map[string]*time.Ticket
+	if len(pbStyle.CreatedAtMapByActor) > 0 {
+		createdAtMapByActor, err = d.fromCreatedAtMapByActor(
+			pbStyle.CreatedAtMapByActor,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	executedAt, err := d.fromRequiredTimeTicket(pbStyle.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
-	return operations.NewStyle(
+	return d.operations.NewStyle(
 		parentCreatedAt,
 		from,
 		to,
+		createdAtMapByActor,
 		pbStyle.Attributes,
 		executedAt,
 	), nil
 }
 
-func fromIncrease(pbInc *api.Operation_Increase) (*operations.Increase, error) {
-	parentCreatedAt, err := fromTimeTicket(pbInc.ParentCreatedAt)
+func (d *decoder) fromAddAnnotation(pbAdd *api.Operation_AddAnnotation) (*operations.AddAnnotation, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbAdd.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	from, err := d.fromTextNodePos(pbAdd.From)
 	if err != nil {
 		return nil, err
 	}
-	elem, err := fromElement(pbInc.Value)
+	to, err := d.fromTextNodePos(pbAdd.To)
 	if err != nil {
 		return nil, err
 	}
-	executedAt, err := fromTimeTicket(pbInc.ExecutedAt)
+	executedAt, err := d.fromRequiredTimeTicket(pbAdd.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
-	return operations.NewIncrease(
+	return d.operations.NewAddAnnotation(
+		parentCreatedAt,
+		pbAdd.Name,
+		from,
+		to,
+		pbAdd.Value,
+		executedAt,
+	), nil
+}
+
+func (d *decoder) fromRemoveAnnotation(pbRemove *api.Operation_RemoveAnnotation) (*operations.RemoveAnnotation, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbRemove.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := d.fromRequiredTimeTicket(pbRemove.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return d.operations.NewRemoveAnnotation(
+		parentCreatedAt,
+		pbRemove.Name,
+		executedAt,
+	), nil
+}
+
+func (d *decoder) fromIncrease(pbInc *api.Operation_Increase) (*operations.Increase, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbInc.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	elem, err := d.fromElement(pbInc.Value)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := d.fromRequiredTimeTicket(pbInc.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return d.operations.NewIncrease(
 		parentCreatedAt,
 		elem,
 		executedAt,
 	), nil
 }
 
-func fromTreeEdit(pbTreeEdit *api.Operation_TreeEdit) (*operations.TreeEdit, error) {
-	parentCreatedAt, err := fromTimeTicket(pbTreeEdit.ParentCreatedAt)
+func (d *decoder) fromSetAdd(pbAdd *api.Operation_SetAdd) (*operations.SetAdd, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbAdd.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	elem, err := d.fromElement(pbAdd.Value)
 	if err != nil {
 		return nil, err
 	}
+	executedAt, err := d.fromRequiredTimeTicket(pbAdd.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return d.operations.NewSetAdd(
+		parentCreatedAt,
+		elem,
+		executedAt,
+	), nil
+}
 
-	executedAt, err := fromTimeTicket(pbTreeEdit.ExecutedAt)
+func (d *decoder) fromSetRemove(pbRemove *api.Operation_SetRemove) (*operations.SetRemove, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbRemove.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	elem, err := d.fromElement(pbRemove.Value)
+	if err != nil {
+		return nil, err
+	}
+	createdAtMapByActor, err := d.fromCreatedAtMapByActor(
+		pbRemove.CreatedAtMapByActor,
+	)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := d.fromRequiredTimeTicket(pbRemove.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return d.operations.NewSetRemove(
+		parentCreatedAt,
+		elem,
+		createdAtMapByActor,
+		executedAt,
+	), nil
+}
+
+func (d *decoder) fromTreeEdit(pbTreeEdit *api.Operation_TreeEdit) (*operations.TreeEdit, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbTreeEdit.ParentCreatedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	from, err := fromTreePos(pbTreeEdit.From)
+	executedAt, err := d.fromRequiredTimeTicket(pbTreeEdit.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	to, err := fromTreePos(pbTreeEdit.To)
+	from, err := d.fromTreePos(pbTreeEdit.From)
 	if err != nil {
 		return nil, err
 	}
 
-	createdAtMapByActor, err := fromCreatedAtMapByActor(
+	to, err := d.fromTreePos(pbTreeEdit.To)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAtMapByActor, err := d.fromCreatedAtMapByActor(
 		pbTreeEdit.CreatedAtMapByActor,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	nodes, err := FromTreeNodesWhenEdit(pbTreeEdit.Contents)
+	nodes, err := d.fromTreeNodesWhenEdit(pbTreeEdit.Contents)
 	if err != nil {
 		return nil, err
 	}
 
-	return operations.NewTreeEdit(
+	return d.operations.NewTreeEdit(
 		parentCreatedAt,
 		from,
 		to,
@@ -545,28 +843,28 @@ func fromTreeEdit(pbTreeEdit *api.Operation_TreeEdit) (*operations.TreeEdit, err
 	), nil
 }
 
-func fromTreeStyle(pbTreeStyle *api.Operation_TreeStyle) (*operations.TreeStyle, error) {
-	parentCreatedAt, err := fromTimeTicket(pbTreeStyle.ParentCreatedAt)
+func (d *decoder) fromTreeStyle(pbTreeStyle *api.Operation_TreeStyle) (*operations.TreeStyle, error) {
+	parentCreatedAt, err := d.fromRequiredTimeTicket(pbTreeStyle.ParentCreatedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	executedAt, err := fromTimeTicket(pbTreeStyle.ExecutedAt)
+	executedAt, err := d.fromRequiredTimeTicket(pbTreeStyle.ExecutedAt)
 	if err != nil {
 		return nil, err
 	}
 
-	from, err := fromTreePos(pbTreeStyle.From)
+	from, err := d.fromTreePos(pbTreeStyle.From)
 	if err != nil {
 		return nil, err
 	}
 
-	to, err := fromTreePos(pbTreeStyle.To)
+	to, err := d.fromTreePos(pbTreeStyle.To)
 	if err != nil {
 		return nil, err
 	}
 
-	return operations.NewTreeStyle(
+	return d.operations.NewTreeStyle(
 		parentCreatedAt,
 		from,
 		to,
@@ -575,12 +873,12 @@ func fromTreeStyle(pbTreeStyle *api.Operation_TreeStyle) (*operations.TreeStyle,
 	), nil
 }
 
-func fromCreatedAtMapByActor(
+func (d *decoder) fromCreatedAtMapByActor(
 	pbCreatedAtMapByActor map[string]*api.TimeTicket,
 ) (map[string]*time.Ticket, error) {
 	createdAtMapByActor := make(map[string]*time.Ticket)
 	for actor, pbTicket := range pbCreatedAtMapByActor {
-		ticket, err := fromTimeTicket(pbTicket)
+		ticket, err := d.fromTimeTicket(pbTicket)
 		if err != nil {
 			return nil, err
 		}
@@ -589,15 +887,15 @@ func fromCreatedAtMapByActor(
 	return createdAtMapByActor, nil
 }
 
-func fromTextNodePos(
+func (d *decoder) fromTextNodePos(
 	pbPos *api.TextNodePos,
 ) (*crdt.RGATreeSplitNodePos, error) {
-	createdAt, err := fromTimeTicket(pbPos.CreatedAt)
+	createdAt, err := d.fromRequiredTimeTicket(pbPos.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
-	return crdt.NewRGATreeSplitNodePos(
-		crdt.NewRGATreeSplitNodeID(createdAt, int(pbPos.Offset)),
+	return d.elements.NewRGATreeSplitNodePos(
+		d.elements.NewRGATreeSplitNodeID(createdAt, int(pbPos.Offset)),
 		int(pbPos.RelativeOffset),
 	), nil
 }
@@ -605,13 +903,19 @@ func fromTextNodePos(
 // FromTreeNodes converts protobuf tree nodes to crdt.TreeNode. The last node
 // in the slice is the root node, because the slice is in post-order.
 func FromTreeNodes(pbNodes []*api.TreeNode) (*crdt.TreeNode, error) {
+	return newDecoder().fromTreeNodes(pbNodes)
+}
+
+// fromTreeNodes converts protobuf tree nodes to crdt.TreeNode. The last node
+// in the slice is the root node, because the slice is in post-order.
+func (d *decoder) fromTreeNodes(pbNodes []*api.TreeNode) (*crdt.TreeNode, error) {
 	if len(pbNodes) == 0 {
 		return nil, nil
 	}
 
 	nodes := make([]*crdt.TreeNode, len(pbNodes))
 	for i, pbNode := range pbNodes {
-		node, err := fromTreeNode(pbNode)
+		node, err := d.fromTreeNode(pbNode)
 		if err != nil {
 			return nil, err
 		}
@@ -640,6 +944,12 @@ func FromTreeNodes(pbNodes []*api.TreeNode) (*crdt.TreeNode, error) {
 // FromTreeNodesWhenEdit converts protobuf tree nodes to array of crdt.TreeNode.
 // in each element in array, the last node in slice is the root node, because the slice is in post-order.
 func FromTreeNodesWhenEdit(pbNodes []*api.TreeNodes) ([]*crdt.TreeNode, error) {
+	return newDecoder().fromTreeNodesWhenEdit(pbNodes)
+}
+
+// fromTreeNodesWhenEdit converts protobuf tree nodes to array of crdt.TreeNode.
+// in each element in array, the last node in slice is the root node, because the slice is in post-order.
+func (d *decoder) fromTreeNodesWhenEdit(pbNodes []*api.TreeNodes) ([]*crdt.TreeNode, error) {
 	if len(pbNodes) == 0 {
 		return nil, nil
 	}
@@ -647,7 +957,7 @@ func FromTreeNodesWhenEdit(pbNodes []*api.TreeNodes) ([]*crdt.TreeNode, error) {
 	var treeNodes []*crdt.TreeNode
 
 	for _, pbNode := range pbNodes {
-		treeNode, err := FromTreeNodes(pbNode.Content)
+		treeNode, err := d.fromTreeNodes(pbNode.Content)
 
 		if err != nil {
 			return nil, err
@@ -659,15 +969,15 @@ func FromTreeNodesWhenEdit(pbNodes []*api.TreeNodes) ([]*crdt.TreeNode, error) {
 	return treeNodes, nil
 }
 
-func fromTreeNode(pbNode *api.TreeNode) (*crdt.TreeNode, error) {
-	id, err := fromTreeNodeID(pbNode.Id)
+func (d *decoder) fromTreeNode(pbNode *api.TreeNode) (*crdt.TreeNode, error) {
+	id, err := d.fromTreeNodeID(pbNode.Id)
 	if err != nil {
 		return nil, err
 	}
 
 	attrs := crdt.NewRHT()
 	for k, pbAttr := range pbNode.Attributes {
-		updatedAt, err := fromTimeTicket(pbAttr.UpdatedAt)
+		updatedAt, err := d.fromRequiredTimeTicket(pbAttr.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -682,20 +992,20 @@ func fromTreeNode(pbNode *api.TreeNode) (*crdt.TreeNode, error) {
 	)
 
 	if pbNode.GetInsPrevId() != nil {
-		node.InsPrevID, err = fromTreeNodeID(pbNode.GetInsPrevId())
+		node.InsPrevID, err = d.fromTreeNodeID(pbNode.GetInsPrevId())
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if pbNode.GetInsNextId() != nil {
-		node.InsNextID, err = fromTreeNodeID(pbNode.GetInsNextId())
+		node.InsNextID, err = d.fromTreeNodeID(pbNode.GetInsNextId())
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	node.RemovedAt, err = fromTimeTicket(pbNode.RemovedAt)
+	node.RemovedAt, err = d.fromTimeTicket(pbNode.RemovedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -703,13 +1013,13 @@ func fromTreeNode(pbNode *api.TreeNode) (*crdt.TreeNode, error) {
 	return node, nil
 }
 
-func fromTreePos(pbPos *api.TreePos) (*crdt.TreePos, error) {
-	parentID, err := fromTreeNodeID(pbPos.ParentId)
+func (d *decoder) fromTreePos(pbPos *api.TreePos) (*crdt.TreePos, error) {
+	parentID, err := d.fromTreeNodeID(pbPos.ParentId)
 	if err != nil {
 		return nil, err
 	}
 
-	leftSiblingID, err := fromTreeNodeID(pbPos.LeftSiblingId)
+	leftSiblingID, err := d.fromTreeNodeID(pbPos.LeftSiblingId)
 	if err != nil {
 		return nil, err
 	}
@@ -717,8 +1027,8 @@ func fromTreePos(pbPos *api.TreePos) (*crdt.TreePos, error) {
 	return crdt.NewTreePos(parentID, leftSiblingID), nil
 }
 
-func fromTreeNodeID(pbPos *api.TreeNodeID) (*crdt.TreeNodeID, error) {
-	createdAt, err := fromTimeTicket(pbPos.CreatedAt)
+func (d *decoder) fromTreeNodeID(pbPos *api.TreeNodeID) (*crdt.TreeNodeID, error) {
+	createdAt, err := d.fromRequiredTimeTicket(pbPos.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -729,35 +1039,50 @@ func fromTreeNodeID(pbPos *api.TreeNodeID) (*crdt.TreeNodeID, error) {
 	), nil
 }
 
-func fromTimeTicket(pbTicket *api.TimeTicket) (*time.Ticket, error) {
+func (d *decoder) fromTimeTicket(pbTicket *api.TimeTicket) (*time.Ticket, error) {
 	if pbTicket == nil {
 		return nil, nil
 	}
 
-	actorID, err := time.ActorIDFromBytes(pbTicket.ActorId)
+	actorID, err := d.times.ActorIDFromBytes(pbTicket.ActorId)
 	if err != nil {
 		return nil, err
 	}
-	return time.NewTicket(
+	return d.times.NewTicket(
 		pbTicket.Lamport,
 		pbTicket.Delimiter,
 		actorID,
 	), nil
 }
 
-func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
+// fromRequiredTimeTicket is like fromTimeTicket, but returns
+// ErrTimeTicketRequired if the given ticket is empty.
+func (d *decoder) fromRequiredTimeTicket(pbTicket *api.TimeTicket) (*time.Ticket, error) {
+	if pbTicket == nil {
+		return nil, ErrTimeTicketRequired
+	}
+	return d.fromTimeTicket(pbTicket)
+}
+
+func (d *decoder) fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 	switch pbType := pbElement.Type; pbType {
 	case api.ValueType_VALUE_TYPE_JSON_OBJECT:
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		createdAt, err := d.fromRequiredTimeTicket(pbElement.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		policy, err := fromConflictPolicy(pbElement.Policy)
 		if err != nil {
 			return nil, err
 		}
-		return crdt.NewObject(
+		obj := crdt.NewObject(
 			crdt.NewElementRHT(),
 			createdAt,
-		), nil
+		)
+		obj.SetPolicy(policy)
+		return obj, nil
 	case api.ValueType_VALUE_TYPE_JSON_ARRAY:
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		createdAt, err := d.fromRequiredTimeTicket(pbElement.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -784,7 +1109,7 @@ func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 		if err != nil {
 			return nil, err
 		}
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		createdAt, err := d.fromRequiredTimeTicket(pbElement.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -793,7 +1118,7 @@ func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 			createdAt,
 		), nil
 	case api.ValueType_VALUE_TYPE_TEXT:
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		createdAt, err := d.fromRequiredTimeTicket(pbElement.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -804,11 +1129,13 @@ func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 	case api.ValueType_VALUE_TYPE_INTEGER_CNT:
 		fallthrough
 	case api.ValueType_VALUE_TYPE_LONG_CNT:
+		fallthrough
+	case api.ValueType_VALUE_TYPE_DOUBLE_CNT:
 		counterType, err := fromCounterType(pbType)
 		if err != nil {
 			return nil, err
 		}
-		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		createdAt, err := d.fromRequiredTimeTicket(pbElement.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -827,7 +1154,13 @@ func fromElement(pbElement *api.JSONElementSimple) (crdt.Element, error) {
 		}
 		return counter, nil
 	case api.ValueType_VALUE_TYPE_TREE:
-		return BytesToTree(pbElement.Value)
+		return d.bytesToTree(pbElement.Value)
+	case api.ValueType_VALUE_TYPE_SET:
+		createdAt, err := d.fromRequiredTimeTicket(pbElement.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		return crdt.NewSet(createdAt), nil
 	}
 
 	return nil, fmt.Errorf("%d, %w", pbElement.Type, ErrUnsupportedElement)
@@ -862,11 +1195,24 @@ func fromCounterType(valueType api.ValueType) (crdt.CounterType, error) {
 		return crdt.IntegerCnt, nil
 	case api.ValueType_VALUE_TYPE_LONG_CNT:
 		return crdt.LongCnt, nil
+	case api.ValueType_VALUE_TYPE_DOUBLE_CNT:
+		return crdt.DoubleCnt, nil
 	}
 
 	return 0, fmt.Errorf("%d, %w", valueType, ErrUnsupportedCounterType)
 }
 
+func fromConflictPolicy(policy api.ConflictPolicy) (crdt.ConflictPolicy, error) {
+	switch policy {
+	case api.ConflictPolicy_CONFLICT_POLICY_LWW:
+		return crdt.LWW, nil
+	case api.ConflictPolicy_CONFLICT_POLICY_MERGE:
+		return crdt.Merge, nil
+	}
+
+	return 0, fmt.Errorf("%d, %w", policy, ErrUnsupportedConflictPolicy)
+}
+
 // FromUpdatableProjectFields converts the given Protobuf formats to model format.
 func FromUpdatableProjectFields(pbProjectFields *api.UpdatableProjectFields) (*types.UpdatableProjectFields, error) {
 	updatableProjectFields := &types.UpdatableProjectFields{}
@@ -879,6 +1225,39 @@ func FromUpdatableProjectFields(pbProjectFields *api.UpdatableProjectFields) (*t
 	if pbProjectFields.AuthWebhookMethods != nil {
 		updatableProjectFields.AuthWebhookMethods = &pbProjectFields.AuthWebhookMethods.Methods
 	}
+	if pbProjectFields.AuthJwtKey != nil {
+		updatableProjectFields.AuthJWTKey = &pbProjectFields.AuthJwtKey.Value
+	}
+	if pbProjectFields.AuthJwksUrl != nil {
+		updatableProjectFields.AuthJWKSURL = &pbProjectFields.AuthJwksUrl.Value
+	}
+	if pbProjectFields.SensitivePresenceKeys != nil {
+		updatableProjectFields.SensitivePresenceKeys = &pbProjectFields.SensitivePresenceKeys.Keys
+	}
+	if pbProjectFields.EventWebhookUrl != nil {
+		updatableProjectFields.EventWebhookURL = &pbProjectFields.EventWebhookUrl.Value
+	}
+	if pbProjectFields.EventWebhookEvents != nil {
+		updatableProjectFields.EventWebhookEvents = &pbProjectFields.EventWebhookEvents.Events
+	}
+	if pbProjectFields.DocumentSizeSoftLimit != nil {
+		updatableProjectFields.DocumentSizeSoftLimit = &pbProjectFields.DocumentSizeSoftLimit.Value
+	}
+	if pbProjectFields.DocumentSizeHardLimit != nil {
+		updatableProjectFields.DocumentSizeHardLimit = &pbProjectFields.DocumentSizeHardLimit.Value
+	}
+	if pbProjectFields.ChangeLogSoftLimit != nil {
+		updatableProjectFields.ChangeLogSoftLimit = &pbProjectFields.ChangeLogSoftLimit.Value
+	}
+	if pbProjectFields.ChangeLogHardLimit != nil {
+		updatableProjectFields.ChangeLogHardLimit = &pbProjectFields.ChangeLogHardLimit.Value
+	}
+	if pbProjectFields.SnapshotThreshold != nil {
+		updatableProjectFields.SnapshotThreshold = &pbProjectFields.SnapshotThreshold.Value
+	}
+	if pbProjectFields.SnapshotInterval != nil {
+		updatableProjectFields.SnapshotInterval = &pbProjectFields.SnapshotInterval.Value
+	}
 	if pbProjectFields.ClientDeactivateThreshold != nil {
 		updatableProjectFields.ClientDeactivateThreshold = &pbProjectFields.ClientDeactivateThreshold.Value
 	}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+// seedDocument returns a document that has all types of elements to build
+// the seed corpus of fuzz tests and the golden files.
+func seedDocument(t testing.TB, opts ...document.Option) *document.Document {
+	doc := document.New("d1", opts...)
+	assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+		root.SetNewObject("k1").SetString("k1.1", "a").SetInteger("k1.2", 1)
+		root.SetNewArray("k2").AddString("a", "b").Delete(0)
+		root.SetNewText("k3").Edit(0, 0, "hello").Style(0, 2, map[string]string{"b": "1"})
+		root.SetNewCounter("k4", crdt.IntegerCnt, 0).Increase(1)
+		root.SetNewTree("k5", &json.TreeNode{
+			Type:     "r",
+			Children: []json.TreeNode{{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "ab"}}}},
+		}).Edit(1, 1, &json.TreeNode{Type: "text", Value: "c"})
+		p.Set("name", "a")
+		return nil
+	}))
+	return doc
+}
+
+func FuzzBytesToChangePack(f *testing.F) {
+	bytes, err := converter.ChangePackToBytes(seedDocument(f).CreateChangePack())
+	assert.NoError(f, err)
+	f.Add(bytes)
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, bytes []byte) {
+		pack, err := converter.BytesToChangePack(bytes)
+		if err != nil {
+			return
+		}
+
+		// NOTE: A decoded pack should be encoded again.
+		_, err = converter.ChangePackToBytes(pack)
+		assert.NoError(t, err)
+	})
+}
+
+func FuzzBytesToSnapshot(f *testing.F) {
+	doc := seedDocument(f)
+	bytes, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+	assert.NoError(f, err)
+	f.Add(bytes)
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, bytes []byte) {
+		obj, _, err := converter.BytesToSnapshot(bytes)
+		if err != nil {
+			return
+		}
+
+		_, err = converter.ObjectToBytes(obj)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// assertGolden asserts that the given bytes are the same as the golden file of
+// the given name. With -update, the golden file is written instead.
+func assertGolden(t *testing.T, name string, bytes []byte) {
+	path := filepath.Join("testdata", "golden", name+".golden")
+	if *update {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, bytes, 0644))
+	}
+
+	golden, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, golden, bytes, "run with -update if the format is changed on purpose")
+}
+
+func TestGolden(t *testing.T) {
+	actorID, err := time.ActorIDFromHex("000000000000000000000001")
+	assert.NoError(t, err)
+	doc := seedDocument(
+		t,
+		document.WithActorID(actorID),
+		document.WithLamportSource(change.LamportSourceFunc(func(lamport int64) int64 {
+			return lamport + 100
+		})),
+	)
+
+	t.Run("change pack test", func(t *testing.T) {
+		bytes, err := converter.ChangePackToBytes(doc.CreateChangePack())
+		assert.NoError(t, err)
+		assertGolden(t, "change_pack", bytes)
+	})
+
+	t.Run("snapshot test", func(t *testing.T) {
+		bytes, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+		assert.NoError(t, err)
+		assertGolden(t, "snapshot", bytes)
+	})
+}
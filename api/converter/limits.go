@@ -0,0 +1,203 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"fmt"
+
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+)
+
+// Limits is the limits of the changes that are decoded from Protobuf. A limit
+// of zero means that it is not limited.
+type Limits struct {
+	// MaxOperationsPerChange is the maximum number of operations in a change.
+	MaxOperationsPerChange int
+
+	// MaxDepth is the maximum nesting depth of the containers created within
+	// a change and of the tree nodes inserted by an operation.
+	MaxDepth int
+
+	// MaxStringLength is the maximum length in bytes of a string in an
+	// operation, such as a key, a string value or the content of an edit.
+	MaxStringLength int
+
+	// MaxDecompressedBytes is the maximum size in bytes of the changes and of
+	// the snapshot of a compressed change pack after decompression.
+	MaxDecompressedBytes uint64
+}
+
+// Check checks that the changes of the given pack are within these limits.
+func (l Limits) Check(pbPack *api.ChangePack) error {
+	for _, pbChange := range pbPack.Changes {
+		if err := l.checkChange(pbChange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckPresence checks that the keys and values of the given presence are
+// within these limits.
+func (l Limits) CheckPresence(presence map[string]string) error {
+	return l.checkAttributes(presence)
+}
+
+func (l Limits) checkChange(pbChange *api.Change) error {
+	if l.MaxOperationsPerChange > 0 && len(pbChange.Operations) > l.MaxOperationsPerChange {
+		return fmt.Errorf(
+			"%d operations, max %d: %w",
+			len(pbChange.Operations),
+			l.MaxOperationsPerChange,
+			ErrTooManyOperations,
+		)
+	}
+
+	// NOTE: The depth of a container is counted from the nearest
+	// container that is not created within this change, because the depth of
+	// the containers in the document is not known while decoding.
+	depths := make(map[string]int)
+	for _, pbOp := range pbChange.Operations {
+		switch decoded := pbOp.Body.(type) {
+		case *api.Operation_Set_:
+			if err := l.checkString(decoded.Set.Key); err != nil {
+				return err
+			}
+			if err := l.checkElement(depths, decoded.Set.ParentCreatedAt, decoded.Set.Value); err != nil {
+				return err
+			}
+		case *api.Operation_Add_:
+			if err := l.checkElement(depths, decoded.Add.ParentCreatedAt, decoded.Add.Value); err != nil {
+				return err
+			}
+		case *api.Operation_Edit_:
+			if err := l.checkString(decoded.Edit.Content); err != nil {
+				return err
+			}
+			if err := l.checkAttributes(decoded.Edit.Attributes); err != nil {
+				return err
+			}
+		case *api.Operation_EditReverse_:
+			if err := l.checkString(decoded.EditReverse.Content); err != nil {
+				return err
+			}
+			if err := l.checkAttributes(decoded.EditReverse.Attributes); err != nil {
+				return err
+			}
+		case *api.Operation_Style_:
+			if err := l.checkAttributes(decoded.Style.Attributes); err != nil {
+				return err
+			}
+		case *api.Operation_AddAnnotation_:
+			if err := l.checkString(decoded.AddAnnotation.Name); err != nil {
+				return err
+			}
+			if err := l.checkString(decoded.AddAnnotation.Value); err != nil {
+				return err
+			}
+		case *api.Operation_SetAdd_:
+			if err := l.checkElement(depths, decoded.SetAdd.ParentCreatedAt, decoded.SetAdd.Value); err != nil {
+				return err
+			}
+		case *api.Operation_TreeEdit_:
+			for _, pbNodes := range decoded.TreeEdit.Contents {
+				if err := l.checkTreeNodes(pbNodes.Content); err != nil {
+					return err
+				}
+			}
+		case *api.Operation_TreeStyle_:
+			if err := l.checkAttributes(decoded.TreeStyle.Attributes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (l Limits) checkElement(
+	depths map[string]int,
+	pbParentCreatedAt *api.TimeTicket,
+	pbElement *api.JSONElementSimple,
+) error {
+	if pbElement == nil {
+		return nil
+	}
+
+	switch pbElement.Type {
+	case api.ValueType_VALUE_TYPE_JSON_OBJECT, api.ValueType_VALUE_TYPE_JSON_ARRAY:
+		depth := depths[ticketKey(pbParentCreatedAt)] + 1
+		if l.MaxDepth > 0 && depth > l.MaxDepth {
+			return fmt.Errorf("depth %d, max %d: %w", depth, l.MaxDepth, ErrTooDeep)
+		}
+		depths[ticketKey(pbElement.CreatedAt)] = depth
+	case api.ValueType_VALUE_TYPE_STRING:
+		if l.MaxStringLength > 0 && len(pbElement.Value) > l.MaxStringLength {
+			return fmt.Errorf("length %d, max %d: %w", len(pbElement.Value), l.MaxStringLength, ErrStringTooLong)
+		}
+	}
+
+	return nil
+}
+
+func (l Limits) checkTreeNodes(pbNodes []*api.TreeNode) error {
+	for _, pbNode := range pbNodes {
+		if l.MaxDepth > 0 && int(pbNode.Depth) >= l.MaxDepth {
+			return fmt.Errorf("depth %d, max %d: %w", pbNode.Depth+1, l.MaxDepth, ErrTooDeep)
+		}
+		if err := l.checkString(pbNode.Value); err != nil {
+			return err
+		}
+		for _, attr := range pbNode.Attributes {
+			if err := l.checkString(attr.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (l Limits) checkAttributes(attrs map[string]string) error {
+	for k, v := range attrs {
+		if err := l.checkString(k); err != nil {
+			return err
+		}
+		if err := l.checkString(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l Limits) checkString(s string) error {
+	if l.MaxStringLength > 0 && len(s) > l.MaxStringLength {
+		return fmt.Errorf("length %d, max %d: %w", len(s), l.MaxStringLength, ErrStringTooLong)
+	}
+
+	return nil
+}
+
+// ticketKey returns the key of the given ticket in the depths of containers.
+func ticketKey(pbTicket *api.TimeTicket) string {
+	if pbTicket == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d:%d:%x", pbTicket.Lamport, pbTicket.Delimiter, pbTicket.ActorId)
+}
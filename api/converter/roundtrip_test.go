@@ -0,0 +1,245 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var randomKeys = []string{"a", "b", "c", "d"}
+
+// containers is the containers of a document that random updates are applied
+// to, collected in a deterministic order.
+type containers struct {
+	objects  []*json.Object
+	arrays   []*json.Array
+	texts    []*json.Text
+	counters []*json.Counter
+	trees    []*json.Tree
+}
+
+// collectContainers collects the containers of the given object recursively.
+func collectContainers(obj *json.Object, c *containers) {
+	c.objects = append(c.objects, obj)
+
+	var keys []string
+	for k := range obj.Members() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		switch obj.Get(k).(type) {
+		case *crdt.Object:
+			collectContainers(obj.GetObject(k), c)
+		case *crdt.Array:
+			c.arrays = append(c.arrays, obj.GetArray(k))
+		case *crdt.Text:
+			c.texts = append(c.texts, obj.GetText(k))
+		case *crdt.Counter:
+			c.counters = append(c.counters, obj.GetCounter(k))
+		case *crdt.Tree:
+			c.trees = append(c.trees, obj.GetTree(k))
+		}
+	}
+}
+
+// randomSet sets a random element of any type to a random key of the object.
+func randomSet(r *rand.Rand, obj *json.Object) {
+	k := randomKeys[r.Intn(len(randomKeys))]
+	switch r.Intn(13) {
+	case 0:
+		obj.SetNewObject(k).SetString(k, "nested")
+	case 1:
+		obj.SetNewArray(k).AddInteger(r.Intn(100)).AddNewArray().AddString("nested")
+	case 2:
+		obj.SetNewText(k).Edit(0, 0, "text")
+	case 3:
+		obj.SetNewCounter(k, crdt.IntegerCnt, r.Intn(100))
+	case 4:
+		obj.SetNewCounter(k, crdt.LongCnt, r.Int63n(100))
+	case 5:
+		obj.SetNewTree(k, &json.TreeNode{
+			Type:     "r",
+			Children: []json.TreeNode{{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "ab"}}}},
+		})
+	case 6:
+		obj.SetNull(k)
+	case 7:
+		obj.SetBool(k, r.Intn(2) == 0)
+	case 8:
+		obj.SetInteger(k, r.Intn(100))
+	case 9:
+		obj.SetLong(k, r.Int63())
+	case 10:
+		obj.SetDouble(k, r.Float64())
+	case 11:
+		obj.SetBytes(k, []byte{byte(r.Intn(256))})
+	case 12:
+		obj.SetDate(k, gotime.Unix(r.Int63n(1<<32), 0))
+	}
+}
+
+// randomUpdate applies a random operation to a random container of the root.
+func randomUpdate(r *rand.Rand, root *json.Object) {
+	c := &containers{}
+	collectContainers(root, c)
+
+	switch r.Intn(8) {
+	case 0, 1:
+		randomSet(r, c.objects[r.Intn(len(c.objects))])
+	case 2:
+		obj := c.objects[r.Intn(len(c.objects))]
+		obj.Delete(randomKeys[r.Intn(len(randomKeys))])
+	case 3:
+		if len(c.arrays) == 0 {
+			return
+		}
+		arr := c.arrays[r.Intn(len(c.arrays))]
+		if arr.Len() > 0 && r.Intn(2) == 0 {
+			arr.Delete(r.Intn(arr.Len()))
+		} else if arr.Len() > 0 {
+			arr.InsertIntegerAfter(r.Intn(arr.Len()), r.Intn(100))
+		} else {
+			arr.AddString("added")
+		}
+	case 4:
+		if len(c.texts) == 0 {
+			return
+		}
+		text := c.texts[r.Intn(len(c.texts))]
+		from, to := randomRange(r, len(text.String()))
+		text.Edit(from, to, string(rune('a'+r.Intn(26))))
+	case 5:
+		if len(c.texts) == 0 {
+			return
+		}
+		text := c.texts[r.Intn(len(c.texts))]
+		from, to := randomRange(r, len(text.String()))
+		text.Style(from, to, map[string]string{"b": fmt.Sprintf("%d", r.Intn(2))})
+	case 6:
+		if len(c.counters) == 0 {
+			return
+		}
+		c.counters[r.Intn(len(c.counters))].Increase(r.Intn(10))
+	case 7:
+		if len(c.trees) == 0 {
+			return
+		}
+		// NOTE: Trees have a paragraph, so the content of the
+		// paragraph is in [1, Len()-1].
+		tree := c.trees[r.Intn(len(c.trees))]
+		from, to := randomRange(r, tree.Len()-2)
+		if r.Intn(2) == 0 {
+			tree.Edit(from+1, to+1)
+		} else {
+			tree.Edit(from+1, from+1, &json.TreeNode{Type: "text", Value: "c"})
+		}
+	}
+}
+
+// randomRange returns a random range in [0, length].
+func randomRange(r *rand.Rand, length int) (int, int) {
+	from := r.Intn(length + 1)
+	return from, from + r.Intn(length-from+1)
+}
+
+// randomDocument returns a document built by the given number of random
+// updates. The updates delete elements, so the document has tombstones.
+func randomDocument(t *testing.T, r *rand.Rand, updates int) *document.Document {
+	doc := document.New("d1")
+	for i := 0; i < updates; i++ {
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			for j := 0; j < 1+r.Intn(4); j++ {
+				randomUpdate(r, root)
+			}
+			if r.Intn(4) == 0 {
+				p.Set("cursor", fmt.Sprintf("%d", r.Intn(100)))
+			}
+			return nil
+		}))
+	}
+	return doc
+}
+
+func TestRoundTrip(t *testing.T) {
+	for seed := int64(0); seed < 100; seed++ {
+		seed := seed
+		t.Run(fmt.Sprintf("random document %d test", seed), func(t *testing.T) {
+			doc := randomDocument(t, rand.New(rand.NewSource(seed)), 30)
+
+			// 01. Change packs should be the same after the round-trip.
+			packBytes, err := converter.ChangePackToBytes(doc.CreateChangePack())
+			assert.NoError(t, err)
+			pack, err := converter.BytesToChangePack(packBytes)
+			assert.NoError(t, err)
+			decodedPackBytes, err := converter.ChangePackToBytes(pack)
+			assert.NoError(t, err)
+			assert.Equal(t, packBytes, decodedPackBytes)
+
+			// 02. Snapshots should be the same after the round-trip.
+			snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+			assert.NoError(t, err)
+			obj, presences, err := converter.BytesToSnapshot(snapshot)
+			assert.NoError(t, err)
+			decodedPresences := make(map[string]innerpresence.Presence)
+			presences.Range(func(clientID string, presence innerpresence.Presence) bool {
+				decodedPresences[clientID] = presence
+				return true
+			})
+			assert.Equal(t, doc.AllPresences(), decodedPresences)
+			decodedSnapshot, err := converter.SnapshotToBytes(obj, decodedPresences)
+			assert.NoError(t, err)
+			assert.Equal(t, snapshot, decodedSnapshot)
+			assert.Equal(t, doc.Marshal(), obj.Marshal())
+
+			// 03. The document rebuilt from the changes should be the same as the
+			// document including its tombstones.
+			rebuilt := document.New("d1")
+			assert.NoError(t, rebuilt.ApplyChangePack(pack))
+			assert.Equal(t, doc.Marshal(), rebuilt.Marshal())
+			rootBytes, err := converter.ObjectToBytes(doc.RootObject())
+			assert.NoError(t, err)
+			rebuiltRootBytes, err := converter.ObjectToBytes(rebuilt.RootObject())
+			assert.NoError(t, err)
+			assert.Equal(t, rootBytes, rebuiltRootBytes)
+
+			// 04. The documents should be the same after collecting garbage.
+			doc.GarbageCollect(time.MaxTicket)
+			rebuilt.GarbageCollect(time.MaxTicket)
+			rootBytes, err = converter.ObjectToBytes(doc.RootObject())
+			assert.NoError(t, err)
+			rebuiltRootBytes, err = converter.ObjectToBytes(rebuilt.RootObject())
+			assert.NoError(t, err)
+			assert.Equal(t, rootBytes, rebuiltRootBytes)
+		})
+	}
+}
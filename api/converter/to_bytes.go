@@ -17,49 +17,172 @@
 package converter
 
 import (
+	"encoding/binary"
 	"fmt"
 	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
 
 	"github.com/gogo/protobuf/proto"
 
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/index"
 )
 
+// parallelEncodingThreshold is the minimum number of members of an object to
+// encode the members in parallel.
+const parallelEncodingThreshold = 2
+
+// The numbers of the fields in resources.proto that are assembled by hand.
+const (
+	wireTypeBytes = 2
+
+	snapshotRootField      = 1
+	jsonElementObjectField = 1
+	jsonObjectNodesField   = 1
+)
+
 // SnapshotToBytes converts the given document to byte array.
 func SnapshotToBytes(obj *crdt.Object, presences map[string]innerpresence.Presence) ([]byte, error) {
-	pbElem, err := toJSONElement(obj)
+	root, err := ObjectToBytes(obj)
 	if err != nil {
 		return nil, err
 	}
 
-	pbPresences := ToPresences(presences)
-
-	bytes, err := proto.Marshal(&api.Snapshot{
-		Root:      pbElem,
-		Presences: pbPresences,
+	// NOTE: The root is encoded in advance, so we append the
+	// presences to it in the order of the fields to get the same bytes as
+	// marshaling the whole snapshot at once.
+	presencesBytes, err := proto.Marshal(&api.Snapshot{
+		Presences: ToPresences(presences),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("marshal Snapshot to bytes: %w", err)
 	}
 
+	bytes := appendBytesField(make([]byte, 0, len(root)+len(presencesBytes)+16), snapshotRootField, root)
+	return append(bytes, presencesBytes...), nil
+}
+
+// ChangePackToBytes converts the given change pack to byte array.
+func ChangePackToBytes(pack *change.Pack) ([]byte, error) {
+	pbPack, err := ToChangePack(pack)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := proto.Marshal(pbPack)
+	if err != nil {
+		return nil, fmt.Errorf("marshal ChangePack to bytes: %w", err)
+	}
 	return bytes, nil
 }
 
-// ObjectToBytes converts the given object to byte array.
+// ObjectToBytes converts the given object to byte array. The members of the
+// object are encoded in parallel since they are independent of each other,
+// and then assembled in order, so the result is the same as encoding the
+// object at once.
 func ObjectToBytes(obj *crdt.Object) ([]byte, error) {
-	pbElem, err := toJSONElement(obj)
+	rhtNodes := obj.RHTNodes()
+	if len(rhtNodes) < parallelEncodingThreshold {
+		pbElem, err := toJSONElement(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		bytes, err := proto.Marshal(pbElem)
+		if err != nil {
+			return nil, fmt.Errorf("marshal JSON element to bytes: %w", err)
+		}
+		return bytes, nil
+	}
+
+	members, err := encodeRHTNodes(rhtNodes)
 	if err != nil {
 		return nil, err
 	}
 
-	bytes, err := proto.Marshal(pbElem)
+	tail, err := proto.Marshal(&api.JSONElement_JSONObject{
+		CreatedAt: ToTimeTicket(obj.CreatedAt()),
+		MovedAt:   ToTimeTicket(obj.MovedAt()),
+		RemovedAt: ToTimeTicket(obj.RemovedAt()),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("marshal JSON element to bytes: %w", err)
 	}
-	return bytes, nil
+
+	size := len(tail)
+	for _, member := range members {
+		size += len(member) + binary.MaxVarintLen64 + 1
+	}
+	body := make([]byte, 0, size)
+	for _, member := range members {
+		body = appendBytesField(body, jsonObjectNodesField, member)
+	}
+	body = append(body, tail...)
+
+	return appendBytesField(make([]byte, 0, len(body)+binary.MaxVarintLen64+1), jsonElementObjectField, body), nil
+}
+
+// encodeRHTNodes encodes the given nodes to bytes of RHTNode with workers as
+// many as GOMAXPROCS. The result is in the order of the given nodes.
+func encodeRHTNodes(rhtNodes []*crdt.ElementRHTNode) ([][]byte, error) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(rhtNodes) {
+		workers = len(rhtNodes)
+	}
+
+	results := make([][]byte, len(rhtNodes))
+	errs := make([]error, workers)
+	var next int64 = -1
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(rhtNodes) {
+					return
+				}
+
+				pbElem, err := toJSONElement(rhtNodes[i].Element())
+				if err != nil {
+					errs[w] = err
+					return
+				}
+				bytes, err := proto.Marshal(&api.RHTNode{
+					Key:     rhtNodes[i].Key(),
+					Element: pbElem,
+				})
+				if err != nil {
+					errs[w] = fmt.Errorf("marshal RHTNode to bytes: %w", err)
+					return
+				}
+				results[i] = bytes
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// appendBytesField appends the given bytes as a length-delimited field of the
+// given number in the protobuf wire format.
+func appendBytesField(dst []byte, fieldNum uint64, bytes []byte) []byte {
+	dst = binary.AppendUvarint(dst, fieldNum<<3|wireTypeBytes)
+	dst = binary.AppendUvarint(dst, uint64(len(bytes)))
+	return append(dst, bytes...)
 }
 
 // TreeToBytes converts the given tree to byte array.
@@ -86,6 +209,8 @@ func toJSONElement(elem crdt.Element) (*api.JSONElement, error) {
 		return toCounter(elem)
 	case *crdt.Tree:
 		return toTree(elem), nil
+	case *crdt.Set:
+		return toJSONSet(elem)
 	default:
 		return nil, fmt.Errorf("%v: %w", reflect.TypeOf(elem), ErrUnsupportedElement)
 	}
@@ -96,6 +221,10 @@ func toJSONObject(obj *crdt.Object) (*api.JSONElement, error) {
 	if err != nil {
 		return nil, err
 	}
+	pbPolicy, err := toConflictPolicy(obj.Policy())
+	if err != nil {
+		return nil, err
+	}
 
 	pbElem := &api.JSONElement{
 		Body: &api.JSONElement_JsonObject{JsonObject: &api.JSONElement_JSONObject{
@@ -103,11 +232,25 @@ func toJSONObject(obj *crdt.Object) (*api.JSONElement, error) {
 			CreatedAt: ToTimeTicket(obj.CreatedAt()),
 			MovedAt:   ToTimeTicket(obj.MovedAt()),
 			RemovedAt: ToTimeTicket(obj.RemovedAt()),
+			Policy:    pbPolicy,
+			Merged:    toTimeTickets(obj.Merged()),
 		}},
 	}
 	return pbElem, nil
 }
 
+func toTimeTickets(tickets []*time.Ticket) []*api.TimeTicket {
+	if len(tickets) == 0 {
+		return nil
+	}
+
+	pbTickets := make([]*api.TimeTicket, 0, len(tickets))
+	for _, ticket := range tickets {
+		pbTickets = append(pbTickets, ToTimeTicket(ticket))
+	}
+	return pbTickets
+}
+
 func toJSONArray(arr *crdt.Array) (*api.JSONElement, error) {
 	pbRGANodes, err := toRGANodes(arr.RGANodes())
 	if err != nil {
@@ -145,10 +288,11 @@ func toPrimitive(primitive *crdt.Primitive) (*api.JSONElement, error) {
 func toText(text *crdt.Text) *api.JSONElement {
 	return &api.JSONElement{
 		Body: &api.JSONElement_Text_{Text: &api.JSONElement_Text{
-			Nodes:     toTextNodes(text.Nodes()),
-			CreatedAt: ToTimeTicket(text.CreatedAt()),
-			MovedAt:   ToTimeTicket(text.MovedAt()),
-			RemovedAt: ToTimeTicket(text.RemovedAt()),
+			Nodes:       toTextNodes(text.Nodes()),
+			CreatedAt:   ToTimeTicket(text.CreatedAt()),
+			MovedAt:     ToTimeTicket(text.MovedAt()),
+			RemovedAt:   ToTimeTicket(text.RemovedAt()),
+			Annotations: toTextAnnotations(text.AllAnnotations()),
 		}},
 	}
 }
@@ -174,6 +318,36 @@ func toCounter(counter *crdt.Counter) (*api.JSONElement, error) {
 	}, nil
 }
 
+func toJSONSet(set *crdt.Set) (*api.JSONElement, error) {
+	var pbMembers []*api.SetMember
+	for _, member := range set.Members() {
+		pbValueType, err := toValueType(member.Value().ValueType())
+		if err != nil {
+			return nil, err
+		}
+
+		var pbTags []*api.TimeTicket
+		for _, tag := range member.Tags() {
+			pbTags = append(pbTags, ToTimeTicket(tag))
+		}
+
+		pbMembers = append(pbMembers, &api.SetMember{
+			Type:  pbValueType,
+			Value: member.Value().Bytes(),
+			Tags:  pbTags,
+		})
+	}
+
+	return &api.JSONElement{
+		Body: &api.JSONElement_Set_{Set: &api.JSONElement_Set{
+			Members:   pbMembers,
+			CreatedAt: ToTimeTicket(set.CreatedAt()),
+			MovedAt:   ToTimeTicket(set.MovedAt()),
+			RemovedAt: ToTimeTicket(set.RemovedAt()),
+		}},
+	}, nil
+}
+
 func toTree(tree *crdt.Tree) *api.JSONElement {
 	return &api.JSONElement{
 		Body: &api.JSONElement_Tree_{Tree: &api.JSONElement_Tree{
@@ -204,18 +378,46 @@ func toRHTNodes(rhtNodes []*crdt.ElementRHTNode) ([]*api.RHTNode, error) {
 func toRGANodes(rgaNodes []*crdt.RGATreeListNode) ([]*api.RGANode, error) {
 	var pbRGANodes []*api.RGANode
 	for _, rgaNode := range rgaNodes {
+		if rgaNode.Element() == nil {
+			pbRGANodes = append(pbRGANodes, &api.RGANode{
+				PositionedAt: ToTimeTicket(rgaNode.PositionedAt()),
+				VacatedAt:    ToTimeTicket(rgaNode.VacatedAt()),
+			})
+			continue
+		}
+
 		pbElem, err := toJSONElement(rgaNode.Element())
 		if err != nil {
 			return nil, err
 		}
 
-		pbRGANodes = append(pbRGANodes, &api.RGANode{
-			Element: pbElem,
-		})
+		// NOTE: The position is encoded only for moved elements, since the
+		// position of an element that has never been moved is its creation
+		// time.
+		pbRGANode := &api.RGANode{Element: pbElem}
+		if rgaNode.PositionedAt().Compare(rgaNode.CreatedAt()) != 0 {
+			pbRGANode.PositionedAt = ToTimeTicket(rgaNode.PositionedAt())
+		}
+		pbRGANodes = append(pbRGANodes, pbRGANode)
 	}
 	return pbRGANodes, nil
 }
 
+func toTextAnnotations(annotations []*crdt.TextAnnotation) []*api.TextAnnotation {
+	var pbAnnotations []*api.TextAnnotation
+	for _, annotation := range annotations {
+		pbAnnotations = append(pbAnnotations, &api.TextAnnotation{
+			Name:      annotation.Name(),
+			From:      toTextNodePos(annotation.From()),
+			To:        toTextNodePos(annotation.To()),
+			Value:     annotation.Value(),
+			UpdatedAt: ToTimeTicket(annotation.UpdatedAt()),
+			IsRemoved: annotation.IsRemoved(),
+		})
+	}
+	return pbAnnotations
+}
+
 func toTextNodes(textNodes []*crdt.RGATreeSplitNode[*crdt.TextValue]) []*api.TextNode {
 	var pbTextNodes []*api.TextNode
 	for _, textNode := range textNodes {
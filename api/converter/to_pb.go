@@ -19,6 +19,7 @@ package converter
 import (
 	"fmt"
 	"reflect"
+	gotime "time"
 
 	protoTypes "github.com/gogo/protobuf/types"
 
@@ -75,6 +76,17 @@ func ToProject(project *types.Project) (*api.Project, error) {
 		Name:                      project.Name,
 		AuthWebhookUrl:            project.AuthWebhookURL,
 		AuthWebhookMethods:        project.AuthWebhookMethods,
+		AuthJwtKey:                project.AuthJWTKey,
+		AuthJwksUrl:               project.AuthJWKSURL,
+		SensitivePresenceKeys:     project.SensitivePresenceKeys,
+		EventWebhookUrl:           project.EventWebhookURL,
+		EventWebhookEvents:        project.EventWebhookEvents,
+		DocumentSizeSoftLimit:     project.DocumentSizeSoftLimit,
+		DocumentSizeHardLimit:     project.DocumentSizeHardLimit,
+		ChangeLogSoftLimit:        project.ChangeLogSoftLimit,
+		ChangeLogHardLimit:        project.ChangeLogHardLimit,
+		SnapshotThreshold:         project.SnapshotThreshold,
+		SnapshotInterval:          project.SnapshotInterval,
 		ClientDeactivateThreshold: project.ClientDeactivateThreshold,
 		PublicKey:                 project.PublicKey,
 		SecretKey:                 project.SecretKey,
@@ -118,9 +130,112 @@ func ToDocumentSummary(summary *types.DocumentSummary) (*api.DocumentSummary, er
 		AccessedAt: pbAccessedAt,
 		UpdatedAt:  pbUpdatedAt,
 		Snapshot:   summary.Snapshot,
+		Labels:     summary.Labels,
 	}, nil
 }
 
+// ToClientSummaries converts the given model to Protobuf.
+func ToClientSummaries(summaries []*types.ClientSummary) ([]*api.ClientSummary, error) {
+	var pbSummaries []*api.ClientSummary
+	for _, summary := range summaries {
+		pbSummary, err := ToClientSummary(summary)
+		if err != nil {
+			return nil, err
+		}
+		pbSummaries = append(pbSummaries, pbSummary)
+	}
+	return pbSummaries, nil
+}
+
+// ToClientSummary converts the given model to Protobuf format.
+func ToClientSummary(summary *types.ClientSummary) (*api.ClientSummary, error) {
+	pbCreatedAt, err := protoTypes.TimestampProto(summary.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("convert createdAt to protobuf: %w", err)
+	}
+	pbUpdatedAt, err := protoTypes.TimestampProto(summary.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("convert updatedAt to protobuf: %w", err)
+	}
+
+	return &api.ClientSummary{
+		Id:     summary.ID.String(),
+		Key:    summary.Key,
+		Status: summary.Status,
+		Connection: &api.ConnectionInfo{
+			Ip:        summary.Connection.IP,
+			UserAgent: summary.Connection.UserAgent,
+			Source:    summary.Connection.Source,
+		},
+		CreatedAt: pbCreatedAt,
+		UpdatedAt: pbUpdatedAt,
+	}, nil
+}
+
+// ToDocumentTemplates converts the given model to Protobuf.
+func ToDocumentTemplates(templates []*types.DocumentTemplate) ([]*api.DocumentTemplate, error) {
+	var pbTemplates []*api.DocumentTemplate
+	for _, template := range templates {
+		pbTemplate, err := ToDocumentTemplate(template)
+		if err != nil {
+			return nil, err
+		}
+		pbTemplates = append(pbTemplates, pbTemplate)
+	}
+	return pbTemplates, nil
+}
+
+// ToDocumentTemplate converts the given model to Protobuf format.
+func ToDocumentTemplate(template *types.DocumentTemplate) (*api.DocumentTemplate, error) {
+	pbCreatedAt, err := protoTypes.TimestampProto(template.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("convert createdAt to protobuf: %w", err)
+	}
+	pbUpdatedAt, err := protoTypes.TimestampProto(template.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("convert updatedAt to protobuf: %w", err)
+	}
+
+	return &api.DocumentTemplate{
+		Collection: template.Collection,
+		Root:       template.Root,
+		CreatedAt:  pbCreatedAt,
+		UpdatedAt:  pbUpdatedAt,
+	}, nil
+}
+
+// ToDocumentACL converts the given model to Protobuf format.
+func ToDocumentACL(acl *types.DocumentACL) *api.DocumentACL {
+	if acl == nil {
+		return &api.DocumentACL{}
+	}
+
+	return &api.DocumentACL{
+		Readers:    acl.Readers,
+		Writers:    acl.Writers,
+		Admins:     acl.Admins,
+		PublicRead: acl.PublicRead,
+	}
+}
+
+// ToDocumentMemories converts the given model to Protobuf format.
+func ToDocumentMemories(memories []*types.DocumentMemory) []*api.DocumentMemory {
+	var pbMemories []*api.DocumentMemory
+	for _, memory := range memories {
+		pbMemories = append(pbMemories, &api.DocumentMemory{
+			Id:                memory.ID.String(),
+			Key:               memory.Key.String(),
+			Subscriptions:     int32(memory.Subscriptions),
+			SubscriptionBytes: memory.SubscriptionBytes,
+			Locks:             int32(memory.Locks),
+			LockBytes:         memory.LockBytes,
+			Snapshots:         int32(memory.Snapshots),
+			SnapshotBytes:     memory.SnapshotBytes,
+		})
+	}
+	return pbMemories
+}
+
 // ToPresences converts the given model to Protobuf format.
 func ToPresences(presences map[string]innerpresence.Presence) map[string]*api.Presence {
 	pbPresences := make(map[string]*api.Presence)
@@ -177,6 +292,7 @@ func ToChangePack(pack *change.Pack) (*api.ChangePack, error) {
 		Snapshot:        pack.Snapshot,
 		MinSyncedTicket: ToTimeTicket(pack.MinSyncedTicket),
 		IsRemoved:       pack.IsRemoved,
+		IdempotencyKey:  pack.IdempotencyKey,
 	}, nil
 }
 
@@ -198,6 +314,16 @@ func ToChangeID(id change.ID) *api.ChangeID {
 	}
 }
 
+// ToOptionalTimestamp converts the given model format to Protobuf format. The
+// zero time is converted to nil.
+func ToOptionalTimestamp(t gotime.Time) (*protoTypes.Timestamp, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+
+	return protoTypes.TimestampProto(t)
+}
+
 // ToDocEventType converts the given model format to Protobuf format.
 func ToDocEventType(eventType types.DocEventType) (api.DocEventType, error) {
 	switch eventType {
@@ -207,6 +333,14 @@ func ToDocEventType(eventType types.DocEventType) (api.DocEventType, error) {
 		return api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_WATCHED, nil
 	case types.DocumentUnwatchedEvent:
 		return api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_UNWATCHED, nil
+	case types.PeersChangedEvent:
+		return api.DocEventType_DOC_EVENT_TYPE_PEERS_CHANGED, nil
+	case types.DocumentReadOnlyEvent:
+		return api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_READ_ONLY, nil
+	case types.DocumentWritableEvent:
+		return api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_WRITABLE, nil
+	case types.DocumentEvictedEvent:
+		return api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_EVICTED, nil
 	default:
 		return 0, fmt.Errorf("%s: %w", eventType, ErrUnsupportedEventType)
 	}
@@ -234,8 +368,16 @@ func ToOperations(ops []operations.Operation) ([]*api.Operation, error) {
 			pbOperation.Body, err = toEditReverse(op)
 		case *operations.Style:
 			pbOperation.Body, err = toStyle(op)
+		case *operations.AddAnnotation:
+			pbOperation.Body, err = toAddAnnotation(op)
+		case *operations.RemoveAnnotation:
+			pbOperation.Body, err = toRemoveAnnotation(op)
 		case *operations.Increase:
 			pbOperation.Body, err = toIncrease(op)
+		case *operations.SetAdd:
+			pbOperation.Body, err = toSetAdd(op)
+		case *operations.SetRemove:
+			pbOperation.Body, err = toSetRemove(op)
 		case *operations.TreeEdit:
 			pbOperation.Body, err = toTreeEdit(op)
 		case *operations.TreeStyle:
@@ -370,11 +512,35 @@ func toEditReverse(e *operations.EditReverse) (*api.Operation_EditReverse_, erro
 func toStyle(style *operations.Style) (*api.Operation_Style_, error) {
 	return &api.Operation_Style_{
 		Style: &api.Operation_Style{
-			ParentCreatedAt: ToTimeTicket(style.ParentCreatedAt()),
-			From:            toTextNodePos(style.From()),
-			To:              toTextNodePos(style.To()),
-			Attributes:      style.Attributes(),
-			ExecutedAt:      ToTimeTicket(style.ExecutedAt()),
+			ParentCreatedAt:     ToTimeTicket(style.ParentCreatedAt()),
+			From:                toTextNodePos(style.From()),
+			To:                  toTextNodePos(style.To()),
+			CreatedAtMapByActor: toCreatedAtMapByActor(style.CreatedAtMapByActor()),
+			Attributes:          style.Attributes(),
+			ExecutedAt:          ToTimeTicket(style.ExecutedAt()),
+		},
+	}, nil
+}
+
+func toAddAnnotation(e *operations.AddAnnotation) (*api.Operation_AddAnnotation_, error) {
+	return &api.Operation_AddAnnotation_{
+		AddAnnotation: &api.Operation_AddAnnotation{
+			ParentCreatedAt: ToTimeTicket(e.ParentCreatedAt()),
+			Name:            e.Name(),
+			From:            toTextNodePos(e.From()),
+			To:              toTextNodePos(e.To()),
+			Value:           e.Value(),
+			ExecutedAt:      ToTimeTicket(e.ExecutedAt()),
+		},
+	}, nil
+}
+
+func toRemoveAnnotation(e *operations.RemoveAnnotation) (*api.Operation_RemoveAnnotation_, error) {
+	return &api.Operation_RemoveAnnotation_{
+		RemoveAnnotation: &api.Operation_RemoveAnnotation{
+			ParentCreatedAt: ToTimeTicket(e.ParentCreatedAt()),
+			Name:            e.Name(),
+			ExecutedAt:      ToTimeTicket(e.ExecutedAt()),
 		},
 	}, nil
 }
@@ -394,6 +560,37 @@ func toIncrease(increase *operations.Increase) (*api.Operation_Increase_, error)
 	}, nil
 }
 
+func toSetAdd(add *operations.SetAdd) (*api.Operation_SetAdd_, error) {
+	pbElem, err := toJSONElementSimple(add.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Operation_SetAdd_{
+		SetAdd: &api.Operation_SetAdd{
+			ParentCreatedAt: ToTimeTicket(add.ParentCreatedAt()),
+			Value:           pbElem,
+			ExecutedAt:      ToTimeTicket(add.ExecutedAt()),
+		},
+	}, nil
+}
+
+func toSetRemove(remove *operations.SetRemove) (*api.Operation_SetRemove_, error) {
+	pbElem, err := toJSONElementSimple(remove.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Operation_SetRemove_{
+		SetRemove: &api.Operation_SetRemove{
+			ParentCreatedAt:     ToTimeTicket(remove.ParentCreatedAt()),
+			Value:               pbElem,
+			CreatedAtMapByActor: toCreatedAtMapByActor(remove.CreatedAtMapByActor()),
+			ExecutedAt:          ToTimeTicket(remove.ExecutedAt()),
+		},
+	}, nil
+}
+
 func toTreeEdit(e *operations.TreeEdit) (*api.Operation_TreeEdit_, error) {
 	return &api.Operation_TreeEdit_{
 		TreeEdit: &api.Operation_TreeEdit{
@@ -422,9 +619,15 @@ func toTreeStyle(style *operations.TreeStyle) (*api.Operation_TreeStyle_, error)
 func toJSONElementSimple(elem crdt.Element) (*api.JSONElementSimple, error) {
 	switch elem := elem.(type) {
 	case *crdt.Object:
+		pbPolicy, err := toConflictPolicy(elem.Policy())
+		if err != nil {
+			return nil, err
+		}
+
 		return &api.JSONElementSimple{
 			Type:      api.ValueType_VALUE_TYPE_JSON_OBJECT,
 			CreatedAt: ToTimeTicket(elem.CreatedAt()),
+			Policy:    pbPolicy,
 		}, nil
 	case *crdt.Array:
 		return &api.JSONElementSimple{
@@ -472,6 +675,11 @@ func toJSONElementSimple(elem crdt.Element) (*api.JSONElementSimple, error) {
 			CreatedAt: ToTimeTicket(elem.CreatedAt()),
 			Value:     bytes,
 		}, nil
+	case *crdt.Set:
+		return &api.JSONElementSimple{
+			Type:      api.ValueType_VALUE_TYPE_SET,
+			CreatedAt: ToTimeTicket(elem.CreatedAt()),
+		}, nil
 	}
 
 	return nil, fmt.Errorf("%v, %w", reflect.TypeOf(elem), ErrUnsupportedElement)
@@ -524,11 +732,24 @@ func toCounterType(valueType crdt.CounterType) (api.ValueType, error) {
 		return api.ValueType_VALUE_TYPE_INTEGER_CNT, nil
 	case crdt.LongCnt:
 		return api.ValueType_VALUE_TYPE_LONG_CNT, nil
+	case crdt.DoubleCnt:
+		return api.ValueType_VALUE_TYPE_DOUBLE_CNT, nil
 	}
 
 	return 0, fmt.Errorf("%d, %w", valueType, ErrUnsupportedCounterType)
 }
 
+func toConflictPolicy(policy crdt.ConflictPolicy) (api.ConflictPolicy, error) {
+	switch policy {
+	case crdt.LWW:
+		return api.ConflictPolicy_CONFLICT_POLICY_LWW, nil
+	case crdt.Merge:
+		return api.ConflictPolicy_CONFLICT_POLICY_MERGE, nil
+	}
+
+	return 0, fmt.Errorf("%d, %w", policy, ErrUnsupportedConflictPolicy)
+}
+
 // ToUpdatableProjectFields converts the given model format to Protobuf format.
 func ToUpdatableProjectFields(fields *types.UpdatableProjectFields) (*api.UpdatableProjectFields, error) {
 	pbUpdatableProjectFields := &api.UpdatableProjectFields{}
@@ -545,6 +766,43 @@ func ToUpdatableProjectFields(fields *types.UpdatableProjectFields) (*api.Updata
 	} else {
 		pbUpdatableProjectFields.AuthWebhookMethods = nil
 	}
+	if fields.AuthJWTKey != nil {
+		pbUpdatableProjectFields.AuthJwtKey = &protoTypes.StringValue{Value: *fields.AuthJWTKey}
+	}
+	if fields.AuthJWKSURL != nil {
+		pbUpdatableProjectFields.AuthJwksUrl = &protoTypes.StringValue{Value: *fields.AuthJWKSURL}
+	}
+	if fields.SensitivePresenceKeys != nil {
+		pbUpdatableProjectFields.SensitivePresenceKeys = &api.UpdatableProjectFields_SensitivePresenceKeys{
+			Keys: *fields.SensitivePresenceKeys,
+		}
+	}
+	if fields.EventWebhookURL != nil {
+		pbUpdatableProjectFields.EventWebhookUrl = &protoTypes.StringValue{Value: *fields.EventWebhookURL}
+	}
+	if fields.EventWebhookEvents != nil {
+		pbUpdatableProjectFields.EventWebhookEvents = &api.UpdatableProjectFields_EventWebhookEvents{
+			Events: *fields.EventWebhookEvents,
+		}
+	}
+	if fields.DocumentSizeSoftLimit != nil {
+		pbUpdatableProjectFields.DocumentSizeSoftLimit = &protoTypes.Int64Value{Value: *fields.DocumentSizeSoftLimit}
+	}
+	if fields.DocumentSizeHardLimit != nil {
+		pbUpdatableProjectFields.DocumentSizeHardLimit = &protoTypes.Int64Value{Value: *fields.DocumentSizeHardLimit}
+	}
+	if fields.ChangeLogSoftLimit != nil {
+		pbUpdatableProjectFields.ChangeLogSoftLimit = &protoTypes.Int64Value{Value: *fields.ChangeLogSoftLimit}
+	}
+	if fields.ChangeLogHardLimit != nil {
+		pbUpdatableProjectFields.ChangeLogHardLimit = &protoTypes.Int64Value{Value: *fields.ChangeLogHardLimit}
+	}
+	if fields.SnapshotThreshold != nil {
+		pbUpdatableProjectFields.SnapshotThreshold = &protoTypes.Int64Value{Value: *fields.SnapshotThreshold}
+	}
+	if fields.SnapshotInterval != nil {
+		pbUpdatableProjectFields.SnapshotInterval = &protoTypes.Int64Value{Value: *fields.SnapshotInterval}
+	}
 	if fields.ClientDeactivateThreshold != nil {
 		pbUpdatableProjectFields.ClientDeactivateThreshold = &protoTypes.StringValue{
 			Value: *fields.ClientDeactivateThreshold,
@@ -37,6 +37,46 @@ const (
 	ReadWrite VerbType = "rw"
 )
 
+// AccessAction represents an action attempted on the document. Unlike the
+// verb, it tells the authorizers what the client is trying to do so that they
+// can implement access control lists per document.
+type AccessAction string
+
+const (
+	// ReadAction represents the case of reading the given document.
+	ReadAction AccessAction = "read"
+
+	// WriteAction represents the case of writing changes to the given document.
+	WriteAction AccessAction = "write"
+
+	// AttachAction represents the case of attaching the given document.
+	AttachAction AccessAction = "attach"
+
+	// DetachAction represents the case of detaching the given document.
+	DetachAction AccessAction = "detach"
+
+	// RemoveAction represents the case of removing the given document.
+	RemoveAction AccessAction = "remove"
+)
+
+// ActionOf returns the action attempted on a document by the given method
+// with the given verb.
+func ActionOf(method Method, verb VerbType) AccessAction {
+	switch method {
+	case AttachDocument:
+		return AttachAction
+	case DetachDocument:
+		return DetachAction
+	case RemoveDocument:
+		return RemoveAction
+	}
+
+	if verb == ReadWrite {
+		return WriteAction
+	}
+	return ReadAction
+}
+
 var (
 	// ErrInvalidWebhookRequest is returned when the given webhook request is not valid.
 	ErrInvalidWebhookRequest = errors.New("invalid authorization webhook request")
@@ -57,6 +97,7 @@ const (
 	RemoveDocument   Method = "RemoveDocument"
 	PushPull         Method = "PushPull"
 	WatchDocuments   Method = "WatchDocuments"
+	ReadDocument     Method = "ReadDocument"
 )
 
 // IsAuthMethod returns whether the given method can be used for authorization.
@@ -79,31 +120,48 @@ func AuthMethods() []Method {
 		RemoveDocument,
 		PushPull,
 		WatchDocuments,
+		ReadDocument,
 	}
 }
 
 // AccessAttribute represents an access attribute.
 type AccessAttribute struct {
-	Key  string   `json:"key"`
-	Verb VerbType `json:"verb"`
+	Key    string       `json:"key"`
+	Verb   VerbType     `json:"verb"`
+	Action AccessAction `json:"action,omitempty"`
 }
 
 // NewAccessAttributes creates a new instance of AccessAttributes.
-func NewAccessAttributes(docKeys []key.Key, verb VerbType) []AccessAttribute {
+func NewAccessAttributes(docKeys []key.Key, method Method, verb VerbType) []AccessAttribute {
 	attrs := make([]AccessAttribute, len(docKeys))
 	for i, docKey := range docKeys {
 		attrs[i] = AccessAttribute{
-			Key:  docKey.String(),
-			Verb: verb,
+			Key:    docKey.String(),
+			Verb:   verb,
+			Action: ActionOf(method, verb),
 		}
 	}
 	return attrs
 }
 
+// AccessClient represents the client that attempts the access.
+type AccessClient struct {
+	// ID is the ID of the client. It is empty before the client is activated.
+	ID string `json:"id,omitempty"`
+
+	// Key is the key of the client. It is only known when the client is
+	// activated.
+	Key string `json:"key,omitempty"`
+
+	// Connection is the information of the connection of the request.
+	Connection ConnectionInfo `json:"connection"`
+}
+
 // AccessInfo represents an access information.
 type AccessInfo struct {
 	Method     Method
 	Attributes []AccessAttribute
+	Client     *AccessClient
 }
 
 // AuthWebhookRequest represents the request of authentication webhook.
@@ -111,6 +169,7 @@ type AuthWebhookRequest struct {
 	Token      string            `json:"token"`
 	Method     Method            `json:"method"`
 	Attributes []AccessAttribute `json:"attributes"`
+	Client     *AccessClient     `json:"client,omitempty"`
 }
 
 // NewAuthWebhookRequest creates a new instance of AuthWebhookRequest.
@@ -128,6 +187,14 @@ func NewAuthWebhookRequest(reader io.Reader) (*AuthWebhookRequest, error) {
 type AuthWebhookResponse struct {
 	Allowed bool   `json:"allowed"`
 	Reason  string `json:"reason"`
+
+	// Subject is the identity of the user of the token. It is used to check
+	// the access control lists of documents.
+	Subject string `json:"subject,omitempty"`
+
+	// DecryptPresence permits the user to read the values of the sensitive
+	// presence keys of the project in plaintext.
+	DecryptPresence bool `json:"decrypt_presence,omitempty"`
 }
 
 // NewAuthWebhookResponse creates a new instance of AuthWebhookResponse.
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"net/url"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+const (
+	// BackupManifestName is the name of the manifest in a backup archive. It
+	// is written after all the documents, so an archive without it is not
+	// complete.
+	BackupManifestName = "manifest.json"
+
+	// BackupDocumentDir is the directory of the documents in a backup archive.
+	BackupDocumentDir = "documents/"
+)
+
+// BackupManifest is the index of the documents in a backup archive of a
+// project.
+type BackupManifest struct {
+	// Project is the name of the project that is backed up.
+	Project string `json:"project"`
+
+	// CreatedAt is the time when the backup started.
+	CreatedAt gotime.Time `json:"created_at"`
+
+	// Documents are the documents in the archive.
+	Documents []*BackupDocument `json:"documents"`
+}
+
+// BackupDocument is the entry of a document in a backup archive.
+type BackupDocument struct {
+	// Key is the key of the document.
+	Key key.Key `json:"key"`
+
+	// ServerSeq is the watermark of the document. The document is backed up
+	// at this server seq even if it is changed during the backup.
+	ServerSeq int64 `json:"server_seq"`
+
+	// HasChanges is whether the change log of the document is backed up. It
+	// is false if the changes are not requested or already compacted.
+	HasChanges bool `json:"has_changes"`
+}
+
+// BackupDocumentPath returns the path of the given document in a backup
+// archive. The key is escaped since it can have characters not allowed in
+// paths.
+func BackupDocumentPath(k key.Key) string {
+	return BackupDocumentDir + url.PathEscape(k.String()) + ".ydoc"
+}
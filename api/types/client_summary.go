@@ -0,0 +1,68 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"time"
+)
+
+// Below are the sources that clients are activated from.
+const (
+	// ConnectionSourceGRPC means that the client is connected with gRPC.
+	ConnectionSourceGRPC = "grpc"
+
+	// ConnectionSourceGRPCWeb means that the client is connected with
+	// gRPC-Web, e.g. from a browser.
+	ConnectionSourceGRPCWeb = "grpc-web"
+)
+
+// ConnectionInfo is the information of the connection that a client was
+// activated with. It is used to investigate abuse and to debug clients.
+type ConnectionInfo struct {
+	// IP is the IP address of the client. If the request is forwarded by a
+	// proxy, it is the first address of the X-Forwarded-For header.
+	IP string `bson:"ip" json:"ip"`
+
+	// UserAgent is the user agent of the client, which contains the type
+	// and the version of the SDK, e.g. "yorkie-js-sdk/0.4.5".
+	UserAgent string `bson:"user_agent" json:"user_agent"`
+
+	// Source is the source that the client is activated from.
+	Source string `bson:"source" json:"source"`
+}
+
+// ClientSummary represents a summary of client.
+type ClientSummary struct {
+	// ID is the unique identifier of the client.
+	ID ID
+
+	// Key is the key of the client.
+	Key string
+
+	// Status is the status of the client.
+	Status string
+
+	// Connection is the information of the connection that the client was
+	// activated with.
+	Connection ConnectionInfo
+
+	// CreatedAt is the time when the client is created.
+	CreatedAt time.Time
+
+	// UpdatedAt is the time when the client is accessed.
+	UpdatedAt time.Time
+}
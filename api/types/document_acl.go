@@ -0,0 +1,112 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+// ACLRole represents a role of a subject in the ACL of a document.
+type ACLRole int
+
+const (
+	// ReaderRole represents the role that can read the document.
+	ReaderRole ACLRole = iota
+
+	// WriterRole represents the role that can read and write the document.
+	WriterRole
+
+	// AdminRole represents the role that can read, write and remove the
+	// document.
+	AdminRole
+)
+
+// AnySubject is the subject that matches every subject including anonymous
+// users.
+const AnySubject = "*"
+
+// DocumentACL is the access control list of a document. Subjects are the
+// identities of users extracted from the auth webhook response.
+type DocumentACL struct {
+	// Readers are the subjects that can read the document.
+	Readers []string `bson:"readers"`
+
+	// Writers are the subjects that can read and write the document.
+	Writers []string `bson:"writers"`
+
+	// Admins are the subjects that can read, write and remove the document.
+	Admins []string `bson:"admins"`
+
+	// PublicRead is whether anyone can read the document even without
+	// authentication. Writes still require the normal authentication.
+	PublicRead bool `bson:"public_read"`
+}
+
+// IsEmpty returns whether this ACL has no entries. A document without entries
+// is not restricted by the ACL regardless of PublicRead.
+func (acl *DocumentACL) IsEmpty() bool {
+	return acl == nil || len(acl.Readers) == 0 && len(acl.Writers) == 0 && len(acl.Admins) == 0
+}
+
+// Allows returns whether the given subject has the given role or a higher
+// role in this ACL.
+func (acl *DocumentACL) Allows(subject string, role ACLRole) bool {
+	if acl.IsEmpty() {
+		return true
+	}
+
+	if role <= ReaderRole && acl.PublicRead {
+		return true
+	}
+	if containsSubject(acl.Admins, subject) {
+		return true
+	}
+	if role <= WriterRole && containsSubject(acl.Writers, subject) {
+		return true
+	}
+	if role <= ReaderRole && containsSubject(acl.Readers, subject) {
+		return true
+	}
+
+	return false
+}
+
+// AllowsPublicRead returns whether this ACL allows anyone to read the
+// document without authentication.
+func (acl *DocumentACL) AllowsPublicRead() bool {
+	return acl != nil && acl.PublicRead
+}
+
+// DeepCopy returns a deep copy of this ACL.
+func (acl *DocumentACL) DeepCopy() *DocumentACL {
+	if acl == nil {
+		return nil
+	}
+
+	return &DocumentACL{
+		Readers:    append([]string(nil), acl.Readers...),
+		Writers:    append([]string(nil), acl.Writers...),
+		Admins:     append([]string(nil), acl.Admins...),
+		PublicRead: acl.PublicRead,
+	}
+}
+
+// containsSubject returns whether the given subjects contain the given subject.
+func containsSubject(subjects []string, subject string) bool {
+	for _, s := range subjects {
+		if s == AnySubject || (subject != "" && s == subject) {
+			return true
+		}
+	}
+	return false
+}
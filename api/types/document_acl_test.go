@@ -0,0 +1,72 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+func TestDocumentACL(t *testing.T) {
+	t.Run("allows test", func(t *testing.T) {
+		acl := &types.DocumentACL{
+			Readers: []string{"reader"},
+			Writers: []string{"writer"},
+			Admins:  []string{"admin"},
+		}
+
+		assert.True(t, acl.Allows("reader", types.ReaderRole))
+		assert.False(t, acl.Allows("reader", types.WriterRole))
+		assert.True(t, acl.Allows("writer", types.WriterRole))
+		assert.False(t, acl.Allows("writer", types.AdminRole))
+		assert.True(t, acl.Allows("admin", types.AdminRole))
+		assert.False(t, acl.Allows("stranger", types.ReaderRole))
+		assert.False(t, acl.Allows("", types.ReaderRole))
+	})
+
+	t.Run("any subject test", func(t *testing.T) {
+		acl := &types.DocumentACL{Readers: []string{types.AnySubject}}
+		assert.True(t, acl.Allows("", types.ReaderRole))
+		assert.True(t, acl.Allows("stranger", types.ReaderRole))
+		assert.False(t, acl.Allows("stranger", types.WriterRole))
+	})
+
+	t.Run("public read test", func(t *testing.T) {
+		acl := &types.DocumentACL{Writers: []string{"writer"}, PublicRead: true}
+		assert.True(t, acl.AllowsPublicRead())
+		assert.True(t, acl.Allows("", types.ReaderRole))
+		assert.True(t, acl.Allows("stranger", types.ReaderRole))
+		assert.False(t, acl.Allows("", types.WriterRole))
+		assert.True(t, acl.Allows("writer", types.WriterRole))
+
+		copied := acl.DeepCopy()
+		assert.True(t, copied.AllowsPublicRead())
+
+		var empty *types.DocumentACL
+		assert.False(t, empty.AllowsPublicRead())
+	})
+
+	t.Run("empty acl test", func(t *testing.T) {
+		var acl *types.DocumentACL
+		assert.True(t, acl.IsEmpty())
+		assert.True(t, acl.Allows("", types.AdminRole))
+		assert.True(t, (&types.DocumentACL{}).Allows("stranger", types.AdminRole))
+	})
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+// DocumentFilter filters the documents to list. The zero value matches every
+// document.
+type DocumentFilter struct {
+	// Labels selects the documents by their labels.
+	Labels LabelSelector
+
+	// KeyPrefix selects the documents whose keys start with it.
+	KeyPrefix string
+
+	// UpdatedAfter selects the documents updated at or after it if it is not
+	// zero.
+	UpdatedAfter time.Time
+
+	// UpdatedBefore selects the documents updated before it if it is not zero.
+	UpdatedBefore time.Time
+}
+
+// HasUpdatedRange returns whether this filter selects the documents by the
+// time when they are updated. Documents that have never been updated do not
+// match such filters.
+func (f DocumentFilter) HasUpdatedRange() bool {
+	return !f.UpdatedAfter.IsZero() || !f.UpdatedBefore.IsZero()
+}
+
+// Matches returns whether the document of the given key, labels and updated
+// time matches this filter.
+func (f DocumentFilter) Matches(docKey string, labels map[string]string, updatedAt time.Time) bool {
+	if !strings.HasPrefix(docKey, f.KeyPrefix) || !f.Labels.Matches(labels) {
+		return false
+	}
+	if !f.HasUpdatedRange() {
+		return true
+	}
+	if updatedAt.IsZero() {
+		return false
+	}
+	if !f.UpdatedAfter.IsZero() && updatedAt.Before(f.UpdatedAfter) {
+		return false
+	}
+	if !f.UpdatedBefore.IsZero() && !updatedAt.Before(f.UpdatedBefore) {
+		return false
+	}
+
+	return true
+}
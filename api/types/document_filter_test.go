@@ -0,0 +1,56 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+func TestDocumentFilter(t *testing.T) {
+	t.Run("matches test", func(t *testing.T) {
+		assert.True(t, types.DocumentFilter{}.Matches("doc", nil, time.Time{}))
+
+		filter := types.DocumentFilter{
+			Labels:    types.LabelSelector{{Key: "env", Operator: types.LabelEquals, Value: "prod"}},
+			KeyPrefix: "room-",
+		}
+		assert.True(t, filter.Matches("room-1", map[string]string{"env": "prod"}, time.Time{}))
+		assert.False(t, filter.Matches("lobby-1", map[string]string{"env": "prod"}, time.Time{}))
+		assert.False(t, filter.Matches("room-1", map[string]string{"env": "dev"}, time.Time{}))
+	})
+
+	t.Run("updated range test", func(t *testing.T) {
+		now := time.Now()
+		filter := types.DocumentFilter{
+			UpdatedAfter:  now.Add(-time.Hour),
+			UpdatedBefore: now,
+		}
+		assert.True(t, filter.Matches("doc", nil, now.Add(-time.Hour)))
+		assert.True(t, filter.Matches("doc", nil, now.Add(-time.Minute)))
+		assert.False(t, filter.Matches("doc", nil, now))
+		assert.False(t, filter.Matches("doc", nil, now.Add(-2*time.Hour)))
+
+		// documents that have never been updated do not match the range.
+		assert.False(t, filter.Matches("doc", nil, time.Time{}))
+		assert.False(t, types.DocumentFilter{UpdatedBefore: now}.Matches("doc", nil, time.Time{}))
+	})
+}
@@ -0,0 +1,192 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxLabelKeyLen is the maximum length of the key of a label.
+	maxLabelKeyLen = 63
+
+	// maxLabelValueLen is the maximum length of the value of a label.
+	maxLabelValueLen = 255
+)
+
+// ErrInvalidLabel is returned when the key or the value of a label is invalid.
+var ErrInvalidLabel = errors.New("invalid label")
+
+// ErrInvalidLabelSelector is returned when the label selector is invalid.
+var ErrInvalidLabelSelector = errors.New("invalid label selector")
+
+// ValidateLabels validates the given labels of a document. Keys consist of
+// alphanumerics, '-', '_' and '/', which keeps them usable as field paths of
+// the database.
+func ValidateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if err := validateLabelKey(k); err != nil {
+			return err
+		}
+		if len(v) > maxLabelValueLen {
+			return fmt.Errorf("value of %s is longer than %d: %w", k, maxLabelValueLen, ErrInvalidLabel)
+		}
+	}
+
+	return nil
+}
+
+// validateLabelKey validates the given key of a label.
+func validateLabelKey(k string) error {
+	if k == "" || len(k) > maxLabelKeyLen {
+		return fmt.Errorf("key %q must be 1 to %d characters: %w", k, maxLabelKeyLen, ErrInvalidLabel)
+	}
+
+	for _, r := range k {
+		if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9' ||
+			r == '-' || r == '_' || r == '/' {
+			continue
+		}
+		return fmt.Errorf("key %q has invalid character %q: %w", k, r, ErrInvalidLabel)
+	}
+
+	return nil
+}
+
+// LabelOperator is the operator of a requirement of a label selector.
+type LabelOperator string
+
+const (
+	// LabelEquals requires the label to have the value.
+	LabelEquals LabelOperator = "="
+
+	// LabelNotEquals requires the label not to have the value. Documents
+	// without the label also match.
+	LabelNotEquals LabelOperator = "!="
+
+	// LabelExists requires the label to exist.
+	LabelExists LabelOperator = "exists"
+
+	// LabelNotExists requires the label not to exist.
+	LabelNotExists LabelOperator = "!exists"
+)
+
+// LabelRequirement is a requirement on a label of documents.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Value    string
+}
+
+// LabelSelector selects documents by their labels. Documents match the
+// selector if they meet all the requirements.
+type LabelSelector []LabelRequirement
+
+// ParseLabelSelector parses the given selector such as "env=prod,team!=web".
+// Each requirement is one of "key=value", "key!=value", "key" and "!key".
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	var requirements LabelSelector
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		var req LabelRequirement
+		if k, v, ok := strings.Cut(term, "!="); ok {
+			req = LabelRequirement{Key: strings.TrimSpace(k), Operator: LabelNotEquals, Value: strings.TrimSpace(v)}
+		} else if k, v, ok := strings.Cut(term, "="); ok {
+			req = LabelRequirement{Key: strings.TrimSpace(k), Operator: LabelEquals, Value: strings.TrimSpace(v)}
+		} else if strings.HasPrefix(term, "!") {
+			req = LabelRequirement{Key: strings.TrimSpace(term[1:]), Operator: LabelNotExists}
+		} else {
+			req = LabelRequirement{Key: term, Operator: LabelExists}
+		}
+
+		if err := validateLabelKey(req.Key); err != nil {
+			return nil, fmt.Errorf("%s: %w", term, ErrInvalidLabelSelector)
+		}
+		requirements = append(requirements, req)
+	}
+
+	return requirements, nil
+}
+
+// IsEmpty returns whether this selector has no requirements. An empty
+// selector matches every document.
+func (s LabelSelector) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Matches returns whether the given labels meet all the requirements.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, req := range s {
+		v, ok := labels[req.Key]
+		switch req.Operator {
+		case LabelEquals:
+			if !ok || v != req.Value {
+				return false
+			}
+		case LabelNotEquals:
+			if ok && v == req.Value {
+				return false
+			}
+		case LabelExists:
+			if !ok {
+				return false
+			}
+		case LabelNotExists:
+			if ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// String returns the string representation of this selector.
+func (s LabelSelector) String() string {
+	var terms []string
+	for _, req := range s {
+		switch req.Operator {
+		case LabelEquals, LabelNotEquals:
+			terms = append(terms, req.Key+string(req.Operator)+req.Value)
+		case LabelExists:
+			terms = append(terms, req.Key)
+		case LabelNotExists:
+			terms = append(terms, "!"+req.Key)
+		}
+	}
+
+	return strings.Join(terms, ",")
+}
+
+// LabelsString returns the string representation of the given labels, which
+// is sorted by keys.
+func LabelsString(labels map[string]string) string {
+	var terms []string
+	for k, v := range labels {
+		terms = append(terms, k+"="+v)
+	}
+	sort.Strings(terms)
+
+	return strings.Join(terms, ",")
+}
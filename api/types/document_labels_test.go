@@ -0,0 +1,66 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+func TestDocumentLabels(t *testing.T) {
+	t.Run("validate labels test", func(t *testing.T) {
+		assert.NoError(t, types.ValidateLabels(map[string]string{"env": "prod", "team/name": "web-1"}))
+		assert.ErrorIs(t, types.ValidateLabels(map[string]string{"": "prod"}), types.ErrInvalidLabel)
+		assert.ErrorIs(t, types.ValidateLabels(map[string]string{"a.b": "prod"}), types.ErrInvalidLabel)
+		assert.ErrorIs(t, types.ValidateLabels(map[string]string{"$env": "prod"}), types.ErrInvalidLabel)
+	})
+
+	t.Run("parse label selector test", func(t *testing.T) {
+		selector, err := types.ParseLabelSelector("env=prod, team!=web,archived,!draft")
+		assert.NoError(t, err)
+		assert.Equal(t, types.LabelSelector{
+			{Key: "env", Operator: types.LabelEquals, Value: "prod"},
+			{Key: "team", Operator: types.LabelNotEquals, Value: "web"},
+			{Key: "archived", Operator: types.LabelExists},
+			{Key: "draft", Operator: types.LabelNotExists},
+		}, selector)
+		assert.Equal(t, "env=prod,team!=web,archived,!draft", selector.String())
+
+		selector, err = types.ParseLabelSelector("")
+		assert.NoError(t, err)
+		assert.True(t, selector.IsEmpty())
+
+		_, err = types.ParseLabelSelector("=prod")
+		assert.ErrorIs(t, err, types.ErrInvalidLabelSelector)
+	})
+
+	t.Run("matches test", func(t *testing.T) {
+		selector, err := types.ParseLabelSelector("env=prod,team!=web,!draft")
+		assert.NoError(t, err)
+
+		assert.True(t, selector.Matches(map[string]string{"env": "prod"}))
+		assert.True(t, selector.Matches(map[string]string{"env": "prod", "team": "api"}))
+		assert.False(t, selector.Matches(map[string]string{"env": "prod", "team": "web"}))
+		assert.False(t, selector.Matches(map[string]string{"env": "prod", "draft": ""}))
+		assert.False(t, selector.Matches(map[string]string{"env": "dev"}))
+		assert.False(t, selector.Matches(nil))
+		assert.True(t, types.LabelSelector(nil).Matches(nil))
+	})
+}
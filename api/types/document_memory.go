@@ -0,0 +1,74 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// DocumentMemory is the approximate memory that a server holds for a document.
+// It consists of the subscriptions of the clients watching the document, the
+// locks of it and the snapshots of it cached for pulls.
+type DocumentMemory struct {
+	// ID is the ID of the document.
+	ID ID
+
+	// Key is the key of the document. It is empty if the document is not
+	// looked up from the database.
+	Key key.Key
+
+	// Subscriptions is the number of subscriptions to the document.
+	Subscriptions int
+
+	// SubscriptionBytes is the approximate bytes of the subscriptions.
+	SubscriptionBytes int64
+
+	// Locks is the number of locks of the document that are held or waited.
+	Locks int
+
+	// LockBytes is the approximate bytes of the locks.
+	LockBytes int64
+
+	// Snapshots is the number of the cached snapshots of the document.
+	Snapshots int
+
+	// SnapshotBytes is the bytes of the cached snapshots.
+	SnapshotBytes int64
+}
+
+// Bytes returns the approximate bytes that the server holds for the document.
+func (m *DocumentMemory) Bytes() int64 {
+	return m.SubscriptionBytes + m.LockBytes + m.SnapshotBytes
+}
+
+// TopDocumentMemories sorts the given memories in descending order of the
+// bytes and returns the first n of them.
+func TopDocumentMemories(memories []*DocumentMemory, n int) []*DocumentMemory {
+	sort.Slice(memories, func(i, j int) bool {
+		if memories[i].Bytes() != memories[j].Bytes() {
+			return memories[i].Bytes() > memories[j].Bytes()
+		}
+		return memories[i].ID < memories[j].ID
+	})
+
+	if len(memories) > n {
+		return memories[:n]
+	}
+	return memories
+}
@@ -25,4 +25,7 @@ type DocumentSummary struct {
 
 	// Snapshot is the string representation of the document.
 	Snapshot string
+
+	// Labels are the key/value labels of the document.
+	Labels map[string]string
 }
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"errors"
+	"time"
+
+	"github.com/yorkie-team/yorkie/internal/validation"
+)
+
+// ErrInvalidTemplateCollection is returned when the collection of the
+// template is invalid.
+var ErrInvalidTemplateCollection = errors.New(
+	"invalid template collection, collection must be a slug with 1-120 characters",
+)
+
+// DocumentTemplate is a blueprint of the initial root of the documents in a
+// collection. The documents whose keys start with the collection are
+// initialized from the template when they are attached for the first time.
+type DocumentTemplate struct {
+	// Collection is the prefix of the document keys to apply the template.
+	Collection string `json:"collection"`
+
+	// Root is the JSON representation of the root object of the template.
+	Root string `json:"root"`
+
+	// CreatedAt is the time when the template was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time when the template was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ValidateTemplateCollection validates the given collection of the template.
+func ValidateTemplateCollection(collection string) error {
+	if err := validation.Validate(collection, []any{
+		"required",
+		"case_sensitive_slug",
+		"max=120",
+	}); err != nil {
+		return ErrInvalidTemplateCollection
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+// DocumentVerification is the result of rebuilding a document from its change
+// log and comparing it against the latest stored snapshot.
+type DocumentVerification struct {
+	// SnapshotServerSeq is the server sequence of the latest snapshot.
+	SnapshotServerSeq int64
+
+	// SnapshotHash is the content hash of the latest snapshot.
+	SnapshotHash string
+
+	// RebuiltHash is the content hash of the document rebuilt from the change
+	// log up to SnapshotServerSeq.
+	RebuiltHash string
+}
+
+// IsDiverged returns whether the rebuilt document differs from the snapshot.
+func (v *DocumentVerification) IsDiverged() bool {
+	return v.SnapshotHash != v.RebuiltHash
+}
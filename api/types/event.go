@@ -15,4 +15,21 @@ const (
 	// DocumentUnwatchedEvent is an event that occurs when document is
 	// unwatched by other clients.
 	DocumentUnwatchedEvent DocEventType = "document-unwatched"
+
+	// PeersChangedEvent is an event that occurs when the presence of a client
+	// watching the document is changed without a change of the document. It
+	// carries only the changed keys of the presence.
+	PeersChangedEvent DocEventType = "peers-changed"
+
+	// DocumentReadOnlyEvent is an event that occurs when the document becomes
+	// read-only because it exceeds the quota of the project.
+	DocumentReadOnlyEvent DocEventType = "document-read-only"
+
+	// DocumentWritableEvent is an event that occurs when the write access of
+	// the read-only document is restored.
+	DocumentWritableEvent DocEventType = "document-writable"
+
+	// DocumentEvictedEvent is an event that occurs when the clients are
+	// detached from the document by the admin, e.g. for maintenance.
+	DocumentEvictedEvent DocEventType = "document-evicted"
 )
@@ -0,0 +1,193 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrInvalidEventWebhookRequest is returned when the given event webhook
+// request is not valid.
+var ErrInvalidEventWebhookRequest = errors.New("invalid event webhook request")
+
+// EventWebhookType represents a type of the lifecycle event that is sent to
+// the event webhook.
+type EventWebhookType string
+
+// Belows are the types of the lifecycle events.
+const (
+	// ClientActivatedEvent is sent when a client is activated.
+	ClientActivatedEvent EventWebhookType = "ClientActivated"
+
+	// ClientDeactivatedEvent is sent when a client is deactivated.
+	ClientDeactivatedEvent EventWebhookType = "ClientDeactivated"
+
+	// DocumentAttachedEvent is sent when a document is attached to a client.
+	DocumentAttachedEvent EventWebhookType = "DocumentAttached"
+
+	// DocumentDetachedEvent is sent when a document is detached from a client.
+	DocumentDetachedEvent EventWebhookType = "DocumentDetached"
+
+	// DocumentLimitWarningEvent is sent when a document reaches a soft limit
+	// of its project.
+	DocumentLimitWarningEvent EventWebhookType = "DocumentLimitWarning"
+
+	// DocumentCreatedEvent is sent when a document is created.
+	DocumentCreatedEvent EventWebhookType = "DocumentCreated"
+
+	// DocumentFirstAttachedEvent is sent when a document is attached to a
+	// client while no other client attaches it.
+	DocumentFirstAttachedEvent EventWebhookType = "DocumentFirstAttached"
+
+	// DocumentLastDetachedEvent is sent when a document is detached from the
+	// last client that attaches it.
+	DocumentLastDetachedEvent EventWebhookType = "DocumentLastDetached"
+
+	// SnapshotStoredEvent is sent when a snapshot of a document is stored.
+	SnapshotStoredEvent EventWebhookType = "SnapshotStored"
+
+	// DocumentRemovedEvent is sent when a document is removed.
+	DocumentRemovedEvent EventWebhookType = "DocumentRemoved"
+)
+
+// EventWebhookSignatureHeader is the header of the event webhook request that
+// carries the signature of the body. The signature is the hex-encoded
+// HMAC-SHA256 of the body with the secret key of the project, prefixed with
+// "sha256=".
+const EventWebhookSignatureHeader = "X-Yorkie-Signature"
+
+// Belows are the limits of documents that can be configured per project.
+const (
+	// DocumentSizeLimit is the limit of the snapshot size of a document.
+	DocumentSizeLimit = "document_size"
+
+	// ChangeLogLimit is the limit of the number of changes of a document.
+	ChangeLogLimit = "change_log"
+)
+
+// IsEventWebhookType returns whether the given type is a type of the
+// lifecycle events.
+func IsEventWebhookType(eventType string) bool {
+	for _, t := range EventWebhookTypes() {
+		if eventType == string(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// EventWebhookTypes returns a slice of the types of the lifecycle events.
+func EventWebhookTypes() []EventWebhookType {
+	return []EventWebhookType{
+		ClientActivatedEvent,
+		ClientDeactivatedEvent,
+		DocumentAttachedEvent,
+		DocumentDetachedEvent,
+		DocumentLimitWarningEvent,
+		DocumentCreatedEvent,
+		DocumentFirstAttachedEvent,
+		DocumentLastDetachedEvent,
+		SnapshotStoredEvent,
+		DocumentRemovedEvent,
+	}
+}
+
+// DocumentLimitUsage is the usage of a document against a limit of its
+// project.
+type DocumentLimitUsage struct {
+	// Limit is the name of the limit, e.g. "document_size" or "change_log".
+	Limit string `json:"limit"`
+
+	// Usage is the current usage of the document.
+	Usage int64 `json:"usage"`
+
+	// Threshold is the value of the limit.
+	Threshold int64 `json:"threshold"`
+}
+
+// EventWebhookRequest represents the request of event webhook. It carries the
+// metadata of the client and the document that the event is about.
+type EventWebhookRequest struct {
+	// Type is the type of the event.
+	Type EventWebhookType `json:"type"`
+
+	// ProjectName is the name of the project that the event occurred in.
+	ProjectName string `json:"project_name"`
+
+	// ClientID is the ID of the client. It is empty for the events that are
+	// not caused by a client.
+	ClientID string `json:"client_id"`
+
+	// ClientKey is the key of the client.
+	ClientKey string `json:"client_key"`
+
+	// Connection is the connection that the client is activated with.
+	Connection ConnectionInfo `json:"connection"`
+
+	// DocumentID is the ID of the document. It is empty for client events.
+	DocumentID string `json:"document_id,omitempty"`
+
+	// DocumentKey is the key of the document. It is empty for client events.
+	DocumentKey string `json:"document_key,omitempty"`
+
+	// DocumentLabels is the labels of the document.
+	DocumentLabels map[string]string `json:"document_labels,omitempty"`
+
+	// LimitUsage is the usage of the document that reached a soft limit. It
+	// is only set for DocumentLimitWarning events.
+	LimitUsage *DocumentLimitUsage `json:"limit_usage,omitempty"`
+
+	// ServerSeq is the server sequence of the stored snapshot. It is only set
+	// for SnapshotStored events.
+	ServerSeq int64 `json:"server_seq,omitempty"`
+
+	// IssuedAt is the time when the event occurred.
+	IssuedAt time.Time `json:"issued_at"`
+}
+
+// NewEventWebhookRequest creates a new instance of EventWebhookRequest.
+func NewEventWebhookRequest(reader io.Reader) (*EventWebhookRequest, error) {
+	req := &EventWebhookRequest{}
+
+	if err := json.NewDecoder(reader).Decode(req); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidEventWebhookRequest)
+	}
+
+	return req, nil
+}
+
+// SignEventWebhookRequest returns the signature of the given body of the event
+// webhook request with the given secret key.
+func SignEventWebhookRequest(secretKey string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyEventWebhookSignature returns whether the given signature is the
+// signature of the given body with the given secret key. Receivers of the
+// event webhook can use it to check that the request is sent by the server.
+func VerifyEventWebhookSignature(secretKey string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(SignEventWebhookRequest(secretKey, body)), []byte(signature))
+}
@@ -26,6 +26,38 @@ const UserAgentKey = "x-yorkie-user-agent"
 // APIKeyKey is the key of the api key header.
 const APIKeyKey = "x-api-key"
 
+// DeprecationKey is the key of the deprecation header. The server sets this
+// header on responses to SDKs below the recommended version.
+const DeprecationKey = "x-yorkie-deprecation"
+
+// ForwardedForKey is the key of the header that proxies set to the addresses
+// of the client and the proxies in front of the server.
+const ForwardedForKey = "x-forwarded-for"
+
+// GRPCWebKey is the key of the header that gRPC-Web clients set.
+const GRPCWebKey = "x-grpc-web"
+
+// PackCompressionKey is the key of the header with which the client tells the
+// compressor of the change packs that it accepts in the responses.
+const PackCompressionKey = "x-yorkie-pack-compression"
+
+// RequestIDKey is the key of the request ID header. Clients or proxies can set
+// this header to correlate the logs of the server with their own, and the
+// server returns the ID of the request in the response header of the same key.
+const RequestIDKey = "x-request-id"
+
+// ForwardedByKey is the key of the header that a server of the cluster sets
+// to the ID of itself when it forwards a request to the owner of a document.
+const ForwardedByKey = "x-yorkie-forwarded-by"
+
+// ForwardedAtKey is the key of the header that a server of the cluster sets
+// to the time in unix seconds when it forwards a request.
+const ForwardedAtKey = "x-yorkie-forwarded-at"
+
+// ForwardedSignatureKey is the key of the header that a server of the cluster
+// sets to the signature of the forwarded request by the secret of the cluster.
+const ForwardedSignatureKey = "x-yorkie-forwarded-signature"
+
 // ShardKey is the key of the shard header.
 const ShardKey = "x-shard-key"
 
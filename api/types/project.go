@@ -34,8 +34,55 @@ type Project struct {
 	AuthWebhookURL string `json:"auth_webhook_url"`
 
 	// AuthWebhookMethods is the methods that run the authorization webhook.
+	// The methods also apply to the JWT verification.
 	AuthWebhookMethods []string `json:"auth_webhook_methods"`
 
+	// AuthJWTKey is the static key that verifies the signatures of the JWTs
+	// of clients. It is either a PEM-encoded RSA or EC public key, or the
+	// secret of HMAC.
+	AuthJWTKey string `json:"auth_jwt_key"`
+
+	// AuthJWKSURL is the url of the JSON Web Key Set that verifies the
+	// signatures of the JWTs of clients.
+	AuthJWKSURL string `json:"auth_jwks_url"`
+
+	// SensitivePresenceKeys are the keys of presences whose values are
+	// encrypted before they are stored.
+	SensitivePresenceKeys []string `json:"sensitive_presence_keys"`
+
+	// EventWebhookURL is the url of the event webhook that receives the
+	// lifecycle events of clients and documents.
+	EventWebhookURL string `json:"event_webhook_url"`
+
+	// EventWebhookEvents is the types of the events that are sent to the
+	// event webhook.
+	EventWebhookEvents []string `json:"event_webhook_events"`
+
+	// DocumentSizeSoftLimit is the size in bytes of the latest snapshot of a
+	// document that triggers a warning. Zero means no limit.
+	DocumentSizeSoftLimit int64 `json:"document_size_soft_limit"`
+
+	// DocumentSizeHardLimit is the size in bytes of the latest snapshot of a
+	// document over which pushes to the document are rejected. Zero means no
+	// limit.
+	DocumentSizeHardLimit int64 `json:"document_size_hard_limit"`
+
+	// ChangeLogSoftLimit is the number of changes of a document that triggers
+	// a warning. Zero means no limit.
+	ChangeLogSoftLimit int64 `json:"change_log_soft_limit"`
+
+	// ChangeLogHardLimit is the number of changes of a document over which
+	// pushes to the document are rejected. Zero means no limit.
+	ChangeLogHardLimit int64 `json:"change_log_hard_limit"`
+
+	// SnapshotThreshold overrides the snapshot threshold of the server for the
+	// documents of this project. Zero means no override.
+	SnapshotThreshold int64 `json:"snapshot_threshold"`
+
+	// SnapshotInterval overrides the snapshot interval of the server for the
+	// documents of this project. Zero means no override.
+	SnapshotInterval int64 `json:"snapshot_interval"`
+
 	// ClientDeactivateThreshold is the time after which clients in
 	// specific project are considered deactivate for housekeeping.
 	ClientDeactivateThreshold string `bson:"client_deactivate_threshold"`
@@ -53,9 +100,15 @@ type Project struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// UseJWTAuth returns whether the project verifies the JWTs of clients by
+// itself instead of calling the authorization webhook.
+func (p *Project) UseJWTAuth() bool {
+	return len(p.AuthJWTKey) > 0 || len(p.AuthJWKSURL) > 0
+}
+
 // RequireAuth returns whether the given method requires authorization.
 func (p *Project) RequireAuth(method Method) bool {
-	if len(p.AuthWebhookURL) == 0 {
+	if len(p.AuthWebhookURL) == 0 && !p.UseJWTAuth() {
 		return false
 	}
 
@@ -71,3 +124,23 @@ func (p *Project) RequireAuth(method Method) bool {
 
 	return false
 }
+
+// RequireEventWebhook returns whether the given event should be sent to the
+// event webhook.
+func (p *Project) RequireEventWebhook(eventType EventWebhookType) bool {
+	if len(p.EventWebhookURL) == 0 {
+		return false
+	}
+
+	if len(p.EventWebhookEvents) == 0 {
+		return true
+	}
+
+	for _, t := range p.EventWebhookEvents {
+		if EventWebhookType(t) == eventType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -48,5 +48,33 @@ func TestProjectInfo(t *testing.T) {
 			AuthWebhookURL: "",
 		}
 		assert.False(t, info3.RequireAuth(types.ActivateClient))
+
+		// 4. JWT verification without webhook URL
+		info4 := &types.Project{
+			AuthJWTKey:         "secret",
+			AuthWebhookMethods: []string{string(types.AttachDocument)},
+		}
+		assert.True(t, info4.UseJWTAuth())
+		assert.True(t, info4.RequireAuth(types.AttachDocument))
+		assert.False(t, info4.RequireAuth(types.ActivateClient))
+	})
+
+	t.Run("require event webhook test", func(t *testing.T) {
+		// 1. Specify which events to send
+		info := &types.Project{
+			EventWebhookURL:    "ValidWebhookURL",
+			EventWebhookEvents: []string{string(types.DocumentAttachedEvent)},
+		}
+		assert.True(t, info.RequireEventWebhook(types.DocumentAttachedEvent))
+		assert.False(t, info.RequireEventWebhook(types.ClientActivatedEvent))
+
+		// 2. Send all
+		info.EventWebhookEvents = nil
+		assert.True(t, info.RequireEventWebhook(types.ClientActivatedEvent))
+		assert.True(t, info.RequireEventWebhook(types.DocumentDetachedEvent))
+
+		// 3. Empty webhook URL
+		info.EventWebhookURL = ""
+		assert.False(t, info.RequireEventWebhook(types.ClientActivatedEvent))
 	})
 }
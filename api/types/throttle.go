@@ -0,0 +1,57 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrResourceExhausted is returned when a request is rejected by a rate
+// limit or a quota.
+var ErrResourceExhausted = errors.New("resource exhausted")
+
+// ThrottleError represents a request rejected by a rate limit or a quota. It
+// carries how long the caller should wait before retrying so that the server
+// can deliver it to SDKs as machine-readable details.
+type ThrottleError struct {
+	// Subject is the subject on which the quota check failed, e.g.
+	// "project:<id>" or "webhook:auth".
+	Subject string
+
+	// Description describes why the request was rejected.
+	Description string
+
+	// RetryAfter is the duration the caller should wait before retrying.
+	RetryAfter time.Duration
+}
+
+// Error returns the message of this error.
+func (e *ThrottleError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s: %s, retry after %s", e.Subject, e.Description, e.RetryAfter)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Subject, e.Description)
+}
+
+// Unwrap returns ErrResourceExhausted so that callers can match this error
+// with errors.Is.
+func (e *ThrottleError) Unwrap() error {
+	return ErrResourceExhausted
+}
@@ -39,13 +39,50 @@ type UpdatableProjectFields struct {
 	// AuthWebhookMethods is the methods that run the authorization webhook.
 	AuthWebhookMethods *[]string `bson:"auth_webhook_methods,omitempty" validate:"omitempty,invalid_webhook_method"`
 
+	// AuthJWTKey is the static key that verifies the JWTs of clients.
+	AuthJWTKey *string `bson:"auth_jwt_key,omitempty"`
+
+	// AuthJWKSURL is the url of the JSON Web Key Set that verifies the JWTs of clients.
+	AuthJWKSURL *string `bson:"auth_jwks_url,omitempty" validate:"omitempty,url|emptystring"`
+
+	// SensitivePresenceKeys are the keys of presences whose values are encrypted before they are stored.
+	SensitivePresenceKeys *[]string `bson:"sensitive_presence_keys,omitempty"`
+
+	// EventWebhookURL is the url of the event webhook.
+	EventWebhookURL *string `bson:"event_webhook_url,omitempty" validate:"omitempty,url|emptystring"`
+
+	// EventWebhookEvents is the types of the events that are sent to the event webhook.
+	EventWebhookEvents *[]string `bson:"event_webhook_events,omitempty" validate:"omitempty,invalid_event_webhook_type"`
+
+	// DocumentSizeSoftLimit is the snapshot size of a document that triggers a warning.
+	DocumentSizeSoftLimit *int64 `bson:"document_size_soft_limit,omitempty" validate:"omitempty,min=0"`
+
+	// DocumentSizeHardLimit is the snapshot size of a document over which pushes are rejected.
+	DocumentSizeHardLimit *int64 `bson:"document_size_hard_limit,omitempty" validate:"omitempty,min=0"`
+
+	// ChangeLogSoftLimit is the number of changes of a document that triggers a warning.
+	ChangeLogSoftLimit *int64 `bson:"change_log_soft_limit,omitempty" validate:"omitempty,min=0"`
+
+	// ChangeLogHardLimit is the number of changes of a document over which pushes are rejected.
+	ChangeLogHardLimit *int64 `bson:"change_log_hard_limit,omitempty" validate:"omitempty,min=0"`
+
+	// SnapshotThreshold overrides the snapshot threshold of the server for the documents.
+	SnapshotThreshold *int64 `bson:"snapshot_threshold,omitempty" validate:"omitempty,min=0"`
+
+	// SnapshotInterval overrides the snapshot interval of the server for the documents.
+	SnapshotInterval *int64 `bson:"snapshot_interval,omitempty" validate:"omitempty,min=0"`
+
 	// ClientDeactivateThreshold is the time after which clients in specific project are considered deactivate.
 	ClientDeactivateThreshold *string `bson:"client_deactivate_threshold,omitempty" validate:"omitempty,min=2,duration"`
 }
 
 // Validate validates the UpdatableProjectFields.
 func (i *UpdatableProjectFields) Validate() error {
-	if i.Name == nil && i.AuthWebhookURL == nil && i.AuthWebhookMethods == nil && i.ClientDeactivateThreshold == nil {
+	if i.Name == nil && i.AuthWebhookURL == nil && i.AuthWebhookMethods == nil &&
+		i.AuthJWTKey == nil && i.AuthJWKSURL == nil && i.SensitivePresenceKeys == nil &&
+		i.EventWebhookURL == nil && i.EventWebhookEvents == nil && i.DocumentSizeSoftLimit == nil &&
+		i.DocumentSizeHardLimit == nil && i.ChangeLogSoftLimit == nil && i.ChangeLogHardLimit == nil &&
+		i.SnapshotThreshold == nil && i.SnapshotInterval == nil && i.ClientDeactivateThreshold == nil {
 		return ErrEmptyProjectFields
 	}
 
@@ -72,4 +109,24 @@ func init() {
 		fmt.Fprintln(os.Stderr, "updatable project fields: ", err)
 		os.Exit(1)
 	}
+
+	if err := validation.RegisterValidation(
+		"invalid_event_webhook_type",
+		func(level validation.FieldLevel) bool {
+			eventTypes := level.Field().Interface().([]string)
+			for _, eventType := range eventTypes {
+				if !IsEventWebhookType(eventType) {
+					return false
+				}
+			}
+			return true
+		},
+	); err != nil {
+		fmt.Fprintln(os.Stderr, "updatable project fields: ", err)
+		os.Exit(1)
+	}
+	if err := validation.RegisterTranslation("invalid_event_webhook_type", "given {0} is invalid event type"); err != nil {
+		fmt.Fprintln(os.Stderr, "updatable project fields: ", err)
+		os.Exit(1)
+	}
 }
@@ -69,6 +69,52 @@ func TestUpdatableProjectFields(t *testing.T) {
 			ClientDeactivateThreshold: &newClientDeactivateThreshold,
 		}
 		assert.ErrorAs(t, fields.Validate(), &structError)
+
+		// EventWebhookEvents
+		newEventWebhookURL := "http://localhost:3000"
+		newEventWebhookEvents := []string{
+			string(types.ClientActivatedEvent),
+			string(types.DocumentAttachedEvent),
+		}
+		fields = &types.UpdatableProjectFields{
+			EventWebhookURL:    &newEventWebhookURL,
+			EventWebhookEvents: &newEventWebhookEvents,
+		}
+		assert.NoError(t, fields.Validate())
+
+		newEventWebhookEvents = []string{"InvalidEvent"}
+		fields = &types.UpdatableProjectFields{
+			EventWebhookEvents: &newEventWebhookEvents,
+		}
+		assert.ErrorAs(t, fields.Validate(), &structError)
+
+		// Document limits
+		newSoftLimit, newHardLimit := int64(100), int64(0)
+		fields = &types.UpdatableProjectFields{
+			ChangeLogSoftLimit:    &newSoftLimit,
+			DocumentSizeHardLimit: &newHardLimit,
+		}
+		assert.NoError(t, fields.Validate())
+
+		newHardLimit = -1
+		fields = &types.UpdatableProjectFields{
+			ChangeLogHardLimit: &newHardLimit,
+		}
+		assert.ErrorAs(t, fields.Validate(), &structError)
+
+		// Snapshot overrides
+		newThreshold, newInterval := int64(500), int64(0)
+		fields = &types.UpdatableProjectFields{
+			SnapshotThreshold: &newThreshold,
+			SnapshotInterval:  &newInterval,
+		}
+		assert.NoError(t, fields.Validate())
+
+		newInterval = -1
+		fields = &types.UpdatableProjectFields{
+			SnapshotInterval: &newInterval,
+		}
+		assert.ErrorAs(t, fields.Validate(), &structError)
 	})
 
 	t.Run("project name format test", func(t *testing.T) {
@@ -6,6 +6,7 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -607,14 +608,18 @@ func (m *UpdateProjectResponse) GetProject() *Project {
 }
 
 type ListDocumentsRequest struct {
-	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	PreviousId           string   `protobuf:"bytes,2,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
-	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	IsForward            bool     `protobuf:"varint,4,opt,name=is_forward,json=isForward,proto3" json:"is_forward,omitempty"`
-	IncludeSnapshot      bool     `protobuf:"varint,5,opt,name=include_snapshot,json=includeSnapshot,proto3" json:"include_snapshot,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ProjectName          string           `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	PreviousId           string           `protobuf:"bytes,2,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+	PageSize             int32            `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IsForward            bool             `protobuf:"varint,4,opt,name=is_forward,json=isForward,proto3" json:"is_forward,omitempty"`
+	IncludeSnapshot      bool             `protobuf:"varint,5,opt,name=include_snapshot,json=includeSnapshot,proto3" json:"include_snapshot,omitempty"`
+	LabelSelector        string           `protobuf:"bytes,6,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	KeyPrefix            string           `protobuf:"bytes,7,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	UpdatedAfter         *types.Timestamp `protobuf:"bytes,8,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore        *types.Timestamp `protobuf:"bytes,9,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ListDocumentsRequest) Reset()         { *m = ListDocumentsRequest{} }
@@ -685,6 +690,34 @@ func (m *ListDocumentsRequest) GetIncludeSnapshot() bool {
 	return false
 }
 
+func (m *ListDocumentsRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *ListDocumentsRequest) GetKeyPrefix() string {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return ""
+}
+
+func (m *ListDocumentsRequest) GetUpdatedAfter() *types.Timestamp {
+	if m != nil {
+		return m.UpdatedAfter
+	}
+	return nil
+}
+
+func (m *ListDocumentsRequest) GetUpdatedBefore() *types.Timestamp {
+	if m != nil {
+		return m.UpdatedBefore
+	}
+	return nil
+}
+
 type ListDocumentsResponse struct {
 	Documents            []*DocumentSummary `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -936,27 +969,27 @@ func (m *RemoveDocumentByAdminResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveDocumentByAdminResponse proto.InternalMessageInfo
 
-type GetSnapshotMetaRequest struct {
+type RemoveDocumentsByAdminRequest struct {
 	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
-	ServerSeq            int64    `protobuf:"varint,3,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	LabelSelector        string   `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSnapshotMetaRequest) Reset()         { *m = GetSnapshotMetaRequest{} }
-func (m *GetSnapshotMetaRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSnapshotMetaRequest) ProtoMessage()    {}
-func (*GetSnapshotMetaRequest) Descriptor() ([]byte, []int) {
+func (m *RemoveDocumentsByAdminRequest) Reset()         { *m = RemoveDocumentsByAdminRequest{} }
+func (m *RemoveDocumentsByAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDocumentsByAdminRequest) ProtoMessage()    {}
+func (*RemoveDocumentsByAdminRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7ef4cd0843a14163, []int{18}
 }
-func (m *GetSnapshotMetaRequest) XXX_Unmarshal(b []byte) error {
+func (m *RemoveDocumentsByAdminRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetSnapshotMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveDocumentsByAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetSnapshotMetaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveDocumentsByAdminRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -966,59 +999,58 @@ func (m *GetSnapshotMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetSnapshotMetaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSnapshotMetaRequest.Merge(m, src)
+func (m *RemoveDocumentsByAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDocumentsByAdminRequest.Merge(m, src)
 }
-func (m *GetSnapshotMetaRequest) XXX_Size() int {
+func (m *RemoveDocumentsByAdminRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetSnapshotMetaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSnapshotMetaRequest.DiscardUnknown(m)
+func (m *RemoveDocumentsByAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDocumentsByAdminRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSnapshotMetaRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveDocumentsByAdminRequest proto.InternalMessageInfo
 
-func (m *GetSnapshotMetaRequest) GetProjectName() string {
+func (m *RemoveDocumentsByAdminRequest) GetProjectName() string {
 	if m != nil {
 		return m.ProjectName
 	}
 	return ""
 }
 
-func (m *GetSnapshotMetaRequest) GetDocumentKey() string {
+func (m *RemoveDocumentsByAdminRequest) GetLabelSelector() string {
 	if m != nil {
-		return m.DocumentKey
+		return m.LabelSelector
 	}
 	return ""
 }
 
-func (m *GetSnapshotMetaRequest) GetServerSeq() int64 {
+func (m *RemoveDocumentsByAdminRequest) GetForce() bool {
 	if m != nil {
-		return m.ServerSeq
+		return m.Force
 	}
-	return 0
+	return false
 }
 
-type GetSnapshotMetaResponse struct {
-	Snapshot             []byte   `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	Lamport              int64    `protobuf:"varint,2,opt,name=lamport,proto3" json:"lamport,omitempty"`
+type RemoveDocumentsByAdminResponse struct {
+	DocumentKeys         []string `protobuf:"bytes,1,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSnapshotMetaResponse) Reset()         { *m = GetSnapshotMetaResponse{} }
-func (m *GetSnapshotMetaResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSnapshotMetaResponse) ProtoMessage()    {}
-func (*GetSnapshotMetaResponse) Descriptor() ([]byte, []int) {
+func (m *RemoveDocumentsByAdminResponse) Reset()         { *m = RemoveDocumentsByAdminResponse{} }
+func (m *RemoveDocumentsByAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDocumentsByAdminResponse) ProtoMessage()    {}
+func (*RemoveDocumentsByAdminResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7ef4cd0843a14163, []int{19}
 }
-func (m *GetSnapshotMetaResponse) XXX_Unmarshal(b []byte) error {
+func (m *RemoveDocumentsByAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetSnapshotMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveDocumentsByAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetSnapshotMetaResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveDocumentsByAdminResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1028,53 +1060,46 @@ func (m *GetSnapshotMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetSnapshotMetaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSnapshotMetaResponse.Merge(m, src)
+func (m *RemoveDocumentsByAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDocumentsByAdminResponse.Merge(m, src)
 }
-func (m *GetSnapshotMetaResponse) XXX_Size() int {
+func (m *RemoveDocumentsByAdminResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetSnapshotMetaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSnapshotMetaResponse.DiscardUnknown(m)
+func (m *RemoveDocumentsByAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDocumentsByAdminResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSnapshotMetaResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveDocumentsByAdminResponse proto.InternalMessageInfo
 
-func (m *GetSnapshotMetaResponse) GetSnapshot() []byte {
+func (m *RemoveDocumentsByAdminResponse) GetDocumentKeys() []string {
 	if m != nil {
-		return m.Snapshot
+		return m.DocumentKeys
 	}
 	return nil
 }
 
-func (m *GetSnapshotMetaResponse) GetLamport() int64 {
-	if m != nil {
-		return m.Lamport
-	}
-	return 0
-}
-
-type SearchDocumentsRequest struct {
-	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	Query                string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type UpdateDocumentACLRequest struct {
+	ProjectName          string       `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string       `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Acl                  *DocumentACL `protobuf:"bytes,3,opt,name=acl,proto3" json:"acl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *SearchDocumentsRequest) Reset()         { *m = SearchDocumentsRequest{} }
-func (m *SearchDocumentsRequest) String() string { return proto.CompactTextString(m) }
-func (*SearchDocumentsRequest) ProtoMessage()    {}
-func (*SearchDocumentsRequest) Descriptor() ([]byte, []int) {
+func (m *UpdateDocumentACLRequest) Reset()         { *m = UpdateDocumentACLRequest{} }
+func (m *UpdateDocumentACLRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDocumentACLRequest) ProtoMessage()    {}
+func (*UpdateDocumentACLRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7ef4cd0843a14163, []int{20}
 }
-func (m *SearchDocumentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateDocumentACLRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SearchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateDocumentACLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SearchDocumentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateDocumentACLRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1084,59 +1109,58 @@ func (m *SearchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *SearchDocumentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchDocumentsRequest.Merge(m, src)
+func (m *UpdateDocumentACLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDocumentACLRequest.Merge(m, src)
 }
-func (m *SearchDocumentsRequest) XXX_Size() int {
+func (m *UpdateDocumentACLRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SearchDocumentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchDocumentsRequest.DiscardUnknown(m)
+func (m *UpdateDocumentACLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDocumentACLRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchDocumentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateDocumentACLRequest proto.InternalMessageInfo
 
-func (m *SearchDocumentsRequest) GetProjectName() string {
+func (m *UpdateDocumentACLRequest) GetProjectName() string {
 	if m != nil {
 		return m.ProjectName
 	}
 	return ""
 }
 
-func (m *SearchDocumentsRequest) GetQuery() string {
+func (m *UpdateDocumentACLRequest) GetDocumentKey() string {
 	if m != nil {
-		return m.Query
+		return m.DocumentKey
 	}
 	return ""
 }
 
-func (m *SearchDocumentsRequest) GetPageSize() int32 {
+func (m *UpdateDocumentACLRequest) GetAcl() *DocumentACL {
 	if m != nil {
-		return m.PageSize
+		return m.Acl
 	}
-	return 0
+	return nil
 }
 
-type SearchDocumentsResponse struct {
-	TotalCount           int32              `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Documents            []*DocumentSummary `protobuf:"bytes,2,rep,name=documents,proto3" json:"documents,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type UpdateDocumentACLResponse struct {
+	Acl                  *DocumentACL `protobuf:"bytes,1,opt,name=acl,proto3" json:"acl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *SearchDocumentsResponse) Reset()         { *m = SearchDocumentsResponse{} }
-func (m *SearchDocumentsResponse) String() string { return proto.CompactTextString(m) }
-func (*SearchDocumentsResponse) ProtoMessage()    {}
-func (*SearchDocumentsResponse) Descriptor() ([]byte, []int) {
+func (m *UpdateDocumentACLResponse) Reset()         { *m = UpdateDocumentACLResponse{} }
+func (m *UpdateDocumentACLResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDocumentACLResponse) ProtoMessage()    {}
+func (*UpdateDocumentACLResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7ef4cd0843a14163, []int{21}
 }
-func (m *SearchDocumentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *UpdateDocumentACLResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SearchDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateDocumentACLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SearchDocumentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateDocumentACLResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1146,55 +1170,46 @@ func (m *SearchDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *SearchDocumentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchDocumentsResponse.Merge(m, src)
+func (m *UpdateDocumentACLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDocumentACLResponse.Merge(m, src)
 }
-func (m *SearchDocumentsResponse) XXX_Size() int {
+func (m *UpdateDocumentACLResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SearchDocumentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchDocumentsResponse.DiscardUnknown(m)
+func (m *UpdateDocumentACLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDocumentACLResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchDocumentsResponse proto.InternalMessageInfo
-
-func (m *SearchDocumentsResponse) GetTotalCount() int32 {
-	if m != nil {
-		return m.TotalCount
-	}
-	return 0
-}
+var xxx_messageInfo_UpdateDocumentACLResponse proto.InternalMessageInfo
 
-func (m *SearchDocumentsResponse) GetDocuments() []*DocumentSummary {
+func (m *UpdateDocumentACLResponse) GetAcl() *DocumentACL {
 	if m != nil {
-		return m.Documents
+		return m.Acl
 	}
 	return nil
 }
 
-type ListChangesRequest struct {
-	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
-	PreviousSeq          int64    `protobuf:"varint,3,opt,name=previous_seq,json=previousSeq,proto3" json:"previous_seq,omitempty"`
-	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	IsForward            bool     `protobuf:"varint,5,opt,name=is_forward,json=isForward,proto3" json:"is_forward,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type UpdateDocumentLabelsRequest struct {
+	ProjectName          string            `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string            `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ListChangesRequest) Reset()         { *m = ListChangesRequest{} }
-func (m *ListChangesRequest) String() string { return proto.CompactTextString(m) }
-func (*ListChangesRequest) ProtoMessage()    {}
-func (*ListChangesRequest) Descriptor() ([]byte, []int) {
+func (m *UpdateDocumentLabelsRequest) Reset()         { *m = UpdateDocumentLabelsRequest{} }
+func (m *UpdateDocumentLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDocumentLabelsRequest) ProtoMessage()    {}
+func (*UpdateDocumentLabelsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7ef4cd0843a14163, []int{22}
 }
-func (m *ListChangesRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateDocumentLabelsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateDocumentLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListChangesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateDocumentLabelsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1204,72 +1219,108 @@ func (m *ListChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ListChangesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListChangesRequest.Merge(m, src)
+func (m *UpdateDocumentLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDocumentLabelsRequest.Merge(m, src)
 }
-func (m *ListChangesRequest) XXX_Size() int {
+func (m *UpdateDocumentLabelsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListChangesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListChangesRequest.DiscardUnknown(m)
+func (m *UpdateDocumentLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDocumentLabelsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListChangesRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateDocumentLabelsRequest proto.InternalMessageInfo
 
-func (m *ListChangesRequest) GetProjectName() string {
+func (m *UpdateDocumentLabelsRequest) GetProjectName() string {
 	if m != nil {
 		return m.ProjectName
 	}
 	return ""
 }
 
-func (m *ListChangesRequest) GetDocumentKey() string {
+func (m *UpdateDocumentLabelsRequest) GetDocumentKey() string {
 	if m != nil {
 		return m.DocumentKey
 	}
 	return ""
 }
 
-func (m *ListChangesRequest) GetPreviousSeq() int64 {
+func (m *UpdateDocumentLabelsRequest) GetLabels() map[string]string {
 	if m != nil {
-		return m.PreviousSeq
+		return m.Labels
 	}
-	return 0
+	return nil
 }
 
-func (m *ListChangesRequest) GetPageSize() int32 {
-	if m != nil {
-		return m.PageSize
+type UpdateDocumentLabelsResponse struct {
+	Labels               map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateDocumentLabelsResponse) Reset()         { *m = UpdateDocumentLabelsResponse{} }
+func (m *UpdateDocumentLabelsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDocumentLabelsResponse) ProtoMessage()    {}
+func (*UpdateDocumentLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{23}
+}
+func (m *UpdateDocumentLabelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDocumentLabelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDocumentLabelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *UpdateDocumentLabelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDocumentLabelsResponse.Merge(m, src)
+}
+func (m *UpdateDocumentLabelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDocumentLabelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDocumentLabelsResponse.DiscardUnknown(m)
 }
 
-func (m *ListChangesRequest) GetIsForward() bool {
+var xxx_messageInfo_UpdateDocumentLabelsResponse proto.InternalMessageInfo
+
+func (m *UpdateDocumentLabelsResponse) GetLabels() map[string]string {
 	if m != nil {
-		return m.IsForward
+		return m.Labels
 	}
-	return false
+	return nil
 }
 
-type ListChangesResponse struct {
-	Changes              []*Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type UpdateDocumentSnapshotConfigRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	SnapshotThreshold    int64    `protobuf:"varint,3,opt,name=snapshot_threshold,json=snapshotThreshold,proto3" json:"snapshot_threshold,omitempty"`
+	SnapshotInterval     int64    `protobuf:"varint,4,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListChangesResponse) Reset()         { *m = ListChangesResponse{} }
-func (m *ListChangesResponse) String() string { return proto.CompactTextString(m) }
-func (*ListChangesResponse) ProtoMessage()    {}
-func (*ListChangesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ef4cd0843a14163, []int{23}
+func (m *UpdateDocumentSnapshotConfigRequest) Reset()         { *m = UpdateDocumentSnapshotConfigRequest{} }
+func (m *UpdateDocumentSnapshotConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDocumentSnapshotConfigRequest) ProtoMessage()    {}
+func (*UpdateDocumentSnapshotConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{24}
 }
-func (m *ListChangesResponse) XXX_Unmarshal(b []byte) error {
+func (m *UpdateDocumentSnapshotConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateDocumentSnapshotConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListChangesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateDocumentSnapshotConfigRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1279,2066 +1330,10916 @@ func (m *ListChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ListChangesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListChangesResponse.Merge(m, src)
+func (m *UpdateDocumentSnapshotConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDocumentSnapshotConfigRequest.Merge(m, src)
 }
-func (m *ListChangesResponse) XXX_Size() int {
+func (m *UpdateDocumentSnapshotConfigRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListChangesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListChangesResponse.DiscardUnknown(m)
+func (m *UpdateDocumentSnapshotConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDocumentSnapshotConfigRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListChangesResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateDocumentSnapshotConfigRequest proto.InternalMessageInfo
 
-func (m *ListChangesResponse) GetChanges() []*Change {
+func (m *UpdateDocumentSnapshotConfigRequest) GetProjectName() string {
 	if m != nil {
-		return m.Changes
+		return m.ProjectName
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*SignUpRequest)(nil), "yorkie.v1.SignUpRequest")
-	proto.RegisterType((*SignUpResponse)(nil), "yorkie.v1.SignUpResponse")
-	proto.RegisterType((*LogInRequest)(nil), "yorkie.v1.LogInRequest")
-	proto.RegisterType((*LogInResponse)(nil), "yorkie.v1.LogInResponse")
-	proto.RegisterType((*CreateProjectRequest)(nil), "yorkie.v1.CreateProjectRequest")
-	proto.RegisterType((*CreateProjectResponse)(nil), "yorkie.v1.CreateProjectResponse")
-	proto.RegisterType((*GetProjectRequest)(nil), "yorkie.v1.GetProjectRequest")
-	proto.RegisterType((*GetProjectResponse)(nil), "yorkie.v1.GetProjectResponse")
-	proto.RegisterType((*ListProjectsRequest)(nil), "yorkie.v1.ListProjectsRequest")
-	proto.RegisterType((*ListProjectsResponse)(nil), "yorkie.v1.ListProjectsResponse")
-	proto.RegisterType((*UpdateProjectRequest)(nil), "yorkie.v1.UpdateProjectRequest")
-	proto.RegisterType((*UpdateProjectResponse)(nil), "yorkie.v1.UpdateProjectResponse")
-	proto.RegisterType((*ListDocumentsRequest)(nil), "yorkie.v1.ListDocumentsRequest")
-	proto.RegisterType((*ListDocumentsResponse)(nil), "yorkie.v1.ListDocumentsResponse")
-	proto.RegisterType((*GetDocumentRequest)(nil), "yorkie.v1.GetDocumentRequest")
-	proto.RegisterType((*GetDocumentResponse)(nil), "yorkie.v1.GetDocumentResponse")
-	proto.RegisterType((*RemoveDocumentByAdminRequest)(nil), "yorkie.v1.RemoveDocumentByAdminRequest")
-	proto.RegisterType((*RemoveDocumentByAdminResponse)(nil), "yorkie.v1.RemoveDocumentByAdminResponse")
-	proto.RegisterType((*GetSnapshotMetaRequest)(nil), "yorkie.v1.GetSnapshotMetaRequest")
-	proto.RegisterType((*GetSnapshotMetaResponse)(nil), "yorkie.v1.GetSnapshotMetaResponse")
-	proto.RegisterType((*SearchDocumentsRequest)(nil), "yorkie.v1.SearchDocumentsRequest")
-	proto.RegisterType((*SearchDocumentsResponse)(nil), "yorkie.v1.SearchDocumentsResponse")
-	proto.RegisterType((*ListChangesRequest)(nil), "yorkie.v1.ListChangesRequest")
-	proto.RegisterType((*ListChangesResponse)(nil), "yorkie.v1.ListChangesResponse")
+func (m *UpdateDocumentSnapshotConfigRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("yorkie/v1/admin.proto", fileDescriptor_7ef4cd0843a14163) }
-
-var fileDescriptor_7ef4cd0843a14163 = []byte{
-	// 999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xae, 0xd3, 0xa4, 0x4d, 0x4e, 0xd2, 0x2d, 0x9d, 0x4d, 0xb6, 0x59, 0xd3, 0xe6, 0x67, 0xd0,
-	0x6a, 0x0b, 0x8b, 0xb2, 0xb4, 0x08, 0x04, 0x02, 0x09, 0x91, 0x42, 0xab, 0xd5, 0xfe, 0x68, 0xd7,
-	0xa1, 0x37, 0xbd, 0x89, 0xbc, 0xf1, 0x69, 0x6a, 0x9a, 0xd8, 0xce, 0x8c, 0x93, 0x55, 0x7a, 0xc7,
-	0x5b, 0xf0, 0x32, 0x5c, 0x70, 0xc7, 0x25, 0x8f, 0x80, 0xca, 0x33, 0x70, 0x8f, 0x6c, 0xcf, 0xb8,
-	0x63, 0xe7, 0x07, 0xda, 0xed, 0x5d, 0xe6, 0x9c, 0xef, 0x7c, 0xe7, 0x6f, 0xe6, 0x9c, 0x18, 0x2a,
-	0x53, 0x97, 0x5d, 0xd8, 0xf8, 0x74, 0xb2, 0xff, 0xd4, 0xb4, 0x86, 0xb6, 0xd3, 0xf2, 0x98, 0xeb,
-	0xbb, 0xa4, 0x10, 0x89, 0x5b, 0x93, 0x7d, 0xfd, 0xe1, 0x35, 0x82, 0x21, 0x77, 0xc7, 0xac, 0x87,
-	0x3c, 0x42, 0xd1, 0x63, 0xd8, 0xe8, 0xd8, 0x7d, 0xe7, 0xc4, 0x33, 0x70, 0x34, 0x46, 0xee, 0x13,
-	0x1d, 0xf2, 0x63, 0x8e, 0xcc, 0x31, 0x87, 0x58, 0xd5, 0x1a, 0xda, 0x5e, 0xc1, 0x88, 0xcf, 0x81,
-	0xce, 0x33, 0x39, 0x7f, 0xe7, 0x32, 0xab, 0x9a, 0x89, 0x74, 0xf2, 0x4c, 0xbf, 0x80, 0x7b, 0x92,
-	0x88, 0x7b, 0xae, 0xc3, 0x91, 0x7c, 0x04, 0xd9, 0xc0, 0x32, 0x64, 0x29, 0x1e, 0x6c, 0xb6, 0xe2,
-	0x78, 0x5a, 0x27, 0x1c, 0x99, 0x11, 0x2a, 0xe9, 0x11, 0x94, 0x5e, 0xb8, 0xfd, 0x67, 0xce, 0xfb,
-	0xba, 0x7f, 0x04, 0x1b, 0x82, 0x47, 0x78, 0x2f, 0x43, 0xce, 0x77, 0x2f, 0xd0, 0x11, 0x2c, 0xd1,
-	0x81, 0x7e, 0x02, 0xe5, 0x43, 0x86, 0xa6, 0x8f, 0xaf, 0x99, 0xfb, 0x33, 0xf6, 0x7c, 0xe9, 0x96,
-	0x40, 0x56, 0x71, 0x19, 0xfe, 0xa6, 0x3f, 0x42, 0x25, 0x85, 0x15, 0xd4, 0x9f, 0xc2, 0xba, 0x17,
-	0x89, 0x44, 0x6e, 0x44, 0xc9, 0x4d, 0x82, 0x25, 0x84, 0x3e, 0x86, 0xad, 0x63, 0xf4, 0xff, 0x87,
-	0xbf, 0x36, 0x10, 0x15, 0x78, 0x2b, 0x67, 0x15, 0xb8, 0xff, 0xc2, 0xe6, 0x92, 0x84, 0x0b, 0x77,
-	0xf4, 0x08, 0xca, 0x49, 0xb1, 0x20, 0x6f, 0x41, 0x5e, 0x58, 0xf2, 0xaa, 0xd6, 0x58, 0x5d, 0xc0,
-	0x1e, 0x63, 0xa8, 0x09, 0xe5, 0x13, 0xcf, 0x9a, 0x2d, 0xdf, 0x3d, 0xc8, 0xd8, 0x96, 0x48, 0x26,
-	0x63, 0x5b, 0xe4, 0x6b, 0x58, 0x3b, 0xb3, 0x71, 0x60, 0xf1, 0xb0, 0x4f, 0xc5, 0x83, 0xa6, 0xda,
-	0xfc, 0x80, 0xc0, 0x7c, 0x3b, 0x90, 0x1c, 0x47, 0x21, 0xd0, 0x10, 0x06, 0x41, 0xd5, 0x53, 0x2e,
-	0x6e, 0x55, 0x88, 0xdf, 0xb5, 0x28, 0xe5, 0x1f, 0xdc, 0xde, 0x78, 0x88, 0x4e, 0x5c, 0x0a, 0xd2,
-	0x84, 0x92, 0xc0, 0x74, 0x95, 0x0e, 0x14, 0x85, 0xec, 0x55, 0x70, 0xcf, 0xea, 0x50, 0xf4, 0x18,
-	0x4e, 0x6c, 0x77, 0xcc, 0xbb, 0xb6, 0xbc, 0x6a, 0x20, 0x45, 0xcf, 0x2c, 0xf2, 0x21, 0x14, 0x3c,
-	0xb3, 0x8f, 0x5d, 0x6e, 0x5f, 0x62, 0x75, 0xb5, 0xa1, 0xed, 0xe5, 0x82, 0x9b, 0xd8, 0xc7, 0x8e,
-	0x7d, 0x89, 0x64, 0x17, 0xc0, 0xe6, 0xdd, 0x33, 0x97, 0xbd, 0x33, 0x99, 0x55, 0xcd, 0x36, 0xb4,
-	0xbd, 0xbc, 0x51, 0xb0, 0xf9, 0x51, 0x24, 0x20, 0x1f, 0xc3, 0x07, 0xb6, 0xd3, 0x1b, 0x8c, 0x2d,
-	0xec, 0x72, 0xc7, 0xf4, 0xf8, 0xb9, 0xeb, 0x57, 0x73, 0x21, 0x68, 0x53, 0xc8, 0x3b, 0x42, 0x4c,
-	0xdf, 0x40, 0x25, 0x95, 0x82, 0x28, 0xc5, 0x57, 0x50, 0xb0, 0xa4, 0x50, 0xf4, 0x4d, 0x57, 0x8a,
-	0x21, 0x0d, 0x3a, 0xe3, 0xe1, 0xd0, 0x64, 0x53, 0xe3, 0x1a, 0x4c, 0x4f, 0xc3, 0x3b, 0x26, 0x01,
-	0x37, 0xa8, 0x49, 0x13, 0x4a, 0x92, 0xa5, 0x7b, 0x81, 0x53, 0x51, 0x94, 0xa2, 0x94, 0x3d, 0xc7,
-	0x29, 0x7d, 0x09, 0xf7, 0x13, 0xdc, 0x22, 0xd8, 0x2f, 0x21, 0x2f, 0x51, 0xa2, 0x71, 0xcb, 0x62,
-	0x8d, 0xb1, 0xf4, 0x12, 0x76, 0x0c, 0x1c, 0xba, 0x13, 0x94, 0x90, 0xf6, 0xf4, 0xfb, 0x60, 0xbc,
-	0xdd, 0x69, 0xd0, 0xc1, 0x98, 0x38, 0x73, 0x59, 0x2f, 0x6a, 0x63, 0xde, 0x88, 0x0e, 0xb4, 0x0e,
-	0xbb, 0x0b, 0x7c, 0x47, 0x49, 0xd1, 0x5f, 0x34, 0x78, 0x70, 0x8c, 0xbe, 0x6c, 0xd5, 0x4b, 0xf4,
-	0xcd, 0xbb, 0x8d, 0xab, 0x09, 0xc0, 0x91, 0x4d, 0x90, 0x75, 0x39, 0x8e, 0xc2, 0xe0, 0x56, 0xdb,
-	0x99, 0xcf, 0x34, 0xa3, 0x10, 0x49, 0x3b, 0x38, 0xa2, 0x1d, 0xd8, 0x9e, 0x09, 0x41, 0xd4, 0x5c,
-	0x87, 0x7c, 0x7c, 0xb9, 0x02, 0xff, 0x25, 0x23, 0x3e, 0x93, 0x1d, 0x58, 0x1f, 0x98, 0x43, 0xcf,
-	0x65, 0x7e, 0xe8, 0x37, 0xa2, 0x95, 0x22, 0xea, 0xc0, 0x83, 0x0e, 0x9a, 0xac, 0x77, 0x7e, 0x9b,
-	0x87, 0x53, 0x86, 0xdc, 0x68, 0x8c, 0x4c, 0x26, 0x14, 0x1d, 0x96, 0xbe, 0x16, 0xea, 0xc3, 0xf6,
-	0x8c, 0x3f, 0x91, 0x44, 0x1d, 0x8a, 0xbe, 0xeb, 0x9b, 0x83, 0x6e, 0xcf, 0x1d, 0x8b, 0xbb, 0x93,
-	0x33, 0x20, 0x14, 0x1d, 0x06, 0x92, 0xe4, 0x33, 0xc8, 0xdc, 0xe4, 0x19, 0xfc, 0xa6, 0x01, 0x09,
-	0x9e, 0xd6, 0xe1, 0xb9, 0xe9, 0xf4, 0x91, 0xdf, 0x6d, 0xeb, 0x1e, 0x05, 0x2c, 0x62, 0x7c, 0x24,
-	0x9b, 0x17, 0x8f, 0x95, 0x0e, 0x8e, 0x92, 0x65, 0xc9, 0x2e, 0x1d, 0x22, 0xb9, 0xd4, 0x10, 0xa1,
-	0xed, 0x68, 0xcc, 0xc7, 0xe1, 0x8b, 0x8a, 0x3d, 0x81, 0xf5, 0x5e, 0x24, 0x12, 0x53, 0x61, 0x4b,
-	0x29, 0x47, 0x04, 0x36, 0x24, 0xe2, 0xe0, 0x9f, 0x75, 0x28, 0x85, 0x97, 0xba, 0x83, 0x6c, 0x62,
-	0xf7, 0x90, 0x7c, 0x07, 0x6b, 0xd1, 0x06, 0x27, 0x55, 0xc5, 0x2c, 0xf1, 0xef, 0x40, 0x7f, 0x38,
-	0x47, 0x23, 0x9e, 0xc4, 0x0a, 0xf9, 0x16, 0x72, 0xe1, 0x0e, 0x26, 0xdb, 0x0a, 0x4a, 0xdd, 0xee,
-	0x7a, 0x75, 0x56, 0x11, 0x5b, 0xff, 0x04, 0x1b, 0x89, 0x75, 0x4b, 0xea, 0x6a, 0xf0, 0x73, 0x96,
-	0xb6, 0xde, 0x58, 0x0c, 0x88, 0x59, 0xdf, 0x40, 0x49, 0xdd, 0x7c, 0xa4, 0xa6, 0x46, 0x30, 0xbb,
-	0x29, 0xf5, 0xfa, 0x42, 0x7d, 0x4c, 0xf9, 0x1c, 0xe0, 0x7a, 0x4f, 0x93, 0x1d, 0xc5, 0x60, 0x66,
-	0xcf, 0xeb, 0xbb, 0x0b, 0xb4, 0x6a, 0xd6, 0x89, 0x75, 0x97, 0xc8, 0x7a, 0xde, 0xae, 0x4d, 0x64,
-	0x3d, 0x77, 0x53, 0x46, 0xac, 0x89, 0xcd, 0x41, 0xd2, 0x69, 0xa5, 0x5f, 0x77, 0x82, 0x75, 0xee,
-	0xd2, 0xa1, 0x2b, 0xe4, 0x15, 0x14, 0x95, 0x01, 0x4f, 0x52, 0xb9, 0xa5, 0x96, 0x8a, 0x5e, 0x5b,
-	0xa4, 0x8e, 0xf9, 0x06, 0x50, 0x99, 0x3b, 0x65, 0xc9, 0x63, 0xc5, 0x74, 0xd9, 0x0e, 0xd0, 0xf7,
-	0xfe, 0x1b, 0x18, 0x7b, 0x3b, 0x85, 0xcd, 0xd4, 0xb8, 0x24, 0xcd, 0x64, 0x88, 0x73, 0xa6, 0xb9,
-	0x4e, 0x97, 0x41, 0x54, 0xee, 0xd4, 0x14, 0x4b, 0x70, 0xcf, 0x9f, 0xa8, 0x09, 0xee, 0x05, 0x43,
-	0x30, 0xaa, 0xba, 0xf2, 0xd6, 0x13, 0x55, 0x9f, 0x1d, 0x61, 0x7a, 0x6d, 0x91, 0x5a, 0xf2, 0xb5,
-	0x9f, 0xfc, 0x71, 0x55, 0xd3, 0xfe, 0xbc, 0xaa, 0x69, 0x7f, 0x5d, 0xd5, 0xb4, 0x5f, 0xff, 0xae,
-	0xad, 0xc0, 0x96, 0x85, 0x13, 0x69, 0x66, 0x7a, 0x76, 0x6b, 0xb2, 0xff, 0x5a, 0x3b, 0xcd, 0xb6,
-	0xbe, 0x99, 0xec, 0xbf, 0x5d, 0x0b, 0xbf, 0x12, 0x3e, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x95, 0x51, 0xe5, 0x64, 0x0c, 0x00, 0x00,
+func (m *UpdateDocumentSnapshotConfigRequest) GetSnapshotThreshold() int64 {
+	if m != nil {
+		return m.SnapshotThreshold
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// AdminServiceClient is the client API for AdminService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AdminServiceClient interface {
-	SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error)
-	LogIn(ctx context.Context, in *LogInRequest, opts ...grpc.CallOption) (*LogInResponse, error)
-	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
-	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
-	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
-	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
-	ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error)
-	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error)
-	RemoveDocumentByAdmin(ctx context.Context, in *RemoveDocumentByAdminRequest, opts ...grpc.CallOption) (*RemoveDocumentByAdminResponse, error)
-	GetSnapshotMeta(ctx context.Context, in *GetSnapshotMetaRequest, opts ...grpc.CallOption) (*GetSnapshotMetaResponse, error)
-	SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsResponse, error)
-	ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error)
+func (m *UpdateDocumentSnapshotConfigRequest) GetSnapshotInterval() int64 {
+	if m != nil {
+		return m.SnapshotInterval
+	}
+	return 0
 }
 
-type adminServiceClient struct {
-	cc *grpc.ClientConn
+type UpdateDocumentSnapshotConfigResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
-	return &adminServiceClient{cc}
+func (m *UpdateDocumentSnapshotConfigResponse) Reset()         { *m = UpdateDocumentSnapshotConfigResponse{} }
+func (m *UpdateDocumentSnapshotConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDocumentSnapshotConfigResponse) ProtoMessage()    {}
+func (*UpdateDocumentSnapshotConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{25}
 }
-
-func (c *adminServiceClient) SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error) {
-	out := new(SignUpResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/SignUp", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateDocumentSnapshotConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *adminServiceClient) LogIn(ctx context.Context, in *LogInRequest, opts ...grpc.CallOption) (*LogInResponse, error) {
-	out := new(LogInResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/LogIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateDocumentSnapshotConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDocumentSnapshotConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *adminServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
-	out := new(CreateProjectResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/CreateProject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateDocumentSnapshotConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDocumentSnapshotConfigResponse.Merge(m, src)
 }
-
-func (c *adminServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
-	out := new(ListProjectsResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ListProjects", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateDocumentSnapshotConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDocumentSnapshotConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDocumentSnapshotConfigResponse.DiscardUnknown(m)
 }
 
-func (c *adminServiceClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
-	out := new(GetProjectResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/GetProject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_UpdateDocumentSnapshotConfigResponse proto.InternalMessageInfo
+
+type GetSnapshotMetaRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	ServerSeq            int64    `protobuf:"varint,3,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *adminServiceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
-	out := new(UpdateProjectResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/UpdateProject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetSnapshotMetaRequest) Reset()         { *m = GetSnapshotMetaRequest{} }
+func (m *GetSnapshotMetaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSnapshotMetaRequest) ProtoMessage()    {}
+func (*GetSnapshotMetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{26}
+}
+func (m *GetSnapshotMetaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSnapshotMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSnapshotMetaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetSnapshotMetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSnapshotMetaRequest.Merge(m, src)
+}
+func (m *GetSnapshotMetaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSnapshotMetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSnapshotMetaRequest.DiscardUnknown(m)
 }
 
-func (c *adminServiceClient) ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error) {
-	out := new(ListDocumentsResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ListDocuments", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetSnapshotMetaRequest proto.InternalMessageInfo
+
+func (m *GetSnapshotMetaRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminServiceClient) GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error) {
-	out := new(GetDocumentResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/GetDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetSnapshotMetaRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminServiceClient) RemoveDocumentByAdmin(ctx context.Context, in *RemoveDocumentByAdminRequest, opts ...grpc.CallOption) (*RemoveDocumentByAdminResponse, error) {
-	out := new(RemoveDocumentByAdminResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/RemoveDocumentByAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetSnapshotMetaRequest) GetServerSeq() int64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	return out, nil
+	return 0
 }
 
-func (c *adminServiceClient) GetSnapshotMeta(ctx context.Context, in *GetSnapshotMetaRequest, opts ...grpc.CallOption) (*GetSnapshotMetaResponse, error) {
-	out := new(GetSnapshotMetaResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/GetSnapshotMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetSnapshotMetaResponse struct {
+	Snapshot             []byte   `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Lamport              int64    `protobuf:"varint,2,opt,name=lamport,proto3" json:"lamport,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *adminServiceClient) SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsResponse, error) {
-	out := new(SearchDocumentsResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/SearchDocuments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetSnapshotMetaResponse) Reset()         { *m = GetSnapshotMetaResponse{} }
+func (m *GetSnapshotMetaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSnapshotMetaResponse) ProtoMessage()    {}
+func (*GetSnapshotMetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{27}
+}
+func (m *GetSnapshotMetaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSnapshotMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSnapshotMetaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetSnapshotMetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSnapshotMetaResponse.Merge(m, src)
+}
+func (m *GetSnapshotMetaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSnapshotMetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSnapshotMetaResponse.DiscardUnknown(m)
 }
 
-func (c *adminServiceClient) ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error) {
-	out := new(ListChangesResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ListChanges", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetSnapshotMetaResponse proto.InternalMessageInfo
+
+func (m *GetSnapshotMetaResponse) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
 	}
-	return out, nil
+	return nil
 }
 
-// AdminServiceServer is the server API for AdminService service.
-type AdminServiceServer interface {
-	SignUp(context.Context, *SignUpRequest) (*SignUpResponse, error)
-	LogIn(context.Context, *LogInRequest) (*LogInResponse, error)
-	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
-	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
-	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
-	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
-	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
-	GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error)
-	RemoveDocumentByAdmin(context.Context, *RemoveDocumentByAdminRequest) (*RemoveDocumentByAdminResponse, error)
-	GetSnapshotMeta(context.Context, *GetSnapshotMetaRequest) (*GetSnapshotMetaResponse, error)
-	SearchDocuments(context.Context, *SearchDocumentsRequest) (*SearchDocumentsResponse, error)
-	ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error)
+func (m *GetSnapshotMetaResponse) GetLamport() int64 {
+	if m != nil {
+		return m.Lamport
+	}
+	return 0
 }
 
-// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAdminServiceServer struct {
+type SearchDocumentsRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Query                string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedAdminServiceServer) SignUp(ctx context.Context, req *SignUpRequest) (*SignUpResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignUp not implemented")
-}
-func (*UnimplementedAdminServiceServer) LogIn(ctx context.Context, req *LogInRequest) (*LogInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogIn not implemented")
+func (m *SearchDocumentsRequest) Reset()         { *m = SearchDocumentsRequest{} }
+func (m *SearchDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchDocumentsRequest) ProtoMessage()    {}
+func (*SearchDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{28}
 }
-func (*UnimplementedAdminServiceServer) CreateProject(ctx context.Context, req *CreateProjectRequest) (*CreateProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+func (m *SearchDocumentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAdminServiceServer) ListProjects(ctx context.Context, req *ListProjectsRequest) (*ListProjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
-}
-func (*UnimplementedAdminServiceServer) GetProject(ctx context.Context, req *GetProjectRequest) (*GetProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
-}
-func (*UnimplementedAdminServiceServer) UpdateProject(ctx context.Context, req *UpdateProjectRequest) (*UpdateProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+func (m *SearchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchDocumentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAdminServiceServer) ListDocuments(ctx context.Context, req *ListDocumentsRequest) (*ListDocumentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDocuments not implemented")
+func (m *SearchDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchDocumentsRequest.Merge(m, src)
 }
-func (*UnimplementedAdminServiceServer) GetDocument(ctx context.Context, req *GetDocumentRequest) (*GetDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDocument not implemented")
+func (m *SearchDocumentsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAdminServiceServer) RemoveDocumentByAdmin(ctx context.Context, req *RemoveDocumentByAdminRequest) (*RemoveDocumentByAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveDocumentByAdmin not implemented")
+func (m *SearchDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchDocumentsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedAdminServiceServer) GetSnapshotMeta(ctx context.Context, req *GetSnapshotMetaRequest) (*GetSnapshotMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshotMeta not implemented")
+
+var xxx_messageInfo_SearchDocumentsRequest proto.InternalMessageInfo
+
+func (m *SearchDocumentsRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
+	}
+	return ""
 }
-func (*UnimplementedAdminServiceServer) SearchDocuments(ctx context.Context, req *SearchDocumentsRequest) (*SearchDocumentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchDocuments not implemented")
+
+func (m *SearchDocumentsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
 }
-func (*UnimplementedAdminServiceServer) ListChanges(ctx context.Context, req *ListChangesRequest) (*ListChangesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListChanges not implemented")
+
+func (m *SearchDocumentsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
-	s.RegisterService(&_AdminService_serviceDesc, srv)
+type SearchDocumentsResponse struct {
+	TotalCount           int32              `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Documents            []*DocumentSummary `protobuf:"bytes,2,rep,name=documents,proto3" json:"documents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func _AdminService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignUpRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).SignUp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/SignUp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).SignUp(ctx, req.(*SignUpRequest))
+func (m *SearchDocumentsResponse) Reset()         { *m = SearchDocumentsResponse{} }
+func (m *SearchDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchDocumentsResponse) ProtoMessage()    {}
+func (*SearchDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{29}
+}
+func (m *SearchDocumentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchDocumentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *SearchDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchDocumentsResponse.Merge(m, src)
+}
+func (m *SearchDocumentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchDocumentsResponse.DiscardUnknown(m)
 }
 
-func _AdminService_LogIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).LogIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/LogIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).LogIn(ctx, req.(*LogInRequest))
+var xxx_messageInfo_SearchDocumentsResponse proto.InternalMessageInfo
+
+func (m *SearchDocumentsResponse) GetTotalCount() int32 {
+	if m != nil {
+		return m.TotalCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AdminService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).CreateProject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/CreateProject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+func (m *SearchDocumentsResponse) GetDocuments() []*DocumentSummary {
+	if m != nil {
+		return m.Documents
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AdminService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).ListProjects(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/ListProjects",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ListChangesRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	PreviousSeq          int64    `protobuf:"varint,3,opt,name=previous_seq,json=previousSeq,proto3" json:"previous_seq,omitempty"`
+	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IsForward            bool     `protobuf:"varint,5,opt,name=is_forward,json=isForward,proto3" json:"is_forward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AdminService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).GetProject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/GetProject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+func (m *ListChangesRequest) Reset()         { *m = ListChangesRequest{} }
+func (m *ListChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChangesRequest) ProtoMessage()    {}
+func (*ListChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{30}
+}
+func (m *ListChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ListChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChangesRequest.Merge(m, src)
+}
+func (m *ListChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChangesRequest.DiscardUnknown(m)
 }
 
-func _AdminService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ListChangesRequest proto.InternalMessageInfo
+
+func (m *ListChangesRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).UpdateProject(ctx, in)
+	return ""
+}
+
+func (m *ListChangesRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/UpdateProject",
+	return ""
+}
+
+func (m *ListChangesRequest) GetPreviousSeq() int64 {
+	if m != nil {
+		return m.PreviousSeq
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	return 0
+}
+
+func (m *ListChangesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AdminService_ListDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListDocumentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ListChangesRequest) GetIsForward() bool {
+	if m != nil {
+		return m.IsForward
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).ListDocuments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/ListDocuments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListDocuments(ctx, req.(*ListDocumentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AdminService_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).GetDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/GetDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetDocument(ctx, req.(*GetDocumentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ListChangesResponse struct {
+	Changes              []*Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func _AdminService_RemoveDocumentByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveDocumentByAdminRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).RemoveDocumentByAdmin(ctx, in)
+func (m *ListChangesResponse) Reset()         { *m = ListChangesResponse{} }
+func (m *ListChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChangesResponse) ProtoMessage()    {}
+func (*ListChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{31}
+}
+func (m *ListChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/RemoveDocumentByAdmin",
+}
+func (m *ListChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChangesResponse.Merge(m, src)
+}
+func (m *ListChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChangesResponse proto.InternalMessageInfo
+
+func (m *ListChangesResponse) GetChanges() []*Change {
+	if m != nil {
+		return m.Changes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).RemoveDocumentByAdmin(ctx, req.(*RemoveDocumentByAdminRequest))
+	return nil
+}
+
+type VerifyDocumentRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyDocumentRequest) Reset()         { *m = VerifyDocumentRequest{} }
+func (m *VerifyDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyDocumentRequest) ProtoMessage()    {}
+func (*VerifyDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{32}
+}
+func (m *VerifyDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *VerifyDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDocumentRequest.Merge(m, src)
+}
+func (m *VerifyDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDocumentRequest.DiscardUnknown(m)
 }
 
-func _AdminService_GetSnapshotMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSnapshotMetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_VerifyDocumentRequest proto.InternalMessageInfo
+
+func (m *VerifyDocumentRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).GetSnapshotMeta(ctx, in)
+	return ""
+}
+
+func (m *VerifyDocumentRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/GetSnapshotMeta",
+	return ""
+}
+
+type VerifyDocumentResponse struct {
+	SnapshotServerSeq    int64    `protobuf:"varint,1,opt,name=snapshot_server_seq,json=snapshotServerSeq,proto3" json:"snapshot_server_seq,omitempty"`
+	SnapshotHash         string   `protobuf:"bytes,2,opt,name=snapshot_hash,json=snapshotHash,proto3" json:"snapshot_hash,omitempty"`
+	RebuiltHash          string   `protobuf:"bytes,3,opt,name=rebuilt_hash,json=rebuiltHash,proto3" json:"rebuilt_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyDocumentResponse) Reset()         { *m = VerifyDocumentResponse{} }
+func (m *VerifyDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyDocumentResponse) ProtoMessage()    {}
+func (*VerifyDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{33}
+}
+func (m *VerifyDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetSnapshotMeta(ctx, req.(*GetSnapshotMetaRequest))
+}
+func (m *VerifyDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDocumentResponse.Merge(m, src)
+}
+func (m *VerifyDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDocumentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyDocumentResponse proto.InternalMessageInfo
+
+func (m *VerifyDocumentResponse) GetSnapshotServerSeq() int64 {
+	if m != nil {
+		return m.SnapshotServerSeq
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AdminService_SearchDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchDocumentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *VerifyDocumentResponse) GetSnapshotHash() string {
+	if m != nil {
+		return m.SnapshotHash
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).SearchDocuments(ctx, in)
+	return ""
+}
+
+func (m *VerifyDocumentResponse) GetRebuiltHash() string {
+	if m != nil {
+		return m.RebuiltHash
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/SearchDocuments",
+	return ""
+}
+
+type RestoreDocumentRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	ServerSeq            int64    `protobuf:"varint,3,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreDocumentRequest) Reset()         { *m = RestoreDocumentRequest{} }
+func (m *RestoreDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreDocumentRequest) ProtoMessage()    {}
+func (*RestoreDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{34}
+}
+func (m *RestoreDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).SearchDocuments(ctx, req.(*SearchDocumentsRequest))
+}
+func (m *RestoreDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreDocumentRequest.Merge(m, src)
+}
+func (m *RestoreDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreDocumentRequest proto.InternalMessageInfo
+
+func (m *RestoreDocumentRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminService_ListChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListChangesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RestoreDocumentRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).ListChanges(ctx, in)
+	return ""
+}
+
+func (m *RestoreDocumentRequest) GetServerSeq() int64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.AdminService/ListChanges",
+	return 0
+}
+
+type RestoreDocumentResponse struct {
+	ServerSeq            int64    `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreDocumentResponse) Reset()         { *m = RestoreDocumentResponse{} }
+func (m *RestoreDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreDocumentResponse) ProtoMessage()    {}
+func (*RestoreDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{35}
+}
+func (m *RestoreDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListChanges(ctx, req.(*ListChangesRequest))
+}
+func (m *RestoreDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreDocumentResponse.Merge(m, src)
+}
+func (m *RestoreDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreDocumentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreDocumentResponse proto.InternalMessageInfo
+
+func (m *RestoreDocumentResponse) GetServerSeq() int64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _AdminService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "yorkie.v1.AdminService",
-	HandlerType: (*AdminServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SignUp",
-			Handler:    _AdminService_SignUp_Handler,
-		},
-		{
-			MethodName: "LogIn",
-			Handler:    _AdminService_LogIn_Handler,
-		},
-		{
-			MethodName: "CreateProject",
-			Handler:    _AdminService_CreateProject_Handler,
-		},
-		{
-			MethodName: "ListProjects",
-			Handler:    _AdminService_ListProjects_Handler,
-		},
-		{
-			MethodName: "GetProject",
-			Handler:    _AdminService_GetProject_Handler,
-		},
-		{
-			MethodName: "UpdateProject",
-			Handler:    _AdminService_UpdateProject_Handler,
-		},
-		{
-			MethodName: "ListDocuments",
-			Handler:    _AdminService_ListDocuments_Handler,
-		},
-		{
-			MethodName: "GetDocument",
-			Handler:    _AdminService_GetDocument_Handler,
-		},
-		{
-			MethodName: "RemoveDocumentByAdmin",
-			Handler:    _AdminService_RemoveDocumentByAdmin_Handler,
-		},
-		{
-			MethodName: "GetSnapshotMeta",
-			Handler:    _AdminService_GetSnapshotMeta_Handler,
-		},
-		{
-			MethodName: "SearchDocuments",
-			Handler:    _AdminService_SearchDocuments_Handler,
-		},
-		{
-			MethodName: "ListChanges",
-			Handler:    _AdminService_ListChanges_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "yorkie/v1/admin.proto",
+type EvictDocumentRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignUpRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EvictDocumentRequest) Reset()         { *m = EvictDocumentRequest{} }
+func (m *EvictDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*EvictDocumentRequest) ProtoMessage()    {}
+func (*EvictDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{36}
+}
+func (m *EvictDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *SignUpRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EvictDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictDocumentRequest.Merge(m, src)
+}
+func (m *EvictDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictDocumentRequest.DiscardUnknown(m)
 }
 
-func (m *SignUpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EvictDocumentRequest proto.InternalMessageInfo
+
+func (m *EvictDocumentRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EvictDocumentRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SignUpResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EvictDocumentRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SignUpResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EvictDocumentResponse struct {
+	ClientIds            []string `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.User != nil {
-		{
-			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+func (m *EvictDocumentResponse) Reset()         { *m = EvictDocumentResponse{} }
+func (m *EvictDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*EvictDocumentResponse) ProtoMessage()    {}
+func (*EvictDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{37}
+}
+func (m *EvictDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EvictDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictDocumentResponse.Merge(m, src)
+}
+func (m *EvictDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictDocumentResponse.DiscardUnknown(m)
 }
 
-func (m *LogInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EvictDocumentResponse proto.InternalMessageInfo
+
+func (m *EvictDocumentResponse) GetClientIds() []string {
+	if m != nil {
+		return m.ClientIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LogInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GCDocumentRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LogInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *GCDocumentRequest) Reset()         { *m = GCDocumentRequest{} }
+func (m *GCDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*GCDocumentRequest) ProtoMessage()    {}
+func (*GCDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{38}
 }
-
-func (m *LogInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GCDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GCDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GCDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *LogInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GCDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCDocumentRequest.Merge(m, src)
+}
+func (m *GCDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GCDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCDocumentRequest.DiscardUnknown(m)
 }
 
-func (m *LogInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_GCDocumentRequest proto.InternalMessageInfo
+
+func (m *GCDocumentRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CreateProjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GCDocumentRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateProjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GCDocumentResponse struct {
+	RemovedElements      int32    `protobuf:"varint,1,opt,name=removed_elements,json=removedElements,proto3" json:"removed_elements,omitempty"`
+	ServerSeq            int64    `protobuf:"varint,2,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GCDocumentResponse) Reset()         { *m = GCDocumentResponse{} }
+func (m *GCDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*GCDocumentResponse) ProtoMessage()    {}
+func (*GCDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{39}
+}
+func (m *GCDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GCDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GCDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GCDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCDocumentResponse.Merge(m, src)
+}
+func (m *GCDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GCDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCDocumentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCDocumentResponse proto.InternalMessageInfo
+
+func (m *GCDocumentResponse) GetRemovedElements() int32 {
+	if m != nil {
+		return m.RemovedElements
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CreateProjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GCDocumentResponse) GetServerSeq() int64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CreateProjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExportDocumentRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	IncludeChanges       bool     `protobuf:"varint,3,opt,name=include_changes,json=includeChanges,proto3" json:"include_changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Project != nil {
-		{
-			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+func (m *ExportDocumentRequest) Reset()         { *m = ExportDocumentRequest{} }
+func (m *ExportDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportDocumentRequest) ProtoMessage()    {}
+func (*ExportDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{40}
+}
+func (m *ExportDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetProjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ExportDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDocumentRequest.Merge(m, src)
 }
-
-func (m *GetProjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExportDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDocumentRequest.DiscardUnknown(m)
 }
 
-func (m *GetProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ExportDocumentRequest proto.InternalMessageInfo
+
+func (m *ExportDocumentRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ExportDocumentRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetProjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExportDocumentRequest) GetIncludeChanges() bool {
+	if m != nil {
+		return m.IncludeChanges
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GetProjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExportDocumentResponse struct {
+	Export               *DocumentExport `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Project != nil {
-		{
-			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+func (m *ExportDocumentResponse) Reset()         { *m = ExportDocumentResponse{} }
+func (m *ExportDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportDocumentResponse) ProtoMessage()    {}
+func (*ExportDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{41}
+}
+func (m *ExportDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExportDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDocumentResponse.Merge(m, src)
+}
+func (m *ExportDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDocumentResponse.DiscardUnknown(m)
 }
 
-func (m *ListProjectsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExportDocumentResponse proto.InternalMessageInfo
+
+func (m *ExportDocumentResponse) GetExport() *DocumentExport {
+	if m != nil {
+		return m.Export
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListProjectsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ImportDocumentRequest struct {
+	ProjectName          string          `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	DocumentKey          string          `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Export               *DocumentExport `protobuf:"bytes,3,opt,name=export,proto3" json:"export,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ListProjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ImportDocumentRequest) Reset()         { *m = ImportDocumentRequest{} }
+func (m *ImportDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportDocumentRequest) ProtoMessage()    {}
+func (*ImportDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{42}
+}
+func (m *ImportDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ImportDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDocumentRequest.Merge(m, src)
+}
+func (m *ImportDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDocumentRequest.DiscardUnknown(m)
 }
 
-func (m *ListProjectsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImportDocumentRequest proto.InternalMessageInfo
+
+func (m *ImportDocumentRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListProjectsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ImportDocumentRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
+	}
+	return ""
 }
 
-func (m *ListProjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Projects) > 0 {
-		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ImportDocumentRequest) GetExport() *DocumentExport {
+	if m != nil {
+		return m.Export
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *UpdateProjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ImportDocumentResponse struct {
+	ServerSeq            int64    `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateProjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ImportDocumentResponse) Reset()         { *m = ImportDocumentResponse{} }
+func (m *ImportDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportDocumentResponse) ProtoMessage()    {}
+func (*ImportDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{43}
 }
-
-func (m *UpdateProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Fields != nil {
-		{
-			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+func (m *ImportDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ImportDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDocumentResponse.Merge(m, src)
+}
+func (m *ImportDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDocumentResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateProjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImportDocumentResponse proto.InternalMessageInfo
+
+func (m *ImportDocumentResponse) GetServerSeq() int64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UpdateProjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BackupProjectRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	IncludeChanges       bool     `protobuf:"varint,2,opt,name=include_changes,json=includeChanges,proto3" json:"include_changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Project != nil {
-		{
-			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+func (m *BackupProjectRequest) Reset()         { *m = BackupProjectRequest{} }
+func (m *BackupProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupProjectRequest) ProtoMessage()    {}
+func (*BackupProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{44}
+}
+func (m *BackupProjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupProjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BackupProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupProjectRequest.Merge(m, src)
+}
+func (m *BackupProjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupProjectRequest.DiscardUnknown(m)
 }
 
-func (m *ListDocumentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BackupProjectRequest proto.InternalMessageInfo
+
+func (m *BackupProjectRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BackupProjectRequest) GetIncludeChanges() bool {
+	if m != nil {
+		return m.IncludeChanges
+	}
+	return false
 }
 
-func (m *ListDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IncludeSnapshot {
-		i--
-		if m.IncludeSnapshot {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.IsForward {
-		i--
-		if m.IsForward {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// BackupProjectResponse is a chunk of the tar archive of the backup.
+type BackupProjectResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupProjectResponse) Reset()         { *m = BackupProjectResponse{} }
+func (m *BackupProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupProjectResponse) ProtoMessage()    {}
+func (*BackupProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{45}
+}
+func (m *BackupProjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupProjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.PreviousId) > 0 {
-		i -= len(m.PreviousId)
-		copy(dAtA[i:], m.PreviousId)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PreviousId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProjectName) > 0 {
-		i -= len(m.ProjectName)
-		copy(dAtA[i:], m.ProjectName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BackupProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupProjectResponse.Merge(m, src)
+}
+func (m *BackupProjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupProjectResponse.DiscardUnknown(m)
 }
 
-func (m *ListDocumentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BackupProjectResponse proto.InternalMessageInfo
+
+func (m *BackupProjectResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListDocumentMemoriesRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Documents) > 0 {
-		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ListDocumentMemoriesRequest) Reset()         { *m = ListDocumentMemoriesRequest{} }
+func (m *ListDocumentMemoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDocumentMemoriesRequest) ProtoMessage()    {}
+func (*ListDocumentMemoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{46}
+}
+func (m *ListDocumentMemoriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDocumentMemoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDocumentMemoriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListDocumentMemoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDocumentMemoriesRequest.Merge(m, src)
+}
+func (m *ListDocumentMemoriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDocumentMemoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDocumentMemoriesRequest.DiscardUnknown(m)
 }
 
-func (m *GetDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListDocumentMemoriesRequest proto.InternalMessageInfo
+
+func (m *ListDocumentMemoriesRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListDocumentMemoriesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *GetDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DocumentKey) > 0 {
-		i -= len(m.DocumentKey)
-		copy(dAtA[i:], m.DocumentKey)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProjectName) > 0 {
-		i -= len(m.ProjectName)
-		copy(dAtA[i:], m.ProjectName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type ListDocumentMemoriesResponse struct {
+	Documents            []*DocumentMemory `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ListDocumentMemoriesResponse) Reset()         { *m = ListDocumentMemoriesResponse{} }
+func (m *ListDocumentMemoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDocumentMemoriesResponse) ProtoMessage()    {}
+func (*ListDocumentMemoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{47}
 }
-
-func (m *GetDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListDocumentMemoriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Document != nil {
-		{
-			size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+func (m *ListDocumentMemoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDocumentMemoriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListDocumentMemoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDocumentMemoriesResponse.Merge(m, src)
+}
+func (m *ListDocumentMemoriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDocumentMemoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDocumentMemoriesResponse.DiscardUnknown(m)
 }
 
-func (m *RemoveDocumentByAdminRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListDocumentMemoriesResponse proto.InternalMessageInfo
+
+func (m *ListDocumentMemoriesResponse) GetDocuments() []*DocumentMemory {
+	if m != nil {
+		return m.Documents
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RemoveDocumentByAdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListClientsRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	PreviousId           string   `protobuf:"bytes,2,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IsForward            bool     `protobuf:"varint,4,opt,name=is_forward,json=isForward,proto3" json:"is_forward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveDocumentByAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ListClientsRequest) Reset()         { *m = ListClientsRequest{} }
+func (m *ListClientsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListClientsRequest) ProtoMessage()    {}
+func (*ListClientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{48}
+}
+func (m *ListClientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListClientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListClientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.DocumentKey) > 0 {
-		i -= len(m.DocumentKey)
-		copy(dAtA[i:], m.DocumentKey)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProjectName) > 0 {
-		i -= len(m.ProjectName)
-		copy(dAtA[i:], m.ProjectName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListClientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClientsRequest.Merge(m, src)
+}
+func (m *ListClientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListClientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClientsRequest.DiscardUnknown(m)
 }
 
-func (m *RemoveDocumentByAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListClientsRequest proto.InternalMessageInfo
+
+func (m *ListClientsRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RemoveDocumentByAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListClientsRequest) GetPreviousId() string {
+	if m != nil {
+		return m.PreviousId
+	}
+	return ""
 }
 
-func (m *RemoveDocumentByAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ListClientsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetSnapshotMetaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListClientsRequest) GetIsForward() bool {
+	if m != nil {
+		return m.IsForward
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GetSnapshotMetaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListClientsResponse struct {
+	Clients              []*ClientSummary `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetSnapshotMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ServerSeq != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.ServerSeq))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.DocumentKey) > 0 {
-		i -= len(m.DocumentKey)
-		copy(dAtA[i:], m.DocumentKey)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProjectName) > 0 {
-		i -= len(m.ProjectName)
-		copy(dAtA[i:], m.ProjectName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
-		i--
-		dAtA[i] = 0xa
+func (m *ListClientsResponse) Reset()         { *m = ListClientsResponse{} }
+func (m *ListClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListClientsResponse) ProtoMessage()    {}
+func (*ListClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{49}
+}
+func (m *ListClientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListClientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClientsResponse.Merge(m, src)
+}
+func (m *ListClientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClientsResponse.DiscardUnknown(m)
 }
 
-func (m *GetSnapshotMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListClientsResponse proto.InternalMessageInfo
+
+func (m *ListClientsResponse) GetClients() []*ClientSummary {
+	if m != nil {
+		return m.Clients
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetSnapshotMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RegisterDocumentTemplateRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Collection           string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Root                 string   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSnapshotMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Lamport != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Lamport))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Snapshot) > 0 {
-		i -= len(m.Snapshot)
-		copy(dAtA[i:], m.Snapshot)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Snapshot)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *RegisterDocumentTemplateRequest) Reset()         { *m = RegisterDocumentTemplateRequest{} }
+func (m *RegisterDocumentTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterDocumentTemplateRequest) ProtoMessage()    {}
+func (*RegisterDocumentTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{50}
 }
-
-func (m *SearchDocumentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RegisterDocumentTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterDocumentTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterDocumentTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *SearchDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RegisterDocumentTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterDocumentTemplateRequest.Merge(m, src)
+}
+func (m *RegisterDocumentTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterDocumentTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterDocumentTemplateRequest.DiscardUnknown(m)
 }
 
-func (m *SearchDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Query)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_RegisterDocumentTemplateRequest proto.InternalMessageInfo
+
+func (m *RegisterDocumentTemplateRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	if len(m.ProjectName) > 0 {
-		i -= len(m.ProjectName)
-		copy(dAtA[i:], m.ProjectName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *RegisterDocumentTemplateRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SearchDocumentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RegisterDocumentTemplateRequest) GetRoot() string {
+	if m != nil {
+		return m.Root
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SearchDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RegisterDocumentTemplateResponse struct {
+	Template             *DocumentTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *SearchDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Documents) > 0 {
-		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *RegisterDocumentTemplateResponse) Reset()         { *m = RegisterDocumentTemplateResponse{} }
+func (m *RegisterDocumentTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterDocumentTemplateResponse) ProtoMessage()    {}
+func (*RegisterDocumentTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{51}
+}
+func (m *RegisterDocumentTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterDocumentTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterDocumentTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TotalCount != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TotalCount))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *RegisterDocumentTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterDocumentTemplateResponse.Merge(m, src)
+}
+func (m *RegisterDocumentTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterDocumentTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterDocumentTemplateResponse.DiscardUnknown(m)
 }
 
-func (m *ListChangesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RegisterDocumentTemplateResponse proto.InternalMessageInfo
+
+func (m *RegisterDocumentTemplateResponse) GetTemplate() *DocumentTemplate {
+	if m != nil {
+		return m.Template
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListChangesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListDocumentTemplatesRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsForward {
-		i--
-		if m.IsForward {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ListDocumentTemplatesRequest) Reset()         { *m = ListDocumentTemplatesRequest{} }
+func (m *ListDocumentTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDocumentTemplatesRequest) ProtoMessage()    {}
+func (*ListDocumentTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{52}
+}
+func (m *ListDocumentTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDocumentTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDocumentTemplatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.PreviousSeq != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.PreviousSeq))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.DocumentKey) > 0 {
-		i -= len(m.DocumentKey)
-		copy(dAtA[i:], m.DocumentKey)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProjectName) > 0 {
-		i -= len(m.ProjectName)
-		copy(dAtA[i:], m.ProjectName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListDocumentTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDocumentTemplatesRequest.Merge(m, src)
+}
+func (m *ListDocumentTemplatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDocumentTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDocumentTemplatesRequest.DiscardUnknown(m)
 }
 
-func (m *ListChangesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListDocumentTemplatesRequest proto.InternalMessageInfo
+
+func (m *ListDocumentTemplatesRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListChangesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListDocumentTemplatesResponse struct {
+	Templates            []*DocumentTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *ListChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Changes) > 0 {
-		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ListDocumentTemplatesResponse) Reset()         { *m = ListDocumentTemplatesResponse{} }
+func (m *ListDocumentTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDocumentTemplatesResponse) ProtoMessage()    {}
+func (*ListDocumentTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{53}
+}
+func (m *ListDocumentTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDocumentTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDocumentTemplatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListDocumentTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDocumentTemplatesResponse.Merge(m, src)
+}
+func (m *ListDocumentTemplatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDocumentTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDocumentTemplatesResponse.DiscardUnknown(m)
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ListDocumentTemplatesResponse proto.InternalMessageInfo
+
+func (m *ListDocumentTemplatesResponse) GetTemplates() []*DocumentTemplate {
+	if m != nil {
+		return m.Templates
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *SignUpRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+type RemoveDocumentTemplateRequest struct {
+	ProjectName          string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Collection           string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignUpResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RemoveDocumentTemplateRequest) Reset()         { *m = RemoveDocumentTemplateRequest{} }
+func (m *RemoveDocumentTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDocumentTemplateRequest) ProtoMessage()    {}
+func (*RemoveDocumentTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{54}
+}
+func (m *RemoveDocumentTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDocumentTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDocumentTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RemoveDocumentTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDocumentTemplateRequest.Merge(m, src)
+}
+func (m *RemoveDocumentTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDocumentTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDocumentTemplateRequest.DiscardUnknown(m)
 }
 
-func (m *LogInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_RemoveDocumentTemplateRequest proto.InternalMessageInfo
+
+func (m *RemoveDocumentTemplateRequest) GetProjectName() string {
+	if m != nil {
+		return m.ProjectName
 	}
-	return n
+	return ""
 }
 
-func (m *LogInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RemoveDocumentTemplateRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
 	}
-	return n
+	return ""
 }
 
-func (m *CreateProjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type RemoveDocumentTemplateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateProjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Project != nil {
-		l = m.Project.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RemoveDocumentTemplateResponse) Reset()         { *m = RemoveDocumentTemplateResponse{} }
+func (m *RemoveDocumentTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDocumentTemplateResponse) ProtoMessage()    {}
+func (*RemoveDocumentTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{55}
+}
+func (m *RemoveDocumentTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDocumentTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDocumentTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RemoveDocumentTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDocumentTemplateResponse.Merge(m, src)
+}
+func (m *RemoveDocumentTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDocumentTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDocumentTemplateResponse.DiscardUnknown(m)
 }
 
-func (m *GetProjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var xxx_messageInfo_RemoveDocumentTemplateResponse proto.InternalMessageInfo
+
+type UpdateLogLevelsRequest struct {
+	Levels               map[string]string `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetProjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Project != nil {
-		l = m.Project.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *UpdateLogLevelsRequest) Reset()         { *m = UpdateLogLevelsRequest{} }
+func (m *UpdateLogLevelsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateLogLevelsRequest) ProtoMessage()    {}
+func (*UpdateLogLevelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{56}
+}
+func (m *UpdateLogLevelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateLogLevelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateLogLevelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *UpdateLogLevelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLogLevelsRequest.Merge(m, src)
+}
+func (m *UpdateLogLevelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateLogLevelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLogLevelsRequest.DiscardUnknown(m)
 }
 
-func (m *ListProjectsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_UpdateLogLevelsRequest proto.InternalMessageInfo
+
+func (m *UpdateLogLevelsRequest) GetLevels() map[string]string {
+	if m != nil {
+		return m.Levels
 	}
-	return n
+	return nil
 }
 
-func (m *ListProjectsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Projects) > 0 {
-		for _, e := range m.Projects {
-			l = e.Size()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type UpdateLogLevelsResponse struct {
+	Levels               map[string]string `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *UpdateProjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Fields != nil {
-		l = m.Fields.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *UpdateLogLevelsResponse) Reset()         { *m = UpdateLogLevelsResponse{} }
+func (m *UpdateLogLevelsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateLogLevelsResponse) ProtoMessage()    {}
+func (*UpdateLogLevelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ef4cd0843a14163, []int{57}
+}
+func (m *UpdateLogLevelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateLogLevelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateLogLevelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *UpdateLogLevelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLogLevelsResponse.Merge(m, src)
+}
+func (m *UpdateLogLevelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateLogLevelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLogLevelsResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateProjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Project != nil {
-		l = m.Project.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_UpdateLogLevelsResponse proto.InternalMessageInfo
+
+func (m *UpdateLogLevelsResponse) GetLevels() map[string]string {
+	if m != nil {
+		return m.Levels
 	}
-	return n
+	return nil
 }
 
-func (m *ListDocumentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProjectName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.PreviousId)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.PageSize != 0 {
-		n += 1 + sovAdmin(uint64(m.PageSize))
-	}
-	if m.IsForward {
-		n += 2
-	}
-	if m.IncludeSnapshot {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func init() {
+	proto.RegisterType((*SignUpRequest)(nil), "yorkie.v1.SignUpRequest")
+	proto.RegisterType((*SignUpResponse)(nil), "yorkie.v1.SignUpResponse")
+	proto.RegisterType((*LogInRequest)(nil), "yorkie.v1.LogInRequest")
+	proto.RegisterType((*LogInResponse)(nil), "yorkie.v1.LogInResponse")
+	proto.RegisterType((*CreateProjectRequest)(nil), "yorkie.v1.CreateProjectRequest")
+	proto.RegisterType((*CreateProjectResponse)(nil), "yorkie.v1.CreateProjectResponse")
+	proto.RegisterType((*GetProjectRequest)(nil), "yorkie.v1.GetProjectRequest")
+	proto.RegisterType((*GetProjectResponse)(nil), "yorkie.v1.GetProjectResponse")
+	proto.RegisterType((*ListProjectsRequest)(nil), "yorkie.v1.ListProjectsRequest")
+	proto.RegisterType((*ListProjectsResponse)(nil), "yorkie.v1.ListProjectsResponse")
+	proto.RegisterType((*UpdateProjectRequest)(nil), "yorkie.v1.UpdateProjectRequest")
+	proto.RegisterType((*UpdateProjectResponse)(nil), "yorkie.v1.UpdateProjectResponse")
+	proto.RegisterType((*ListDocumentsRequest)(nil), "yorkie.v1.ListDocumentsRequest")
+	proto.RegisterType((*ListDocumentsResponse)(nil), "yorkie.v1.ListDocumentsResponse")
+	proto.RegisterType((*GetDocumentRequest)(nil), "yorkie.v1.GetDocumentRequest")
+	proto.RegisterType((*GetDocumentResponse)(nil), "yorkie.v1.GetDocumentResponse")
+	proto.RegisterType((*RemoveDocumentByAdminRequest)(nil), "yorkie.v1.RemoveDocumentByAdminRequest")
+	proto.RegisterType((*RemoveDocumentByAdminResponse)(nil), "yorkie.v1.RemoveDocumentByAdminResponse")
+	proto.RegisterType((*RemoveDocumentsByAdminRequest)(nil), "yorkie.v1.RemoveDocumentsByAdminRequest")
+	proto.RegisterType((*RemoveDocumentsByAdminResponse)(nil), "yorkie.v1.RemoveDocumentsByAdminResponse")
+	proto.RegisterType((*UpdateDocumentACLRequest)(nil), "yorkie.v1.UpdateDocumentACLRequest")
+	proto.RegisterType((*UpdateDocumentACLResponse)(nil), "yorkie.v1.UpdateDocumentACLResponse")
+	proto.RegisterType((*UpdateDocumentLabelsRequest)(nil), "yorkie.v1.UpdateDocumentLabelsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.UpdateDocumentLabelsRequest.LabelsEntry")
+	proto.RegisterType((*UpdateDocumentLabelsResponse)(nil), "yorkie.v1.UpdateDocumentLabelsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.UpdateDocumentLabelsResponse.LabelsEntry")
+	proto.RegisterType((*UpdateDocumentSnapshotConfigRequest)(nil), "yorkie.v1.UpdateDocumentSnapshotConfigRequest")
+	proto.RegisterType((*UpdateDocumentSnapshotConfigResponse)(nil), "yorkie.v1.UpdateDocumentSnapshotConfigResponse")
+	proto.RegisterType((*GetSnapshotMetaRequest)(nil), "yorkie.v1.GetSnapshotMetaRequest")
+	proto.RegisterType((*GetSnapshotMetaResponse)(nil), "yorkie.v1.GetSnapshotMetaResponse")
+	proto.RegisterType((*SearchDocumentsRequest)(nil), "yorkie.v1.SearchDocumentsRequest")
+	proto.RegisterType((*SearchDocumentsResponse)(nil), "yorkie.v1.SearchDocumentsResponse")
+	proto.RegisterType((*ListChangesRequest)(nil), "yorkie.v1.ListChangesRequest")
+	proto.RegisterType((*ListChangesResponse)(nil), "yorkie.v1.ListChangesResponse")
+	proto.RegisterType((*VerifyDocumentRequest)(nil), "yorkie.v1.VerifyDocumentRequest")
+	proto.RegisterType((*VerifyDocumentResponse)(nil), "yorkie.v1.VerifyDocumentResponse")
+	proto.RegisterType((*RestoreDocumentRequest)(nil), "yorkie.v1.RestoreDocumentRequest")
+	proto.RegisterType((*RestoreDocumentResponse)(nil), "yorkie.v1.RestoreDocumentResponse")
+	proto.RegisterType((*EvictDocumentRequest)(nil), "yorkie.v1.EvictDocumentRequest")
+	proto.RegisterType((*EvictDocumentResponse)(nil), "yorkie.v1.EvictDocumentResponse")
+	proto.RegisterType((*GCDocumentRequest)(nil), "yorkie.v1.GCDocumentRequest")
+	proto.RegisterType((*GCDocumentResponse)(nil), "yorkie.v1.GCDocumentResponse")
+	proto.RegisterType((*ExportDocumentRequest)(nil), "yorkie.v1.ExportDocumentRequest")
+	proto.RegisterType((*ExportDocumentResponse)(nil), "yorkie.v1.ExportDocumentResponse")
+	proto.RegisterType((*ImportDocumentRequest)(nil), "yorkie.v1.ImportDocumentRequest")
+	proto.RegisterType((*ImportDocumentResponse)(nil), "yorkie.v1.ImportDocumentResponse")
+	proto.RegisterType((*BackupProjectRequest)(nil), "yorkie.v1.BackupProjectRequest")
+	proto.RegisterType((*BackupProjectResponse)(nil), "yorkie.v1.BackupProjectResponse")
+	proto.RegisterType((*ListDocumentMemoriesRequest)(nil), "yorkie.v1.ListDocumentMemoriesRequest")
+	proto.RegisterType((*ListDocumentMemoriesResponse)(nil), "yorkie.v1.ListDocumentMemoriesResponse")
+	proto.RegisterType((*ListClientsRequest)(nil), "yorkie.v1.ListClientsRequest")
+	proto.RegisterType((*ListClientsResponse)(nil), "yorkie.v1.ListClientsResponse")
+	proto.RegisterType((*RegisterDocumentTemplateRequest)(nil), "yorkie.v1.RegisterDocumentTemplateRequest")
+	proto.RegisterType((*RegisterDocumentTemplateResponse)(nil), "yorkie.v1.RegisterDocumentTemplateResponse")
+	proto.RegisterType((*ListDocumentTemplatesRequest)(nil), "yorkie.v1.ListDocumentTemplatesRequest")
+	proto.RegisterType((*ListDocumentTemplatesResponse)(nil), "yorkie.v1.ListDocumentTemplatesResponse")
+	proto.RegisterType((*RemoveDocumentTemplateRequest)(nil), "yorkie.v1.RemoveDocumentTemplateRequest")
+	proto.RegisterType((*RemoveDocumentTemplateResponse)(nil), "yorkie.v1.RemoveDocumentTemplateResponse")
+	proto.RegisterType((*UpdateLogLevelsRequest)(nil), "yorkie.v1.UpdateLogLevelsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.UpdateLogLevelsRequest.LevelsEntry")
+	proto.RegisterType((*UpdateLogLevelsResponse)(nil), "yorkie.v1.UpdateLogLevelsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.UpdateLogLevelsResponse.LevelsEntry")
 }
 
-func (m *ListDocumentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Documents) > 0 {
-		for _, e := range m.Documents {
-			l = e.Size()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func init() { proto.RegisterFile("yorkie/v1/admin.proto", fileDescriptor_7ef4cd0843a14163) }
+
+var fileDescriptor_7ef4cd0843a14163 = []byte{
+	// 2143 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x73, 0xdb, 0xc6,
+	0x15, 0x37, 0x48, 0xfd, 0xe3, 0x23, 0x25, 0x5b, 0x2b, 0x91, 0xa2, 0x61, 0x89, 0xa2, 0xd6, 0x71,
+	0x24, 0x47, 0x0d, 0x15, 0x29, 0xd3, 0x34, 0x6e, 0x32, 0x93, 0x91, 0x54, 0x49, 0x65, 0x2d, 0x67,
+	0x1c, 0xd0, 0x76, 0xa6, 0xee, 0x74, 0x58, 0x90, 0x5c, 0x52, 0xa8, 0x40, 0x82, 0x02, 0x40, 0xc6,
+	0xf4, 0xa5, 0x93, 0x4b, 0x0e, 0xed, 0xa5, 0x07, 0x1f, 0x3a, 0x9d, 0x9e, 0xfb, 0x2d, 0x7a, 0x6e,
+	0x8f, 0xfd, 0x08, 0x1d, 0xf7, 0xd6, 0x4f, 0xd1, 0x01, 0x76, 0x17, 0xda, 0x05, 0x01, 0x8a, 0x52,
+	0xa9, 0x99, 0xde, 0x88, 0xb7, 0xbf, 0x7d, 0xff, 0x76, 0xf7, 0xfd, 0x23, 0x64, 0x07, 0x96, 0x7d,
+	0x6e, 0x90, 0x9d, 0xfe, 0xee, 0x8e, 0xde, 0x68, 0x1b, 0x9d, 0x52, 0xd7, 0xb6, 0x5c, 0x0b, 0xa5,
+	0x28, 0xb9, 0xd4, 0xdf, 0x55, 0xd7, 0x5b, 0x96, 0xd5, 0x32, 0xc9, 0x8e, 0xbf, 0x50, 0xeb, 0x35,
+	0x77, 0x5c, 0xa3, 0x4d, 0x1c, 0x57, 0x6f, 0x77, 0x29, 0x56, 0xbd, 0x7f, 0xc9, 0xc2, 0x26, 0x8e,
+	0xd5, 0xb3, 0xeb, 0xc4, 0xa1, 0x4b, 0xf8, 0x04, 0xe6, 0x2b, 0x46, 0xab, 0xf3, 0xb2, 0xab, 0x91,
+	0x8b, 0x1e, 0x71, 0x5c, 0xa4, 0xc2, 0x5c, 0xcf, 0x21, 0x76, 0x47, 0x6f, 0x93, 0xbc, 0x52, 0x54,
+	0xb6, 0x52, 0x5a, 0xf0, 0xed, 0xad, 0x75, 0x75, 0xc7, 0xf9, 0xce, 0xb2, 0x1b, 0xf9, 0x04, 0x5d,
+	0xe3, 0xdf, 0xf8, 0xc7, 0xb0, 0xc0, 0x19, 0x39, 0x5d, 0xab, 0xe3, 0x10, 0xf4, 0x10, 0xa6, 0xbc,
+	0x9d, 0x3e, 0x97, 0xf4, 0xde, 0xdd, 0x52, 0xa0, 0x70, 0xe9, 0xa5, 0x43, 0x6c, 0xcd, 0x5f, 0xc4,
+	0xc7, 0x90, 0x39, 0xb5, 0x5a, 0xe5, 0xce, 0xff, 0x2a, 0xfe, 0x11, 0xcc, 0x33, 0x3e, 0x4c, 0xfa,
+	0x32, 0x4c, 0xbb, 0xd6, 0x39, 0xe9, 0x30, 0x2e, 0xf4, 0x03, 0x7f, 0x04, 0xcb, 0x87, 0x36, 0xd1,
+	0x5d, 0xf2, 0xdc, 0xb6, 0x7e, 0x4b, 0xea, 0x2e, 0x17, 0x8b, 0x60, 0x4a, 0x10, 0xe9, 0xff, 0xc6,
+	0x47, 0x90, 0x0d, 0x61, 0x19, 0xeb, 0x1f, 0xc1, 0x6c, 0x97, 0x92, 0x98, 0x6d, 0x48, 0xb0, 0x8d,
+	0x83, 0x39, 0x04, 0x6f, 0xc2, 0xe2, 0x09, 0x71, 0xc7, 0x90, 0x77, 0x00, 0x48, 0x04, 0xde, 0x48,
+	0x58, 0x16, 0x96, 0x4e, 0x0d, 0x87, 0x33, 0x71, 0x98, 0x38, 0x7c, 0x0c, 0xcb, 0x32, 0x99, 0x31,
+	0x2f, 0xc1, 0x1c, 0xdb, 0xe9, 0xe4, 0x95, 0x62, 0x32, 0x86, 0x7b, 0x80, 0xc1, 0x3a, 0x2c, 0xbf,
+	0xec, 0x36, 0x86, 0xdd, 0xb7, 0x00, 0x09, 0xa3, 0xc1, 0x8c, 0x49, 0x18, 0x0d, 0xf4, 0x04, 0x66,
+	0x9a, 0x06, 0x31, 0x1b, 0x8e, 0x7f, 0x4e, 0xe9, 0xbd, 0x0d, 0xf1, 0xf0, 0x3d, 0x06, 0x7a, 0xcd,
+	0xe4, 0x3c, 0x8e, 0x7d, 0xa0, 0xc6, 0x36, 0x78, 0x5e, 0x0f, 0x89, 0xb8, 0x91, 0x23, 0xfe, 0x90,
+	0xa4, 0x26, 0xff, 0xcc, 0xaa, 0xf7, 0xda, 0xa4, 0x13, 0xb8, 0x02, 0x6d, 0x40, 0x86, 0x61, 0xaa,
+	0xc2, 0x09, 0xa4, 0x19, 0xed, 0x6b, 0xef, 0x9e, 0xad, 0x43, 0xba, 0x6b, 0x93, 0xbe, 0x61, 0xf5,
+	0x9c, 0xaa, 0xc1, 0xaf, 0x1a, 0x70, 0x52, 0xb9, 0x81, 0x1e, 0x40, 0xaa, 0xab, 0xb7, 0x48, 0xd5,
+	0x31, 0xde, 0x92, 0x7c, 0xb2, 0xa8, 0x6c, 0x4d, 0x7b, 0x37, 0xb1, 0x45, 0x2a, 0xc6, 0x5b, 0x82,
+	0xd6, 0x00, 0x0c, 0xa7, 0xda, 0xb4, 0xec, 0xef, 0x74, 0xbb, 0x91, 0x9f, 0x2a, 0x2a, 0x5b, 0x73,
+	0x5a, 0xca, 0x70, 0x8e, 0x29, 0x01, 0x3d, 0x86, 0x7b, 0x46, 0xa7, 0x6e, 0xf6, 0x1a, 0xa4, 0xea,
+	0x74, 0xf4, 0xae, 0x73, 0x66, 0xb9, 0xf9, 0x69, 0x1f, 0x74, 0x97, 0xd1, 0x2b, 0x8c, 0x8c, 0x1e,
+	0xc1, 0x82, 0xa9, 0xd7, 0x88, 0x59, 0x75, 0x88, 0x49, 0xea, 0xae, 0x65, 0xe7, 0x67, 0x7c, 0x55,
+	0xe6, 0x7d, 0x6a, 0x85, 0x11, 0x3d, 0x81, 0xe7, 0x64, 0x50, 0xed, 0xda, 0xa4, 0x69, 0xbc, 0xc9,
+	0xcf, 0xfa, 0x90, 0xd4, 0x39, 0x19, 0x3c, 0xf7, 0x09, 0xe8, 0x2b, 0x98, 0xef, 0xf9, 0x0e, 0x6d,
+	0x54, 0xf5, 0xa6, 0x4b, 0xec, 0xfc, 0x9c, 0xef, 0x3d, 0xb5, 0x44, 0xa3, 0x46, 0x89, 0x47, 0x8d,
+	0xd2, 0x0b, 0x1e, 0x35, 0xb4, 0x0c, 0xdb, 0xb0, 0xef, 0xe1, 0xd1, 0x3e, 0x2c, 0x70, 0x06, 0x35,
+	0xd2, 0xb4, 0x6c, 0x92, 0x4f, 0x5d, 0xc9, 0x81, 0x8b, 0x3c, 0xf0, 0x37, 0xe0, 0x6f, 0x20, 0x1b,
+	0x3a, 0x0c, 0x76, 0xa8, 0x9f, 0x43, 0xaa, 0xc1, 0x89, 0xec, 0x06, 0xaa, 0xc2, 0xb1, 0xf2, 0x0d,
+	0x95, 0x5e, 0xbb, 0xad, 0xdb, 0x03, 0xed, 0x12, 0x8c, 0x5f, 0xfb, 0xaf, 0x85, 0x03, 0xae, 0x71,
+	0xba, 0x1b, 0x90, 0xe1, 0x5c, 0xaa, 0xe7, 0x64, 0xc0, 0x8e, 0x37, 0xcd, 0x69, 0x4f, 0xc9, 0x00,
+	0x3f, 0x83, 0x25, 0x89, 0x37, 0x53, 0xf6, 0x33, 0x98, 0xe3, 0x28, 0x76, 0x05, 0x47, 0xe9, 0x1a,
+	0x60, 0xf1, 0x5b, 0x58, 0xd5, 0x48, 0xdb, 0xea, 0x13, 0x0e, 0x39, 0x18, 0xec, 0x7b, 0x91, 0x7c,
+	0xa2, 0x4a, 0x7b, 0x01, 0xaf, 0x69, 0xd9, 0x75, 0x7a, 0x21, 0xe7, 0x34, 0xfa, 0x81, 0xd7, 0x61,
+	0x2d, 0x46, 0x36, 0x35, 0x0a, 0xff, 0x2e, 0x0c, 0x70, 0xae, 0xaf, 0xdd, 0xf0, 0x45, 0x4d, 0x44,
+	0x5d, 0xd4, 0x68, 0x0d, 0x8f, 0xa0, 0x10, 0xa7, 0x40, 0x90, 0x48, 0xe6, 0x45, 0xe3, 0xe9, 0x45,
+	0x49, 0x69, 0x19, 0xc1, 0x7a, 0x07, 0xff, 0x5e, 0x81, 0x3c, 0x0d, 0x1c, 0x9c, 0xcf, 0xfe, 0xe1,
+	0xe9, 0x64, 0x3d, 0xbc, 0x05, 0x49, 0xbd, 0x6e, 0xfa, 0xda, 0xa7, 0xf7, 0x72, 0x11, 0x47, 0xef,
+	0x49, 0xf4, 0x20, 0xf8, 0x08, 0xee, 0x47, 0xe8, 0xc2, 0xcc, 0x61, 0x6c, 0x94, 0xab, 0xd9, 0xfc,
+	0x47, 0x81, 0x07, 0x32, 0x9f, 0x53, 0xcf, 0xa1, 0xce, 0x64, 0xcd, 0xfa, 0x05, 0xcc, 0xf8, 0xe7,
+	0xe4, 0xe4, 0x93, 0xfe, 0x03, 0xdc, 0x0b, 0x07, 0xeb, 0x68, 0xe9, 0x25, 0xfa, 0x75, 0xd4, 0x71,
+	0xed, 0x81, 0xc6, 0x38, 0xa8, 0x4f, 0x20, 0x2d, 0x90, 0xd1, 0x3d, 0x48, 0x7a, 0x42, 0xa9, 0x5e,
+	0xde, 0x4f, 0xef, 0x0e, 0xf4, 0x75, 0xb3, 0x47, 0x98, 0x22, 0xf4, 0xe3, 0xa7, 0x89, 0xcf, 0x15,
+	0xfc, 0x57, 0x05, 0x56, 0xa3, 0xc5, 0x31, 0xbf, 0x3d, 0x0d, 0xf4, 0xa4, 0x81, 0xe2, 0xd3, 0x2b,
+	0xf5, 0xa4, 0x1b, 0x27, 0xad, 0xe8, 0xdf, 0x15, 0x78, 0x28, 0xcb, 0xe3, 0x11, 0xfb, 0xd0, 0xea,
+	0x34, 0x8d, 0xd6, 0x64, 0x4f, 0xe7, 0x63, 0x40, 0x3c, 0x4f, 0x54, 0xdd, 0x33, 0x9b, 0x38, 0x67,
+	0x96, 0xd9, 0xf0, 0xef, 0x60, 0x52, 0x5b, 0xe4, 0x2b, 0x2f, 0xf8, 0x02, 0xda, 0x86, 0x80, 0x58,
+	0x35, 0x3a, 0x2e, 0xb1, 0xfb, 0xba, 0xe9, 0x27, 0xa1, 0xa4, 0x76, 0x8f, 0x2f, 0x94, 0x19, 0x1d,
+	0x7f, 0x08, 0x1f, 0x8c, 0x36, 0x84, 0xc5, 0x88, 0xef, 0x15, 0xc8, 0x9d, 0x90, 0x60, 0xf5, 0x19,
+	0x71, 0xf5, 0xc9, 0x1a, 0xb9, 0x01, 0xe0, 0x10, 0xbb, 0x4f, 0xec, 0xaa, 0x43, 0x2e, 0xa8, 0x71,
+	0x07, 0x89, 0x4f, 0x14, 0x2d, 0x45, 0xa9, 0x15, 0x72, 0x81, 0x2b, 0xb0, 0x32, 0xa4, 0x02, 0xbb,
+	0x18, 0x2a, 0xcc, 0x05, 0xa9, 0xd4, 0x93, 0x9f, 0xd1, 0x82, 0x6f, 0xb4, 0x0a, 0xb3, 0xa6, 0xde,
+	0xee, 0x5a, 0xb6, 0x9b, 0x4f, 0x04, 0x6c, 0x39, 0x09, 0x77, 0x20, 0x57, 0x21, 0xba, 0x5d, 0x3f,
+	0xbb, 0x49, 0x99, 0xb0, 0x0c, 0xd3, 0x17, 0x3d, 0x62, 0x73, 0x83, 0xe8, 0xc7, 0xc8, 0xda, 0x00,
+	0xbb, 0xb0, 0x32, 0x24, 0x8f, 0x19, 0xb1, 0x0e, 0x69, 0xd7, 0x72, 0x75, 0xb3, 0x5a, 0xb7, 0x7a,
+	0x2c, 0xbf, 0x4c, 0x6b, 0xe0, 0x93, 0x0e, 0x3d, 0x8a, 0x9c, 0x2a, 0x13, 0xd7, 0x49, 0x95, 0x7f,
+	0x53, 0x00, 0x79, 0xe9, 0xf7, 0xf0, 0x4c, 0xef, 0xb4, 0xc8, 0x84, 0xa3, 0xc7, 0x23, 0xc8, 0xf0,
+	0xca, 0x28, 0x74, 0x78, 0x41, 0x11, 0x55, 0x21, 0x17, 0xb2, 0x5b, 0xa6, 0x46, 0x96, 0x4c, 0xd3,
+	0xa1, 0x92, 0x09, 0x1f, 0xc0, 0x92, 0xa4, 0x3e, 0xf3, 0xd8, 0x36, 0xcc, 0xd6, 0x29, 0x89, 0x05,
+	0x84, 0x45, 0xc1, 0x1d, 0x14, 0xac, 0x71, 0x04, 0xfe, 0x35, 0x64, 0x5f, 0x11, 0xdb, 0x68, 0x0e,
+	0x6e, 0xa7, 0x62, 0x78, 0xa7, 0x40, 0x2e, 0xcc, 0x9f, 0xa9, 0xb9, 0x07, 0x4b, 0xc1, 0x8b, 0x14,
+	0x2e, 0xb9, 0x12, 0xf8, 0x29, 0x78, 0xb0, 0x15, 0x7e, 0xd9, 0xbd, 0x8c, 0x17, 0xec, 0x39, 0xd3,
+	0x9d, 0x33, 0x26, 0x32, 0xc3, 0x89, 0x3f, 0xd7, 0x9d, 0x33, 0x4f, 0x2d, 0x9b, 0xd4, 0x7a, 0x86,
+	0xc9, 0x30, 0x49, 0xaa, 0x16, 0xa3, 0x79, 0x10, 0xff, 0xe1, 0x6a, 0xc4, 0x71, 0x2d, 0x9b, 0xdc,
+	0x8a, 0xdd, 0xe3, 0x3c, 0xdc, 0x2f, 0x61, 0x65, 0x48, 0x05, 0xe6, 0x1a, 0x79, 0xb7, 0x12, 0xb5,
+	0xdb, 0x85, 0xe5, 0xa3, 0xbe, 0x51, 0xbf, 0x9d, 0x42, 0x0f, 0xe5, 0x60, 0xc6, 0x26, 0xba, 0x63,
+	0x75, 0x98, 0xf3, 0xd8, 0x17, 0xfe, 0x0c, 0xb2, 0x21, 0xa9, 0x4c, 0xe3, 0x35, 0x80, 0xba, 0x69,
+	0x78, 0x1c, 0x8d, 0x06, 0xaf, 0x43, 0x52, 0x94, 0x52, 0x6e, 0x38, 0xf8, 0x97, 0xb0, 0x78, 0x72,
+	0x78, 0x3b, 0x37, 0xac, 0x06, 0xe8, 0xe4, 0x70, 0x48, 0x9f, 0xc7, 0x70, 0xcf, 0xf6, 0x8b, 0xa7,
+	0x46, 0x95, 0x98, 0x84, 0x97, 0xd1, 0xde, 0xeb, 0xba, 0xcb, 0xe8, 0x47, 0x8c, 0x1c, 0x72, 0x76,
+	0x22, 0xca, 0xd9, 0x3f, 0x28, 0x90, 0x3d, 0x7a, 0xe3, 0x45, 0xc6, 0xdb, 0x71, 0xf7, 0x26, 0xf0,
+	0x1e, 0xa7, 0xca, 0x5f, 0x2e, 0x2d, 0x05, 0x17, 0x18, 0x99, 0x3d, 0x71, 0xfc, 0x14, 0x72, 0x61,
+	0x3d, 0x98, 0xc1, 0xbb, 0x30, 0x43, 0xfc, 0x15, 0x56, 0x3f, 0xdd, 0x8f, 0x08, 0x81, 0x74, 0xab,
+	0xc6, 0x80, 0xf8, 0x8f, 0x0a, 0x64, 0xcb, 0xed, 0xdb, 0xb3, 0xea, 0x52, 0xa5, 0xe4, 0xb8, 0x2a,
+	0x7d, 0x01, 0xb9, 0x72, 0x3b, 0xd2, 0xbe, 0x31, 0x9e, 0x44, 0x0d, 0x96, 0x0f, 0xf4, 0xfa, 0x79,
+	0xaf, 0x1b, 0x6a, 0xc2, 0xc7, 0xb0, 0x26, 0xe2, 0x00, 0x12, 0x91, 0x07, 0xb0, 0x0d, 0xd9, 0x90,
+	0x0c, 0xa6, 0x1f, 0x82, 0x29, 0xaf, 0x7d, 0x67, 0x79, 0xd6, 0xff, 0x8d, 0x5f, 0xc1, 0x03, 0xb1,
+	0xbb, 0x7b, 0x46, 0xda, 0x96, 0x6d, 0x90, 0x6b, 0xa6, 0x52, 0xd3, 0x68, 0x1b, 0x34, 0x47, 0x4f,
+	0x6b, 0xf4, 0x03, 0x7f, 0x0b, 0xab, 0xd1, 0x7c, 0x99, 0x2e, 0x3f, 0x19, 0x6e, 0x1e, 0xa3, 0x7c,
+	0xef, 0xef, 0x93, 0x12, 0xe2, 0x3b, 0x9e, 0x10, 0xfd, 0x87, 0xfb, 0xff, 0x32, 0x1a, 0xc0, 0x65,
+	0x58, 0x92, 0xb4, 0x0a, 0x12, 0xc8, 0x2c, 0x8d, 0x30, 0xdc, 0xc8, 0xbc, 0x98, 0xe7, 0x4c, 0x43,
+	0x48, 0xfa, 0x1c, 0x88, 0xdf, 0xc0, 0xba, 0x46, 0x5a, 0x86, 0xe3, 0x12, 0x9b, 0xbb, 0xe1, 0x05,
+	0x69, 0x77, 0x4d, 0xdd, 0x25, 0xd7, 0xb0, 0xb6, 0x00, 0x50, 0xb7, 0x4c, 0xaf, 0x7b, 0x33, 0xac,
+	0x0e, 0x37, 0xf6, 0x92, 0xe2, 0x5d, 0x06, 0xdb, 0xb2, 0x5c, 0x16, 0x3c, 0xfd, 0xdf, 0xf8, 0x57,
+	0x50, 0x8c, 0x97, 0x1c, 0x1c, 0xdc, 0x9c, 0xcb, 0x68, 0xec, 0x19, 0x3f, 0x88, 0x38, 0xb7, 0x60,
+	0x5b, 0x00, 0xc6, 0xfb, 0xf2, 0x8d, 0xe0, 0x88, 0x6b, 0x9c, 0x20, 0x7e, 0x0d, 0x6b, 0x31, 0x2c,
+	0x98, 0x72, 0x4f, 0x20, 0xc5, 0xe5, 0x71, 0x87, 0x8f, 0xd4, 0xee, 0x12, 0x8d, 0x6b, 0xe1, 0x5e,
+	0x7a, 0xf2, 0x3e, 0xc7, 0x45, 0x28, 0xc4, 0xc9, 0x60, 0xd5, 0xfa, 0x9f, 0x15, 0xc8, 0xd1, 0xb2,
+	0xfe, 0xd4, 0x6a, 0x9d, 0x92, 0xbe, 0xd0, 0x30, 0x1e, 0xc1, 0x8c, 0xe9, 0x13, 0x98, 0x61, 0x1f,
+	0x0f, 0xb5, 0x50, 0xe1, 0x2d, 0x25, 0xfa, 0xc5, 0x9b, 0x27, 0xd2, 0xe7, 0xcd, 0x13, 0xe9, 0xdf,
+	0xa8, 0x79, 0xfa, 0x8b, 0x02, 0x2b, 0x43, 0x92, 0x98, 0xe7, 0x8f, 0x43, 0xda, 0x95, 0x46, 0x69,
+	0xc7, 0x7b, 0xbb, 0x89, 0xaa, 0xb7, 0xf7, 0x43, 0x16, 0x32, 0xfe, 0xf0, 0xc1, 0xab, 0xc5, 0x8c,
+	0x3a, 0x41, 0x5f, 0xc1, 0x0c, 0x1d, 0x6b, 0x23, 0xf1, 0xd5, 0x49, 0x23, 0x73, 0xf5, 0x7e, 0xc4,
+	0x0a, 0x3b, 0x8b, 0x3b, 0xe8, 0x4b, 0x98, 0xf6, 0x07, 0xd3, 0x68, 0x45, 0x40, 0x89, 0x23, 0x6f,
+	0x35, 0x3f, 0xbc, 0x10, 0xec, 0x7e, 0x01, 0xf3, 0xd2, 0x0c, 0x1a, 0xad, 0x8b, 0x6f, 0x3f, 0x62,
+	0x92, 0xad, 0x16, 0xe3, 0x01, 0x01, 0xd7, 0x6f, 0x20, 0x23, 0x8e, 0x83, 0x51, 0x41, 0xd4, 0x60,
+	0x78, 0x7c, 0xac, 0xae, 0xc7, 0xae, 0x07, 0x2c, 0x9f, 0x02, 0x5c, 0x0e, 0xaf, 0xd1, 0xaa, 0xb0,
+	0x61, 0x68, 0xf8, 0xad, 0xae, 0xc5, 0xac, 0x8a, 0x56, 0x4b, 0x33, 0x60, 0xc9, 0xea, 0xa8, 0x01,
+	0xb4, 0x5a, 0x8c, 0x07, 0x88, 0x5c, 0xa5, 0x21, 0x24, 0x0a, 0x9b, 0x15, 0x6e, 0x02, 0xd5, 0x62,
+	0x3c, 0x20, 0xe0, 0xfa, 0x35, 0xa4, 0x85, 0x59, 0x21, 0x0a, 0xd9, 0x16, 0xaa, 0x38, 0xd4, 0x42,
+	0xdc, 0x72, 0xc0, 0xcf, 0x84, 0x6c, 0xe4, 0xc0, 0x0e, 0x6d, 0x0a, 0x5b, 0x47, 0x8d, 0x13, 0xd5,
+	0xad, 0xab, 0x81, 0x81, 0x34, 0x0b, 0x72, 0x32, 0x84, 0x0f, 0xdf, 0x50, 0x3c, 0x97, 0xd0, 0x80,
+	0x50, 0x7d, 0x3c, 0x06, 0x32, 0x10, 0xf8, 0x1b, 0x58, 0x1c, 0x9a, 0x8c, 0xa1, 0x87, 0xb1, 0x93,
+	0x9c, 0xcb, 0x19, 0x9e, 0xfa, 0xc1, 0x68, 0x50, 0x20, 0xc1, 0x80, 0x65, 0x79, 0x99, 0xce, 0x79,
+	0xd0, 0x87, 0xe3, 0x8d, 0xb5, 0xd4, 0xcd, 0x31, 0xc7, 0x4a, 0xf8, 0x0e, 0xfa, 0x7e, 0x68, 0x64,
+	0x25, 0x0f, 0x50, 0x50, 0x29, 0x96, 0x57, 0xe4, 0xc8, 0x48, 0xdd, 0x19, 0x1b, 0x1f, 0xe8, 0xf0,
+	0x1a, 0xee, 0x86, 0xe6, 0x22, 0x68, 0x43, 0xbe, 0x64, 0x11, 0x63, 0x1b, 0x15, 0x8f, 0x82, 0x88,
+	0xbc, 0x43, 0xe3, 0x0a, 0x89, 0x77, 0xf4, 0xe8, 0x44, 0xc5, 0xa3, 0x20, 0xe2, 0xbb, 0x11, 0x9a,
+	0x7a, 0xe9, 0xdd, 0x0c, 0xcf, 0x2a, 0xd4, 0x42, 0xdc, 0x72, 0xc0, 0xef, 0x5b, 0x58, 0x90, 0x1b,
+	0x70, 0x24, 0xbe, 0xde, 0xc8, 0xde, 0x5f, 0xdd, 0x18, 0x81, 0x10, 0x9d, 0x10, 0xea, 0x5f, 0x25,
+	0x27, 0x44, 0xb7, 0xd7, 0x2a, 0x1e, 0x05, 0x11, 0x43, 0x92, 0xd4, 0x67, 0x4a, 0x21, 0x29, 0xaa,
+	0xef, 0x55, 0x8b, 0xf1, 0x00, 0x29, 0x16, 0x07, 0xad, 0xa2, 0x1c, 0x8b, 0xc3, 0xcd, 0xa9, 0xba,
+	0x16, 0xb3, 0x2a, 0xfa, 0x55, 0x6e, 0xc5, 0x24, 0xbf, 0x46, 0x76, 0x8b, 0xea, 0xc6, 0x08, 0x84,
+	0xc8, 0xb8, 0xdc, 0x8e, 0x65, 0x5c, 0x6e, 0x5f, 0xc5, 0x38, 0xba, 0x81, 0xc2, 0x77, 0xd0, 0x2b,
+	0x98, 0x97, 0x7a, 0x17, 0xc9, 0xa9, 0x51, 0x9d, 0x93, 0x5a, 0x8c, 0x07, 0x70, 0xae, 0x9f, 0x28,
+	0x5e, 0x60, 0x89, 0x6a, 0x47, 0xa4, 0xc0, 0x32, 0xa2, 0x0f, 0x52, 0x37, 0xaf, 0xc4, 0x0d, 0x3d,
+	0x0e, 0x5a, 0xcd, 0x0f, 0x3f, 0x0e, 0xa9, 0x6f, 0x51, 0x0b, 0x71, 0xcb, 0x01, 0xbf, 0x1e, 0xe4,
+	0xe3, 0x8a, 0x72, 0xf4, 0x91, 0x74, 0x53, 0x47, 0xf6, 0x0c, 0xea, 0xf6, 0x58, 0x58, 0x31, 0x97,
+	0x45, 0xd6, 0xda, 0x28, 0xce, 0x15, 0xe1, 0x82, 0x5e, 0xdd, 0xba, 0x1a, 0x18, 0x9f, 0xcb, 0x02,
+	0x13, 0xe3, 0x73, 0x59, 0xd8, 0xc0, 0xc7, 0x63, 0x20, 0xc5, 0xc8, 0x10, 0x2a, 0x4b, 0xd1, 0xc6,
+	0x95, 0x05, 0xb5, 0x8a, 0x47, 0x41, 0x38, 0xef, 0x83, 0xed, 0x7f, 0xbc, 0x2f, 0x28, 0xff, 0x7c,
+	0x5f, 0x50, 0xfe, 0xf5, 0xbe, 0xa0, 0xfc, 0xe9, 0xdf, 0x85, 0x3b, 0xb0, 0xd8, 0x20, 0x7d, 0xbe,
+	0x55, 0xef, 0x1a, 0xa5, 0xfe, 0xee, 0x73, 0xe5, 0xf5, 0x54, 0xe9, 0x8b, 0xfe, 0x6e, 0x6d, 0xc6,
+	0xff, 0x07, 0xf6, 0xd3, 0xff, 0x0e, 0x00, 0xd5, 0xc9, 0xe6, 0x90, 0x2b, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error)
+	LogIn(ctx context.Context, in *LogInRequest, opts ...grpc.CallOption) (*LogInResponse, error)
+	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
+	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
+	ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error)
+	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error)
+	RemoveDocumentByAdmin(ctx context.Context, in *RemoveDocumentByAdminRequest, opts ...grpc.CallOption) (*RemoveDocumentByAdminResponse, error)
+	RemoveDocumentsByAdmin(ctx context.Context, in *RemoveDocumentsByAdminRequest, opts ...grpc.CallOption) (*RemoveDocumentsByAdminResponse, error)
+	UpdateDocumentACL(ctx context.Context, in *UpdateDocumentACLRequest, opts ...grpc.CallOption) (*UpdateDocumentACLResponse, error)
+	UpdateDocumentLabels(ctx context.Context, in *UpdateDocumentLabelsRequest, opts ...grpc.CallOption) (*UpdateDocumentLabelsResponse, error)
+	UpdateDocumentSnapshotConfig(ctx context.Context, in *UpdateDocumentSnapshotConfigRequest, opts ...grpc.CallOption) (*UpdateDocumentSnapshotConfigResponse, error)
+	GetSnapshotMeta(ctx context.Context, in *GetSnapshotMetaRequest, opts ...grpc.CallOption) (*GetSnapshotMetaResponse, error)
+	SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsResponse, error)
+	ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error)
+	VerifyDocument(ctx context.Context, in *VerifyDocumentRequest, opts ...grpc.CallOption) (*VerifyDocumentResponse, error)
+	RestoreDocument(ctx context.Context, in *RestoreDocumentRequest, opts ...grpc.CallOption) (*RestoreDocumentResponse, error)
+	EvictDocument(ctx context.Context, in *EvictDocumentRequest, opts ...grpc.CallOption) (*EvictDocumentResponse, error)
+	GCDocument(ctx context.Context, in *GCDocumentRequest, opts ...grpc.CallOption) (*GCDocumentResponse, error)
+	ExportDocument(ctx context.Context, in *ExportDocumentRequest, opts ...grpc.CallOption) (*ExportDocumentResponse, error)
+	ImportDocument(ctx context.Context, in *ImportDocumentRequest, opts ...grpc.CallOption) (*ImportDocumentResponse, error)
+	BackupProject(ctx context.Context, in *BackupProjectRequest, opts ...grpc.CallOption) (AdminService_BackupProjectClient, error)
+	ListDocumentMemories(ctx context.Context, in *ListDocumentMemoriesRequest, opts ...grpc.CallOption) (*ListDocumentMemoriesResponse, error)
+	ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error)
+	RegisterDocumentTemplate(ctx context.Context, in *RegisterDocumentTemplateRequest, opts ...grpc.CallOption) (*RegisterDocumentTemplateResponse, error)
+	ListDocumentTemplates(ctx context.Context, in *ListDocumentTemplatesRequest, opts ...grpc.CallOption) (*ListDocumentTemplatesResponse, error)
+	RemoveDocumentTemplate(ctx context.Context, in *RemoveDocumentTemplateRequest, opts ...grpc.CallOption) (*RemoveDocumentTemplateResponse, error)
+	UpdateLogLevels(ctx context.Context, in *UpdateLogLevelsRequest, opts ...grpc.CallOption) (*UpdateLogLevelsResponse, error)
+}
+
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error) {
+	out := new(SignUpResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/SignUp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) LogIn(ctx context.Context, in *LogInRequest, opts ...grpc.CallOption) (*LogInResponse, error) {
+	out := new(LogInResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/LogIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ProjectName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
+	out := new(CreateProjectResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/CreateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.DocumentKey)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+	out := new(ListProjectsResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ListProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminServiceClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
+	out := new(GetProjectResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/GetProject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
+	out := new(UpdateProjectResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/UpdateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Document != nil {
-		l = m.Document.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error) {
+	out := new(ListDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ListDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error) {
+	out := new(GetDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/GetDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RemoveDocumentByAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) RemoveDocumentByAdmin(ctx context.Context, in *RemoveDocumentByAdminRequest, opts ...grpc.CallOption) (*RemoveDocumentByAdminResponse, error) {
+	out := new(RemoveDocumentByAdminResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/RemoveDocumentByAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ProjectName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveDocumentsByAdmin(ctx context.Context, in *RemoveDocumentsByAdminRequest, opts ...grpc.CallOption) (*RemoveDocumentsByAdminResponse, error) {
+	out := new(RemoveDocumentsByAdminResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/RemoveDocumentsByAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.DocumentKey)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateDocumentACL(ctx context.Context, in *UpdateDocumentACLRequest, opts ...grpc.CallOption) (*UpdateDocumentACLResponse, error) {
+	out := new(UpdateDocumentACLResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/UpdateDocumentACL", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Force {
-		n += 2
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateDocumentLabels(ctx context.Context, in *UpdateDocumentLabelsRequest, opts ...grpc.CallOption) (*UpdateDocumentLabelsResponse, error) {
+	out := new(UpdateDocumentLabelsResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/UpdateDocumentLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateDocumentSnapshotConfig(ctx context.Context, in *UpdateDocumentSnapshotConfigRequest, opts ...grpc.CallOption) (*UpdateDocumentSnapshotConfigResponse, error) {
+	out := new(UpdateDocumentSnapshotConfigResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/UpdateDocumentSnapshotConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *RemoveDocumentByAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) GetSnapshotMeta(ctx context.Context, in *GetSnapshotMetaRequest, opts ...grpc.CallOption) (*GetSnapshotMetaResponse, error) {
+	out := new(GetSnapshotMetaResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/GetSnapshotMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminServiceClient) SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsResponse, error) {
+	out := new(SearchDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/SearchDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetSnapshotMetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error) {
+	out := new(ListChangesResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ListChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ProjectName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) VerifyDocument(ctx context.Context, in *VerifyDocumentRequest, opts ...grpc.CallOption) (*VerifyDocumentResponse, error) {
+	out := new(VerifyDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/VerifyDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.DocumentKey)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreDocument(ctx context.Context, in *RestoreDocumentRequest, opts ...grpc.CallOption) (*RestoreDocumentResponse, error) {
+	out := new(RestoreDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/RestoreDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ServerSeq != 0 {
-		n += 1 + sovAdmin(uint64(m.ServerSeq))
+	return out, nil
+}
+
+func (c *adminServiceClient) EvictDocument(ctx context.Context, in *EvictDocumentRequest, opts ...grpc.CallOption) (*EvictDocumentResponse, error) {
+	out := new(EvictDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/EvictDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminServiceClient) GCDocument(ctx context.Context, in *GCDocumentRequest, opts ...grpc.CallOption) (*GCDocumentResponse, error) {
+	out := new(GCDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/GCDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetSnapshotMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) ExportDocument(ctx context.Context, in *ExportDocumentRequest, opts ...grpc.CallOption) (*ExportDocumentResponse, error) {
+	out := new(ExportDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ExportDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Snapshot)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) ImportDocument(ctx context.Context, in *ImportDocumentRequest, opts ...grpc.CallOption) (*ImportDocumentResponse, error) {
+	out := new(ImportDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ImportDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Lamport != 0 {
-		n += 1 + sovAdmin(uint64(m.Lamport))
+	return out, nil
+}
+
+func (c *adminServiceClient) BackupProject(ctx context.Context, in *BackupProjectRequest, opts ...grpc.CallOption) (AdminService_BackupProjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[0], "/yorkie.v1.AdminService/BackupProject", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	x := &adminServiceBackupProjectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return n
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *SearchDocumentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type AdminService_BackupProjectClient interface {
+	Recv() (*BackupProjectResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceBackupProjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceBackupProjectClient) Recv() (*BackupProjectResponse, error) {
+	m := new(BackupProjectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ProjectName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return m, nil
+}
+
+func (c *adminServiceClient) ListDocumentMemories(ctx context.Context, in *ListDocumentMemoriesRequest, opts ...grpc.CallOption) (*ListDocumentMemoriesResponse, error) {
+	out := new(ListDocumentMemoriesResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ListDocumentMemories", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) ListClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error) {
+	out := new(ListClientsResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ListClients", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovAdmin(uint64(m.PageSize))
+	return out, nil
+}
+
+func (c *adminServiceClient) RegisterDocumentTemplate(ctx context.Context, in *RegisterDocumentTemplateRequest, opts ...grpc.CallOption) (*RegisterDocumentTemplateResponse, error) {
+	out := new(RegisterDocumentTemplateResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/RegisterDocumentTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDocumentTemplates(ctx context.Context, in *ListDocumentTemplatesRequest, opts ...grpc.CallOption) (*ListDocumentTemplatesResponse, error) {
+	out := new(ListDocumentTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/ListDocumentTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SearchDocumentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) RemoveDocumentTemplate(ctx context.Context, in *RemoveDocumentTemplateRequest, opts ...grpc.CallOption) (*RemoveDocumentTemplateResponse, error) {
+	out := new(RemoveDocumentTemplateResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/RemoveDocumentTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TotalCount != 0 {
-		n += 1 + sovAdmin(uint64(m.TotalCount))
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateLogLevels(ctx context.Context, in *UpdateLogLevelsRequest, opts ...grpc.CallOption) (*UpdateLogLevelsResponse, error) {
+	out := new(UpdateLogLevelsResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.AdminService/UpdateLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Documents) > 0 {
-		for _, e := range m.Documents {
-			l = e.Size()
-			n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	SignUp(context.Context, *SignUpRequest) (*SignUpResponse, error)
+	LogIn(context.Context, *LogInRequest) (*LogInResponse, error)
+	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
+	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
+	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
+	GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error)
+	RemoveDocumentByAdmin(context.Context, *RemoveDocumentByAdminRequest) (*RemoveDocumentByAdminResponse, error)
+	RemoveDocumentsByAdmin(context.Context, *RemoveDocumentsByAdminRequest) (*RemoveDocumentsByAdminResponse, error)
+	UpdateDocumentACL(context.Context, *UpdateDocumentACLRequest) (*UpdateDocumentACLResponse, error)
+	UpdateDocumentLabels(context.Context, *UpdateDocumentLabelsRequest) (*UpdateDocumentLabelsResponse, error)
+	UpdateDocumentSnapshotConfig(context.Context, *UpdateDocumentSnapshotConfigRequest) (*UpdateDocumentSnapshotConfigResponse, error)
+	GetSnapshotMeta(context.Context, *GetSnapshotMetaRequest) (*GetSnapshotMetaResponse, error)
+	SearchDocuments(context.Context, *SearchDocumentsRequest) (*SearchDocumentsResponse, error)
+	ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error)
+	VerifyDocument(context.Context, *VerifyDocumentRequest) (*VerifyDocumentResponse, error)
+	RestoreDocument(context.Context, *RestoreDocumentRequest) (*RestoreDocumentResponse, error)
+	EvictDocument(context.Context, *EvictDocumentRequest) (*EvictDocumentResponse, error)
+	GCDocument(context.Context, *GCDocumentRequest) (*GCDocumentResponse, error)
+	ExportDocument(context.Context, *ExportDocumentRequest) (*ExportDocumentResponse, error)
+	ImportDocument(context.Context, *ImportDocumentRequest) (*ImportDocumentResponse, error)
+	BackupProject(*BackupProjectRequest, AdminService_BackupProjectServer) error
+	ListDocumentMemories(context.Context, *ListDocumentMemoriesRequest) (*ListDocumentMemoriesResponse, error)
+	ListClients(context.Context, *ListClientsRequest) (*ListClientsResponse, error)
+	RegisterDocumentTemplate(context.Context, *RegisterDocumentTemplateRequest) (*RegisterDocumentTemplateResponse, error)
+	ListDocumentTemplates(context.Context, *ListDocumentTemplatesRequest) (*ListDocumentTemplatesResponse, error)
+	RemoveDocumentTemplate(context.Context, *RemoveDocumentTemplateRequest) (*RemoveDocumentTemplateResponse, error)
+	UpdateLogLevels(context.Context, *UpdateLogLevelsRequest) (*UpdateLogLevelsResponse, error)
+}
+
+// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (*UnimplementedAdminServiceServer) SignUp(ctx context.Context, req *SignUpRequest) (*SignUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUp not implemented")
+}
+func (*UnimplementedAdminServiceServer) LogIn(ctx context.Context, req *LogInRequest) (*LogInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogIn not implemented")
+}
+func (*UnimplementedAdminServiceServer) CreateProject(ctx context.Context, req *CreateProjectRequest) (*CreateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListProjects(ctx context.Context, req *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetProject(ctx context.Context, req *GetProjectRequest) (*GetProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateProject(ctx context.Context, req *UpdateProjectRequest) (*UpdateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDocuments(ctx context.Context, req *ListDocumentsRequest) (*ListDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocuments not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetDocument(ctx context.Context, req *GetDocumentRequest) (*GetDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocument not implemented")
+}
+func (*UnimplementedAdminServiceServer) RemoveDocumentByAdmin(ctx context.Context, req *RemoveDocumentByAdminRequest) (*RemoveDocumentByAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDocumentByAdmin not implemented")
+}
+func (*UnimplementedAdminServiceServer) RemoveDocumentsByAdmin(ctx context.Context, req *RemoveDocumentsByAdminRequest) (*RemoveDocumentsByAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDocumentsByAdmin not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateDocumentACL(ctx context.Context, req *UpdateDocumentACLRequest) (*UpdateDocumentACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDocumentACL not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateDocumentLabels(ctx context.Context, req *UpdateDocumentLabelsRequest) (*UpdateDocumentLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDocumentLabels not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateDocumentSnapshotConfig(ctx context.Context, req *UpdateDocumentSnapshotConfigRequest) (*UpdateDocumentSnapshotConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDocumentSnapshotConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetSnapshotMeta(ctx context.Context, req *GetSnapshotMetaRequest) (*GetSnapshotMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshotMeta not implemented")
+}
+func (*UnimplementedAdminServiceServer) SearchDocuments(ctx context.Context, req *SearchDocumentsRequest) (*SearchDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDocuments not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListChanges(ctx context.Context, req *ListChangesRequest) (*ListChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChanges not implemented")
+}
+func (*UnimplementedAdminServiceServer) VerifyDocument(ctx context.Context, req *VerifyDocumentRequest) (*VerifyDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDocument not implemented")
+}
+func (*UnimplementedAdminServiceServer) RestoreDocument(ctx context.Context, req *RestoreDocumentRequest) (*RestoreDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDocument not implemented")
+}
+func (*UnimplementedAdminServiceServer) EvictDocument(ctx context.Context, req *EvictDocumentRequest) (*EvictDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictDocument not implemented")
+}
+func (*UnimplementedAdminServiceServer) GCDocument(ctx context.Context, req *GCDocumentRequest) (*GCDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCDocument not implemented")
+}
+func (*UnimplementedAdminServiceServer) ExportDocument(ctx context.Context, req *ExportDocumentRequest) (*ExportDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDocument not implemented")
+}
+func (*UnimplementedAdminServiceServer) ImportDocument(ctx context.Context, req *ImportDocumentRequest) (*ImportDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDocument not implemented")
+}
+func (*UnimplementedAdminServiceServer) BackupProject(req *BackupProjectRequest, srv AdminService_BackupProjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackupProject not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDocumentMemories(ctx context.Context, req *ListDocumentMemoriesRequest) (*ListDocumentMemoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocumentMemories not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListClients(ctx context.Context, req *ListClientsRequest) (*ListClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (*UnimplementedAdminServiceServer) RegisterDocumentTemplate(ctx context.Context, req *RegisterDocumentTemplateRequest) (*RegisterDocumentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDocumentTemplate not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDocumentTemplates(ctx context.Context, req *ListDocumentTemplatesRequest) (*ListDocumentTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocumentTemplates not implemented")
+}
+func (*UnimplementedAdminServiceServer) RemoveDocumentTemplate(ctx context.Context, req *RemoveDocumentTemplateRequest) (*RemoveDocumentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDocumentTemplate not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateLogLevels(ctx context.Context, req *UpdateLogLevelsRequest) (*UpdateLogLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogLevels not implemented")
+}
+
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SignUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/SignUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SignUp(ctx, req.(*SignUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_LogIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).LogIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/LogIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).LogIn(ctx, req.(*LogInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/CreateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/ListProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/GetProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/UpdateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/ListDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDocuments(ctx, req.(*ListDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/GetDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDocument(ctx, req.(*GetDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveDocumentByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDocumentByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveDocumentByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/RemoveDocumentByAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveDocumentByAdmin(ctx, req.(*RemoveDocumentByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveDocumentsByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDocumentsByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveDocumentsByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/RemoveDocumentsByAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveDocumentsByAdmin(ctx, req.(*RemoveDocumentsByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateDocumentACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDocumentACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateDocumentACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/UpdateDocumentACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateDocumentACL(ctx, req.(*UpdateDocumentACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateDocumentLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDocumentLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateDocumentLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/UpdateDocumentLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateDocumentLabels(ctx, req.(*UpdateDocumentLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateDocumentSnapshotConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDocumentSnapshotConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateDocumentSnapshotConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/UpdateDocumentSnapshotConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateDocumentSnapshotConfig(ctx, req.(*UpdateDocumentSnapshotConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetSnapshotMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnapshotMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetSnapshotMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/GetSnapshotMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetSnapshotMeta(ctx, req.(*GetSnapshotMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SearchDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SearchDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/SearchDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SearchDocuments(ctx, req.(*SearchDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/ListChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListChanges(ctx, req.(*ListChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_VerifyDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).VerifyDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/VerifyDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).VerifyDocument(ctx, req.(*VerifyDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/RestoreDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreDocument(ctx, req.(*RestoreDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EvictDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EvictDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/EvictDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EvictDocument(ctx, req.(*EvictDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GCDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GCDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/GCDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GCDocument(ctx, req.(*GCDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/ExportDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportDocument(ctx, req.(*ExportDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ImportDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/ImportDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportDocument(ctx, req.(*ImportDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BackupProject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupProjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).BackupProject(m, &adminServiceBackupProjectServer{stream})
+}
+
+type AdminService_BackupProjectServer interface {
+	Send(*BackupProjectResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceBackupProjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceBackupProjectServer) Send(m *BackupProjectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_ListDocumentMemories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentMemoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDocumentMemories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/ListDocumentMemories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDocumentMemories(ctx, req.(*ListDocumentMemoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/ListClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListClients(ctx, req.(*ListClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RegisterDocumentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDocumentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RegisterDocumentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/RegisterDocumentTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RegisterDocumentTemplate(ctx, req.(*RegisterDocumentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDocumentTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDocumentTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/ListDocumentTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDocumentTemplates(ctx, req.(*ListDocumentTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveDocumentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDocumentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveDocumentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/RemoveDocumentTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveDocumentTemplate(ctx, req.(*RemoveDocumentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLogLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.AdminService/UpdateLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateLogLevels(ctx, req.(*UpdateLogLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "yorkie.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignUp",
+			Handler:    _AdminService_SignUp_Handler,
+		},
+		{
+			MethodName: "LogIn",
+			Handler:    _AdminService_LogIn_Handler,
+		},
+		{
+			MethodName: "CreateProject",
+			Handler:    _AdminService_CreateProject_Handler,
+		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _AdminService_ListProjects_Handler,
+		},
+		{
+			MethodName: "GetProject",
+			Handler:    _AdminService_GetProject_Handler,
+		},
+		{
+			MethodName: "UpdateProject",
+			Handler:    _AdminService_UpdateProject_Handler,
+		},
+		{
+			MethodName: "ListDocuments",
+			Handler:    _AdminService_ListDocuments_Handler,
+		},
+		{
+			MethodName: "GetDocument",
+			Handler:    _AdminService_GetDocument_Handler,
+		},
+		{
+			MethodName: "RemoveDocumentByAdmin",
+			Handler:    _AdminService_RemoveDocumentByAdmin_Handler,
+		},
+		{
+			MethodName: "RemoveDocumentsByAdmin",
+			Handler:    _AdminService_RemoveDocumentsByAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateDocumentACL",
+			Handler:    _AdminService_UpdateDocumentACL_Handler,
+		},
+		{
+			MethodName: "UpdateDocumentLabels",
+			Handler:    _AdminService_UpdateDocumentLabels_Handler,
+		},
+		{
+			MethodName: "UpdateDocumentSnapshotConfig",
+			Handler:    _AdminService_UpdateDocumentSnapshotConfig_Handler,
+		},
+		{
+			MethodName: "GetSnapshotMeta",
+			Handler:    _AdminService_GetSnapshotMeta_Handler,
+		},
+		{
+			MethodName: "SearchDocuments",
+			Handler:    _AdminService_SearchDocuments_Handler,
+		},
+		{
+			MethodName: "ListChanges",
+			Handler:    _AdminService_ListChanges_Handler,
+		},
+		{
+			MethodName: "VerifyDocument",
+			Handler:    _AdminService_VerifyDocument_Handler,
+		},
+		{
+			MethodName: "RestoreDocument",
+			Handler:    _AdminService_RestoreDocument_Handler,
+		},
+		{
+			MethodName: "EvictDocument",
+			Handler:    _AdminService_EvictDocument_Handler,
+		},
+		{
+			MethodName: "GCDocument",
+			Handler:    _AdminService_GCDocument_Handler,
+		},
+		{
+			MethodName: "ExportDocument",
+			Handler:    _AdminService_ExportDocument_Handler,
+		},
+		{
+			MethodName: "ImportDocument",
+			Handler:    _AdminService_ImportDocument_Handler,
+		},
+		{
+			MethodName: "ListDocumentMemories",
+			Handler:    _AdminService_ListDocumentMemories_Handler,
+		},
+		{
+			MethodName: "ListClients",
+			Handler:    _AdminService_ListClients_Handler,
+		},
+		{
+			MethodName: "RegisterDocumentTemplate",
+			Handler:    _AdminService_RegisterDocumentTemplate_Handler,
+		},
+		{
+			MethodName: "ListDocumentTemplates",
+			Handler:    _AdminService_ListDocumentTemplates_Handler,
+		},
+		{
+			MethodName: "RemoveDocumentTemplate",
+			Handler:    _AdminService_RemoveDocumentTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateLogLevels",
+			Handler:    _AdminService_UpdateLogLevels_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BackupProject",
+			Handler:       _AdminService_BackupProject_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "yorkie/v1/admin.proto",
+}
+
+func (m *SignUpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignUpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignUpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		{
+			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		{
+			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListProjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListProjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		{
+			size, err := m.Project.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDocumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdatedBefore != nil {
+		{
+			size, err := m.UpdatedBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.UpdatedAfter != nil {
+		{
+			size, err := m.UpdatedAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.KeyPrefix) > 0 {
+		i -= len(m.KeyPrefix)
+		copy(dAtA[i:], m.KeyPrefix)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.KeyPrefix)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LabelSelector) > 0 {
+		i -= len(m.LabelSelector)
+		copy(dAtA[i:], m.LabelSelector)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.LabelSelector)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.IncludeSnapshot {
+		i--
+		if m.IncludeSnapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsForward {
+		i--
+		if m.IsForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PreviousId) > 0 {
+		i -= len(m.PreviousId)
+		copy(dAtA[i:], m.PreviousId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PreviousId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Documents) > 0 {
+		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Document != nil {
+		{
+			size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDocumentByAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDocumentByAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDocumentByAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDocumentByAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDocumentByAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDocumentByAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDocumentsByAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDocumentsByAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDocumentsByAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LabelSelector) > 0 {
+		i -= len(m.LabelSelector)
+		copy(dAtA[i:], m.LabelSelector)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.LabelSelector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDocumentsByAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDocumentsByAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDocumentsByAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentKeys) > 0 {
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DocumentKeys[iNdEx])
+			copy(dAtA[i:], m.DocumentKeys[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDocumentACLRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDocumentACLRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDocumentACLRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Acl != nil {
+		{
+			size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDocumentACLResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDocumentACLResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDocumentACLResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Acl != nil {
+		{
+			size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDocumentLabelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDocumentLabelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDocumentLabelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDocumentLabelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDocumentLabelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDocumentLabelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDocumentSnapshotConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDocumentSnapshotConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDocumentSnapshotConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SnapshotInterval != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SnapshotInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SnapshotThreshold != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SnapshotThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDocumentSnapshotConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDocumentSnapshotConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDocumentSnapshotConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSnapshotMetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSnapshotMetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSnapshotMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSnapshotMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSnapshotMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSnapshotMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Lamport != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Lamport))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchDocumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Documents) > 0 {
+		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsForward {
+		i--
+		if m.IsForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousSeq != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PreviousSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RebuiltHash) > 0 {
+		i -= len(m.RebuiltHash)
+		copy(dAtA[i:], m.RebuiltHash)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RebuiltHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SnapshotHash) > 0 {
+		i -= len(m.SnapshotHash)
+		copy(dAtA[i:], m.SnapshotHash)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.SnapshotHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SnapshotServerSeq != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SnapshotServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvictDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvictDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientIds) > 0 {
+		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIds[iNdEx])
+			copy(dAtA[i:], m.ClientIds[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.ClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GCDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GCDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GCDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GCDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GCDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GCDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RemovedElements != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.RemovedElements))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeChanges {
+		i--
+		if m.IncludeChanges {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Export != nil {
+		{
+			size, err := m.Export.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Export != nil {
+		{
+			size, err := m.Export.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeChanges {
+		i--
+		if m.IncludeChanges {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDocumentMemoriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDocumentMemoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDocumentMemoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDocumentMemoriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDocumentMemoriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDocumentMemoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Documents) > 0 {
+		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsForward {
+		i--
+		if m.IsForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PreviousId) > 0 {
+		i -= len(m.PreviousId)
+		copy(dAtA[i:], m.PreviousId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PreviousId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterDocumentTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterDocumentTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterDocumentTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterDocumentTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterDocumentTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterDocumentTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDocumentTemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDocumentTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDocumentTemplatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDocumentTemplatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDocumentTemplatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDocumentTemplatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDocumentTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDocumentTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDocumentTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectName) > 0 {
+		i -= len(m.ProjectName)
+		copy(dAtA[i:], m.ProjectName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProjectName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDocumentTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDocumentTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDocumentTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateLogLevelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateLogLevelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateLogLevelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Levels) > 0 {
+		for k := range m.Levels {
+			v := m.Levels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateLogLevelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateLogLevelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateLogLevelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Levels) > 0 {
+		for k := range m.Levels {
+			v := m.Levels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignUpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Project != nil {
+		l = m.Project.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Project != nil {
+		l = m.Project.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListProjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListProjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for _, e := range m.Projects {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Project != nil {
+		l = m.Project.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDocumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.PreviousId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovAdmin(uint64(m.PageSize))
+	}
+	if m.IsForward {
+		n += 2
+	}
+	if m.IncludeSnapshot {
+		n += 2
+	}
+	l = len(m.LabelSelector)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.KeyPrefix)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.UpdatedAfter != nil {
+		l = m.UpdatedAfter.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.UpdatedBefore != nil {
+		l = m.UpdatedBefore.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Documents) > 0 {
+		for _, e := range m.Documents {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Document != nil {
+		l = m.Document.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDocumentByAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDocumentByAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDocumentsByAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.LabelSelector)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDocumentsByAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DocumentKeys) > 0 {
+		for _, s := range m.DocumentKeys {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDocumentACLRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDocumentACLResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDocumentLabelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDocumentLabelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDocumentSnapshotConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.SnapshotThreshold != 0 {
+		n += 1 + sovAdmin(uint64(m.SnapshotThreshold))
+	}
+	if m.SnapshotInterval != 0 {
+		n += 1 + sovAdmin(uint64(m.SnapshotInterval))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDocumentSnapshotConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSnapshotMetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovAdmin(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSnapshotMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Lamport != 0 {
+		n += 1 + sovAdmin(uint64(m.Lamport))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchDocumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovAdmin(uint64(m.PageSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalCount != 0 {
+		n += 1 + sovAdmin(uint64(m.TotalCount))
+	}
+	if len(m.Documents) > 0 {
+		for _, e := range m.Documents {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.PreviousSeq != 0 {
+		n += 1 + sovAdmin(uint64(m.PreviousSeq))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovAdmin(uint64(m.PageSize))
+	}
+	if m.IsForward {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotServerSeq != 0 {
+		n += 1 + sovAdmin(uint64(m.SnapshotServerSeq))
+	}
+	l = len(m.SnapshotHash)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RebuiltHash)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovAdmin(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovAdmin(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EvictDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EvictDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for _, s := range m.ClientIds {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GCDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GCDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemovedElements != 0 {
+		n += 1 + sovAdmin(uint64(m.RemovedElements))
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovAdmin(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.IncludeChanges {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Export != nil {
+		l = m.Export.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Export != nil {
+		l = m.Export.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovAdmin(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.IncludeChanges {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDocumentMemoriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdmin(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDocumentMemoriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Documents) > 0 {
+		for _, e := range m.Documents {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListClientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.PreviousId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovAdmin(uint64(m.PageSize))
+	}
+	if m.IsForward {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListClientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegisterDocumentTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegisterDocumentTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDocumentTemplatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDocumentTemplatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDocumentTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDocumentTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateLogLevelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Levels) > 0 {
+		for k, v := range m.Levels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateLogLevelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Levels) > 0 {
+		for k, v := range m.Levels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignUpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignUpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignUpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignUpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignUpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateProjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateProjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateProjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateProjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &Project{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &Project{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProjectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, &Project{})
+			if err := m.Projects[len(m.Projects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateProjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateProjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &UpdatableProjectFields{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateProjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateProjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Project == nil {
+				m.Project = &Project{}
+			}
+			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDocumentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDocumentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsForward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsForward = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSnapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSnapshot = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAfter == nil {
+				m.UpdatedAfter = &types.Timestamp{}
+			}
+			if err := m.UpdatedAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedBefore == nil {
+				m.UpdatedBefore = &types.Timestamp{}
+			}
+			if err := m.UpdatedBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDocumentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDocumentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Documents = append(m.Documents, &DocumentSummary{})
+			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Document == nil {
+				m.Document = &DocumentSummary{}
+			}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDocumentByAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDocumentByAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDocumentByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDocumentByAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDocumentByAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDocumentByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDocumentsByAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDocumentsByAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDocumentsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDocumentsByAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDocumentsByAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDocumentsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKeys = append(m.DocumentKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDocumentACLRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDocumentACLRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDocumentACLRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &DocumentACL{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDocumentACLResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDocumentACLResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDocumentACLResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &DocumentACL{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDocumentLabelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDocumentLabelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDocumentLabelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDocumentLabelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDocumentLabelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDocumentLabelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDocumentSnapshotConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDocumentSnapshotConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDocumentSnapshotConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotThreshold", wireType)
+			}
+			m.SnapshotThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+			}
+			m.SnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDocumentSnapshotConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDocumentSnapshotConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDocumentSnapshotConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSnapshotMetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSnapshotMetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSnapshotMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSnapshotMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSnapshotMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSnapshotMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.Snapshot == nil {
+				m.Snapshot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
+			}
+			m.Lamport = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lamport |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchDocumentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchDocumentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchDocumentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchDocumentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			m.TotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Documents = append(m.Documents, &DocumentSummary{})
+			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSeq", wireType)
+			}
+			m.PreviousSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsForward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsForward = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &Change{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotServerSeq", wireType)
+			}
+			m.SnapshotServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotServerSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebuiltHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RebuiltHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *ListChangesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProjectName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.DocumentKey)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.PreviousSeq != 0 {
-		n += 1 + sovAdmin(uint64(m.PreviousSeq))
-	}
-	if m.PageSize != 0 {
-		n += 1 + sovAdmin(uint64(m.PageSize))
-	}
-	if m.IsForward {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *ListChangesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovAdmin(uint64(l))
+func (m *RestoreDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovAdmin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *SignUpRequest) Unmarshal(dAtA []byte) error {
+func (m *EvictDocumentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3361,15 +12262,15 @@ func (m *SignUpRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignUpRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvictDocumentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignUpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvictDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3397,11 +12298,11 @@ func (m *SignUpRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3429,7 +12330,39 @@ func (m *SignUpRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3453,7 +12386,7 @@ func (m *SignUpRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignUpResponse) Unmarshal(dAtA []byte) error {
+func (m *EvictDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3476,17 +12409,17 @@ func (m *SignUpResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignUpResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvictDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvictDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3496,27 +12429,23 @@ func (m *SignUpResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &User{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientIds = append(m.ClientIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3540,7 +12469,7 @@ func (m *SignUpResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogInRequest) Unmarshal(dAtA []byte) error {
+func (m *GCDocumentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3563,15 +12492,15 @@ func (m *LogInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCDocumentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3599,11 +12528,11 @@ func (m *LogInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3631,7 +12560,7 @@ func (m *LogInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3655,7 +12584,7 @@ func (m *LogInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogInResponse) Unmarshal(dAtA []byte) error {
+func (m *GCDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3678,17 +12607,17 @@ func (m *LogInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedElements", wireType)
 			}
-			var stringLen uint64
+			m.RemovedElements = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3698,24 +12627,30 @@ func (m *LogInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RemovedElements |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3738,7 +12673,7 @@ func (m *LogInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateProjectRequest) Unmarshal(dAtA []byte) error {
+func (m *ExportDocumentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3761,15 +12696,15 @@ func (m *CreateProjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateProjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportDocumentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3797,8 +12732,60 @@ func (m *CreateProjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeChanges", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeChanges = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3821,7 +12808,7 @@ func (m *CreateProjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateProjectResponse) Unmarshal(dAtA []byte) error {
+func (m *ExportDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3844,15 +12831,15 @@ func (m *CreateProjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateProjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Export", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3879,10 +12866,10 @@ func (m *CreateProjectResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Project == nil {
-				m.Project = &Project{}
+			if m.Export == nil {
+				m.Export = &DocumentExport{}
 			}
-			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Export.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3908,7 +12895,7 @@ func (m *CreateProjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetProjectRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportDocumentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3931,15 +12918,15 @@ func (m *GetProjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetProjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportDocumentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3967,62 +12954,43 @@ func (m *GetProjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetProjectResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetProjectResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Export", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4049,10 +13017,10 @@ func (m *GetProjectResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Project == nil {
-				m.Project = &Project{}
+			if m.Export == nil {
+				m.Export = &DocumentExport{}
 			}
-			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Export.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4078,58 +13046,7 @@ func (m *GetProjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListProjectsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListProjectsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListProjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListProjectsResponse) Unmarshal(dAtA []byte) error {
+func (m *ImportDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4152,17 +13069,17 @@ func (m *ListProjectsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListProjectsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListProjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			var msglen int
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -4172,26 +13089,11 @@ func (m *ListProjectsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ServerSeq |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Projects = append(m.Projects, &Project{})
-			if err := m.Projects[len(m.Projects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -4214,7 +13116,7 @@ func (m *ListProjectsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateProjectRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupProjectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4237,15 +13139,15 @@ func (m *UpdateProjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateProjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupProjectRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4273,13 +13175,13 @@ func (m *UpdateProjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeChanges", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -4289,28 +13191,12 @@ func (m *UpdateProjectRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Fields == nil {
-				m.Fields = &UpdatableProjectFields{}
-			}
-			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
 			}
-			iNdEx = postIndex
+			m.IncludeChanges = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -4333,7 +13219,7 @@ func (m *UpdateProjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateProjectResponse) Unmarshal(dAtA []byte) error {
+func (m *BackupProjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4356,17 +13242,17 @@ func (m *UpdateProjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateProjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupProjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -4376,26 +13262,24 @@ func (m *UpdateProjectResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Project == nil {
-				m.Project = &Project{}
-			}
-			if err := m.Project.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4420,7 +13304,7 @@ func (m *UpdateProjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDocumentsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListDocumentMemoriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4443,10 +13327,10 @@ func (m *ListDocumentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDocumentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDocumentMemoriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDocumentMemoriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4482,81 +13366,10 @@ func (m *ListDocumentsRequest) Unmarshal(dAtA []byte) error {
 			m.ProjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviousId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsForward", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsForward = bool(v != 0)
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSnapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -4566,12 +13379,11 @@ func (m *ListDocumentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeSnapshot = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -4594,7 +13406,7 @@ func (m *ListDocumentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDocumentsResponse) Unmarshal(dAtA []byte) error {
+func (m *ListDocumentMemoriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4617,10 +13429,10 @@ func (m *ListDocumentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDocumentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDocumentMemoriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDocumentMemoriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4652,7 +13464,7 @@ func (m *ListDocumentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Documents = append(m.Documents, &DocumentSummary{})
+			m.Documents = append(m.Documents, &DocumentMemory{})
 			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4679,7 +13491,7 @@ func (m *ListDocumentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *ListClientsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4702,10 +13514,10 @@ func (m *GetDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListClientsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4742,7 +13554,7 @@ func (m *GetDocumentRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4770,8 +13582,47 @@ func (m *GetDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			m.PreviousId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsForward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsForward = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -4794,7 +13645,7 @@ func (m *GetDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *ListClientsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4817,15 +13668,15 @@ func (m *GetDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListClientsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4852,10 +13703,8 @@ func (m *GetDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Document == nil {
-				m.Document = &DocumentSummary{}
-			}
-			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Clients = append(m.Clients, &ClientSummary{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4881,7 +13730,7 @@ func (m *GetDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDocumentByAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisterDocumentTemplateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4904,10 +13753,10 @@ func (m *RemoveDocumentByAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDocumentByAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterDocumentTemplateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDocumentByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterDocumentTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4944,7 +13793,7 @@ func (m *RemoveDocumentByAdminRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4972,13 +13821,13 @@ func (m *RemoveDocumentByAdminRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			m.Collection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -4988,12 +13837,24 @@ func (m *RemoveDocumentByAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -5016,7 +13877,7 @@ func (m *RemoveDocumentByAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDocumentByAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisterDocumentTemplateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5039,12 +13900,48 @@ func (m *RemoveDocumentByAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDocumentByAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterDocumentTemplateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDocumentByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterDocumentTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &DocumentTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -5067,7 +13964,7 @@ func (m *RemoveDocumentByAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSnapshotMetaRequest) Unmarshal(dAtA []byte) error {
+func (m *ListDocumentTemplatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5089,48 +13986,16 @@ func (m *GetSnapshotMetaRequest) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetSnapshotMetaRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSnapshotMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDocumentTemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDocumentTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5158,27 +14023,8 @@ func (m *GetSnapshotMetaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
-			}
-			m.ServerSeq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ServerSeq |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -5201,7 +14047,7 @@ func (m *GetSnapshotMetaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSnapshotMetaResponse) Unmarshal(dAtA []byte) error {
+func (m *ListDocumentTemplatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5224,17 +14070,17 @@ func (m *GetSnapshotMetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSnapshotMetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDocumentTemplatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSnapshotMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDocumentTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -5244,45 +14090,26 @@ func (m *GetSnapshotMetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
-			if m.Snapshot == nil {
-				m.Snapshot = []byte{}
+			m.Templates = append(m.Templates, &DocumentTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
-			}
-			m.Lamport = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lamport |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -5305,7 +14132,7 @@ func (m *GetSnapshotMetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchDocumentsRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveDocumentTemplateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5328,10 +14155,10 @@ func (m *SearchDocumentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchDocumentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDocumentTemplateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDocumentTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5368,7 +14195,7 @@ func (m *SearchDocumentsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5396,27 +14223,8 @@ func (m *SearchDocumentsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Collection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -5439,7 +14247,7 @@ func (m *SearchDocumentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchDocumentsResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveDocumentTemplateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5462,65 +14270,12 @@ func (m *SearchDocumentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchDocumentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDocumentTemplateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDocumentTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
-			}
-			m.TotalCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Documents = append(m.Documents, &DocumentSummary{})
-			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -5543,7 +14298,7 @@ func (m *SearchDocumentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListChangesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateLogLevelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5566,49 +14321,17 @@ func (m *ListChangesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListChangesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateLogLevelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateLogLevelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -5618,82 +14341,119 @@ func (m *ListChangesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			if m.Levels == nil {
+				m.Levels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Levels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSeq", wireType)
-			}
-			m.PreviousSeq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousSeq |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsForward", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsForward = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -5716,7 +14476,7 @@ func (m *ListChangesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListChangesResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateLogLevelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5739,15 +14499,15 @@ func (m *ListChangesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListChangesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateLogLevelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateLogLevelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5774,10 +14534,103 @@ func (m *ListChangesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, &Change{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if m.Levels == nil {
+				m.Levels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Levels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40,6 +40,8 @@ const (
 	ValueType_VALUE_TYPE_INTEGER_CNT ValueType = 11
 	ValueType_VALUE_TYPE_LONG_CNT    ValueType = 12
 	ValueType_VALUE_TYPE_TREE        ValueType = 13
+	ValueType_VALUE_TYPE_DOUBLE_CNT  ValueType = 14
+	ValueType_VALUE_TYPE_SET         ValueType = 15
 )
 
 var ValueType_name = map[int32]string{
@@ -57,6 +59,8 @@ var ValueType_name = map[int32]string{
 	11: "VALUE_TYPE_INTEGER_CNT",
 	12: "VALUE_TYPE_LONG_CNT",
 	13: "VALUE_TYPE_TREE",
+	14: "VALUE_TYPE_DOUBLE_CNT",
+	15: "VALUE_TYPE_SET",
 }
 
 var ValueType_value = map[string]int32{
@@ -74,6 +78,8 @@ var ValueType_value = map[string]int32{
 	"VALUE_TYPE_INTEGER_CNT": 11,
 	"VALUE_TYPE_LONG_CNT":    12,
 	"VALUE_TYPE_TREE":        13,
+	"VALUE_TYPE_DOUBLE_CNT":  14,
+	"VALUE_TYPE_SET":         15,
 }
 
 func (x ValueType) String() string {
@@ -84,24 +90,61 @@ func (ValueType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_36361b2f5d0f0896, []int{0}
 }
 
+type ConflictPolicy int32
+
+const (
+	ConflictPolicy_CONFLICT_POLICY_LWW   ConflictPolicy = 0
+	ConflictPolicy_CONFLICT_POLICY_MERGE ConflictPolicy = 1
+)
+
+var ConflictPolicy_name = map[int32]string{
+	0: "CONFLICT_POLICY_LWW",
+	1: "CONFLICT_POLICY_MERGE",
+}
+
+var ConflictPolicy_value = map[string]int32{
+	"CONFLICT_POLICY_LWW":   0,
+	"CONFLICT_POLICY_MERGE": 1,
+}
+
+func (x ConflictPolicy) String() string {
+	return proto.EnumName(ConflictPolicy_name, int32(x))
+}
+
+func (ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{1}
+}
+
 type DocEventType int32
 
 const (
 	DocEventType_DOC_EVENT_TYPE_DOCUMENT_CHANGED   DocEventType = 0
 	DocEventType_DOC_EVENT_TYPE_DOCUMENT_WATCHED   DocEventType = 1
 	DocEventType_DOC_EVENT_TYPE_DOCUMENT_UNWATCHED DocEventType = 2
+	DocEventType_DOC_EVENT_TYPE_PEERS_CHANGED      DocEventType = 3
+	DocEventType_DOC_EVENT_TYPE_DOCUMENT_READ_ONLY DocEventType = 4
+	DocEventType_DOC_EVENT_TYPE_DOCUMENT_WRITABLE  DocEventType = 5
+	DocEventType_DOC_EVENT_TYPE_DOCUMENT_EVICTED   DocEventType = 6
 )
 
 var DocEventType_name = map[int32]string{
 	0: "DOC_EVENT_TYPE_DOCUMENT_CHANGED",
 	1: "DOC_EVENT_TYPE_DOCUMENT_WATCHED",
 	2: "DOC_EVENT_TYPE_DOCUMENT_UNWATCHED",
+	3: "DOC_EVENT_TYPE_PEERS_CHANGED",
+	4: "DOC_EVENT_TYPE_DOCUMENT_READ_ONLY",
+	5: "DOC_EVENT_TYPE_DOCUMENT_WRITABLE",
+	6: "DOC_EVENT_TYPE_DOCUMENT_EVICTED",
 }
 
 var DocEventType_value = map[string]int32{
 	"DOC_EVENT_TYPE_DOCUMENT_CHANGED":   0,
 	"DOC_EVENT_TYPE_DOCUMENT_WATCHED":   1,
 	"DOC_EVENT_TYPE_DOCUMENT_UNWATCHED": 2,
+	"DOC_EVENT_TYPE_PEERS_CHANGED":      3,
+	"DOC_EVENT_TYPE_DOCUMENT_READ_ONLY": 4,
+	"DOC_EVENT_TYPE_DOCUMENT_WRITABLE":  5,
+	"DOC_EVENT_TYPE_DOCUMENT_EVICTED":   6,
 }
 
 func (x DocEventType) String() string {
@@ -109,7 +152,7 @@ func (x DocEventType) String() string {
 }
 
 func (DocEventType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{1}
+	return fileDescriptor_36361b2f5d0f0896, []int{2}
 }
 
 type PresenceChange_ChangeType int32
@@ -140,7 +183,7 @@ func (x PresenceChange_ChangeType) String() string {
 }
 
 func (PresenceChange_ChangeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{20, 0}
+	return fileDescriptor_36361b2f5d0f0896, []int{29, 0}
 }
 
 // ///////////////////////////////////////
@@ -201,25 +244,166 @@ func (m *Snapshot) GetPresences() map[string]*Presence {
 	return nil
 }
 
+// SnapshotDelta is a snapshot encoded as the difference from the snapshot
+// that it is based on. The members of the root object that are not modified
+// since the base snapshot refer to the members of the base snapshot instead
+// of being encoded again.
+type SnapshotDelta struct {
+	Nodes     []*SnapshotDeltaNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Presences map[string]*Presence `protobuf:"bytes,2,rep,name=presences,proto3" json:"presences,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// root is the root object of the snapshot without its members.
+	Root                 *JSONElement `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SnapshotDelta) Reset()         { *m = SnapshotDelta{} }
+func (m *SnapshotDelta) String() string { return proto.CompactTextString(m) }
+func (*SnapshotDelta) ProtoMessage()    {}
+func (*SnapshotDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{1}
+}
+func (m *SnapshotDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotDelta.Merge(m, src)
+}
+func (m *SnapshotDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotDelta proto.InternalMessageInfo
+
+func (m *SnapshotDelta) GetNodes() []*SnapshotDeltaNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *SnapshotDelta) GetPresences() map[string]*Presence {
+	if m != nil {
+		return m.Presences
+	}
+	return nil
+}
+
+func (m *SnapshotDelta) GetRoot() *JSONElement {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type SnapshotDeltaNode struct {
+	// base_index is the index of the member in the base snapshot plus one, or
+	// zero if the member is encoded in node.
+	BaseIndex            uint32   `protobuf:"varint,1,opt,name=base_index,json=baseIndex,proto3" json:"base_index,omitempty"`
+	Node                 *RHTNode `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotDeltaNode) Reset()         { *m = SnapshotDeltaNode{} }
+func (m *SnapshotDeltaNode) String() string { return proto.CompactTextString(m) }
+func (*SnapshotDeltaNode) ProtoMessage()    {}
+func (*SnapshotDeltaNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{2}
+}
+func (m *SnapshotDeltaNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotDeltaNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotDeltaNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotDeltaNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotDeltaNode.Merge(m, src)
+}
+func (m *SnapshotDeltaNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotDeltaNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotDeltaNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotDeltaNode proto.InternalMessageInfo
+
+func (m *SnapshotDeltaNode) GetBaseIndex() uint32 {
+	if m != nil {
+		return m.BaseIndex
+	}
+	return 0
+}
+
+func (m *SnapshotDeltaNode) GetNode() *RHTNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
 // ChangePack is a message that contains all changes that occurred in a document.
 // It is used to synchronize changes between clients and servers.
 type ChangePack struct {
-	DocumentKey          string      `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
-	Checkpoint           *Checkpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
-	Snapshot             []byte      `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	Changes              []*Change   `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
-	MinSyncedTicket      *TimeTicket `protobuf:"bytes,5,opt,name=min_synced_ticket,json=minSyncedTicket,proto3" json:"min_synced_ticket,omitempty"`
-	IsRemoved            bool        `protobuf:"varint,6,opt,name=is_removed,json=isRemoved,proto3" json:"is_removed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	DocumentKey     string      `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Checkpoint      *Checkpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Snapshot        []byte      `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Changes         []*Change   `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	MinSyncedTicket *TimeTicket `protobuf:"bytes,5,opt,name=min_synced_ticket,json=minSyncedTicket,proto3" json:"min_synced_ticket,omitempty"`
+	IsRemoved       bool        `protobuf:"varint,6,opt,name=is_removed,json=isRemoved,proto3" json:"is_removed,omitempty"`
+	// is_compact indicates that the tickets of the changes in this pack are
+	// encoded compactly: actor IDs are replaced with indexes into actor_ids and
+	// lamports are encoded as deltas. The receiver replies in the same format.
+	IsCompact bool     `protobuf:"varint,7,opt,name=is_compact,json=isCompact,proto3" json:"is_compact,omitempty"`
+	ActorIds  [][]byte `protobuf:"bytes,8,rep,name=actor_ids,json=actorIds,proto3" json:"actor_ids,omitempty"`
+	// compression is the name of the compressor of compressed_changes and
+	// snapshot, such as "gzip" or "zstd". The changes of a compressed pack are
+	// encoded as a ChangePack with the changes only, then compressed into
+	// compressed_changes. The server compresses the packs of its responses
+	// only if the client requests it with the pack compression header.
+	Compression       string `protobuf:"bytes,9,opt,name=compression,proto3" json:"compression,omitempty"`
+	CompressedChanges []byte `protobuf:"bytes,10,opt,name=compressed_changes,json=compressedChanges,proto3" json:"compressed_changes,omitempty"`
+	// idempotency_key identifies the push of the changes in this pack. A
+	// client sends the same key when it retries the push of the same changes,
+	// and the server responds with the cached response of the first push.
+	IdempotencyKey       string   `protobuf:"bytes,11,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ChangePack) Reset()         { *m = ChangePack{} }
 func (m *ChangePack) String() string { return proto.CompactTextString(m) }
 func (*ChangePack) ProtoMessage()    {}
 func (*ChangePack) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{1}
+	return fileDescriptor_36361b2f5d0f0896, []int{3}
 }
 func (m *ChangePack) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,6 +474,41 @@ func (m *ChangePack) GetIsRemoved() bool {
 	return false
 }
 
+func (m *ChangePack) GetIsCompact() bool {
+	if m != nil {
+		return m.IsCompact
+	}
+	return false
+}
+
+func (m *ChangePack) GetActorIds() [][]byte {
+	if m != nil {
+		return m.ActorIds
+	}
+	return nil
+}
+
+func (m *ChangePack) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *ChangePack) GetCompressedChanges() []byte {
+	if m != nil {
+		return m.CompressedChanges
+	}
+	return nil
+}
+
+func (m *ChangePack) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 type Change struct {
 	Id                   *ChangeID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Message              string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -304,7 +523,7 @@ func (m *Change) Reset()         { *m = Change{} }
 func (m *Change) String() string { return proto.CompactTextString(m) }
 func (*Change) ProtoMessage()    {}
 func (*Change) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{2}
+	return fileDescriptor_36361b2f5d0f0896, []int{4}
 }
 func (m *Change) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,10 +581,16 @@ func (m *Change) GetPresenceChange() *PresenceChange {
 }
 
 type ChangeID struct {
-	ClientSeq            uint32   `protobuf:"varint,1,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
-	ServerSeq            int64    `protobuf:"varint,2,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
-	Lamport              int64    `protobuf:"varint,3,opt,name=lamport,proto3" json:"lamport,omitempty"`
-	ActorId              []byte   `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ClientSeq uint32 `protobuf:"varint,1,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
+	ServerSeq int64  `protobuf:"varint,2,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	Lamport   int64  `protobuf:"varint,3,opt,name=lamport,proto3" json:"lamport,omitempty"`
+	ActorId   []byte `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// The fields below are used instead of server_seq, lamport and actor_id
+	// when the enclosing pack is compact. The deltas are relative to the
+	// previous change in the pack.
+	ActorIndex           uint32   `protobuf:"varint,5,opt,name=actor_index,json=actorIndex,proto3" json:"actor_index,omitempty"`
+	ServerSeqDelta       int64    `protobuf:"zigzag64,6,opt,name=server_seq_delta,json=serverSeqDelta,proto3" json:"server_seq_delta,omitempty"`
+	LamportDelta         int64    `protobuf:"zigzag64,7,opt,name=lamport_delta,json=lamportDelta,proto3" json:"lamport_delta,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -375,7 +600,7 @@ func (m *ChangeID) Reset()         { *m = ChangeID{} }
 func (m *ChangeID) String() string { return proto.CompactTextString(m) }
 func (*ChangeID) ProtoMessage()    {}
 func (*ChangeID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{3}
+	return fileDescriptor_36361b2f5d0f0896, []int{5}
 }
 func (m *ChangeID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,6 +657,27 @@ func (m *ChangeID) GetActorId() []byte {
 	return nil
 }
 
+func (m *ChangeID) GetActorIndex() uint32 {
+	if m != nil {
+		return m.ActorIndex
+	}
+	return 0
+}
+
+func (m *ChangeID) GetServerSeqDelta() int64 {
+	if m != nil {
+		return m.ServerSeqDelta
+	}
+	return 0
+}
+
+func (m *ChangeID) GetLamportDelta() int64 {
+	if m != nil {
+		return m.LamportDelta
+	}
+	return 0
+}
+
 type Operation struct {
 	// Types that are valid to be assigned to Body:
 	//
@@ -446,6 +692,10 @@ type Operation struct {
 	//	*Operation_TreeEdit_
 	//	*Operation_TreeStyle_
 	//	*Operation_EditReverse_
+	//	*Operation_AddAnnotation_
+	//	*Operation_RemoveAnnotation_
+	//	*Operation_SetAdd_
+	//	*Operation_SetRemove_
 	Body                 isOperation_Body `protobuf_oneof:"body"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
@@ -456,7 +706,7 @@ func (m *Operation) Reset()         { *m = Operation{} }
 func (m *Operation) String() string { return proto.CompactTextString(m) }
 func (*Operation) ProtoMessage()    {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4}
+	return fileDescriptor_36361b2f5d0f0896, []int{6}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,18 +774,34 @@ type Operation_TreeStyle_ struct {
 type Operation_EditReverse_ struct {
 	EditReverse *Operation_EditReverse `protobuf:"bytes,11,opt,name=edit_reverse,json=editReverse,proto3,oneof" json:"edit_reverse,omitempty"`
 }
+type Operation_AddAnnotation_ struct {
+	AddAnnotation *Operation_AddAnnotation `protobuf:"bytes,12,opt,name=add_annotation,json=addAnnotation,proto3,oneof" json:"add_annotation,omitempty"`
+}
+type Operation_RemoveAnnotation_ struct {
+	RemoveAnnotation *Operation_RemoveAnnotation `protobuf:"bytes,13,opt,name=remove_annotation,json=removeAnnotation,proto3,oneof" json:"remove_annotation,omitempty"`
+}
+type Operation_SetAdd_ struct {
+	SetAdd *Operation_SetAdd `protobuf:"bytes,14,opt,name=set_add,json=setAdd,proto3,oneof" json:"set_add,omitempty"`
+}
+type Operation_SetRemove_ struct {
+	SetRemove *Operation_SetRemove `protobuf:"bytes,15,opt,name=set_remove,json=setRemove,proto3,oneof" json:"set_remove,omitempty"`
+}
 
-func (*Operation_Set_) isOperation_Body()         {}
-func (*Operation_Add_) isOperation_Body()         {}
-func (*Operation_Move_) isOperation_Body()        {}
-func (*Operation_Remove_) isOperation_Body()      {}
-func (*Operation_Edit_) isOperation_Body()        {}
-func (*Operation_Select_) isOperation_Body()      {}
-func (*Operation_Style_) isOperation_Body()       {}
-func (*Operation_Increase_) isOperation_Body()    {}
-func (*Operation_TreeEdit_) isOperation_Body()    {}
-func (*Operation_TreeStyle_) isOperation_Body()   {}
-func (*Operation_EditReverse_) isOperation_Body() {}
+func (*Operation_Set_) isOperation_Body()              {}
+func (*Operation_Add_) isOperation_Body()              {}
+func (*Operation_Move_) isOperation_Body()             {}
+func (*Operation_Remove_) isOperation_Body()           {}
+func (*Operation_Edit_) isOperation_Body()             {}
+func (*Operation_Select_) isOperation_Body()           {}
+func (*Operation_Style_) isOperation_Body()            {}
+func (*Operation_Increase_) isOperation_Body()         {}
+func (*Operation_TreeEdit_) isOperation_Body()         {}
+func (*Operation_TreeStyle_) isOperation_Body()        {}
+func (*Operation_EditReverse_) isOperation_Body()      {}
+func (*Operation_AddAnnotation_) isOperation_Body()    {}
+func (*Operation_RemoveAnnotation_) isOperation_Body() {}
+func (*Operation_SetAdd_) isOperation_Body()           {}
+func (*Operation_SetRemove_) isOperation_Body()        {}
 
 func (m *Operation) GetBody() isOperation_Body {
 	if m != nil {
@@ -621,6 +887,34 @@ func (m *Operation) GetEditReverse() *Operation_EditReverse {
 	return nil
 }
 
+func (m *Operation) GetAddAnnotation() *Operation_AddAnnotation {
+	if x, ok := m.GetBody().(*Operation_AddAnnotation_); ok {
+		return x.AddAnnotation
+	}
+	return nil
+}
+
+func (m *Operation) GetRemoveAnnotation() *Operation_RemoveAnnotation {
+	if x, ok := m.GetBody().(*Operation_RemoveAnnotation_); ok {
+		return x.RemoveAnnotation
+	}
+	return nil
+}
+
+func (m *Operation) GetSetAdd() *Operation_SetAdd {
+	if x, ok := m.GetBody().(*Operation_SetAdd_); ok {
+		return x.SetAdd
+	}
+	return nil
+}
+
+func (m *Operation) GetSetRemove() *Operation_SetRemove {
+	if x, ok := m.GetBody().(*Operation_SetRemove_); ok {
+		return x.SetRemove
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Operation) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -635,6 +929,10 @@ func (*Operation) XXX_OneofWrappers() []interface{} {
 		(*Operation_TreeEdit_)(nil),
 		(*Operation_TreeStyle_)(nil),
 		(*Operation_EditReverse_)(nil),
+		(*Operation_AddAnnotation_)(nil),
+		(*Operation_RemoveAnnotation_)(nil),
+		(*Operation_SetAdd_)(nil),
+		(*Operation_SetRemove_)(nil),
 	}
 }
 
@@ -652,7 +950,7 @@ func (m *Operation_Set) Reset()         { *m = Operation_Set{} }
 func (m *Operation_Set) String() string { return proto.CompactTextString(m) }
 func (*Operation_Set) ProtoMessage()    {}
 func (*Operation_Set) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 0}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 0}
 }
 func (m *Operation_Set) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +1021,7 @@ func (m *Operation_Add) Reset()         { *m = Operation_Add{} }
 func (m *Operation_Add) String() string { return proto.CompactTextString(m) }
 func (*Operation_Add) ProtoMessage()    {}
 func (*Operation_Add) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 1}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 1}
 }
 func (m *Operation_Add) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -794,7 +1092,7 @@ func (m *Operation_Move) Reset()         { *m = Operation_Move{} }
 func (m *Operation_Move) String() string { return proto.CompactTextString(m) }
 func (*Operation_Move) ProtoMessage()    {}
 func (*Operation_Move) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 2}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 2}
 }
 func (m *Operation_Move) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +1162,7 @@ func (m *Operation_Remove) Reset()         { *m = Operation_Remove{} }
 func (m *Operation_Remove) String() string { return proto.CompactTextString(m) }
 func (*Operation_Remove) ProtoMessage()    {}
 func (*Operation_Remove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 3}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 3}
 }
 func (m *Operation_Remove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -931,7 +1229,7 @@ func (m *Operation_Edit) Reset()         { *m = Operation_Edit{} }
 func (m *Operation_Edit) String() string { return proto.CompactTextString(m) }
 func (*Operation_Edit) ProtoMessage()    {}
 func (*Operation_Edit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 4}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 4}
 }
 func (m *Operation_Edit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1027,7 +1325,7 @@ func (m *Operation_Select) Reset()         { *m = Operation_Select{} }
 func (m *Operation_Select) String() string { return proto.CompactTextString(m) }
 func (*Operation_Select) ProtoMessage()    {}
 func (*Operation_Select) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 5}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 5}
 }
 func (m *Operation_Select) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,21 +1383,22 @@ func (m *Operation_Select) GetExecutedAt() *TimeTicket {
 }
 
 type Operation_Style struct {
-	ParentCreatedAt      *TimeTicket       `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	From                 *TextNodePos      `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To                   *TextNodePos      `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Attributes           map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ExecutedAt           *TimeTicket       `protobuf:"bytes,5,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	From                 *TextNodePos           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TextNodePos           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Attributes           map[string]string      `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExecutedAt           *TimeTicket            `protobuf:"bytes,5,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,6,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *Operation_Style) Reset()         { *m = Operation_Style{} }
 func (m *Operation_Style) String() string { return proto.CompactTextString(m) }
 func (*Operation_Style) ProtoMessage()    {}
 func (*Operation_Style) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 6}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 6}
 }
 func (m *Operation_Style) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,6 +1462,13 @@ func (m *Operation_Style) GetExecutedAt() *TimeTicket {
 	return nil
 }
 
+func (m *Operation_Style) GetCreatedAtMapByActor() map[string]*TimeTicket {
+	if m != nil {
+		return m.CreatedAtMapByActor
+	}
+	return nil
+}
+
 type Operation_Increase struct {
 	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
 	Value                *JSONElementSimple `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -1176,7 +1482,7 @@ func (m *Operation_Increase) Reset()         { *m = Operation_Increase{} }
 func (m *Operation_Increase) String() string { return proto.CompactTextString(m) }
 func (*Operation_Increase) ProtoMessage()    {}
 func (*Operation_Increase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 7}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 7}
 }
 func (m *Operation_Increase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1242,7 +1548,7 @@ func (m *Operation_TreeEdit) Reset()         { *m = Operation_TreeEdit{} }
 func (m *Operation_TreeEdit) String() string { return proto.CompactTextString(m) }
 func (*Operation_TreeEdit) ProtoMessage()    {}
 func (*Operation_TreeEdit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 8}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 8}
 }
 func (m *Operation_TreeEdit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1328,7 +1634,7 @@ func (m *Operation_TreeStyle) Reset()         { *m = Operation_TreeStyle{} }
 func (m *Operation_TreeStyle) String() string { return proto.CompactTextString(m) }
 func (*Operation_TreeStyle) ProtoMessage()    {}
 func (*Operation_TreeStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 9}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 9}
 }
 func (m *Operation_TreeStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1409,7 +1715,7 @@ func (m *Operation_EditReverse) Reset()         { *m = Operation_EditReverse{} }
 func (m *Operation_EditReverse) String() string { return proto.CompactTextString(m) }
 func (*Operation_EditReverse) ProtoMessage()    {}
 func (*Operation_EditReverse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{4, 10}
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 10}
 }
 func (m *Operation_EditReverse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1487,29 +1793,30 @@ func (m *Operation_EditReverse) GetAttributes() map[string]string {
 	return nil
 }
 
-type JSONElementSimple struct {
-	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket `protobuf:"bytes,2,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,3,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	Type                 ValueType   `protobuf:"varint,4,opt,name=type,proto3,enum=yorkie.v1.ValueType" json:"type,omitempty"`
-	Value                []byte      `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type Operation_AddAnnotation struct {
+	ParentCreatedAt      *TimeTicket  `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	Name                 string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	From                 *TextNodePos `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TextNodePos `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Value                string       `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	ExecutedAt           *TimeTicket  `protobuf:"bytes,6,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *JSONElementSimple) Reset()         { *m = JSONElementSimple{} }
-func (m *JSONElementSimple) String() string { return proto.CompactTextString(m) }
-func (*JSONElementSimple) ProtoMessage()    {}
-func (*JSONElementSimple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{5}
+func (m *Operation_AddAnnotation) Reset()         { *m = Operation_AddAnnotation{} }
+func (m *Operation_AddAnnotation) String() string { return proto.CompactTextString(m) }
+func (*Operation_AddAnnotation) ProtoMessage()    {}
+func (*Operation_AddAnnotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 11}
 }
-func (m *JSONElementSimple) XXX_Unmarshal(b []byte) error {
+func (m *Operation_AddAnnotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JSONElementSimple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Operation_AddAnnotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JSONElementSimple.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Operation_AddAnnotation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1519,73 +1826,365 @@ func (m *JSONElementSimple) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *JSONElementSimple) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JSONElementSimple.Merge(m, src)
+func (m *Operation_AddAnnotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_AddAnnotation.Merge(m, src)
 }
-func (m *JSONElementSimple) XXX_Size() int {
+func (m *Operation_AddAnnotation) XXX_Size() int {
 	return m.Size()
 }
-func (m *JSONElementSimple) XXX_DiscardUnknown() {
-	xxx_messageInfo_JSONElementSimple.DiscardUnknown(m)
+func (m *Operation_AddAnnotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_AddAnnotation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JSONElementSimple proto.InternalMessageInfo
+var xxx_messageInfo_Operation_AddAnnotation proto.InternalMessageInfo
 
-func (m *JSONElementSimple) GetCreatedAt() *TimeTicket {
+func (m *Operation_AddAnnotation) GetParentCreatedAt() *TimeTicket {
 	if m != nil {
-		return m.CreatedAt
+		return m.ParentCreatedAt
 	}
 	return nil
 }
 
-func (m *JSONElementSimple) GetMovedAt() *TimeTicket {
+func (m *Operation_AddAnnotation) GetName() string {
 	if m != nil {
-		return m.MovedAt
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *JSONElementSimple) GetRemovedAt() *TimeTicket {
+func (m *Operation_AddAnnotation) GetFrom() *TextNodePos {
 	if m != nil {
-		return m.RemovedAt
+		return m.From
 	}
 	return nil
 }
 
-func (m *JSONElementSimple) GetType() ValueType {
+func (m *Operation_AddAnnotation) GetTo() *TextNodePos {
 	if m != nil {
-		return m.Type
+		return m.To
 	}
-	return ValueType_VALUE_TYPE_NULL
+	return nil
 }
 
-func (m *JSONElementSimple) GetValue() []byte {
+func (m *Operation_AddAnnotation) GetValue() string {
 	if m != nil {
 		return m.Value
 	}
+	return ""
+}
+
+func (m *Operation_AddAnnotation) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
 	return nil
 }
 
-type JSONElement struct {
-	// Types that are valid to be assigned to Body:
-	//
-	//	*JSONElement_JsonObject
-	//	*JSONElement_JsonArray
-	//	*JSONElement_Primitive_
-	//	*JSONElement_Text_
-	//	*JSONElement_Counter_
-	//	*JSONElement_Tree_
-	Body                 isJSONElement_Body `protobuf_oneof:"body"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type Operation_RemoveAnnotation struct {
+	ParentCreatedAt      *TimeTicket `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExecutedAt           *TimeTicket `protobuf:"bytes,3,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *JSONElement) Reset()         { *m = JSONElement{} }
+func (m *Operation_RemoveAnnotation) Reset()         { *m = Operation_RemoveAnnotation{} }
+func (m *Operation_RemoveAnnotation) String() string { return proto.CompactTextString(m) }
+func (*Operation_RemoveAnnotation) ProtoMessage()    {}
+func (*Operation_RemoveAnnotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 12}
+}
+func (m *Operation_RemoveAnnotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_RemoveAnnotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_RemoveAnnotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operation_RemoveAnnotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_RemoveAnnotation.Merge(m, src)
+}
+func (m *Operation_RemoveAnnotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_RemoveAnnotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_RemoveAnnotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation_RemoveAnnotation proto.InternalMessageInfo
+
+func (m *Operation_RemoveAnnotation) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
+}
+
+func (m *Operation_RemoveAnnotation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Operation_RemoveAnnotation) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
+}
+
+type Operation_SetAdd struct {
+	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	Value                *JSONElementSimple `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ExecutedAt           *TimeTicket        `protobuf:"bytes,3,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Operation_SetAdd) Reset()         { *m = Operation_SetAdd{} }
+func (m *Operation_SetAdd) String() string { return proto.CompactTextString(m) }
+func (*Operation_SetAdd) ProtoMessage()    {}
+func (*Operation_SetAdd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 13}
+}
+func (m *Operation_SetAdd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_SetAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_SetAdd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operation_SetAdd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_SetAdd.Merge(m, src)
+}
+func (m *Operation_SetAdd) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_SetAdd) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_SetAdd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation_SetAdd proto.InternalMessageInfo
+
+func (m *Operation_SetAdd) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
+}
+
+func (m *Operation_SetAdd) GetValue() *JSONElementSimple {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Operation_SetAdd) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
+}
+
+type Operation_SetRemove struct {
+	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	Value                *JSONElementSimple     `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,3,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExecutedAt           *TimeTicket            `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Operation_SetRemove) Reset()         { *m = Operation_SetRemove{} }
+func (m *Operation_SetRemove) String() string { return proto.CompactTextString(m) }
+func (*Operation_SetRemove) ProtoMessage()    {}
+func (*Operation_SetRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{6, 14}
+}
+func (m *Operation_SetRemove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_SetRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_SetRemove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operation_SetRemove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_SetRemove.Merge(m, src)
+}
+func (m *Operation_SetRemove) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_SetRemove) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_SetRemove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation_SetRemove proto.InternalMessageInfo
+
+func (m *Operation_SetRemove) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
+}
+
+func (m *Operation_SetRemove) GetValue() *JSONElementSimple {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Operation_SetRemove) GetCreatedAtMapByActor() map[string]*TimeTicket {
+	if m != nil {
+		return m.CreatedAtMapByActor
+	}
+	return nil
+}
+
+func (m *Operation_SetRemove) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
+}
+
+type JSONElementSimple struct {
+	CreatedAt            *TimeTicket    `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket    `protobuf:"bytes,2,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket    `protobuf:"bytes,3,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	Type                 ValueType      `protobuf:"varint,4,opt,name=type,proto3,enum=yorkie.v1.ValueType" json:"type,omitempty"`
+	Value                []byte         `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Policy               ConflictPolicy `protobuf:"varint,6,opt,name=policy,proto3,enum=yorkie.v1.ConflictPolicy" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *JSONElementSimple) Reset()         { *m = JSONElementSimple{} }
+func (m *JSONElementSimple) String() string { return proto.CompactTextString(m) }
+func (*JSONElementSimple) ProtoMessage()    {}
+func (*JSONElementSimple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{7}
+}
+func (m *JSONElementSimple) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElementSimple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElementSimple.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JSONElementSimple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElementSimple.Merge(m, src)
+}
+func (m *JSONElementSimple) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElementSimple) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElementSimple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONElementSimple proto.InternalMessageInfo
+
+func (m *JSONElementSimple) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *JSONElementSimple) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
+	}
+	return nil
+}
+
+func (m *JSONElementSimple) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
+	}
+	return nil
+}
+
+func (m *JSONElementSimple) GetType() ValueType {
+	if m != nil {
+		return m.Type
+	}
+	return ValueType_VALUE_TYPE_NULL
+}
+
+func (m *JSONElementSimple) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *JSONElementSimple) GetPolicy() ConflictPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ConflictPolicy_CONFLICT_POLICY_LWW
+}
+
+type JSONElement struct {
+	// Types that are valid to be assigned to Body:
+	//
+	//	*JSONElement_JsonObject
+	//	*JSONElement_JsonArray
+	//	*JSONElement_Primitive_
+	//	*JSONElement_Text_
+	//	*JSONElement_Counter_
+	//	*JSONElement_Tree_
+	//	*JSONElement_Set_
+	Body                 isJSONElement_Body `protobuf_oneof:"body"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *JSONElement) Reset()         { *m = JSONElement{} }
 func (m *JSONElement) String() string { return proto.CompactTextString(m) }
 func (*JSONElement) ProtoMessage()    {}
 func (*JSONElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{6}
+	return fileDescriptor_36361b2f5d0f0896, []int{8}
 }
 func (m *JSONElement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1638,6 +2237,9 @@ type JSONElement_Counter_ struct {
 type JSONElement_Tree_ struct {
 	Tree *JSONElement_Tree `protobuf:"bytes,7,opt,name=tree,proto3,oneof" json:"tree,omitempty"`
 }
+type JSONElement_Set_ struct {
+	Set *JSONElement_Set `protobuf:"bytes,8,opt,name=set,proto3,oneof" json:"set,omitempty"`
+}
 
 func (*JSONElement_JsonObject) isJSONElement_Body() {}
 func (*JSONElement_JsonArray) isJSONElement_Body()  {}
@@ -1645,6 +2247,7 @@ func (*JSONElement_Primitive_) isJSONElement_Body() {}
 func (*JSONElement_Text_) isJSONElement_Body()      {}
 func (*JSONElement_Counter_) isJSONElement_Body()   {}
 func (*JSONElement_Tree_) isJSONElement_Body()      {}
+func (*JSONElement_Set_) isJSONElement_Body()       {}
 
 func (m *JSONElement) GetBody() isJSONElement_Body {
 	if m != nil {
@@ -1695,6 +2298,13 @@ func (m *JSONElement) GetTree() *JSONElement_Tree {
 	return nil
 }
 
+func (m *JSONElement) GetSet() *JSONElement_Set {
+	if x, ok := m.GetBody().(*JSONElement_Set_); ok {
+		return x.Set
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*JSONElement) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1704,24 +2314,27 @@ func (*JSONElement) XXX_OneofWrappers() []interface{} {
 		(*JSONElement_Text_)(nil),
 		(*JSONElement_Counter_)(nil),
 		(*JSONElement_Tree_)(nil),
+		(*JSONElement_Set_)(nil),
 	}
 }
 
 type JSONElement_JSONObject struct {
-	Nodes                []*RHTNode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Nodes                []*RHTNode     `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	CreatedAt            *TimeTicket    `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket    `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket    `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	Policy               ConflictPolicy `protobuf:"varint,5,opt,name=policy,proto3,enum=yorkie.v1.ConflictPolicy" json:"policy,omitempty"`
+	Merged               []*TimeTicket  `protobuf:"bytes,6,rep,name=merged,proto3" json:"merged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *JSONElement_JSONObject) Reset()         { *m = JSONElement_JSONObject{} }
 func (m *JSONElement_JSONObject) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_JSONObject) ProtoMessage()    {}
 func (*JSONElement_JSONObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{6, 0}
+	return fileDescriptor_36361b2f5d0f0896, []int{8, 0}
 }
 func (m *JSONElement_JSONObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1778,6 +2391,20 @@ func (m *JSONElement_JSONObject) GetRemovedAt() *TimeTicket {
 	return nil
 }
 
+func (m *JSONElement_JSONObject) GetPolicy() ConflictPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ConflictPolicy_CONFLICT_POLICY_LWW
+}
+
+func (m *JSONElement_JSONObject) GetMerged() []*TimeTicket {
+	if m != nil {
+		return m.Merged
+	}
+	return nil
+}
+
 type JSONElement_JSONArray struct {
 	Nodes                []*RGANode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -1792,7 +2419,7 @@ func (m *JSONElement_JSONArray) Reset()         { *m = JSONElement_JSONArray{} }
 func (m *JSONElement_JSONArray) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_JSONArray) ProtoMessage()    {}
 func (*JSONElement_JSONArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{6, 1}
+	return fileDescriptor_36361b2f5d0f0896, []int{8, 1}
 }
 func (m *JSONElement_JSONArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +2491,7 @@ func (m *JSONElement_Primitive) Reset()         { *m = JSONElement_Primitive{} }
 func (m *JSONElement_Primitive) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Primitive) ProtoMessage()    {}
 func (*JSONElement_Primitive) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{6, 2}
+	return fileDescriptor_36361b2f5d0f0896, []int{8, 2}
 }
 func (m *JSONElement_Primitive) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1929,20 +2556,21 @@ func (m *JSONElement_Primitive) GetRemovedAt() *TimeTicket {
 }
 
 type JSONElement_Text struct {
-	Nodes                []*TextNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
-}
-
+	Nodes                []*TextNode       `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	CreatedAt            *TimeTicket       `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket       `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket       `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	Annotations          []*TextAnnotation `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
 func (m *JSONElement_Text) Reset()         { *m = JSONElement_Text{} }
 func (m *JSONElement_Text) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Text) ProtoMessage()    {}
 func (*JSONElement_Text) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{6, 3}
+	return fileDescriptor_36361b2f5d0f0896, []int{8, 3}
 }
 func (m *JSONElement_Text) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,6 +2627,13 @@ func (m *JSONElement_Text) GetRemovedAt() *TimeTicket {
 	return nil
 }
 
+func (m *JSONElement_Text) GetAnnotations() []*TextAnnotation {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
 type JSONElement_Counter struct {
 	Type                 ValueType   `protobuf:"varint,1,opt,name=type,proto3,enum=yorkie.v1.ValueType" json:"type,omitempty"`
 	Value                []byte      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -2014,7 +2649,7 @@ func (m *JSONElement_Counter) Reset()         { *m = JSONElement_Counter{} }
 func (m *JSONElement_Counter) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Counter) ProtoMessage()    {}
 func (*JSONElement_Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{6, 4}
+	return fileDescriptor_36361b2f5d0f0896, []int{8, 4}
 }
 func (m *JSONElement_Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2092,7 +2727,7 @@ func (m *JSONElement_Tree) Reset()         { *m = JSONElement_Tree{} }
 func (m *JSONElement_Tree) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Tree) ProtoMessage()    {}
 func (*JSONElement_Tree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{6, 5}
+	return fileDescriptor_36361b2f5d0f0896, []int{8, 5}
 }
 func (m *JSONElement_Tree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2149,6 +2784,140 @@ func (m *JSONElement_Tree) GetRemovedAt() *TimeTicket {
 	return nil
 }
 
+type JSONElement_Set struct {
+	Members              []*SetMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt            *TimeTicket  `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket  `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket  `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *JSONElement_Set) Reset()         { *m = JSONElement_Set{} }
+func (m *JSONElement_Set) String() string { return proto.CompactTextString(m) }
+func (*JSONElement_Set) ProtoMessage()    {}
+func (*JSONElement_Set) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{8, 6}
+}
+func (m *JSONElement_Set) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElement_Set) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement_Set.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JSONElement_Set) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement_Set.Merge(m, src)
+}
+func (m *JSONElement_Set) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement_Set) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement_Set.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONElement_Set proto.InternalMessageInfo
+
+func (m *JSONElement_Set) GetMembers() []*SetMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *JSONElement_Set) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *JSONElement_Set) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
+	}
+	return nil
+}
+
+func (m *JSONElement_Set) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
+	}
+	return nil
+}
+
+type SetMember struct {
+	Type                 ValueType     `protobuf:"varint,1,opt,name=type,proto3,enum=yorkie.v1.ValueType" json:"type,omitempty"`
+	Value                []byte        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Tags                 []*TimeTicket `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SetMember) Reset()         { *m = SetMember{} }
+func (m *SetMember) String() string { return proto.CompactTextString(m) }
+func (*SetMember) ProtoMessage()    {}
+func (*SetMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{9}
+}
+func (m *SetMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMember.Merge(m, src)
+}
+func (m *SetMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMember proto.InternalMessageInfo
+
+func (m *SetMember) GetType() ValueType {
+	if m != nil {
+		return m.Type
+	}
+	return ValueType_VALUE_TYPE_NULL
+}
+
+func (m *SetMember) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SetMember) GetTags() []*TimeTicket {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type RHTNode struct {
 	Key                  string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Element              *JSONElement `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
@@ -2161,7 +2930,7 @@ func (m *RHTNode) Reset()         { *m = RHTNode{} }
 func (m *RHTNode) String() string { return proto.CompactTextString(m) }
 func (*RHTNode) ProtoMessage()    {}
 func (*RHTNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{7}
+	return fileDescriptor_36361b2f5d0f0896, []int{10}
 }
 func (m *RHTNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2207,6 +2976,8 @@ func (m *RHTNode) GetElement() *JSONElement {
 type RGANode struct {
 	Next                 *RGANode     `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
 	Element              *JSONElement `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	PositionedAt         *TimeTicket  `protobuf:"bytes,3,opt,name=positioned_at,json=positionedAt,proto3" json:"positioned_at,omitempty"`
+	VacatedAt            *TimeTicket  `protobuf:"bytes,4,opt,name=vacated_at,json=vacatedAt,proto3" json:"vacated_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -2216,7 +2987,7 @@ func (m *RGANode) Reset()         { *m = RGANode{} }
 func (m *RGANode) String() string { return proto.CompactTextString(m) }
 func (*RGANode) ProtoMessage()    {}
 func (*RGANode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{8}
+	return fileDescriptor_36361b2f5d0f0896, []int{11}
 }
 func (m *RGANode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2259,6 +3030,20 @@ func (m *RGANode) GetElement() *JSONElement {
 	return nil
 }
 
+func (m *RGANode) GetPositionedAt() *TimeTicket {
+	if m != nil {
+		return m.PositionedAt
+	}
+	return nil
+}
+
+func (m *RGANode) GetVacatedAt() *TimeTicket {
+	if m != nil {
+		return m.VacatedAt
+	}
+	return nil
+}
+
 type NodeAttr struct {
 	Value                string      `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	UpdatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
@@ -2271,7 +3056,7 @@ func (m *NodeAttr) Reset()         { *m = NodeAttr{} }
 func (m *NodeAttr) String() string { return proto.CompactTextString(m) }
 func (*NodeAttr) ProtoMessage()    {}
 func (*NodeAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{9}
+	return fileDescriptor_36361b2f5d0f0896, []int{12}
 }
 func (m *NodeAttr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2329,7 +3114,7 @@ func (m *TextNode) Reset()         { *m = TextNode{} }
 func (m *TextNode) String() string { return proto.CompactTextString(m) }
 func (*TextNode) ProtoMessage()    {}
 func (*TextNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{10}
+	return fileDescriptor_36361b2f5d0f0896, []int{13}
 }
 func (m *TextNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2405,7 +3190,7 @@ func (m *TextNodeID) Reset()         { *m = TextNodeID{} }
 func (m *TextNodeID) String() string { return proto.CompactTextString(m) }
 func (*TextNodeID) ProtoMessage()    {}
 func (*TextNodeID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{11}
+	return fileDescriptor_36361b2f5d0f0896, []int{14}
 }
 func (m *TextNodeID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2448,6 +3233,93 @@ func (m *TextNodeID) GetOffset() int32 {
 	return 0
 }
 
+type TextAnnotation struct {
+	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	From                 *TextNodePos `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TextNodePos `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value                string       `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	UpdatedAt            *TimeTicket  `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsRemoved            bool         `protobuf:"varint,6,opt,name=is_removed,json=isRemoved,proto3" json:"is_removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TextAnnotation) Reset()         { *m = TextAnnotation{} }
+func (m *TextAnnotation) String() string { return proto.CompactTextString(m) }
+func (*TextAnnotation) ProtoMessage()    {}
+func (*TextAnnotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{15}
+}
+func (m *TextAnnotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextAnnotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextAnnotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TextAnnotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextAnnotation.Merge(m, src)
+}
+func (m *TextAnnotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextAnnotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextAnnotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextAnnotation proto.InternalMessageInfo
+
+func (m *TextAnnotation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TextAnnotation) GetFrom() *TextNodePos {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *TextAnnotation) GetTo() *TextNodePos {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *TextAnnotation) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TextAnnotation) GetUpdatedAt() *TimeTicket {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *TextAnnotation) GetIsRemoved() bool {
+	if m != nil {
+		return m.IsRemoved
+	}
+	return false
+}
+
 type TreeNode struct {
 	Id                   *TreeNodeID          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type                 string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -2466,7 +3338,7 @@ func (m *TreeNode) Reset()         { *m = TreeNode{} }
 func (m *TreeNode) String() string { return proto.CompactTextString(m) }
 func (*TreeNode) ProtoMessage()    {}
 func (*TreeNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{12}
+	return fileDescriptor_36361b2f5d0f0896, []int{16}
 }
 func (m *TreeNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2562,7 +3434,7 @@ func (m *TreeNodes) Reset()         { *m = TreeNodes{} }
 func (m *TreeNodes) String() string { return proto.CompactTextString(m) }
 func (*TreeNodes) ProtoMessage()    {}
 func (*TreeNodes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{13}
+	return fileDescriptor_36361b2f5d0f0896, []int{17}
 }
 func (m *TreeNodes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2610,7 +3482,7 @@ func (m *TreeNodeID) Reset()         { *m = TreeNodeID{} }
 func (m *TreeNodeID) String() string { return proto.CompactTextString(m) }
 func (*TreeNodeID) ProtoMessage()    {}
 func (*TreeNodeID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{14}
+	return fileDescriptor_36361b2f5d0f0896, []int{18}
 }
 func (m *TreeNodeID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2665,7 +3537,7 @@ func (m *TreePos) Reset()         { *m = TreePos{} }
 func (m *TreePos) String() string { return proto.CompactTextString(m) }
 func (*TreePos) ProtoMessage()    {}
 func (*TreePos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{15}
+	return fileDescriptor_36361b2f5d0f0896, []int{19}
 }
 func (m *TreePos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2721,7 +3593,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{16}
+	return fileDescriptor_36361b2f5d0f0896, []int{20}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2781,6 +3653,17 @@ type Project struct {
 	ClientDeactivateThreshold string           `protobuf:"bytes,7,opt,name=client_deactivate_threshold,json=clientDeactivateThreshold,proto3" json:"client_deactivate_threshold,omitempty"`
 	CreatedAt                 *types.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt                 *types.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AuthJwtKey                string           `protobuf:"bytes,10,opt,name=auth_jwt_key,json=authJwtKey,proto3" json:"auth_jwt_key,omitempty"`
+	AuthJwksUrl               string           `protobuf:"bytes,11,opt,name=auth_jwks_url,json=authJwksUrl,proto3" json:"auth_jwks_url,omitempty"`
+	SensitivePresenceKeys     []string         `protobuf:"bytes,12,rep,name=sensitive_presence_keys,json=sensitivePresenceKeys,proto3" json:"sensitive_presence_keys,omitempty"`
+	EventWebhookUrl           string           `protobuf:"bytes,13,opt,name=event_webhook_url,json=eventWebhookUrl,proto3" json:"event_webhook_url,omitempty"`
+	EventWebhookEvents        []string         `protobuf:"bytes,14,rep,name=event_webhook_events,json=eventWebhookEvents,proto3" json:"event_webhook_events,omitempty"`
+	DocumentSizeSoftLimit     int64            `protobuf:"varint,15,opt,name=document_size_soft_limit,json=documentSizeSoftLimit,proto3" json:"document_size_soft_limit,omitempty"`
+	DocumentSizeHardLimit     int64            `protobuf:"varint,16,opt,name=document_size_hard_limit,json=documentSizeHardLimit,proto3" json:"document_size_hard_limit,omitempty"`
+	ChangeLogSoftLimit        int64            `protobuf:"varint,17,opt,name=change_log_soft_limit,json=changeLogSoftLimit,proto3" json:"change_log_soft_limit,omitempty"`
+	ChangeLogHardLimit        int64            `protobuf:"varint,18,opt,name=change_log_hard_limit,json=changeLogHardLimit,proto3" json:"change_log_hard_limit,omitempty"`
+	SnapshotThreshold         int64            `protobuf:"varint,19,opt,name=snapshot_threshold,json=snapshotThreshold,proto3" json:"snapshot_threshold,omitempty"`
+	SnapshotInterval          int64            `protobuf:"varint,20,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}         `json:"-"`
 	XXX_unrecognized          []byte           `json:"-"`
 	XXX_sizecache             int32            `json:"-"`
@@ -2790,7 +3673,7 @@ func (m *Project) Reset()         { *m = Project{} }
 func (m *Project) String() string { return proto.CompactTextString(m) }
 func (*Project) ProtoMessage()    {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{17}
+	return fileDescriptor_36361b2f5d0f0896, []int{21}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2882,21 +3765,109 @@ func (m *Project) GetUpdatedAt() *types.Timestamp {
 	return nil
 }
 
+func (m *Project) GetAuthJwtKey() string {
+	if m != nil {
+		return m.AuthJwtKey
+	}
+	return ""
+}
+
+func (m *Project) GetAuthJwksUrl() string {
+	if m != nil {
+		return m.AuthJwksUrl
+	}
+	return ""
+}
+
+func (m *Project) GetSensitivePresenceKeys() []string {
+	if m != nil {
+		return m.SensitivePresenceKeys
+	}
+	return nil
+}
+
+func (m *Project) GetEventWebhookUrl() string {
+	if m != nil {
+		return m.EventWebhookUrl
+	}
+	return ""
+}
+
+func (m *Project) GetEventWebhookEvents() []string {
+	if m != nil {
+		return m.EventWebhookEvents
+	}
+	return nil
+}
+
+func (m *Project) GetDocumentSizeSoftLimit() int64 {
+	if m != nil {
+		return m.DocumentSizeSoftLimit
+	}
+	return 0
+}
+
+func (m *Project) GetDocumentSizeHardLimit() int64 {
+	if m != nil {
+		return m.DocumentSizeHardLimit
+	}
+	return 0
+}
+
+func (m *Project) GetChangeLogSoftLimit() int64 {
+	if m != nil {
+		return m.ChangeLogSoftLimit
+	}
+	return 0
+}
+
+func (m *Project) GetChangeLogHardLimit() int64 {
+	if m != nil {
+		return m.ChangeLogHardLimit
+	}
+	return 0
+}
+
+func (m *Project) GetSnapshotThreshold() int64 {
+	if m != nil {
+		return m.SnapshotThreshold
+	}
+	return 0
+}
+
+func (m *Project) GetSnapshotInterval() int64 {
+	if m != nil {
+		return m.SnapshotInterval
+	}
+	return 0
+}
+
 type UpdatableProjectFields struct {
-	Name                      *types.StringValue                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	AuthWebhookUrl            *types.StringValue                         `protobuf:"bytes,2,opt,name=auth_webhook_url,json=authWebhookUrl,proto3" json:"auth_webhook_url,omitempty"`
-	AuthWebhookMethods        *UpdatableProjectFields_AuthWebhookMethods `protobuf:"bytes,3,opt,name=auth_webhook_methods,json=authWebhookMethods,proto3" json:"auth_webhook_methods,omitempty"`
-	ClientDeactivateThreshold *types.StringValue                         `protobuf:"bytes,4,opt,name=client_deactivate_threshold,json=clientDeactivateThreshold,proto3" json:"client_deactivate_threshold,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}                                   `json:"-"`
-	XXX_unrecognized          []byte                                     `json:"-"`
-	XXX_sizecache             int32                                      `json:"-"`
+	Name                      *types.StringValue                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AuthWebhookUrl            *types.StringValue                            `protobuf:"bytes,2,opt,name=auth_webhook_url,json=authWebhookUrl,proto3" json:"auth_webhook_url,omitempty"`
+	AuthWebhookMethods        *UpdatableProjectFields_AuthWebhookMethods    `protobuf:"bytes,3,opt,name=auth_webhook_methods,json=authWebhookMethods,proto3" json:"auth_webhook_methods,omitempty"`
+	ClientDeactivateThreshold *types.StringValue                            `protobuf:"bytes,4,opt,name=client_deactivate_threshold,json=clientDeactivateThreshold,proto3" json:"client_deactivate_threshold,omitempty"`
+	AuthJwtKey                *types.StringValue                            `protobuf:"bytes,5,opt,name=auth_jwt_key,json=authJwtKey,proto3" json:"auth_jwt_key,omitempty"`
+	AuthJwksUrl               *types.StringValue                            `protobuf:"bytes,6,opt,name=auth_jwks_url,json=authJwksUrl,proto3" json:"auth_jwks_url,omitempty"`
+	SensitivePresenceKeys     *UpdatableProjectFields_SensitivePresenceKeys `protobuf:"bytes,7,opt,name=sensitive_presence_keys,json=sensitivePresenceKeys,proto3" json:"sensitive_presence_keys,omitempty"`
+	EventWebhookUrl           *types.StringValue                            `protobuf:"bytes,8,opt,name=event_webhook_url,json=eventWebhookUrl,proto3" json:"event_webhook_url,omitempty"`
+	EventWebhookEvents        *UpdatableProjectFields_EventWebhookEvents    `protobuf:"bytes,9,opt,name=event_webhook_events,json=eventWebhookEvents,proto3" json:"event_webhook_events,omitempty"`
+	DocumentSizeSoftLimit     *types.Int64Value                             `protobuf:"bytes,10,opt,name=document_size_soft_limit,json=documentSizeSoftLimit,proto3" json:"document_size_soft_limit,omitempty"`
+	DocumentSizeHardLimit     *types.Int64Value                             `protobuf:"bytes,11,opt,name=document_size_hard_limit,json=documentSizeHardLimit,proto3" json:"document_size_hard_limit,omitempty"`
+	ChangeLogSoftLimit        *types.Int64Value                             `protobuf:"bytes,12,opt,name=change_log_soft_limit,json=changeLogSoftLimit,proto3" json:"change_log_soft_limit,omitempty"`
+	ChangeLogHardLimit        *types.Int64Value                             `protobuf:"bytes,13,opt,name=change_log_hard_limit,json=changeLogHardLimit,proto3" json:"change_log_hard_limit,omitempty"`
+	SnapshotThreshold         *types.Int64Value                             `protobuf:"bytes,14,opt,name=snapshot_threshold,json=snapshotThreshold,proto3" json:"snapshot_threshold,omitempty"`
+	SnapshotInterval          *types.Int64Value                             `protobuf:"bytes,15,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                                      `json:"-"`
+	XXX_unrecognized          []byte                                        `json:"-"`
+	XXX_sizecache             int32                                         `json:"-"`
 }
 
 func (m *UpdatableProjectFields) Reset()         { *m = UpdatableProjectFields{} }
 func (m *UpdatableProjectFields) String() string { return proto.CompactTextString(m) }
 func (*UpdatableProjectFields) ProtoMessage()    {}
 func (*UpdatableProjectFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{18}
+	return fileDescriptor_36361b2f5d0f0896, []int{22}
 }
 func (m *UpdatableProjectFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2953,11 +3924,88 @@ func (m *UpdatableProjectFields) GetClientDeactivateThreshold() *types.StringVal
 	return nil
 }
 
-type UpdatableProjectFields_AuthWebhookMethods struct {
-	Methods              []string `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UpdatableProjectFields) GetAuthJwtKey() *types.StringValue {
+	if m != nil {
+		return m.AuthJwtKey
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetAuthJwksUrl() *types.StringValue {
+	if m != nil {
+		return m.AuthJwksUrl
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetSensitivePresenceKeys() *UpdatableProjectFields_SensitivePresenceKeys {
+	if m != nil {
+		return m.SensitivePresenceKeys
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetEventWebhookUrl() *types.StringValue {
+	if m != nil {
+		return m.EventWebhookUrl
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetEventWebhookEvents() *UpdatableProjectFields_EventWebhookEvents {
+	if m != nil {
+		return m.EventWebhookEvents
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetDocumentSizeSoftLimit() *types.Int64Value {
+	if m != nil {
+		return m.DocumentSizeSoftLimit
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetDocumentSizeHardLimit() *types.Int64Value {
+	if m != nil {
+		return m.DocumentSizeHardLimit
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetChangeLogSoftLimit() *types.Int64Value {
+	if m != nil {
+		return m.ChangeLogSoftLimit
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetChangeLogHardLimit() *types.Int64Value {
+	if m != nil {
+		return m.ChangeLogHardLimit
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetSnapshotThreshold() *types.Int64Value {
+	if m != nil {
+		return m.SnapshotThreshold
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetSnapshotInterval() *types.Int64Value {
+	if m != nil {
+		return m.SnapshotInterval
+	}
+	return nil
+}
+
+type UpdatableProjectFields_AuthWebhookMethods struct {
+	Methods              []string `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpdatableProjectFields_AuthWebhookMethods) Reset() {
@@ -2968,7 +4016,7 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) String() string {
 }
 func (*UpdatableProjectFields_AuthWebhookMethods) ProtoMessage() {}
 func (*UpdatableProjectFields_AuthWebhookMethods) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{18, 0}
+	return fileDescriptor_36361b2f5d0f0896, []int{22, 0}
 }
 func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3004,23 +4052,126 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) GetMethods() []string {
 	return nil
 }
 
+type UpdatableProjectFields_SensitivePresenceKeys struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdatableProjectFields_SensitivePresenceKeys) Reset() {
+	*m = UpdatableProjectFields_SensitivePresenceKeys{}
+}
+func (m *UpdatableProjectFields_SensitivePresenceKeys) String() string {
+	return proto.CompactTextString(m)
+}
+func (*UpdatableProjectFields_SensitivePresenceKeys) ProtoMessage() {}
+func (*UpdatableProjectFields_SensitivePresenceKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{22, 1}
+}
+func (m *UpdatableProjectFields_SensitivePresenceKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatableProjectFields_SensitivePresenceKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatableProjectFields_SensitivePresenceKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatableProjectFields_SensitivePresenceKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatableProjectFields_SensitivePresenceKeys.Merge(m, src)
+}
+func (m *UpdatableProjectFields_SensitivePresenceKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatableProjectFields_SensitivePresenceKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatableProjectFields_SensitivePresenceKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatableProjectFields_SensitivePresenceKeys proto.InternalMessageInfo
+
+func (m *UpdatableProjectFields_SensitivePresenceKeys) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type UpdatableProjectFields_EventWebhookEvents struct {
+	Events               []string `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdatableProjectFields_EventWebhookEvents) Reset() {
+	*m = UpdatableProjectFields_EventWebhookEvents{}
+}
+func (m *UpdatableProjectFields_EventWebhookEvents) String() string {
+	return proto.CompactTextString(m)
+}
+func (*UpdatableProjectFields_EventWebhookEvents) ProtoMessage() {}
+func (*UpdatableProjectFields_EventWebhookEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{22, 2}
+}
+func (m *UpdatableProjectFields_EventWebhookEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatableProjectFields_EventWebhookEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatableProjectFields_EventWebhookEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatableProjectFields_EventWebhookEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatableProjectFields_EventWebhookEvents.Merge(m, src)
+}
+func (m *UpdatableProjectFields_EventWebhookEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatableProjectFields_EventWebhookEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatableProjectFields_EventWebhookEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatableProjectFields_EventWebhookEvents proto.InternalMessageInfo
+
+func (m *UpdatableProjectFields_EventWebhookEvents) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type DocumentSummary struct {
-	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Key                  string           `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Snapshot             string           `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	CreatedAt            *types.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	AccessedAt           *types.Timestamp `protobuf:"bytes,5,opt,name=accessed_at,json=accessedAt,proto3" json:"accessed_at,omitempty"`
-	UpdatedAt            *types.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key                  string            `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Snapshot             string            `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	CreatedAt            *types.Timestamp  `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AccessedAt           *types.Timestamp  `protobuf:"bytes,5,opt,name=accessed_at,json=accessedAt,proto3" json:"accessed_at,omitempty"`
+	UpdatedAt            *types.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *DocumentSummary) Reset()         { *m = DocumentSummary{} }
 func (m *DocumentSummary) String() string { return proto.CompactTextString(m) }
 func (*DocumentSummary) ProtoMessage()    {}
 func (*DocumentSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{19}
+	return fileDescriptor_36361b2f5d0f0896, []int{23}
 }
 func (m *DocumentSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,26 +4242,35 @@ func (m *DocumentSummary) GetUpdatedAt() *types.Timestamp {
 	return nil
 }
 
-type PresenceChange struct {
-	Type                 PresenceChange_ChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=yorkie.v1.PresenceChange_ChangeType" json:"type,omitempty"`
-	Presence             *Presence                 `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+func (m *DocumentSummary) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
 }
 
-func (m *PresenceChange) Reset()         { *m = PresenceChange{} }
-func (m *PresenceChange) String() string { return proto.CompactTextString(m) }
-func (*PresenceChange) ProtoMessage()    {}
-func (*PresenceChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{20}
+type DocumentTemplate struct {
+	Collection           string           `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Root                 string           `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	CreatedAt            *types.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *types.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *PresenceChange) XXX_Unmarshal(b []byte) error {
+
+func (m *DocumentTemplate) Reset()         { *m = DocumentTemplate{} }
+func (m *DocumentTemplate) String() string { return proto.CompactTextString(m) }
+func (*DocumentTemplate) ProtoMessage()    {}
+func (*DocumentTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{24}
+}
+func (m *DocumentTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PresenceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DocumentTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PresenceChange.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DocumentTemplate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3120,51 +4280,68 @@ func (m *PresenceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *PresenceChange) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PresenceChange.Merge(m, src)
+func (m *DocumentTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentTemplate.Merge(m, src)
 }
-func (m *PresenceChange) XXX_Size() int {
+func (m *DocumentTemplate) XXX_Size() int {
 	return m.Size()
 }
-func (m *PresenceChange) XXX_DiscardUnknown() {
-	xxx_messageInfo_PresenceChange.DiscardUnknown(m)
+func (m *DocumentTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentTemplate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PresenceChange proto.InternalMessageInfo
+var xxx_messageInfo_DocumentTemplate proto.InternalMessageInfo
 
-func (m *PresenceChange) GetType() PresenceChange_ChangeType {
+func (m *DocumentTemplate) GetCollection() string {
 	if m != nil {
-		return m.Type
+		return m.Collection
 	}
-	return PresenceChange_CHANGE_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (m *PresenceChange) GetPresence() *Presence {
+func (m *DocumentTemplate) GetRoot() string {
 	if m != nil {
-		return m.Presence
+		return m.Root
+	}
+	return ""
+}
+
+func (m *DocumentTemplate) GetCreatedAt() *types.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
 	return nil
 }
 
-type Presence struct {
-	Data                 map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *DocumentTemplate) GetUpdatedAt() *types.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
 }
 
-func (m *Presence) Reset()         { *m = Presence{} }
-func (m *Presence) String() string { return proto.CompactTextString(m) }
-func (*Presence) ProtoMessage()    {}
-func (*Presence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{21}
+type DocumentACL struct {
+	Readers              []string `protobuf:"bytes,1,rep,name=readers,proto3" json:"readers,omitempty"`
+	Writers              []string `protobuf:"bytes,2,rep,name=writers,proto3" json:"writers,omitempty"`
+	Admins               []string `protobuf:"bytes,3,rep,name=admins,proto3" json:"admins,omitempty"`
+	PublicRead           bool     `protobuf:"varint,4,opt,name=public_read,json=publicRead,proto3" json:"public_read,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Presence) XXX_Unmarshal(b []byte) error {
+
+func (m *DocumentACL) Reset()         { *m = DocumentACL{} }
+func (m *DocumentACL) String() string { return proto.CompactTextString(m) }
+func (*DocumentACL) ProtoMessage()    {}
+func (*DocumentACL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{25}
+}
+func (m *DocumentACL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DocumentACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Presence.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DocumentACL.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3174,45 +4351,72 @@ func (m *Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Presence) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Presence.Merge(m, src)
+func (m *DocumentACL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentACL.Merge(m, src)
 }
-func (m *Presence) XXX_Size() int {
+func (m *DocumentACL) XXX_Size() int {
 	return m.Size()
 }
-func (m *Presence) XXX_DiscardUnknown() {
-	xxx_messageInfo_Presence.DiscardUnknown(m)
+func (m *DocumentACL) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentACL.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Presence proto.InternalMessageInfo
+var xxx_messageInfo_DocumentACL proto.InternalMessageInfo
 
-func (m *Presence) GetData() map[string]string {
+func (m *DocumentACL) GetReaders() []string {
 	if m != nil {
-		return m.Data
+		return m.Readers
 	}
 	return nil
 }
 
-type Checkpoint struct {
-	ServerSeq            int64    `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
-	ClientSeq            uint32   `protobuf:"varint,2,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
+func (m *DocumentACL) GetWriters() []string {
+	if m != nil {
+		return m.Writers
+	}
+	return nil
+}
+
+func (m *DocumentACL) GetAdmins() []string {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+func (m *DocumentACL) GetPublicRead() bool {
+	if m != nil {
+		return m.PublicRead
+	}
+	return false
+}
+
+type DocumentMemory struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Subscriptions        int32    `protobuf:"varint,3,opt,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	SubscriptionBytes    int64    `protobuf:"varint,4,opt,name=subscription_bytes,json=subscriptionBytes,proto3" json:"subscription_bytes,omitempty"`
+	Locks                int32    `protobuf:"varint,5,opt,name=locks,proto3" json:"locks,omitempty"`
+	LockBytes            int64    `protobuf:"varint,6,opt,name=lock_bytes,json=lockBytes,proto3" json:"lock_bytes,omitempty"`
+	Snapshots            int32    `protobuf:"varint,7,opt,name=snapshots,proto3" json:"snapshots,omitempty"`
+	SnapshotBytes        int64    `protobuf:"varint,8,opt,name=snapshot_bytes,json=snapshotBytes,proto3" json:"snapshot_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
-func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
-func (*Checkpoint) ProtoMessage()    {}
-func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{22}
+func (m *DocumentMemory) Reset()         { *m = DocumentMemory{} }
+func (m *DocumentMemory) String() string { return proto.CompactTextString(m) }
+func (*DocumentMemory) ProtoMessage()    {}
+func (*DocumentMemory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{26}
 }
-func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+func (m *DocumentMemory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DocumentMemory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DocumentMemory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3222,53 +4426,98 @@ func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Checkpoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Checkpoint.Merge(m, src)
+func (m *DocumentMemory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentMemory.Merge(m, src)
 }
-func (m *Checkpoint) XXX_Size() int {
+func (m *DocumentMemory) XXX_Size() int {
 	return m.Size()
 }
-func (m *Checkpoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+func (m *DocumentMemory) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentMemory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+var xxx_messageInfo_DocumentMemory proto.InternalMessageInfo
 
-func (m *Checkpoint) GetServerSeq() int64 {
+func (m *DocumentMemory) GetId() string {
 	if m != nil {
-		return m.ServerSeq
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *Checkpoint) GetClientSeq() uint32 {
+func (m *DocumentMemory) GetKey() string {
 	if m != nil {
-		return m.ClientSeq
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DocumentMemory) GetSubscriptions() int32 {
+	if m != nil {
+		return m.Subscriptions
 	}
 	return 0
 }
 
-type TextNodePos struct {
-	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Offset               int32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	RelativeOffset       int32       `protobuf:"varint,3,opt,name=relative_offset,json=relativeOffset,proto3" json:"relative_offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *DocumentMemory) GetSubscriptionBytes() int64 {
+	if m != nil {
+		return m.SubscriptionBytes
+	}
+	return 0
 }
 
-func (m *TextNodePos) Reset()         { *m = TextNodePos{} }
-func (m *TextNodePos) String() string { return proto.CompactTextString(m) }
-func (*TextNodePos) ProtoMessage()    {}
-func (*TextNodePos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{23}
+func (m *DocumentMemory) GetLocks() int32 {
+	if m != nil {
+		return m.Locks
+	}
+	return 0
 }
-func (m *TextNodePos) XXX_Unmarshal(b []byte) error {
+
+func (m *DocumentMemory) GetLockBytes() int64 {
+	if m != nil {
+		return m.LockBytes
+	}
+	return 0
+}
+
+func (m *DocumentMemory) GetSnapshots() int32 {
+	if m != nil {
+		return m.Snapshots
+	}
+	return 0
+}
+
+func (m *DocumentMemory) GetSnapshotBytes() int64 {
+	if m != nil {
+		return m.SnapshotBytes
+	}
+	return 0
+}
+
+type ClientSummary struct {
+	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key                  string           `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Status               string           `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Connection           *ConnectionInfo  `protobuf:"bytes,4,opt,name=connection,proto3" json:"connection,omitempty"`
+	CreatedAt            *types.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *types.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ClientSummary) Reset()         { *m = ClientSummary{} }
+func (m *ClientSummary) String() string { return proto.CompactTextString(m) }
+func (*ClientSummary) ProtoMessage()    {}
+func (*ClientSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{27}
+}
+func (m *ClientSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TextNodePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClientSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TextNodePos.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClientSummary.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3278,60 +4527,81 @@ func (m *TextNodePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TextNodePos) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TextNodePos.Merge(m, src)
+func (m *ClientSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientSummary.Merge(m, src)
 }
-func (m *TextNodePos) XXX_Size() int {
+func (m *ClientSummary) XXX_Size() int {
 	return m.Size()
 }
-func (m *TextNodePos) XXX_DiscardUnknown() {
-	xxx_messageInfo_TextNodePos.DiscardUnknown(m)
+func (m *ClientSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientSummary.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TextNodePos proto.InternalMessageInfo
+var xxx_messageInfo_ClientSummary proto.InternalMessageInfo
 
-func (m *TextNodePos) GetCreatedAt() *TimeTicket {
+func (m *ClientSummary) GetId() string {
 	if m != nil {
-		return m.CreatedAt
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ClientSummary) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ClientSummary) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ClientSummary) GetConnection() *ConnectionInfo {
+	if m != nil {
+		return m.Connection
 	}
 	return nil
 }
 
-func (m *TextNodePos) GetOffset() int32 {
+func (m *ClientSummary) GetCreatedAt() *types.Timestamp {
 	if m != nil {
-		return m.Offset
+		return m.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (m *TextNodePos) GetRelativeOffset() int32 {
+func (m *ClientSummary) GetUpdatedAt() *types.Timestamp {
 	if m != nil {
-		return m.RelativeOffset
+		return m.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-type TimeTicket struct {
-	Lamport              int64    `protobuf:"varint,1,opt,name=lamport,proto3" json:"lamport,omitempty"`
-	Delimiter            uint32   `protobuf:"varint,2,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
-	ActorId              []byte   `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+type ConnectionInfo struct {
+	Ip                   string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent            string   `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Source               string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TimeTicket) Reset()         { *m = TimeTicket{} }
-func (m *TimeTicket) String() string { return proto.CompactTextString(m) }
-func (*TimeTicket) ProtoMessage()    {}
-func (*TimeTicket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{24}
+func (m *ConnectionInfo) Reset()         { *m = ConnectionInfo{} }
+func (m *ConnectionInfo) String() string { return proto.CompactTextString(m) }
+func (*ConnectionInfo) ProtoMessage()    {}
+func (*ConnectionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{28}
 }
-func (m *TimeTicket) XXX_Unmarshal(b []byte) error {
+func (m *ConnectionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TimeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConnectionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TimeTicket.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConnectionInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3341,59 +4611,59 @@ func (m *TimeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TimeTicket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TimeTicket.Merge(m, src)
+func (m *ConnectionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionInfo.Merge(m, src)
 }
-func (m *TimeTicket) XXX_Size() int {
+func (m *ConnectionInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *TimeTicket) XXX_DiscardUnknown() {
-	xxx_messageInfo_TimeTicket.DiscardUnknown(m)
+func (m *ConnectionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TimeTicket proto.InternalMessageInfo
+var xxx_messageInfo_ConnectionInfo proto.InternalMessageInfo
 
-func (m *TimeTicket) GetLamport() int64 {
+func (m *ConnectionInfo) GetIp() string {
 	if m != nil {
-		return m.Lamport
+		return m.Ip
 	}
-	return 0
+	return ""
 }
 
-func (m *TimeTicket) GetDelimiter() uint32 {
+func (m *ConnectionInfo) GetUserAgent() string {
 	if m != nil {
-		return m.Delimiter
+		return m.UserAgent
 	}
-	return 0
+	return ""
 }
 
-func (m *TimeTicket) GetActorId() []byte {
+func (m *ConnectionInfo) GetSource() string {
 	if m != nil {
-		return m.ActorId
+		return m.Source
 	}
-	return nil
+	return ""
 }
 
-type DocEvent struct {
-	Type                 DocEventType `protobuf:"varint,1,opt,name=type,proto3,enum=yorkie.v1.DocEventType" json:"type,omitempty"`
-	Publisher            string       `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type PresenceChange struct {
+	Type                 PresenceChange_ChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=yorkie.v1.PresenceChange_ChangeType" json:"type,omitempty"`
+	Presence             *Presence                 `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *DocEvent) Reset()         { *m = DocEvent{} }
-func (m *DocEvent) String() string { return proto.CompactTextString(m) }
-func (*DocEvent) ProtoMessage()    {}
-func (*DocEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36361b2f5d0f0896, []int{25}
+func (m *PresenceChange) Reset()         { *m = PresenceChange{} }
+func (m *PresenceChange) String() string { return proto.CompactTextString(m) }
+func (*PresenceChange) ProtoMessage()    {}
+func (*PresenceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{29}
 }
-func (m *DocEvent) XXX_Unmarshal(b []byte) error {
+func (m *PresenceChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DocEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PresenceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DocEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PresenceChange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3403,756 +4673,824 @@ func (m *DocEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DocEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DocEvent.Merge(m, src)
+func (m *PresenceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresenceChange.Merge(m, src)
 }
-func (m *DocEvent) XXX_Size() int {
+func (m *PresenceChange) XXX_Size() int {
 	return m.Size()
 }
-func (m *DocEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_DocEvent.DiscardUnknown(m)
+func (m *PresenceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresenceChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DocEvent proto.InternalMessageInfo
+var xxx_messageInfo_PresenceChange proto.InternalMessageInfo
 
-func (m *DocEvent) GetType() DocEventType {
+func (m *PresenceChange) GetType() PresenceChange_ChangeType {
 	if m != nil {
 		return m.Type
 	}
-	return DocEventType_DOC_EVENT_TYPE_DOCUMENT_CHANGED
+	return PresenceChange_CHANGE_TYPE_UNSPECIFIED
 }
 
-func (m *DocEvent) GetPublisher() string {
+func (m *PresenceChange) GetPresence() *Presence {
 	if m != nil {
-		return m.Publisher
+		return m.Presence
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("yorkie.v1.ValueType", ValueType_name, ValueType_value)
-	proto.RegisterEnum("yorkie.v1.DocEventType", DocEventType_name, DocEventType_value)
-	proto.RegisterEnum("yorkie.v1.PresenceChange_ChangeType", PresenceChange_ChangeType_name, PresenceChange_ChangeType_value)
-	proto.RegisterType((*Snapshot)(nil), "yorkie.v1.Snapshot")
-	proto.RegisterMapType((map[string]*Presence)(nil), "yorkie.v1.Snapshot.PresencesEntry")
-	proto.RegisterType((*ChangePack)(nil), "yorkie.v1.ChangePack")
-	proto.RegisterType((*Change)(nil), "yorkie.v1.Change")
-	proto.RegisterType((*ChangeID)(nil), "yorkie.v1.ChangeID")
-	proto.RegisterType((*Operation)(nil), "yorkie.v1.Operation")
-	proto.RegisterType((*Operation_Set)(nil), "yorkie.v1.Operation.Set")
-	proto.RegisterType((*Operation_Add)(nil), "yorkie.v1.Operation.Add")
-	proto.RegisterType((*Operation_Move)(nil), "yorkie.v1.Operation.Move")
-	proto.RegisterType((*Operation_Remove)(nil), "yorkie.v1.Operation.Remove")
-	proto.RegisterType((*Operation_Edit)(nil), "yorkie.v1.Operation.Edit")
-	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Operation.Edit.AttributesEntry")
-	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.Edit.CreatedAtMapByActorEntry")
-	proto.RegisterType((*Operation_Select)(nil), "yorkie.v1.Operation.Select")
-	proto.RegisterType((*Operation_Style)(nil), "yorkie.v1.Operation.Style")
-	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Operation.Style.AttributesEntry")
-	proto.RegisterType((*Operation_Increase)(nil), "yorkie.v1.Operation.Increase")
-	proto.RegisterType((*Operation_TreeEdit)(nil), "yorkie.v1.Operation.TreeEdit")
-	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.TreeEdit.CreatedAtMapByActorEntry")
-	proto.RegisterType((*Operation_TreeStyle)(nil), "yorkie.v1.Operation.TreeStyle")
-	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Operation.TreeStyle.AttributesEntry")
-	proto.RegisterType((*Operation_EditReverse)(nil), "yorkie.v1.Operation.EditReverse")
-	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Operation.EditReverse.AttributesEntry")
-	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.EditReverse.CreatedAtMapByActorEntry")
-	proto.RegisterType((*JSONElementSimple)(nil), "yorkie.v1.JSONElementSimple")
-	proto.RegisterType((*JSONElement)(nil), "yorkie.v1.JSONElement")
-	proto.RegisterType((*JSONElement_JSONObject)(nil), "yorkie.v1.JSONElement.JSONObject")
-	proto.RegisterType((*JSONElement_JSONArray)(nil), "yorkie.v1.JSONElement.JSONArray")
-	proto.RegisterType((*JSONElement_Primitive)(nil), "yorkie.v1.JSONElement.Primitive")
-	proto.RegisterType((*JSONElement_Text)(nil), "yorkie.v1.JSONElement.Text")
-	proto.RegisterType((*JSONElement_Counter)(nil), "yorkie.v1.JSONElement.Counter")
-	proto.RegisterType((*JSONElement_Tree)(nil), "yorkie.v1.JSONElement.Tree")
-	proto.RegisterType((*RHTNode)(nil), "yorkie.v1.RHTNode")
-	proto.RegisterType((*RGANode)(nil), "yorkie.v1.RGANode")
-	proto.RegisterType((*NodeAttr)(nil), "yorkie.v1.NodeAttr")
-	proto.RegisterType((*TextNode)(nil), "yorkie.v1.TextNode")
-	proto.RegisterMapType((map[string]*NodeAttr)(nil), "yorkie.v1.TextNode.AttributesEntry")
-	proto.RegisterType((*TextNodeID)(nil), "yorkie.v1.TextNodeID")
-	proto.RegisterType((*TreeNode)(nil), "yorkie.v1.TreeNode")
-	proto.RegisterMapType((map[string]*NodeAttr)(nil), "yorkie.v1.TreeNode.AttributesEntry")
-	proto.RegisterType((*TreeNodes)(nil), "yorkie.v1.TreeNodes")
-	proto.RegisterType((*TreeNodeID)(nil), "yorkie.v1.TreeNodeID")
-	proto.RegisterType((*TreePos)(nil), "yorkie.v1.TreePos")
-	proto.RegisterType((*User)(nil), "yorkie.v1.User")
-	proto.RegisterType((*Project)(nil), "yorkie.v1.Project")
-	proto.RegisterType((*UpdatableProjectFields)(nil), "yorkie.v1.UpdatableProjectFields")
-	proto.RegisterType((*UpdatableProjectFields_AuthWebhookMethods)(nil), "yorkie.v1.UpdatableProjectFields.AuthWebhookMethods")
-	proto.RegisterType((*DocumentSummary)(nil), "yorkie.v1.DocumentSummary")
-	proto.RegisterType((*PresenceChange)(nil), "yorkie.v1.PresenceChange")
-	proto.RegisterType((*Presence)(nil), "yorkie.v1.Presence")
-	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Presence.DataEntry")
-	proto.RegisterType((*Checkpoint)(nil), "yorkie.v1.Checkpoint")
-	proto.RegisterType((*TextNodePos)(nil), "yorkie.v1.TextNodePos")
-	proto.RegisterType((*TimeTicket)(nil), "yorkie.v1.TimeTicket")
-	proto.RegisterType((*DocEvent)(nil), "yorkie.v1.DocEvent")
+type Presence struct {
+	Data                 map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func init() { proto.RegisterFile("yorkie/v1/resources.proto", fileDescriptor_36361b2f5d0f0896) }
-
-var fileDescriptor_36361b2f5d0f0896 = []byte{
-	// 2688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x8f, 0x23, 0x47,
-	0xd9, 0x77, 0xb7, 0xdb, 0x1f, 0xfd, 0x78, 0x77, 0xc7, 0x5b, 0xfb, 0xe5, 0xf5, 0xee, 0x4e, 0x66,
-	0x9d, 0x37, 0x79, 0x27, 0xbb, 0xe0, 0x9d, 0x1d, 0x92, 0x90, 0x0f, 0x02, 0x78, 0xec, 0xce, 0xda,
-	0xc9, 0xac, 0x67, 0x68, 0x7b, 0x36, 0x24, 0x02, 0xb5, 0x7a, 0xba, 0x6b, 0x77, 0x3a, 0x6b, 0xbb,
-	0x9d, 0xee, 0xb2, 0xb3, 0x96, 0x90, 0x90, 0x10, 0x48, 0x5c, 0xb9, 0xe5, 0x5f, 0xe0, 0xc2, 0x8d,
-	0x43, 0x8e, 0x70, 0x40, 0x48, 0x08, 0x11, 0x89, 0x48, 0x5c, 0x49, 0x38, 0x20, 0xb8, 0x21, 0x24,
-	0x6e, 0x48, 0xa8, 0x3e, 0xba, 0xdd, 0xb6, 0xdb, 0x1e, 0xaf, 0x19, 0xc2, 0xae, 0xb8, 0x75, 0x55,
-	0xfd, 0x9e, 0xaa, 0xe7, 0xab, 0x9e, 0x7a, 0xaa, 0xfa, 0x81, 0xcb, 0x23, 0xd7, 0x7b, 0xe8, 0xe0,
-	0x5b, 0xc3, 0xdb, 0xb7, 0x3c, 0xec, 0xbb, 0x03, 0xcf, 0xc2, 0x7e, 0xb9, 0xef, 0xb9, 0xc4, 0x45,
-	0x2a, 0x1f, 0x2a, 0x0f, 0x6f, 0x17, 0x9f, 0x79, 0xe0, 0xba, 0x0f, 0x3a, 0xf8, 0x16, 0x1b, 0x38,
-	0x1c, 0xdc, 0xbf, 0x45, 0x9c, 0x2e, 0xf6, 0x89, 0xd9, 0xed, 0x73, 0x6c, 0x71, 0x7d, 0x1a, 0xf0,
-	0xa1, 0x67, 0xf6, 0xfb, 0xd8, 0x13, 0x73, 0x95, 0x7e, 0x23, 0x41, 0xb6, 0xd5, 0x33, 0xfb, 0xfe,
-	0x91, 0x4b, 0xd0, 0x0d, 0x50, 0x3c, 0xd7, 0x25, 0x05, 0x69, 0x43, 0xda, 0xcc, 0x6d, 0x5f, 0x2c,
-	0x87, 0xeb, 0x94, 0xdf, 0x6a, 0xed, 0x35, 0xb5, 0x0e, 0xee, 0xe2, 0x1e, 0xd1, 0x19, 0x06, 0x7d,
-	0x13, 0xd4, 0xbe, 0x87, 0x7d, 0xdc, 0xb3, 0xb0, 0x5f, 0x90, 0x37, 0x92, 0x9b, 0xb9, 0xed, 0x52,
-	0x84, 0x20, 0x98, 0xb3, 0xbc, 0x1f, 0x80, 0xb4, 0x1e, 0xf1, 0x46, 0xfa, 0x98, 0xa8, 0xf8, 0x2d,
-	0x38, 0x33, 0x39, 0x88, 0xf2, 0x90, 0x7c, 0x88, 0x47, 0x6c, 0x79, 0x55, 0xa7, 0x9f, 0xe8, 0x05,
-	0x48, 0x0d, 0xcd, 0xce, 0x00, 0x17, 0x64, 0xc6, 0xd2, 0xb9, 0xc8, 0x0a, 0x01, 0xad, 0xce, 0x11,
-	0xaf, 0xc9, 0xaf, 0x48, 0xa5, 0x9f, 0xc8, 0x00, 0xd5, 0x23, 0xb3, 0xf7, 0x00, 0xef, 0x9b, 0xd6,
-	0x43, 0x74, 0x1d, 0x4e, 0xd9, 0xae, 0x35, 0xa0, 0x5c, 0x1b, 0xe3, 0x89, 0x73, 0x41, 0xdf, 0xdb,
-	0x78, 0x84, 0x5e, 0x02, 0xb0, 0x8e, 0xb0, 0xf5, 0xb0, 0xef, 0x3a, 0x3d, 0x22, 0x56, 0xb9, 0x10,
-	0x59, 0xa5, 0x1a, 0x0e, 0xea, 0x11, 0x20, 0x2a, 0x42, 0xd6, 0x17, 0x12, 0x16, 0x92, 0x1b, 0xd2,
-	0xe6, 0x29, 0x3d, 0x6c, 0xa3, 0x9b, 0x90, 0xb1, 0x18, 0x0f, 0x7e, 0x41, 0x61, 0x7a, 0x39, 0x3b,
-	0x31, 0x1f, 0x1d, 0xd1, 0x03, 0x04, 0xaa, 0xc0, 0xd9, 0xae, 0xd3, 0x33, 0xfc, 0x51, 0xcf, 0xc2,
-	0xb6, 0x41, 0x1c, 0xeb, 0x21, 0x26, 0x85, 0xd4, 0x0c, 0x1b, 0x6d, 0xa7, 0x8b, 0xdb, 0x6c, 0x50,
-	0x5f, 0xeb, 0x3a, 0xbd, 0x16, 0x83, 0xf3, 0x0e, 0x74, 0x0d, 0xc0, 0xf1, 0x0d, 0x0f, 0x77, 0xdd,
-	0x21, 0xb6, 0x0b, 0xe9, 0x0d, 0x69, 0x33, 0xab, 0xab, 0x8e, 0xaf, 0xf3, 0x8e, 0xd2, 0x2f, 0x24,
-	0x48, 0xf3, 0x55, 0xd1, 0xb3, 0x20, 0x3b, 0xb6, 0xb0, 0xee, 0xb9, 0x19, 0xa6, 0x1a, 0x35, 0x5d,
-	0x76, 0x6c, 0x54, 0x80, 0x4c, 0x17, 0xfb, 0xbe, 0xf9, 0x80, 0x2b, 0x5d, 0xd5, 0x83, 0x26, 0x7a,
-	0x11, 0xc0, 0xed, 0x63, 0xcf, 0x24, 0x8e, 0xdb, 0xf3, 0x0b, 0x49, 0x26, 0xdb, 0xf9, 0xc8, 0x34,
-	0x7b, 0xc1, 0xa0, 0x1e, 0xc1, 0xa1, 0x1d, 0x58, 0x0b, 0x6c, 0x6e, 0x70, 0xa9, 0x0b, 0x0a, 0xe3,
-	0xe0, 0x72, 0x8c, 0x31, 0x85, 0x7a, 0xce, 0xf4, 0x27, 0xda, 0xa5, 0x1f, 0x49, 0x90, 0x0d, 0x98,
-	0xa4, 0xf2, 0x5a, 0x1d, 0x87, 0xda, 0xd4, 0xc7, 0x1f, 0x30, 0x69, 0x4e, 0xeb, 0x2a, 0xef, 0x69,
-	0xe1, 0x0f, 0xd0, 0x75, 0x00, 0x1f, 0x7b, 0x43, 0xec, 0xb1, 0x61, 0x2a, 0x42, 0x72, 0x47, 0xde,
-	0x92, 0x74, 0x95, 0xf7, 0x52, 0xc8, 0x55, 0xc8, 0x74, 0xcc, 0x6e, 0xdf, 0xf5, 0xb8, 0xf1, 0xf8,
-	0x78, 0xd0, 0x85, 0x2e, 0x43, 0xd6, 0xb4, 0x88, 0xeb, 0x19, 0x8e, 0xcd, 0x38, 0x3d, 0xa5, 0x67,
-	0x58, 0xbb, 0x61, 0x97, 0x3e, 0xba, 0x0e, 0x6a, 0x28, 0x25, 0xfa, 0x12, 0x24, 0x7d, 0x1c, 0xec,
-	0x96, 0x42, 0x9c, 0x22, 0xca, 0x2d, 0x4c, 0xea, 0x09, 0x9d, 0xc2, 0x28, 0xda, 0xb4, 0x6d, 0xe1,
-	0x62, 0xf1, 0xe8, 0x8a, 0x6d, 0x53, 0xb4, 0x69, 0xdb, 0xe8, 0x16, 0x28, 0xd4, 0x7c, 0x8c, 0xbf,
-	0x49, 0x55, 0x8d, 0xe1, 0x77, 0xdd, 0x21, 0xae, 0x27, 0x74, 0x06, 0x44, 0x2f, 0x41, 0x9a, 0xbb,
-	0x80, 0xd0, 0xee, 0x95, 0x58, 0x12, 0xee, 0x14, 0xf5, 0x84, 0x2e, 0xc0, 0x74, 0x1d, 0x6c, 0x3b,
-	0x81, 0xcb, 0xc5, 0xaf, 0xa3, 0xd9, 0x0e, 0x95, 0x82, 0x01, 0xe9, 0x3a, 0x3e, 0xee, 0x60, 0x8b,
-	0x30, 0x4f, 0x9b, 0xb7, 0x4e, 0x8b, 0x41, 0xe8, 0x3a, 0x1c, 0x8c, 0xb6, 0x21, 0xe5, 0x93, 0x51,
-	0x07, 0x17, 0x32, 0x8c, 0xaa, 0x18, 0x4f, 0x45, 0x11, 0xf5, 0x84, 0xce, 0xa1, 0xe8, 0x75, 0xc8,
-	0x3a, 0x3d, 0xcb, 0xc3, 0xa6, 0x8f, 0x0b, 0x59, 0x46, 0x76, 0x2d, 0x96, 0xac, 0x21, 0x40, 0xf5,
-	0x84, 0x1e, 0x12, 0xa0, 0xaf, 0x81, 0x4a, 0x3c, 0x8c, 0x0d, 0x26, 0x9d, 0xba, 0x80, 0xba, 0xed,
-	0x61, 0x2c, 0x24, 0xcc, 0x12, 0xf1, 0x8d, 0xbe, 0x01, 0xc0, 0xa8, 0x39, 0xcf, 0xc0, 0xc8, 0xd7,
-	0xe7, 0x92, 0x07, 0x7c, 0xb3, 0x15, 0x59, 0x03, 0x69, 0x70, 0x8a, 0xae, 0x6c, 0x78, 0x78, 0x88,
-	0x3d, 0x1f, 0x17, 0x72, 0x6c, 0x8a, 0x8d, 0xb9, 0xfa, 0xd5, 0x39, 0xae, 0x9e, 0xd0, 0x73, 0x78,
-	0xdc, 0x2c, 0xfe, 0x4a, 0x82, 0x64, 0x0b, 0x13, 0x1a, 0x26, 0xfa, 0xa6, 0x47, 0x7d, 0x9e, 0x8a,
-	0x47, 0xb0, 0x6d, 0x98, 0x81, 0xe3, 0xcd, 0x0b, 0x13, 0x1c, 0x5f, 0xe5, 0xf0, 0x0a, 0x09, 0x82,
-	0xab, 0x3c, 0x0e, 0xae, 0xdb, 0x41, 0x70, 0xe5, 0x4e, 0x76, 0x35, 0x3e, 0xde, 0xb7, 0x9c, 0x6e,
-	0xbf, 0x13, 0x44, 0x59, 0xf4, 0x32, 0xe4, 0xf0, 0x23, 0x6c, 0x0d, 0x04, 0x0b, 0xca, 0x22, 0x16,
-	0x20, 0x40, 0x56, 0x48, 0xf1, 0xef, 0x12, 0x24, 0x2b, 0xb6, 0x7d, 0x12, 0x82, 0xbc, 0xc1, 0x02,
-	0xca, 0x30, 0x3a, 0x81, 0xbc, 0x68, 0x82, 0xd3, 0x14, 0x3d, 0x26, 0xff, 0x22, 0xa5, 0xfe, 0x87,
-	0x04, 0x0a, 0xdd, 0xa5, 0x4f, 0x80, 0xd8, 0x2f, 0x02, 0x44, 0x28, 0x93, 0x8b, 0x28, 0x55, 0x2b,
-	0xa4, 0x5a, 0x55, 0xf0, 0x8f, 0x25, 0x48, 0xf3, 0x58, 0x73, 0x12, 0xa2, 0x4f, 0xf2, 0x2e, 0xaf,
-	0xc6, 0x7b, 0x72, 0x59, 0xde, 0x7f, 0xa9, 0x80, 0xc2, 0x82, 0xc0, 0x09, 0x70, 0x7e, 0x03, 0x94,
-	0xfb, 0x9e, 0xdb, 0x15, 0x3c, 0x47, 0x33, 0xaa, 0x36, 0x7e, 0x44, 0x9a, 0xae, 0x8d, 0xf7, 0x5d,
-	0x5f, 0x67, 0x18, 0xf4, 0x3c, 0xc8, 0xc4, 0x15, 0x6c, 0xce, 0x43, 0xca, 0xc4, 0x45, 0x47, 0x70,
-	0x69, 0xcc, 0x8f, 0xd1, 0x35, 0xfb, 0xc6, 0xe1, 0xc8, 0x60, 0x27, 0x94, 0xc8, 0x37, 0xb6, 0xe7,
-	0x46, 0x99, 0x72, 0xc8, 0xd9, 0x5d, 0xb3, 0xbf, 0x33, 0xaa, 0x50, 0x22, 0x9e, 0x97, 0x9d, 0xb3,
-	0x66, 0x47, 0x68, 0x2a, 0x60, 0xb9, 0x3d, 0x82, 0x7b, 0xfc, 0x7c, 0x50, 0xf5, 0xa0, 0x39, 0xad,
-	0xdb, 0xf4, 0x92, 0xba, 0x45, 0x0d, 0x00, 0x93, 0x10, 0xcf, 0x39, 0x1c, 0x10, 0xec, 0x17, 0x32,
-	0x8c, 0xdd, 0x17, 0xe6, 0xb3, 0x5b, 0x09, 0xb1, 0x9c, 0xcb, 0x08, 0x71, 0xf1, 0xbb, 0x50, 0x98,
-	0x27, 0x4d, 0x4c, 0x22, 0x79, 0x73, 0x32, 0x91, 0x9c, 0xc3, 0xea, 0x38, 0x95, 0x2c, 0xbe, 0x01,
-	0x6b, 0x53, 0xab, 0xc7, 0xcc, 0x7a, 0x3e, 0x3a, 0xab, 0x1a, 0x25, 0xff, 0x83, 0x04, 0x69, 0x7e,
-	0x08, 0x3e, 0xa9, 0x6e, 0xb4, 0xea, 0xd6, 0xfe, 0x4c, 0x86, 0x14, 0x3f, 0xe3, 0x9e, 0x50, 0xc1,
-	0xde, 0x9a, 0xf0, 0x31, 0xbe, 0x25, 0x6e, 0xcc, 0xcf, 0x37, 0x16, 0x39, 0xd9, 0xb4, 0x92, 0x52,
-	0xcb, 0x2a, 0xe9, 0xdf, 0xf4, 0x9e, 0x8f, 0x25, 0xc8, 0x06, 0x59, 0xcd, 0x49, 0xa8, 0x79, 0x7b,
-	0xd2, 0xfb, 0x57, 0x39, 0xf3, 0x96, 0x0e, 0x9f, 0x9f, 0x24, 0x21, 0x1b, 0xe4, 0x54, 0x27, 0xc1,
-	0xfb, 0xf3, 0x13, 0x2e, 0x82, 0xa2, 0x54, 0x1e, 0x8e, 0xb8, 0x47, 0x29, 0xe2, 0x1e, 0x71, 0x28,
-	0xea, 0x1a, 0x9d, 0xe3, 0x42, 0xe7, 0xcb, 0x0b, 0x53, 0xc4, 0xc7, 0x0c, 0x9f, 0x5b, 0x90, 0x15,
-	0xf1, 0xd2, 0x2f, 0xa4, 0x66, 0x6e, 0x4b, 0x74, 0x52, 0xea, 0xb6, 0xbe, 0x1e, 0xa2, 0x56, 0x0d,
-	0xab, 0xff, 0xe9, 0x58, 0xf8, 0x99, 0x0c, 0x6a, 0x98, 0xe7, 0x3e, 0x69, 0x36, 0x6d, 0xc6, 0x6c,
-	0xf7, 0xf2, 0xe2, 0x54, 0xfd, 0x49, 0xdc, 0xf2, 0x3f, 0x57, 0x20, 0x17, 0xb9, 0x08, 0x9c, 0x84,
-	0x96, 0x2f, 0x43, 0x96, 0x6a, 0xd1, 0x70, 0xec, 0x47, 0x6c, 0xbd, 0x94, 0x9e, 0xa1, 0xed, 0x86,
-	0xfd, 0x08, 0x5d, 0x80, 0x34, 0x71, 0xd9, 0x40, 0x92, 0x0d, 0xa4, 0x88, 0x4b, 0xbb, 0xdd, 0xe3,
-	0xf6, 0xc7, 0xab, 0xc7, 0x5d, 0x60, 0xfe, 0xeb, 0x19, 0xc6, 0x7e, 0x4c, 0x86, 0xb1, 0x75, 0x2c,
-	0xd7, 0x4f, 0x6d, 0xa2, 0xb1, 0x93, 0x06, 0xe5, 0xd0, 0xb5, 0x47, 0xa5, 0xbf, 0x49, 0x70, 0x76,
-	0x26, 0x96, 0x4f, 0x65, 0xce, 0xd2, 0x92, 0x99, 0xf3, 0x16, 0x64, 0xd9, 0xdb, 0xd1, 0xb1, 0xd9,
-	0x76, 0x86, 0xc1, 0x78, 0x86, 0x2e, 0x1e, 0xa0, 0x8e, 0xbf, 0x5d, 0x08, 0x60, 0x85, 0xa0, 0x4d,
-	0x50, 0xc8, 0xa8, 0xcf, 0x5f, 0x2c, 0xce, 0x4c, 0x04, 0xc7, 0x7b, 0x54, 0xbe, 0xf6, 0xa8, 0x8f,
-	0x75, 0x86, 0x18, 0xcb, 0x9f, 0x62, 0x0f, 0x32, 0xbc, 0x51, 0xfa, 0xe9, 0x69, 0xc8, 0x45, 0x64,
-	0x46, 0x35, 0xc8, 0xbd, 0xef, 0xbb, 0x3d, 0xc3, 0x3d, 0x7c, 0x1f, 0x5b, 0x81, 0xb8, 0xd7, 0xe3,
-	0x0f, 0x3b, 0xf6, 0xbd, 0xc7, 0x80, 0xf5, 0x84, 0x0e, 0x94, 0x8e, 0xb7, 0x50, 0x05, 0x58, 0xcb,
-	0x30, 0x3d, 0xcf, 0x1c, 0x09, 0xf9, 0x37, 0x16, 0x4c, 0x52, 0xa1, 0x38, 0x7a, 0xfb, 0xa7, 0x54,
-	0xac, 0xc1, 0x1f, 0x47, 0x9d, 0xae, 0x43, 0x9c, 0xf0, 0x09, 0x67, 0xde, 0x0c, 0xfb, 0x01, 0x8e,
-	0xce, 0x10, 0x12, 0xa1, 0xdb, 0xa0, 0x10, 0xfc, 0x28, 0x08, 0x3f, 0x57, 0xe6, 0x10, 0xd3, 0xd4,
-	0xa7, 0x9e, 0xd0, 0x19, 0x14, 0xbd, 0x46, 0xf7, 0xd2, 0xa0, 0x47, 0xb0, 0x27, 0x76, 0xcb, 0xfa,
-	0x1c, 0xaa, 0x2a, 0x47, 0xd5, 0x13, 0x7a, 0x40, 0xc0, 0x96, 0xf3, 0x70, 0xf0, 0x3a, 0x33, 0x77,
-	0x39, 0x0f, 0xb3, 0x07, 0x27, 0x0a, 0x2d, 0x7e, 0x2a, 0x01, 0x8c, 0x75, 0x88, 0x36, 0x21, 0xd5,
-	0xa3, 0xa7, 0x59, 0x41, 0x62, 0x5b, 0x2e, 0x1a, 0xad, 0xf5, 0x7a, 0x9b, 0x1e, 0x74, 0x3a, 0x07,
-	0xac, 0x78, 0x9b, 0x8b, 0xfa, 0x64, 0x72, 0x05, 0x9f, 0x54, 0x96, 0xf3, 0xc9, 0xe2, 0xef, 0x25,
-	0x50, 0x43, 0xab, 0x2e, 0x94, 0xea, 0x4e, 0xe5, 0xe9, 0x91, 0xea, 0x2f, 0x12, 0xa8, 0xa1, 0xa7,
-	0x85, 0xfb, 0x4e, 0x5a, 0x7e, 0xdf, 0xc9, 0x91, 0x7d, 0xb7, 0xe2, 0x5b, 0x42, 0x54, 0x56, 0x65,
-	0x05, 0x59, 0x53, 0x4b, 0xca, 0xfa, 0x3b, 0x09, 0x14, 0xba, 0x31, 0xd0, 0x0b, 0x93, 0xc6, 0x3b,
-	0x17, 0x73, 0x67, 0x78, 0x3a, 0xac, 0xf7, 0x67, 0x09, 0x32, 0x62, 0xd3, 0xfe, 0x2f, 0xd8, 0xce,
-	0xc3, 0x78, 0xa1, 0xed, 0x44, 0xe2, 0xfc, 0x54, 0xd8, 0x2e, 0x3c, 0x9f, 0xef, 0x42, 0x46, 0xc4,
-	0xc1, 0x98, 0xe3, 0x7d, 0x0b, 0x32, 0x98, 0xc7, 0xd8, 0x98, 0x9b, 0x70, 0xf4, 0xdf, 0x5b, 0x00,
-	0x2b, 0x59, 0x90, 0x11, 0x01, 0x88, 0x26, 0xd3, 0x3d, 0x7a, 0x54, 0x48, 0x33, 0x69, 0x72, 0x10,
-	0xa2, 0xd8, 0xf8, 0x0a, 0x8b, 0xdc, 0x83, 0x2c, 0xa5, 0xa7, 0xe9, 0xc9, 0xd8, 0x9b, 0xa4, 0x48,
-	0x06, 0x42, 0x75, 0x32, 0xe8, 0xdb, 0xcb, 0xe9, 0x5e, 0x00, 0x2b, 0xa4, 0xf4, 0x5b, 0x19, 0xb2,
-	0xc1, 0x0e, 0x44, 0xcf, 0x45, 0x7e, 0x4a, 0x5d, 0x88, 0xd9, 0xa2, 0xe2, 0xb7, 0x54, 0x6c, 0x06,
-	0xb4, 0x62, 0xde, 0xf1, 0x12, 0xe4, 0x9c, 0x9e, 0x6f, 0xb0, 0xe7, 0x54, 0xf1, 0x93, 0x67, 0xee,
-	0xda, 0xaa, 0xd3, 0xf3, 0xf7, 0x3d, 0x3c, 0x6c, 0xd8, 0xa8, 0x3a, 0x91, 0x5a, 0xf2, 0x1b, 0xdd,
-	0xb3, 0x31, 0x54, 0x0b, 0xb3, 0x49, 0x7d, 0x99, 0x74, 0x6f, 0xc1, 0x6f, 0xcf, 0xc0, 0x20, 0xd1,
-	0xdf, 0x9e, 0xef, 0x01, 0x8c, 0x39, 0x5e, 0x31, 0xe7, 0xbb, 0x08, 0x69, 0xf7, 0xfe, 0x7d, 0x1f,
-	0x13, 0x71, 0x55, 0x10, 0xad, 0xd2, 0xcf, 0xc4, 0x75, 0x7e, 0xb1, 0xad, 0x04, 0x40, 0xd8, 0x0a,
-	0x89, 0x18, 0xc5, 0x4d, 0x35, 0x15, 0x8d, 0x92, 0xf3, 0xed, 0xa7, 0xac, 0x66, 0xbf, 0xd4, 0x22,
-	0x7e, 0x22, 0xf6, 0x13, 0x64, 0x74, 0x33, 0x50, 0xb2, 0xf4, 0x71, 0x64, 0x4d, 0xfc, 0x88, 0x34,
-	0x98, 0xe7, 0xd9, 0xb8, 0x4f, 0x8e, 0x58, 0x72, 0x94, 0xd2, 0x79, 0x63, 0xca, 0x19, 0xb2, 0xb3,
-	0xce, 0x20, 0xe6, 0xfa, 0xc2, 0x9d, 0xe1, 0x35, 0x7e, 0x57, 0x67, 0x4f, 0x0b, 0xe8, 0xcb, 0xe3,
-	0xfb, 0xd5, 0x82, 0x40, 0x1a, 0x60, 0x98, 0x23, 0x85, 0x3a, 0x38, 0x61, 0x47, 0xfa, 0x1e, 0x64,
-	0xc4, 0xb5, 0x1d, 0x6d, 0x83, 0x2a, 0xee, 0xb6, 0xc7, 0x79, 0x53, 0x96, 0xe3, 0x1a, 0x36, 0x7a,
-	0x03, 0xd6, 0x3a, 0xf8, 0x3e, 0x31, 0x7c, 0xe7, 0xb0, 0xe3, 0xf4, 0x1e, 0x50, 0x4a, 0x79, 0x11,
-	0xe5, 0x69, 0x8a, 0x6e, 0x71, 0x70, 0xc3, 0x2e, 0x75, 0x41, 0x39, 0xf0, 0xb1, 0x87, 0xce, 0x84,
-	0x1e, 0xac, 0x32, 0x57, 0x2d, 0x42, 0x76, 0xe0, 0x63, 0xaf, 0x67, 0x76, 0x03, 0x77, 0x0d, 0xdb,
-	0xe8, 0xd5, 0x98, 0xa3, 0xb2, 0x58, 0xe6, 0x05, 0x15, 0xe5, 0xa0, 0xa0, 0x82, 0x69, 0x81, 0x55,
-	0x5c, 0x44, 0x94, 0x50, 0xfa, 0xa7, 0x0c, 0x99, 0x7d, 0xcf, 0x65, 0x99, 0xf1, 0xf4, 0x92, 0x08,
-	0x94, 0xc8, 0x72, 0xec, 0x1b, 0x5d, 0x03, 0xe8, 0x0f, 0x0e, 0x3b, 0x8e, 0xc5, 0xea, 0x14, 0xf8,
-	0x16, 0x51, 0x79, 0xcf, 0xdb, 0x78, 0x44, 0x87, 0x7d, 0x6c, 0x79, 0x98, 0x97, 0x31, 0x28, 0x7c,
-	0x98, 0xf7, 0xd0, 0xe1, 0x4d, 0xc8, 0x9b, 0x03, 0x72, 0x64, 0x7c, 0x88, 0x0f, 0x8f, 0x5c, 0xf7,
-	0xa1, 0x31, 0xf0, 0x3a, 0xe2, 0x3a, 0x7d, 0x86, 0xf6, 0xbf, 0xc3, 0xbb, 0x0f, 0xbc, 0x0e, 0xda,
-	0x82, 0xf3, 0x13, 0xc8, 0x2e, 0x26, 0x47, 0xae, 0xed, 0x17, 0xd2, 0x1b, 0xc9, 0x4d, 0x55, 0x47,
-	0x11, 0xf4, 0x5d, 0x3e, 0x82, 0xbe, 0x0e, 0x57, 0xc4, 0xdf, 0x76, 0x1b, 0x9b, 0x16, 0x71, 0x86,
-	0x26, 0xc1, 0x06, 0x39, 0xf2, 0xb0, 0x7f, 0xe4, 0x76, 0x6c, 0xb6, 0x27, 0x54, 0xfd, 0x32, 0x87,
-	0xd4, 0x42, 0x44, 0x3b, 0x00, 0x4c, 0x29, 0x31, 0xfb, 0x18, 0x4a, 0xa4, 0xa4, 0x91, 0xc3, 0x45,
-	0x3d, 0x9e, 0x74, 0x7c, 0xc2, 0xfc, 0x38, 0x09, 0x17, 0x0f, 0x68, 0xcb, 0x3c, 0xec, 0x60, 0x61,
-	0x88, 0x37, 0x1d, 0xdc, 0xb1, 0x7d, 0xb4, 0x25, 0xd4, 0x2f, 0x89, 0xa7, 0xd0, 0xe9, 0xf9, 0x5a,
-	0xc4, 0x73, 0x7a, 0x0f, 0x58, 0x32, 0x25, 0x8c, 0xf3, 0x66, 0x8c, 0x7a, 0xe5, 0x25, 0xa8, 0xa7,
-	0x95, 0x7f, 0x7f, 0x8e, 0xf2, 0xb9, 0x67, 0xbd, 0x18, 0xf1, 0xe3, 0x78, 0xd6, 0xcb, 0x95, 0x19,
-	0xf3, 0xc4, 0x9a, 0xec, 0x3b, 0x8b, 0x4d, 0xa6, 0x2c, 0xc1, 0xfa, 0x7c, 0x83, 0x16, 0xcb, 0x80,
-	0x66, 0xf9, 0xe0, 0x55, 0x23, 0x5c, 0x1c, 0x89, 0xf9, 0x52, 0xd0, 0x2c, 0xfd, 0x40, 0x86, 0xb5,
-	0x9a, 0xa8, 0xb8, 0x69, 0x0d, 0xba, 0x5d, 0xd3, 0x1b, 0xcd, 0x6c, 0x89, 0xd9, 0x7f, 0xd3, 0xd3,
-	0x05, 0x36, 0x6a, 0xa4, 0xc0, 0x66, 0xd2, 0xa5, 0x94, 0xc7, 0x71, 0xa9, 0xd7, 0x21, 0x67, 0x5a,
-	0x16, 0xf6, 0xfd, 0x68, 0x5a, 0xba, 0x88, 0x16, 0x02, 0xf8, 0x8c, 0x3f, 0xa6, 0x1f, 0xc7, 0x1f,
-	0xff, 0x2a, 0x8d, 0x8b, 0x9d, 0x44, 0x31, 0xce, 0x2b, 0x13, 0x89, 0xfc, 0xff, 0xcd, 0x2d, 0x86,
-	0x11, 0xd5, 0x39, 0x91, 0xc4, 0xfe, 0x16, 0x64, 0x83, 0xfa, 0x98, 0x45, 0x75, 0x51, 0x21, 0xa8,
-	0xd4, 0x0d, 0xaa, 0xa2, 0xe8, 0x24, 0xe8, 0x0a, 0x5c, 0xaa, 0xd6, 0x2b, 0xcd, 0x3b, 0x9a, 0xd1,
-	0x7e, 0x77, 0x5f, 0x33, 0x0e, 0x9a, 0xad, 0x7d, 0xad, 0xda, 0x78, 0xb3, 0xa1, 0xd5, 0xf2, 0x09,
-	0x74, 0x0e, 0xd6, 0xa2, 0x83, 0xfb, 0x07, 0xed, 0xbc, 0x84, 0x2e, 0x02, 0x8a, 0x76, 0xd6, 0xb4,
-	0x5d, 0xad, 0xad, 0xe5, 0x65, 0x74, 0x01, 0xce, 0x46, 0xfb, 0xab, 0xbb, 0x5a, 0x45, 0xcf, 0x27,
-	0x4b, 0x43, 0xc8, 0x06, 0x4c, 0xa0, 0xdb, 0xa0, 0x50, 0x57, 0x16, 0xa7, 0xcf, 0xb5, 0x18, 0x3e,
-	0xcb, 0x35, 0x93, 0x98, 0xfc, 0x68, 0x64, 0xd0, 0xe2, 0x57, 0x41, 0x0d, 0xbb, 0x1e, 0xe7, 0x29,
-	0xac, 0xd4, 0xa4, 0x62, 0x86, 0x25, 0x5a, 0x93, 0x75, 0x40, 0x52, 0x5c, 0x1d, 0xd0, 0x64, 0x25,
-	0x91, 0x3c, 0x55, 0x49, 0x54, 0xfa, 0xa1, 0x04, 0xb9, 0xc8, 0xcf, 0xa5, 0x93, 0x3d, 0x0f, 0xd1,
-	0xff, 0xc3, 0x9a, 0x87, 0x3b, 0x26, 0xbd, 0x90, 0x1b, 0x02, 0xc0, 0xdf, 0x62, 0xcf, 0x04, 0xdd,
-	0x7b, 0xfc, 0xe0, 0xb4, 0x00, 0xc6, 0x33, 0x47, 0x6b, 0x97, 0xa4, 0xd9, 0xda, 0xa5, 0xab, 0xa0,
-	0xda, 0xb8, 0x43, 0xef, 0xf9, 0xd8, 0x0b, 0x04, 0x0a, 0x3b, 0x26, 0x2a, 0x9b, 0x92, 0x93, 0x95,
-	0x4d, 0x07, 0x90, 0xad, 0xb9, 0x96, 0x36, 0xc4, 0x3d, 0x82, 0x6e, 0x4e, 0x78, 0xe6, 0xa5, 0x88,
-	0x84, 0x01, 0x24, 0xe2, 0x8c, 0x57, 0x81, 0x9f, 0x53, 0xfe, 0x91, 0x58, 0x31, 0x38, 0xb8, 0x68,
-	0xc7, 0x8d, 0x4f, 0x65, 0x50, 0xc3, 0x7b, 0x29, 0x75, 0xae, 0x7b, 0x95, 0xdd, 0x03, 0xe1, 0x2e,
-	0xcd, 0x83, 0xdd, 0xdd, 0x7c, 0x82, 0x3a, 0x57, 0xa4, 0x73, 0x67, 0x6f, 0x6f, 0x57, 0xab, 0x34,
-	0xb9, 0xd3, 0x45, 0xfa, 0x1b, 0xcd, 0xb6, 0x76, 0x47, 0xd3, 0xf3, 0xf2, 0xd4, 0x24, 0xbb, 0x7b,
-	0xcd, 0x3b, 0xf9, 0x24, 0xf5, 0xc4, 0x48, 0x67, 0x6d, 0xef, 0x60, 0x67, 0x57, 0xcb, 0x2b, 0x53,
-	0xdd, 0xad, 0xb6, 0xde, 0x68, 0xde, 0xc9, 0xa7, 0xd0, 0x79, 0xc8, 0x47, 0x97, 0x7c, 0xb7, 0xad,
-	0xb5, 0xf2, 0xe9, 0xa9, 0x89, 0x6b, 0x95, 0xb6, 0x96, 0xcf, 0xa0, 0x22, 0x5c, 0x8c, 0x74, 0xd2,
-	0x5b, 0x92, 0xb1, 0xb7, 0xf3, 0x96, 0x56, 0x6d, 0xe7, 0xb3, 0xe8, 0x32, 0x5c, 0x98, 0x1e, 0xab,
-	0xe8, 0x7a, 0xe5, 0xdd, 0xbc, 0x3a, 0x35, 0x57, 0x5b, 0xfb, 0x76, 0x3b, 0x0f, 0x53, 0x73, 0x09,
-	0x89, 0x8c, 0x6a, 0xb3, 0x9d, 0xcf, 0xa1, 0x4b, 0x70, 0x6e, 0x4a, 0x2a, 0x36, 0x70, 0x6a, 0x7a,
-	0x26, 0x5d, 0xd3, 0xf2, 0xa7, 0x6f, 0x7c, 0x1f, 0x4e, 0x45, 0x4d, 0x81, 0x9e, 0x85, 0x67, 0x6a,
-	0x7b, 0x55, 0x43, 0xbb, 0xa7, 0x35, 0xdb, 0x81, 0x0a, 0xaa, 0x07, 0x77, 0x69, 0x8b, 0x6f, 0x50,
-	0xba, 0xb5, 0x17, 0x80, 0xde, 0xa9, 0xb4, 0xab, 0x75, 0xad, 0x96, 0x97, 0xd0, 0x73, 0x70, 0x7d,
-	0x1e, 0xe8, 0xa0, 0x19, 0xc0, 0xe4, 0x9d, 0x9b, 0xbf, 0xfe, 0x7c, 0x5d, 0xfa, 0xe4, 0xf3, 0x75,
-	0xe9, 0x8f, 0x9f, 0xaf, 0x4b, 0x1f, 0xfd, 0x69, 0x3d, 0x01, 0x67, 0x6d, 0x3c, 0x0c, 0x3c, 0xc5,
-	0xec, 0x3b, 0xe5, 0xe1, 0xed, 0x7d, 0xe9, 0x3d, 0xa5, 0xfc, 0xfa, 0xf0, 0xf6, 0x61, 0x9a, 0xc5,
-	0xc6, 0xaf, 0xfc, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x31, 0x80, 0xfd, 0xd3, 0x2a, 0x00, 0x00,
+func (m *Presence) Reset()         { *m = Presence{} }
+func (m *Presence) String() string { return proto.CompactTextString(m) }
+func (*Presence) ProtoMessage()    {}
+func (*Presence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{30}
 }
-
-func (m *Snapshot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Presence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Presence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Presence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Presence.Merge(m, src)
 }
-
-func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Presence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Presence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Presence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Presence proto.InternalMessageInfo
+
+func (m *Presence) GetData() map[string]string {
+	if m != nil {
+		return m.Data
 	}
-	if len(m.Presences) > 0 {
-		for k := range m.Presences {
-			v := m.Presences[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintResources(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+	return nil
+}
+
+type Checkpoint struct {
+	ServerSeq            int64    `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	ClientSeq            uint32   `protobuf:"varint,2,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
+func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
+func (*Checkpoint) ProtoMessage()    {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{31}
+}
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Root != nil {
-		{
-			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
+}
+func (m *Checkpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
+func (m *Checkpoint) GetServerSeq() int64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ChangePack) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Checkpoint) GetClientSeq() uint32 {
+	if m != nil {
+		return m.ClientSeq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ChangePack) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TextNodePos struct {
+	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Offset               int32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	RelativeOffset       int32       `protobuf:"varint,3,opt,name=relative_offset,json=relativeOffset,proto3" json:"relative_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsRemoved {
-		i--
-		if m.IsRemoved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.MinSyncedTicket != nil {
-		{
-			size, err := m.MinSyncedTicket.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Changes) > 0 {
-		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *TextNodePos) Reset()         { *m = TextNodePos{} }
+func (m *TextNodePos) String() string { return proto.CompactTextString(m) }
+func (*TextNodePos) ProtoMessage()    {}
+func (*TextNodePos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{32}
+}
+func (m *TextNodePos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextNodePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextNodePos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Snapshot) > 0 {
-		i -= len(m.Snapshot)
-		copy(dAtA[i:], m.Snapshot)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Snapshot)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Checkpoint != nil {
-		{
-			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *TextNodePos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextNodePos.Merge(m, src)
+}
+func (m *TextNodePos) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextNodePos) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextNodePos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextNodePos proto.InternalMessageInfo
+
+func (m *TextNodePos) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.DocumentKey) > 0 {
-		i -= len(m.DocumentKey)
-		copy(dAtA[i:], m.DocumentKey)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKey)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *TextNodePos) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Change) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TextNodePos) GetRelativeOffset() int32 {
+	if m != nil {
+		return m.RelativeOffset
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Change) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TimeTicket struct {
+	Lamport   int64  `protobuf:"varint,1,opt,name=lamport,proto3" json:"lamport,omitempty"`
+	Delimiter uint32 `protobuf:"varint,2,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	ActorId   []byte `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// The fields below are used instead of lamport and actor_id when the
+	// enclosing pack is compact. The delta is relative to the lamport of the
+	// change that contains this ticket.
+	ActorIndex           uint32   `protobuf:"varint,4,opt,name=actor_index,json=actorIndex,proto3" json:"actor_index,omitempty"`
+	LamportDelta         int64    `protobuf:"zigzag64,5,opt,name=lamport_delta,json=lamportDelta,proto3" json:"lamport_delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PresenceChange != nil {
-		{
-			size, err := m.PresenceChange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *TimeTicket) Reset()         { *m = TimeTicket{} }
+func (m *TimeTicket) String() string { return proto.CompactTextString(m) }
+func (*TimeTicket) ProtoMessage()    {}
+func (*TimeTicket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{33}
+}
+func (m *TimeTicket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeTicket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TimeTicket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeTicket.Merge(m, src)
+}
+func (m *TimeTicket) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeTicket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeTicket.DiscardUnknown(m)
 }
 
-func (m *ChangeID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TimeTicket proto.InternalMessageInfo
+
+func (m *TimeTicket) GetLamport() int64 {
+	if m != nil {
+		return m.Lamport
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ChangeID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TimeTicket) GetDelimiter() uint32 {
+	if m != nil {
+		return m.Delimiter
+	}
+	return 0
 }
 
-func (m *ChangeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Lamport != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ServerSeq != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
-		i--
-		dAtA[i] = 0x10
+func (m *TimeTicket) GetActorId() []byte {
+	if m != nil {
+		return m.ActorId
 	}
-	if m.ClientSeq != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.ClientSeq))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *TimeTicket) GetActorIndex() uint32 {
+	if m != nil {
+		return m.ActorIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Operation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TimeTicket) GetLamportDelta() int64 {
+	if m != nil {
+		return m.LamportDelta
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DocEvent struct {
+	Type                 DocEventType      `protobuf:"varint,1,opt,name=type,proto3,enum=yorkie.v1.DocEventType" json:"type,omitempty"`
+	Publisher            string            `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Presence             map[string]string `protobuf:"bytes,3,rep,name=presence,proto3" json:"presence,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Reason               string            `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *DocEvent) Reset()         { *m = DocEvent{} }
+func (m *DocEvent) String() string { return proto.CompactTextString(m) }
+func (*DocEvent) ProtoMessage()    {}
+func (*DocEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{34}
+}
+func (m *DocEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DocEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DocEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocEvent.Merge(m, src)
+}
+func (m *DocEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocEvent.DiscardUnknown(m)
 }
 
-func (m *Operation_Set_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DocEvent proto.InternalMessageInfo
+
+func (m *DocEvent) GetType() DocEventType {
+	if m != nil {
+		return m.Type
+	}
+	return DocEventType_DOC_EVENT_TYPE_DOCUMENT_CHANGED
 }
 
-func (m *Operation_Set_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Set != nil {
-		{
-			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *DocEvent) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *Operation_Add_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *DocEvent) GetPresence() map[string]string {
+	if m != nil {
+		return m.Presence
+	}
+	return nil
 }
 
-func (m *Operation_Add_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Add != nil {
-		{
-			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *DocEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *Operation_Move_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// DocumentExport is the portable dump of a document to move it between
+// clusters. The changes are included only if the full history is exported.
+type DocumentExport struct {
+	DocumentKey          string    `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	ServerSeq            int64     `protobuf:"varint,2,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	Snapshot             []byte    `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Changes              []*Change `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *Operation_Move_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Move != nil {
-		{
-			size, err := m.Move.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	return len(dAtA) - i, nil
+func (m *DocumentExport) Reset()         { *m = DocumentExport{} }
+func (m *DocumentExport) String() string { return proto.CompactTextString(m) }
+func (*DocumentExport) ProtoMessage()    {}
+func (*DocumentExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36361b2f5d0f0896, []int{35}
 }
-func (m *Operation_Remove_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DocumentExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Operation_Remove_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Remove != nil {
-		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *DocumentExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocumentExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Operation_Edit_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DocumentExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentExport.Merge(m, src)
+}
+func (m *DocumentExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocumentExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentExport.DiscardUnknown(m)
 }
 
-func (m *Operation_Edit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Edit != nil {
-		{
-			size, err := m.Edit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+var xxx_messageInfo_DocumentExport proto.InternalMessageInfo
+
+func (m *DocumentExport) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Select_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *Operation_Select_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Select != nil {
-		{
-			size, err := m.Select.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+func (m *DocumentExport) GetServerSeq() int64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *Operation_Style_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *DocumentExport) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
 }
 
-func (m *Operation_Style_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Style != nil {
-		{
-			size, err := m.Style.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+func (m *DocumentExport) GetChanges() []*Change {
+	if m != nil {
+		return m.Changes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *Operation_Increase_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func init() {
+	proto.RegisterEnum("yorkie.v1.ValueType", ValueType_name, ValueType_value)
+	proto.RegisterEnum("yorkie.v1.ConflictPolicy", ConflictPolicy_name, ConflictPolicy_value)
+	proto.RegisterEnum("yorkie.v1.DocEventType", DocEventType_name, DocEventType_value)
+	proto.RegisterEnum("yorkie.v1.PresenceChange_ChangeType", PresenceChange_ChangeType_name, PresenceChange_ChangeType_value)
+	proto.RegisterType((*Snapshot)(nil), "yorkie.v1.Snapshot")
+	proto.RegisterMapType((map[string]*Presence)(nil), "yorkie.v1.Snapshot.PresencesEntry")
+	proto.RegisterType((*SnapshotDelta)(nil), "yorkie.v1.SnapshotDelta")
+	proto.RegisterMapType((map[string]*Presence)(nil), "yorkie.v1.SnapshotDelta.PresencesEntry")
+	proto.RegisterType((*SnapshotDeltaNode)(nil), "yorkie.v1.SnapshotDeltaNode")
+	proto.RegisterType((*ChangePack)(nil), "yorkie.v1.ChangePack")
+	proto.RegisterType((*Change)(nil), "yorkie.v1.Change")
+	proto.RegisterType((*ChangeID)(nil), "yorkie.v1.ChangeID")
+	proto.RegisterType((*Operation)(nil), "yorkie.v1.Operation")
+	proto.RegisterType((*Operation_Set)(nil), "yorkie.v1.Operation.Set")
+	proto.RegisterType((*Operation_Add)(nil), "yorkie.v1.Operation.Add")
+	proto.RegisterType((*Operation_Move)(nil), "yorkie.v1.Operation.Move")
+	proto.RegisterType((*Operation_Remove)(nil), "yorkie.v1.Operation.Remove")
+	proto.RegisterType((*Operation_Edit)(nil), "yorkie.v1.Operation.Edit")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Operation.Edit.AttributesEntry")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.Edit.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_Select)(nil), "yorkie.v1.Operation.Select")
+	proto.RegisterType((*Operation_Style)(nil), "yorkie.v1.Operation.Style")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Operation.Style.AttributesEntry")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.Style.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_Increase)(nil), "yorkie.v1.Operation.Increase")
+	proto.RegisterType((*Operation_TreeEdit)(nil), "yorkie.v1.Operation.TreeEdit")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.TreeEdit.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_TreeStyle)(nil), "yorkie.v1.Operation.TreeStyle")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Operation.TreeStyle.AttributesEntry")
+	proto.RegisterType((*Operation_EditReverse)(nil), "yorkie.v1.Operation.EditReverse")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Operation.EditReverse.AttributesEntry")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.EditReverse.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_AddAnnotation)(nil), "yorkie.v1.Operation.AddAnnotation")
+	proto.RegisterType((*Operation_RemoveAnnotation)(nil), "yorkie.v1.Operation.RemoveAnnotation")
+	proto.RegisterType((*Operation_SetAdd)(nil), "yorkie.v1.Operation.SetAdd")
+	proto.RegisterType((*Operation_SetRemove)(nil), "yorkie.v1.Operation.SetRemove")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "yorkie.v1.Operation.SetRemove.CreatedAtMapByActorEntry")
+	proto.RegisterType((*JSONElementSimple)(nil), "yorkie.v1.JSONElementSimple")
+	proto.RegisterType((*JSONElement)(nil), "yorkie.v1.JSONElement")
+	proto.RegisterType((*JSONElement_JSONObject)(nil), "yorkie.v1.JSONElement.JSONObject")
+	proto.RegisterType((*JSONElement_JSONArray)(nil), "yorkie.v1.JSONElement.JSONArray")
+	proto.RegisterType((*JSONElement_Primitive)(nil), "yorkie.v1.JSONElement.Primitive")
+	proto.RegisterType((*JSONElement_Text)(nil), "yorkie.v1.JSONElement.Text")
+	proto.RegisterType((*JSONElement_Counter)(nil), "yorkie.v1.JSONElement.Counter")
+	proto.RegisterType((*JSONElement_Tree)(nil), "yorkie.v1.JSONElement.Tree")
+	proto.RegisterType((*JSONElement_Set)(nil), "yorkie.v1.JSONElement.Set")
+	proto.RegisterType((*SetMember)(nil), "yorkie.v1.SetMember")
+	proto.RegisterType((*RHTNode)(nil), "yorkie.v1.RHTNode")
+	proto.RegisterType((*RGANode)(nil), "yorkie.v1.RGANode")
+	proto.RegisterType((*NodeAttr)(nil), "yorkie.v1.NodeAttr")
+	proto.RegisterType((*TextNode)(nil), "yorkie.v1.TextNode")
+	proto.RegisterMapType((map[string]*NodeAttr)(nil), "yorkie.v1.TextNode.AttributesEntry")
+	proto.RegisterType((*TextNodeID)(nil), "yorkie.v1.TextNodeID")
+	proto.RegisterType((*TextAnnotation)(nil), "yorkie.v1.TextAnnotation")
+	proto.RegisterType((*TreeNode)(nil), "yorkie.v1.TreeNode")
+	proto.RegisterMapType((map[string]*NodeAttr)(nil), "yorkie.v1.TreeNode.AttributesEntry")
+	proto.RegisterType((*TreeNodes)(nil), "yorkie.v1.TreeNodes")
+	proto.RegisterType((*TreeNodeID)(nil), "yorkie.v1.TreeNodeID")
+	proto.RegisterType((*TreePos)(nil), "yorkie.v1.TreePos")
+	proto.RegisterType((*User)(nil), "yorkie.v1.User")
+	proto.RegisterType((*Project)(nil), "yorkie.v1.Project")
+	proto.RegisterType((*UpdatableProjectFields)(nil), "yorkie.v1.UpdatableProjectFields")
+	proto.RegisterType((*UpdatableProjectFields_AuthWebhookMethods)(nil), "yorkie.v1.UpdatableProjectFields.AuthWebhookMethods")
+	proto.RegisterType((*UpdatableProjectFields_SensitivePresenceKeys)(nil), "yorkie.v1.UpdatableProjectFields.SensitivePresenceKeys")
+	proto.RegisterType((*UpdatableProjectFields_EventWebhookEvents)(nil), "yorkie.v1.UpdatableProjectFields.EventWebhookEvents")
+	proto.RegisterType((*DocumentSummary)(nil), "yorkie.v1.DocumentSummary")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.DocumentSummary.LabelsEntry")
+	proto.RegisterType((*DocumentTemplate)(nil), "yorkie.v1.DocumentTemplate")
+	proto.RegisterType((*DocumentACL)(nil), "yorkie.v1.DocumentACL")
+	proto.RegisterType((*DocumentMemory)(nil), "yorkie.v1.DocumentMemory")
+	proto.RegisterType((*ClientSummary)(nil), "yorkie.v1.ClientSummary")
+	proto.RegisterType((*ConnectionInfo)(nil), "yorkie.v1.ConnectionInfo")
+	proto.RegisterType((*PresenceChange)(nil), "yorkie.v1.PresenceChange")
+	proto.RegisterType((*Presence)(nil), "yorkie.v1.Presence")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.Presence.DataEntry")
+	proto.RegisterType((*Checkpoint)(nil), "yorkie.v1.Checkpoint")
+	proto.RegisterType((*TextNodePos)(nil), "yorkie.v1.TextNodePos")
+	proto.RegisterType((*TimeTicket)(nil), "yorkie.v1.TimeTicket")
+	proto.RegisterType((*DocEvent)(nil), "yorkie.v1.DocEvent")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.DocEvent.PresenceEntry")
+	proto.RegisterType((*DocumentExport)(nil), "yorkie.v1.DocumentExport")
 }
 
-func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Increase != nil {
-		{
-			size, err := m.Increase.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+func init() { proto.RegisterFile("yorkie/v1/resources.proto", fileDescriptor_36361b2f5d0f0896) }
+
+var fileDescriptor_36361b2f5d0f0896 = []byte{
+	// 4121 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x4d, 0x6c, 0x1b, 0x49,
+	0x76, 0x56, 0xf3, 0xbf, 0x1f, 0x45, 0x8a, 0x2a, 0xff, 0xd1, 0xf4, 0xcf, 0xc8, 0x9c, 0x9f, 0xf5,
+	0xd8, 0x33, 0xb4, 0xad, 0xf5, 0x78, 0x76, 0x66, 0x32, 0x93, 0xa5, 0xa8, 0x1e, 0x8b, 0x1e, 0x99,
+	0x52, 0x9a, 0x94, 0x1d, 0x2f, 0x12, 0x34, 0x5a, 0xec, 0x92, 0xd4, 0x23, 0x92, 0xcd, 0xed, 0x6e,
+	0xd1, 0xe6, 0x20, 0xb7, 0xe4, 0xb0, 0x01, 0xb2, 0xa7, 0x5c, 0x72, 0x0b, 0x02, 0xe4, 0x12, 0x04,
+	0xc8, 0x2d, 0x09, 0x16, 0xc8, 0x29, 0x87, 0x6c, 0x80, 0x20, 0xc9, 0x02, 0x8b, 0x20, 0xd7, 0x64,
+	0xf6, 0x10, 0xec, 0x1e, 0x13, 0x24, 0x87, 0x00, 0x01, 0x82, 0xfa, 0x6b, 0x76, 0x37, 0x9b, 0x2d,
+	0x4a, 0x23, 0xcf, 0x7a, 0xf6, 0xd6, 0x55, 0xf5, 0xbd, 0xaa, 0x57, 0xaf, 0xde, 0x7b, 0xf5, 0xea,
+	0x75, 0x15, 0x5c, 0x1e, 0x5b, 0xf6, 0xa1, 0x89, 0xef, 0x8c, 0xee, 0xdd, 0xb1, 0xb1, 0x63, 0x1d,
+	0xd9, 0x5d, 0xec, 0xd4, 0x86, 0xb6, 0xe5, 0x5a, 0x48, 0x66, 0x4d, 0xb5, 0xd1, 0xbd, 0xca, 0x6b,
+	0xfb, 0x96, 0xb5, 0xdf, 0xc3, 0x77, 0x68, 0xc3, 0xee, 0xd1, 0xde, 0x1d, 0xd7, 0xec, 0x63, 0xc7,
+	0xd5, 0xfb, 0x43, 0x86, 0xad, 0x5c, 0x0f, 0x03, 0x9e, 0xdb, 0xfa, 0x70, 0x88, 0x6d, 0xde, 0x57,
+	0xf5, 0x1f, 0x24, 0xc8, 0xb5, 0x07, 0xfa, 0xd0, 0x39, 0xb0, 0x5c, 0x74, 0x0b, 0x52, 0xb6, 0x65,
+	0xb9, 0x65, 0x69, 0x45, 0xba, 0x99, 0x5f, 0xbd, 0x58, 0xf3, 0xc6, 0xa9, 0x3d, 0x6a, 0x6f, 0xb5,
+	0x94, 0x1e, 0xee, 0xe3, 0x81, 0xab, 0x52, 0x0c, 0xfa, 0x2e, 0xc8, 0x43, 0x1b, 0x3b, 0x78, 0xd0,
+	0xc5, 0x4e, 0x39, 0xb1, 0x92, 0xbc, 0x99, 0x5f, 0xad, 0xfa, 0x08, 0x44, 0x9f, 0xb5, 0x6d, 0x01,
+	0x52, 0x06, 0xae, 0x3d, 0x56, 0x27, 0x44, 0x95, 0xdf, 0x80, 0x62, 0xb0, 0x11, 0x95, 0x20, 0x79,
+	0x88, 0xc7, 0x74, 0x78, 0x59, 0x25, 0x9f, 0xe8, 0x6d, 0x48, 0x8f, 0xf4, 0xde, 0x11, 0x2e, 0x27,
+	0x28, 0x4b, 0xe7, 0x7c, 0x23, 0x08, 0x5a, 0x95, 0x21, 0x3e, 0x4c, 0x7c, 0x47, 0xaa, 0xfe, 0x7e,
+	0x02, 0x0a, 0x62, 0xe4, 0x75, 0xdc, 0x73, 0x75, 0xb4, 0x0a, 0xe9, 0x81, 0x65, 0x60, 0xa7, 0x2c,
+	0x51, 0x16, 0xaf, 0x46, 0xb0, 0x48, 0x81, 0x2d, 0xcb, 0xc0, 0x2a, 0x83, 0x22, 0x65, 0x7a, 0x6a,
+	0xdf, 0x9a, 0x45, 0x37, 0x7b, 0x7e, 0x9e, 0x34, 0x93, 0xc7, 0x4b, 0xf3, 0x65, 0xc8, 0xe2, 0x7b,
+	0xb0, 0x3c, 0x35, 0x43, 0x74, 0x0d, 0x60, 0x57, 0x77, 0xb0, 0x66, 0x0e, 0x0c, 0xfc, 0x82, 0x76,
+	0x5e, 0x50, 0x65, 0x52, 0xd3, 0x24, 0x15, 0xe8, 0x2d, 0x48, 0x11, 0x11, 0xf0, 0x11, 0x90, 0x6f,
+	0x04, 0x75, 0xa3, 0x43, 0x45, 0x44, 0xdb, 0xab, 0x3f, 0x4e, 0x02, 0x34, 0x0e, 0xf4, 0xc1, 0x3e,
+	0xde, 0xd6, 0xbb, 0x87, 0xe8, 0x06, 0x2c, 0x1a, 0x56, 0xf7, 0x88, 0xcc, 0x47, 0x9b, 0x30, 0x9d,
+	0x17, 0x75, 0x9f, 0xe1, 0x31, 0x7a, 0x0f, 0xa0, 0x7b, 0x80, 0xbb, 0x87, 0x43, 0xcb, 0x1c, 0xb8,
+	0xbc, 0xff, 0x0b, 0xbe, 0xfe, 0x1b, 0x5e, 0xa3, 0xea, 0x03, 0xa2, 0x0a, 0xe4, 0x1c, 0x3e, 0x09,
+	0x2a, 0xc7, 0x45, 0xd5, 0x2b, 0xa3, 0xdb, 0x90, 0xed, 0x52, 0x1e, 0x9c, 0x72, 0x8a, 0x2e, 0xd2,
+	0x72, 0xa0, 0x3f, 0xd2, 0xa2, 0x0a, 0x04, 0xaa, 0xc3, 0x72, 0xdf, 0x1c, 0x68, 0xce, 0x78, 0xd0,
+	0xc5, 0x86, 0xe6, 0x9a, 0xdd, 0x43, 0xec, 0x96, 0xd3, 0x53, 0x6c, 0x74, 0xcc, 0x3e, 0xee, 0xd0,
+	0x46, 0x75, 0xa9, 0x6f, 0x0e, 0xda, 0x14, 0xce, 0x2a, 0x88, 0xec, 0x4c, 0x47, 0xb3, 0x71, 0xdf,
+	0x1a, 0x61, 0xa3, 0x9c, 0x59, 0x91, 0x6e, 0xe6, 0x54, 0xd9, 0x74, 0x54, 0x56, 0xc1, 0x9b, 0xbb,
+	0x56, 0x7f, 0xa8, 0x77, 0xdd, 0x72, 0x56, 0x34, 0x37, 0x58, 0x05, 0xba, 0x02, 0xb2, 0xde, 0x75,
+	0x2d, 0x5b, 0x33, 0x0d, 0xa7, 0x9c, 0x5b, 0x49, 0x92, 0xa9, 0xd0, 0x8a, 0xa6, 0xe1, 0xa0, 0x15,
+	0xc8, 0x13, 0x42, 0x1b, 0x3b, 0x8e, 0x69, 0x0d, 0xca, 0x32, 0x93, 0x9f, 0xaf, 0x0a, 0xbd, 0x0b,
+	0x48, 0x14, 0xb1, 0xa1, 0x89, 0x79, 0x03, 0x15, 0xc9, 0xf2, 0xa4, 0xa5, 0xc1, 0xa7, 0xfb, 0x2d,
+	0x58, 0x32, 0x0d, 0xdc, 0x1f, 0x5a, 0x2e, 0x1e, 0x74, 0xc7, 0x74, 0x51, 0xf2, 0xb4, 0xd3, 0xa2,
+	0xaf, 0xfa, 0x33, 0x3c, 0xae, 0xfe, 0x58, 0x82, 0x0c, 0x23, 0x42, 0xaf, 0x43, 0xc2, 0x34, 0xb8,
+	0xed, 0x9f, 0x9b, 0x12, 0x65, 0x73, 0x5d, 0x4d, 0x98, 0x06, 0x2a, 0x43, 0xb6, 0x8f, 0x1d, 0x47,
+	0xdf, 0x67, 0x4a, 0x22, 0xab, 0xa2, 0x88, 0xee, 0x03, 0x58, 0x43, 0x6c, 0xeb, 0xae, 0x69, 0x0d,
+	0x9c, 0x72, 0x92, 0xae, 0xc8, 0x79, 0x5f, 0x37, 0x5b, 0xa2, 0x51, 0xf5, 0xe1, 0xd0, 0x1a, 0x2c,
+	0x09, 0x8b, 0xe1, 0xb3, 0x2a, 0xa7, 0x28, 0x07, 0x97, 0x23, 0xd4, 0x9b, 0x2f, 0x6a, 0x71, 0x18,
+	0x28, 0x3f, 0x4a, 0xe5, 0xd2, 0xa5, 0x4c, 0xf5, 0xbf, 0x25, 0xc8, 0x09, 0x56, 0xc9, 0x62, 0x74,
+	0x7b, 0x26, 0xd1, 0x47, 0x07, 0x7f, 0x5f, 0xe8, 0x39, 0xab, 0x69, 0xe3, 0xef, 0xa3, 0x1b, 0x00,
+	0x0e, 0xb6, 0x47, 0xd8, 0xa6, 0xcd, 0x64, 0x22, 0xc9, 0xb5, 0xc4, 0x5d, 0x49, 0x95, 0x59, 0x2d,
+	0x81, 0x5c, 0x85, 0x6c, 0x4f, 0xef, 0x0f, 0x2d, 0x9b, 0x29, 0x1e, 0x6b, 0x17, 0x55, 0xe8, 0x32,
+	0xe4, 0xc4, 0x6a, 0x52, 0x7e, 0x17, 0xd5, 0x2c, 0x5f, 0x4c, 0xf4, 0x1a, 0xe4, 0x79, 0x13, 0xb5,
+	0xb1, 0x34, 0x1d, 0x1b, 0x58, 0x2b, 0xa9, 0x41, 0x37, 0xa1, 0x34, 0x19, 0x5c, 0x33, 0x88, 0x6d,
+	0x52, 0x6d, 0x42, 0x6a, 0xd1, 0x1b, 0x9e, 0x39, 0xaf, 0xd7, 0xa1, 0xc0, 0x07, 0xe4, 0xb0, 0x2c,
+	0x85, 0x2d, 0xf2, 0x4a, 0x0a, 0xaa, 0xfe, 0xe7, 0x3b, 0x20, 0x7b, 0xb2, 0x45, 0xef, 0x40, 0xd2,
+	0xc1, 0xc2, 0x83, 0x97, 0xa3, 0xc4, 0x5f, 0x6b, 0x63, 0x77, 0x63, 0x41, 0x25, 0x30, 0x82, 0xd6,
+	0x0d, 0xa3, 0x9c, 0x88, 0x41, 0xd7, 0x0d, 0x83, 0xa0, 0x75, 0xc3, 0x40, 0x77, 0x20, 0x45, 0x54,
+	0xbd, 0x9c, 0x9c, 0x5a, 0xa0, 0x09, 0xfc, 0xb1, 0x35, 0xc2, 0x1b, 0x0b, 0x2a, 0x05, 0xa2, 0xf7,
+	0x20, 0xc3, 0xcc, 0x85, 0xaf, 0xe9, 0x95, 0x48, 0x12, 0x66, 0x40, 0x1b, 0x0b, 0x2a, 0x07, 0x93,
+	0x71, 0xb0, 0x61, 0x0a, 0xf3, 0x8c, 0x1e, 0x47, 0x31, 0x4c, 0x32, 0x0b, 0x0a, 0x24, 0xe3, 0x38,
+	0xb8, 0x87, 0xbb, 0x6e, 0x39, 0x13, 0x33, 0x4e, 0x9b, 0x42, 0xc8, 0x38, 0x0c, 0x4c, 0xf6, 0x06,
+	0xc7, 0x1d, 0xf7, 0x30, 0x15, 0x6b, 0x7e, 0xb5, 0x12, 0x4d, 0x45, 0x10, 0x1b, 0x0b, 0x2a, 0x83,
+	0xa2, 0x8f, 0x20, 0x67, 0x0e, 0xba, 0x36, 0xd6, 0x1d, 0x5c, 0xce, 0x51, 0xb2, 0x6b, 0x91, 0x64,
+	0x4d, 0x0e, 0xda, 0x58, 0x50, 0x3d, 0x02, 0xf4, 0x6b, 0x20, 0xbb, 0x36, 0xc6, 0x1a, 0x9d, 0x9d,
+	0x1c, 0x43, 0xdd, 0xb1, 0x31, 0xe6, 0x33, 0xcc, 0xb9, 0xfc, 0x1b, 0xfd, 0x3a, 0x00, 0xa5, 0x66,
+	0x3c, 0x03, 0x25, 0xbf, 0x3e, 0x93, 0x5c, 0xf0, 0x2d, 0xbb, 0xa2, 0x80, 0x14, 0x58, 0x24, 0x23,
+	0x6b, 0x36, 0x1e, 0x61, 0xdb, 0xc1, 0xd4, 0x23, 0xe4, 0x57, 0x57, 0x66, 0xca, 0x57, 0x65, 0xb8,
+	0x8d, 0x05, 0x35, 0x8f, 0x27, 0x45, 0xf4, 0x19, 0x14, 0x75, 0xc3, 0xd0, 0xf4, 0xc1, 0xc0, 0x72,
+	0x29, 0xb8, 0xbc, 0xb8, 0x22, 0x85, 0xb6, 0xff, 0x80, 0xfe, 0xd4, 0x3d, 0xe4, 0xc6, 0x82, 0x5a,
+	0xd0, 0xfd, 0x15, 0xa8, 0x03, 0xcb, 0x6c, 0xd5, 0xfd, 0xfd, 0x15, 0x68, 0x7f, 0x6f, 0xc6, 0x68,
+	0x4b, 0xa0, 0xcb, 0x92, 0x1d, 0xaa, 0x43, 0x0f, 0x20, 0xeb, 0x60, 0x57, 0x23, 0xba, 0x5d, 0x8c,
+	0xd5, 0x08, 0x97, 0xa9, 0x77, 0xc6, 0xa1, 0x5f, 0x44, 0xc4, 0x84, 0x8e, 0x2b, 0xed, 0x52, 0x8c,
+	0x88, 0xdb, 0xd8, 0xf5, 0xf4, 0x56, 0x76, 0x44, 0xa1, 0xf2, 0x77, 0x12, 0x24, 0xdb, 0xd8, 0x25,
+	0xdb, 0xcd, 0x50, 0xb7, 0x89, 0xff, 0x21, 0x4b, 0xef, 0x62, 0x43, 0xd3, 0x85, 0x51, 0xce, 0xda,
+	0x6e, 0x18, 0xbe, 0xc1, 0xe0, 0x75, 0x57, 0x04, 0x00, 0x89, 0x49, 0x00, 0xb0, 0x2a, 0x02, 0x00,
+	0x66, 0x80, 0x57, 0xa3, 0x23, 0x8a, 0xb6, 0xd9, 0x1f, 0xf6, 0x44, 0x24, 0x80, 0x1e, 0x40, 0x1e,
+	0xbf, 0xc0, 0xdd, 0x23, 0xce, 0x42, 0x2a, 0x8e, 0x05, 0x10, 0xc8, 0xba, 0x5b, 0xf9, 0x2f, 0x09,
+	0x92, 0x44, 0x22, 0x67, 0x30, 0x91, 0x8f, 0xa9, 0x8b, 0x1f, 0xf9, 0x3b, 0x48, 0xc4, 0x75, 0x50,
+	0x20, 0xe8, 0x09, 0xf9, 0xd7, 0x39, 0xeb, 0xff, 0x91, 0x20, 0x45, 0x3c, 0xd8, 0x2b, 0x30, 0xed,
+	0xfb, 0x00, 0x3e, 0xca, 0x64, 0x1c, 0xa5, 0xdc, 0xf5, 0xa8, 0x4e, 0x3b, 0xf1, 0x1f, 0x49, 0x90,
+	0x61, 0x2a, 0x7c, 0x16, 0x53, 0x0f, 0xf2, 0x9e, 0x38, 0x1d, 0xef, 0xc9, 0x79, 0x79, 0xff, 0xdb,
+	0x14, 0xa4, 0xa8, 0x83, 0x3c, 0x03, 0xce, 0x6f, 0x41, 0x6a, 0xcf, 0xb6, 0xfa, 0xe5, 0xc4, 0x54,
+	0xcc, 0xde, 0xc1, 0x2f, 0x5c, 0x12, 0x01, 0x6f, 0x5b, 0x8e, 0x4a, 0x31, 0xe8, 0x2d, 0x48, 0xb8,
+	0x56, 0x39, 0x19, 0x8b, 0x4c, 0xb8, 0x16, 0x3a, 0x80, 0x4b, 0x13, 0x7e, 0xb4, 0xbe, 0x3e, 0xd4,
+	0x76, 0xc7, 0x1a, 0x8d, 0x07, 0x78, 0xdc, 0xba, 0x3a, 0xd3, 0x03, 0xd7, 0x3c, 0xce, 0x1e, 0xeb,
+	0xc3, 0xb5, 0x71, 0x9d, 0x10, 0xb1, 0x73, 0xc6, 0xb9, 0xee, 0x74, 0x0b, 0x09, 0xce, 0xba, 0xd6,
+	0xc0, 0xc5, 0x03, 0xb6, 0x77, 0xca, 0xaa, 0x28, 0x86, 0x65, 0x9b, 0x99, 0x53, 0xb6, 0xa8, 0x09,
+	0xa0, 0xbb, 0xae, 0x6d, 0xee, 0x1e, 0xb9, 0xd8, 0x29, 0x67, 0x29, 0xbb, 0x6f, 0xcf, 0x66, 0xb7,
+	0xee, 0x61, 0x19, 0x97, 0x3e, 0xe2, 0xca, 0x6f, 0x43, 0x79, 0xd6, 0x6c, 0x22, 0x0e, 0x3b, 0xb7,
+	0x83, 0x87, 0x9d, 0x19, 0xac, 0x4e, 0x8e, 0x3b, 0x95, 0x8f, 0x61, 0x29, 0x34, 0x7a, 0x44, 0xaf,
+	0xe7, 0xfd, 0xbd, 0xca, 0x7e, 0xf2, 0x7f, 0x95, 0x20, 0xc3, 0x02, 0x84, 0x57, 0x55, 0x8d, 0x4e,
+	0x6b, 0xda, 0x7f, 0x9e, 0x82, 0x34, 0xdb, 0xff, 0x5f, 0xd1, 0x89, 0x3d, 0x0a, 0xe8, 0x18, 0x33,
+	0x89, 0x5b, 0xb3, 0x63, 0xb1, 0x38, 0x25, 0x0b, 0x0b, 0x29, 0x3d, 0xaf, 0x9e, 0x9b, 0xb3, 0x6d,
+	0x34, 0x43, 0x19, 0xfa, 0x76, 0x0c, 0x43, 0x27, 0x32, 0xd2, 0xaf, 0xaa, 0xa8, 0x2f, 0xd9, 0x8c,
+	0x7e, 0x24, 0x41, 0x4e, 0xc4, 0xae, 0x67, 0xa1, 0x30, 0xab, 0x41, 0x06, 0x4e, 0xb3, 0x7b, 0xcf,
+	0xbd, 0x11, 0xfc, 0x24, 0x09, 0x39, 0x11, 0x39, 0x9f, 0x05, 0xef, 0x6f, 0x05, 0x94, 0xdd, 0x9f,
+	0x0d, 0x21, 0xa3, 0x4c, 0x14, 0xbd, 0xea, 0x53, 0xf4, 0x28, 0x14, 0x51, 0xf2, 0xde, 0x71, 0x9b,
+	0xc0, 0x83, 0xd8, 0x83, 0xc0, 0x09, 0x37, 0x82, 0xbb, 0x90, 0xe3, 0x9e, 0xdf, 0x29, 0xa7, 0xa7,
+	0x4e, 0xe2, 0xa4, 0x53, 0x62, 0x80, 0x8e, 0xea, 0xa1, 0x4e, 0xbb, 0x41, 0xbc, 0x6c, 0x75, 0xfc,
+	0xf7, 0x04, 0xc8, 0xde, 0x69, 0xe6, 0x55, 0x5b, 0xd3, 0x56, 0x84, 0xe3, 0xaa, 0xc5, 0x1f, 0xc8,
+	0x5e, 0x86, 0xf3, 0xfa, 0xaa, 0x1e, 0xe5, 0x2f, 0x53, 0x90, 0xf7, 0x1d, 0xf7, 0xce, 0x42, 0xca,
+	0x97, 0x21, 0x47, 0xa4, 0xa8, 0x99, 0xc6, 0x0b, 0x3a, 0x5e, 0x5a, 0xcd, 0x92, 0x72, 0xd3, 0x78,
+	0x81, 0x2e, 0x40, 0xc6, 0xb5, 0x68, 0x43, 0x92, 0x36, 0xa4, 0x5d, 0x8b, 0x54, 0x5b, 0xc7, 0xd9,
+	0xc7, 0x07, 0xc7, 0x1d, 0x53, 0x7f, 0xe9, 0xb1, 0xd2, 0x76, 0x44, 0xac, 0x74, 0xf7, 0x58, 0xae,
+	0xbf, 0xb9, 0x21, 0xd3, 0x0f, 0x12, 0x50, 0x08, 0x9c, 0xee, 0xcf, 0x42, 0x73, 0x10, 0xa4, 0x06,
+	0x7a, 0x5f, 0x8c, 0x46, 0xbf, 0xbd, 0xa0, 0x23, 0x39, 0x77, 0xd0, 0x91, 0x3a, 0x36, 0xe8, 0xf0,
+	0xa6, 0x95, 0xf6, 0x4d, 0xeb, 0xd4, 0x5e, 0xf0, 0x4f, 0x24, 0x28, 0x85, 0x13, 0x13, 0x2f, 0x4b,
+	0x1a, 0xa7, 0xdd, 0x1d, 0xff, 0x9a, 0x46, 0xb8, 0xee, 0x19, 0x1d, 0xea, 0xbf, 0xce, 0x7d, 0xfd,
+	0x07, 0x49, 0x90, 0xbd, 0x7c, 0xcb, 0x2f, 0x8b, 0xf9, 0xfe, 0x6c, 0x07, 0xc5, 0x72, 0xdd, 0xef,
+	0xc7, 0xe7, 0x89, 0x4e, 0xe8, 0x9e, 0x4e, 0x1b, 0xed, 0xbf, 0x5c, 0x97, 0xb1, 0x96, 0x81, 0xd4,
+	0xae, 0x65, 0x8c, 0xab, 0x7f, 0x9a, 0x80, 0xe5, 0x29, 0x51, 0x85, 0xce, 0xfd, 0xd2, 0x9c, 0xe7,
+	0xfe, 0xbb, 0x90, 0xa3, 0x7f, 0x50, 0x8e, 0xcd, 0x15, 0x64, 0x29, 0x8c, 0xe5, 0x17, 0x6c, 0xec,
+	0xd1, 0xc4, 0xe7, 0x46, 0x38, 0xb0, 0xee, 0xa2, 0x9b, 0x90, 0x72, 0xc7, 0x43, 0x96, 0x8b, 0x2e,
+	0x06, 0x02, 0xa2, 0x27, 0x64, 0x7e, 0x9d, 0xf1, 0x10, 0xab, 0x14, 0x11, 0x74, 0x0e, 0x8b, 0x42,
+	0x03, 0xee, 0x41, 0x66, 0x68, 0xf5, 0xcc, 0xee, 0x98, 0xfa, 0x85, 0x62, 0x20, 0x31, 0xdd, 0xb0,
+	0x06, 0x7b, 0x3d, 0xb3, 0xeb, 0x6e, 0x53, 0x80, 0xca, 0x81, 0xd5, 0x7f, 0x2a, 0x41, 0xde, 0x27,
+	0x26, 0xb4, 0x0e, 0xf9, 0xcf, 0x1d, 0x6b, 0xa0, 0x59, 0xbb, 0x9f, 0xe3, 0xae, 0x90, 0xd0, 0x8d,
+	0x68, 0xf5, 0xa3, 0xdf, 0x5b, 0x14, 0xb8, 0xb1, 0xa0, 0x02, 0xa1, 0x63, 0x25, 0x54, 0x07, 0x5a,
+	0xd2, 0x74, 0xdb, 0xd6, 0xc7, 0xe5, 0xc4, 0x54, 0x16, 0x37, 0xdc, 0x49, 0x9d, 0xe0, 0x48, 0x9e,
+	0x92, 0x50, 0xd1, 0x02, 0xfb, 0x7b, 0x6b, 0xf6, 0x4d, 0xd7, 0xf4, 0xf2, 0xf9, 0xb3, 0x7a, 0xd8,
+	0x16, 0x38, 0xd2, 0x83, 0x47, 0x84, 0xee, 0x41, 0xca, 0xc5, 0x2f, 0x44, 0x94, 0x72, 0x65, 0x06,
+	0x31, 0x71, 0xbb, 0x24, 0x4d, 0x4f, 0xa0, 0xe8, 0x43, 0xb2, 0xe5, 0x1e, 0x0d, 0x5c, 0x6c, 0x97,
+	0x33, 0x53, 0xa9, 0x55, 0x3f, 0x55, 0x83, 0xa1, 0x36, 0x16, 0x54, 0x41, 0x40, 0x87, 0xb3, 0xb1,
+	0x48, 0xd5, 0xcf, 0x1c, 0xce, 0xc6, 0xf4, 0xef, 0x03, 0x81, 0xa2, 0x1a, 0xfb, 0x15, 0x92, 0x9b,
+	0x4a, 0xee, 0xfb, 0x29, 0x26, 0x3f, 0x43, 0x2a, 0x7f, 0x95, 0x00, 0x98, 0xc8, 0x1c, 0xdd, 0x0c,
+	0xfe, 0x39, 0x8e, 0xfa, 0x19, 0xca, 0x00, 0xa7, 0x4c, 0x77, 0xf9, 0xd5, 0x3e, 0x79, 0x0a, 0xb5,
+	0x4f, 0xcd, 0xa9, 0xf6, 0x13, 0xb5, 0x4d, 0xcf, 0xa9, 0xb6, 0xe8, 0x5d, 0xc8, 0xf4, 0xb1, 0xbd,
+	0x4f, 0xff, 0x72, 0x26, 0x67, 0x0f, 0xc2, 0x41, 0x95, 0x9f, 0x4a, 0x20, 0x7b, 0x7a, 0x16, 0x2b,
+	0xb7, 0x87, 0xf5, 0x6f, 0x8c, 0xdc, 0x2a, 0x3f, 0x97, 0x40, 0xf6, 0x74, 0xdf, 0x73, 0x1e, 0xd2,
+	0xfc, 0xce, 0x23, 0xe1, 0x77, 0x1e, 0xa7, 0x4b, 0xe7, 0xfa, 0xe7, 0x9a, 0x3a, 0xc5, 0x5c, 0xd3,
+	0x73, 0xce, 0xf5, 0x0f, 0x12, 0x90, 0x22, 0xa6, 0x4a, 0xee, 0x18, 0xf8, 0x17, 0xef, 0x5c, 0x44,
+	0x04, 0xf5, 0xcd, 0xd0, 0xfa, 0x8f, 0x20, 0x3f, 0xf9, 0xa1, 0x24, 0x0e, 0xc1, 0x97, 0x43, 0xd3,
+	0x99, 0x04, 0x6b, 0xaa, 0x1f, 0x5d, 0xf9, 0x0f, 0x09, 0xb2, 0xdc, 0x07, 0xfd, 0x8a, 0x2f, 0xfc,
+	0x3f, 0x4b, 0x90, 0x22, 0x4e, 0x33, 0x76, 0xe1, 0x79, 0xba, 0xe0, 0x9b, 0x61, 0xb6, 0x3f, 0xe5,
+	0x7f, 0xe0, 0x6a, 0xe4, 0xa2, 0x42, 0x7f, 0x17, 0xdb, 0x62, 0x4a, 0xfe, 0xa5, 0x6b, 0x63, 0xf7,
+	0x31, 0x6d, 0x54, 0x05, 0xe8, 0xd5, 0x9e, 0x95, 0x17, 0x77, 0x8d, 0x40, 0xf6, 0x78, 0xff, 0xca,
+	0xaa, 0xf9, 0x36, 0xa4, 0x5c, 0x7d, 0x5f, 0xdc, 0xd5, 0x98, 0xc1, 0x04, 0x85, 0x54, 0x1f, 0x43,
+	0x96, 0x6f, 0x7a, 0x11, 0x51, 0xe4, 0x5d, 0xc8, 0x62, 0xb6, 0x9d, 0x46, 0xe4, 0x85, 0xfd, 0x77,
+	0x9d, 0x04, 0xac, 0xfa, 0x2f, 0x12, 0x64, 0xf9, 0x66, 0x40, 0xef, 0x1c, 0x91, 0x40, 0x42, 0x9a,
+	0xbe, 0x73, 0xc4, 0xb7, 0x0b, 0xda, 0x7e, 0xf2, 0x51, 0xd0, 0x87, 0x50, 0x18, 0x5a, 0x8e, 0x49,
+	0x6c, 0x7a, 0x8e, 0x15, 0x5a, 0x9c, 0x60, 0xd9, 0x32, 0x8d, 0xf4, 0xae, 0x3e, 0x4f, 0xf8, 0x2d,
+	0x73, 0x60, 0xdd, 0xad, 0x3e, 0x81, 0x1c, 0xe1, 0x98, 0x9c, 0xaa, 0x27, 0x32, 0x97, 0xfc, 0x27,
+	0xcc, 0xfb, 0x00, 0x47, 0x43, 0x63, 0x3e, 0x35, 0xe3, 0xc0, 0xba, 0x5b, 0xfd, 0xc7, 0x04, 0xe4,
+	0x84, 0xff, 0x45, 0x6f, 0xfa, 0xee, 0xe9, 0x5c, 0x88, 0x70, 0xd0, 0xfc, 0xa6, 0x4e, 0xe4, 0xc1,
+	0xfd, 0x94, 0xa1, 0xf3, 0x7b, 0x90, 0x37, 0x07, 0x8e, 0x46, 0xff, 0x67, 0xf2, 0x1b, 0x2f, 0x33,
+	0xc7, 0x96, 0xcd, 0x81, 0xb3, 0x6d, 0xe3, 0x51, 0xd3, 0x40, 0x8d, 0x40, 0x46, 0x84, 0xf9, 0xe0,
+	0xd7, 0x23, 0xa8, 0x62, 0x93, 0x20, 0xea, 0x3c, 0x59, 0x8a, 0x98, 0xbb, 0x71, 0x62, 0x41, 0x82,
+	0x77, 0xe3, 0x60, 0xc2, 0xf1, 0x29, 0x8f, 0x2d, 0x17, 0x21, 0x63, 0xed, 0xed, 0x91, 0x08, 0x93,
+	0x65, 0xb8, 0x78, 0xa9, 0xfa, 0x33, 0x09, 0x8a, 0xc1, 0xcd, 0xc5, 0x3b, 0xc6, 0x4b, 0x11, 0x49,
+	0x8d, 0xb3, 0xfc, 0x93, 0xe2, 0x2d, 0x79, 0x6a, 0xb6, 0xca, 0xa5, 0xe7, 0x53, 0xb9, 0x63, 0x6e,
+	0xbb, 0x55, 0xff, 0x82, 0xe7, 0xda, 0xe3, 0x35, 0x92, 0x03, 0xb8, 0x46, 0x22, 0xee, 0xaf, 0x78,
+	0x36, 0x23, 0xe8, 0x99, 0x92, 0xb3, 0xb5, 0x34, 0x75, 0x3a, 0x2d, 0x4d, 0xc7, 0xf1, 0xe3, 0xd3,
+	0x52, 0x4e, 0x46, 0x9c, 0x8c, 0x66, 0xb2, 0xa9, 0xc6, 0x92, 0xb5, 0xf0, 0x0b, 0xb7, 0x49, 0xed,
+	0xcb, 0xc0, 0x43, 0xf7, 0x80, 0x1e, 0x49, 0xd2, 0x2a, 0x2b, 0x84, 0x54, 0x3e, 0x37, 0xad, 0xf2,
+	0xbc, 0xaf, 0xaf, 0x5d, 0xe5, 0x3f, 0x64, 0x89, 0xf4, 0x16, 0xdd, 0xc2, 0xdf, 0x9d, 0x24, 0x3f,
+	0x63, 0xf6, 0x7b, 0x81, 0xa1, 0xe6, 0xe2, 0xc9, 0xe0, 0x8c, 0xcd, 0xe5, 0x77, 0x20, 0xcb, 0x73,
+	0xea, 0x68, 0x15, 0x64, 0x9e, 0xd9, 0x39, 0x4e, 0x9b, 0x72, 0x0c, 0xd7, 0x34, 0xc8, 0x2d, 0x8b,
+	0x1e, 0xde, 0x73, 0x35, 0xc7, 0xdc, 0xed, 0x99, 0x83, 0x7d, 0x42, 0x99, 0x88, 0xa3, 0x2c, 0x10,
+	0x74, 0x9b, 0x81, 0x9b, 0x46, 0xb5, 0x0f, 0xa9, 0x1d, 0x07, 0xdb, 0xa8, 0xe8, 0x69, 0xb0, 0x4c,
+	0x55, 0xb5, 0x02, 0xb9, 0x23, 0x07, 0xdb, 0xbe, 0xe4, 0x9b, 0x57, 0x46, 0x1f, 0x44, 0x44, 0x74,
+	0x95, 0x1a, 0xbb, 0x67, 0x5d, 0x13, 0xf7, 0xac, 0x6b, 0x1d, 0x71, 0x11, 0xdb, 0x27, 0x84, 0xea,
+	0x0f, 0xb3, 0x90, 0xdd, 0xb6, 0x2d, 0x7a, 0xbe, 0x0c, 0x0f, 0x19, 0x95, 0xeb, 0xbb, 0x06, 0x30,
+	0x3c, 0xda, 0xed, 0x99, 0x5d, 0x7a, 0x83, 0x93, 0x99, 0x88, 0xcc, 0x6a, 0xc8, 0xa5, 0xda, 0x6b,
+	0x00, 0x0e, 0xee, 0xda, 0x98, 0xdd, 0xba, 0x65, 0x46, 0x2f, 0xb3, 0x1a, 0xd2, 0x7c, 0x13, 0x4a,
+	0xfa, 0x91, 0x7b, 0xa0, 0x3d, 0xc7, 0xbb, 0x07, 0x96, 0x75, 0xa8, 0x1d, 0xd9, 0x3d, 0x9e, 0xee,
+	0x2c, 0x92, 0xfa, 0xa7, 0xac, 0x7a, 0xc7, 0xee, 0xa1, 0xbb, 0x70, 0x3e, 0x80, 0xec, 0x63, 0xf7,
+	0xc0, 0x32, 0x1c, 0x7a, 0xfc, 0x93, 0x55, 0xe4, 0x43, 0x3f, 0x66, 0x2d, 0xe8, 0x13, 0xb8, 0xc2,
+	0x2f, 0x58, 0x1a, 0x58, 0xef, 0xba, 0xe6, 0x48, 0x77, 0xb1, 0xe6, 0x1e, 0xd8, 0xd8, 0x39, 0xb0,
+	0x7a, 0x06, 0xb5, 0x09, 0x59, 0xbd, 0xcc, 0x20, 0xeb, 0x1e, 0xa2, 0x23, 0x00, 0x21, 0x21, 0xe6,
+	0x4e, 0x20, 0x44, 0x42, 0xea, 0xf3, 0x67, 0xf2, 0xf1, 0xa4, 0x13, 0xa7, 0xb6, 0x02, 0x8b, 0x74,
+	0x9e, 0x9f, 0x3f, 0x67, 0x22, 0x03, 0xca, 0x26, 0x90, 0xba, 0x47, 0xcf, 0xa9, 0xcc, 0xaa, 0x50,
+	0xe0, 0x88, 0x43, 0x87, 0x0a, 0x8c, 0x5d, 0x9b, 0xcd, 0x33, 0xc8, 0xa1, 0x43, 0xa4, 0xf5, 0x00,
+	0x2e, 0x39, 0x78, 0xe0, 0xd0, 0x83, 0xa1, 0xe6, 0xdd, 0x5e, 0x3d, 0xc4, 0x63, 0xa7, 0xbc, 0x48,
+	0x05, 0x76, 0xc1, 0x6b, 0x16, 0x37, 0x57, 0x3f, 0xc3, 0x63, 0x72, 0x21, 0x7c, 0x19, 0x8f, 0x88,
+	0xc8, 0xfc, 0x0b, 0x52, 0xa0, 0xfd, 0x2f, 0xd1, 0x86, 0xe0, 0x8a, 0x04, 0xb1, 0xb4, 0xe4, 0x94,
+	0x8b, 0x6c, 0x45, 0xfc, 0x70, 0x85, 0xb6, 0xa0, 0xf7, 0xa1, 0xec, 0x5d, 0xc2, 0x76, 0xcc, 0x2f,
+	0xb0, 0xe6, 0x58, 0x7b, 0xae, 0xd6, 0x23, 0x07, 0x58, 0x7a, 0x93, 0x2d, 0xa9, 0x5e, 0x10, 0xed,
+	0x6d, 0xf3, 0x0b, 0xdc, 0xb6, 0xf6, 0xdc, 0x4d, 0xd2, 0x38, 0x4d, 0x78, 0xa0, 0xdb, 0x06, 0x27,
+	0x2c, 0x4d, 0x13, 0x6e, 0xe8, 0xb6, 0xc1, 0x08, 0xef, 0xc1, 0x05, 0x76, 0x65, 0x57, 0xeb, 0x59,
+	0xfb, 0xfe, 0xe1, 0x96, 0x29, 0x15, 0x62, 0x8d, 0x9b, 0xd6, 0xfe, 0x64, 0xac, 0x20, 0x89, 0x6f,
+	0x20, 0x14, 0x22, 0x99, 0x8c, 0xf2, 0x2e, 0x20, 0x71, 0xe5, 0xdb, 0xa7, 0x60, 0xe7, 0x28, 0x7e,
+	0x59, 0xb4, 0x4c, 0x14, 0xeb, 0x36, 0x78, 0x95, 0x9a, 0x39, 0x70, 0xb1, 0x3d, 0xd2, 0x7b, 0xe5,
+	0xf3, 0x14, 0x5d, 0x12, 0x0d, 0x4d, 0x5e, 0x5f, 0xfd, 0x05, 0xc0, 0xc5, 0x1d, 0xa2, 0x1d, 0xfa,
+	0x6e, 0x0f, 0x73, 0xc3, 0xfc, 0xd4, 0xc4, 0x3d, 0xc3, 0x41, 0x77, 0x7d, 0x7b, 0x36, 0x49, 0x11,
+	0x87, 0xf5, 0xab, 0xed, 0xda, 0xe6, 0x60, 0x9f, 0x06, 0xda, 0xdc, 0x58, 0x3f, 0x8d, 0x30, 0xb7,
+	0xc4, 0x1c, 0xd4, 0x61, 0x63, 0xdc, 0x9b, 0x61, 0x8c, 0xcc, 0xd3, 0xdc, 0xf7, 0xf9, 0xb5, 0x68,
+	0xd6, 0x6b, 0xf5, 0x29, 0x73, 0x8d, 0x34, 0xe1, 0xdf, 0x8a, 0x37, 0xe1, 0xd4, 0x1c, 0xac, 0xc7,
+	0x18, 0xf8, 0x27, 0x21, 0x53, 0x4b, 0xcf, 0xd1, 0x9d, 0xdf, 0x10, 0xbf, 0x1b, 0x36, 0xc4, 0xcc,
+	0x1c, 0x1d, 0x04, 0xcc, 0xd4, 0x9a, 0x6d, 0xa6, 0x2c, 0x8b, 0xf8, 0xfe, 0xf1, 0xa2, 0x6c, 0x47,
+	0x19, 0xf2, 0x2c, 0xfb, 0xde, 0x88, 0xb2, 0xef, 0xdc, 0x1c, 0x6c, 0x4f, 0x59, 0xff, 0xde, 0x0c,
+	0xeb, 0x97, 0xe7, 0x55, 0x01, 0x65, 0xca, 0x3f, 0x44, 0xfa, 0x8c, 0x4e, 0x8c, 0xcf, 0x00, 0x9e,
+	0x69, 0x0d, 0x33, 0xde, 0x1c, 0xb8, 0x0f, 0xee, 0x33, 0xbe, 0x67, 0x38, 0x94, 0x4e, 0x8c, 0x43,
+	0xc9, 0x9f, 0xb0, 0xd7, 0x89, 0x1f, 0x68, 0xcd, 0xf2, 0x36, 0x8b, 0xc7, 0x77, 0x19, 0xe5, 0x8a,
+	0x5a, 0xb3, 0x5c, 0x51, 0xe1, 0x24, 0xfd, 0x4d, 0xf8, 0x7b, 0x14, 0xe9, 0xa7, 0x8a, 0xc7, 0x77,
+	0x16, 0xe1, 0xc4, 0x36, 0xa2, 0x9c, 0xd8, 0xd2, 0xf1, 0x5d, 0x4d, 0x79, 0xb8, 0x4a, 0x0d, 0xd0,
+	0xb4, 0x3b, 0x60, 0xaf, 0x38, 0xe8, 0x27, 0x8d, 0xff, 0x64, 0x55, 0x14, 0x2b, 0xb7, 0xe1, 0x42,
+	0xa4, 0xce, 0x93, 0xf0, 0x84, 0x9a, 0x0e, 0xc3, 0xd3, 0xef, 0xca, 0x3b, 0x80, 0xa6, 0x15, 0x8d,
+	0x44, 0x7a, 0x5c, 0x5d, 0x19, 0x96, 0x97, 0xaa, 0xff, 0x97, 0x80, 0xa5, 0x75, 0xb1, 0xb4, 0x47,
+	0xfd, 0xbe, 0x6e, 0x8f, 0xa7, 0x82, 0xa0, 0xe9, 0x4b, 0xcf, 0xe1, 0x17, 0x40, 0xb2, 0xef, 0x05,
+	0x50, 0x30, 0x88, 0x48, 0x9d, 0x24, 0x88, 0x20, 0xf9, 0xc1, 0x6e, 0x97, 0xbd, 0xa6, 0xf1, 0x4e,
+	0x45, 0x71, 0xb4, 0x20, 0xe0, 0x53, 0x11, 0x48, 0xe6, 0x24, 0x11, 0xc8, 0x27, 0x90, 0xe9, 0xe9,
+	0xbb, 0xb8, 0x27, 0x2e, 0x08, 0xbc, 0xe5, 0xb3, 0xe5, 0x90, 0x70, 0x6a, 0x9b, 0x14, 0xc8, 0x8e,
+	0x07, 0x9c, 0xaa, 0xf2, 0x01, 0xe4, 0x7d, 0xd5, 0x27, 0xf9, 0x5f, 0x5f, 0xfd, 0x1b, 0x09, 0x4a,
+	0x62, 0x88, 0x0e, 0xee, 0x0f, 0x7b, 0xba, 0x8b, 0xd1, 0x75, 0x80, 0xae, 0xd5, 0xeb, 0xe1, 0x2e,
+	0xbd, 0x78, 0xcf, 0xfa, 0xf1, 0xd5, 0x90, 0x65, 0xa7, 0x8f, 0xd8, 0x78, 0x54, 0x4a, 0xbe, 0xbf,
+	0x42, 0x00, 0x1c, 0x92, 0x5c, 0xea, 0x04, 0x92, 0xab, 0x7e, 0x01, 0x79, 0xc1, 0x7d, 0xbd, 0xb1,
+	0x49, 0x54, 0xd8, 0xc6, 0xba, 0x21, 0xf2, 0x7b, 0xb2, 0x2a, 0x8a, 0xa4, 0xe5, 0xb9, 0x6d, 0xba,
+	0xd8, 0x66, 0x8f, 0xf7, 0x64, 0x55, 0x14, 0x89, 0x66, 0xea, 0x46, 0xdf, 0xe4, 0xcf, 0x93, 0x64,
+	0x95, 0x97, 0xc8, 0x93, 0x1d, 0x1e, 0x66, 0x93, 0x3e, 0x28, 0x5b, 0x39, 0x95, 0x47, 0xde, 0x2a,
+	0xd6, 0x8d, 0xea, 0x0f, 0x13, 0x50, 0x14, 0x83, 0x3f, 0xc6, 0x7d, 0x6b, 0x2e, 0xcd, 0x7d, 0x03,
+	0x0a, 0xce, 0xd1, 0xae, 0xd3, 0xb5, 0xcd, 0xa1, 0x78, 0x13, 0x45, 0x0e, 0x3e, 0xc1, 0x4a, 0x74,
+	0x0f, 0x90, 0xbf, 0x42, 0xdb, 0x1d, 0xb3, 0xcb, 0x44, 0xe2, 0xc9, 0xd1, 0xb2, 0xbf, 0x75, 0x8d,
+	0x34, 0x92, 0x25, 0xee, 0x59, 0xdd, 0x43, 0x87, 0x6a, 0x6d, 0x5a, 0x65, 0x05, 0xf2, 0xa6, 0x89,
+	0x7c, 0xf0, 0x0e, 0x32, 0x5e, 0x07, 0x32, 0xa9, 0x65, 0x84, 0x57, 0x41, 0x16, 0xb6, 0xe3, 0xf0,
+	0x63, 0xeb, 0xa4, 0x02, 0xbd, 0x0d, 0x45, 0x51, 0xe0, 0x9d, 0xe4, 0xbc, 0x4e, 0x0a, 0xa2, 0x85,
+	0x76, 0x54, 0xfd, 0x5f, 0x09, 0x0a, 0x8d, 0x9e, 0x39, 0xd1, 0xd5, 0x39, 0xc4, 0x71, 0x11, 0x32,
+	0x8e, 0xab, 0xbb, 0x47, 0x0e, 0x37, 0x63, 0x5e, 0xa2, 0xda, 0x64, 0x0d, 0x06, 0x5c, 0x03, 0xa7,
+	0x1f, 0x7f, 0x35, 0xbc, 0xc6, 0xe6, 0x60, 0xcf, 0x52, 0x7d, 0xe0, 0x90, 0x22, 0xa6, 0x4f, 0xaf,
+	0x88, 0x27, 0x31, 0xe1, 0xea, 0x53, 0x28, 0x06, 0x79, 0xa2, 0x93, 0x1f, 0x7a, 0x93, 0x1f, 0x92,
+	0x73, 0x19, 0x39, 0x2d, 0x6a, 0xfa, 0xbe, 0xc8, 0x56, 0xca, 0xaa, 0x4c, 0x6a, 0xea, 0xa4, 0x82,
+	0x4a, 0x82, 0x3e, 0xe8, 0xf5, 0x24, 0x41, 0x4b, 0xd5, 0x5f, 0x48, 0x93, 0x57, 0xa0, 0xfc, 0x4d,
+	0xde, 0x77, 0x02, 0x29, 0xde, 0x37, 0x66, 0xbe, 0x89, 0xe3, 0x8f, 0xf4, 0x7c, 0x29, 0xdf, 0x3b,
+	0x90, 0x13, 0x31, 0x4f, 0xdc, 0x83, 0x51, 0x0f, 0x54, 0xed, 0x03, 0x4c, 0x3a, 0x41, 0x57, 0xe0,
+	0x52, 0x63, 0xa3, 0xde, 0x7a, 0xa8, 0x68, 0x9d, 0x67, 0xdb, 0x8a, 0xb6, 0xd3, 0x6a, 0x6f, 0x2b,
+	0x8d, 0xe6, 0xa7, 0x4d, 0x65, 0xbd, 0xb4, 0x80, 0xce, 0xc1, 0x92, 0xbf, 0x71, 0x7b, 0xa7, 0x53,
+	0x92, 0xd0, 0x45, 0x40, 0xfe, 0xca, 0x75, 0x65, 0x53, 0xe9, 0x28, 0xa5, 0x04, 0xba, 0x00, 0xcb,
+	0xfe, 0xfa, 0xc6, 0xa6, 0x52, 0x57, 0x4b, 0xc9, 0xea, 0x08, 0x72, 0x82, 0x09, 0xf2, 0x73, 0x97,
+	0x44, 0x31, 0x3c, 0x17, 0x71, 0x2d, 0x82, 0xcf, 0xda, 0xba, 0xee, 0xea, 0xcc, 0x13, 0x52, 0x68,
+	0xe5, 0x7d, 0x90, 0xbd, 0xaa, 0x13, 0x79, 0xc1, 0x16, 0x99, 0xa6, 0xf7, 0xbe, 0x34, 0xf8, 0x10,
+	0x50, 0x8a, 0x7a, 0x08, 0x18, 0x7c, 0x4a, 0x98, 0x08, 0x3d, 0x25, 0xac, 0xfe, 0x9e, 0x04, 0x79,
+	0x5f, 0x1e, 0xee, 0x6c, 0xb3, 0x23, 0xe4, 0x1d, 0xa7, 0x8d, 0x7b, 0x3a, 0x0d, 0x61, 0x39, 0x80,
+	0x79, 0x91, 0xa2, 0xa8, 0xde, 0x62, 0x69, 0x94, 0x3f, 0x93, 0x00, 0x26, 0x5d, 0xfb, 0x5f, 0x2f,
+	0x4a, 0xd3, 0xaf, 0x17, 0xaf, 0x82, 0x6c, 0x60, 0x1a, 0xec, 0x60, 0x5b, 0xcc, 0xc8, 0xab, 0x08,
+	0xbc, 0x6d, 0x4c, 0xc6, 0xbe, 0x6d, 0x4c, 0x4d, 0xbd, 0x6d, 0x9c, 0x7a, 0xb1, 0x98, 0x8e, 0x78,
+	0xb1, 0xf8, 0x73, 0x09, 0x72, 0xeb, 0x56, 0x97, 0x86, 0x0b, 0xe8, 0x76, 0x40, 0xc3, 0x2f, 0x05,
+	0xb7, 0x43, 0x0a, 0xf1, 0x29, 0xf5, 0x55, 0x60, 0xd9, 0x0f, 0xe7, 0x80, 0x33, 0x2e, 0xab, 0x93,
+	0x0a, 0xf4, 0xb1, 0x4f, 0xe5, 0xd9, 0x3f, 0x8d, 0x1b, 0x11, 0xdd, 0x79, 0x3a, 0xc5, 0xd4, 0xc9,
+	0x23, 0x21, 0x6b, 0x60, 0x63, 0xdd, 0xe1, 0x4e, 0x48, 0x56, 0x79, 0xa9, 0xf2, 0x11, 0x14, 0x02,
+	0x24, 0x27, 0x52, 0xb7, 0x3f, 0x96, 0x26, 0x3b, 0x87, 0xf2, 0x82, 0x4a, 0x7f, 0x8e, 0xd7, 0xd2,
+	0x73, 0xbc, 0x4f, 0x3d, 0xab, 0x97, 0xd1, 0xb7, 0x7e, 0x37, 0x09, 0xb2, 0xf7, 0xbf, 0x88, 0x98,
+	0xf6, 0x93, 0xfa, 0xe6, 0x0e, 0x37, 0xd6, 0xd6, 0xce, 0xe6, 0x66, 0x69, 0x81, 0x98, 0xb6, 0xaf,
+	0x72, 0x6d, 0x6b, 0x6b, 0x53, 0xa9, 0xb7, 0x4a, 0x52, 0xa8, 0xbe, 0xd9, 0xea, 0x28, 0x0f, 0x15,
+	0xb5, 0x94, 0x08, 0x75, 0xb2, 0xb9, 0xd5, 0x7a, 0x58, 0x4a, 0x12, 0x3f, 0xe0, 0xab, 0x5c, 0xdf,
+	0xda, 0x59, 0xdb, 0x54, 0x4a, 0xa9, 0x50, 0x75, 0xbb, 0xa3, 0x36, 0x5b, 0x0f, 0x4b, 0x69, 0x74,
+	0x1e, 0x4a, 0xfe, 0x21, 0x9f, 0x75, 0x94, 0x76, 0x29, 0x13, 0xea, 0x78, 0xbd, 0xde, 0x51, 0x4a,
+	0x59, 0x54, 0x81, 0x8b, 0xbe, 0x4a, 0xf2, 0x27, 0x48, 0xdb, 0x5a, 0x7b, 0xa4, 0x34, 0x3a, 0xa5,
+	0x1c, 0xba, 0x0c, 0x17, 0xc2, 0x6d, 0x75, 0x55, 0xad, 0x3f, 0x2b, 0xc9, 0xa1, 0xbe, 0x3a, 0xca,
+	0x6f, 0x76, 0x4a, 0x10, 0xea, 0x8b, 0xcf, 0x48, 0x6b, 0xb4, 0x3a, 0xa5, 0x3c, 0xba, 0x04, 0xe7,
+	0x42, 0xb3, 0xa2, 0x0d, 0x8b, 0xe1, 0x9e, 0x54, 0x45, 0x29, 0x15, 0x42, 0x23, 0xb3, 0xe9, 0x52,
+	0x7c, 0x11, 0x21, 0x28, 0xfa, 0xa7, 0xac, 0x74, 0x4a, 0x4b, 0xb7, 0xd6, 0xa1, 0x18, 0xbc, 0x8c,
+	0x41, 0x86, 0x6b, 0x6c, 0xb5, 0x3e, 0xdd, 0x6c, 0x36, 0x3a, 0xda, 0xf6, 0xd6, 0x66, 0xb3, 0xf1,
+	0x4c, 0xdb, 0x7c, 0xfa, 0xb4, 0xb4, 0x40, 0x7a, 0x0e, 0x37, 0x3c, 0x56, 0xd4, 0x87, 0x4a, 0x49,
+	0xba, 0xf5, 0x87, 0x09, 0x58, 0xf4, 0x9b, 0x0d, 0x7a, 0x1d, 0x5e, 0x5b, 0xdf, 0x6a, 0x68, 0xca,
+	0x13, 0xa5, 0xd5, 0x11, 0x9c, 0x34, 0x76, 0x1e, 0x93, 0x12, 0x73, 0xca, 0xc4, 0x9d, 0xc7, 0x80,
+	0x9e, 0xd6, 0x3b, 0x8d, 0x0d, 0x65, 0xbd, 0x24, 0xa1, 0x37, 0xe1, 0xc6, 0x2c, 0xd0, 0x4e, 0x4b,
+	0xc0, 0x12, 0x68, 0x05, 0xae, 0x86, 0x60, 0xdb, 0x8a, 0xa2, 0xb6, 0xbd, 0xd1, 0x92, 0x71, 0x1d,
+	0xa9, 0x4a, 0x7d, 0x5d, 0xdb, 0x6a, 0x6d, 0x3e, 0x2b, 0xa5, 0xd0, 0x1b, 0xb0, 0x32, 0x93, 0x29,
+	0xb5, 0xd9, 0xa9, 0x13, 0xed, 0x49, 0xc7, 0xb1, 0xae, 0x3c, 0x69, 0x36, 0x3a, 0xca, 0x7a, 0x29,
+	0xb3, 0x76, 0xfb, 0xef, 0xbf, 0xbc, 0x2e, 0xfd, 0xe4, 0xcb, 0xeb, 0xd2, 0xbf, 0x7d, 0x79, 0x5d,
+	0xfa, 0xa3, 0x9f, 0x5d, 0x5f, 0x80, 0x65, 0x03, 0x8f, 0x84, 0x49, 0xe8, 0x43, 0xb3, 0x36, 0xba,
+	0xb7, 0x2d, 0x7d, 0x2f, 0x55, 0xfb, 0x68, 0x74, 0x6f, 0x37, 0x43, 0x37, 0xff, 0x6f, 0xff, 0xff,
+	0x00, 0xcd, 0x1a, 0xec, 0x3e, 0x80, 0x43, 0x00, 0x00,
+}
+
+func (m *Snapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *Operation_TreeEdit_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_TreeEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.TreeEdit != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Presences) > 0 {
+		for k := range m.Presences {
+			v := m.Presences[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Root != nil {
 		{
-			size, err := m.TreeEdit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4160,20 +5498,38 @@ func (m *Operation_TreeEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Operation_TreeStyle_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SnapshotDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotDelta) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_TreeStyle_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SnapshotDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.TreeStyle != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Root != nil {
 		{
-			size, err := m.TreeStyle.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4181,32 +5537,52 @@ func (m *Operation_TreeStyle_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_EditReverse_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Operation_EditReverse_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.EditReverse != nil {
-		{
-			size, err := m.EditReverse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Presences) > 0 {
+		for k := range m.Presences {
+			v := m.Presences[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x5a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
+
+func (m *SnapshotDeltaNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4216,12 +5592,12 @@ func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Set) MarshalTo(dAtA []byte) (int, error) {
+func (m *SnapshotDeltaNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SnapshotDeltaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4230,21 +5606,9 @@ func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Value != nil {
+	if m.Node != nil {
 		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4252,31 +5616,17 @@ func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if m.BaseIndex != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.BaseIndex))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
+func (m *ChangePack) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4286,12 +5636,12 @@ func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Add) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePack) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4300,9 +5650,59 @@ func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutedAt != nil {
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CompressedChanges) > 0 {
+		i -= len(m.CompressedChanges)
+		copy(dAtA[i:], m.CompressedChanges)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.CompressedChanges)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Compression) > 0 {
+		i -= len(m.Compression)
+		copy(dAtA[i:], m.Compression)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Compression)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ActorIds) > 0 {
+		for iNdEx := len(m.ActorIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActorIds[iNdEx])
+			copy(dAtA[i:], m.ActorIds[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.ActorIds[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.IsCompact {
+		i--
+		if m.IsCompact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsRemoved {
+		i--
+		if m.IsRemoved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinSyncedTicket != nil {
 		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MinSyncedTicket.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4310,23 +5710,32 @@ func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
+	}
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Snapshot)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.PrevCreatedAt != nil {
+	if m.Checkpoint != nil {
 		{
-			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4336,22 +5745,17 @@ func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKey)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
+func (m *Change) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4361,12 +5765,12 @@ func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Move) MarshalTo(dAtA []byte) (int, error) {
+func (m *Change) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4375,9 +5779,9 @@ func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutedAt != nil {
+	if m.PresenceChange != nil {
 		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PresenceChange.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4387,33 +5791,30 @@ func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.PrevCreatedAt != nil {
-		{
-			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Message)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ParentCreatedAt != nil {
+	if m.Id != nil {
 		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4426,7 +5827,7 @@ func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
+func (m *ChangeID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4436,12 +5837,12 @@ func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangeID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChangeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4450,46 +5851,47 @@ func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if m.LamportDelta != 0 {
+		i = encodeVarintResources(dAtA, i, uint64((uint64(m.LamportDelta)<<1)^uint64((m.LamportDelta>>63))))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x38
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if m.ServerSeqDelta != 0 {
+		i = encodeVarintResources(dAtA, i, uint64((uint64(m.ServerSeqDelta)<<1)^uint64((m.ServerSeqDelta>>63))))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x30
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if m.ActorIndex != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ActorIndex))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x28
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Lamport != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClientSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ClientSeq))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Edit) Marshal() (dAtA []byte, err error) {
+func (m *Operation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4499,12 +5901,12 @@ func (m *Operation_Edit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Edit) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4513,28 +5915,28 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintResources(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
 	}
-	if m.ExecutedAt != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Set_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Set_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Set != nil {
 		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4542,44 +5944,20 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k := range m.CreatedAtMapByActor {
-			v := m.CreatedAtMapByActor[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintResources(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0xa
 	}
-	if m.To != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Add_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Add_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Add != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4587,11 +5965,20 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.From != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Move_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Move_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Move != nil {
 		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Move.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4599,11 +5986,20 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.ParentCreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Remove_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Remove_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Remove != nil {
 		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4611,38 +6007,20 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Operation_Select) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Operation_Select) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Edit_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Edit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
+	if m.Edit != nil {
 		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Edit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4650,11 +6028,20 @@ func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if m.To != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Select_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Select_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Select != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Select.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4662,11 +6049,20 @@ func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x32
 	}
-	if m.From != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Style_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Style_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Style != nil {
 		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Style.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4674,11 +6070,20 @@ func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if m.ParentCreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Increase_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Increase != nil {
 		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Increase.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4686,38 +6091,20 @@ func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Operation_Style) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Operation_Style) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_TreeEdit_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_TreeEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
+	if m.TreeEdit != nil {
 		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TreeEdit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4725,30 +6112,41 @@ func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x4a
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintResources(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *Operation_TreeStyle_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_TreeStyle_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TreeStyle != nil {
+		{
+			size, err := m.TreeStyle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.To != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_EditReverse_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_EditReverse_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EditReverse != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.EditReverse.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4756,11 +6154,20 @@ func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x5a
 	}
-	if m.From != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_AddAnnotation_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_AddAnnotation_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddAnnotation != nil {
 		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AddAnnotation.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4768,11 +6175,20 @@ func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x62
 	}
-	if m.ParentCreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_RemoveAnnotation_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_RemoveAnnotation_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RemoveAnnotation != nil {
 		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemoveAnnotation.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4780,12 +6196,53 @@ func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_SetAdd_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_SetAdd_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetAdd != nil {
+		{
+			size, err := m.SetAdd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Operation_SetRemove_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
+func (m *Operation_SetRemove_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetRemove != nil {
+		{
+			size, err := m.SetRemove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4795,12 +6252,12 @@ func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Increase) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Set) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4819,7 +6276,7 @@ func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	if m.Value != nil {
 		{
@@ -4831,6 +6288,13 @@ func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.ParentCreatedAt != nil {
@@ -4848,7 +6312,7 @@ func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_TreeEdit) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4858,12 +6322,12 @@ func (m *Operation_TreeEdit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_TreeEdit) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Add) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_TreeEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4882,51 +6346,11 @@ func (m *Operation_TreeEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Contents) > 0 {
-		for iNdEx := len(m.Contents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Contents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k := range m.CreatedAtMapByActor {
-			v := m.CreatedAtMapByActor[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintResources(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0x22
 	}
-	if m.To != nil {
+	if m.Value != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4936,9 +6360,9 @@ func (m *Operation_TreeEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.From != nil {
+	if m.PrevCreatedAt != nil {
 		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4963,7 +6387,7 @@ func (m *Operation_TreeEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_TreeStyle) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4973,12 +6397,12 @@ func (m *Operation_TreeStyle) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_TreeStyle) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Move) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_TreeStyle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4997,30 +6421,11 @@ func (m *Operation_TreeStyle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintResources(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0x22
 	}
-	if m.To != nil {
+	if m.CreatedAt != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5030,9 +6435,9 @@ func (m *Operation_TreeStyle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.From != nil {
+	if m.PrevCreatedAt != nil {
 		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5057,7 +6462,7 @@ func (m *Operation_TreeStyle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_EditReverse) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5067,12 +6472,12 @@ func (m *Operation_EditReverse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_EditReverse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_EditReverse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5081,25 +6486,6 @@ func (m *Operation_EditReverse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintResources(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
 	if m.ExecutedAt != nil {
 		{
 			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -5110,50 +6496,19 @@ func (m *Operation_EditReverse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1a
 	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Content)))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k := range m.CreatedAtMapByActor {
-			v := m.CreatedAtMapByActor[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintResources(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.ToIdx != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.ToIdx))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.FromIdx != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.FromIdx))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.ParentCreatedAt != nil {
 		{
@@ -5170,7 +6525,7 @@ func (m *Operation_EditReverse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Edit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5180,12 +6535,12 @@ func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElementSimple) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Edit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5194,21 +6549,73 @@ func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x32
 	}
-	if m.Type != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Content)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x2a
 	}
-	if m.RemovedAt != nil {
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5218,9 +6625,9 @@ func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.MovedAt != nil {
+	if m.From != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5230,9 +6637,9 @@ func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.CreatedAt != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5245,7 +6652,7 @@ func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Select) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5255,12 +6662,12 @@ func (m *JSONElement) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Select) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5269,49 +6676,9 @@ func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *JSONElement_JsonObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_JsonObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JsonObject != nil {
-		{
-			size, err := m.JsonObject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-func (m *JSONElement_JsonArray) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JsonArray != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.JsonArray.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5319,20 +6686,11 @@ func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *JSONElement_Primitive_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Primitive != nil {
+	if m.To != nil {
 		{
-			size, err := m.Primitive.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5342,18 +6700,9 @@ func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *JSONElement_Text_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Text != nil {
+	if m.From != nil {
 		{
-			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5361,20 +6710,11 @@ func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *JSONElement_Counter_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Counter != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5382,32 +6722,12 @@ func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *JSONElement_Tree_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *JSONElement_Tree_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Tree != nil {
-		{
-			size, err := m.Tree.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	return len(dAtA) - i, nil
-}
-func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Style) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5417,12 +6737,12 @@ func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_JSONObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Style) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5431,21 +6751,66 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5455,9 +6820,9 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	if m.From != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5467,24 +6832,22 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5494,12 +6857,12 @@ func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_JSONArray) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Increase) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5508,9 +6871,9 @@ func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5518,11 +6881,11 @@ func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if m.MovedAt != nil {
+	if m.Value != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5530,11 +6893,11 @@ func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.CreatedAt != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5542,26 +6905,12 @@ func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
+func (m *Operation_TreeEdit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5571,12 +6920,12 @@ func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_Primitive) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_TreeEdit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_TreeEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5585,9 +6934,9 @@ func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5595,11 +6944,51 @@ func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x32
 	}
-	if m.MovedAt != nil {
+	if len(m.Contents) > 0 {
+		for iNdEx := len(m.Contents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5607,11 +6996,11 @@ func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	if m.From != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5619,24 +7008,24 @@ func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
+func (m *Operation_TreeStyle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5646,12 +7035,12 @@ func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_Text) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_TreeStyle) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_TreeStyle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5660,9 +7049,9 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5670,11 +7059,30 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if m.MovedAt != nil {
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5684,9 +7092,9 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	if m.From != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5696,24 +7104,22 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Counter) Marshal() (dAtA []byte, err error) {
+func (m *Operation_EditReverse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5723,12 +7129,12 @@ func (m *JSONElement_Counter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_Counter) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_EditReverse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_EditReverse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5737,9 +7143,28 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5747,23 +7172,54 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Content)))
+		i--
 		dAtA[i] = 0x2a
 	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
 		}
+	}
+	if m.ToIdx != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ToIdx))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x18
 	}
-	if m.CreatedAt != nil {
+	if m.FromIdx != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.FromIdx))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5771,24 +7227,12 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Tree) Marshal() (dAtA []byte, err error) {
+func (m *Operation_AddAnnotation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5798,12 +7242,12 @@ func (m *JSONElement_Tree) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_Tree) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_AddAnnotation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Tree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_AddAnnotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5812,9 +7256,9 @@ func (m *JSONElement_Tree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5822,11 +7266,18 @@ func (m *JSONElement_Tree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if m.MovedAt != nil {
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.To != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5834,11 +7285,11 @@ func (m *JSONElement_Tree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.CreatedAt != nil {
+	if m.From != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5846,26 +7297,31 @@ func (m *JSONElement_Tree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RHTNode) Marshal() (dAtA []byte, err error) {
+func (m *Operation_RemoveAnnotation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5875,12 +7331,12 @@ func (m *RHTNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RHTNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_RemoveAnnotation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_RemoveAnnotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5889,9 +7345,9 @@ func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Element != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5899,19 +7355,31 @@ func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RGANode) Marshal() (dAtA []byte, err error) {
+func (m *Operation_SetAdd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5921,12 +7389,12 @@ func (m *RGANode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RGANode) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_SetAdd) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_SetAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5935,9 +7403,21 @@ func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Element != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5947,9 +7427,9 @@ func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Next != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5962,7 +7442,7 @@ func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeAttr) Marshal() (dAtA []byte, err error) {
+func (m *Operation_SetRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5972,12 +7452,12 @@ func (m *NodeAttr) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeAttr) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_SetRemove) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_SetRemove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5986,9 +7466,9 @@ func (m *NodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UpdatedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5996,45 +7476,11 @@ func (m *NodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TextNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TextNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		dAtA[i] = 0x22
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
 			baseI := i
 			if v != nil {
 				{
@@ -6055,12 +7501,12 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.InsPrevId != nil {
+	if m.Value != nil {
 		{
-			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6068,11 +7514,11 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if m.RemovedAt != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6080,31 +7526,12 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
+func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6114,12 +7541,12 @@ func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextNodeID) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElementSimple) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6128,10 +7555,46 @@ func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+	if m.Policy != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Policy))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x30
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.CreatedAt != nil {
 		{
@@ -6148,7 +7611,7 @@ func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TreeNode) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6158,12 +7621,12 @@ func (m *TreeNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TreeNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6172,40 +7635,49 @@ func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintResources(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x42
 		}
 	}
-	if m.Depth != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Depth))
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElement_JsonObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_JsonObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonObject != nil {
+		{
+			size, err := m.JsonObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0xa
 	}
-	if m.InsNextId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_JsonArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonArray != nil {
 		{
-			size, err := m.InsNextId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JsonArray.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6213,11 +7685,20 @@ func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	if m.InsPrevId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Primitive_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Primitive != nil {
 		{
-			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Primitive.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6225,11 +7706,20 @@ func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	if m.RemovedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Text_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Text != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6237,25 +7727,62 @@ func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Counter_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Counter != nil {
+		{
+			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x32
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Type)))
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Tree_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Tree_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Tree != nil {
+		{
+			size, err := m.Tree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if m.Id != nil {
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Set_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Set_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Set != nil {
 		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6263,12 +7790,11 @@ func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *TreeNodes) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6278,12 +7804,12 @@ func (m *TreeNodes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TreeNodes) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_JSONObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TreeNodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6292,10 +7818,10 @@ func (m *TreeNodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Content) > 0 {
-		for iNdEx := len(m.Content) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Merged) > 0 {
+		for iNdEx := len(m.Merged) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Content[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Merged[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6303,40 +7829,37 @@ func (m *TreeNodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintResources(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x32
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TreeNodeID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Policy != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x28
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TreeNodeID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TreeNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Offset != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
 		{
@@ -6348,12 +7871,26 @@ func (m *TreeNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TreePos) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6363,12 +7900,12 @@ func (m *TreePos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TreePos) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_JSONArray) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TreePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6377,9 +7914,9 @@ func (m *TreePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.LeftSiblingId != nil {
+	if m.RemovedAt != nil {
 		{
-			size, err := m.LeftSiblingId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6387,11 +7924,11 @@ func (m *TreePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if m.ParentId != nil {
+	if m.MovedAt != nil {
 		{
-			size, err := m.ParentId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6399,34 +7936,7 @@ func (m *TreePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *User) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *User) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
 		{
@@ -6438,26 +7948,26 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Username)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Project) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6467,12 +7977,12 @@ func (m *Project) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Primitive) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6481,9 +7991,9 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UpdatedAt != nil {
+	if m.RemovedAt != nil {
 		{
-			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6491,11 +8001,11 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x2a
 	}
-	if m.CreatedAt != nil {
+	if m.MovedAt != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6503,63 +8013,36 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ClientDeactivateThreshold) > 0 {
-		i -= len(m.ClientDeactivateThreshold)
-		copy(dAtA[i:], m.ClientDeactivateThreshold)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.ClientDeactivateThreshold)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AuthWebhookMethods) > 0 {
-		for iNdEx := len(m.AuthWebhookMethods) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AuthWebhookMethods[iNdEx])
-			copy(dAtA[i:], m.AuthWebhookMethods[iNdEx])
-			i = encodeVarintResources(dAtA, i, uint64(len(m.AuthWebhookMethods[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.AuthWebhookUrl) > 0 {
-		i -= len(m.AuthWebhookUrl)
-		copy(dAtA[i:], m.AuthWebhookUrl)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.AuthWebhookUrl)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.SecretKey)))
-		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.PublicKey)))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Name)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdatableProjectFields) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6569,12 +8052,12 @@ func (m *UpdatableProjectFields) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdatableProjectFields) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Text) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6583,9 +8066,23 @@ func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ClientDeactivateThreshold != nil {
+	if len(m.Annotations) > 0 {
+		for iNdEx := len(m.Annotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Annotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RemovedAt != nil {
 		{
-			size, err := m.ClientDeactivateThreshold.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6595,9 +8092,9 @@ func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.AuthWebhookMethods != nil {
+	if m.MovedAt != nil {
 		{
-			size, err := m.AuthWebhookMethods.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6607,9 +8104,9 @@ func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.AuthWebhookUrl != nil {
+	if m.CreatedAt != nil {
 		{
-			size, err := m.AuthWebhookUrl.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6619,22 +8116,24 @@ func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Name != nil {
-		{
-			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_Counter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6644,12 +8143,12 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Counter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6658,19 +8157,58 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalToSizedBuffer(dAtA []
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Methods) > 0 {
-		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Methods[iNdEx])
-			copy(dAtA[i:], m.Methods[iNdEx])
-			i = encodeVarintResources(dAtA, i, uint64(len(m.Methods[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DocumentSummary) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_Tree) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6680,12 +8218,12 @@ func (m *DocumentSummary) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DocumentSummary) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Tree) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Tree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6694,9 +8232,9 @@ func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UpdatedAt != nil {
+	if m.RemovedAt != nil {
 		{
-			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6704,11 +8242,11 @@ func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x22
 	}
-	if m.AccessedAt != nil {
+	if m.MovedAt != nil {
 		{
-			size, err := m.AccessedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6716,7 +8254,7 @@ func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
 		{
@@ -6728,33 +8266,26 @@ func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Snapshot) > 0 {
-		i -= len(m.Snapshot)
-		copy(dAtA[i:], m.Snapshot)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Snapshot)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PresenceChange) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_Set) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6764,12 +8295,12 @@ func (m *PresenceChange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PresenceChange) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Set) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PresenceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6778,9 +8309,9 @@ func (m *PresenceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Presence != nil {
+	if m.RemovedAt != nil {
 		{
-			size, err := m.Presence.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6788,17 +8319,50 @@ func (m *PresenceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if m.Type != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Presence) Marshal() (dAtA []byte, err error) {
+func (m *SetMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6808,12 +8372,12 @@ func (m *Presence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Presence) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Presence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6822,29 +8386,36 @@ func (m *Presence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		for k := range m.Data {
-			v := m.Data[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintResources(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+func (m *RHTNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6854,12 +8425,12 @@ func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *RHTNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6868,20 +8439,29 @@ func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ClientSeq != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.ClientSeq))
+	if m.Element != nil {
+		{
+			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.ServerSeq != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
+func (m *RGANode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6891,12 +8471,12 @@ func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextNodePos) MarshalTo(dAtA []byte) (int, error) {
+func (m *RGANode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6905,19 +8485,45 @@ func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RelativeOffset != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.RelativeOffset))
+	if m.VacatedAt != nil {
+		{
+			size, err := m.VacatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
-	if m.Offset != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+	if m.PositionedAt != nil {
+		{
+			size, err := m.PositionedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	if m.Element != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Next != nil {
+		{
+			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6930,7 +8536,7 @@ func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
+func (m *NodeAttr) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6940,12 +8546,12 @@ func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TimeTicket) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeAttr) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6954,27 +8560,29 @@ func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Delimiter != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Delimiter))
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Lamport != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DocEvent) Marshal() (dAtA []byte, err error) {
+func (m *TextNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6984,12 +8592,12 @@ func (m *DocEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DocEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *TextNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6998,1138 +8606,1827 @@ func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Publisher) > 0 {
-		i -= len(m.Publisher)
-		copy(dAtA[i:], m.Publisher)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Publisher)))
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.InsPrevId != nil {
+		{
+			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintResources(dAtA []byte, offset int, v uint64) int {
-	offset -= sovResources(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Snapshot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Root != nil {
-		l = m.Root.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if len(m.Presences) > 0 {
-		for k, v := range m.Presences {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovResources(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *TextNodeID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChangePack) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DocumentKey)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.Checkpoint != nil {
-		l = m.Checkpoint.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Snapshot)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.Offset != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MinSyncedTicket != nil {
-		l = m.MinSyncedTicket.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.IsRemoved {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Change) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TextAnnotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TextAnnotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextAnnotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.IsRemoved {
+		i--
+		if m.IsRemoved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PresenceChange != nil {
-		l = m.PresenceChange.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangeID) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClientSeq != 0 {
-		n += 1 + sovResources(uint64(m.ClientSeq))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ServerSeq != 0 {
-		n += 1 + sovResources(uint64(m.ServerSeq))
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.Lamport != 0 {
-		n += 1 + sovResources(uint64(m.Lamport))
+	if m.Depth != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.InsNextId != nil {
+		{
+			size, err := m.InsNextId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.InsPrevId != nil {
+		{
+			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *Operation) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Set_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Set != nil {
-		l = m.Set.Size()
-		n += 1 + l + sovResources(uint64(l))
+func (m *TreeNodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Operation_Add_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TreeNodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreeNodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Add != nil {
-		l = m.Add.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Content) > 0 {
+		for iNdEx := len(m.Content) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Content[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Move_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *TreeNodeID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeNodeID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreeNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Move != nil {
-		l = m.Move.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *Operation_Remove_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Offset != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Remove != nil {
-		l = m.Remove.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Edit_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Edit != nil {
-		l = m.Edit.Size()
-		n += 1 + l + sovResources(uint64(l))
+
+func (m *TreePos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Operation_Select_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Select != nil {
-		l = m.Select.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	return n
+
+func (m *TreePos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Operation_Style_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TreePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Style != nil {
-		l = m.Style.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *Operation_Increase_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LeftSiblingId != nil {
+		{
+			size, err := m.LeftSiblingId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Increase != nil {
-		l = m.Increase.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ParentId != nil {
+		{
+			size, err := m.ParentId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Operation_TreeEdit_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TreeEdit != nil {
-		l = m.TreeEdit.Size()
-		n += 1 + l + sovResources(uint64(l))
+
+func (m *User) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Operation_TreeStyle_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TreeStyle != nil {
-		l = m.TreeStyle.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *Operation_EditReverse_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.EditReverse != nil {
-		l = m.EditReverse.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Set) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Project) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.SnapshotInterval != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.SnapshotInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.SnapshotThreshold != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.SnapshotThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ChangeLogHardLimit != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ChangeLogHardLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	return n
-}
-
-func (m *Operation_Add) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChangeLogSoftLimit != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ChangeLogSoftLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.DocumentSizeHardLimit != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.DocumentSizeHardLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.PrevCreatedAt != nil {
-		l = m.PrevCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.DocumentSizeSoftLimit != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.DocumentSizeSoftLimit))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.EventWebhookEvents) > 0 {
+		for iNdEx := len(m.EventWebhookEvents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventWebhookEvents[iNdEx])
+			copy(dAtA[i:], m.EventWebhookEvents[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.EventWebhookEvents[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.EventWebhookUrl) > 0 {
+		i -= len(m.EventWebhookUrl)
+		copy(dAtA[i:], m.EventWebhookUrl)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.EventWebhookUrl)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.SensitivePresenceKeys) > 0 {
+		for iNdEx := len(m.SensitivePresenceKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SensitivePresenceKeys[iNdEx])
+			copy(dAtA[i:], m.SensitivePresenceKeys[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.SensitivePresenceKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	return n
-}
-
-func (m *Operation_Move) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.AuthJwksUrl) > 0 {
+		i -= len(m.AuthJwksUrl)
+		copy(dAtA[i:], m.AuthJwksUrl)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.AuthJwksUrl)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.AuthJwtKey) > 0 {
+		i -= len(m.AuthJwtKey)
+		copy(dAtA[i:], m.AuthJwtKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.AuthJwtKey)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.PrevCreatedAt != nil {
-		l = m.PrevCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
 	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.ClientDeactivateThreshold) > 0 {
+		i -= len(m.ClientDeactivateThreshold)
+		copy(dAtA[i:], m.ClientDeactivateThreshold)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ClientDeactivateThreshold)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.AuthWebhookMethods) > 0 {
+		for iNdEx := len(m.AuthWebhookMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthWebhookMethods[iNdEx])
+			copy(dAtA[i:], m.AuthWebhookMethods[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.AuthWebhookMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return n
-}
-
-func (m *Operation_Remove) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.AuthWebhookUrl) > 0 {
+		i -= len(m.AuthWebhookUrl)
+		copy(dAtA[i:], m.AuthWebhookUrl)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.AuthWebhookUrl)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.SecretKey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Edit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdatableProjectFields) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatableProjectFields) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.SnapshotInterval != nil {
+		{
+			size, err := m.SnapshotInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k, v := range m.CreatedAtMapByActor {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovResources(uint64(l))
+	if m.SnapshotThreshold != nil {
+		{
+			size, err := m.SnapshotThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x72
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.ChangeLogHardLimit != nil {
+		{
+			size, err := m.ChangeLogHardLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ChangeLogSoftLimit != nil {
+		{
+			size, err := m.ChangeLogSoftLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+	if m.DocumentSizeHardLimit != nil {
+		{
+			size, err := m.DocumentSizeHardLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DocumentSizeSoftLimit != nil {
+		{
+			size, err := m.DocumentSizeSoftLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
-}
-
-func (m *Operation_Select) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.EventWebhookEvents != nil {
+		{
+			size, err := m.EventWebhookEvents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.EventWebhookUrl != nil {
+		{
+			size, err := m.EventWebhookUrl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.SensitivePresenceKeys != nil {
+		{
+			size, err := m.SensitivePresenceKeys.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Operation_Style) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.AuthJwksUrl != nil {
+		{
+			size, err := m.AuthJwksUrl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.AuthJwtKey != nil {
+		{
+			size, err := m.AuthJwtKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ClientDeactivateThreshold != nil {
+		{
+			size, err := m.ClientDeactivateThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+	if m.AuthWebhookMethods != nil {
+		{
+			size, err := m.AuthWebhookMethods.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.AuthWebhookUrl != nil {
+		{
+			size, err := m.AuthWebhookUrl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name != nil {
+		{
+			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Increase) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdatableProjectFields_AuthWebhookMethods) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Methods[iNdEx])
+			copy(dAtA[i:], m.Methods[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.Methods[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_TreeEdit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdatableProjectFields_SensitivePresenceKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatableProjectFields_SensitivePresenceKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatableProjectFields_SensitivePresenceKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k, v := range m.CreatedAtMapByActor {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovResources(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Contents) > 0 {
-		for _, e := range m.Contents {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_TreeStyle) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdatableProjectFields_EventWebhookEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatableProjectFields_EventWebhookEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatableProjectFields_EventWebhookEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_EditReverse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DocumentSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FromIdx != 0 {
-		n += 1 + sovResources(uint64(m.FromIdx))
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.ToIdx != 0 {
-		n += 1 + sovResources(uint64(m.ToIdx))
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k, v := range m.CreatedAtMapByActor {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovResources(uint64(l))
+	if m.AccessedAt != nil {
+		{
+			size, err := m.AccessedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
-		}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElementSimple) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DocumentTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Type != 0 {
-		n += 1 + sovResources(uint64(m.Type))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DocumentACL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentACL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentACL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.PublicRead {
+		i--
+		if m.PublicRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Writers) > 0 {
+		for iNdEx := len(m.Writers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Writers[iNdEx])
+			copy(dAtA[i:], m.Writers[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.Writers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Readers) > 0 {
+		for iNdEx := len(m.Readers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Readers[iNdEx])
+			copy(dAtA[i:], m.Readers[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.Readers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_JsonObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DocumentMemory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentMemory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentMemory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JsonObject != nil {
-		l = m.JsonObject.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *JSONElement_JsonArray) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SnapshotBytes != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.SnapshotBytes))
+		i--
+		dAtA[i] = 0x40
 	}
-	var l int
-	_ = l
-	if m.JsonArray != nil {
-		l = m.JsonArray.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Snapshots != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Snapshots))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-func (m *JSONElement_Primitive_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LockBytes != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.LockBytes))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.Primitive != nil {
-		l = m.Primitive.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Locks != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Locks))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-func (m *JSONElement_Text_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SubscriptionBytes != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.SubscriptionBytes))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.Text != nil {
-		l = m.Text.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Subscriptions != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Subscriptions))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *JSONElement_Counter_) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Counter != nil {
-		l = m.Counter.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *JSONElement_Tree_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tree != nil {
-		l = m.Tree.Size()
-		n += 1 + l + sovResources(uint64(l))
+
+func (m *ClientSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *JSONElement_JSONObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
-		}
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *ClientSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_JSONArray) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClientSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Connection != nil {
+		{
+			size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Primitive) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConnectionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovResources(uint64(m.Type))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.UserAgent) > 0 {
+		i -= len(m.UserAgent)
+		copy(dAtA[i:], m.UserAgent)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.UserAgent)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Ip) > 0 {
+		i -= len(m.Ip)
+		copy(dAtA[i:], m.Ip)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Ip)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Text) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PresenceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PresenceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PresenceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Presence != nil {
+		{
+			size, err := m.Presence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Counter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovResources(uint64(m.Type))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Presence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JSONElement_Tree) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Presence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Presence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
-		}
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Data) > 0 {
+		for k := range m.Data {
+			v := m.Data[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RHTNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Element != nil {
-		l = m.Element.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ClientSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ClientSeq))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ServerSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RGANode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TextNodePos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Next != nil {
-		l = m.Next.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Element != nil {
-		l = m.Element.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.RelativeOffset != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.RelativeOffset))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Offset != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *NodeAttr) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeTicket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.UpdatedAt != nil {
-		l = m.UpdatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *TextNode) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LamportDelta != 0 {
+		i = encodeVarintResources(dAtA, i, uint64((uint64(m.LamportDelta)<<1)^uint64((m.LamportDelta>>63))))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ActorIndex != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ActorIndex))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.InsPrevId != nil {
-		l = m.InsPrevId.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovResources(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
-		}
+	if m.Delimiter != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Delimiter))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Lamport != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TextNodeID) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DocEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		n += 1 + sovResources(uint64(m.Offset))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Presence) > 0 {
+		for k := range m.Presence {
+			v := m.Presence[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if len(m.Publisher) > 0 {
+		i -= len(m.Publisher)
+		copy(dAtA[i:], m.Publisher)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Publisher)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TreeNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DocumentExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ServerSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.InsPrevId != nil {
-		l = m.InsPrevId.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.InsNextId != nil {
-		l = m.InsNextId.Size()
-		n += 1 + l + sovResources(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintResources(dAtA []byte, offset int, v uint64) int {
+	offset -= sovResources(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	if m.Depth != 0 {
-		n += 1 + sovResources(uint64(m.Depth))
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
+	var l int
+	_ = l
+	if m.Root != nil {
+		l = m.Root.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Presences) > 0 {
+		for k, v := range m.Presences {
 			_ = k
 			_ = v
 			l = 0
@@ -8147,55 +10444,52 @@ func (m *TreeNode) Size() (n int) {
 	return n
 }
 
-func (m *TreeNodes) Size() (n int) {
+func (m *SnapshotDelta) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Content) > 0 {
-		for _, e := range m.Content {
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
 			l = e.Size()
 			n += 1 + l + sovResources(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *TreeNodeID) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Presences) > 0 {
+		for k, v := range m.Presences {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
 	}
-	var l int
-	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
+	if m.Root != nil {
+		l = m.Root.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.Offset != 0 {
-		n += 1 + sovResources(uint64(m.Offset))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TreePos) Size() (n int) {
+func (m *SnapshotDeltaNode) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ParentId != nil {
-		l = m.ParentId.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.BaseIndex != 0 {
+		n += 1 + sovResources(uint64(m.BaseIndex))
 	}
-	if m.LeftSiblingId != nil {
-		l = m.LeftSiblingId.Size()
+	if m.Node != nil {
+		l = m.Node.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -8204,72 +10498,86 @@ func (m *TreePos) Size() (n int) {
 	return n
 }
 
-func (m *User) Size() (n int) {
+func (m *ChangePack) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.DocumentKey)
 	if l > 0 {
 		n += 1 + l + sovResources(uint64(l))
 	}
-	l = len(m.Username)
-	if l > 0 {
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
+	l = len(m.Snapshot)
+	if l > 0 {
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Project) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	if m.MinSyncedTicket != nil {
+		l = m.MinSyncedTicket.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.IsRemoved {
+		n += 2
 	}
-	l = len(m.PublicKey)
+	if m.IsCompact {
+		n += 2
+	}
+	if len(m.ActorIds) > 0 {
+		for _, b := range m.ActorIds {
+			l = len(b)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	l = len(m.Compression)
 	if l > 0 {
 		n += 1 + l + sovResources(uint64(l))
 	}
-	l = len(m.SecretKey)
+	l = len(m.CompressedChanges)
 	if l > 0 {
 		n += 1 + l + sovResources(uint64(l))
 	}
-	l = len(m.AuthWebhookUrl)
+	l = len(m.IdempotencyKey)
 	if l > 0 {
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if len(m.AuthWebhookMethods) > 0 {
-		for _, s := range m.AuthWebhookMethods {
-			l = len(s)
-			n += 1 + l + sovResources(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	l = len(m.ClientDeactivateThreshold)
-	if l > 0 {
+	return n
+}
+
+func (m *Change) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
+	l = len(m.Message)
+	if l > 0 {
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.UpdatedAt != nil {
-		l = m.UpdatedAt.Size()
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.PresenceChange != nil {
+		l = m.PresenceChange.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -8278,45 +10586,48 @@ func (m *Project) Size() (n int) {
 	return n
 }
 
-func (m *UpdatableProjectFields) Size() (n int) {
+func (m *ChangeID) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = m.Name.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ClientSeq != 0 {
+		n += 1 + sovResources(uint64(m.ClientSeq))
 	}
-	if m.AuthWebhookUrl != nil {
-		l = m.AuthWebhookUrl.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ServerSeq != 0 {
+		n += 1 + sovResources(uint64(m.ServerSeq))
 	}
-	if m.AuthWebhookMethods != nil {
-		l = m.AuthWebhookMethods.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Lamport != 0 {
+		n += 1 + sovResources(uint64(m.Lamport))
 	}
-	if m.ClientDeactivateThreshold != nil {
-		l = m.ClientDeactivateThreshold.Size()
+	l = len(m.ActorId)
+	if l > 0 {
 		n += 1 + l + sovResources(uint64(l))
 	}
+	if m.ActorIndex != 0 {
+		n += 1 + sovResources(uint64(m.ActorIndex))
+	}
+	if m.ServerSeqDelta != 0 {
+		n += 1 + sozResources(uint64(m.ServerSeqDelta))
+	}
+	if m.LamportDelta != 0 {
+		n += 1 + sozResources(uint64(m.LamportDelta))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) Size() (n int) {
+func (m *Operation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Methods) > 0 {
-		for _, s := range m.Methods {
-			l = len(s)
-			n += 1 + l + sovResources(uint64(l))
-		}
+	if m.Body != nil {
+		n += m.Body.Size()
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8324,169 +10635,6620 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) Size() (n int) {
 	return n
 }
 
-func (m *DocumentSummary) Size() (n int) {
+func (m *Operation_Set_) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	if m.Set != nil {
+		l = m.Set.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	return n
+}
+func (m *Operation_Add_) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Snapshot)
-	if l > 0 {
+	var l int
+	_ = l
+	if m.Add != nil {
+		l = m.Add.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	return n
+}
+func (m *Operation_Move_) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.AccessedAt != nil {
-		l = m.AccessedAt.Size()
+	var l int
+	_ = l
+	if m.Move != nil {
+		l = m.Move.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.UpdatedAt != nil {
-		l = m.UpdatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	return n
+}
+func (m *Operation_Remove_) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	var l int
+	_ = l
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovResources(uint64(l))
 	}
 	return n
 }
-
-func (m *PresenceChange) Size() (n int) {
+func (m *Operation_Edit_) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovResources(uint64(m.Type))
-	}
-	if m.Presence != nil {
-		l = m.Presence.Size()
+	if m.Edit != nil {
+		l = m.Edit.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
 	return n
 }
-
-func (m *Presence) Size() (n int) {
+func (m *Operation_Select_) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for k, v := range m.Data {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Select != nil {
+		l = m.Select.Size()
+		n += 1 + l + sovResources(uint64(l))
 	}
 	return n
 }
-
-func (m *Checkpoint) Size() (n int) {
+func (m *Operation_Style_) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ServerSeq != 0 {
-		n += 1 + sovResources(uint64(m.ServerSeq))
+	if m.Style != nil {
+		l = m.Style.Size()
+		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.ClientSeq != 0 {
-		n += 1 + sovResources(uint64(m.ClientSeq))
+	return n
+}
+func (m *Operation_Increase_) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	var l int
+	_ = l
+	if m.Increase != nil {
+		l = m.Increase.Size()
+		n += 1 + l + sovResources(uint64(l))
 	}
 	return n
 }
-
-func (m *TextNodePos) Size() (n int) {
+func (m *Operation_TreeEdit_) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
+	if m.TreeEdit != nil {
+		l = m.TreeEdit.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.Offset != 0 {
-		n += 1 + sovResources(uint64(m.Offset))
-	}
-	if m.RelativeOffset != 0 {
-		n += 1 + sovResources(uint64(m.RelativeOffset))
+	return n
+}
+func (m *Operation_TreeStyle_) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	var l int
+	_ = l
+	if m.TreeStyle != nil {
+		l = m.TreeStyle.Size()
+		n += 1 + l + sovResources(uint64(l))
 	}
 	return n
 }
-
-func (m *TimeTicket) Size() (n int) {
+func (m *Operation_EditReverse_) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Lamport != 0 {
-		n += 1 + sovResources(uint64(m.Lamport))
+	if m.EditReverse != nil {
+		l = m.EditReverse.Size()
+		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.Delimiter != 0 {
-		n += 1 + sovResources(uint64(m.Delimiter))
+	return n
+}
+func (m *Operation_AddAnnotation_) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.ActorId)
-	if l > 0 {
+	var l int
+	_ = l
+	if m.AddAnnotation != nil {
+		l = m.AddAnnotation.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
 	return n
 }
-
-func (m *DocEvent) Size() (n int) {
+func (m *Operation_RemoveAnnotation_) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovResources(uint64(m.Type))
-	}
-	l = len(m.Publisher)
-	if l > 0 {
+	if m.RemoveAnnotation != nil {
+		l = m.RemoveAnnotation.Size()
 		n += 1 + l + sovResources(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return n
+}
+func (m *Operation_SetAdd_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetAdd != nil {
+		l = m.SetAdd.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_SetRemove_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetRemove != nil {
+		l = m.SetRemove.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Set) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func sovResources(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Operation_Add) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.PrevCreatedAt != nil {
+		l = m.PrevCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func sozResources(x uint64) (n int) {
-	return sovResources(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Operation_Move) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.PrevCreatedAt != nil {
+		l = m.PrevCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Edit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Select) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Style) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Increase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_TreeEdit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Contents) > 0 {
+		for _, e := range m.Contents {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_TreeStyle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_EditReverse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.FromIdx != 0 {
+		n += 1 + sovResources(uint64(m.FromIdx))
+	}
+	if m.ToIdx != 0 {
+		n += 1 + sovResources(uint64(m.ToIdx))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_AddAnnotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_RemoveAnnotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_SetAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_SetRemove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElementSimple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovResources(uint64(m.Policy))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_JsonObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonObject != nil {
+		l = m.JsonObject.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_JsonArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonArray != nil {
+		l = m.JsonArray.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Primitive_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Primitive != nil {
+		l = m.Primitive.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Text_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Text != nil {
+		l = m.Text.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Counter_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Counter != nil {
+		l = m.Counter.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Tree_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Set_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Set != nil {
+		l = m.Set.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_JSONObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovResources(uint64(m.Policy))
+	}
+	if len(m.Merged) > 0 {
+		for _, e := range m.Merged {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_JSONArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Primitive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Text) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Annotations) > 0 {
+		for _, e := range m.Annotations {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Counter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Tree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Set) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RHTNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Element != nil {
+		l = m.Element.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RGANode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Next != nil {
+		l = m.Next.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Element != nil {
+		l = m.Element.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.PositionedAt != nil {
+		l = m.PositionedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.VacatedAt != nil {
+		l = m.VacatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeAttr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.InsPrevId != nil {
+		l = m.InsPrevId.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextNodeID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovResources(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextAnnotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.IsRemoved {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.InsPrevId != nil {
+		l = m.InsPrevId.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.InsNextId != nil {
+		l = m.InsNextId.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovResources(uint64(m.Depth))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreeNodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Content) > 0 {
+		for _, e := range m.Content {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreeNodeID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovResources(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreePos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentId != nil {
+		l = m.ParentId.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.LeftSiblingId != nil {
+		l = m.LeftSiblingId.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Project) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.AuthWebhookUrl)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.AuthWebhookMethods) > 0 {
+		for _, s := range m.AuthWebhookMethods {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	l = len(m.ClientDeactivateThreshold)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.AuthJwtKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.AuthJwksUrl)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.SensitivePresenceKeys) > 0 {
+		for _, s := range m.SensitivePresenceKeys {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	l = len(m.EventWebhookUrl)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.EventWebhookEvents) > 0 {
+		for _, s := range m.EventWebhookEvents {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.DocumentSizeSoftLimit != 0 {
+		n += 1 + sovResources(uint64(m.DocumentSizeSoftLimit))
+	}
+	if m.DocumentSizeHardLimit != 0 {
+		n += 2 + sovResources(uint64(m.DocumentSizeHardLimit))
+	}
+	if m.ChangeLogSoftLimit != 0 {
+		n += 2 + sovResources(uint64(m.ChangeLogSoftLimit))
+	}
+	if m.ChangeLogHardLimit != 0 {
+		n += 2 + sovResources(uint64(m.ChangeLogHardLimit))
+	}
+	if m.SnapshotThreshold != 0 {
+		n += 2 + sovResources(uint64(m.SnapshotThreshold))
+	}
+	if m.SnapshotInterval != 0 {
+		n += 2 + sovResources(uint64(m.SnapshotInterval))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatableProjectFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = m.Name.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.AuthWebhookUrl != nil {
+		l = m.AuthWebhookUrl.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.AuthWebhookMethods != nil {
+		l = m.AuthWebhookMethods.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ClientDeactivateThreshold != nil {
+		l = m.ClientDeactivateThreshold.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.AuthJwtKey != nil {
+		l = m.AuthJwtKey.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.AuthJwksUrl != nil {
+		l = m.AuthJwksUrl.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.SensitivePresenceKeys != nil {
+		l = m.SensitivePresenceKeys.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.EventWebhookUrl != nil {
+		l = m.EventWebhookUrl.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.EventWebhookEvents != nil {
+		l = m.EventWebhookEvents.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.DocumentSizeSoftLimit != nil {
+		l = m.DocumentSizeSoftLimit.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.DocumentSizeHardLimit != nil {
+		l = m.DocumentSizeHardLimit.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ChangeLogSoftLimit != nil {
+		l = m.ChangeLogSoftLimit.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ChangeLogHardLimit != nil {
+		l = m.ChangeLogHardLimit.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.SnapshotThreshold != nil {
+		l = m.SnapshotThreshold.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.SnapshotInterval != nil {
+		l = m.SnapshotInterval.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatableProjectFields_AuthWebhookMethods) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatableProjectFields_SensitivePresenceKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatableProjectFields_EventWebhookEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.AccessedAt != nil {
+		l = m.AccessedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentACL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Readers) > 0 {
+		for _, s := range m.Readers {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if len(m.Writers) > 0 {
+		for _, s := range m.Writers {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.PublicRead {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentMemory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Subscriptions != 0 {
+		n += 1 + sovResources(uint64(m.Subscriptions))
+	}
+	if m.SubscriptionBytes != 0 {
+		n += 1 + sovResources(uint64(m.SubscriptionBytes))
+	}
+	if m.Locks != 0 {
+		n += 1 + sovResources(uint64(m.Locks))
+	}
+	if m.LockBytes != 0 {
+		n += 1 + sovResources(uint64(m.LockBytes))
+	}
+	if m.Snapshots != 0 {
+		n += 1 + sovResources(uint64(m.Snapshots))
+	}
+	if m.SnapshotBytes != 0 {
+		n += 1 + sovResources(uint64(m.SnapshotBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClientSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Connection != nil {
+		l = m.Connection.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConnectionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ip)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.UserAgent)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PresenceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	if m.Presence != nil {
+		l = m.Presence.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Presence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for k, v := range m.Data {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovResources(uint64(m.ServerSeq))
+	}
+	if m.ClientSeq != 0 {
+		n += 1 + sovResources(uint64(m.ClientSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextNodePos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovResources(uint64(m.Offset))
+	}
+	if m.RelativeOffset != 0 {
+		n += 1 + sovResources(uint64(m.RelativeOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimeTicket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lamport != 0 {
+		n += 1 + sovResources(uint64(m.Lamport))
+	}
+	if m.Delimiter != 0 {
+		n += 1 + sovResources(uint64(m.Delimiter))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ActorIndex != 0 {
+		n += 1 + sovResources(uint64(m.ActorIndex))
+	}
+	if m.LamportDelta != 0 {
+		n += 1 + sozResources(uint64(m.LamportDelta))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	l = len(m.Publisher)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Presence) > 0 {
+		for k, v := range m.Presence {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovResources(uint64(m.ServerSeq))
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovResources(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozResources(x uint64) (n int) {
+	return sovResources(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Snapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Root == nil {
+				m.Root = &JSONElement{}
+			}
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Presences == nil {
+				m.Presences = make(map[string]*Presence)
+			}
+			var mapkey string
+			var mapvalue *Presence
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Presence{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Presences[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &SnapshotDeltaNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Presences == nil {
+				m.Presences = make(map[string]*Presence)
+			}
+			var mapkey string
+			var mapvalue *Presence
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Presence{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Presences[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Root == nil {
+				m.Root = &JSONElement{}
+			}
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotDeltaNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotDeltaNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotDeltaNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseIndex", wireType)
+			}
+			m.BaseIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &RHTNode{}
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangePack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangePack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangePack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &Checkpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.Snapshot == nil {
+				m.Snapshot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &Change{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSyncedTicket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSyncedTicket == nil {
+				m.MinSyncedTicket = &TimeTicket{}
+			}
+			if err := m.MinSyncedTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRemoved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRemoved = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCompact", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCompact = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorIds = append(m.ActorIds, make([]byte, postIndex-iNdEx))
+			copy(m.ActorIds[len(m.ActorIds)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedChanges", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressedChanges = append(m.CompressedChanges[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedChanges == nil {
+				m.CompressedChanges = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Change) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Change: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Change: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ChangeID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &Operation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PresenceChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PresenceChange == nil {
+				m.PresenceChange = &PresenceChange{}
+			}
+			if err := m.PresenceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSeq", wireType)
+			}
+			m.ClientSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientSeq |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
+			}
+			m.Lamport = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lamport |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = append(m.ActorId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ActorId == nil {
+				m.ActorId = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorIndex", wireType)
+			}
+			m.ActorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeqDelta", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.ServerSeqDelta = int64(v)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LamportDelta", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.LamportDelta = int64(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Set{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Set_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Add{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Add_{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Move{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Move_{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Remove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Remove_{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Edit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Edit_{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Select", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Select{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Select_{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Style{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Style_{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Increase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Increase_{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeEdit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_TreeEdit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_TreeEdit_{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeStyle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_TreeStyle{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_TreeStyle_{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditReverse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_EditReverse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_EditReverse_{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAnnotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_AddAnnotation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_AddAnnotation_{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAnnotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_RemoveAnnotation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_RemoveAnnotation_{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAdd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_SetAdd{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_SetAdd_{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_SetRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_SetRemove_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Set) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &JSONElementSimple{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Add) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Add: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Add: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevCreatedAt == nil {
+				m.PrevCreatedAt = &TimeTicket{}
+			}
+			if err := m.PrevCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &JSONElementSimple{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Move) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Move: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Move: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevCreatedAt == nil {
+				m.PrevCreatedAt = &TimeTicket{}
+			}
+			if err := m.PrevCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TextNodePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TextNodePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			}
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Select) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Select: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Select: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TextNodePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TextNodePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Style) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Style: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Style: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TextNodePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TextNodePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			}
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Increase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Increase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &JSONElementSimple{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeEdit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeEdit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TreePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TreePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			}
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contents = append(m.Contents, &TreeNodes{})
+			if err := m.Contents[len(m.Contents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeStyle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeStyle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TreePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TreePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Snapshot) Unmarshal(dAtA []byte) error {
+func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8509,15 +17271,15 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: EditReverse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EditReverse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8544,16 +17306,54 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Root == nil {
-				m.Root = &JSONElement{}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
 			}
-			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromIdx", wireType)
+			}
+			m.FromIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToIdx", wireType)
+			}
+			m.ToIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Presences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8580,11 +17380,11 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Presences == nil {
-				m.Presences = make(map[string]*Presence)
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
 			}
 			var mapkey string
-			var mapvalue *Presence
+			var mapvalue *TimeTicket
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -8658,11 +17458,206 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &Presence{}
+					mapvalue = &TimeTicket{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
-					iNdEx = postmsgIndex
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipResources(dAtA[iNdEx:])
@@ -8678,7 +17673,7 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Presences[mapkey] = mapvalue
+			m.Attributes[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8702,7 +17697,7 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePack) Unmarshal(dAtA []byte) error {
+func (m *Operation_AddAnnotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8725,17 +17720,17 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePack: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddAnnotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddAnnotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8745,29 +17740,33 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8777,33 +17776,29 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Checkpoint == nil {
-				m.Checkpoint = &Checkpoint{}
-			}
-			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8813,29 +17808,31 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
-			if m.Snapshot == nil {
-				m.Snapshot = []byte{}
+			if m.From == nil {
+				m.From = &TextNodePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8862,16 +17859,18 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, &Change{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.To == nil {
+				m.To = &TextNodePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSyncedTicket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8881,33 +17880,29 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MinSyncedTicket == nil {
-				m.MinSyncedTicket = &TimeTicket{}
-			}
-			if err := m.MinSyncedTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRemoved", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8917,12 +17912,28 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRemoved = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -8945,7 +17956,7 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Change) Unmarshal(dAtA []byte) error {
+func (m *Operation_RemoveAnnotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8968,15 +17979,15 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Change: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveAnnotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Change: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveAnnotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9003,16 +18014,16 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ChangeID{}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9040,45 +18051,11 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operations = append(m.Operations, &Operation{})
-			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PresenceChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9105,10 +18082,10 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PresenceChange == nil {
-				m.PresenceChange = &PresenceChange{}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
 			}
-			if err := m.PresenceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9134,7 +18111,7 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeID) Unmarshal(dAtA []byte) error {
+func (m *Operation_SetAdd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9157,17 +18134,17 @@ func (m *ChangeID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeID: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAdd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAdd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSeq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
 			}
-			m.ClientSeq = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9177,16 +18154,33 @@ func (m *ChangeID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientSeq |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.ServerSeq = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9196,35 +18190,33 @@ func (m *ChangeID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServerSeq |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthResources
 			}
-			m.Lamport = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lamport |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &JSONElementSimple{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9234,24 +18226,26 @@ func (m *ChangeID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = append(m.ActorId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ActorId == nil {
-				m.ActorId = []byte{}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9276,7 +18270,7 @@ func (m *ChangeID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *Operation_SetRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9299,15 +18293,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9334,15 +18328,16 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Set{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Set_{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9369,15 +18364,16 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Add{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Value == nil {
+				m.Value = &JSONElementSimple{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Add_{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9404,15 +18400,109 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Move{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
 			}
-			m.Body = &Operation_Move_{v}
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9439,15 +18529,67 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Remove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Remove_{v}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JSONElementSimple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JSONElementSimple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9474,15 +18616,16 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Edit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Edit_{v}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Select", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9509,17 +18652,73 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Select{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Select_{v}
-			iNdEx = postIndex
-		case 7:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9529,32 +18728,31 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Style{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			m.Body = &Operation_Style_{v}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increase", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var msglen int
+			m.Policy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9564,30 +18762,65 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Policy |= ConflictPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Increase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
 			}
-			m.Body = &Operation_Increase_{v}
-			iNdEx = postIndex
-		case 9:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JSONElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JSONElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreeEdit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonObject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9614,15 +18847,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_TreeEdit{}
+			v := &JSONElement_JSONObject{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_TreeEdit_{v}
+			m.Body = &JSONElement_JsonObject{v}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreeStyle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonArray", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9649,15 +18882,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_TreeStyle{}
+			v := &JSONElement_JSONArray{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_TreeStyle_{v}
+			m.Body = &JSONElement_JsonArray{v}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EditReverse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Primitive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9684,66 +18917,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_EditReverse{}
+			v := &JSONElement_Primitive{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_EditReverse_{v}
+			m.Body = &JSONElement_Primitive_{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Operation_Set) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Set: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9770,18 +18952,17 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &JSONElement_Text{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Body = &JSONElement_Text_{v}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9791,27 +18972,30 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			v := &JSONElement_Counter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &JSONElement_Counter_{v}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9838,16 +19022,15 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &JSONElementSimple{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &JSONElement_Tree{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Body = &JSONElement_Tree_{v}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9874,12 +19057,11 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
-			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &JSONElement_Set{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Body = &JSONElement_Set_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9903,7 +19085,7 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Add) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9926,15 +19108,49 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Add: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Add: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &RHTNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9961,16 +19177,16 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9997,16 +19213,16 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevCreatedAt == nil {
-				m.PrevCreatedAt = &TimeTicket{}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
 			}
-			if err := m.PrevCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10033,16 +19249,35 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &JSONElementSimple{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= ConflictPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merged", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10069,10 +19304,8 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
-			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Merged = append(m.Merged, &TimeTicket{})
+			if err := m.Merged[len(m.Merged)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10098,7 +19331,7 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Move) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10121,15 +19354,15 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Move: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONArray: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Move: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONArray: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10156,16 +19389,14 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, &RGANode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10192,16 +19423,16 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevCreatedAt == nil {
-				m.PrevCreatedAt = &TimeTicket{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			if err := m.PrevCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10228,16 +19459,16 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10264,10 +19495,10 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10293,7 +19524,7 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10316,15 +19547,68 @@ func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+			return fmt.Errorf("proto: Primitive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Primitive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10351,16 +19635,16 @@ func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10387,16 +19671,16 @@ func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10423,10 +19707,10 @@ func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10452,7 +19736,7 @@ func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10475,87 +19759,15 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Edit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Text: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Edit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.From == nil {
-				m.From = &TextNodePos{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10582,16 +19794,14 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &TextNodePos{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, &TextNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10618,111 +19828,18 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAtMapByActor == nil {
-				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			var mapkey string
-			var mapvalue *TimeTicket
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthResources
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &TimeTicket{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CreatedAtMapByActor[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10732,27 +19849,31 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10779,16 +19900,16 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10799,119 +19920,26 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotations = append(m.Annotations, &TextAnnotation{})
+			if err := m.Annotations[len(m.Annotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10935,7 +19963,7 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Select) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10958,17 +19986,36 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Select: wiretype end group for non-group")
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Select: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10978,31 +20025,29 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11029,16 +20074,16 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &TextNodePos{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11065,16 +20110,16 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &TextNodePos{}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11101,10 +20146,10 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11130,7 +20175,7 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Style) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_Tree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11153,15 +20198,15 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Style: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Style: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11188,16 +20233,14 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, &TreeNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11224,16 +20267,16 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &TextNodePos{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11260,143 +20303,16 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &TextNodePos{}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Attributes[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11423,10 +20339,10 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11452,7 +20368,7 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_Set) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11475,15 +20391,15 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Increase: wiretype end group for non-group")
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Increase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11510,16 +20426,14 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &SetMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11546,16 +20460,16 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &JSONElementSimple{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11582,10 +20496,46 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11611,7 +20561,7 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
+func (m *SetMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11634,17 +20584,17 @@ func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeEdit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeEdit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11654,33 +20604,16 @@ func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= ValueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11690,31 +20623,29 @@ func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &TreePos{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11741,147 +20672,67 @@ func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &TreePos{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, &TimeTicket{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAtMapByActor == nil {
-				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
-			}
-			var mapkey string
-			var mapvalue *TimeTicket
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthResources
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &TimeTicket{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RHTNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
 			}
-			m.CreatedAtMapByActor[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RHTNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RHTNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11891,29 +20742,27 @@ func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contents = append(m.Contents, &TreeNodes{})
-			if err := m.Contents[len(m.Contents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11940,10 +20789,10 @@ func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.Element == nil {
+				m.Element = &JSONElement{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11969,7 +20818,7 @@ func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
+func (m *RGANode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11992,15 +20841,15 @@ func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeStyle: wiretype end group for non-group")
+			return fmt.Errorf("proto: RGANode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeStyle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RGANode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12027,16 +20876,16 @@ func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
+			if m.Next == nil {
+				m.Next = &RGANode{}
 			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12063,16 +20912,16 @@ func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &TreePos{}
+			if m.Element == nil {
+				m.Element = &JSONElement{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12099,16 +20948,16 @@ func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &TreePos{}
+			if m.PositionedAt == nil {
+				m.PositionedAt = &TimeTicket{}
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PositionedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VacatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12135,107 +20984,99 @@ func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
+			if m.VacatedAt == nil {
+				m.VacatedAt = &TimeTicket{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.VacatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeAttr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeAttr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeAttr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12262,10 +21103,10 @@ func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12291,7 +21132,7 @@ func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
+func (m *TextNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12314,15 +21155,15 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EditReverse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TextNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EditReverse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TextNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12349,56 +21190,18 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
+			if m.Id == nil {
+				m.Id = &TextNodeID{}
 			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromIdx", wireType)
-			}
-			m.FromIdx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FromIdx |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToIdx", wireType)
-			}
-			m.ToIdx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ToIdx |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12408,126 +21211,29 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAtMapByActor == nil {
-				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
-			}
-			var mapkey string
-			var mapvalue *TimeTicket
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthResources
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &TimeTicket{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.CreatedAtMapByActor[mapkey] = mapvalue
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12537,27 +21243,31 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12584,14 +21294,14 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.InsPrevId == nil {
+				m.InsPrevId = &TextNodeID{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
@@ -12621,10 +21331,10 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
+				m.Attributes = make(map[string]*NodeAttr)
 			}
 			var mapkey string
-			var mapvalue string
+			var mapvalue *NodeAttr
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -12673,7 +21383,7 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowResources
@@ -12683,24 +21393,26 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthResources
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthResources
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &NodeAttr{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipResources(dAtA[iNdEx:])
@@ -12716,8 +21428,114 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextNodeID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextNodeID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextNodeID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -12740,7 +21558,7 @@ func (m *Operation_EditReverse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
+func (m *TextAnnotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12763,17 +21581,17 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JSONElementSimple: wiretype end group for non-group")
+			return fmt.Errorf("proto: TextAnnotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONElementSimple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TextAnnotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12783,31 +21601,27 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12834,16 +21648,16 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
+			if m.From == nil {
+				m.From = &TextNodePos{}
 			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12870,18 +21684,18 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			if m.To == nil {
+				m.To = &TextNodePos{}
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12891,16 +21705,29 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12910,26 +21737,48 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &TimeTicket{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRemoved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRemoved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -12952,7 +21801,7 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement) Unmarshal(dAtA []byte) error {
+func (m *TreeNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12965,27 +21814,95 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &TreeNodeID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JSONElement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONElement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonObject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12995,30 +21912,27 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_JSONObject{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &JSONElement_JsonObject{v}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonArray", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13045,15 +21959,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_JSONArray{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_JsonArray{v}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Primitive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13080,15 +21995,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_Primitive{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InsPrevId == nil {
+				m.InsPrevId = &TreeNodeID{}
+			}
+			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_Primitive_{v}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsNextId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13115,15 +22031,35 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_Text{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InsNextId == nil {
+				m.InsNextId = &TreeNodeID{}
+			}
+			if err := m.InsNextId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_Text_{v}
 			iNdEx = postIndex
-		case 6:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13150,15 +22086,160 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_Counter{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]*NodeAttr)
 			}
-			m.Body = &JSONElement_Counter_{v}
+			var mapkey string
+			var mapvalue *NodeAttr
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NodeAttr{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreeNodes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeNodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeNodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13185,11 +22266,10 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_Tree{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Content = append(m.Content, &TreeNode{})
+			if err := m.Content[len(m.Content)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_Tree_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13213,7 +22293,7 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
+func (m *TreeNodeID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13236,15 +22316,15 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JSONObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeNodeID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeNodeID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13271,16 +22351,18 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &RHTNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var msglen int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13290,31 +22372,65 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Offset |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreePos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreePos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreePos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13341,16 +22457,16 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
+			if m.ParentId == nil {
+				m.ParentId = &TreeNodeID{}
 			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftSiblingId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13377,10 +22493,10 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			if m.LeftSiblingId == nil {
+				m.LeftSiblingId = &TreeNodeID{}
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LeftSiblingId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13406,7 +22522,7 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
+func (m *User) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13429,17 +22545,17 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JSONArray: wiretype end group for non-group")
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONArray: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13449,31 +22565,29 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &RGANode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13483,67 +22597,27 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
-			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13570,10 +22644,10 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13599,7 +22673,7 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13622,17 +22696,81 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Primitive: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Primitive: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13642,16 +22780,29 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13661,31 +22812,29 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13695,33 +22844,29 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthWebhookUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookMethods", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13731,33 +22876,29 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
-			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthWebhookMethods = append(m.AuthWebhookMethods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDeactivateThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13767,82 +22908,27 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientDeactivateThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Text: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13869,14 +22955,16 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &TextNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13903,18 +22991,18 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthJwtKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13924,33 +23012,29 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
-			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthJwtKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthJwksUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13960,84 +23044,29 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthJwksUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SensitivePresenceKeys", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14047,16 +23076,29 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SensitivePresenceKeys = append(m.SensitivePresenceKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventWebhookUrl", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14066,31 +23108,29 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.EventWebhookUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventWebhookEvents", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14100,33 +23140,29 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventWebhookEvents = append(m.EventWebhookEvents, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSizeSoftLimit", wireType)
 			}
-			var msglen int
+			m.DocumentSizeSoftLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14136,33 +23172,54 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DocumentSizeSoftLimit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSizeHardLimit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DocumentSizeHardLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentSizeHardLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeLogSoftLimit", wireType)
 			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChangeLogSoftLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeLogSoftLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeLogHardLimit", wireType)
 			}
-			var msglen int
+			m.ChangeLogHardLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14172,28 +23229,49 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChangeLogHardLimit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotThreshold", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SnapshotThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -14216,7 +23294,7 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_Tree) Unmarshal(dAtA []byte) error {
+func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14239,15 +23317,15 @@ func (m *JSONElement_Tree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tree: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatableProjectFields: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatableProjectFields: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14274,14 +23352,16 @@ func (m *JSONElement_Tree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &TreeNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Name == nil {
+				m.Name = &types.StringValue{}
+			}
+			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookUrl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14308,52 +23388,16 @@ func (m *JSONElement_Tree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.AuthWebhookUrl == nil {
+				m.AuthWebhookUrl = &types.StringValue{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthWebhookUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
-			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookMethods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14380,69 +23424,18 @@ func (m *JSONElement_Tree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RHTNode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RHTNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RHTNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if m.AuthWebhookMethods == nil {
+				m.AuthWebhookMethods = &UpdatableProjectFields_AuthWebhookMethods{}
+			}
+			if err := m.AuthWebhookMethods.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDeactivateThreshold", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14452,27 +23445,31 @@ func (m *RHTNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.ClientDeactivateThreshold == nil {
+				m.ClientDeactivateThreshold = &types.StringValue{}
+			}
+			if err := m.ClientDeactivateThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthJwtKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14499,67 +23496,16 @@ func (m *RHTNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Element == nil {
-				m.Element = &JSONElement{}
+			if m.AuthJwtKey == nil {
+				m.AuthJwtKey = &types.StringValue{}
 			}
-			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthJwtKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RGANode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RGANode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RGANode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthJwksUrl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14586,16 +23532,16 @@ func (m *RGANode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Next == nil {
-				m.Next = &RGANode{}
+			if m.AuthJwksUrl == nil {
+				m.AuthJwksUrl = &types.StringValue{}
 			}
-			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthJwksUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SensitivePresenceKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14622,69 +23568,54 @@ func (m *RGANode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Element == nil {
-				m.Element = &JSONElement{}
+			if m.SensitivePresenceKeys == nil {
+				m.SensitivePresenceKeys = &UpdatableProjectFields_SensitivePresenceKeys{}
 			}
-			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SensitivePresenceKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventWebhookUrl", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthResources
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeAttr) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.EventWebhookUrl == nil {
+				m.EventWebhookUrl = &types.StringValue{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeAttr: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeAttr: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.EventWebhookUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventWebhookEvents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14694,27 +23625,31 @@ func (m *NodeAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.EventWebhookEvents == nil {
+				m.EventWebhookEvents = &UpdatableProjectFields_EventWebhookEvents{}
+			}
+			if err := m.EventWebhookEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSizeSoftLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14741,67 +23676,52 @@ func (m *NodeAttr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = &TimeTicket{}
+			if m.DocumentSizeSoftLimit == nil {
+				m.DocumentSizeSoftLimit = &types.Int64Value{}
 			}
-			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DocumentSizeSoftLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentSizeHardLimit", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TextNode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthResources
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TextNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentSizeHardLimit == nil {
+				m.DocumentSizeHardLimit = &types.Int64Value{}
+			}
+			if err := m.DocumentSizeHardLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeLogSoftLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14828,18 +23748,18 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &TextNodeID{}
+			if m.ChangeLogSoftLimit == nil {
+				m.ChangeLogSoftLimit = &types.Int64Value{}
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangeLogSoftLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeLogHardLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14849,27 +23769,31 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.ChangeLogHardLimit == nil {
+				m.ChangeLogHardLimit = &types.Int64Value{}
+			}
+			if err := m.ChangeLogHardLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotThreshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14896,16 +23820,16 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			if m.SnapshotThreshold == nil {
+				m.SnapshotThreshold = &types.Int64Value{}
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SnapshotThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14932,18 +23856,69 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InsPrevId == nil {
-				m.InsPrevId = &TextNodeID{}
+			if m.SnapshotInterval == nil {
+				m.SnapshotInterval = &types.Int64Value{}
 			}
-			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SnapshotInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatableProjectFields_AuthWebhookMethods) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthWebhookMethods: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthWebhookMethods: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14953,120 +23928,106 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]*NodeAttr)
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *NodeAttr
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatableProjectFields_SensitivePresenceKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SensitivePresenceKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SensitivePresenceKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthResources
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &NodeAttr{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15090,7 +24051,7 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextNodeID) Unmarshal(dAtA []byte) error {
+func (m *UpdatableProjectFields_EventWebhookEvents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15113,17 +24074,17 @@ func (m *TextNodeID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextNodeID: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWebhookEvents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextNodeID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWebhookEvents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -15133,47 +24094,24 @@ func (m *TextNodeID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -15196,7 +24134,7 @@ func (m *TextNodeID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TreeNode) Unmarshal(dAtA []byte) error {
+func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15219,17 +24157,17 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -15239,31 +24177,27 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &TreeNodeID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15291,11 +24225,11 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15323,11 +24257,11 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Snapshot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15354,16 +24288,16 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15390,16 +24324,16 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InsPrevId == nil {
-				m.InsPrevId = &TreeNodeID{}
+			if m.AccessedAt == nil {
+				m.AccessedAt = &types.Timestamp{}
 			}
-			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsNextId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15426,35 +24360,16 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InsNextId == nil {
-				m.InsNextId = &TreeNodeID{}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
 			}
-			if err := m.InsNextId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
-			}
-			m.Depth = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Depth |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15481,11 +24396,11 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]*NodeAttr)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue *NodeAttr
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -15534,7 +24449,7 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowResources
@@ -15544,26 +24459,24 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthResources
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthResources
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &NodeAttr{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipResources(dAtA[iNdEx:])
@@ -15579,92 +24492,7 @@ func (m *TreeNode) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TreeNodes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TreeNodes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeNodes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Content = append(m.Content, &TreeNode{})
-			if err := m.Content[len(m.Content)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15688,7 +24516,7 @@ func (m *TreeNodes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TreeNodeID) Unmarshal(dAtA []byte) error {
+func (m *DocumentTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15711,17 +24539,17 @@ func (m *TreeNodeID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeNodeID: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeNodeID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -15731,33 +24559,29 @@ func (m *TreeNodeID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			m.Offset = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -15767,65 +24591,27 @@ func (m *TreeNodeID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TreePos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TreePos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreePos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15852,16 +24638,16 @@ func (m *TreePos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentId == nil {
-				m.ParentId = &TreeNodeID{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
 			}
-			if err := m.ParentId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeftSiblingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15888,10 +24674,10 @@ func (m *TreePos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LeftSiblingId == nil {
-				m.LeftSiblingId = &TreeNodeID{}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
 			}
-			if err := m.LeftSiblingId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15917,7 +24703,7 @@ func (m *TreePos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *User) Unmarshal(dAtA []byte) error {
+func (m *DocumentACL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15940,15 +24726,15 @@ func (m *User) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentACL: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentACL: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Readers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15976,11 +24762,11 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Readers = append(m.Readers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Writers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16008,13 +24794,13 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Writers = append(m.Writers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16024,28 +24810,44 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicRead", wireType)
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.PublicRead = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -16068,7 +24870,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *DocumentMemory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16091,10 +24893,10 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentMemory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentMemory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16131,7 +24933,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16159,13 +24961,13 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 			}
-			var stringLen uint64
+			m.Subscriptions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16175,29 +24977,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Subscriptions |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionBytes", wireType)
 			}
-			var stringLen uint64
+			m.SubscriptionBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16207,29 +24996,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SubscriptionBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookUrl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 			}
-			var stringLen uint64
+			m.Locks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16239,29 +25015,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Locks |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AuthWebhookUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookMethods", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBytes", wireType)
 			}
-			var stringLen uint64
+			m.LockBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16271,29 +25034,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LockBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AuthWebhookMethods = append(m.AuthWebhookMethods, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientDeactivateThreshold", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
-			var stringLen uint64
+			m.Snapshots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16303,65 +25053,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Snapshots |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientDeactivateThreshold = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotBytes", wireType)
 			}
-			var msglen int
+			m.SnapshotBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16371,28 +25072,11 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SnapshotBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = &types.Timestamp{}
-			}
-			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -16415,7 +25099,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
+func (m *ClientSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16438,17 +25122,17 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatableProjectFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatableProjectFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16458,33 +25142,29 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Name == nil {
-				m.Name = &types.StringValue{}
-			}
-			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16494,33 +25174,29 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthWebhookUrl == nil {
-				m.AuthWebhookUrl = &types.StringValue{}
-			}
-			if err := m.AuthWebhookUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookMethods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16530,31 +25206,27 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthWebhookMethods == nil {
-				m.AuthWebhookMethods = &UpdatableProjectFields_AuthWebhookMethods{}
-			}
-			if err := m.AuthWebhookMethods.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientDeactivateThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16581,69 +25253,54 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientDeactivateThreshold == nil {
-				m.ClientDeactivateThreshold = &types.StringValue{}
+			if m.Connection == nil {
+				m.Connection = &ConnectionInfo{}
 			}
-			if err := m.ClientDeactivateThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthResources
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdatableProjectFields_AuthWebhookMethods) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AuthWebhookMethods: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthWebhookMethods: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16653,23 +25310,27 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16693,7 +25354,7 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
+func (m *ConnectionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16716,15 +25377,15 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocumentSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocumentSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16752,11 +25413,11 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Ip = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAgent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16784,11 +25445,11 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.UserAgent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16816,47 +25477,81 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshot = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PresenceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PresenceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PresenceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PresenceChange_ChangeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16883,16 +25578,67 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessedAt == nil {
-				m.AccessedAt = &types.Timestamp{}
+			if m.Presence == nil {
+				m.Presence = &Presence{}
 			}
-			if err := m.AccessedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Presence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Presence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Presence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Presence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16919,12 +25665,103 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = &types.Timestamp{}
+			if m.Data == nil {
+				m.Data = make(map[string]string)
 			}
-			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Data[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16948,7 +25785,7 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PresenceChange) Unmarshal(dAtA []byte) error {
+func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16971,17 +25808,17 @@ func (m *PresenceChange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PresenceChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PresenceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			m.Type = 0
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -16991,16 +25828,16 @@ func (m *PresenceChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PresenceChange_ChangeType(b&0x7F) << shift
+				m.ServerSeq |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSeq", wireType)
 			}
-			var msglen int
+			m.ClientSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -17010,28 +25847,11 @@ func (m *PresenceChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClientSeq |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Presence == nil {
-				m.Presence = &Presence{}
-			}
-			if err := m.Presence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -17054,7 +25874,7 @@ func (m *PresenceChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Presence) Unmarshal(dAtA []byte) error {
+func (m *TextNodePos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17077,15 +25897,15 @@ func (m *Presence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Presence: wiretype end group for non-group")
+			return fmt.Errorf("proto: TextNodePos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Presence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TextNodePos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17097,119 +25917,66 @@ func (m *Presence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data == nil {
-				m.Data = make(map[string]string)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeOffset", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.RelativeOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeOffset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Data[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -17232,7 +25999,7 @@ func (m *Presence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Checkpoint) Unmarshal(dAtA []byte) error {
+func (m *TimeTicket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17255,17 +26022,17 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeTicket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeTicket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
 			}
-			m.ServerSeq = 0
+			m.Lamport = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -17275,16 +26042,16 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServerSeq |= int64(b&0x7F) << shift
+				m.Lamport |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSeq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
 			}
-			m.ClientSeq = 0
+			m.Delimiter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -17294,11 +26061,85 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientSeq |= uint32(b&0x7F) << shift
+				m.Delimiter |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = append(m.ActorId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ActorId == nil {
+				m.ActorId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorIndex", wireType)
+			}
+			m.ActorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LamportDelta", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.LamportDelta = int64(v)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -17321,7 +26162,7 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextNodePos) Unmarshal(dAtA []byte) error {
+func (m *DocEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17344,17 +26185,36 @@ func (m *TextNodePos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextNodePos: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextNodePos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= DocEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -17364,33 +26224,29 @@ func (m *TextNodePos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Publisher = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
 			}
-			m.Offset = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -17400,16 +26256,124 @@ func (m *TextNodePos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelativeOffset", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthResources
 			}
-			m.RelativeOffset = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Presence == nil {
+				m.Presence = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Presence[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -17419,11 +26383,24 @@ func (m *TextNodePos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RelativeOffset |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -17446,7 +26423,7 @@ func (m *TextNodePos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimeTicket) Unmarshal(dAtA []byte) error {
+func (m *DocumentExport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17469,17 +26446,17 @@ func (m *TimeTicket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimeTicket: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentExport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimeTicket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentExport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
-			m.Lamport = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -17489,16 +26466,29 @@ func (m *TimeTicket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Lamport |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			m.Delimiter = 0
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -17508,14 +26498,14 @@ func (m *TimeTicket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delimiter |= uint32(b&0x7F) << shift
+				m.ServerSeq |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -17542,86 +26532,16 @@ func (m *TimeTicket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = append(m.ActorId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ActorId == nil {
-				m.ActorId = []byte{}
+			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.Snapshot == nil {
+				m.Snapshot = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DocEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DocEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= DocEventType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -17631,23 +26551,25 @@ func (m *DocEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Publisher = string(dAtA[iNdEx:postIndex])
+			m.Changes = append(m.Changes, &Change{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
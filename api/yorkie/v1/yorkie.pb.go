@@ -74,7 +74,10 @@ func (m *ActivateClientRequest) GetClientKey() string {
 }
 
 type ActivateClientResponse struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// compact_encoding tells that the server accepts the change packs whose
+	// tickets are encoded compactly.
+	CompactEncoding      bool     `protobuf:"varint,2,opt,name=compact_encoding,json=compactEncoding,proto3" json:"compact_encoding,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -120,6 +123,13 @@ func (m *ActivateClientResponse) GetClientId() string {
 	return ""
 }
 
+func (m *ActivateClientResponse) GetCompactEncoding() bool {
+	if m != nil {
+		return m.CompactEncoding
+	}
+	return false
+}
+
 type DeactivateClientRequest struct {
 	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -207,11 +217,13 @@ func (m *DeactivateClientResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_DeactivateClientResponse proto.InternalMessageInfo
 
 type AttachDocumentRequest struct {
-	ClientId             string      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	ClientId             string            `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack       `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PathFilter           string            `protobuf:"bytes,4,opt,name=path_filter,json=pathFilter,proto3" json:"path_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *AttachDocumentRequest) Reset()         { *m = AttachDocumentRequest{} }
@@ -261,6 +273,20 @@ func (m *AttachDocumentRequest) GetChangePack() *ChangePack {
 	return nil
 }
 
+func (m *AttachDocumentRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *AttachDocumentRequest) GetPathFilter() string {
+	if m != nil {
+		return m.PathFilter
+	}
+	return ""
+}
+
 type AttachDocumentResponse struct {
 	DocumentId           string      `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
 	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
@@ -435,8 +461,12 @@ func (m *DetachDocumentResponse) GetChangePack() *ChangePack {
 }
 
 type WatchDocumentRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	DocumentId           string   `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	ClientId   string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	DocumentId string `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// query is the path of the value that the server evaluates after changes,
+	// such as "$.todos.0.title". If it is given, the stream delivers the value
+	// instead of the document changed events.
+	Query                string   `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -489,10 +519,19 @@ func (m *WatchDocumentRequest) GetDocumentId() string {
 	return ""
 }
 
+func (m *WatchDocumentRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
 type WatchDocumentResponse struct {
 	// Types that are valid to be assigned to Body:
+	//
 	//	*WatchDocumentResponse_Initialization_
 	//	*WatchDocumentResponse_Event
+	//	*WatchDocumentResponse_QueryResult_
 	Body                 isWatchDocumentResponse_Body `protobuf_oneof:"body"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
@@ -544,9 +583,13 @@ type WatchDocumentResponse_Initialization_ struct {
 type WatchDocumentResponse_Event struct {
 	Event *DocEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
 }
+type WatchDocumentResponse_QueryResult_ struct {
+	QueryResult *WatchDocumentResponse_QueryResult `protobuf:"bytes,3,opt,name=query_result,json=queryResult,proto3,oneof" json:"query_result,omitempty"`
+}
 
 func (*WatchDocumentResponse_Initialization_) isWatchDocumentResponse_Body() {}
 func (*WatchDocumentResponse_Event) isWatchDocumentResponse_Body()           {}
+func (*WatchDocumentResponse_QueryResult_) isWatchDocumentResponse_Body()    {}
 
 func (m *WatchDocumentResponse) GetBody() isWatchDocumentResponse_Body {
 	if m != nil {
@@ -569,11 +612,19 @@ func (m *WatchDocumentResponse) GetEvent() *DocEvent {
 	return nil
 }
 
+func (m *WatchDocumentResponse) GetQueryResult() *WatchDocumentResponse_QueryResult {
+	if x, ok := m.GetBody().(*WatchDocumentResponse_QueryResult_); ok {
+		return x.QueryResult
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*WatchDocumentResponse) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*WatchDocumentResponse_Initialization_)(nil),
 		(*WatchDocumentResponse_Event)(nil),
+		(*WatchDocumentResponse_QueryResult_)(nil),
 	}
 }
 
@@ -624,27 +675,27 @@ func (m *WatchDocumentResponse_Initialization) GetClientIds() []string {
 	return nil
 }
 
-type RemoveDocumentRequest struct {
-	ClientId             string      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	DocumentId           string      `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,3,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type WatchDocumentResponse_QueryResult struct {
+	// value is the JSON encoding of the value of the query.
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	ServerSeq            int64    `protobuf:"varint,2,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveDocumentRequest) Reset()         { *m = RemoveDocumentRequest{} }
-func (m *RemoveDocumentRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveDocumentRequest) ProtoMessage()    {}
-func (*RemoveDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40070c858814ab24, []int{10}
+func (m *WatchDocumentResponse_QueryResult) Reset()         { *m = WatchDocumentResponse_QueryResult{} }
+func (m *WatchDocumentResponse_QueryResult) String() string { return proto.CompactTextString(m) }
+func (*WatchDocumentResponse_QueryResult) ProtoMessage()    {}
+func (*WatchDocumentResponse_QueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{9, 1}
 }
-func (m *RemoveDocumentRequest) XXX_Unmarshal(b []byte) error {
+func (m *WatchDocumentResponse_QueryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchDocumentResponse_QueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveDocumentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchDocumentResponse_QueryResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -654,58 +705,105 @@ func (m *RemoveDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *RemoveDocumentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveDocumentRequest.Merge(m, src)
+func (m *WatchDocumentResponse_QueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDocumentResponse_QueryResult.Merge(m, src)
 }
-func (m *RemoveDocumentRequest) XXX_Size() int {
+func (m *WatchDocumentResponse_QueryResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveDocumentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveDocumentRequest.DiscardUnknown(m)
+func (m *WatchDocumentResponse_QueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDocumentResponse_QueryResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveDocumentRequest proto.InternalMessageInfo
+var xxx_messageInfo_WatchDocumentResponse_QueryResult proto.InternalMessageInfo
 
-func (m *RemoveDocumentRequest) GetClientId() string {
+func (m *WatchDocumentResponse_QueryResult) GetValue() string {
 	if m != nil {
-		return m.ClientId
+		return m.Value
 	}
 	return ""
 }
 
-func (m *RemoveDocumentRequest) GetDocumentId() string {
+func (m *WatchDocumentResponse_QueryResult) GetServerSeq() int64 {
 	if m != nil {
-		return m.DocumentId
+		return m.ServerSeq
+	}
+	return 0
+}
+
+type HeartbeatRequest struct {
+	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	DocumentId           string   `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeartbeatRequest) Reset()         { *m = HeartbeatRequest{} }
+func (m *HeartbeatRequest) String() string { return proto.CompactTextString(m) }
+func (*HeartbeatRequest) ProtoMessage()    {}
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{10}
+}
+func (m *HeartbeatRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeartbeatRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeartbeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatRequest.Merge(m, src)
+}
+func (m *HeartbeatRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeartbeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeartbeatRequest proto.InternalMessageInfo
+
+func (m *HeartbeatRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
 	return ""
 }
 
-func (m *RemoveDocumentRequest) GetChangePack() *ChangePack {
+func (m *HeartbeatRequest) GetDocumentId() string {
 	if m != nil {
-		return m.ChangePack
+		return m.DocumentId
 	}
-	return nil
+	return ""
 }
 
-type RemoveDocumentResponse struct {
-	ChangePack           *ChangePack `protobuf:"bytes,1,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type HeartbeatResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveDocumentResponse) Reset()         { *m = RemoveDocumentResponse{} }
-func (m *RemoveDocumentResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveDocumentResponse) ProtoMessage()    {}
-func (*RemoveDocumentResponse) Descriptor() ([]byte, []int) {
+func (m *HeartbeatResponse) Reset()         { *m = HeartbeatResponse{} }
+func (m *HeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*HeartbeatResponse) ProtoMessage()    {}
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_40070c858814ab24, []int{11}
 }
-func (m *RemoveDocumentResponse) XXX_Unmarshal(b []byte) error {
+func (m *HeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveDocumentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HeartbeatResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -715,47 +813,39 @@ func (m *RemoveDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *RemoveDocumentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveDocumentResponse.Merge(m, src)
+func (m *HeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatResponse.Merge(m, src)
 }
-func (m *RemoveDocumentResponse) XXX_Size() int {
+func (m *HeartbeatResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveDocumentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveDocumentResponse.DiscardUnknown(m)
+func (m *HeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveDocumentResponse proto.InternalMessageInfo
-
-func (m *RemoveDocumentResponse) GetChangePack() *ChangePack {
-	if m != nil {
-		return m.ChangePack
-	}
-	return nil
-}
+var xxx_messageInfo_HeartbeatResponse proto.InternalMessageInfo
 
-type PushPullChangesRequest struct {
-	ClientId             string      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	DocumentId           string      `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,3,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	PushOnly             bool        `protobuf:"varint,4,opt,name=push_only,json=pushOnly,proto3" json:"push_only,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type UpdatePresenceRequest struct {
+	ClientId             string            `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	DocumentId           string            `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Presence             map[string]string `protobuf:"bytes,3,rep,name=presence,proto3" json:"presence,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *PushPullChangesRequest) Reset()         { *m = PushPullChangesRequest{} }
-func (m *PushPullChangesRequest) String() string { return proto.CompactTextString(m) }
-func (*PushPullChangesRequest) ProtoMessage()    {}
-func (*PushPullChangesRequest) Descriptor() ([]byte, []int) {
+func (m *UpdatePresenceRequest) Reset()         { *m = UpdatePresenceRequest{} }
+func (m *UpdatePresenceRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePresenceRequest) ProtoMessage()    {}
+func (*UpdatePresenceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_40070c858814ab24, []int{12}
 }
-func (m *PushPullChangesRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdatePresenceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PushPullChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdatePresenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PushPullChangesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdatePresenceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -765,65 +855,97 @@ func (m *PushPullChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *PushPullChangesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushPullChangesRequest.Merge(m, src)
+func (m *UpdatePresenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePresenceRequest.Merge(m, src)
 }
-func (m *PushPullChangesRequest) XXX_Size() int {
+func (m *UpdatePresenceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PushPullChangesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushPullChangesRequest.DiscardUnknown(m)
+func (m *UpdatePresenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePresenceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushPullChangesRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdatePresenceRequest proto.InternalMessageInfo
 
-func (m *PushPullChangesRequest) GetClientId() string {
+func (m *UpdatePresenceRequest) GetClientId() string {
 	if m != nil {
 		return m.ClientId
 	}
 	return ""
 }
 
-func (m *PushPullChangesRequest) GetDocumentId() string {
+func (m *UpdatePresenceRequest) GetDocumentId() string {
 	if m != nil {
 		return m.DocumentId
 	}
 	return ""
 }
 
-func (m *PushPullChangesRequest) GetChangePack() *ChangePack {
+func (m *UpdatePresenceRequest) GetPresence() map[string]string {
 	if m != nil {
-		return m.ChangePack
+		return m.Presence
 	}
 	return nil
 }
 
-func (m *PushPullChangesRequest) GetPushOnly() bool {
-	if m != nil {
-		return m.PushOnly
+type UpdatePresenceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdatePresenceResponse) Reset()         { *m = UpdatePresenceResponse{} }
+func (m *UpdatePresenceResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdatePresenceResponse) ProtoMessage()    {}
+func (*UpdatePresenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{13}
+}
+func (m *UpdatePresenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePresenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePresenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *UpdatePresenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePresenceResponse.Merge(m, src)
+}
+func (m *UpdatePresenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePresenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePresenceResponse.DiscardUnknown(m)
 }
 
-type PushPullChangesResponse struct {
-	ChangePack           *ChangePack `protobuf:"bytes,1,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+var xxx_messageInfo_UpdatePresenceResponse proto.InternalMessageInfo
+
+type ReadDocumentRequest struct {
+	DocumentKey          string   `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PushPullChangesResponse) Reset()         { *m = PushPullChangesResponse{} }
-func (m *PushPullChangesResponse) String() string { return proto.CompactTextString(m) }
-func (*PushPullChangesResponse) ProtoMessage()    {}
-func (*PushPullChangesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40070c858814ab24, []int{13}
+func (m *ReadDocumentRequest) Reset()         { *m = ReadDocumentRequest{} }
+func (m *ReadDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadDocumentRequest) ProtoMessage()    {}
+func (*ReadDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{14}
 }
-func (m *PushPullChangesResponse) XXX_Unmarshal(b []byte) error {
+func (m *ReadDocumentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PushPullChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReadDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PushPullChangesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReadDocumentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -833,1407 +955,4954 @@ func (m *PushPullChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *PushPullChangesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushPullChangesResponse.Merge(m, src)
+func (m *ReadDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDocumentRequest.Merge(m, src)
 }
-func (m *PushPullChangesResponse) XXX_Size() int {
+func (m *ReadDocumentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PushPullChangesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushPullChangesResponse.DiscardUnknown(m)
+func (m *ReadDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDocumentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushPullChangesResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReadDocumentRequest proto.InternalMessageInfo
 
-func (m *PushPullChangesResponse) GetChangePack() *ChangePack {
+func (m *ReadDocumentRequest) GetDocumentKey() string {
 	if m != nil {
-		return m.ChangePack
+		return m.DocumentKey
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*ActivateClientRequest)(nil), "yorkie.v1.ActivateClientRequest")
-	proto.RegisterType((*ActivateClientResponse)(nil), "yorkie.v1.ActivateClientResponse")
-	proto.RegisterType((*DeactivateClientRequest)(nil), "yorkie.v1.DeactivateClientRequest")
-	proto.RegisterType((*DeactivateClientResponse)(nil), "yorkie.v1.DeactivateClientResponse")
-	proto.RegisterType((*AttachDocumentRequest)(nil), "yorkie.v1.AttachDocumentRequest")
-	proto.RegisterType((*AttachDocumentResponse)(nil), "yorkie.v1.AttachDocumentResponse")
-	proto.RegisterType((*DetachDocumentRequest)(nil), "yorkie.v1.DetachDocumentRequest")
-	proto.RegisterType((*DetachDocumentResponse)(nil), "yorkie.v1.DetachDocumentResponse")
-	proto.RegisterType((*WatchDocumentRequest)(nil), "yorkie.v1.WatchDocumentRequest")
-	proto.RegisterType((*WatchDocumentResponse)(nil), "yorkie.v1.WatchDocumentResponse")
-	proto.RegisterType((*WatchDocumentResponse_Initialization)(nil), "yorkie.v1.WatchDocumentResponse.Initialization")
-	proto.RegisterType((*RemoveDocumentRequest)(nil), "yorkie.v1.RemoveDocumentRequest")
-	proto.RegisterType((*RemoveDocumentResponse)(nil), "yorkie.v1.RemoveDocumentResponse")
-	proto.RegisterType((*PushPullChangesRequest)(nil), "yorkie.v1.PushPullChangesRequest")
-	proto.RegisterType((*PushPullChangesResponse)(nil), "yorkie.v1.PushPullChangesResponse")
+type ReadDocumentResponse struct {
+	// root is the JSON of the root of the document at server_seq.
+	Root                 string   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	ServerSeq            int64    `protobuf:"varint,2,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("yorkie/v1/yorkie.proto", fileDescriptor_40070c858814ab24) }
-
-var fileDescriptor_40070c858814ab24 = []byte{
-	// 650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xce, 0xf6, 0x9f, 0x9a, 0x89, 0xda, 0xdf, 0x8f, 0x2d, 0x49, 0x43, 0x2a, 0x52, 0xb3, 0x5c,
-	0x2a, 0x55, 0x72, 0x48, 0x2b, 0x7a, 0xe1, 0xd4, 0x36, 0x48, 0x8d, 0x90, 0x20, 0x18, 0x44, 0xd5,
-	0x4a, 0xc8, 0x72, 0xed, 0x2d, 0x59, 0xc5, 0xf5, 0xa6, 0xf6, 0xda, 0x92, 0x79, 0x00, 0x6e, 0xdc,
-	0x79, 0x07, 0xde, 0x82, 0x13, 0x47, 0x8e, 0x1c, 0x51, 0x79, 0x11, 0x14, 0xdb, 0x4d, 0xbd, 0xee,
-	0x92, 0x16, 0x8a, 0x04, 0xb7, 0xcd, 0xec, 0x37, 0xdf, 0x7c, 0x3b, 0x99, 0xf9, 0x64, 0xa8, 0xc5,
-	0xdc, 0x1f, 0x30, 0xda, 0x8a, 0xda, 0xad, 0xf4, 0xa4, 0x0f, 0x7d, 0x2e, 0x38, 0x2e, 0x67, 0xbf,
-	0xa2, 0x76, 0xe3, 0xce, 0x05, 0xc4, 0xa7, 0x01, 0x0f, 0x7d, 0x9b, 0x06, 0x29, 0x8a, 0x6c, 0x41,
-	0x75, 0xdb, 0x16, 0x2c, 0xb2, 0x04, 0xdd, 0x75, 0x19, 0xf5, 0x84, 0x41, 0x4f, 0x43, 0x1a, 0x08,
-	0x7c, 0x17, 0xc0, 0x4e, 0x02, 0xe6, 0x80, 0xc6, 0x75, 0xa4, 0xa1, 0xb5, 0xb2, 0x51, 0x4e, 0x23,
-	0x4f, 0x68, 0x4c, 0x1e, 0x42, 0xad, 0x98, 0x17, 0x0c, 0xb9, 0x17, 0x50, 0xbc, 0x02, 0x19, 0xcc,
-	0x64, 0x4e, 0x96, 0x37, 0x9f, 0x06, 0xba, 0x0e, 0xd9, 0x82, 0xe5, 0x0e, 0xb5, 0x94, 0x05, 0x27,
-	0xe6, 0x35, 0xa0, 0x7e, 0x39, 0x2f, 0x2d, 0x48, 0x5c, 0xa8, 0x6e, 0x0b, 0x61, 0xd9, 0xfd, 0x0e,
-	0xb7, 0xc3, 0x93, 0x6b, 0x32, 0xe2, 0x2d, 0xa8, 0xd8, 0x7d, 0xcb, 0x7b, 0x43, 0xcd, 0xa1, 0x65,
-	0x0f, 0xea, 0x53, 0x1a, 0x5a, 0xab, 0x6c, 0x54, 0xf5, 0x71, 0xd3, 0xf4, 0xdd, 0xe4, 0xb6, 0x67,
-	0xd9, 0x03, 0x03, 0xec, 0xf1, 0x99, 0x9c, 0x42, 0xad, 0x58, 0x2d, 0x7b, 0xf8, 0x2a, 0x54, 0x9c,
-	0x2c, 0x76, 0x51, 0x10, 0xce, 0x43, 0x37, 0x28, 0xf9, 0x09, 0x41, 0xb5, 0x43, 0x7f, 0xf9, 0x85,
-	0x05, 0x3d, 0x53, 0x57, 0xe9, 0x99, 0xbe, 0xa6, 0x1e, 0xbc, 0x09, 0x35, 0x9f, 0x9e, 0xf0, 0x88,
-	0x9a, 0xec, 0xd8, 0xf4, 0xb8, 0x30, 0xad, 0xa4, 0x21, 0xd4, 0xa9, 0xcf, 0x68, 0x68, 0x6d, 0xde,
-	0x58, 0x4a, 0x6f, 0xbb, 0xc7, 0x4f, 0xb9, 0xd8, 0xce, 0xae, 0x48, 0x0f, 0x6a, 0xc5, 0x37, 0x64,
-	0x7d, 0xfb, 0xdd, 0xb6, 0xbc, 0x84, 0xdb, 0xfb, 0x96, 0xf8, 0xc3, 0x4d, 0x21, 0x5f, 0x11, 0x54,
-	0x0b, 0xb4, 0x99, 0xce, 0x03, 0x58, 0x64, 0x1e, 0x13, 0xcc, 0x72, 0xd9, 0x5b, 0x4b, 0x30, 0xee,
-	0x25, 0xe4, 0x95, 0x8d, 0x56, 0x4e, 0xaa, 0x32, 0x53, 0xef, 0x4a, 0x69, 0x7b, 0x25, 0xa3, 0x40,
-	0x84, 0xd7, 0x61, 0x96, 0x46, 0xd4, 0x13, 0xd9, 0xe3, 0x97, 0x72, 0x8c, 0x1d, 0x6e, 0x3f, 0x1e,
-	0x5d, 0xed, 0x95, 0x8c, 0x14, 0xd3, 0x68, 0xc1, 0xa2, 0x4c, 0x98, 0xdb, 0x55, 0xe6, 0x04, 0x75,
-	0xa4, 0x4d, 0x5f, 0xec, 0x6a, 0xd7, 0x09, 0x76, 0xe6, 0x60, 0xe6, 0x88, 0x3b, 0x31, 0x79, 0x8f,
-	0xa0, 0x6a, 0x24, 0x7f, 0xcd, 0x3f, 0x31, 0x47, 0xa3, 0x91, 0x28, 0xca, 0x51, 0x8f, 0x04, 0xba,
-	0x2e, 0xe3, 0x47, 0x04, 0xb5, 0x5e, 0x18, 0xf4, 0x7b, 0xa1, 0xeb, 0xa6, 0x90, 0xe0, 0xef, 0xae,
-	0xca, 0x0a, 0x94, 0x87, 0x61, 0xd0, 0x37, 0xb9, 0xe7, 0xc6, 0xd9, 0x76, 0xcc, 0x8f, 0x02, 0xcf,
-	0x3c, 0x37, 0x26, 0xcf, 0x61, 0xf9, 0x92, 0xd8, 0x9b, 0x35, 0x60, 0xe3, 0xdd, 0x2c, 0x2c, 0x1c,
-	0x24, 0xa0, 0x17, 0xd4, 0x8f, 0x98, 0x4d, 0xf1, 0x3e, 0x2c, 0xca, 0x46, 0x8d, 0xb5, 0x1c, 0x8d,
-	0xd2, 0xfb, 0x1b, 0xf7, 0x26, 0x20, 0x32, 0xd3, 0x2d, 0xe1, 0xd7, 0xf0, 0x7f, 0xd1, 0x92, 0x31,
-	0xc9, 0x0f, 0xae, 0xda, 0xe7, 0x1b, 0xf7, 0x27, 0x62, 0xc6, 0xf4, 0x23, 0xdd, 0x92, 0xcf, 0xca,
-	0xba, 0x55, 0x86, 0x2f, 0xeb, 0x56, 0x9a, 0x74, 0x4a, 0x2c, 0x1b, 0x91, 0x44, 0xac, 0xf4, 0x59,
-	0x89, 0x58, 0xed, 0x62, 0x29, 0xb1, 0x3c, 0xce, 0x12, 0xb1, 0x72, 0xf1, 0x24, 0x62, 0xf5, 0x2e,
-	0x90, 0x12, 0x3e, 0x84, 0xff, 0x0a, 0x73, 0x82, 0xf3, 0x79, 0xea, 0x81, 0x6f, 0x90, 0x49, 0x90,
-	0x31, 0xf7, 0x2b, 0x58, 0x90, 0x3c, 0x0b, 0xaf, 0xfe, 0xdc, 0xcd, 0x52, 0x5e, 0xed, 0x2a, 0xbb,
-	0x23, 0xa5, 0x07, 0x68, 0x67, 0xfd, 0xf3, 0x59, 0x13, 0x7d, 0x39, 0x6b, 0xa2, 0x6f, 0x67, 0x4d,
-	0xf4, 0xe1, 0x7b, 0xb3, 0x04, 0xb7, 0x1c, 0x1a, 0x9d, 0xa7, 0x5a, 0x43, 0xa6, 0x47, 0xed, 0x1e,
-	0x3a, 0x9c, 0xd1, 0x1f, 0x45, 0xed, 0xa3, 0xb9, 0xe4, 0x5b, 0x64, 0xf3, 0x47, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x8c, 0xb9, 0x33, 0xc1, 0xcb, 0x08, 0x00, 0x00,
+func (m *ReadDocumentResponse) Reset()         { *m = ReadDocumentResponse{} }
+func (m *ReadDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadDocumentResponse) ProtoMessage()    {}
+func (*ReadDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{15}
+}
+func (m *ReadDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDocumentResponse.Merge(m, src)
+}
+func (m *ReadDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDocumentResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_ReadDocumentResponse proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ReadDocumentResponse) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
 
-// YorkieServiceClient is the client API for YorkieService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type YorkieServiceClient interface {
-	ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error)
-	DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error)
-	AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error)
-	DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error)
-	RemoveDocument(ctx context.Context, in *RemoveDocumentRequest, opts ...grpc.CallOption) (*RemoveDocumentResponse, error)
-	PushPullChanges(ctx context.Context, in *PushPullChangesRequest, opts ...grpc.CallOption) (*PushPullChangesResponse, error)
-	WatchDocument(ctx context.Context, in *WatchDocumentRequest, opts ...grpc.CallOption) (YorkieService_WatchDocumentClient, error)
+func (m *ReadDocumentResponse) GetServerSeq() int64 {
+	if m != nil {
+		return m.ServerSeq
+	}
+	return 0
 }
 
-type yorkieServiceClient struct {
-	cc *grpc.ClientConn
+type RemoveDocumentRequest struct {
+	ClientId             string      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	DocumentId           string      `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,3,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func NewYorkieServiceClient(cc *grpc.ClientConn) YorkieServiceClient {
-	return &yorkieServiceClient{cc}
+func (m *RemoveDocumentRequest) Reset()         { *m = RemoveDocumentRequest{} }
+func (m *RemoveDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDocumentRequest) ProtoMessage()    {}
+func (*RemoveDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{16}
 }
-
-func (c *yorkieServiceClient) ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error) {
-	out := new(ActivateClientResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/ActivateClient", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RemoveDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *yorkieServiceClient) DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error) {
-	out := new(DeactivateClientResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/DeactivateClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *yorkieServiceClient) AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error) {
-	out := new(AttachDocumentResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/AttachDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RemoveDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDocumentRequest.Merge(m, src)
 }
-
-func (c *yorkieServiceClient) DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error) {
-	out := new(DetachDocumentResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/DetachDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RemoveDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDocumentRequest.DiscardUnknown(m)
 }
 
-func (c *yorkieServiceClient) RemoveDocument(ctx context.Context, in *RemoveDocumentRequest, opts ...grpc.CallOption) (*RemoveDocumentResponse, error) {
-	out := new(RemoveDocumentResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/RemoveDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveDocumentRequest proto.InternalMessageInfo
+
+func (m *RemoveDocumentRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *yorkieServiceClient) PushPullChanges(ctx context.Context, in *PushPullChangesRequest, opts ...grpc.CallOption) (*PushPullChangesResponse, error) {
-	out := new(PushPullChangesResponse)
-	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/PushPullChanges", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveDocumentRequest) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *yorkieServiceClient) WatchDocument(ctx context.Context, in *WatchDocumentRequest, opts ...grpc.CallOption) (YorkieService_WatchDocumentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_YorkieService_serviceDesc.Streams[0], "/yorkie.v1.YorkieService/WatchDocument", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &yorkieServiceWatchDocumentClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RemoveDocumentRequest) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
 	}
-	return x, nil
+	return nil
 }
 
-type YorkieService_WatchDocumentClient interface {
-	Recv() (*WatchDocumentResponse, error)
-	grpc.ClientStream
+type RemoveDocumentResponse struct {
+	ChangePack           *ChangePack `protobuf:"bytes,1,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-type yorkieServiceWatchDocumentClient struct {
-	grpc.ClientStream
+func (m *RemoveDocumentResponse) Reset()         { *m = RemoveDocumentResponse{} }
+func (m *RemoveDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDocumentResponse) ProtoMessage()    {}
+func (*RemoveDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{17}
 }
-
-func (x *yorkieServiceWatchDocumentClient) Recv() (*WatchDocumentResponse, error) {
-	m := new(WatchDocumentResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *RemoveDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-// YorkieServiceServer is the server API for YorkieService service.
-type YorkieServiceServer interface {
-	ActivateClient(context.Context, *ActivateClientRequest) (*ActivateClientResponse, error)
-	DeactivateClient(context.Context, *DeactivateClientRequest) (*DeactivateClientResponse, error)
-	AttachDocument(context.Context, *AttachDocumentRequest) (*AttachDocumentResponse, error)
-	DetachDocument(context.Context, *DetachDocumentRequest) (*DetachDocumentResponse, error)
-	RemoveDocument(context.Context, *RemoveDocumentRequest) (*RemoveDocumentResponse, error)
-	PushPullChanges(context.Context, *PushPullChangesRequest) (*PushPullChangesResponse, error)
-	WatchDocument(*WatchDocumentRequest, YorkieService_WatchDocumentServer) error
+func (m *RemoveDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDocumentResponse.Merge(m, src)
+}
+func (m *RemoveDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDocumentResponse.DiscardUnknown(m)
 }
 
-// UnimplementedYorkieServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedYorkieServiceServer struct {
+var xxx_messageInfo_RemoveDocumentResponse proto.InternalMessageInfo
+
+func (m *RemoveDocumentResponse) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
+	}
+	return nil
 }
 
-func (*UnimplementedYorkieServiceServer) ActivateClient(ctx context.Context, req *ActivateClientRequest) (*ActivateClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateClient not implemented")
+type PushPullChangesRequest struct {
+	ClientId             string      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	DocumentId           string      `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,3,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	PushOnly             bool        `protobuf:"varint,4,opt,name=push_only,json=pushOnly,proto3" json:"push_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (*UnimplementedYorkieServiceServer) DeactivateClient(ctx context.Context, req *DeactivateClientRequest) (*DeactivateClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateClient not implemented")
+
+func (m *PushPullChangesRequest) Reset()         { *m = PushPullChangesRequest{} }
+func (m *PushPullChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*PushPullChangesRequest) ProtoMessage()    {}
+func (*PushPullChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{18}
 }
-func (*UnimplementedYorkieServiceServer) AttachDocument(ctx context.Context, req *AttachDocumentRequest) (*AttachDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttachDocument not implemented")
+func (m *PushPullChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedYorkieServiceServer) DetachDocument(ctx context.Context, req *DetachDocumentRequest) (*DetachDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DetachDocument not implemented")
+func (m *PushPullChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushPullChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedYorkieServiceServer) RemoveDocument(ctx context.Context, req *RemoveDocumentRequest) (*RemoveDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveDocument not implemented")
+func (m *PushPullChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPullChangesRequest.Merge(m, src)
 }
-func (*UnimplementedYorkieServiceServer) PushPullChanges(ctx context.Context, req *PushPullChangesRequest) (*PushPullChangesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PushPullChanges not implemented")
+func (m *PushPullChangesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedYorkieServiceServer) WatchDocument(req *WatchDocumentRequest, srv YorkieService_WatchDocumentServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchDocument not implemented")
+func (m *PushPullChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPullChangesRequest.DiscardUnknown(m)
 }
 
-func RegisterYorkieServiceServer(s *grpc.Server, srv YorkieServiceServer) {
-	s.RegisterService(&_YorkieService_serviceDesc, srv)
-}
+var xxx_messageInfo_PushPullChangesRequest proto.InternalMessageInfo
 
-func _YorkieService_ActivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateClientRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServiceServer).ActivateClient(ctx, in)
+func (m *PushPullChangesRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.YorkieService/ActivateClient",
+	return ""
+}
+
+func (m *PushPullChangesRequest) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServiceServer).ActivateClient(ctx, req.(*ActivateClientRequest))
+	return ""
+}
+
+func (m *PushPullChangesRequest) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _YorkieService_DeactivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeactivateClientRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PushPullChangesRequest) GetPushOnly() bool {
+	if m != nil {
+		return m.PushOnly
 	}
-	if interceptor == nil {
-		return srv.(YorkieServiceServer).DeactivateClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.YorkieService/DeactivateClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServiceServer).DeactivateClient(ctx, req.(*DeactivateClientRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _YorkieService_AttachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttachDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServiceServer).AttachDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.YorkieService/AttachDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServiceServer).AttachDocument(ctx, req.(*AttachDocumentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type PushPullChangesResponse struct {
+	ChangePack           *ChangePack `protobuf:"bytes,1,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func _YorkieService_DetachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DetachDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServiceServer).DetachDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.YorkieService/DetachDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServiceServer).DetachDocument(ctx, req.(*DetachDocumentRequest))
+func (m *PushPullChangesResponse) Reset()         { *m = PushPullChangesResponse{} }
+func (m *PushPullChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*PushPullChangesResponse) ProtoMessage()    {}
+func (*PushPullChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{19}
+}
+func (m *PushPullChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushPullChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushPullChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PushPullChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPullChangesResponse.Merge(m, src)
+}
+func (m *PushPullChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushPullChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPullChangesResponse.DiscardUnknown(m)
 }
 
-func _YorkieService_RemoveDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServiceServer).RemoveDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.YorkieService/RemoveDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServiceServer).RemoveDocument(ctx, req.(*RemoveDocumentRequest))
+var xxx_messageInfo_PushPullChangesResponse proto.InternalMessageInfo
+
+func (m *PushPullChangesResponse) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _YorkieService_PushPullChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushPullChangesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServiceServer).PushPullChanges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/yorkie.v1.YorkieService/PushPullChanges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServiceServer).PushPullChanges(ctx, req.(*PushPullChangesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DocumentChangePack struct {
+	DocumentId           string      `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func _YorkieService_WatchDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchDocumentRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *DocumentChangePack) Reset()         { *m = DocumentChangePack{} }
+func (m *DocumentChangePack) String() string { return proto.CompactTextString(m) }
+func (*DocumentChangePack) ProtoMessage()    {}
+func (*DocumentChangePack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{20}
+}
+func (m *DocumentChangePack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DocumentChangePack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocumentChangePack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(YorkieServiceServer).WatchDocument(m, &yorkieServiceWatchDocumentServer{stream})
 }
-
-type YorkieService_WatchDocumentServer interface {
-	Send(*WatchDocumentResponse) error
-	grpc.ServerStream
+func (m *DocumentChangePack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentChangePack.Merge(m, src)
 }
-
-type yorkieServiceWatchDocumentServer struct {
-	grpc.ServerStream
+func (m *DocumentChangePack) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *yorkieServiceWatchDocumentServer) Send(m *WatchDocumentResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *DocumentChangePack) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentChangePack.DiscardUnknown(m)
 }
 
-var _YorkieService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "yorkie.v1.YorkieService",
-	HandlerType: (*YorkieServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ActivateClient",
-			Handler:    _YorkieService_ActivateClient_Handler,
-		},
-		{
-			MethodName: "DeactivateClient",
-			Handler:    _YorkieService_DeactivateClient_Handler,
-		},
-		{
-			MethodName: "AttachDocument",
-			Handler:    _YorkieService_AttachDocument_Handler,
-		},
-		{
-			MethodName: "DetachDocument",
-			Handler:    _YorkieService_DetachDocument_Handler,
-		},
-		{
-			MethodName: "RemoveDocument",
-			Handler:    _YorkieService_RemoveDocument_Handler,
-		},
-		{
-			MethodName: "PushPullChanges",
-			Handler:    _YorkieService_PushPullChanges_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WatchDocument",
-			Handler:       _YorkieService_WatchDocument_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "yorkie/v1/yorkie.proto",
+var xxx_messageInfo_DocumentChangePack proto.InternalMessageInfo
+
+func (m *DocumentChangePack) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
 }
 
-func (m *ActivateClientRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DocumentChangePack) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ActivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PushPullChangesMultiRequest struct {
+	ClientId             string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePacks          []*DocumentChangePack `protobuf:"bytes,2,rep,name=change_packs,json=changePacks,proto3" json:"change_packs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ActivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PushPullChangesMultiRequest) Reset()         { *m = PushPullChangesMultiRequest{} }
+func (m *PushPullChangesMultiRequest) String() string { return proto.CompactTextString(m) }
+func (*PushPullChangesMultiRequest) ProtoMessage()    {}
+func (*PushPullChangesMultiRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{21}
+}
+func (m *PushPullChangesMultiRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushPullChangesMultiRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushPullChangesMultiRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ClientKey) > 0 {
-		i -= len(m.ClientKey)
-		copy(dAtA[i:], m.ClientKey)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PushPullChangesMultiRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPullChangesMultiRequest.Merge(m, src)
+}
+func (m *PushPullChangesMultiRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushPullChangesMultiRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPullChangesMultiRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushPullChangesMultiRequest proto.InternalMessageInfo
+
+func (m *PushPullChangesMultiRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ActivateClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PushPullChangesMultiRequest) GetChangePacks() []*DocumentChangePack {
+	if m != nil {
+		return m.ChangePacks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ActivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PushPullChangesMultiResponse struct {
+	ChangePacks          []*ChangePack `protobuf:"bytes,1,rep,name=change_packs,json=changePacks,proto3" json:"change_packs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ActivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *PushPullChangesMultiResponse) Reset()         { *m = PushPullChangesMultiResponse{} }
+func (m *PushPullChangesMultiResponse) String() string { return proto.CompactTextString(m) }
+func (*PushPullChangesMultiResponse) ProtoMessage()    {}
+func (*PushPullChangesMultiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{22}
+}
+func (m *PushPullChangesMultiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushPullChangesMultiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushPullChangesMultiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PushPullChangesMultiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPullChangesMultiResponse.Merge(m, src)
+}
+func (m *PushPullChangesMultiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushPullChangesMultiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPullChangesMultiResponse.DiscardUnknown(m)
 }
 
-func (m *DeactivateClientRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PushPullChangesMultiResponse proto.InternalMessageInfo
+
+func (m *PushPullChangesMultiResponse) GetChangePacks() []*ChangePack {
+	if m != nil {
+		return m.ChangePacks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeactivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BatchPushPullChangesRequest struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// requests are synchronized independently of each other. The client_id of
+	// each request is ignored in favor of the one of the batch.
+	Requests             []*PushPullChangesRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *DeactivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *BatchPushPullChangesRequest) Reset()         { *m = BatchPushPullChangesRequest{} }
+func (m *BatchPushPullChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchPushPullChangesRequest) ProtoMessage()    {}
+func (*BatchPushPullChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{23}
+}
+func (m *BatchPushPullChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchPushPullChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchPushPullChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BatchPushPullChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPushPullChangesRequest.Merge(m, src)
+}
+func (m *BatchPushPullChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchPushPullChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPushPullChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchPushPullChangesRequest proto.InternalMessageInfo
+
+func (m *BatchPushPullChangesRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DeactivateClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchPushPullChangesRequest) GetRequests() []*PushPullChangesRequest {
+	if m != nil {
+		return m.Requests
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeactivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BatchPushPullChangesResponse struct {
+	// results are in the order of the requests of the batch.
+	Results              []*BatchPushPullResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *DeactivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *BatchPushPullChangesResponse) Reset()         { *m = BatchPushPullChangesResponse{} }
+func (m *BatchPushPullChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchPushPullChangesResponse) ProtoMessage()    {}
+func (*BatchPushPullChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{24}
+}
+func (m *BatchPushPullChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchPushPullChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchPushPullChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchPushPullChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPushPullChangesResponse.Merge(m, src)
+}
+func (m *BatchPushPullChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchPushPullChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPushPullChangesResponse.DiscardUnknown(m)
 }
 
-func (m *AttachDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchPushPullChangesResponse proto.InternalMessageInfo
+
+func (m *BatchPushPullChangesResponse) GetResults() []*BatchPushPullResult {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AttachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BatchPushPullResult struct {
+	// change_pack is set if the document is synchronized, and error otherwise.
+	ChangePack           *ChangePack         `protobuf:"bytes,1,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	Error                *BatchPushPullError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *AttachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *BatchPushPullResult) Reset()         { *m = BatchPushPullResult{} }
+func (m *BatchPushPullResult) String() string { return proto.CompactTextString(m) }
+func (*BatchPushPullResult) ProtoMessage()    {}
+func (*BatchPushPullResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{25}
+}
+func (m *BatchPushPullResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchPushPullResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchPushPullResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BatchPushPullResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPushPullResult.Merge(m, src)
+}
+func (m *BatchPushPullResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchPushPullResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPushPullResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchPushPullResult proto.InternalMessageInfo
+
+func (m *BatchPushPullResult) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AttachDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchPushPullResult) GetError() *BatchPushPullError {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AttachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BatchPushPullError struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AttachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *BatchPushPullError) Reset()         { *m = BatchPushPullError{} }
+func (m *BatchPushPullError) String() string { return proto.CompactTextString(m) }
+func (*BatchPushPullError) ProtoMessage()    {}
+func (*BatchPushPullError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40070c858814ab24, []int{26}
+}
+func (m *BatchPushPullError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchPushPullError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchPushPullError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DocumentId) > 0 {
-		i -= len(m.DocumentId)
-		copy(dAtA[i:], m.DocumentId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchPushPullError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPushPullError.Merge(m, src)
+}
+func (m *BatchPushPullError) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchPushPullError) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPushPullError.DiscardUnknown(m)
 }
 
-func (m *DetachDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchPushPullError proto.InternalMessageInfo
+
+func (m *BatchPushPullError) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DetachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchPushPullError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *DetachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.RemoveIfNotAttached {
-		i--
-		if m.RemoveIfNotAttached {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func (m *BatchPushPullError) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DocumentId) > 0 {
-		i -= len(m.DocumentId)
-		copy(dAtA[i:], m.DocumentId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DetachDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*ActivateClientRequest)(nil), "yorkie.v1.ActivateClientRequest")
+	proto.RegisterType((*ActivateClientResponse)(nil), "yorkie.v1.ActivateClientResponse")
+	proto.RegisterType((*DeactivateClientRequest)(nil), "yorkie.v1.DeactivateClientRequest")
+	proto.RegisterType((*DeactivateClientResponse)(nil), "yorkie.v1.DeactivateClientResponse")
+	proto.RegisterType((*AttachDocumentRequest)(nil), "yorkie.v1.AttachDocumentRequest")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.AttachDocumentRequest.LabelsEntry")
+	proto.RegisterType((*AttachDocumentResponse)(nil), "yorkie.v1.AttachDocumentResponse")
+	proto.RegisterType((*DetachDocumentRequest)(nil), "yorkie.v1.DetachDocumentRequest")
+	proto.RegisterType((*DetachDocumentResponse)(nil), "yorkie.v1.DetachDocumentResponse")
+	proto.RegisterType((*WatchDocumentRequest)(nil), "yorkie.v1.WatchDocumentRequest")
+	proto.RegisterType((*WatchDocumentResponse)(nil), "yorkie.v1.WatchDocumentResponse")
+	proto.RegisterType((*WatchDocumentResponse_Initialization)(nil), "yorkie.v1.WatchDocumentResponse.Initialization")
+	proto.RegisterType((*WatchDocumentResponse_QueryResult)(nil), "yorkie.v1.WatchDocumentResponse.QueryResult")
+	proto.RegisterType((*HeartbeatRequest)(nil), "yorkie.v1.HeartbeatRequest")
+	proto.RegisterType((*HeartbeatResponse)(nil), "yorkie.v1.HeartbeatResponse")
+	proto.RegisterType((*UpdatePresenceRequest)(nil), "yorkie.v1.UpdatePresenceRequest")
+	proto.RegisterMapType((map[string]string)(nil), "yorkie.v1.UpdatePresenceRequest.PresenceEntry")
+	proto.RegisterType((*UpdatePresenceResponse)(nil), "yorkie.v1.UpdatePresenceResponse")
+	proto.RegisterType((*ReadDocumentRequest)(nil), "yorkie.v1.ReadDocumentRequest")
+	proto.RegisterType((*ReadDocumentResponse)(nil), "yorkie.v1.ReadDocumentResponse")
+	proto.RegisterType((*RemoveDocumentRequest)(nil), "yorkie.v1.RemoveDocumentRequest")
+	proto.RegisterType((*RemoveDocumentResponse)(nil), "yorkie.v1.RemoveDocumentResponse")
+	proto.RegisterType((*PushPullChangesRequest)(nil), "yorkie.v1.PushPullChangesRequest")
+	proto.RegisterType((*PushPullChangesResponse)(nil), "yorkie.v1.PushPullChangesResponse")
+	proto.RegisterType((*DocumentChangePack)(nil), "yorkie.v1.DocumentChangePack")
+	proto.RegisterType((*PushPullChangesMultiRequest)(nil), "yorkie.v1.PushPullChangesMultiRequest")
+	proto.RegisterType((*PushPullChangesMultiResponse)(nil), "yorkie.v1.PushPullChangesMultiResponse")
+	proto.RegisterType((*BatchPushPullChangesRequest)(nil), "yorkie.v1.BatchPushPullChangesRequest")
+	proto.RegisterType((*BatchPushPullChangesResponse)(nil), "yorkie.v1.BatchPushPullChangesResponse")
+	proto.RegisterType((*BatchPushPullResult)(nil), "yorkie.v1.BatchPushPullResult")
+	proto.RegisterType((*BatchPushPullError)(nil), "yorkie.v1.BatchPushPullError")
+}
+
+func init() { proto.RegisterFile("yorkie/v1/yorkie.proto", fileDescriptor_40070c858814ab24) }
+
+var fileDescriptor_40070c858814ab24 = []byte{
+	// 1211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xb6, 0x9b, 0x9c, 0xb4, 0xbb, 0xdd, 0x69, 0x92, 0x0d, 0xee, 0x6e, 0x9a, 0x18,
+	0x89, 0x2d, 0xda, 0x55, 0xba, 0xed, 0x4a, 0x55, 0x61, 0x85, 0x44, 0xbb, 0xe9, 0xaa, 0xe5, 0x37,
+	0x75, 0x05, 0xcb, 0x56, 0x42, 0x66, 0x6a, 0x9f, 0xb6, 0xa6, 0x8e, 0x9d, 0xd8, 0x13, 0x4b, 0x41,
+	0x5c, 0x71, 0x0f, 0xd7, 0xbc, 0x03, 0xf7, 0x3c, 0x00, 0x57, 0x5c, 0xf2, 0x04, 0x08, 0x95, 0x47,
+	0xe0, 0x05, 0x90, 0xed, 0x89, 0x63, 0xbb, 0x4e, 0xd2, 0x2d, 0x45, 0x70, 0xe7, 0x99, 0xf9, 0xce,
+	0x77, 0x7e, 0x66, 0xe6, 0x9b, 0x93, 0x40, 0x65, 0x60, 0xd9, 0xe7, 0x3a, 0xae, 0xb9, 0xeb, 0x6b,
+	0xc1, 0x57, 0xb3, 0x6b, 0x5b, 0xcc, 0x22, 0x05, 0x3e, 0x72, 0xd7, 0xc5, 0x37, 0x46, 0x10, 0x1b,
+	0x1d, 0xab, 0x6f, 0xab, 0xe8, 0x04, 0x28, 0x69, 0x13, 0xca, 0xdb, 0x2a, 0xd3, 0x5d, 0xca, 0xf0,
+	0xb9, 0xa1, 0xa3, 0xc9, 0x64, 0xec, 0xf5, 0xd1, 0x61, 0xe4, 0x01, 0x80, 0xea, 0x4f, 0x28, 0xe7,
+	0x38, 0xa8, 0x0a, 0x75, 0x61, 0xb5, 0x20, 0x17, 0x82, 0x99, 0x0f, 0x71, 0x20, 0x7d, 0x05, 0x95,
+	0xa4, 0x9d, 0xd3, 0xb5, 0x4c, 0x07, 0xc9, 0x32, 0x70, 0x98, 0xa2, 0x6b, 0xdc, 0x2e, 0x1f, 0x4c,
+	0xec, 0x6b, 0xe4, 0x6d, 0x58, 0x54, 0xad, 0x4e, 0x97, 0xaa, 0x4c, 0x41, 0x53, 0xb5, 0x34, 0xdd,
+	0x3c, 0xad, 0x66, 0xeb, 0xc2, 0x6a, 0x5e, 0xbe, 0xc3, 0xe7, 0x77, 0xf9, 0xb4, 0xb4, 0x09, 0xf7,
+	0x5a, 0x48, 0x53, 0x63, 0x9b, 0xe4, 0x42, 0x12, 0xa1, 0x7a, 0xd9, 0x2e, 0x88, 0x4d, 0xfa, 0x21,
+	0x0b, 0xe5, 0x6d, 0xc6, 0xa8, 0x7a, 0xd6, 0xb2, 0xd4, 0x7e, 0xe7, 0x8a, 0x94, 0x64, 0x13, 0x8a,
+	0xea, 0x19, 0x35, 0x4f, 0x51, 0xe9, 0x52, 0xf5, 0xdc, 0x0f, 0xb8, 0xb8, 0x51, 0x6e, 0x86, 0x05,
+	0x6e, 0x3e, 0xf7, 0x57, 0xdb, 0x54, 0x3d, 0x97, 0x41, 0x0d, 0xbf, 0x49, 0x0b, 0xe6, 0x0c, 0x7a,
+	0x8c, 0x86, 0x53, 0xcd, 0xd5, 0x73, 0xab, 0xc5, 0x8d, 0xc7, 0x11, 0x93, 0xd4, 0x30, 0x9a, 0x1f,
+	0xf9, 0xf0, 0x5d, 0x93, 0xd9, 0x03, 0x99, 0xdb, 0x92, 0x15, 0x28, 0x76, 0x29, 0x3b, 0x53, 0x4e,
+	0x74, 0x83, 0xa1, 0x5d, 0x9d, 0xf1, 0x83, 0x03, 0x6f, 0xea, 0x85, 0x3f, 0x23, 0xbe, 0x03, 0xc5,
+	0x88, 0x1d, 0x59, 0x84, 0xdc, 0x68, 0xcb, 0xbc, 0x4f, 0x52, 0x82, 0x59, 0x97, 0x1a, 0x7d, 0xf4,
+	0x23, 0x2f, 0xc8, 0xc1, 0xe0, 0xdd, 0xec, 0x96, 0x20, 0xf5, 0xa0, 0x92, 0x0c, 0x84, 0x6f, 0xe3,
+	0x0a, 0x14, 0x35, 0x3e, 0x37, 0x2a, 0x09, 0x0c, 0xa7, 0xae, 0x5f, 0x14, 0xe9, 0x17, 0x01, 0xca,
+	0x2d, 0x7c, 0xed, 0x3d, 0x48, 0xc4, 0x93, 0x9d, 0x16, 0x4f, 0xee, 0xaa, 0x9b, 0xf4, 0x14, 0x2a,
+	0x36, 0x76, 0x2c, 0x17, 0x15, 0xfd, 0x44, 0x31, 0x2d, 0xa6, 0x50, 0xbf, 0x20, 0xa8, 0xf9, 0x95,
+	0xce, 0xcb, 0x4b, 0xc1, 0xea, 0xfe, 0xc9, 0x27, 0x16, 0xdb, 0xe6, 0x4b, 0x52, 0x1b, 0x2a, 0x2d,
+	0x4c, 0xad, 0xdb, 0x75, 0xcb, 0xf2, 0x35, 0x94, 0x5e, 0x52, 0x76, 0xd3, 0x45, 0x29, 0xc1, 0x6c,
+	0xaf, 0x8f, 0xf6, 0xc0, 0x2f, 0x47, 0x41, 0x0e, 0x06, 0xd2, 0x5f, 0x59, 0x28, 0x27, 0x9c, 0xf1,
+	0xe8, 0x5f, 0xc1, 0x6d, 0xdd, 0xd4, 0x99, 0x4e, 0x0d, 0xfd, 0x1b, 0xca, 0x74, 0xcb, 0xf4, 0x5d,
+	0x16, 0x37, 0xd6, 0x22, 0x09, 0xa4, 0x5a, 0x36, 0xf7, 0x63, 0x66, 0x7b, 0x19, 0x39, 0x41, 0x44,
+	0x1e, 0xc1, 0x2c, 0xba, 0x68, 0x32, 0x5e, 0x92, 0xa5, 0x08, 0x63, 0xcb, 0x52, 0x77, 0xbd, 0xa5,
+	0xbd, 0x8c, 0x1c, 0x60, 0xc8, 0x01, 0xcc, 0xfb, 0xa1, 0x2a, 0x36, 0x3a, 0x7d, 0x83, 0xf1, 0xdd,
+	0x7c, 0x3c, 0x35, 0x8a, 0x03, 0xcf, 0x48, 0xf6, 0x6d, 0xf6, 0x32, 0x72, 0xb1, 0x37, 0x1a, 0x8a,
+	0x6b, 0x70, 0x3b, 0x1e, 0x63, 0x44, 0xe2, 0x74, 0xcd, 0xa9, 0x0a, 0xf5, 0xdc, 0x48, 0xe2, 0xf6,
+	0x35, 0x47, 0x7c, 0x01, 0xc5, 0x08, 0xdd, 0xe8, 0x12, 0x09, 0x91, 0x4b, 0x44, 0x1a, 0x00, 0x0e,
+	0xda, 0x2e, 0xda, 0x8a, 0x83, 0x3d, 0x3f, 0xb5, 0xdc, 0x4e, 0xf6, 0x89, 0x20, 0x17, 0x82, 0xd9,
+	0x43, 0xec, 0xed, 0xcc, 0xc1, 0xcc, 0xb1, 0xa5, 0x0d, 0xa4, 0x36, 0x2c, 0xee, 0x21, 0xb5, 0xd9,
+	0x31, 0xd2, 0x9b, 0xd9, 0x5d, 0x69, 0x09, 0xee, 0x46, 0x18, 0xb9, 0xc6, 0xfd, 0x2e, 0x40, 0xf9,
+	0xb3, 0xae, 0x46, 0x19, 0xb6, 0x6d, 0x74, 0xd0, 0x54, 0xf1, 0x66, 0x8e, 0xd2, 0x07, 0x90, 0xef,
+	0x72, 0x42, 0x2e, 0x67, 0xcd, 0xc8, 0x76, 0xa4, 0x7a, 0x6c, 0x0e, 0xc7, 0x81, 0xa0, 0x85, 0xf6,
+	0xe2, 0x33, 0x58, 0x88, 0x2d, 0xbd, 0x96, 0x66, 0x55, 0xa1, 0x92, 0xf4, 0xc6, 0x53, 0xdf, 0x82,
+	0x25, 0x19, 0xa9, 0x96, 0xbc, 0x42, 0x0d, 0x98, 0x0f, 0x53, 0x1b, 0x79, 0x09, 0xd3, 0xf5, 0x9e,
+	0xb3, 0x7d, 0x28, 0xc5, 0x2d, 0xf9, 0x7d, 0x20, 0x30, 0x63, 0x5b, 0x16, 0xe3, 0x26, 0xfe, 0xb7,
+	0x77, 0x6c, 0x92, 0x5b, 0x1e, 0xd9, 0x6e, 0xe9, 0x7b, 0x01, 0xca, 0xb2, 0x2f, 0x19, 0xff, 0x0b,
+	0x7d, 0xf3, 0xa4, 0x2a, 0x19, 0x4e, 0xba, 0x54, 0x09, 0x57, 0x65, 0xfc, 0x49, 0x80, 0x4a, 0xbb,
+	0xef, 0x9c, 0xb5, 0xfb, 0x86, 0x11, 0x40, 0x9c, 0xff, 0x56, 0xc2, 0x97, 0xa1, 0xd0, 0xed, 0x3b,
+	0x67, 0x8a, 0x65, 0x1a, 0x03, 0xae, 0xda, 0x79, 0x6f, 0xe2, 0x53, 0xd3, 0x18, 0x48, 0x07, 0x70,
+	0xef, 0x52, 0xb0, 0xff, 0xb0, 0x00, 0x1d, 0x20, 0xc3, 0x62, 0x8e, 0x10, 0xff, 0xde, 0x8b, 0xf9,
+	0x2d, 0x2c, 0x27, 0x32, 0xf8, 0xb8, 0x6f, 0x30, 0xfd, 0x4a, 0x35, 0x7f, 0x1f, 0xe6, 0x23, 0x3e,
+	0x9d, 0x6a, 0xd6, 0xbf, 0xb9, 0x0f, 0xe2, 0xe2, 0x9b, 0xc8, 0x44, 0x2e, 0x8e, 0x9c, 0x3b, 0xd2,
+	0x17, 0x70, 0x3f, 0xdd, 0x3b, 0x2f, 0xe2, 0x56, 0xc2, 0x83, 0x50, 0xcf, 0x8d, 0x4f, 0x2b, 0xc6,
+	0x3c, 0x80, 0xe5, 0x1d, 0x4f, 0xc5, 0xaf, 0x73, 0x96, 0xde, 0x83, 0xbc, 0x1d, 0xe0, 0x86, 0x39,
+	0x35, 0x22, 0x1e, 0xd3, 0x19, 0xe5, 0xd0, 0xc4, 0x4b, 0x2a, 0xdd, 0x75, 0x98, 0xd4, 0xad, 0xe0,
+	0xe5, 0x19, 0xe6, 0x53, 0x8b, 0xb0, 0xc7, 0x2c, 0x83, 0xd7, 0x41, 0x1e, 0xc2, 0xa5, 0xef, 0x04,
+	0x58, 0x4a, 0x01, 0x5c, 0xf7, 0xac, 0x91, 0xa7, 0x30, 0x8b, 0xb6, 0x6d, 0xd9, 0xfc, 0xb8, 0x3c,
+	0x18, 0x17, 0xc7, 0xae, 0x07, 0x92, 0x03, 0xac, 0x74, 0x04, 0xe4, 0xf2, 0xa2, 0x27, 0x66, 0xaa,
+	0xa5, 0x05, 0x0f, 0xd8, 0x82, 0xec, 0x7f, 0x93, 0x2a, 0xdc, 0xea, 0xa0, 0xe3, 0xd0, 0xd3, 0xa1,
+	0xd0, 0x0e, 0x87, 0xa4, 0x02, 0x73, 0x36, 0x52, 0xc7, 0x32, 0x79, 0xef, 0xc0, 0x47, 0x1b, 0x3f,
+	0x17, 0x60, 0xe1, 0x95, 0x1f, 0xc3, 0x21, 0xda, 0xae, 0xae, 0x22, 0x79, 0x09, 0xb7, 0xe3, 0xbf,
+	0x05, 0x48, 0x3d, 0xda, 0xe8, 0xa6, 0xb5, 0xf0, 0x62, 0x63, 0x02, 0x82, 0xab, 0x79, 0x86, 0x7c,
+	0x09, 0x8b, 0xc9, 0x56, 0x9e, 0x48, 0xd1, 0xa3, 0x9b, 0xfe, 0xfb, 0x40, 0x7c, 0x73, 0x22, 0x26,
+	0xa4, 0xf7, 0xe2, 0x8e, 0x35, 0xbf, 0xf1, 0xb8, 0xd3, 0x1a, 0x74, 0xb1, 0x31, 0x01, 0x11, 0x25,
+	0x6e, 0xe1, 0x58, 0xe2, 0x16, 0x4e, 0x23, 0x6e, 0xe1, 0x78, 0xe2, 0xb8, 0x96, 0xc7, 0x88, 0x53,
+	0x5f, 0x1d, 0xb1, 0x31, 0x01, 0x11, 0x12, 0x1f, 0xc1, 0x9d, 0xc4, 0x55, 0x20, 0xd3, 0xef, 0x93,
+	0x28, 0x4d, 0x82, 0x84, 0xdc, 0xc7, 0x50, 0x4e, 0x2c, 0x1e, 0x32, 0x1b, 0x69, 0xe7, 0xc6, 0x3c,
+	0xac, 0x0a, 0x44, 0x87, 0x52, 0x9a, 0x48, 0x91, 0xb7, 0xc6, 0xdb, 0x47, 0x35, 0x54, 0x7c, 0x38,
+	0x15, 0x17, 0xa6, 0xa3, 0x43, 0x29, 0x4d, 0x3a, 0x62, 0xae, 0x26, 0xc8, 0x9a, 0xf8, 0x70, 0x2a,
+	0x2e, 0x74, 0xf5, 0x39, 0x2c, 0xc4, 0xda, 0x5c, 0xb2, 0x32, 0xbe, 0x01, 0x0e, 0xc8, 0xeb, 0xd3,
+	0x3a, 0x64, 0x29, 0xf3, 0x44, 0x20, 0x7b, 0x50, 0x08, 0xfb, 0x46, 0xb2, 0x1c, 0x31, 0x49, 0xf6,
+	0xa7, 0xe2, 0xfd, 0xf4, 0xc5, 0xe8, 0x81, 0x8c, 0xf7, 0x62, 0xb1, 0x03, 0x99, 0xda, 0x14, 0x8a,
+	0x8d, 0x09, 0x88, 0x90, 0xf8, 0x00, 0xe6, 0xa3, 0x0d, 0x19, 0xa9, 0xc5, 0x4e, 0xf1, 0xa5, 0x1e,
+	0x4f, 0x5c, 0x19, 0xbb, 0x3e, 0xa4, 0xdc, 0x79, 0xf4, 0xeb, 0x45, 0x4d, 0xf8, 0xed, 0xa2, 0x26,
+	0xfc, 0x71, 0x51, 0x13, 0x7e, 0xfc, 0xb3, 0x96, 0x81, 0xbb, 0x1a, 0xba, 0x43, 0x3b, 0xda, 0xd5,
+	0x9b, 0xee, 0x7a, 0x5b, 0x38, 0x9a, 0x69, 0x3e, 0x73, 0xd7, 0x8f, 0xe7, 0xfc, 0xbf, 0x47, 0x9e,
+	0xfe, 0x3d, 0x00, 0xe8, 0xe6, 0xcd, 0x97, 0x5e, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// YorkieServiceClient is the client API for YorkieService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type YorkieServiceClient interface {
+	ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error)
+	DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error)
+	AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error)
+	DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error)
+	RemoveDocument(ctx context.Context, in *RemoveDocumentRequest, opts ...grpc.CallOption) (*RemoveDocumentResponse, error)
+	PushPullChanges(ctx context.Context, in *PushPullChangesRequest, opts ...grpc.CallOption) (*PushPullChangesResponse, error)
+	PushPullChangesStream(ctx context.Context, opts ...grpc.CallOption) (YorkieService_PushPullChangesStreamClient, error)
+	PushPullChangesMulti(ctx context.Context, in *PushPullChangesMultiRequest, opts ...grpc.CallOption) (*PushPullChangesMultiResponse, error)
+	BatchPushPullChanges(ctx context.Context, in *BatchPushPullChangesRequest, opts ...grpc.CallOption) (*BatchPushPullChangesResponse, error)
+	WatchDocument(ctx context.Context, in *WatchDocumentRequest, opts ...grpc.CallOption) (YorkieService_WatchDocumentClient, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*UpdatePresenceResponse, error)
+	ReadDocument(ctx context.Context, in *ReadDocumentRequest, opts ...grpc.CallOption) (*ReadDocumentResponse, error)
+}
+
+type yorkieServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewYorkieServiceClient(cc *grpc.ClientConn) YorkieServiceClient {
+	return &yorkieServiceClient{cc}
+}
+
+func (c *yorkieServiceClient) ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error) {
+	out := new(ActivateClientResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/ActivateClient", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DetachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieServiceClient) DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error) {
+	out := new(DeactivateClientResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/DeactivateClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DetachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *yorkieServiceClient) AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error) {
+	out := new(AttachDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/AttachDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *WatchDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieServiceClient) DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error) {
+	out := new(DetachDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/DetachDocument", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WatchDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieServiceClient) RemoveDocument(ctx context.Context, in *RemoveDocumentRequest, opts ...grpc.CallOption) (*RemoveDocumentResponse, error) {
+	out := new(RemoveDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/RemoveDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *WatchDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DocumentId) > 0 {
-		i -= len(m.DocumentId)
-		copy(dAtA[i:], m.DocumentId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (c *yorkieServiceClient) PushPullChanges(ctx context.Context, in *PushPullChangesRequest, opts ...grpc.CallOption) (*PushPullChangesResponse, error) {
+	out := new(PushPullChangesResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/PushPullChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *WatchDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieServiceClient) PushPullChangesStream(ctx context.Context, opts ...grpc.CallOption) (YorkieService_PushPullChangesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_YorkieService_serviceDesc.Streams[0], "/yorkie.v1.YorkieService/PushPullChangesStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &yorkieServicePushPullChangesStreamClient{stream}
+	return x, nil
 }
 
-func (m *WatchDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type YorkieService_PushPullChangesStreamClient interface {
+	Send(*PushPullChangesRequest) error
+	CloseAndRecv() (*PushPullChangesResponse, error)
+	grpc.ClientStream
 }
 
-func (m *WatchDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+type yorkieServicePushPullChangesStreamClient struct {
+	grpc.ClientStream
 }
 
-func (m *WatchDocumentResponse_Initialization_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *yorkieServicePushPullChangesStreamClient) Send(m *PushPullChangesRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func (m *WatchDocumentResponse_Initialization_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Initialization != nil {
-		{
-			size, err := m.Initialization.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (x *yorkieServicePushPullChangesStreamClient) CloseAndRecv() (*PushPullChangesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *WatchDocumentResponse_Event) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	m := new(PushPullChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (m *WatchDocumentResponse_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *yorkieServiceClient) PushPullChangesMulti(ctx context.Context, in *PushPullChangesMultiRequest, opts ...grpc.CallOption) (*PushPullChangesMultiResponse, error) {
+	out := new(PushPullChangesMultiResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/PushPullChangesMulti", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
-func (m *WatchDocumentResponse_Initialization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *yorkieServiceClient) BatchPushPullChanges(ctx context.Context, in *BatchPushPullChangesRequest, opts ...grpc.CallOption) (*BatchPushPullChangesResponse, error) {
+	out := new(BatchPushPullChangesResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/BatchPushPullChanges", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WatchDocumentResponse_Initialization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *WatchDocumentResponse_Initialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *yorkieServiceClient) WatchDocument(ctx context.Context, in *WatchDocumentRequest, opts ...grpc.CallOption) (YorkieService_WatchDocumentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_YorkieService_serviceDesc.Streams[1], "/yorkie.v1.YorkieService/WatchDocument", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ClientIds) > 0 {
-		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ClientIds[iNdEx])
-			copy(dAtA[i:], m.ClientIds[iNdEx])
-			i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	x := &yorkieServiceWatchDocumentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RemoveDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return x, nil
 }
 
-func (m *RemoveDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type YorkieService_WatchDocumentClient interface {
+	Recv() (*WatchDocumentResponse, error)
+	grpc.ClientStream
 }
 
-func (m *RemoveDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DocumentId) > 0 {
-		i -= len(m.DocumentId)
-		copy(dAtA[i:], m.DocumentId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type yorkieServiceWatchDocumentClient struct {
+	grpc.ClientStream
 }
 
-func (m *RemoveDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *yorkieServiceWatchDocumentClient) Recv() (*WatchDocumentResponse, error) {
+	m := new(WatchDocumentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *RemoveDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoveDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *yorkieServiceClient) UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*UpdatePresenceResponse, error) {
+	out := new(UpdatePresenceResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/UpdatePresence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PushPullChangesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieServiceClient) ReadDocument(ctx context.Context, in *ReadDocumentRequest, opts ...grpc.CallOption) (*ReadDocumentResponse, error) {
+	out := new(ReadDocumentResponse)
+	err := c.cc.Invoke(ctx, "/yorkie.v1.YorkieService/ReadDocument", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PushPullChangesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// YorkieServiceServer is the server API for YorkieService service.
+type YorkieServiceServer interface {
+	ActivateClient(context.Context, *ActivateClientRequest) (*ActivateClientResponse, error)
+	DeactivateClient(context.Context, *DeactivateClientRequest) (*DeactivateClientResponse, error)
+	AttachDocument(context.Context, *AttachDocumentRequest) (*AttachDocumentResponse, error)
+	DetachDocument(context.Context, *DetachDocumentRequest) (*DetachDocumentResponse, error)
+	RemoveDocument(context.Context, *RemoveDocumentRequest) (*RemoveDocumentResponse, error)
+	PushPullChanges(context.Context, *PushPullChangesRequest) (*PushPullChangesResponse, error)
+	PushPullChangesStream(YorkieService_PushPullChangesStreamServer) error
+	PushPullChangesMulti(context.Context, *PushPullChangesMultiRequest) (*PushPullChangesMultiResponse, error)
+	BatchPushPullChanges(context.Context, *BatchPushPullChangesRequest) (*BatchPushPullChangesResponse, error)
+	WatchDocument(*WatchDocumentRequest, YorkieService_WatchDocumentServer) error
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	UpdatePresence(context.Context, *UpdatePresenceRequest) (*UpdatePresenceResponse, error)
+	ReadDocument(context.Context, *ReadDocumentRequest) (*ReadDocumentResponse, error)
 }
 
-func (m *PushPullChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PushOnly {
-		i--
-		if m.PushOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+// UnimplementedYorkieServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedYorkieServiceServer struct {
+}
+
+func (*UnimplementedYorkieServiceServer) ActivateClient(ctx context.Context, req *ActivateClientRequest) (*ActivateClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateClient not implemented")
+}
+func (*UnimplementedYorkieServiceServer) DeactivateClient(ctx context.Context, req *DeactivateClientRequest) (*DeactivateClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateClient not implemented")
+}
+func (*UnimplementedYorkieServiceServer) AttachDocument(ctx context.Context, req *AttachDocumentRequest) (*AttachDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachDocument not implemented")
+}
+func (*UnimplementedYorkieServiceServer) DetachDocument(ctx context.Context, req *DetachDocumentRequest) (*DetachDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachDocument not implemented")
+}
+func (*UnimplementedYorkieServiceServer) RemoveDocument(ctx context.Context, req *RemoveDocumentRequest) (*RemoveDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDocument not implemented")
+}
+func (*UnimplementedYorkieServiceServer) PushPullChanges(ctx context.Context, req *PushPullChangesRequest) (*PushPullChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushPullChanges not implemented")
+}
+func (*UnimplementedYorkieServiceServer) PushPullChangesStream(srv YorkieService_PushPullChangesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushPullChangesStream not implemented")
+}
+func (*UnimplementedYorkieServiceServer) PushPullChangesMulti(ctx context.Context, req *PushPullChangesMultiRequest) (*PushPullChangesMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushPullChangesMulti not implemented")
+}
+func (*UnimplementedYorkieServiceServer) BatchPushPullChanges(ctx context.Context, req *BatchPushPullChangesRequest) (*BatchPushPullChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPushPullChanges not implemented")
+}
+func (*UnimplementedYorkieServiceServer) WatchDocument(req *WatchDocumentRequest, srv YorkieService_WatchDocumentServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDocument not implemented")
+}
+func (*UnimplementedYorkieServiceServer) Heartbeat(ctx context.Context, req *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedYorkieServiceServer) UpdatePresence(ctx context.Context, req *UpdatePresenceRequest) (*UpdatePresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresence not implemented")
+}
+func (*UnimplementedYorkieServiceServer) ReadDocument(ctx context.Context, req *ReadDocumentRequest) (*ReadDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDocument not implemented")
+}
+
+func RegisterYorkieServiceServer(s *grpc.Server, srv YorkieServiceServer) {
+	s.RegisterService(&_YorkieService_serviceDesc, srv)
+}
+
+func _YorkieService_ActivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).ActivateClient(ctx, in)
 	}
-	if len(m.DocumentId) > 0 {
-		i -= len(m.DocumentId)
-		copy(dAtA[i:], m.DocumentId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/ActivateClient",
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).ActivateClient(ctx, req.(*ActivateClientRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushPullChangesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _YorkieService_DeactivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateClientRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PushPullChangesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).DeactivateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/DeactivateClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).DeactivateClient(ctx, req.(*DeactivateClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushPullChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _YorkieService_AttachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).AttachDocument(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/AttachDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).AttachDocument(ctx, req.(*AttachDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintYorkie(dAtA []byte, offset int, v uint64) int {
-	offset -= sovYorkie(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _YorkieService_DetachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ActivateClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).DetachDocument(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.ClientKey)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/DetachDocument",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).DetachDocument(ctx, req.(*DetachDocumentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActivateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _YorkieService_RemoveDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).RemoveDocument(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/RemoveDocument",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).RemoveDocument(ctx, req.(*RemoveDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeactivateClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _YorkieService_PushPullChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushPullChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).PushPullChanges(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/PushPullChanges",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).PushPullChanges(ctx, req.(*PushPullChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeactivateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _YorkieService_PushPullChangesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(YorkieServiceServer).PushPullChangesStream(&yorkieServicePushPullChangesStreamServer{stream})
+}
+
+type YorkieService_PushPullChangesStreamServer interface {
+	SendAndClose(*PushPullChangesResponse) error
+	Recv() (*PushPullChangesRequest, error)
+	grpc.ServerStream
+}
+
+type yorkieServicePushPullChangesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *yorkieServicePushPullChangesStreamServer) SendAndClose(m *PushPullChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *yorkieServicePushPullChangesStreamServer) Recv() (*PushPullChangesRequest, error) {
+	m := new(PushPullChangesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *AttachDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _YorkieService_PushPullChangesMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushPullChangesMultiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).PushPullChangesMulti(ctx, in)
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/PushPullChangesMulti",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).PushPullChangesMulti(ctx, req.(*PushPullChangesMultiRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AttachDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _YorkieService_BatchPushPullChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPushPullChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DocumentId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).BatchPushPullChanges(ctx, in)
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/BatchPushPullChanges",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).BatchPushPullChanges(ctx, req.(*BatchPushPullChangesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DetachDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+func _YorkieService_WatchDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDocumentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	l = len(m.DocumentId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	return srv.(YorkieServiceServer).WatchDocument(m, &yorkieServiceWatchDocumentServer{stream})
+}
+
+type YorkieService_WatchDocumentServer interface {
+	Send(*WatchDocumentResponse) error
+	grpc.ServerStream
+}
+
+type yorkieServiceWatchDocumentServer struct {
+	grpc.ServerStream
+}
+
+func (x *yorkieServiceWatchDocumentServer) Send(m *WatchDocumentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _YorkieService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).Heartbeat(ctx, in)
 	}
-	if m.RemoveIfNotAttached {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/Heartbeat",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DetachDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _YorkieService_UpdatePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).UpdatePresence(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/UpdatePresence",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).UpdatePresence(ctx, req.(*UpdatePresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _YorkieService_ReadDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServiceServer).ReadDocument(ctx, in)
 	}
-	l = len(m.DocumentId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/yorkie.v1.YorkieService/ReadDocument",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServiceServer).ReadDocument(ctx, req.(*ReadDocumentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var _YorkieService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "yorkie.v1.YorkieService",
+	HandlerType: (*YorkieServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActivateClient",
+			Handler:    _YorkieService_ActivateClient_Handler,
+		},
+		{
+			MethodName: "DeactivateClient",
+			Handler:    _YorkieService_DeactivateClient_Handler,
+		},
+		{
+			MethodName: "AttachDocument",
+			Handler:    _YorkieService_AttachDocument_Handler,
+		},
+		{
+			MethodName: "DetachDocument",
+			Handler:    _YorkieService_DetachDocument_Handler,
+		},
+		{
+			MethodName: "RemoveDocument",
+			Handler:    _YorkieService_RemoveDocument_Handler,
+		},
+		{
+			MethodName: "PushPullChanges",
+			Handler:    _YorkieService_PushPullChanges_Handler,
+		},
+		{
+			MethodName: "PushPullChangesMulti",
+			Handler:    _YorkieService_PushPullChangesMulti_Handler,
+		},
+		{
+			MethodName: "BatchPushPullChanges",
+			Handler:    _YorkieService_BatchPushPullChanges_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _YorkieService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "UpdatePresence",
+			Handler:    _YorkieService_UpdatePresence_Handler,
+		},
+		{
+			MethodName: "ReadDocument",
+			Handler:    _YorkieService_ReadDocument_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PushPullChangesStream",
+			Handler:       _YorkieService_PushPullChangesStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchDocument",
+			Handler:       _YorkieService_WatchDocument_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "yorkie/v1/yorkie.proto",
+}
+
+func (m *ActivateClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WatchDocumentResponse_Initialization_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Initialization != nil {
-		l = m.Initialization.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+func (m *ActivateClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *WatchDocumentResponse_Event) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	return n
+
+func (m *ActivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *WatchDocumentResponse_Initialization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ActivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ClientIds) > 0 {
-		for _, s := range m.ClientIds {
-			l = len(s)
-			n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CompactEncoding {
+		i--
+		if m.CompactEncoding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeactivateClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeactivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeactivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	l = len(m.DocumentId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeactivateClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeactivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeactivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PushPullChangesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttachDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	l = len(m.DocumentId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PathFilter) > 0 {
+		i -= len(m.PathFilter)
+		copy(dAtA[i:], m.PathFilter)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.PathFilter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PushOnly {
-		n += 2
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PushPullChangesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DetachDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DetachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemoveIfNotAttached {
+		i--
+		if m.RemoveIfNotAttached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetachDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DetachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func sovYorkie(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozYorkie(x uint64) (n int) {
-	return sovYorkie(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *WatchDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentResponse_Initialization_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentResponse_Initialization_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Initialization != nil {
+		{
+			size, err := m.Initialization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WatchDocumentResponse_Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentResponse_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WatchDocumentResponse_QueryResult_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentResponse_QueryResult_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.QueryResult != nil {
+		{
+			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WatchDocumentResponse_Initialization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentResponse_Initialization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentResponse_Initialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientIds) > 0 {
+		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIds[iNdEx])
+			copy(dAtA[i:], m.ClientIds[iNdEx])
+			i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentResponse_QueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentResponse_QueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentResponse_QueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePresenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePresenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePresenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Presence) > 0 {
+		for k := range m.Presence {
+			v := m.Presence[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePresenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePresenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePresenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushPullChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPullChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPullChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PushOnly {
+		i--
+		if m.PushOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushPullChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPullChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPullChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocumentChangePack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentChangePack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushPullChangesMultiRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPullChangesMultiRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPullChangesMultiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ChangePacks) > 0 {
+		for iNdEx := len(m.ChangePacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangePacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushPullChangesMultiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPullChangesMultiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPullChangesMultiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ChangePacks) > 0 {
+		for iNdEx := len(m.ChangePacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangePacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchPushPullChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchPushPullChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchPushPullChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchPushPullChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchPushPullChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchPushPullChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchPushPullResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchPushPullResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchPushPullResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchPushPullError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchPushPullError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchPushPullError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintYorkie(dAtA []byte, offset int, v uint64) int {
+	offset -= sovYorkie(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActivateClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.CompactEncoding {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.PathFilter)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemoveIfNotAttached {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentResponse_Initialization_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Initialization != nil {
+		l = m.Initialization.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *WatchDocumentResponse_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *WatchDocumentResponse_QueryResult_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryResult != nil {
+		l = m.QueryResult.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *WatchDocumentResponse_Initialization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for _, s := range m.ClientIds {
+			l = len(s)
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentResponse_QueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeartbeatRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatePresenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Presence) > 0 {
+		for k, v := range m.Presence {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatePresenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPullChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.PushOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPullChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentChangePack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPullChangesMultiRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.ChangePacks) > 0 {
+		for _, e := range m.ChangePacks {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPullChangesMultiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChangePacks) > 0 {
+		for _, e := range m.ChangePacks {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchPushPullChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchPushPullChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchPushPullResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchPushPullError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovYorkie(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovYorkie(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozYorkie(x uint64) (n int) {
+	return sovYorkie(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactEncoding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompactEncoding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetachDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveIfNotAttached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoveIfNotAttached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetachDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initialization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WatchDocumentResponse_Initialization{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &WatchDocumentResponse_Initialization_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DocEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &WatchDocumentResponse_Event{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WatchDocumentResponse_QueryResult{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &WatchDocumentResponse_QueryResult_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchDocumentResponse_Initialization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Initialization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Initialization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIds = append(m.ClientIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchDocumentResponse_QueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdatePresenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2256,15 +5925,15 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatePresenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatePresenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2292,7 +5961,166 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Presence == nil {
+				m.Presence = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Presence[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2316,7 +6144,7 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdatePresenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2339,44 +6167,12 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatePresenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatePresenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -2399,7 +6195,7 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
+func (m *ReadDocumentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2422,15 +6218,15 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadDocumentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2458,7 +6254,7 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2482,7 +6278,7 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *ReadDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2505,12 +6301,63 @@ func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -2533,7 +6380,7 @@ func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveDocumentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2556,10 +6403,10 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttachDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDocumentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2595,6 +6442,38 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
@@ -2652,7 +6531,7 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2675,45 +6554,13 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttachDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DocumentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
@@ -2771,7 +6618,7 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *PushPullChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2794,10 +6641,10 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetachDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2902,7 +6749,7 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveIfNotAttached", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PushOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2919,7 +6766,7 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RemoveIfNotAttached = bool(v != 0)
+			m.PushOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -2942,7 +6789,7 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *PushPullChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2965,13 +6812,13 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetachDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
@@ -3029,7 +6876,7 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *DocumentChangePack) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3052,15 +6899,15 @@ func (m *WatchDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentChangePack: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentChangePack: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3088,13 +6935,13 @@ func (m *WatchDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -3104,23 +6951,27 @@ func (m *WatchDocumentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3144,7 +6995,7 @@ func (m *WatchDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *PushPullChangesMultiRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3167,17 +7018,17 @@ func (m *WatchDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullChangesMultiRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullChangesMultiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initialization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -3187,30 +7038,27 @@ func (m *WatchDocumentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WatchDocumentResponse_Initialization{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &WatchDocumentResponse_Initialization_{v}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePacks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3237,11 +7085,10 @@ func (m *WatchDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DocEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChangePacks = append(m.ChangePacks, &DocumentChangePack{})
+			if err := m.ChangePacks[len(m.ChangePacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &WatchDocumentResponse_Event{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3265,7 +7112,7 @@ func (m *WatchDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentResponse_Initialization) Unmarshal(dAtA []byte) error {
+func (m *PushPullChangesMultiResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,17 +7135,17 @@ func (m *WatchDocumentResponse_Initialization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Initialization: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullChangesMultiResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Initialization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullChangesMultiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePacks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -3308,23 +7155,25 @@ func (m *WatchDocumentResponse_Initialization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientIds = append(m.ClientIds, string(dAtA[iNdEx:postIndex]))
+			m.ChangePacks = append(m.ChangePacks, &ChangePack{})
+			if err := m.ChangePacks[len(m.ChangePacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3348,7 +7197,7 @@ func (m *WatchDocumentResponse_Initialization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchPushPullChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3371,10 +7220,10 @@ func (m *RemoveDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchPushPullChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchPushPullChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3411,39 +7260,7 @@ func (m *RemoveDocumentRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DocumentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3470,10 +7287,8 @@ func (m *RemoveDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Requests = append(m.Requests, &PushPullChangesRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3499,7 +7314,7 @@ func (m *RemoveDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchPushPullChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3522,15 +7337,15 @@ func (m *RemoveDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchPushPullChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchPushPullChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3557,10 +7372,8 @@ func (m *RemoveDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &BatchPushPullResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3586,7 +7399,7 @@ func (m *RemoveDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPullChangesRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchPushPullResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3609,17 +7422,17 @@ func (m *PushPullChangesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPullChangesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchPushPullResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPullChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchPushPullResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -3629,59 +7442,31 @@ func (m *PushPullChangesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DocumentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3708,33 +7493,13 @@ func (m *PushPullChangesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
+			if m.Error == nil {
+				m.Error = &BatchPushPullError{}
 			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PushOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PushOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -3757,7 +7522,7 @@ func (m *PushPullChangesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPullChangesResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchPushPullError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3780,17 +7545,36 @@ func (m *PushPullChangesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPullChangesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchPushPullError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPullChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchPushPullError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -3800,27 +7584,55 @@ func (m *PushPullChangesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51,12 +51,7 @@ func (i *AuthInterceptor) Unary() grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		ctx = metadata.AppendToOutgoingContext(ctx,
-			types.APIKeyKey, i.apiKey,
-			types.AuthorizationKey, i.token,
-			types.UserAgentKey, types.GoSDKType+"/"+version.Version,
-		)
-		return invoker(ctx, method, req, reply, cc, opts...)
+		return invoker(i.appendMetadata(ctx), method, req, reply, cc, opts...)
 	}
 }
 
@@ -70,11 +65,16 @@ func (i *AuthInterceptor) Stream() grpc.StreamClientInterceptor {
 		streamer grpc.Streamer,
 		opts ...grpc.CallOption,
 	) (grpc.ClientStream, error) {
-		ctx = metadata.AppendToOutgoingContext(ctx,
-			types.APIKeyKey, i.apiKey,
-			types.AuthorizationKey, i.token,
-			types.UserAgentKey, types.GoSDKType+"/"+version.Version,
-		)
-		return streamer(ctx, desc, cc, method, opts...)
+		return streamer(i.appendMetadata(ctx), desc, cc, method, opts...)
 	}
 }
+
+// appendMetadata appends the metadata for authentication to the given
+// outgoing context.
+func (i *AuthInterceptor) appendMetadata(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx,
+		types.APIKeyKey, i.apiKey,
+		types.AuthorizationKey, i.token,
+		types.UserAgentKey, types.GoSDKType+"/"+version.Version,
+	)
+}
@@ -22,19 +22,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
+	gotime "time"
 
 	"github.com/rs/xid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/internal/compression"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
@@ -42,11 +48,29 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// DefaultHeartbeatInterval is the default interval of the heartbeats that
+// the client sends while watching a document.
+const DefaultHeartbeatInterval = 10 * gotime.Second
+
+const (
+	// DefaultBackoffInitialInterval is the default interval before the first
+	// retry of Backoff.
+	DefaultBackoffInitialInterval = 100 * gotime.Millisecond
+
+	// DefaultBackoffMaxInterval is the default maximum interval between the
+	// retries of Backoff.
+	DefaultBackoffMaxInterval = 10 * gotime.Second
+)
+
 type status int
 
 const (
 	deactivated status = iota
 	activated
+
+	// expired is the status of a client whose activation has expired on the
+	// server while documents were attached.
+	expired
 )
 
 var (
@@ -65,28 +89,79 @@ var (
 	// ErrUnsupportedWatchResponseType occurs when the given WatchResponseType
 	// is not supported.
 	ErrUnsupportedWatchResponseType = errors.New("unsupported watch response type")
+
+	// ErrUnsupportedWatchTransport occurs when the given WatchTransport is not
+	// supported.
+	ErrUnsupportedWatchTransport = errors.New("unsupported watch transport")
 )
 
 // Attachment represents the document attached.
 type Attachment struct {
-	doc   *document.Document
-	docID types.ID
+	doc        *document.Document
+	docID      types.ID
+	pathFilter string
+	tracker    *syncTracker
+
+	syncMode     SyncMode
+	syncInterval gotime.Duration
+
+	// lastSyncAt is the time of the last synchronization of the document by
+	// Sync, which is used with syncInterval.
+	lastSyncAt gotime.Time
+
+	// restored is whether the document is loaded from the store and is not
+	// attached again to the server yet.
+	restored bool
+
+	// loaded is whether the document is loaded from the store and is not
+	// handed over to the user by Attach yet.
+	loaded bool
+
+	// pushKey is the idempotency key of the changes being pushed, and pushed
+	// identifies the changes. The key is kept while the same changes are
+	// pushed again, so that the server can deduplicate the retried pushes.
+	pushKey string
+	pushed  string
+}
+
+// idempotencyKey returns the idempotency key of the given pack to push. The
+// same key is returned while the pack has the same checkpoint and changes,
+// and a new key is created otherwise.
+func (a *Attachment) idempotencyKey(pack *change.Pack) string {
+	if !pack.HasChanges() {
+		return ""
+	}
+
+	last := pack.Changes[len(pack.Changes)-1].ID()
+	pushed := fmt.Sprintf("%s, last: %d", pack.Checkpoint, last.ClientSeq())
+	if a.pushed != pushed {
+		a.pushKey = xid.New().String()
+		a.pushed = pushed
+	}
+
+	return a.pushKey
 }
 
 // Client is a normal client that can communicate with the server.
 // It has documents and sends changes of the document in local
 // to the server to synchronize with other replicas in remote.
 type Client struct {
-	conn        *grpc.ClientConn
-	client      api.YorkieServiceClient
-	options     Options
-	dialOptions []grpc.DialOption
-	logger      *zap.Logger
+	conn            *grpc.ClientConn
+	client          api.YorkieServiceClient
+	rpcAddr         string
+	options         Options
+	dialOptions     []grpc.DialOption
+	authInterceptor *AuthInterceptor
+	logger          *zap.Logger
 
 	id          *time.ActorID
 	key         string
 	status      status
 	attachments map[key.Key]*Attachment
+
+	// compactEncoding is whether the change packs are encoded compactly. It is
+	// enabled only if the server supports it.
+	compactEncoding bool
 }
 
 // WatchResponseType is type of watch response.
@@ -98,13 +173,29 @@ const (
 	DocumentWatched   WatchResponseType = "document-watched"
 	DocumentUnwatched WatchResponseType = "document-unwatched"
 	PresenceChanged   WatchResponseType = "presence-changed"
+	PeersChanged      WatchResponseType = "peers-changed"
+	QueryChanged      WatchResponseType = "query-changed"
+	DocumentReadOnly  WatchResponseType = "document-read-only"
+	DocumentWritable  WatchResponseType = "document-writable"
+	DocumentEvicted   WatchResponseType = "document-evicted"
+	Disconnected      WatchResponseType = "disconnected"
+	Reconnected       WatchResponseType = "reconnected"
 )
 
 // WatchResponse is a structure representing response of Watch.
 type WatchResponse struct {
 	Type      WatchResponseType
 	Presences map[string]innerpresence.Presence
-	Err       error
+
+	// QueryResult is the JSON encoding of the value of the query of the watch.
+	// It is set when the type is QueryChanged.
+	QueryResult string
+
+	// Reason is the reason why the document becomes read-only or is evicted.
+	// It is set when the type is DocumentReadOnly or DocumentEvicted.
+	Reason string
+
+	Err error
 }
 
 // New creates an instance of Client.
@@ -114,6 +205,16 @@ func New(opts ...Option) (*Client, error) {
 		opt(&options)
 	}
 
+	if options.HeartbeatInterval == 0 {
+		options.HeartbeatInterval = DefaultHeartbeatInterval
+	}
+	if options.WatchTransport == "" {
+		options.WatchTransport = WatchTransportGRPC
+	}
+	if options.WatchTransport != WatchTransportGRPC && options.WatchTransport != WatchTransportWebSocket {
+		return nil, fmt.Errorf("%s: %w", options.WatchTransport, ErrUnsupportedWatchTransport)
+	}
+
 	k := options.Key
 	if k == "" {
 		k = xid.New().String()
@@ -134,6 +235,25 @@ func New(opts ...Option) (*Client, error) {
 	authInterceptor := NewAuthInterceptor(options.APIKey, options.Token)
 	dialOptions = append(dialOptions, grpc.WithUnaryInterceptor(authInterceptor.Unary()))
 	dialOptions = append(dialOptions, grpc.WithStreamInterceptor(authInterceptor.Stream()))
+	dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(throttleUnaryInterceptor))
+
+	if err := compression.Validate(options.Compression); err != nil {
+		return nil, err
+	}
+	if options.Compression != "" {
+		dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(options.Compression)))
+	}
+	if options.CompressionMinSize > 0 {
+		compression.SetMinSize(options.CompressionMinSize)
+	}
+	if err := compression.Validate(options.PackCompression); err != nil {
+		return nil, err
+	}
+	if options.PackCompression != "" {
+		interceptor := newPackCompressionInterceptor(options.PackCompression)
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(interceptor.Unary()))
+		dialOptions = append(dialOptions, grpc.WithChainStreamInterceptor(interceptor.Stream()))
+	}
 
 	if options.MaxCallRecvMsgSize != 0 {
 		dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(options.MaxCallRecvMsgSize)))
@@ -149,9 +269,10 @@ func New(opts ...Option) (*Client, error) {
 	}
 
 	return &Client{
-		dialOptions: dialOptions,
-		options:     options,
-		logger:      logger,
+		dialOptions:     dialOptions,
+		authInterceptor: authInterceptor,
+		options:         options,
+		logger:          logger,
 
 		key:         k,
 		status:      deactivated,
@@ -182,6 +303,7 @@ func (c *Client) Dial(rpcAddr string) error {
 
 	c.conn = conn
 	c.client = api.NewYorkieServiceClient(conn)
+	c.rpcAddr = rpcAddr
 
 	return nil
 }
@@ -207,20 +329,102 @@ func (c *Client) Activate(ctx context.Context) error {
 		return nil
 	}
 
-	response, err := c.client.ActivateClient(withShardKey(ctx, c.options.APIKey), &api.ActivateClientRequest{
-		ClientKey: c.key,
-	})
+	var header metadata.MD
+	response, err := c.client.ActivateClient(
+		withShardKey(ctx, c.options.APIKey),
+		&api.ActivateClientRequest{ClientKey: c.key},
+		grpc.Header(&header),
+	)
 	if err != nil {
 		return err
 	}
 
+	// NOTE: The server returns a deprecation warning if this SDK
+	// is below the recommended version. We only log it here so that users can
+	// plan to upgrade the SDK.
+	if deprecations := header.Get(types.DeprecationKey); len(deprecations) > 0 {
+		c.logger.Warn(deprecations[0])
+	}
+
 	clientID, err := time.ActorIDFromHex(response.ClientId)
 	if err != nil {
 		return err
 	}
 
-	c.status = activated
 	c.id = clientID
+	c.compactEncoding = c.options.CompactEncoding && response.CompactEncoding
+	if c.status == expired {
+		if err := c.reattachAll(ctx); err != nil {
+			return err
+		}
+	}
+	c.status = activated
+
+	return c.loadDocuments()
+}
+
+// reattachAll re-attaches the documents of this client whose activation has
+// expired on the server, with the new actor so that the local changes that
+// were not pushed to the server are replayed. If one of them fails, the new
+// activation is rolled back so that the next Activate tries again.
+func (c *Client) reattachAll(ctx context.Context) error {
+	for k, attachment := range c.attachments {
+		if err := c.reattach(ctx, attachment); err != nil {
+			if _, deactivateErr := c.client.DeactivateClient(
+				withShardKey(ctx, c.options.APIKey),
+				&api.DeactivateClientRequest{ClientId: c.id.String()},
+			); deactivateErr != nil {
+				c.logger.Warn("deactivate client", zap.Error(deactivateErr))
+			}
+			return fmt.Errorf("reattach %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// loadDocuments loads the documents persisted in the store that are not
+// attached to this client. Their local changes are replayed on the next Sync.
+func (c *Client) loadDocuments() error {
+	if c.options.Store == nil {
+		return nil
+	}
+
+	keys, err := c.options.Store.Keys()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if _, ok := c.attachments[k]; ok {
+			continue
+		}
+
+		data, err := c.options.Store.Load(k)
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			continue
+		}
+
+		doc := document.New(k)
+		stored, err := decodeDocument(doc, data)
+		if err != nil {
+			return err
+		}
+		doc.ResetActor(c.id)
+		doc.SetStatus(document.StatusAttached)
+		doc.SetValidator(c.options.SchemaValidator)
+		c.attachments[k] = &Attachment{
+			doc:        doc,
+			docID:      types.ID(stored.DocID),
+			pathFilter: stored.PathFilter,
+			tracker:    newSyncTracker(),
+			syncMode:   SyncModeRealtime,
+			restored:   true,
+			loaded:     true,
+		}
+	}
 
 	return nil
 }
@@ -231,13 +435,28 @@ func (c *Client) Deactivate(ctx context.Context) error {
 		return nil
 	}
 
-	_, err := c.client.DeactivateClient(withShardKey(ctx, c.options.APIKey), &api.DeactivateClientRequest{
-		ClientId: c.id.String(),
-	})
-	if err != nil {
-		return err
+	for _, attachment := range c.attachments {
+		c.persist(attachment)
 	}
 
+	// NOTE: If the activation has already expired, the server has forgotten
+	// this client, so we only deactivate it locally.
+	if c.status == activated {
+		_, err := c.client.DeactivateClient(withShardKey(ctx, c.options.APIKey), &api.DeactivateClientRequest{
+			ClientId: c.id.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// NOTE: The documents are detached locally, since the server no longer
+	// treats them as attached to this client.
+	for _, attachment := range c.attachments {
+		attachment.doc.SetStatus(document.StatusDetached)
+		attachment.doc.SetValidator(nil)
+	}
+	c.attachments = make(map[key.Key]*Attachment)
 	c.status = deactivated
 
 	return nil
@@ -254,12 +473,38 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		return ErrDocumentNotDetached
 	}
 
-	opts := &AttachOptions{}
+	opts := &AttachOptions{SyncMode: SyncModeRealtime}
 	for _, opt := range options {
 		opt(opts)
 	}
 
+	// NOTE: If the document has been loaded from the store, the given document
+	// takes over its state with the local changes instead of being attached
+	// again, and the changes are replayed on the next Sync.
+	if attachment, ok := c.attachments[doc.Key()]; ok && attachment.loaded {
+		data, err := encodeAttachment(attachment)
+		if err != nil {
+			return err
+		}
+		if _, err := decodeDocument(doc, data); err != nil {
+			return err
+		}
+		doc.ResetActor(c.id)
+		doc.SetStatus(document.StatusAttached)
+		if c.options.StatsHandler != nil {
+			doc.EnableStats()
+		}
+		attachment.doc = doc
+		attachment.syncMode = opts.SyncMode
+		attachment.syncInterval = opts.SyncInterval
+		attachment.loaded = false
+		return nil
+	}
+
 	doc.SetActor(c.id)
+	if c.options.StatsHandler != nil {
+		doc.EnableStats()
+	}
 
 	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
 		p.Initialize(opts.Presence)
@@ -268,7 +513,7 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		return err
 	}
 
-	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
+	pbChangePack, err := c.toPBChangePack(doc.CreateChangePack())
 	if err != nil {
 		return err
 	}
@@ -278,6 +523,8 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		&api.AttachDocumentRequest{
 			ClientId:   c.id.String(),
 			ChangePack: pbChangePack,
+			Labels:     opts.Labels,
+			PathFilter: opts.PathFilter,
 		},
 	)
 	if err != nil {
@@ -305,11 +552,77 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 	}
 
 	doc.SetStatus(document.StatusAttached)
-	c.attachments[doc.Key()] = &Attachment{
-		doc:   doc,
-		docID: types.ID(res.DocumentId),
+	doc.SetValidator(c.options.SchemaValidator)
+	attachment := &Attachment{
+		doc:          doc,
+		docID:        types.ID(res.DocumentId),
+		pathFilter:   opts.PathFilter,
+		tracker:      newSyncTracker(),
+		syncMode:     opts.SyncMode,
+		syncInterval: opts.SyncInterval,
+	}
+	c.attachments[doc.Key()] = attachment
+	c.validate(attachment)
+
+	return nil
+}
+
+// isSyncDue returns whether the document of this attachment should be
+// synchronized at the given time by Sync without document keys.
+func (a *Attachment) isSyncDue(now gotime.Time) bool {
+	if a.syncMode == SyncModeManual {
+		return false
+	}
+
+	return a.syncInterval <= 0 || now.Sub(a.lastSyncAt) >= a.syncInterval
+}
+
+// reattach attaches the document of the given attachment again after this
+// client is re-activated with a new actor.
+//
+// The local changes keep the actor of the previous activation. The actor is no
+// longer used by any other replica, so the tickets of the changes remain
+// unique, and the elements created by the changes in the local root match the
+// ones in remote replicas after the changes are replayed.
+func (c *Client) reattach(ctx context.Context, attachment *Attachment) error {
+	doc := attachment.doc
+	myPresence := doc.MyPresence()
+	doc.ResetActor(c.id)
+
+	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+		p.Initialize(myPresence)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	pbChangePack, err := c.toPBChangePack(doc.CreateChangePack())
+	if err != nil {
+		return err
+	}
+
+	res, err := c.client.AttachDocument(
+		withShardKey(ctx, c.options.APIKey, doc.Key().String()),
+		&api.AttachDocumentRequest{
+			ClientId:   c.id.String(),
+			ChangePack: pbChangePack,
+			PathFilter: attachment.pathFilter,
+		},
+	)
+	if err != nil {
+		c.recordSync(attachment, err)
+		return err
 	}
 
+	if err := c.applyChangePack(attachment, res.ChangePack); err != nil {
+		return err
+	}
+	if doc.Status() == document.StatusRemoved {
+		delete(c.attachments, doc.Key())
+		return nil
+	}
+
+	attachment.docID = types.ID(res.DocumentId)
 	return nil
 }
 
@@ -341,7 +654,7 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 		return err
 	}
 
-	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
+	pbChangePack, err := c.toPBChangePack(doc.CreateChangePack())
 	if err != nil {
 		return err
 	}
@@ -356,6 +669,15 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 		},
 	)
 	if err != nil {
+		// NOTE: If the document has already been detached on the server, e.g.
+		// evicted by the admin, we only detach it locally.
+		if ErrorReason(err) == reasonDocumentNotAttached {
+			doc.SetStatus(document.StatusDetached)
+			doc.SetValidator(nil)
+			delete(c.attachments, doc.Key())
+			c.unpersist(doc.Key())
+			return nil
+		}
 		return err
 	}
 
@@ -370,7 +692,9 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 	if doc.Status() != document.StatusRemoved {
 		doc.SetStatus(document.StatusDetached)
 	}
+	doc.SetValidator(nil)
 	delete(c.attachments, doc.Key())
+	c.unpersist(doc.Key())
 
 	return nil
 }
@@ -378,13 +702,32 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 // Sync pushes local changes of the attached documents to the server and
 // receives changes of the remote replica from the server then apply them to
 // local documents.
+//
+// Without options, the documents in SyncModeManual and the ones synchronized
+// within their sync interval are skipped. Each document is synchronized in
+// the direction of its sync mode unless WithPushOnly is given. Several
+// documents are synchronized in a single request, and the failure of one of
+// them does not prevent the others from being synchronized.
 func (c *Client) Sync(ctx context.Context, options ...SyncOptions) error {
 	if len(options) == 0 {
+		now := gotime.Now()
 		for _, attachment := range c.attachments {
+			if !attachment.isSyncDue(now) {
+				continue
+			}
 			options = append(options, WithDocKey(attachment.doc.Key()))
 		}
 	}
 
+	for _, opt := range options {
+		if err := c.flushPresence(ctx, opt.key); err != nil {
+			return err
+		}
+	}
+
+	if len(options) > 1 {
+		return c.batchPushPullChanges(ctx, options)
+	}
 	for _, opt := range options {
 		if err := c.pushPullChanges(ctx, opt); err != nil {
 			return err
@@ -394,32 +737,119 @@ func (c *Client) Sync(ctx context.Context, options ...SyncOptions) error {
 	return nil
 }
 
+// UpdateMulti updates the given attached documents with the given updater,
+// then pushes the changes of all the documents in a single request and pulls
+// the changes of the remote replicas. The server stores the changes of all the
+// documents or none of them, so the updater can keep invariants across them.
+//
+// The roots passed to the updater are in the order of the given documents,
+// which should be distinct. If the updater returns an error, none of the
+// documents is changed.
+func (c *Client) UpdateMulti(
+	ctx context.Context,
+	docs []*document.Document,
+	updater func(roots []*json.Object) error,
+	msgAndArgs ...interface{},
+) error {
+	if c.status != activated {
+		return ErrClientNotActivated
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	var attachments []*Attachment
+	for _, doc := range docs {
+		attachment, ok := c.attachments[doc.Key()]
+		if !ok {
+			return ErrDocumentNotAttached
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if err := updateNested(docs, nil, updater, msgAndArgs...); err != nil {
+		return err
+	}
+
+	req := &api.PushPullChangesMultiRequest{ClientId: c.id.String()}
+	for _, attachment := range attachments {
+		pbChangePack, err := c.toPBChangePack(attachment.doc.CreateChangePack())
+		if err != nil {
+			return err
+		}
+		req.ChangePacks = append(req.ChangePacks, &api.DocumentChangePack{
+			DocumentId: attachment.docID.String(),
+			ChangePack: pbChangePack,
+		})
+	}
+
+	res, err := c.client.PushPullChangesMulti(
+		withShardKey(ctx, c.options.APIKey, docs[0].Key().String()),
+		req,
+	)
+	if err != nil {
+		if ErrorReason(err) == reasonClientNotActivated {
+			c.status = expired
+		}
+		for _, attachment := range attachments {
+			c.recordSync(attachment, err)
+		}
+		return err
+	}
+
+	for i, attachment := range attachments {
+		if err := c.applyChangePack(attachment, res.ChangePacks[i]); err != nil {
+			return err
+		}
+		if attachment.doc.Status() == document.StatusRemoved {
+			delete(c.attachments, attachment.doc.Key())
+		}
+	}
+
+	return nil
+}
+
+// updateNested updates the given documents within the updates of each other,
+// so that the updater sees the roots of all of them, and the changes of none
+// of them are kept if the updater fails.
+func updateNested(
+	docs []*document.Document,
+	roots []*json.Object,
+	updater func(roots []*json.Object) error,
+	msgAndArgs ...interface{},
+) error {
+	if len(docs) == 0 {
+		return updater(roots)
+	}
+
+	return docs[0].Update(func(root *json.Object, _ *presence.Presence) error {
+		return updateNested(docs[1:], append(roots, root), updater, msgAndArgs...)
+	}, msgAndArgs...)
+}
+
 // Watch subscribes to events on a given documentIDs.
 // If an error occurs before stream initialization, the second response, error,
 // is returned. If the context "ctx" is canceled or timed out, returned channel
 // is closed, and "WatchResponse" from this closed channel has zero events and
 // nil "Err()".
+// With WithReconnect, the stream is reconnected after transient disconnects
+// instead of closing the channel.
 func (c *Client) Watch(
 	ctx context.Context,
 	doc *document.Document,
+	options ...WatchOption,
 ) (<-chan WatchResponse, error) {
 	attachment, ok := c.attachments[doc.Key()]
 	if !ok {
 		return nil, ErrDocumentNotAttached
 	}
 
-	rch := make(chan WatchResponse)
-	stream, err := c.client.WatchDocument(
-		withShardKey(ctx, c.options.APIKey, doc.Key().String()),
-		&api.WatchDocumentRequest{
-			ClientId:   c.id.String(),
-			DocumentId: attachment.docID.String(),
-		},
-	)
-	if err != nil {
-		return nil, err
+	opts := &WatchOptions{}
+	for _, opt := range options {
+		opt(opts)
 	}
 
+	rch := make(chan WatchResponse)
 	handleResponse := func(pbResp *api.WatchDocumentResponse) (*WatchResponse, error) {
 		switch resp := pbResp.Body.(type) {
 		case *api.WatchDocumentResponse_Initialization_:
@@ -434,6 +864,11 @@ func (c *Client) Watch(
 
 			doc.SetOnlineClients(clientIDs...)
 			return nil, nil
+		case *api.WatchDocumentResponse_QueryResult_:
+			return &WatchResponse{
+				Type:        QueryChanged,
+				QueryResult: resp.QueryResult.Value,
+			}, nil
 		case *api.WatchDocumentResponse_Event:
 			eventType, err := converter.FromEventType(resp.Event.Type)
 			if err != nil {
@@ -473,22 +908,82 @@ func (c *Client) Watch(
 						cli.String(): p,
 					},
 				}, nil
+			case types.PeersChangedEvent:
+				delta := innerpresence.Presence(resp.Event.Presence)
+				doc.ApplyPresenceDelta(cli.String(), delta)
+
+				return &WatchResponse{
+					Type: PeersChanged,
+					Presences: map[string]innerpresence.Presence{
+						cli.String(): delta,
+					},
+				}, nil
+			case types.DocumentReadOnlyEvent:
+				return &WatchResponse{
+					Type:   DocumentReadOnly,
+					Reason: resp.Event.Reason,
+				}, nil
+			case types.DocumentWritableEvent:
+				return &WatchResponse{Type: DocumentWritable}, nil
+			case types.DocumentEvictedEvent:
+				return &WatchResponse{
+					Type:   DocumentEvicted,
+					Reason: resp.Event.Reason,
+				}, nil
 			}
 		}
 		return nil, ErrUnsupportedWatchResponseType
 	}
 
-	pbResp, err := stream.Recv()
-	if err != nil {
-		return nil, err
+	// watch opens the watch stream and handles its initialization response.
+	watch := func() (watchStream, error) {
+		stream, err := c.watchDocument(
+			withShardKey(ctx, c.options.APIKey, doc.Key().String()),
+			&api.WatchDocumentRequest{
+				ClientId:   c.id.String(),
+				DocumentId: attachment.docID.String(),
+				Query:      opts.Query,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		pbResp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := handleResponse(pbResp); err != nil {
+			return nil, err
+		}
+		return stream, nil
 	}
-	if _, err := handleResponse(pbResp); err != nil {
+
+	stream, err := watch()
+	if err != nil {
 		return nil, err
 	}
 
+	closed := make(chan struct{})
+	go c.sendHeartbeats(ctx, doc.Key(), attachment.docID, closed)
+
 	go func() {
+		defer close(closed)
 		for {
 			pbResp, err := stream.Recv()
+			if err != nil && opts.Reconnect != nil && ctx.Err() == nil && isTransientWatchError(err) {
+				c.logger.Warn(fmt.Sprintf("watch of %s disconnected: %s", doc.Key(), err))
+				rch <- WatchResponse{Type: Disconnected}
+
+				// NOTE: The changes made while the stream is disconnected are
+				// not notified, so DocumentChanged is sent after reconnecting
+				// to make the document synced again.
+				if stream, err = reconnectWatch(ctx, opts.Reconnect, watch); err == nil {
+					rch <- WatchResponse{Type: Reconnected}
+					rch <- WatchResponse{Type: DocumentChanged}
+					continue
+				}
+			}
 			if err != nil {
 				rch <- WatchResponse{Err: err}
 				close(rch)
@@ -505,6 +1000,13 @@ func (c *Client) Watch(
 			}
 
 			rch <- *resp
+
+			// NOTE: The server closes the stream after the document is
+			// evicted, so the channel is closed without waiting for it.
+			if resp.Type == DocumentEvicted {
+				close(rch)
+				return
+			}
 		}
 	}()
 
@@ -537,6 +1039,296 @@ func (c *Client) Watch(
 	return rch, nil
 }
 
+// reconnectWatch re-establishes the watch stream with the given backoff until
+// it succeeds, the retries are exhausted or the given context is done.
+func reconnectWatch(
+	ctx context.Context,
+	backoff *Backoff,
+	watch func() (watchStream, error),
+) (watchStream, error) {
+	var err error
+	for retries := 0; backoff.MaxRetries == 0 || retries < backoff.MaxRetries; retries++ {
+		select {
+		case <-ctx.Done():
+			return nil, grpcstatus.FromContextError(ctx.Err()).Err()
+		case <-gotime.After(backoff.interval(retries)):
+		}
+
+		var stream watchStream
+		if stream, err = watch(); err == nil {
+			return stream, nil
+		}
+		if !isTransientWatchError(err) {
+			return nil, err
+		}
+	}
+
+	return nil, err
+}
+
+// isTransientWatchError returns whether the watch stream is closed by the
+// given error transiently, e.g. the server is restarted or the network is
+// unstable, so that the stream can be reconnected.
+func isTransientWatchError(err error) bool {
+	if errors.Is(err, io.EOF) {
+		return true
+	}
+
+	code := grpcstatus.Code(err)
+	return code == codes.Unavailable || code == codes.Aborted
+}
+
+// sendHeartbeats tells the server periodically that this client is still
+// watching the given document, until the given context is done or the stream
+// is closed. The server evicts watchers whose heartbeats stop.
+func (c *Client) sendHeartbeats(
+	ctx context.Context,
+	docKey key.Key,
+	docID types.ID,
+	closed <-chan struct{},
+) {
+	ticker := gotime.NewTicker(c.options.HeartbeatInterval)
+	defer ticker.Stop()
+
+	req := &api.HeartbeatRequest{
+		ClientId:   c.id.String(),
+		DocumentId: docID.String(),
+	}
+	for {
+		if _, err := c.client.Heartbeat(
+			withShardKey(ctx, c.options.APIKey, docKey.String()),
+			req,
+		); err != nil && ctx.Err() == nil {
+			c.logger.Warn(fmt.Sprintf("heartbeat of %s: %s", docKey, err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-closed:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// UpdatePresence sets the given keys of the presence of this client in the
+// given document, and delivers only the changed keys to the other clients
+// watching the document as PeersChanged. Unlike the presence updated by
+// Document.Update, it does not write a change to the document, so the
+// document should be watched and the clients attaching later see the keys
+// only after the next change of the presence.
+func (c *Client) UpdatePresence(
+	ctx context.Context,
+	doc *document.Document,
+	presence innerpresence.Presence,
+) error {
+	if c.status != activated {
+		return ErrClientNotActivated
+	}
+
+	attachment, ok := c.attachments[doc.Key()]
+	if !ok {
+		return ErrDocumentNotAttached
+	}
+
+	if _, err := c.client.UpdatePresence(
+		withShardKey(ctx, c.options.APIKey, doc.Key().String()),
+		&api.UpdatePresenceRequest{
+			ClientId:   c.id.String(),
+			DocumentId: attachment.docID.String(),
+			Presence:   presence,
+		},
+	); err != nil {
+		return err
+	}
+
+	doc.ApplyPresenceDelta(c.id.String(), presence)
+	return nil
+}
+
+// UpdateAllPresences sets the given keys of the presence of this client in all
+// the attached documents like UpdatePresence, e.g. to change the display name
+// of the user everywhere without attaching the documents again. It stops at
+// the first document that fails, so the documents updated before it keep the
+// new keys.
+func (c *Client) UpdateAllPresences(
+	ctx context.Context,
+	presence innerpresence.Presence,
+) error {
+	if c.status != activated {
+		return ErrClientNotActivated
+	}
+
+	for _, attachment := range c.attachments {
+		if err := c.UpdatePresence(ctx, attachment.doc, presence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flushPresence delivers the keys of the presence set by Document.SetPresence
+// to the other clients watching the document of the given key.
+func (c *Client) flushPresence(ctx context.Context, docKey key.Key) error {
+	attachment, ok := c.attachments[docKey]
+	if !ok {
+		return ErrDocumentNotAttached
+	}
+
+	delta := attachment.doc.TakePresenceDelta()
+	if len(delta) == 0 {
+		return nil
+	}
+
+	if err := c.UpdatePresence(ctx, attachment.doc, delta); err != nil {
+		attachment.doc.RestorePresenceDelta(delta)
+		return err
+	}
+
+	return nil
+}
+
+// SyncStatus returns the synchronization status of the given document, such
+// as the number of the local changes that are not yet pushed to the server.
+func (c *Client) SyncStatus(doc *document.Document) (*SyncStatus, error) {
+	attachment, ok := c.attachments[doc.Key()]
+	if !ok {
+		return nil, ErrDocumentNotAttached
+	}
+
+	return attachment.tracker.status(doc.CreateChangePack().ChangesLen()), nil
+}
+
+// Stats returns the time that the given document has spent in local updates
+// and remote changes. It returns zero stats if the stats of the document are
+// not enabled by WithStatsHandler or Document.EnableStats.
+func (c *Client) Stats(doc *document.Document) (document.Stats, error) {
+	attachment, ok := c.attachments[doc.Key()]
+	if !ok {
+		return document.Stats{}, ErrDocumentNotAttached
+	}
+
+	return attachment.doc.Stats(), nil
+}
+
+// applyChangePack applies the given change pack from the server to the
+// document of the given attachment, and records the result of the sync.
+func (c *Client) applyChangePack(attachment *Attachment, pbPack *api.ChangePack) error {
+	pack, err := converter.FromChangePack(pbPack)
+	if err != nil {
+		c.recordSync(attachment, err)
+		return err
+	}
+
+	if len(pack.Snapshot) > 0 {
+		attachment.tracker.setSnapshotPulling(true)
+		defer attachment.tracker.setSnapshotPulling(false)
+	}
+
+	err = attachment.doc.ApplyChangePack(pack)
+	c.recordSync(attachment, err)
+	if err != nil {
+		return err
+	}
+
+	c.validate(attachment)
+	return nil
+}
+
+// validate validates the document of the given attachment after the changes
+// of other replicas are applied. The changes cannot be rejected since the
+// server already has them, so the error is recorded in the sync status and
+// logged to flag the divergence.
+func (c *Client) validate(attachment *Attachment) {
+	if c.options.SchemaValidator == nil {
+		return
+	}
+
+	err := c.options.SchemaValidator(attachment.doc.RootObject())
+	attachment.tracker.setValidationErr(err)
+	if err != nil {
+		c.logger.Warn(fmt.Sprintf("validate %s: %s", attachment.doc.Key(), err))
+	}
+}
+
+// toPBChangePack converts the given pack into the Protobuf format with the
+// encodings that this client is configured with.
+func (c *Client) toPBChangePack(pack *change.Pack) (*api.ChangePack, error) {
+	pbChangePack, err := converter.ToChangePack(pack)
+	if err != nil {
+		return nil, err
+	}
+	if c.compactEncoding {
+		converter.CompactChangePack(pbChangePack)
+	}
+	if err := converter.CompressChangePack(pbChangePack, c.options.PackCompression); err != nil {
+		return nil, err
+	}
+
+	return pbChangePack, nil
+}
+
+// recordSync records the result of the sync of the given attachment, and
+// notifies the handler if the document transitions to another state. The
+// local changes left after the sync are persisted in the store.
+func (c *Client) recordSync(attachment *Attachment, err error) {
+	c.persist(attachment)
+
+	if c.options.StatsHandler != nil {
+		c.options.StatsHandler(StatsEvent{
+			DocumentKey: attachment.doc.Key(),
+			Stats:       attachment.doc.Stats(),
+		})
+	}
+
+	state, changed := attachment.tracker.record(err)
+	if !changed || c.options.SyncStatusHandler == nil {
+		return
+	}
+
+	c.options.SyncStatusHandler(SyncStatusEvent{
+		DocumentKey: attachment.doc.Key(),
+		State:       state,
+		Err:         err,
+	})
+}
+
+// persist stores the document of the given attachment in the store if it has
+// local changes not pushed to the server, or deletes it from the store
+// otherwise. The failure is only logged since the changes remain in memory.
+func (c *Client) persist(attachment *Attachment) {
+	if c.options.Store == nil {
+		return
+	}
+
+	doc := attachment.doc
+	if !doc.HasLocalChanges() || doc.Status() == document.StatusRemoved {
+		c.unpersist(doc.Key())
+		return
+	}
+
+	data, err := encodeAttachment(attachment)
+	if err == nil {
+		err = c.options.Store.Save(doc.Key(), data)
+	}
+	if err != nil {
+		c.logger.Warn(fmt.Sprintf("persist %s: %s", doc.Key(), err))
+	}
+}
+
+// unpersist deletes the document of the given key from the store.
+func (c *Client) unpersist(docKey key.Key) {
+	if c.options.Store == nil {
+		return
+	}
+
+	if err := c.options.Store.Delete(docKey); err != nil {
+		c.logger.Warn(fmt.Sprintf("unpersist %s: %s", docKey, err))
+	}
+}
+
 func (c *Client) findDocKey(docID string) (key.Key, error) {
 	for _, attachment := range c.attachments {
 		if attachment.docID.String() == docID {
@@ -564,39 +1356,162 @@ func (c *Client) IsActive() bool {
 
 // pushPullChanges pushes the changes of the document to the server and pulls the changes from the server.
 func (c *Client) pushPullChanges(ctx context.Context, opt SyncOptions) error {
-	if c.status != activated {
-		return ErrClientNotActivated
+	attachment, req, err := c.newPushPullRequest(ctx, opt)
+	if err != nil || req == nil {
+		return err
 	}
 
-	attachment, ok := c.attachments[opt.key]
-	if !ok {
-		return ErrDocumentNotAttached
+	var res *api.PushPullChangesResponse
+	ctx = withShardKey(ctx, c.options.APIKey, opt.key.String())
+	if c.options.PushChunkSize > 0 && req.Size() > c.options.PushChunkSize {
+		res, err = c.pushPullChangesInChunks(ctx, req)
+	} else {
+		res, err = c.client.PushPullChanges(ctx, req)
 	}
-
-	pbChangePack, err := converter.ToChangePack(attachment.doc.CreateChangePack())
 	if err != nil {
+		c.handlePushPullError(attachment, err)
 		return err
 	}
 
-	res, err := c.client.PushPullChanges(
-		withShardKey(ctx, c.options.APIKey, opt.key.String()),
-		&api.PushPullChangesRequest{
-			ClientId:   c.id.String(),
-			DocumentId: attachment.docID.String(),
-			ChangePack: pbChangePack,
-			PushOnly:   opt.mode == types.SyncModePushOnly,
-		},
+	return c.applyPulledPack(attachment, res.ChangePack)
+}
+
+// batchPushPullChanges synchronizes the documents of the given options in a
+// single request. The documents are synchronized independently, so the pulled
+// changes are applied to the synchronized documents even if the others fail,
+// and the first error is returned.
+func (c *Client) batchPushPullChanges(ctx context.Context, options []SyncOptions) error {
+	var attachments []*Attachment
+	req := &api.BatchPushPullChangesRequest{ClientId: c.id.String()}
+	for _, opt := range options {
+		attachment, docReq, err := c.newPushPullRequest(ctx, opt)
+		if err != nil {
+			return err
+		}
+		if docReq == nil {
+			continue
+		}
+
+		// NOTE: Large change packs are sent in chunks over a stream
+		// instead of being batched.
+		if c.options.PushChunkSize > 0 && docReq.Size() > c.options.PushChunkSize {
+			res, err := c.pushPullChangesInChunks(
+				withShardKey(ctx, c.options.APIKey, opt.key.String()),
+				docReq,
+			)
+			if err != nil {
+				c.handlePushPullError(attachment, err)
+				return err
+			}
+			if err := c.applyPulledPack(attachment, res.ChangePack); err != nil {
+				return err
+			}
+			continue
+		}
+
+		docReq.ClientId = ""
+		attachments = append(attachments, attachment)
+		req.Requests = append(req.Requests, docReq)
+	}
+	if len(req.Requests) == 0 {
+		return nil
+	}
+
+	res, err := c.client.BatchPushPullChanges(
+		withShardKey(ctx, c.options.APIKey, attachments[0].doc.Key().String()),
+		req,
 	)
 	if err != nil {
+		for _, attachment := range attachments {
+			c.handlePushPullError(attachment, err)
+		}
 		return err
 	}
 
-	pack, err := converter.FromChangePack(res.ChangePack)
+	var firstErr error
+	for i, attachment := range attachments {
+		result := res.Results[i]
+		if result.Error != nil {
+			err = toStatusError(result.Error)
+			c.handlePushPullError(attachment, err)
+		} else {
+			err = c.applyPulledPack(attachment, result.ChangePack)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// newPushPullRequest creates the PushPull request of the document of the given
+// option in the direction of its sync mode. The request is nil if the document
+// has already been synchronized by attaching it again.
+func (c *Client) newPushPullRequest(
+	ctx context.Context,
+	opt SyncOptions,
+) (*Attachment, *api.PushPullChangesRequest, error) {
+	if c.status != activated {
+		return nil, nil, ErrClientNotActivated
+	}
+
+	attachment, ok := c.attachments[opt.key]
+	if !ok {
+		return nil, nil, ErrDocumentNotAttached
+	}
+
+	// NOTE: The server may have forgotten the attachment of the document
+	// loaded from the store while this client was not running, so it is
+	// attached again with the local changes. If the server still has the
+	// attachment, the local changes are pushed as usual.
+	if attachment.restored {
+		err := c.reattach(ctx, attachment)
+		if err == nil || ErrorReason(err) == reasonDocumentAlreadyAttached {
+			attachment.restored = false
+		}
+		if ErrorReason(err) != reasonDocumentAlreadyAttached {
+			return attachment, nil, err
+		}
+	}
+
+	pack := attachment.doc.CreateChangePack()
+	pushOnly := opt.mode == types.SyncModePushOnly || attachment.syncMode == SyncModePushOnly
+	if attachment.syncMode == SyncModePullOnly && !pushOnly {
+		pack = change.NewPack(pack.DocumentKey, attachment.doc.Checkpoint(), nil, nil)
+	}
+	attachment.lastSyncAt = gotime.Now()
+	pack.IdempotencyKey = attachment.idempotencyKey(pack)
+
+	pbChangePack, err := c.toPBChangePack(pack)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	if err := attachment.doc.ApplyChangePack(pack); err != nil {
+	return attachment, &api.PushPullChangesRequest{
+		ClientId:   c.id.String(),
+		DocumentId: attachment.docID.String(),
+		ChangePack: pbChangePack,
+		PushOnly:   pushOnly,
+	}, nil
+}
+
+// handlePushPullError records the given error of the PushPull of the document
+// of the given attachment.
+func (c *Client) handlePushPullError(attachment *Attachment, err error) {
+	// NOTE: If the activation of this client has expired on the server, we
+	// mark it as expired so that the next Activate replays the local changes
+	// with a new actor.
+	if ErrorReason(err) == reasonClientNotActivated {
+		c.status = expired
+	}
+	c.recordSync(attachment, err)
+}
+
+// applyPulledPack applies the given change pack pulled from the server to the
+// document of the given attachment.
+func (c *Client) applyPulledPack(attachment *Attachment, pbPack *api.ChangePack) error {
+	if err := c.applyChangePack(attachment, pbPack); err != nil {
 		return err
 	}
 	if attachment.doc.Status() == document.StatusRemoved {
@@ -606,6 +1521,45 @@ func (c *Client) pushPullChanges(ctx context.Context, opt SyncOptions) error {
 	return nil
 }
 
+// pushPullChangesInChunks sends the given request in chunks over a stream.
+// The first chunk carries the fields of the request and the metadata of the
+// change pack, and the following chunks carry only the changes.
+func (c *Client) pushPullChangesInChunks(
+	ctx context.Context,
+	req *api.PushPullChangesRequest,
+) (*api.PushPullChangesResponse, error) {
+	stream, err := c.client.PushPullChangesStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := req.ChangePack.Changes
+	req.ChangePack.Changes = nil
+
+	chunk, size := req, req.Size()
+	for _, change := range changes {
+		if len(chunk.ChangePack.Changes) > 0 && size+change.Size() > c.options.PushChunkSize {
+			if err := stream.Send(chunk); err != nil {
+				// NOTE: io.EOF means that the server has closed
+				// the stream, and the error is returned by CloseAndRecv.
+				if err == io.EOF {
+					return stream.CloseAndRecv()
+				}
+				return nil, err
+			}
+			chunk, size = &api.PushPullChangesRequest{ChangePack: &api.ChangePack{}}, 0
+		}
+
+		chunk.ChangePack.Changes = append(chunk.ChangePack.Changes, change)
+		size += change.Size()
+	}
+	if err := stream.Send(chunk); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
 // Remove removes the given document.
 func (c *Client) Remove(ctx context.Context, doc *document.Document) error {
 	if c.status != activated {
@@ -617,7 +1571,7 @@ func (c *Client) Remove(ctx context.Context, doc *document.Document) error {
 		return ErrDocumentNotAttached
 	}
 
-	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
+	pbChangePack, err := c.toPBChangePack(doc.CreateChangePack())
 	if err != nil {
 		return err
 	}
@@ -645,6 +1599,7 @@ func (c *Client) Remove(ctx context.Context, doc *document.Document) error {
 	}
 	if doc.Status() == document.StatusRemoved {
 		delete(c.attachments, doc.Key())
+		c.unpersist(doc.Key())
 	}
 
 	return nil
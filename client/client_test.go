@@ -22,17 +22,27 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/assert"
 	monkey "github.com/undefinedlabs/go-mpatch"
 	"golang.org/x/net/nettest"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	grpcmetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/internal/compression"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
 )
 
 type testYorkieServer struct {
@@ -55,6 +65,117 @@ func dialTestYorkieServer(t *testing.T) (*testYorkieServer, string) {
 	return testYorkieServer, testYorkieServer.listenAndServe(t)
 }
 
+// throttlingYorkieServer is a server that rejects every request as if it
+// exceeded the rate limit.
+type throttlingYorkieServer struct {
+	api.UnimplementedYorkieServiceServer
+}
+
+func (s *throttlingYorkieServer) ActivateClient(
+	_ context.Context,
+	_ *api.ActivateClientRequest,
+) (*api.ActivateClientResponse, error) {
+	st, err := status.New(codes.ResourceExhausted, "too many requests").WithDetails(
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(2 * time.Second)},
+		&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     "project:dummy",
+			Description: "too many requests",
+		}}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return nil, st.Err()
+}
+
+// activatingYorkieServer is a server that activates every client with the
+// same id.
+type activatingYorkieServer struct {
+	api.UnimplementedYorkieServiceServer
+	compactEncoding bool
+}
+
+func (s *activatingYorkieServer) ActivateClient(
+	_ context.Context,
+	_ *api.ActivateClientRequest,
+) (*api.ActivateClientResponse, error) {
+	return &api.ActivateClientResponse{
+		ClientId:        "000000000000000000000000",
+		CompactEncoding: s.compactEncoding,
+	}, nil
+}
+
+// unavailableYorkieServer is a server that attaches documents, but fails
+// every push with the idempotency keys and the encodings of the pushes
+// recorded.
+type unavailableYorkieServer struct {
+	activatingYorkieServer
+	idempotencyKeys []string
+	compacts        []bool
+}
+
+func (s *unavailableYorkieServer) AttachDocument(
+	_ context.Context,
+	req *api.AttachDocumentRequest,
+) (*api.AttachDocumentResponse, error) {
+	return &api.AttachDocumentResponse{
+		DocumentId: "000000000000000000000000",
+		ChangePack: &api.ChangePack{
+			DocumentKey: req.ChangePack.DocumentKey,
+			Checkpoint:  &api.Checkpoint{ServerSeq: 1, ClientSeq: 1},
+		},
+	}, nil
+}
+
+func (s *unavailableYorkieServer) PushPullChanges(
+	_ context.Context,
+	req *api.PushPullChangesRequest,
+) (*api.PushPullChangesResponse, error) {
+	s.idempotencyKeys = append(s.idempotencyKeys, req.ChangePack.IdempotencyKey)
+	s.compacts = append(s.compacts, req.ChangePack.IsCompact)
+	return nil, status.Error(codes.Unavailable, "unavailable")
+}
+
+// expiringYorkieServer is a server that attaches documents, but fails every
+// push as if the activation of the client has expired. It fails to attach
+// documents again while unavailable is set.
+type expiringYorkieServer struct {
+	unavailableYorkieServer
+	unavailable bool
+	deactivated int
+}
+
+func (s *expiringYorkieServer) AttachDocument(
+	ctx context.Context,
+	req *api.AttachDocumentRequest,
+) (*api.AttachDocumentResponse, error) {
+	if s.unavailable {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return s.unavailableYorkieServer.AttachDocument(ctx, req)
+}
+
+func (s *expiringYorkieServer) DeactivateClient(
+	_ context.Context,
+	_ *api.DeactivateClientRequest,
+) (*api.DeactivateClientResponse, error) {
+	s.deactivated++
+	return &api.DeactivateClientResponse{}, nil
+}
+
+func (s *expiringYorkieServer) PushPullChanges(
+	_ context.Context,
+	_ *api.PushPullChangesRequest,
+) (*api.PushPullChangesResponse, error) {
+	st, err := status.New(codes.FailedPrecondition, "client is not activated").WithDetails(
+		&errdetails.ErrorInfo{Reason: "CLIENT_NOT_ACTIVATED"},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return nil, st.Err()
+}
+
 func (s *testYorkieServer) listenAndServe(t *testing.T) string {
 	lis, err := nettest.NewLocalListener("tcp")
 	if err != nil {
@@ -118,4 +239,159 @@ func TestClient(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NoError(t, cli.Activate(context.Background()))
 	})
+	t.Run("throttled error test", func(t *testing.T) {
+		grpcServer := grpc.NewServer()
+		api.RegisterYorkieServiceServer(grpcServer, &throttlingYorkieServer{})
+		testServer := &testYorkieServer{grpcServer: grpcServer}
+		addr := testServer.listenAndServe(t)
+		defer testServer.Stop()
+
+		cli, err := client.Dial(addr)
+		assert.NoError(t, err)
+
+		err = cli.Activate(context.Background())
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		var throttledErr *client.ThrottledError
+		assert.ErrorAs(t, err, &throttledErr)
+		assert.Equal(t, 2*time.Second, throttledErr.RetryAfter())
+		assert.Equal(t, []client.QuotaViolation{{
+			Subject:     "project:dummy",
+			Description: "too many requests",
+		}}, throttledErr.Violations())
+	})
+	t.Run("watch transport test", func(t *testing.T) {
+		_, err := client.New(client.WithWatchTransport(client.WatchTransportWebSocket))
+		assert.NoError(t, err)
+
+		_, err = client.New(client.WithWatchTransport("sse"))
+		assert.ErrorIs(t, err, client.ErrUnsupportedWatchTransport)
+	})
+	t.Run("compression test", func(t *testing.T) {
+		_, err := client.New(client.WithCompression("br"))
+		assert.ErrorIs(t, err, compression.ErrUnsupportedCompressor)
+
+		grpcServer := grpc.NewServer()
+		api.RegisterYorkieServiceServer(grpcServer, &activatingYorkieServer{})
+		testServer := &testYorkieServer{grpcServer: grpcServer}
+		addr := testServer.listenAndServe(t)
+		defer testServer.Stop()
+
+		var compressors []string
+		compression.SetObserver(func(compressor string, uncompressed, compressed int) {
+			compressors = append(compressors, compressor)
+		})
+		defer compression.SetObserver(nil)
+
+		// the server replies with the compressor of the request.
+		cli, err := client.Dial(addr, client.WithCompression(compression.Zstd))
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(context.Background()))
+		assert.Equal(t, []string{compression.Zstd, compression.Zstd}, compressors)
+	})
+
+	t.Run("idempotency key test", func(t *testing.T) {
+		yorkieServer := &unavailableYorkieServer{}
+		grpcServer := grpc.NewServer()
+		api.RegisterYorkieServiceServer(grpcServer, yorkieServer)
+		testServer := &testYorkieServer{grpcServer: grpcServer}
+		addr := testServer.listenAndServe(t)
+		defer testServer.Stop()
+
+		ctx := context.Background()
+		cli, err := client.Dial(addr)
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+		doc := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		update := func(k string) {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+		}
+
+		// 01. the retried pushes of the same changes have the same key.
+		update("k1")
+		assert.Error(t, cli.Sync(ctx))
+		assert.Error(t, cli.Sync(ctx))
+
+		// 02. the push with new changes has a new key.
+		update("k2")
+		assert.Error(t, cli.Sync(ctx))
+
+		keys := yorkieServer.idempotencyKeys
+		assert.Len(t, keys, 3)
+		assert.NotEmpty(t, keys[0])
+		assert.Equal(t, keys[0], keys[1])
+		assert.NotEqual(t, keys[1], keys[2])
+	})
+	t.Run("compact encoding negotiation test", func(t *testing.T) {
+		for _, supported := range []bool{false, true} {
+			yorkieServer := &unavailableYorkieServer{}
+			yorkieServer.compactEncoding = supported
+			grpcServer := grpc.NewServer()
+			api.RegisterYorkieServiceServer(grpcServer, yorkieServer)
+			testServer := &testYorkieServer{grpcServer: grpcServer}
+			addr := testServer.listenAndServe(t)
+
+			ctx := context.Background()
+			cli, err := client.Dial(addr, client.WithCompactEncoding())
+			assert.NoError(t, err)
+			assert.NoError(t, cli.Activate(ctx))
+			doc := document.New(key.Key(t.Name()))
+			assert.NoError(t, cli.Attach(ctx, doc))
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString("k1", "v")
+				return nil
+			}))
+
+			// NOTE: The packs are encoded compactly only if the server
+			// tells that it supports the encoding.
+			assert.Error(t, cli.Sync(ctx))
+			assert.Equal(t, []bool{supported}, yorkieServer.compacts)
+			testServer.Stop()
+		}
+	})
+	t.Run("reattach after expiration test", func(t *testing.T) {
+		yorkieServer := &expiringYorkieServer{}
+		grpcServer := grpc.NewServer()
+		api.RegisterYorkieServiceServer(grpcServer, yorkieServer)
+		testServer := &testYorkieServer{grpcServer: grpcServer}
+		addr := testServer.listenAndServe(t)
+		defer testServer.Stop()
+
+		ctx := context.Background()
+		cli, err := client.Dial(addr)
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+		doc := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 01. the client finds that its activation has expired when syncing.
+		assert.Error(t, cli.Sync(ctx))
+		assert.False(t, cli.IsActive())
+
+		// 02. the activation is rolled back if the document fails to be
+		// attached again.
+		yorkieServer.unavailable = true
+		assert.Error(t, cli.Activate(ctx))
+		assert.False(t, cli.IsActive())
+		assert.Equal(t, 1, yorkieServer.deactivated)
+
+		// 03. the next activation attaches the document again.
+		yorkieServer.unavailable = false
+		assert.NoError(t, cli.Activate(ctx))
+		assert.True(t, cli.IsActive())
+		assert.Equal(t, document.StatusAttached, doc.Status())
+
+		// 04. the documents are detached locally by an explicit Deactivate,
+		// and are not attached again by the next activation.
+		assert.NoError(t, cli.Deactivate(ctx))
+		assert.Equal(t, document.StatusDetached, doc.Status())
+		yorkieServer.unavailable = true
+		assert.NoError(t, cli.Activate(ctx))
+		assert.True(t, cli.IsActive())
+	})
 }
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+// packCompressionInterceptor is an interceptor that tells the server the
+// compressor of the change packs that the client accepts in the responses.
+type packCompressionInterceptor struct {
+	compressor string
+}
+
+// newPackCompressionInterceptor creates a new instance of
+// packCompressionInterceptor.
+func newPackCompressionInterceptor(compressor string) *packCompressionInterceptor {
+	return &packCompressionInterceptor{compressor: compressor}
+}
+
+// Unary creates a unary client interceptor for the pack compression.
+func (i *packCompressionInterceptor) Unary() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req,
+		reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, types.PackCompressionKey, i.compressor)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// Stream creates a stream client interceptor for the pack compression.
+func (i *packCompressionInterceptor) Stream() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		ctx = metadata.AppendToOutgoingContext(ctx, types.PackCompressionKey, i.compressor)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
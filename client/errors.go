@@ -0,0 +1,71 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+)
+
+// reasonClientNotActivated is the reason of the error that the server returns
+// when the client is not activated, e.g. its activation has expired.
+const reasonClientNotActivated = "CLIENT_NOT_ACTIVATED"
+
+// reasonDocumentNotAttached is the reason of the error that the server returns
+// when the document is not attached, e.g. it has been evicted by the admin.
+const reasonDocumentNotAttached = "DOCUMENT_NOT_ATTACHED"
+
+// reasonDocumentAlreadyAttached is the reason of the error that the server
+// returns when the document is already attached to the client.
+const reasonDocumentAlreadyAttached = "DOCUMENT_ALREADY_ATTACHED"
+
+// ErrorReason returns the reason of the given error from the server, e.g.
+// "INVALID_SERVER_SEQ" or "DOCUMENT_NOT_FOUND". It returns an empty string if
+// the error does not have the reason.
+func ErrorReason(err error) string {
+	st, ok := grpcstatus.FromError(err)
+	if !ok {
+		return ""
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+
+	return ""
+}
+
+// toStatusError returns the status error of the given error of a document in
+// a batch, so that its reason is read by ErrorReason like the errors of the
+// other requests.
+func toStatusError(batchErr *api.BatchPushPullError) error {
+	st := grpcstatus.New(codes.Code(batchErr.Code), batchErr.Message)
+	if batchErr.Reason == "" {
+		return st.Err()
+	}
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: batchErr.Reason})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
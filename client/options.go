@@ -17,9 +17,12 @@
 package client
 
 import (
+	"time"
+
 	"go.uber.org/zap"
 
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 )
@@ -49,8 +52,75 @@ type Options struct {
 
 	// MaxCallRecvMsgSize is the maximum message size in bytes the client can receive.
 	MaxCallRecvMsgSize int
+
+	// CompactEncoding is whether the client encodes the tickets of change packs
+	// compactly if the server supports it. The server replies in the same
+	// encoding.
+	CompactEncoding bool
+
+	// SyncStatusHandler is called when an attached document transitions
+	// between synced and out-of-sync states.
+	SyncStatusHandler func(event SyncStatusEvent)
+
+	// StatsHandler is called with the stats of an attached document after
+	// each sync of the document. The stats of the documents attached while it
+	// is set are enabled.
+	StatsHandler func(event StatsEvent)
+
+	// HeartbeatInterval is the interval of the heartbeats that the client
+	// sends while watching a document. Default is DefaultHeartbeatInterval.
+	HeartbeatInterval time.Duration
+
+	// Compression is the name of the compressor of the messages, "gzip" or
+	// "zstd". The server replies with the same compressor. If it is empty,
+	// the messages are not compressed.
+	Compression string
+
+	// CompressionMinSize is the size in bytes of the messages below which the
+	// compressor uses the cheapest level. If it is zero, the default is used.
+	CompressionMinSize int
+
+	// PackCompression is the name of the compressor of the changes and the
+	// snapshots of change packs, "gzip" or "zstd". Unlike Compression, only
+	// the payloads of change packs are compressed, and the server replies
+	// with the same compressor. If it is empty, they are not compressed.
+	PackCompression string
+
+	// PushChunkSize is the size in bytes of the chunks of a change pack. If a
+	// change pack is larger than it, its changes are pushed in chunks over a
+	// stream. If it is zero, change packs are always pushed in one message.
+	PushChunkSize int
+
+	// Store is the local storage where the documents with local changes not
+	// pushed to the server are persisted. If it is nil, they are kept only in
+	// memory.
+	Store Store
+
+	// SchemaValidator validates the root of the attached documents. A local
+	// update is rejected if the root after the update is invalid. Remote
+	// changes cannot be rejected, so the error of validating the root after
+	// they are applied is reported in SyncStatus instead.
+	SchemaValidator func(root *crdt.Object) error
+
+	// WatchTransport is the transport of the watch streams of documents.
+	// Default is WatchTransportGRPC.
+	WatchTransport WatchTransport
 }
 
+// WatchTransport is the transport of the watch streams of documents.
+type WatchTransport string
+
+const (
+	// WatchTransportGRPC watches documents over gRPC streams.
+	WatchTransportGRPC WatchTransport = "grpc"
+
+	// WatchTransportWebSocket watches documents over WebSocket. It requires
+	// the server to serve the web protocols, and is for the environments where
+	// gRPC streaming is blocked, e.g. by proxies. The other calls are still
+	// made over gRPC.
+	WatchTransportWebSocket WatchTransport = "websocket"
+)
+
 // WithKey configures the key of the client.
 func WithKey(key string) Option {
 	return func(o *Options) { o.Key = key }
@@ -86,6 +156,87 @@ func WithMaxRecvMsgSize(maxRecvMsgSize int) Option {
 	return func(o *Options) { o.MaxCallRecvMsgSize = maxRecvMsgSize }
 }
 
+// WithCompactEncoding configures the client to encode the tickets of change
+// packs compactly to reduce the size of packs. It takes effect only if the
+// server tells that it supports the encoding when the client is activated.
+func WithCompactEncoding() Option {
+	return func(o *Options) { o.CompactEncoding = true }
+}
+
+// WithSyncStatusHandler configures the handler that is called when an attached
+// document transitions between synced and out-of-sync states. The handler is
+// called in the goroutine that synchronizes the document, so it should not
+// block.
+func WithSyncStatusHandler(handler func(event SyncStatusEvent)) Option {
+	return func(o *Options) { o.SyncStatusHandler = handler }
+}
+
+// WithStatsHandler configures the handler that is called with the time spent
+// in local updates and remote changes of an attached document after each sync
+// of the document. The stats of the documents are enabled when they are
+// attached. The handler is called in the goroutine that synchronizes the
+// document, so it should not block.
+func WithStatsHandler(handler func(event StatsEvent)) Option {
+	return func(o *Options) { o.StatsHandler = handler }
+}
+
+// WithHeartbeatInterval configures the interval of the heartbeats that the
+// client sends while watching a document. It should be shorter than the
+// heartbeat timeout of the server.
+func WithHeartbeatInterval(interval time.Duration) Option {
+	return func(o *Options) { o.HeartbeatInterval = interval }
+}
+
+// WithCompression configures the compressor of the messages, "gzip" or "zstd".
+// The server replies with the same compressor, as a complement to the compact
+// encoding of change packs.
+func WithCompression(name string) Option {
+	return func(o *Options) { o.Compression = name }
+}
+
+// WithCompressionMinSize configures the size in bytes of the messages below
+// which the compressor uses the cheapest level. Since gRPC registers the
+// compressors globally, it applies to all the clients and servers in the
+// process.
+func WithCompressionMinSize(size int) Option {
+	return func(o *Options) { o.CompressionMinSize = size }
+}
+
+// WithPackCompression configures the compressor of the changes and the
+// snapshots of change packs, "gzip" or "zstd". It reduces the bandwidth for
+// documents with large texts, such as the snapshots of text-heavy documents.
+func WithPackCompression(name string) Option {
+	return func(o *Options) { o.PackCompression = name }
+}
+
+// WithPushChunkSize configures the size in bytes of the chunks of change
+// packs. Change packs larger than it, such as the ones with many offline
+// changes, are pushed in chunks so that they are not bounded by the max
+// message size of the server.
+func WithPushChunkSize(size int) Option {
+	return func(o *Options) { o.PushChunkSize = size }
+}
+
+// WithStore configures the local storage where the documents with local
+// changes not pushed to the server are persisted, so that the changes survive
+// restarts of the process. The stored documents are loaded on Activate and
+// their changes are replayed on the next Sync.
+func WithStore(store Store) Option {
+	return func(o *Options) { o.Store = store }
+}
+
+// WithSchemaValidator configures the validator of the root of the attached
+// documents to catch the violations of the invariants of the application.
+func WithSchemaValidator(validator func(root *crdt.Object) error) Option {
+	return func(o *Options) { o.SchemaValidator = validator }
+}
+
+// WithWatchTransport configures the transport of the watch streams of
+// documents.
+func WithWatchTransport(transport WatchTransport) Option {
+	return func(o *Options) { o.WatchTransport = transport }
+}
+
 // AttachOption configures AttachOptions.
 type AttachOption func(*AttachOptions)
 
@@ -93,13 +244,144 @@ type AttachOption func(*AttachOptions)
 type AttachOptions struct {
 	// Presence is the presence of the client.
 	Presence innerpresence.Presence
+
+	// Labels are the labels of the document. They are set only when the
+	// document is created by this attachment.
+	Labels map[string]string
+
+	// PathFilter is the path of the subtree to subscribe to.
+	PathFilter string
+
+	// SyncMode is the mode of the synchronization of the document. Default is
+	// SyncModeRealtime.
+	SyncMode SyncMode
+
+	// SyncInterval is the minimum interval between the synchronizations of
+	// the document by Sync without document keys. If it is zero, the document
+	// is synchronized whenever Sync is called.
+	SyncInterval time.Duration
 }
 
+// SyncMode is the mode of the synchronization of an attached document.
+type SyncMode string
+
+const (
+	// SyncModeRealtime pushes and pulls the changes of the document whenever
+	// the attached documents are synchronized.
+	SyncModeRealtime SyncMode = "realtime"
+
+	// SyncModeManual synchronizes the document only when its key is given to
+	// Sync.
+	SyncModeManual SyncMode = "manual"
+
+	// SyncModePushOnly pushes the local changes of the document without
+	// pulling the changes of the other replicas.
+	SyncModePushOnly SyncMode = "push-only"
+
+	// SyncModePullOnly pulls the changes of the other replicas without pushing
+	// the local changes of the document. The local changes are kept until they
+	// are pushed by Sync with WithPushOnly.
+	SyncModePullOnly SyncMode = "pull-only"
+)
+
 // WithPresence configures the presence of the client.
 func WithPresence(presence innerpresence.Presence) AttachOption {
 	return func(o *AttachOptions) { o.Presence = presence }
 }
 
+// WithLabels configures the labels of the document to be created.
+func WithLabels(labels map[string]string) AttachOption {
+	return func(o *AttachOptions) { o.Labels = labels }
+}
+
+// WithSyncMode configures the mode of the synchronization of the document,
+// such as SyncModeManual for documents that are synchronized on demand.
+func WithSyncMode(mode SyncMode) AttachOption {
+	return func(o *AttachOptions) { o.SyncMode = mode }
+}
+
+// WithSyncInterval configures the minimum interval between the
+// synchronizations of the document by Sync without document keys, so that
+// low-priority documents use less bandwidth than the others.
+func WithSyncInterval(interval time.Duration) AttachOption {
+	return func(o *AttachOptions) { o.SyncInterval = interval }
+}
+
+// WithPathFilter configures the path of the subtree to subscribe to, such as
+// "$.rows[*].cells". The server then only sends the changes affecting the
+// subtree, and the snapshots without the members of the objects out of the
+// subtree. Since the other parts of the document are not kept up to date, the
+// document should only be updated within the subtree.
+func WithPathFilter(path string) AttachOption {
+	return func(o *AttachOptions) { o.PathFilter = path }
+}
+
+// WatchOption configures WatchOptions.
+type WatchOption func(*WatchOptions)
+
+// WatchOptions configures how we watch the document.
+type WatchOptions struct {
+	// Query is the path of the value that the server evaluates after the
+	// changes of the document, such as "$.todos.0.title".
+	Query string
+
+	// Reconnect is the backoff of reconnecting the watch stream after
+	// transient disconnects. If it is nil, the watch is not reconnected.
+	Reconnect *Backoff
+}
+
+// Backoff configures the intervals of retries, which start from
+// InitialInterval and double up to MaxInterval.
+type Backoff struct {
+	// InitialInterval is the interval before the first retry. Default is
+	// DefaultBackoffInitialInterval.
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum interval between retries. Default is
+	// DefaultBackoffMaxInterval.
+	MaxInterval time.Duration
+
+	// MaxRetries is the maximum number of retries. If it is zero, it retries
+	// until the context is done.
+	MaxRetries int
+}
+
+// interval returns the interval before the retry of the given count.
+func (b *Backoff) interval(retries int) time.Duration {
+	initialInterval, maxInterval := b.InitialInterval, b.MaxInterval
+	if initialInterval <= 0 {
+		initialInterval = DefaultBackoffInitialInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultBackoffMaxInterval
+	}
+
+	interval := initialInterval
+	for i := 0; i < retries && interval < maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxInterval {
+		return maxInterval
+	}
+	return interval
+}
+
+// WithQuery configures the watch to receive the value at the given path of
+// the document instead of the document changed events. The value is sent
+// when the watch starts and whenever it changes.
+func WithQuery(path string) WatchOption {
+	return func(o *WatchOptions) { o.Query = path }
+}
+
+// WithReconnect configures the watch to reconnect the stream with the given
+// backoff after transient disconnects, such as a restart of the server,
+// instead of closing the channel. Disconnected and Reconnected are sent to
+// the channel, and DocumentChanged follows Reconnected so that the changes
+// made while disconnected are synced.
+func WithReconnect(backoff Backoff) WatchOption {
+	return func(o *WatchOptions) { o.Reconnect = &backoff }
+}
+
 // DetachOption configures DetachOptions.
 type DetachOption func(*DetachOptions)
 
@@ -0,0 +1,216 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"encoding/hex"
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// Store is the local storage where the client persists the documents that have
+// local changes not pushed to the server, so that the changes survive restarts
+// of the process. A store should be used by only one client.
+type Store interface {
+	// Save stores the given data of the document of the given key.
+	Save(docKey key.Key, data []byte) error
+
+	// Load returns the data of the document of the given key. It returns nil
+	// if the document is not stored.
+	Load(docKey key.Key) ([]byte, error)
+
+	// Delete deletes the data of the document of the given key.
+	Delete(docKey key.Key) error
+
+	// Keys returns the keys of the stored documents.
+	Keys() ([]key.Key, error)
+}
+
+// fileExt is the extension of the files of FileStore.
+const fileExt = ".doc"
+
+// FileStore is a Store that keeps each document in a file of the directory.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore creates an instance of FileStore with the given directory. The
+// directory is created if it does not exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("create store directory: %w", err)
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Save stores the given data of the document of the given key. The data is
+// written to a temporary file first so that the previous data is kept if the
+// process stops while writing.
+func (s *FileStore) Save(docKey key.Key, data []byte) error {
+	f, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("save %s: %w", docKey, err)
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("save %s: %w", docKey, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("save %s: %w", docKey, err)
+	}
+	if err := os.Rename(f.Name(), s.path(docKey)); err != nil {
+		return fmt.Errorf("save %s: %w", docKey, err)
+	}
+
+	return nil
+}
+
+// Load returns the data of the document of the given key.
+func (s *FileStore) Load(docKey key.Key) ([]byte, error) {
+	data, err := os.ReadFile(s.path(docKey))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load %s: %w", docKey, err)
+	}
+
+	return data, nil
+}
+
+// Delete deletes the data of the document of the given key.
+func (s *FileStore) Delete(docKey key.Key) error {
+	if err := os.Remove(s.path(docKey)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete %s: %w", docKey, err)
+	}
+
+	return nil
+}
+
+// Keys returns the keys of the stored documents.
+func (s *FileStore) Keys() ([]key.Key, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read store directory: %w", err)
+	}
+
+	var keys []key.Key
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, fileExt) {
+			continue
+		}
+
+		k, err := hex.DecodeString(strings.TrimSuffix(name, fileExt))
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key.Key(k))
+	}
+
+	return keys, nil
+}
+
+// path returns the path of the file of the document of the given key. The key
+// is hex-encoded since it can have characters not allowed in file names.
+func (s *FileStore) path(docKey key.Key) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(docKey))+fileExt)
+}
+
+// storedDocument is the state of a document persisted in the store.
+type storedDocument struct {
+	DocID      string `json:"doc_id"`
+	PathFilter string `json:"path_filter"`
+	Lamport    int64  `json:"lamport"`
+
+	// Pack is the encoded change pack that has the snapshot of the document
+	// with its checkpoint, and the local changes not pushed to the server.
+	Pack []byte `json:"pack"`
+}
+
+// encodeAttachment encodes the document of the given attachment with its local
+// changes to persist it in the store.
+func encodeAttachment(attachment *Attachment) ([]byte, error) {
+	doc := attachment.doc
+	snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+	if err != nil {
+		return nil, err
+	}
+
+	pbPack, err := converter.ToChangePack(change.NewPack(
+		doc.Key(),
+		doc.Checkpoint(),
+		doc.CreateChangePack().Changes,
+		snapshot,
+	))
+	if err != nil {
+		return nil, err
+	}
+	pack, err := pbPack.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal pack of %s: %w", doc.Key(), err)
+	}
+
+	return gojson.Marshal(&storedDocument{
+		DocID:      attachment.docID.String(),
+		PathFilter: attachment.pathFilter,
+		Lamport:    doc.InternalDocument().Lamport(),
+		Pack:       pack,
+	})
+}
+
+// decodeDocument restores the given data of the store into the given document,
+// and returns the stored state of the document.
+func decodeDocument(doc *document.Document, data []byte) (*storedDocument, error) {
+	stored := &storedDocument{}
+	if err := gojson.Unmarshal(data, stored); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", doc.Key(), err)
+	}
+
+	pbPack := &api.ChangePack{}
+	if err := pbPack.Unmarshal(stored.Pack); err != nil {
+		return nil, fmt.Errorf("unmarshal pack of %s: %w", doc.Key(), err)
+	}
+	pack, err := converter.FromChangePack(pbPack)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := pack.Changes
+	pack.Changes = nil
+	if err := doc.ApplyChangePack(pack); err != nil {
+		return nil, err
+	}
+	doc.RestoreLocalChanges(stored.Lamport, changes)
+
+	return stored, nil
+}
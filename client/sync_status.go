@@ -0,0 +1,145 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	gosync "sync"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// SyncState represents whether the document is in sync with the server.
+type SyncState string
+
+const (
+	// Synced means that the last synchronization with the server succeeded.
+	Synced SyncState = "synced"
+
+	// OutOfSync means that the last synchronization with the server failed.
+	OutOfSync SyncState = "out-of-sync"
+)
+
+// SyncStatus represents the synchronization status of an attached document.
+type SyncStatus struct {
+	// State is whether the document is in sync with the server.
+	State SyncState
+
+	// PendingChanges is the number of the local changes that are not yet
+	// pushed to the server.
+	PendingChanges int
+
+	// LastSyncedAt is the time of the last successful push-pull.
+	LastSyncedAt gotime.Time
+
+	// LastError is the error of the last push-pull. It is nil if the last
+	// push-pull succeeded.
+	LastError error
+
+	// SnapshotPulling is whether a snapshot pulled from the server is being
+	// applied to the document.
+	SnapshotPulling bool
+
+	// ValidationError is the error of validating the document by the schema
+	// validator after the last changes pulled from the server. It is nil if
+	// the document is valid, and non-nil means that the document diverged
+	// from the schema by the changes of other replicas.
+	ValidationError error
+}
+
+// SyncStatusEvent is emitted when the document transitions between synced
+// and out-of-sync states.
+type SyncStatusEvent struct {
+	// DocumentKey is the key of the document.
+	DocumentKey key.Key
+
+	// State is the new state of the document.
+	State SyncState
+
+	// Err is the error that made the document out of sync.
+	Err error
+}
+
+// syncTracker tracks the synchronization status of an attached document. It
+// can be read by other goroutines while the document is synchronized.
+type syncTracker struct {
+	mu              gosync.Mutex
+	state           SyncState
+	lastSyncedAt    gotime.Time
+	lastErr         error
+	snapshotPulling bool
+	validationErr   error
+}
+
+// newSyncTracker creates a new instance of syncTracker for the document that
+// has just been attached.
+func newSyncTracker() *syncTracker {
+	return &syncTracker{
+		state:        Synced,
+		lastSyncedAt: gotime.Now(),
+	}
+}
+
+// setSnapshotPulling sets whether a snapshot is being applied.
+func (t *syncTracker) setSnapshotPulling(pulling bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.snapshotPulling = pulling
+}
+
+// setValidationErr sets the error of validating the document.
+func (t *syncTracker) setValidationErr(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.validationErr = err
+}
+
+// record records the result of a push-pull, and returns the new state and
+// whether the state has changed.
+func (t *syncTracker) record(err error) (SyncState, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state := Synced
+	if err != nil {
+		state = OutOfSync
+	} else {
+		t.lastSyncedAt = gotime.Now()
+	}
+	t.lastErr = err
+
+	changed := t.state != state
+	t.state = state
+	return state, changed
+}
+
+// status returns the status with the given number of pending changes.
+func (t *syncTracker) status(pendingChanges int) *SyncStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return &SyncStatus{
+		State:           t.state,
+		PendingChanges:  pendingChanges,
+		LastSyncedAt:    t.lastSyncedAt,
+		LastError:       t.lastErr,
+		SnapshotPulling: t.snapshotPulling,
+		ValidationError: t.validationErr,
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// QuotaViolation describes a single quota check failure reported by the
+// server.
+type QuotaViolation struct {
+	Subject     string
+	Description string
+}
+
+// ThrottledError is returned when the server rejects a request because of a
+// rate limit or a quota. Callers should wait for RetryAfter before sending
+// the request again.
+type ThrottledError struct {
+	err        error
+	retryAfter time.Duration
+	violations []QuotaViolation
+}
+
+// Error returns the message of this error.
+func (e *ThrottledError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the original status error from the server.
+func (e *ThrottledError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus returns the status of the original error so that status.Code and
+// status.Convert keep working on this error.
+func (e *ThrottledError) GRPCStatus() *grpcstatus.Status {
+	return grpcstatus.Convert(e.err)
+}
+
+// RetryAfter returns the duration to wait before retrying the request. It
+// returns 0 if the server did not specify the duration.
+func (e *ThrottledError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// Violations returns the quota violations reported by the server.
+func (e *ThrottledError) Violations() []QuotaViolation {
+	return e.violations
+}
+
+// toThrottledError converts the given status error into ThrottledError if
+// the server rejected the request because of a rate limit or a quota.
+// Otherwise, it returns the given error as is.
+func toThrottledError(err error) error {
+	st, ok := grpcstatus.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return err
+	}
+
+	throttledErr := &ThrottledError{err: err}
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.RetryInfo:
+			throttledErr.retryAfter = d.RetryDelay.AsDuration()
+		case *errdetails.QuotaFailure:
+			for _, v := range d.Violations {
+				throttledErr.violations = append(throttledErr.violations, QuotaViolation{
+					Subject:     v.Subject,
+					Description: v.Description,
+				})
+			}
+		}
+	}
+
+	return throttledErr
+}
+
+// throttleUnaryInterceptor converts throttling errors of unary calls.
+func throttleUnaryInterceptor(
+	ctx context.Context,
+	method string,
+	req,
+	reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	return toThrottledError(invoker(ctx, method, req, reply, cc, opts...))
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/websocket"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+)
+
+// watchDocumentMethod is the path of WatchDocument, which is also the path of
+// its WebSocket endpoint.
+const watchDocumentMethod = "/yorkie.v1.YorkieService/WatchDocument"
+
+// The flags of the messages from the WebSocket endpoint of the server. Each
+// message is a flag followed by a response, or by the final status of the
+// call as google.rpc.Status.
+const (
+	webSocketFlagMessage = 0x00
+	webSocketFlagStatus  = 0x02
+)
+
+// watchStream is the stream of the responses of WatchDocument.
+type watchStream interface {
+	Recv() (*api.WatchDocumentResponse, error)
+}
+
+// watchDocument opens the watch stream of the given request over the watch
+// transport of the client.
+func (c *Client) watchDocument(ctx context.Context, req *api.WatchDocumentRequest) (watchStream, error) {
+	if c.options.WatchTransport == WatchTransportWebSocket {
+		return c.watchDocumentOverWebSocket(ctx, req)
+	}
+
+	return c.client.WatchDocument(ctx, req)
+}
+
+// watchDocumentOverWebSocket opens the watch stream of the given request over
+// WebSocket. The errors of the stream are status errors like the ones of
+// gRPC, so that the stream is reconnected in the same way.
+func (c *Client) watchDocumentOverWebSocket(
+	ctx context.Context,
+	req *api.WatchDocumentRequest,
+) (watchStream, error) {
+	network, address, host := "tcp", c.rpcAddr, c.rpcAddr
+	if strings.HasPrefix(address, "unix:") {
+		address = strings.TrimPrefix(strings.TrimPrefix(address, "unix://"), "unix:")
+		network, host = "unix", "localhost"
+	}
+
+	scheme := "ws"
+	if c.options.CertFile != "" {
+		scheme = "wss"
+	}
+	config, err := websocket.NewConfig(scheme+"://"+host+watchDocumentMethod, "http://"+host)
+	if err != nil {
+		return nil, fmt.Errorf("watch over websocket: %w", err)
+	}
+	md, _ := metadata.FromOutgoingContext(c.authInterceptor.appendMetadata(ctx))
+	for k, vv := range md {
+		for _, v := range vv {
+			config.Header.Add(k, v)
+		}
+	}
+
+	var dialer net.Dialer
+	rawConn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unavailable, err.Error())
+	}
+	stream := &webSocketStream{ctx: ctx, done: make(chan struct{})}
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stream.done:
+		}
+		_ = rawConn.Close()
+	}()
+
+	conn := rawConn
+
+	if scheme == "wss" {
+		tlsConfig, err := c.webSocketTLSConfig(host)
+		if err != nil {
+			stream.close()
+			return nil, err
+		}
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	ws, err := websocket.NewClient(config, conn)
+	if err != nil {
+		return nil, stream.fail(err)
+	}
+	stream.conn = ws
+
+	data, err := req.Marshal()
+	if err != nil {
+		stream.close()
+		return nil, fmt.Errorf("marshal watch request: %w", err)
+	}
+	if err := websocket.Message.Send(ws, data); err != nil {
+		return nil, stream.fail(err)
+	}
+
+	return stream, nil
+}
+
+// webSocketTLSConfig returns the TLS config of WebSocket with the certificate
+// file of the client.
+func (c *Client) webSocketTLSConfig(host string) (*tls.Config, error) {
+	cert, err := os.ReadFile(c.options.CertFile)
+	if err != nil {
+		return nil, fmt.Errorf("read cert file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(cert) {
+		return nil, fmt.Errorf("no certificates in %s", c.options.CertFile)
+	}
+
+	serverName := c.options.ServerNameOverride
+	if serverName == "" {
+		if serverName, _, err = net.SplitHostPort(host); err != nil {
+			serverName = host
+		}
+	}
+
+	return &tls.Config{
+		RootCAs:    pool,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
+// webSocketStream is the watch stream over WebSocket.
+type webSocketStream struct {
+	ctx  context.Context
+	conn *websocket.Conn
+
+	once sync.Once
+	done chan struct{}
+	err  error
+}
+
+// Recv receives the next response of the stream. It returns io.EOF if the
+// stream ends successfully.
+func (s *webSocketStream) Recv() (*api.WatchDocumentResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	var msg []byte
+	if err := websocket.Message.Receive(s.conn, &msg); err != nil {
+		return nil, s.fail(err)
+	}
+	if len(msg) == 0 {
+		return nil, s.fail(errors.New("empty message"))
+	}
+
+	switch msg[0] {
+	case webSocketFlagMessage:
+		resp := &api.WatchDocumentResponse{}
+		if err := resp.Unmarshal(msg[1:]); err != nil {
+			return nil, s.fail(fmt.Errorf("unmarshal watch response: %w", err))
+		}
+		return resp, nil
+	case webSocketFlagStatus:
+		st := &spb.Status{}
+		if err := proto.Unmarshal(msg[1:], st); err != nil {
+			return nil, s.fail(fmt.Errorf("unmarshal watch status: %w", err))
+		}
+
+		s.err = io.EOF
+		if codes.Code(st.Code) != codes.OK {
+			s.err = grpcstatus.ErrorProto(st)
+		}
+		s.close()
+		return nil, s.err
+	}
+
+	return nil, s.fail(fmt.Errorf("unknown message flag %d", msg[0]))
+}
+
+// fail closes the stream with the status error of the given error, and
+// returns it.
+func (s *webSocketStream) fail(err error) error {
+	if s.ctx.Err() != nil {
+		s.err = grpcstatus.FromContextError(s.ctx.Err()).Err()
+	} else {
+		s.err = grpcstatus.Error(codes.Unavailable, err.Error())
+	}
+	s.close()
+	return s.err
+}
+
+// close closes the connection of the stream.
+func (s *webSocketStream) close() {
+	s.once.Do(func() {
+		close(s.done)
+	})
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/test/loadtest"
+)
+
+var (
+	errNotConverged = errors.New("documents did not converge")
+
+	benchAPIKey    string
+	benchDocuments int
+	benchWriters   int
+	benchReaders   int
+	benchWorkload  string
+	benchRounds    int
+	benchChurnRate float64
+	benchSeed      int64
+)
+
+func newBenchCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bench",
+		Short: "Run a load test against the server",
+		Long: "Run a load test in which writers update and readers sync documents " +
+			"concurrently, and report the throughput and latencies of syncs.",
+		Example:      "yorkie bench --api-key [public key] --docs 10 --writers 5 --readers 5 --workload text",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scenario := loadtest.Scenario{
+				Name:      benchWorkload,
+				Documents: benchDocuments,
+				Writers:   benchWriters,
+				Readers:   benchReaders,
+				Workload:  loadtest.Workload(benchWorkload),
+				Rounds:    benchRounds,
+				ChurnRate: benchChurnRate,
+				Seed:      benchSeed,
+			}
+
+			var opts []client.Option
+			if benchAPIKey != "" {
+				opts = append(opts, client.WithAPIKey(benchAPIKey))
+			}
+
+			result, err := loadtest.Run(context.Background(), config.RPCAddr, scenario, opts...)
+			if err != nil {
+				return err
+			}
+			if err := result.Report(cmd.OutOrStdout()); err != nil {
+				return err
+			}
+
+			if !result.Converged {
+				return errNotConverged
+			}
+			return nil
+		},
+	}
+}
+
+func init() {
+	var workloads []string
+	for _, w := range loadtest.Workloads() {
+		workloads = append(workloads, string(w))
+	}
+
+	cmd := newBenchCmd()
+	cmd.Flags().StringVar(
+		&benchAPIKey,
+		"api-key",
+		"",
+		"The public key of the project to run the load test in",
+	)
+	cmd.Flags().IntVar(
+		&benchDocuments,
+		"docs",
+		1,
+		"The number of documents",
+	)
+	cmd.Flags().IntVar(
+		&benchWriters,
+		"writers",
+		2,
+		"The number of writers of each document",
+	)
+	cmd.Flags().IntVar(
+		&benchReaders,
+		"readers",
+		0,
+		"The number of readers of each document",
+	)
+	cmd.Flags().StringVar(
+		&benchWorkload,
+		"workload",
+		string(loadtest.WorkloadText),
+		fmt.Sprintf("The workload of writers (%s)", strings.Join(workloads, ", ")),
+	)
+	cmd.Flags().IntVar(
+		&benchRounds,
+		"rounds",
+		10,
+		"The number of rounds that each client runs",
+	)
+	cmd.Flags().Float64Var(
+		&benchChurnRate,
+		"churn-rate",
+		0,
+		"The probability that a client leaves and rejoins after a round",
+	)
+	cmd.Flags().Int64Var(
+		&benchSeed,
+		"seed",
+		0,
+		"The seed of the random source of updates and churns",
+	)
+	rootCmd.AddCommand(cmd)
+}
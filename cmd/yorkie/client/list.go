@@ -0,0 +1,121 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/units"
+)
+
+var (
+	previousID string
+	pageSize   int32
+	isForward  bool
+)
+
+func newListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "ls [project name]",
+		Short: "List all clients in the project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("project is required")
+			}
+			projectName := args[0]
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			clients, err := cli.ListClients(ctx, projectName, previousID, pageSize, isForward)
+			if err != nil {
+				return err
+			}
+
+			tw := table.NewWriter()
+			tw.Style().Options.DrawBorder = false
+			tw.Style().Options.SeparateColumns = false
+			tw.Style().Options.SeparateFooter = false
+			tw.Style().Options.SeparateHeader = false
+			tw.Style().Options.SeparateRows = false
+			tw.AppendHeader(table.Row{
+				"ID",
+				"KEY",
+				"STATUS",
+				"IP",
+				"USER AGENT",
+				"SOURCE",
+				"CREATED AT",
+				"UPDATED AT",
+			})
+			for _, client := range clients {
+				tw.AppendRow(table.Row{
+					client.ID,
+					client.Key,
+					client.Status,
+					client.Connection.IP,
+					client.Connection.UserAgent,
+					client.Connection.Source,
+					units.HumanDuration(time.Now().UTC().Sub(client.CreatedAt)),
+					units.HumanDuration(time.Now().UTC().Sub(client.UpdatedAt)),
+				})
+			}
+			cmd.Printf("%s\n", tw.Render())
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newListCommand()
+	cmd.Flags().StringVar(
+		&previousID,
+		"previous-id",
+		"",
+		"The previous client ID to start from",
+	)
+	cmd.Flags().Int32Var(
+		&pageSize,
+		"size",
+		10,
+		"The number of clients to output per page",
+	)
+	cmd.Flags().BoolVar(
+		&isForward,
+		"forward",
+		false,
+		"Whether to search forward or backward",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -22,9 +22,11 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/yorkie-team/yorkie/cmd/yorkie/client"
 	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
 	"github.com/yorkie-team/yorkie/cmd/yorkie/document"
 	"github.com/yorkie-team/yorkie/cmd/yorkie/project"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/template"
 )
 
 var rootCmd = &cobra.Command{
@@ -46,6 +48,8 @@ func init() {
 	rootCmd.SetErr(os.Stderr)
 	rootCmd.AddCommand(project.SubCmd)
 	rootCmd.AddCommand(document.SubCmd)
+	rootCmd.AddCommand(client.SubCmd)
+	rootCmd.AddCommand(template.SubCmd)
 	// TODO(chacha912): set rpcAddr from env using viper.
 	// https://github.com/spf13/cobra/blob/main/user_guide.md#bind-flags-with-config
 	rootCmd.PersistentFlags().StringVar(&config.RPCAddr, "rpc-addr", "localhost:11101", "Address of the rpc server")
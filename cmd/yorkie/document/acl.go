@@ -0,0 +1,139 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	flagReaders []string
+	flagWriters []string
+	flagAdmins  []string
+
+	flagPublicRead bool
+)
+
+func newACLCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "acl [project name] [document key]",
+		Short: "Update the access control list of the document",
+		Long: `Update the access control list of the document. Only the subjects in the
+list can access the document, and a document without any subject is not
+restricted. Use "*" as a subject to allow every user. With --public-read,
+anyone can attach and watch the document without a token, while writes still
+require the authentication. Anonymous readers must be able to activate, so
+ActivateClient and DeactivateClient should not be in the auth webhook methods
+of the project.`,
+		Example: "yorkie document acl sample-project sample-document --readers '*' --writers alice,bob",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := args[1]
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			acl, err := cli.UpdateDocumentACL(ctx, projectName, key.Key(documentKey), &types.DocumentACL{
+				Readers:    flagReaders,
+				Writers:    flagWriters,
+				Admins:     flagAdmins,
+				PublicRead: flagPublicRead,
+			})
+			if err != nil {
+				return err
+			}
+
+			if acl.IsEmpty() && !acl.AllowsPublicRead() {
+				cmd.Printf("%s is not restricted\n", documentKey)
+				return nil
+			}
+
+			tw := table.NewWriter()
+			tw.Style().Options.DrawBorder = false
+			tw.Style().Options.SeparateColumns = false
+			tw.Style().Options.SeparateFooter = false
+			tw.Style().Options.SeparateHeader = false
+			tw.Style().Options.SeparateRows = false
+			tw.AppendHeader(table.Row{
+				"READERS",
+				"WRITERS",
+				"ADMINS",
+				"PUBLIC READ",
+			})
+			tw.AppendRow(table.Row{
+				strings.Join(acl.Readers, ","),
+				strings.Join(acl.Writers, ","),
+				strings.Join(acl.Admins, ","),
+				acl.AllowsPublicRead(),
+			})
+			cmd.Printf("%s\n", tw.Render())
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newACLCommand()
+	cmd.Flags().StringSliceVar(
+		&flagReaders,
+		"readers",
+		nil,
+		"subjects that can read the document",
+	)
+	cmd.Flags().StringSliceVar(
+		&flagWriters,
+		"writers",
+		nil,
+		"subjects that can read and write the document",
+	)
+	cmd.Flags().StringSliceVar(
+		&flagAdmins,
+		"admins",
+		nil,
+		"subjects that can read, write and remove the document",
+	)
+	cmd.Flags().BoolVar(
+		&flagPublicRead,
+		"public-read",
+		false,
+		"allow anyone to read the document without authentication",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	errReplicaDiverged = errors.New("replicas of document diverged")
+
+	checkPeerRPCAddr string
+)
+
+// replica is the state of a document rebuilt from its change log or built by
+// a server.
+type replica struct {
+	name      string
+	serverSeq int64
+	root      string
+}
+
+// hash returns the content hash of the root of this replica.
+func (r *replica) hash() string {
+	sum := sha256.Sum256([]byte(r.root))
+	return hex.EncodeToString(sum[:])
+}
+
+func newCheckCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check [project name] [document key]",
+		Short: "Check that the replicas of a document are consistent",
+		Long: "Rebuild a document from its change log and compare its root with the " +
+			"root built by the server at the same server sequence. If a peer is given, " +
+			"the root built by the peer server is compared as well. The document is " +
+			"read through the admin API, so no client is attached to it.",
+		Example:      "yorkie document check sample-project sample-document --peer-rpc-addr localhost:11201",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			ctx := context.Background()
+			localCli, err := dialAdmin(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = localCli.Close()
+			}()
+
+			doc, serverSeq, err := localCli.RebuildDocument(ctx, projectName, documentKey)
+			if err != nil {
+				return err
+			}
+			rebuilt := &replica{
+				name:      "changes",
+				serverSeq: serverSeq,
+				root:      doc.Marshal(),
+			}
+
+			local, err := readServerReplica(ctx, localCli, "local", projectName, documentKey, serverSeq)
+			if err != nil {
+				return err
+			}
+			replicas := []*replica{rebuilt, local}
+
+			if checkPeerRPCAddr != "" {
+				peerCli, err := dialAdmin(checkPeerRPCAddr)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					_ = peerCli.Close()
+				}()
+
+				peer, err := readServerReplica(ctx, peerCli, "peer", projectName, documentKey, serverSeq)
+				if err != nil {
+					return err
+				}
+				replicas = append(replicas, peer)
+			}
+
+			for _, r := range replicas {
+				cmd.Printf("%s server seq: %d\n", r.name, r.serverSeq)
+				cmd.Printf("%s hash:       %s\n", r.name, r.hash())
+			}
+			for _, r := range replicas[1:] {
+				if r.hash() != rebuilt.hash() {
+					return fmt.Errorf("%s: %w", r.name, errReplicaDiverged)
+				}
+			}
+
+			cmd.Println("ok")
+			return nil
+		},
+	}
+}
+
+// dialAdmin dials the admin service of the given server with the token that
+// was stored when logging in to it.
+func dialAdmin(rpcAddr string) (*admin.Client, error) {
+	token, err := config.LoadToken(rpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return admin.Dial(rpcAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+}
+
+// readServerReplica returns the replica built by the server of the given
+// client at the given server seq.
+func readServerReplica(
+	ctx context.Context,
+	cli *admin.Client,
+	name string,
+	projectName string,
+	documentKey key.Key,
+	serverSeq int64,
+) (*replica, error) {
+	snapshot, err := cli.GetSnapshot(ctx, projectName, documentKey, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+	root, _, err := converter.BytesToSnapshot(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+
+	return &replica{
+		name:      name,
+		serverSeq: serverSeq,
+		root:      root.Marshal(),
+	}, nil
+}
+
+func init() {
+	cmd := newCheckCommand()
+	cmd.Flags().StringVar(
+		&checkPeerRPCAddr,
+		"peer-rpc-addr",
+		"",
+		"The address of the peer server to compare with (the database if empty)",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	fromFile string
+	toFile   string
+	fromSeq  int64
+	toSeq    int64
+)
+
+func newDiffCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [project name] [document key]",
+		Short: "Show the differences between two snapshots of a document",
+		Long: "Show the differences between two snapshots of a document. Each side is " +
+			"read from a snapshot file if given, otherwise it is built by the server " +
+			"at the given server sequence. If the sequence is 0, the live state is used.",
+		Example: "yorkie document diff sample-project sample-document --from-file backup.snapshot",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			if fromFile == "" && fromSeq == 0 {
+				return errors.New("--from-file or --from-seq is required")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			from, err := loadSnapshot(ctx, cli, projectName, documentKey, fromFile, fromSeq)
+			if err != nil {
+				return err
+			}
+			to, err := loadSnapshot(ctx, cli, projectName, documentKey, toFile, toSeq)
+			if err != nil {
+				return err
+			}
+
+			diffs, err := document.DiffSnapshots(from, to)
+			if err != nil {
+				return err
+			}
+			if len(diffs) == 0 {
+				cmd.Println("no differences")
+				return nil
+			}
+
+			tw := table.NewWriter()
+			tw.Style().Options.DrawBorder = false
+			tw.Style().Options.SeparateColumns = false
+			tw.Style().Options.SeparateFooter = false
+			tw.Style().Options.SeparateHeader = false
+			tw.Style().Options.SeparateRows = false
+			tw.AppendHeader(table.Row{
+				"TYPE",
+				"PATH",
+				"FROM",
+				"TO",
+			})
+			for _, diff := range diffs {
+				tw.AppendRow(table.Row{
+					diff.Type,
+					diff.Path,
+					diff.From,
+					diff.To,
+				})
+			}
+			cmd.Printf("%s\n", tw.Render())
+			return nil
+		},
+	}
+}
+
+// loadSnapshot reads the snapshot from the given file, or fetches it from the
+// server at the given server sequence if the file is not given.
+func loadSnapshot(
+	ctx context.Context,
+	cli *admin.Client,
+	projectName string,
+	documentKey key.Key,
+	file string,
+	serverSeq int64,
+) ([]byte, error) {
+	if file != "" {
+		snapshot, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read snapshot %s: %w", file, err)
+		}
+		return snapshot, nil
+	}
+
+	if serverSeq == 0 {
+		serverSeq = math.MaxInt64
+	}
+	return cli.GetSnapshot(ctx, projectName, documentKey, serverSeq)
+}
+
+func init() {
+	cmd := newDiffCommand()
+	cmd.Flags().StringVar(
+		&fromFile,
+		"from-file",
+		"",
+		"The snapshot file to diff from",
+	)
+	cmd.Flags().StringVar(
+		&toFile,
+		"to-file",
+		"",
+		"The snapshot file to diff to",
+	)
+	cmd.Flags().Int64Var(
+		&fromSeq,
+		"from-seq",
+		0,
+		"The server sequence of the snapshot to diff from",
+	)
+	cmd.Flags().Int64Var(
+		&toSeq,
+		"to-seq",
+		0,
+		"The server sequence of the snapshot to diff to (0 means the live state)",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	flagReason string
+)
+
+func newEvictCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "evict [project name] [document key]",
+		Short: "Detach a document from all the clients",
+		Long: `Detach the document from all the clients attaching it, e.g. for
+maintenance. The watchers of the document are notified with the reason.`,
+		Example: "yorkie document evict sample-project sample-document --reason maintenance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			clientIDs, err := cli.EvictDocument(ctx, projectName, documentKey, flagReason)
+			if err != nil {
+				return err
+			}
+
+			for _, id := range clientIDs {
+				cmd.Printf("%s detached from %s\n", id, documentKey)
+			}
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newEvictCommand()
+	cmd.Flags().StringVar(
+		&flagReason,
+		"reason",
+		"",
+		"the reason of the eviction notified to the watchers",
+	)
+	SubCmd.AddCommand(cmd)
+}
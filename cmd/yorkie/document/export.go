@@ -0,0 +1,89 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	withChanges bool
+)
+
+func newExportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [project name] [document key] [file]",
+		Short: "Export a document to a file",
+		Long: `Export the latest state of the document to the file, e.g. for backups or
+migrations between clusters. With --with-changes, the full change history of
+the document is exported together, which fails if the changes are purged.
+The file can be loaded by 'yorkie document import'.`,
+		Example: "yorkie document export sample-project sample-document sample.ydoc --with-changes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				return errors.New("project name, document key and file are required")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			data, err := cli.ExportDocument(ctx, projectName, documentKey, withChanges)
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(args[2], data, 0600); err != nil {
+				return fmt.Errorf("write export file: %w", err)
+			}
+
+			cmd.Printf("%s exported to %s (%d bytes)\n", documentKey, args[2], len(data))
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newExportCommand()
+	cmd.Flags().BoolVar(
+		&withChanges,
+		"with-changes",
+		false,
+		"Whether to export the full change history of the document",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func newGCCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "gc [project name] [document key]",
+		Short: "Purge the garbage of a document",
+		Long: `Purge the removed elements of the document that all the clients
+attaching it have synchronized, and store a snapshot without them instead of
+waiting for the next snapshot.`,
+		Example: "yorkie document gc sample-project sample-document",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			removed, serverSeq, err := cli.GCDocument(ctx, projectName, documentKey)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("%d elements purged from %s (server seq: %d)\n", removed, documentKey, serverSeq)
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newGCCommand())
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func newImportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [project name] [document key] [file]",
+		Short: "Import a document from a file",
+		Long: `Create the document from the file written by 'yorkie document export'. The
+document key can differ from the key of the exported document, but no document
+with contents must exist for the key. If the file has the change history, the
+history is kept. Otherwise, the document starts with a single change.`,
+		Example: "yorkie document import sample-project sample-document sample.ydoc",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				return errors.New("project name, document key and file are required")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			data, err := os.ReadFile(args[2])
+			if err != nil {
+				return fmt.Errorf("read export file: %w", err)
+			}
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			serverSeq, err := cli.ImportDocument(ctx, projectName, documentKey, data)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("%s imported from %s (server seq: %d)\n", documentKey, args[2], serverSeq)
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newImportCommand())
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func newLabelCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "label [project name] [document key] [key=value...]",
+		Short: "Update the labels of the document",
+		Long: `Replace the labels of the document with the given labels. The labels are
+removed if none are given.`,
+		Example: "yorkie document label sample-project sample-document env=prod team=web",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := args[1]
+
+			labels := make(map[string]string)
+			for _, arg := range args[2:] {
+				k, v, ok := strings.Cut(arg, "=")
+				if !ok {
+					return fmt.Errorf("label %q must be in key=value format", arg)
+				}
+				labels[k] = v
+			}
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			updated, err := cli.UpdateDocumentLabels(ctx, projectName, key.Key(documentKey), labels)
+			if err != nil {
+				return err
+			}
+
+			if len(updated) == 0 {
+				cmd.Printf("%s has no labels\n", documentKey)
+				return nil
+			}
+
+			cmd.Printf("%s\n", types.LabelsString(updated))
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newLabelCommand())
+}
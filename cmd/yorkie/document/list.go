@@ -19,20 +19,26 @@ package document
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 
 	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
 	"github.com/yorkie-team/yorkie/pkg/units"
 )
 
 var (
-	previousID string
-	pageSize   int32
-	isForward  bool
+	previousID    string
+	pageSize      int32
+	isForward     bool
+	labelSelector string
+	keyPrefix     string
+	updatedAfter  string
+	updatedBefore string
 )
 
 func newListCommand() *cobra.Command {
@@ -57,8 +63,13 @@ func newListCommand() *cobra.Command {
 				_ = cli.Close()
 			}()
 
+			filter, err := newDocumentFilter()
+			if err != nil {
+				return err
+			}
+
 			ctx := context.Background()
-			documents, err := cli.ListDocuments(ctx, projectName, previousID, pageSize, isForward, true)
+			documents, err := cli.ListDocuments(ctx, projectName, filter, previousID, pageSize, isForward, true)
 			if err != nil {
 				return err
 			}
@@ -75,6 +86,7 @@ func newListCommand() *cobra.Command {
 				"CREATED AT",
 				"ACCESSED AT",
 				"UPDATED AT",
+				"LABELS",
 				"SNAPSHOT",
 			})
 			for _, document := range documents {
@@ -84,6 +96,7 @@ func newListCommand() *cobra.Command {
 					units.HumanDuration(time.Now().UTC().Sub(document.CreatedAt)),
 					units.HumanDuration(time.Now().UTC().Sub(document.AccessedAt)),
 					units.HumanDuration(time.Now().UTC().Sub(document.UpdatedAt)),
+					types.LabelsString(document.Labels),
 					document.Snapshot,
 				})
 			}
@@ -93,6 +106,31 @@ func newListCommand() *cobra.Command {
 	}
 }
 
+// newDocumentFilter creates the filter of documents from the flags.
+func newDocumentFilter() (types.DocumentFilter, error) {
+	selector, err := types.ParseLabelSelector(labelSelector)
+	if err != nil {
+		return types.DocumentFilter{}, err
+	}
+	filter := types.DocumentFilter{
+		Labels:    selector,
+		KeyPrefix: keyPrefix,
+	}
+
+	if updatedAfter != "" {
+		if filter.UpdatedAfter, err = time.Parse(time.RFC3339, updatedAfter); err != nil {
+			return types.DocumentFilter{}, fmt.Errorf("parse --updated-after: %w", err)
+		}
+	}
+	if updatedBefore != "" {
+		if filter.UpdatedBefore, err = time.Parse(time.RFC3339, updatedBefore); err != nil {
+			return types.DocumentFilter{}, fmt.Errorf("parse --updated-before: %w", err)
+		}
+	}
+
+	return filter, nil
+}
+
 func init() {
 	cmd := newListCommand()
 	cmd.Flags().StringVar(
@@ -113,5 +151,30 @@ func init() {
 		false,
 		"Whether to search forward or backward",
 	)
+	cmd.Flags().StringVarP(
+		&labelSelector,
+		"selector",
+		"l",
+		"",
+		"The label selector to filter documents, e.g. env=prod,team!=web",
+	)
+	cmd.Flags().StringVar(
+		&keyPrefix,
+		"key-prefix",
+		"",
+		"The prefix of the keys of documents to list",
+	)
+	cmd.Flags().StringVar(
+		&updatedAfter,
+		"updated-after",
+		"",
+		"List documents updated at or after the time in RFC3339, e.g. 2023-01-02T15:04:05Z",
+	)
+	cmd.Flags().StringVar(
+		&updatedBefore,
+		"updated-before",
+		"",
+		"List documents updated before the time in RFC3339, e.g. 2023-01-02T15:04:05Z",
+	)
 	SubCmd.AddCommand(cmd)
 }
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+)
+
+var memoryLimit int32
+
+func newMemoryCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "memory [project name]",
+		Short: "List the documents that the server holds the most memory for",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("project is required")
+			}
+			projectName := args[0]
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			memories, err := cli.ListDocumentMemories(ctx, projectName, memoryLimit)
+			if err != nil {
+				return err
+			}
+
+			tw := table.NewWriter()
+			tw.Style().Options.DrawBorder = false
+			tw.Style().Options.SeparateColumns = false
+			tw.Style().Options.SeparateFooter = false
+			tw.Style().Options.SeparateHeader = false
+			tw.Style().Options.SeparateRows = false
+			tw.AppendHeader(table.Row{
+				"ID",
+				"KEY",
+				"SUBSCRIPTIONS",
+				"LOCKS",
+				"SNAPSHOTS",
+				"BYTES",
+			})
+			for _, memory := range memories {
+				tw.AppendRow(table.Row{
+					memory.ID,
+					memory.Key,
+					memory.Subscriptions,
+					memory.Locks,
+					memory.Snapshots,
+					memory.Bytes(),
+				})
+			}
+			cmd.Printf("%s\n", tw.Render())
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newMemoryCommand()
+	cmd.Flags().Int32Var(
+		&memoryLimit,
+		"limit",
+		10,
+		"The number of documents to output",
+	)
+	SubCmd.AddCommand(cmd)
+}
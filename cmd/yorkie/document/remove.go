@@ -27,20 +27,26 @@ import (
 )
 
 var (
-	flagForce bool
+	flagForce    bool
+	flagSelector string
 )
 
 func newRemoveCommand() *cobra.Command {
 	return &cobra.Command{
-		Use:     "remove [project name] [document key]",
-		Short:   "Remove documents in the project",
-		Example: "yorkie document remove sample-project sample-document [options]",
+		Use:   "remove [project name] [document key]",
+		Short: "Remove documents in the project",
+		Long: `Remove the document of the given key, or the documents that match the
+label selector if --selector is given.`,
+		Example: `yorkie document remove sample-project sample-document [options]
+yorkie document remove sample-project --selector env=dev [options]`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) != 2 {
+			if flagSelector != "" && len(args) != 1 {
+				return errors.New("only project name is required with selector")
+			}
+			if flagSelector == "" && len(args) != 2 {
 				return errors.New("project name and document key are required")
 			}
 			projectName := args[0]
-			documentKey := args[1]
 
 			token, err := config.LoadToken(config.RPCAddr)
 			if err != nil {
@@ -56,7 +62,18 @@ func newRemoveCommand() *cobra.Command {
 
 			ctx := context.Background()
 
-			return cli.RemoveDocument(ctx, projectName, documentKey, flagForce)
+			if flagSelector == "" {
+				return cli.RemoveDocument(ctx, projectName, args[1], flagForce)
+			}
+
+			keys, err := cli.RemoveDocuments(ctx, projectName, flagSelector, flagForce)
+			if err != nil {
+				return err
+			}
+			for _, k := range keys {
+				cmd.Printf("%s removed\n", k)
+			}
+			return nil
 		},
 	}
 }
@@ -69,5 +86,12 @@ func init() {
 		false,
 		"force remove document even if it is attached to clients",
 	)
+	cmd.Flags().StringVarP(
+		&flagSelector,
+		"selector",
+		"l",
+		"",
+		"label selector of the documents to remove, e.g. env=dev",
+	)
 	SubCmd.AddCommand(cmd)
 }
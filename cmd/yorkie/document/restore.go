@@ -0,0 +1,87 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	restoreSeq int64
+)
+
+func newRestoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [project name] [document key]",
+		Short: "Restore a document to its state at a server sequence",
+		Long: `Restore the document to its state at the given server sequence. The
+restore is stored as a new change, so the history of the document is kept.`,
+		Example: "yorkie document restore sample-project sample-document --server-seq 10",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			if !cmd.Flags().Changed("server-seq") {
+				return errors.New("--server-seq is required")
+			}
+			if restoreSeq < 0 {
+				return errors.New("--server-seq must not be negative")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			serverSeq, err := cli.RestoreDocument(ctx, projectName, documentKey, restoreSeq)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("%s restored to %d (server seq: %d)\n", documentKey, restoreSeq, serverSeq)
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newRestoreCommand()
+	cmd.Flags().Int64Var(
+		&restoreSeq,
+		"server-seq",
+		0,
+		"The server sequence of the state to restore",
+	)
+	SubCmd.AddCommand(cmd)
+}
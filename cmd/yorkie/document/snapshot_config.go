@@ -0,0 +1,102 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	snapshotThreshold int64
+	snapshotInterval  int64
+)
+
+func newSnapshotConfigCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "snapshot-config [project name] [document key]",
+		Short: "Override the snapshot threshold and interval of a document",
+		Long: `Override the snapshot threshold and interval of the project and the server
+for the document, e.g. to tune large text documents. The flags not given are
+reset to those of the project.`,
+		Example: "yorkie document snapshot-config sample-project sample-document --threshold 500 --interval 1000",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			if snapshotThreshold < 0 || snapshotInterval < 0 {
+				return errors.New("--threshold and --interval must not be negative")
+			}
+			projectName := args[0]
+			documentKey := key.Key(args[1])
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			if err := cli.UpdateDocumentSnapshotConfig(
+				ctx,
+				projectName,
+				documentKey,
+				snapshotThreshold,
+				snapshotInterval,
+			); err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"%s snapshot config updated (threshold: %d, interval: %d)\n",
+				documentKey,
+				snapshotThreshold,
+				snapshotInterval,
+			)
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newSnapshotConfigCommand()
+	cmd.Flags().Int64Var(
+		&snapshotThreshold,
+		"threshold",
+		0,
+		"The number of changes to pull over which a snapshot is sent instead (0 means that of the project)",
+	)
+	cmd.Flags().Int64Var(
+		&snapshotInterval,
+		"interval",
+		0,
+		"The number of changes between snapshots of the document (0 means that of the project)",
+	)
+	SubCmd.AddCommand(cmd)
+}
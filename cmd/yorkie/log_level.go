@@ -0,0 +1,96 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+func newLogLevelCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "log-level [module=level,...]",
+		Short:   "Update the log levels of modules of the server",
+		Example: "yorkie log-level packs=debug,rpc=info",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("module levels are required")
+			}
+
+			levels, err := logging.ParseModuleLevels(args[0])
+			if err != nil {
+				return err
+			}
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			updated, err := cli.UpdateLogLevels(ctx, levels)
+			if err != nil {
+				return err
+			}
+
+			modules := make([]string, 0, len(updated))
+			for module := range updated {
+				modules = append(modules, module)
+			}
+			sort.Strings(modules)
+
+			tw := table.NewWriter()
+			tw.Style().Options.DrawBorder = false
+			tw.Style().Options.SeparateColumns = false
+			tw.Style().Options.SeparateFooter = false
+			tw.Style().Options.SeparateHeader = false
+			tw.Style().Options.SeparateRows = false
+			tw.AppendHeader(table.Row{
+				"MODULE",
+				"LEVEL",
+			})
+			for _, module := range modules {
+				tw.AppendRow(table.Row{
+					module,
+					updated[module],
+				})
+			}
+			cmd.Printf("%s\n", tw.Render())
+			return nil
+		},
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(newLogLevelCmd())
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+)
+
+var (
+	withChanges bool
+)
+
+func newBackupCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "backup [project name] [file]",
+		Short: "Back up the documents of a project to a tar archive",
+		Long: `Back up the documents of the project to the tar archive. Each document is
+backed up at its server sequence when the backup starts, and the housekeeping
+of the cluster is paused until the backup ends. With --with-changes, the
+change logs of the documents are backed up together unless they are compacted.
+The archive can be loaded by 'yorkie project restore'.`,
+		Example: "yorkie project backup sample-project sample-project.tar --with-changes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and file are required")
+			}
+			projectName := args[0]
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			file, err := os.OpenFile(args[1], os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+			if err != nil {
+				return fmt.Errorf("create backup file: %w", err)
+			}
+
+			ctx := context.Background()
+			if err := cli.BackupProject(ctx, projectName, withChanges, file); err != nil {
+				_ = file.Close()
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return fmt.Errorf("close backup file: %w", err)
+			}
+
+			cmd.Printf("%s backed up to %s\n", projectName, args[1])
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newBackupCommand()
+	cmd.Flags().BoolVar(
+		&withChanges,
+		"with-changes",
+		false,
+		"Whether to back up the change logs of the documents",
+	)
+	SubCmd.AddCommand(cmd)
+}
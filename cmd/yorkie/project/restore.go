@@ -0,0 +1,84 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+)
+
+func newRestoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [project name] [file]",
+		Short: "Restore the documents of a project from a tar archive",
+		Long: `Import the documents in the tar archive written by 'yorkie project backup'
+into the project. The documents must not exist in the project, e.g. the
+project of a new cluster.`,
+		Example: "yorkie project restore sample-project sample-project.tar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and file are required")
+			}
+			projectName := args[0]
+
+			file, err := os.Open(args[1])
+			if err != nil {
+				return fmt.Errorf("open backup file: %w", err)
+			}
+			defer func() {
+				_ = file.Close()
+			}()
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			manifest, err := cli.RestoreProject(ctx, projectName, file)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"%d documents restored to %s from the backup of %s\n",
+				len(manifest.Documents),
+				projectName,
+				manifest.Project,
+			)
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newRestoreCommand())
+}
@@ -33,6 +33,17 @@ import (
 
 var (
 	flagAuthWebhookURL            string
+	flagAuthJWTKey                string
+	flagAuthJWKSURL               string
+	flagSensitivePresenceKeys     []string
+	flagEventWebhookURL           string
+	flagEventWebhookEvents        []string
+	flagDocumentSizeSoftLimit     int64
+	flagDocumentSizeHardLimit     int64
+	flagChangeLogSoftLimit        int64
+	flagChangeLogHardLimit        int64
+	flagSnapshotThreshold         int64
+	flagSnapshotInterval          int64
 	flagName                      string
 	flagClientDeactivateThreshold string
 )
@@ -79,6 +90,61 @@ func newUpdateCommand() *cobra.Command {
 				newAuthWebhookURL = flagAuthWebhookURL
 			}
 
+			newAuthJWTKey := project.AuthJWTKey
+			if cmd.Flags().Lookup("auth-jwt-key").Changed { // allow empty string
+				newAuthJWTKey = flagAuthJWTKey
+			}
+
+			newAuthJWKSURL := project.AuthJWKSURL
+			if cmd.Flags().Lookup("auth-jwks-url").Changed { // allow empty string
+				newAuthJWKSURL = flagAuthJWKSURL
+			}
+
+			newSensitivePresenceKeys := project.SensitivePresenceKeys
+			if cmd.Flags().Lookup("sensitive-presence-keys").Changed { // allow empty list
+				newSensitivePresenceKeys = flagSensitivePresenceKeys
+			}
+
+			newEventWebhookURL := project.EventWebhookURL
+			if cmd.Flags().Lookup("event-webhook-url").Changed { // allow empty string
+				newEventWebhookURL = flagEventWebhookURL
+			}
+
+			newEventWebhookEvents := project.EventWebhookEvents
+			if cmd.Flags().Lookup("event-webhook-events").Changed { // allow empty list
+				newEventWebhookEvents = flagEventWebhookEvents
+			}
+
+			newDocumentSizeSoftLimit := project.DocumentSizeSoftLimit
+			if cmd.Flags().Lookup("document-size-soft-limit").Changed {
+				newDocumentSizeSoftLimit = flagDocumentSizeSoftLimit
+			}
+
+			newDocumentSizeHardLimit := project.DocumentSizeHardLimit
+			if cmd.Flags().Lookup("document-size-hard-limit").Changed {
+				newDocumentSizeHardLimit = flagDocumentSizeHardLimit
+			}
+
+			newChangeLogSoftLimit := project.ChangeLogSoftLimit
+			if cmd.Flags().Lookup("change-log-soft-limit").Changed {
+				newChangeLogSoftLimit = flagChangeLogSoftLimit
+			}
+
+			newChangeLogHardLimit := project.ChangeLogHardLimit
+			if cmd.Flags().Lookup("change-log-hard-limit").Changed {
+				newChangeLogHardLimit = flagChangeLogHardLimit
+			}
+
+			newSnapshotThreshold := project.SnapshotThreshold
+			if cmd.Flags().Lookup("snapshot-threshold").Changed {
+				newSnapshotThreshold = flagSnapshotThreshold
+			}
+
+			newSnapshotInterval := project.SnapshotInterval
+			if cmd.Flags().Lookup("snapshot-interval").Changed {
+				newSnapshotInterval = flagSnapshotInterval
+			}
+
 			newClientDeactivateThreshold := project.ClientDeactivateThreshold
 			if flagClientDeactivateThreshold != "" {
 				newClientDeactivateThreshold = flagClientDeactivateThreshold
@@ -87,6 +153,17 @@ func newUpdateCommand() *cobra.Command {
 			updatableProjectFields := &types.UpdatableProjectFields{
 				Name:                      &newName,
 				AuthWebhookURL:            &newAuthWebhookURL,
+				AuthJWTKey:                &newAuthJWTKey,
+				AuthJWKSURL:               &newAuthJWKSURL,
+				SensitivePresenceKeys:     &newSensitivePresenceKeys,
+				EventWebhookURL:           &newEventWebhookURL,
+				EventWebhookEvents:        &newEventWebhookEvents,
+				DocumentSizeSoftLimit:     &newDocumentSizeSoftLimit,
+				DocumentSizeHardLimit:     &newDocumentSizeHardLimit,
+				ChangeLogSoftLimit:        &newChangeLogSoftLimit,
+				ChangeLogHardLimit:        &newChangeLogHardLimit,
+				SnapshotThreshold:         &newSnapshotThreshold,
+				SnapshotInterval:          &newSnapshotInterval,
 				ClientDeactivateThreshold: &newClientDeactivateThreshold,
 			}
 
@@ -132,6 +209,72 @@ func init() {
 		"",
 		"authorization-webhook update url",
 	)
+	cmd.Flags().StringVar(
+		&flagAuthJWTKey,
+		"auth-jwt-key",
+		"",
+		"static key(PEM-encoded public key or HMAC secret) that verifies the JWTs of clients",
+	)
+	cmd.Flags().StringVar(
+		&flagAuthJWKSURL,
+		"auth-jwks-url",
+		"",
+		"url of the JSON Web Key Set that verifies the JWTs of clients",
+	)
+	cmd.Flags().StringSliceVar(
+		&flagSensitivePresenceKeys,
+		"sensitive-presence-keys",
+		nil,
+		"keys of presences whose values are encrypted before they are stored",
+	)
+	cmd.Flags().StringVar(
+		&flagEventWebhookURL,
+		"event-webhook-url",
+		"",
+		"url of the webhook that receives the lifecycle events of clients and documents",
+	)
+	cmd.Flags().StringSliceVar(
+		&flagEventWebhookEvents,
+		"event-webhook-events",
+		nil,
+		"types of the events that are sent to the event webhook(all if empty)",
+	)
+	cmd.Flags().Int64Var(
+		&flagDocumentSizeSoftLimit,
+		"document-size-soft-limit",
+		0,
+		"snapshot size in bytes of a document that triggers a warning(0 for no limit)",
+	)
+	cmd.Flags().Int64Var(
+		&flagDocumentSizeHardLimit,
+		"document-size-hard-limit",
+		0,
+		"snapshot size in bytes of a document over which pushes are rejected(0 for no limit)",
+	)
+	cmd.Flags().Int64Var(
+		&flagChangeLogSoftLimit,
+		"change-log-soft-limit",
+		0,
+		"number of changes of a document that triggers a warning(0 for no limit)",
+	)
+	cmd.Flags().Int64Var(
+		&flagChangeLogHardLimit,
+		"change-log-hard-limit",
+		0,
+		"number of changes of a document over which pushes are rejected(0 for no limit)",
+	)
+	cmd.Flags().Int64Var(
+		&flagSnapshotThreshold,
+		"snapshot-threshold",
+		0,
+		"number of changes to pull over which a snapshot is sent instead(0 for the server default)",
+	)
+	cmd.Flags().Int64Var(
+		&flagSnapshotInterval,
+		"snapshot-interval",
+		0,
+		"number of changes between snapshots of a document(0 for the server default)",
+	)
 	cmd.Flags().StringVar(
 		&flagClientDeactivateThreshold,
 		"client-deactivate-threshold",
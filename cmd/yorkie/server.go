@@ -27,7 +27,11 @@ import (
 
 	"github.com/yorkie-team/yorkie/server"
 	"github.com/yorkie-team/yorkie/server/backend/database/mongo"
+	"github.com/yorkie-team/yorkie/server/backend/database/postgres"
+	"github.com/yorkie-team/yorkie/server/backend/objectstorage"
+	"github.com/yorkie-team/yorkie/server/backend/sync/redis"
 	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/rpc"
 )
 
 var (
@@ -35,11 +39,13 @@ var (
 )
 
 var (
-	flagConfPath string
-	flagLogLevel string
+	flagConfPath        string
+	flagLogLevel        string
+	flagLogModuleLevels string
 
 	adminTokenDuration        time.Duration
 	housekeepingInterval      time.Duration
+	verificationInterval      time.Duration
 	clientDeactivateThreshold string
 
 	mongoConnectionURI     string
@@ -47,10 +53,41 @@ var (
 	mongoYorkieDatabase    string
 	mongoPingTimeout       time.Duration
 
-	authWebhookMaxWaitInterval time.Duration
-	authWebhookCacheAuthTTL    time.Duration
-	authWebhookCacheUnauthTTL  time.Duration
-	projectInfoCacheTTL        time.Duration
+	postgresConnectionURI     string
+	postgresConnectionTimeout time.Duration
+	postgresYorkieSchema      string
+	postgresPingTimeout       time.Duration
+
+	objectStorageEndpoint          string
+	objectStorageRegion            string
+	objectStorageBucket            string
+	objectStorageAccessKeyID       string
+	objectStorageSecretAccessKey   string
+	objectStorageInsecure          bool
+	objectStorageSnapshotThreshold int64
+
+	redisAddress     string
+	redisPassword    string
+	redisDB          int
+	redisChannel     string
+	redisPingTimeout time.Duration
+
+	authWebhookMaxWaitInterval  time.Duration
+	authWebhookCacheAuthTTL     time.Duration
+	authWebhookCacheUnauthTTL   time.Duration
+	authJWKSCacheTTL            time.Duration
+	eventWebhookMaxWaitInterval time.Duration
+	projectInfoCacheTTL         time.Duration
+	snapshotCacheTTL            time.Duration
+	pushPullCacheTTL            time.Duration
+	watchHeartbeatTimeout       time.Duration
+
+	adminPort                int
+	adminAddresses           []string
+	adminCertFile            string
+	adminKeyFile             string
+	adminSecretKey           string
+	adminServerTokenDuration time.Duration
 
 	conf = server.NewConfig()
 )
@@ -67,10 +104,36 @@ func newServerCmd() *cobra.Command {
 			conf.Backend.AuthWebhookMaxWaitInterval = authWebhookMaxWaitInterval.String()
 			conf.Backend.AuthWebhookCacheAuthTTL = authWebhookCacheAuthTTL.String()
 			conf.Backend.AuthWebhookCacheUnauthTTL = authWebhookCacheUnauthTTL.String()
+			conf.Backend.AuthJWKSCacheTTL = authJWKSCacheTTL.String()
+			conf.Backend.EventWebhookMaxWaitInterval = eventWebhookMaxWaitInterval.String()
 			conf.Backend.ProjectInfoCacheTTL = projectInfoCacheTTL.String()
+			conf.Backend.SnapshotCacheTTL = snapshotCacheTTL.String()
+			conf.Backend.PushPullCacheTTL = pushPullCacheTTL.String()
+			conf.Backend.WatchHeartbeatTimeout = watchHeartbeatTimeout.String()
 
 			conf.Housekeeping.Interval = housekeepingInterval.String()
 
+			conf.Verification.Interval = verificationInterval.String()
+			if conf.Verification.SampleSize == 0 {
+				conf.Verification = nil
+			}
+
+			if adminPort != 0 || len(adminAddresses) > 0 {
+				conf.Admin = &rpc.AdminConfig{
+					Port:      adminPort,
+					Addresses: adminAddresses,
+					CertFile:  adminCertFile,
+					KeyFile:   adminKeyFile,
+					SecretKey: adminSecretKey,
+				}
+				if conf.Admin.Port == 0 {
+					conf.Admin.Port = server.DefaultAdminPort
+				}
+				if adminServerTokenDuration != 0 {
+					conf.Admin.TokenDuration = adminServerTokenDuration.String()
+				}
+			}
+
 			if mongoConnectionURI != "" {
 				conf.Mongo = &mongo.Config{
 					ConnectionURI:     mongoConnectionURI,
@@ -80,6 +143,37 @@ func newServerCmd() *cobra.Command {
 				}
 			}
 
+			if postgresConnectionURI != "" {
+				conf.Postgres = &postgres.Config{
+					ConnectionURI:     postgresConnectionURI,
+					ConnectionTimeout: postgresConnectionTimeout.String(),
+					YorkieSchema:      postgresYorkieSchema,
+					PingTimeout:       postgresPingTimeout.String(),
+				}
+			}
+
+			if objectStorageEndpoint != "" {
+				conf.ObjectStorage = &objectstorage.Config{
+					Endpoint:          objectStorageEndpoint,
+					Region:            objectStorageRegion,
+					Bucket:            objectStorageBucket,
+					AccessKeyID:       objectStorageAccessKeyID,
+					SecretAccessKey:   objectStorageSecretAccessKey,
+					Insecure:          objectStorageInsecure,
+					SnapshotThreshold: objectStorageSnapshotThreshold,
+				}
+			}
+
+			if redisAddress != "" {
+				conf.Redis = &redis.Config{
+					Address:     redisAddress,
+					Password:    redisPassword,
+					DB:          redisDB,
+					Channel:     redisChannel,
+					PingTimeout: redisPingTimeout.String(),
+				}
+			}
+
 			// If config file is given, command-line arguments will be overwritten.
 			if flagConfPath != "" {
 				parsed, err := server.NewConfigFromFile(flagConfPath)
@@ -89,9 +183,21 @@ func newServerCmd() *cobra.Command {
 				conf = parsed
 			}
 
+			if conf.Logging != nil {
+				if err := logging.Configure(conf.Logging); err != nil {
+					return err
+				}
+			}
 			if err := logging.SetLogLevel(flagLogLevel); err != nil {
 				return err
 			}
+			moduleLevels, err := logging.ParseModuleLevels(flagLogModuleLevels)
+			if err != nil {
+				return err
+			}
+			if err := logging.SetModuleLevels(moduleLevels); err != nil {
+				return err
+			}
 
 			y, err := server.New(conf)
 			if err != nil {
@@ -163,12 +269,67 @@ func init() {
 		"info",
 		"Log level: debug, info, warn, error, panic, fatal",
 	)
+	cmd.Flags().StringVar(
+		&flagLogModuleLevels,
+		"log-module-levels",
+		"",
+		"Log levels of modules that override the log level, e.g. packs=debug,rpc=info",
+	)
+	cmd.Flags().StringVar(
+		&conf.Logging.Encoding,
+		"log-encoding",
+		server.DefaultLogEncoding,
+		"Encoding of the logs: console, json",
+	)
+	cmd.Flags().IntVar(
+		&conf.Logging.SamplingInitial,
+		"log-sampling-initial",
+		0,
+		"Number of debug and info messages with the same prefix logged in each second before sampling starts. "+
+			"0 disables sampling.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Logging.SamplingThereafter,
+		"log-sampling-thereafter",
+		0,
+		"Interval of the messages logged after the initial messages in each second while sampling.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Logging.File,
+		"log-file",
+		"",
+		"Path of the file to write the logs to. If empty, the logs are written to the standard output.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Logging.FileMaxSize,
+		"log-file-max-size",
+		server.DefaultLogFileMaxSize,
+		"Maximum size of the log file in megabytes before it is rotated. 0 disables rotation.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Logging.FileMaxBackups,
+		"log-file-max-backups",
+		server.DefaultLogFileMaxBackups,
+		"Maximum number of rotated log files to keep.",
+	)
 	cmd.Flags().IntVar(
 		&conf.RPC.Port,
 		"rpc-port",
 		server.DefaultRPCPort,
 		"RPC port",
 	)
+	cmd.Flags().StringSliceVar(
+		&conf.RPC.Addresses,
+		"rpc-addresses",
+		nil,
+		"Addresses to listen on for RPC instead of rpc-port, such as \"[::1]:11101\" or \"unix:/tmp/yorkie.sock\".",
+	)
+	cmd.Flags().StringSliceVar(
+		&conf.RPC.TrustedProxies,
+		"rpc-trusted-proxies",
+		nil,
+		"IP addresses or CIDRs of the proxies whose X-Forwarded-For headers are trusted, such as \"10.0.0.0/8\".",
+	)
 	cmd.Flags().StringVar(
 		&conf.RPC.CertFile,
 		"rpc-cert-file",
@@ -187,6 +348,12 @@ func init() {
 		server.DefaultRPCMaxRequestsBytes,
 		"Maximum client request size in bytes the server will accept.",
 	)
+	cmd.Flags().Uint64Var(
+		&conf.RPC.MaxStreamedPackBytes,
+		"rpc-max-streamed-pack-bytes",
+		server.DefaultRPCMaxStreamedPackBytes,
+		"Maximum size in bytes of the change pack assembled from the chunks of a streamed PushPull request.",
+	)
 	cmd.Flags().StringVar(
 		&conf.RPC.MaxConnectionAge,
 		"rpc-max-connection-age",
@@ -199,12 +366,67 @@ func init() {
 		server.DefaultRPCMaxConnectionAgeGrace.String(),
 		"Additional grace period after MaxConnectionAge after which connections will be forcibly closed.",
 	)
+	cmd.Flags().IntVar(
+		&conf.RPC.CompressionMinSize,
+		"rpc-compression-min-size",
+		server.DefaultRPCCompressionMinSize,
+		"Size in bytes of messages below which the negotiated compressors use the cheapest level.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.RPC.EnableWebProtocols,
+		"rpc-enable-web-protocols",
+		false,
+		"Serve gRPC-Web, Connect and WebSocket streams along with gRPC on the same addresses.",
+	)
+	cmd.Flags().IntVar(
+		&adminPort,
+		"admin-port",
+		0,
+		"Port of the separate admin server. If neither this nor admin-addresses is given, "+
+			"the admin service is served along with RPC.",
+	)
+	cmd.Flags().StringSliceVar(
+		&adminAddresses,
+		"admin-addresses",
+		nil,
+		"Addresses to listen on for the separate admin server instead of admin-port.",
+	)
+	cmd.Flags().StringVar(
+		&adminCertFile,
+		"admin-cert-file",
+		"",
+		"Admin server certification file's path",
+	)
+	cmd.Flags().StringVar(
+		&adminKeyFile,
+		"admin-key-file",
+		"",
+		"Admin server key file's path",
+	)
+	cmd.Flags().StringVar(
+		&adminSecretKey,
+		"admin-secret-key",
+		"",
+		"The secret key for signing tokens of the separate admin server. Defaults to backend-secret-key.",
+	)
+	cmd.Flags().DurationVar(
+		&adminServerTokenDuration,
+		"admin-token-duration",
+		0,
+		"The duration of tokens of the separate admin server. Defaults to backend-admin-token-duration.",
+	)
 	cmd.Flags().IntVar(
 		&conf.Profiling.Port,
 		"profiling-port",
 		server.DefaultProfilingPort,
 		"Profiling port",
 	)
+	cmd.Flags().StringSliceVar(
+		&conf.Profiling.Addresses,
+		"profiling-addresses",
+		nil,
+		"Addresses to listen on for profiling instead of profiling-port.",
+	)
 	cmd.Flags().BoolVar(
 		&conf.Profiling.EnablePprof,
 		"enable-pprof",
@@ -229,6 +451,30 @@ func init() {
 		server.DefaultHousekeepingProjectFetchSize,
 		"housekeeping project fetch size for a single housekeeping run",
 	)
+	cmd.Flags().IntVar(
+		&conf.Housekeeping.CompactionCandidatesLimit,
+		"housekeeping-compaction-candidates-limit",
+		server.DefaultHousekeepingCompactionCandidatesLimit,
+		"documents limit of change log compaction for a single housekeeping run (0 disables compaction)",
+	)
+	cmd.Flags().Int64Var(
+		&conf.Housekeeping.ChangeLogRetention,
+		"housekeeping-change-log-retention",
+		server.DefaultHousekeepingChangeLogRetention,
+		"number of changes to keep before the latest snapshot when compacting change logs",
+	)
+	cmd.Flags().DurationVar(
+		&verificationInterval,
+		"verification-interval",
+		server.DefaultVerificationInterval,
+		"verification interval between verification runs",
+	)
+	cmd.Flags().IntVar(
+		&conf.Verification.SampleSize,
+		"verification-sample-size",
+		server.DefaultVerificationSampleSize,
+		"number of documents to verify against their change logs in a single verification run, 0 to disable",
+	)
 	cmd.Flags().StringVar(
 		&mongoConnectionURI,
 		"mongo-connection-uri",
@@ -253,6 +499,109 @@ func init() {
 		server.DefaultMongoPingTimeout,
 		"Mongo DB's ping timeout",
 	)
+	cmd.Flags().StringVar(
+		&postgresConnectionURI,
+		"postgres-connection-uri",
+		"",
+		"PostgreSQL's connection URI",
+	)
+	cmd.Flags().DurationVar(
+		&postgresConnectionTimeout,
+		"postgres-connection-timeout",
+		server.DefaultPostgresConnectionTimeout,
+		"PostgreSQL's connection timeout",
+	)
+	cmd.Flags().StringVar(
+		&postgresYorkieSchema,
+		"postgres-yorkie-schema",
+		server.DefaultPostgresYorkieSchema,
+		"Yorkie's schema name in PostgreSQL",
+	)
+	cmd.Flags().DurationVar(
+		&postgresPingTimeout,
+		"postgres-ping-timeout",
+		server.DefaultPostgresPingTimeout,
+		"PostgreSQL's ping timeout",
+	)
+	cmd.Flags().StringVar(
+		&objectStorageEndpoint,
+		"object-storage-endpoint",
+		"",
+		"endpoint of the object storage that large snapshots are offloaded to, e.g. s3.amazonaws.com",
+	)
+	cmd.Flags().StringVar(
+		&objectStorageRegion,
+		"object-storage-region",
+		"",
+		"region of the bucket of the object storage",
+	)
+	cmd.Flags().StringVar(
+		&objectStorageBucket,
+		"object-storage-bucket",
+		"",
+		"bucket of the object storage that stores snapshots",
+	)
+	cmd.Flags().StringVar(
+		&objectStorageAccessKeyID,
+		"object-storage-access-key-id",
+		"",
+		"access key ID of the object storage, read from the environment if empty",
+	)
+	cmd.Flags().StringVar(
+		&objectStorageSecretAccessKey,
+		"object-storage-secret-access-key",
+		"",
+		"secret access key of the object storage",
+	)
+	cmd.Flags().BoolVar(
+		&objectStorageInsecure,
+		"object-storage-insecure",
+		false,
+		"connect to the object storage without TLS",
+	)
+	cmd.Flags().Int64Var(
+		&objectStorageSnapshotThreshold,
+		"object-storage-snapshot-threshold",
+		server.DefaultObjectStorageSnapshotThreshold,
+		"size of snapshots in bytes above which snapshots are offloaded to the object storage",
+	)
+	cmd.Flags().StringVar(
+		&redisAddress,
+		"redis-address",
+		"",
+		"address of the Redis server through which the servers of a cluster broadcast the events of documents",
+	)
+	cmd.Flags().StringVar(
+		&redisPassword,
+		"redis-password",
+		"",
+		"password of the Redis server",
+	)
+	cmd.Flags().IntVar(
+		&redisDB,
+		"redis-db",
+		0,
+		"number of the Redis database that keeps the members of the cluster",
+	)
+	cmd.Flags().StringVar(
+		&redisChannel,
+		"redis-channel",
+		server.DefaultRedisChannel,
+		"name of the Redis channel shared by the servers of a cluster",
+	)
+	cmd.Flags().DurationVar(
+		&redisPingTimeout,
+		"redis-ping-timeout",
+		server.DefaultRedisPingTimeout,
+		"Redis's ping timeout",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.Database,
+		"backend-database",
+		"",
+		"The name of the database implementation, e.g. memory, mongo or postgres. If empty, "+
+			"mongo or postgres is used when its connection URI is given and memory otherwise.",
+	)
 	cmd.Flags().StringVar(
 		&conf.Backend.AdminUser,
 		"backend-admin-user",
@@ -303,12 +652,36 @@ func init() {
 		server.DefaultSnapshotInterval,
 		"Interval of changes to create a snapshot.",
 	)
+	cmd.Flags().Int64Var(
+		&conf.Backend.SnapshotBuildBatchSize,
+		"backend-snapshot-build-batch-size",
+		server.DefaultSnapshotBuildBatchSize,
+		"Number of changes that are read from the database at once when building a document.",
+	)
 	cmd.Flags().BoolVar(
 		&conf.Backend.SnapshotWithPurgingChanges,
 		"backend-snapshot-with-purging-changes",
 		server.DefaultSnapshotWithPurgingChanges,
 		"Whether to delete previous changes when the snapshot is created.",
 	)
+	cmd.Flags().StringVar(
+		&conf.Backend.SnapshotMode,
+		"backend-snapshot-mode",
+		server.DefaultSnapshotMode,
+		"The way of storing snapshots: full or delta.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.SnapshotMaxDeltas,
+		"backend-snapshot-max-deltas",
+		server.DefaultSnapshotMaxDeltas,
+		"Maximum number of delta snapshots in a row before a full snapshot is stored.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.SnapshotCodec,
+		"backend-snapshot-codec",
+		server.DefaultSnapshotCodec,
+		"The codec of the stored snapshots: protobuf or cbor.",
+	)
 	cmd.Flags().Uint64Var(
 		&conf.Backend.AuthWebhookMaxRetries,
 		"auth-webhook-max-retries",
@@ -339,6 +712,24 @@ func init() {
 		server.DefaultAuthWebhookCacheUnauthTTL,
 		"TTL value to set when caching unauthorized webhook response.",
 	)
+	cmd.Flags().DurationVar(
+		&authJWKSCacheTTL,
+		"auth-jwks-cache-ttl",
+		server.DefaultAuthJWKSCacheTTL,
+		"TTL value to set when caching JSON Web Key Sets of projects.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.EventWebhookMaxRetries,
+		"event-webhook-max-retries",
+		server.DefaultEventWebhookMaxRetries,
+		"Maximum number of retries for an event webhook.",
+	)
+	cmd.Flags().DurationVar(
+		&eventWebhookMaxWaitInterval,
+		"event-webhook-max-wait-interval",
+		server.DefaultEventWebhookMaxWaitInterval,
+		"Maximum wait interval for event webhook.",
+	)
 	cmd.Flags().IntVar(
 		&conf.Backend.ProjectInfoCacheSize,
 		"project-info-cache-size",
@@ -351,12 +742,134 @@ func init() {
 		server.DefaultProjectInfoCacheTTL,
 		"TTL value to set when caching project info.",
 	)
+	cmd.Flags().IntVar(
+		&conf.Backend.SnapshotCacheSize,
+		"snapshot-cache-size",
+		server.DefaultSnapshotCacheSize,
+		"The cache size of the snapshots built for pulling documents.",
+	)
+	cmd.Flags().DurationVar(
+		&snapshotCacheTTL,
+		"snapshot-cache-ttl",
+		server.DefaultSnapshotCacheTTL,
+		"TTL value to set when caching snapshots.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.PushPullCacheSize,
+		"push-pull-cache-size",
+		server.DefaultPushPullCacheSize,
+		"The cache size of the responses of PushPull by the idempotency keys of the pushed packs.",
+	)
+	cmd.Flags().DurationVar(
+		&pushPullCacheTTL,
+		"push-pull-cache-ttl",
+		server.DefaultPushPullCacheTTL,
+		"TTL value to set when caching the responses of PushPull.",
+	)
+	cmd.Flags().DurationVar(
+		&watchHeartbeatTimeout,
+		"watch-heartbeat-timeout",
+		server.DefaultWatchHeartbeatTimeout,
+		"Duration after which the watch streams of clients that stopped sending heartbeats are closed. 0 disables it.",
+	)
 	cmd.Flags().StringVar(
 		&conf.Backend.Hostname,
 		"hostname",
 		server.DefaultHostname,
 		"Yorkie Server Hostname",
 	)
+	cmd.Flags().StringVar(
+		&conf.Backend.ClusterRPCAddr,
+		"backend-cluster-rpc-addr",
+		"",
+		"Address through which the other servers of the cluster forward requests to this server, "+
+			"e.g. 10.0.0.1:11101. If set, documents are owned by servers by consistent hashing. It requires Redis.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.ClusterSecret,
+		"backend-cluster-secret",
+		"",
+		"Secret shared by the servers of the cluster to sign the requests forwarded to each other. "+
+			"It is required if --backend-cluster-rpc-addr is set.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.MaxOperationsPerChange,
+		"backend-max-operations-per-change",
+		server.DefaultMaxOperationsPerChange,
+		"Maximum number of operations in a change that the server accepts.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.MaxChangeDepth,
+		"backend-max-change-depth",
+		server.DefaultMaxChangeDepth,
+		"Maximum nesting depth of the elements in a change that the server accepts.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.MaxStringLength,
+		"backend-max-string-length",
+		server.DefaultMaxStringLength,
+		"Maximum length in bytes of a string in an operation that the server accepts.",
+	)
+	cmd.Flags().Int64Var(
+		&conf.Backend.MaxChangePackSize,
+		"backend-max-change-pack-size",
+		0,
+		"Maximum size in bytes of the changes of a pack that the server accepts. Zero means unlimited.",
+	)
+	cmd.Flags().Int64Var(
+		&conf.Backend.MaxDocumentSize,
+		"backend-max-document-size",
+		0,
+		"Maximum size in bytes of the snapshot of a document. Zero means unlimited.",
+	)
+	cmd.Flags().Int64Var(
+		&conf.Backend.MaxDocumentElements,
+		"backend-max-document-elements",
+		0,
+		"Maximum number of elements of a document. Zero means unlimited.",
+	)
+	cmd.Flags().Float64Var(
+		&conf.Backend.ClientRateLimit,
+		"backend-client-rate-limit",
+		0,
+		"Number of PushPull and WatchDocument calls per second that a client can make. Zero means unlimited.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.ClientRateLimitBurst,
+		"backend-client-rate-limit-burst",
+		0,
+		"Maximum number of calls that a client can make at once. Zero means derived from the rate.",
+	)
+	cmd.Flags().Float64Var(
+		&conf.Backend.ProjectRateLimit,
+		"backend-project-rate-limit",
+		0,
+		"Number of PushPull, WatchDocument and ReadDocument calls per second that the clients of a project can make. Zero means unlimited.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.ProjectRateLimitBurst,
+		"backend-project-rate-limit-burst",
+		0,
+		"Maximum number of calls that the clients of a project can make at once. Zero means derived from the rate.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.MinClientVersion,
+		"backend-min-client-version",
+		"",
+		"Minimum version of SDKs that the server accepts. Requests from older SDKs are rejected.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.RecommendedClientVersion,
+		"backend-recommended-client-version",
+		"",
+		"Recommended version of SDKs. Requests from older SDKs are served with a deprecation warning.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.PresenceEncryptionKey,
+		"backend-presence-encryption-key",
+		"",
+		"Hex-encoded AES key that encrypts the values of the sensitive presence keys of projects.",
+	)
 
 	rootCmd.AddCommand(cmd)
 }
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+)
+
+func newRegisterCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "register [project name] [collection] [root json file]",
+		Short: "Register the template of the collection",
+		Long: `Register the template of the collection. Documents whose keys start with
+the collection are initialized from the root of the template when they are
+attached for the first time. The existing template of the collection is
+replaced.`,
+		Example: "yorkie template register sample-project board- board.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				return errors.New("project name, collection and root json file are required")
+			}
+			projectName := args[0]
+			collection := args[1]
+
+			root, err := os.ReadFile(args[2])
+			if err != nil {
+				return fmt.Errorf("read root json file: %w", err)
+			}
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			template, err := cli.RegisterDocumentTemplate(ctx, projectName, collection, string(root))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("template of %s registered\n", template.Collection)
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newRegisterCommand())
+}
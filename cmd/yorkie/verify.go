@@ -0,0 +1,78 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var errDocumentDiverged = errors.New("document diverged from its change log")
+
+func newVerifyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify [project name] [document key]",
+		Short: "Verify a document by replaying its change log",
+		Long: "Rebuild a document purely from its change log and compare the result " +
+			"against the latest stored snapshot by content hash.",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+
+			token, err := config.LoadToken(config.RPCAddr)
+			if err != nil {
+				return err
+			}
+
+			cli, err := admin.Dial(config.RPCAddr, admin.WithToken(token), admin.WithInsecure(config.IsInsecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			verification, err := cli.VerifyDocument(ctx, args[0], key.Key(args[1]))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("snapshot server seq: %d\n", verification.SnapshotServerSeq)
+			cmd.Printf("snapshot hash:       %s\n", verification.SnapshotHash)
+			cmd.Printf("rebuilt hash:        %s\n", verification.RebuiltHash)
+			if verification.IsDiverged() {
+				return errDocumentDiverged
+			}
+
+			cmd.Println("ok")
+			return nil
+		},
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(newVerifyCmd())
+}
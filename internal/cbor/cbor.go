@@ -0,0 +1,563 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cbor encodes the messages generated from protocol buffers in CBOR,
+// RFC 8949. A message is encoded as a map from the numbers of its fields to
+// their values, so that it can evolve like protocol buffers: the fields not
+// known to the decoder are skipped.
+//
+// Only the subset of CBOR that is needed for the messages is supported: the
+// integers, the byte and text strings of definite lengths, the arrays, the
+// maps, the booleans, null and the floating-point numbers.
+package cbor
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrInvalid is returned when the given data is not a valid encoding of the
+// given message.
+var ErrInvalid = errors.New("invalid cbor")
+
+// maxDepth is the maximum depth of the nested items that can be decoded.
+const maxDepth = 512
+
+// The major types of CBOR.
+const (
+	majorUint   = 0
+	majorNegInt = 1
+	majorBytes  = 2
+	majorText   = 3
+	majorArray  = 4
+	majorMap    = 5
+	majorSimple = 7
+)
+
+// The simple values and the floating-point numbers of the major type 7.
+const (
+	simpleFalse = 20
+	simpleTrue  = 21
+	simpleNull  = 22
+	float32Info = 26
+	float64Info = 27
+)
+
+// field is a field of a message.
+type field struct {
+	num   uint64
+	index int
+
+	// wrapper is the type of the wrapper of the field if it is a field of a
+	// oneof, and the field is the only field of the wrapper.
+	wrapper reflect.Type
+}
+
+// message is the fields of the type of a message in the order of the struct.
+type message struct {
+	fields []field
+	byNum  map[uint64]field
+}
+
+var messages sync.Map
+
+// oneofWrappers is implemented by the messages that have oneofs.
+type oneofWrappers interface {
+	XXX_OneofWrappers() []interface{}
+}
+
+// messageOf returns the fields of the given struct type of a message.
+func messageOf(t reflect.Type) *message {
+	if m, ok := messages.Load(t); ok {
+		return m.(*message)
+	}
+
+	m := &message{byNum: make(map[uint64]field)}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if num, ok := fieldNum(f); ok {
+			m.fields = append(m.fields, field{num: num, index: i})
+			m.byNum[num] = field{num: num, index: i}
+		} else if _, ok := f.Tag.Lookup("protobuf_oneof"); ok {
+			m.fields = append(m.fields, field{index: i})
+		}
+	}
+
+	if w, ok := reflect.New(t).Interface().(oneofWrappers); ok {
+		for _, wrapper := range w.XXX_OneofWrappers() {
+			wt := reflect.TypeOf(wrapper)
+			num, ok := fieldNum(wt.Elem().Field(0))
+			if !ok {
+				continue
+			}
+			for _, f := range m.fields {
+				if f.num == 0 && wt.Implements(t.Field(f.index).Type) {
+					m.byNum[num] = field{num: num, index: f.index, wrapper: wt}
+				}
+			}
+		}
+	}
+
+	messages.Store(t, m)
+	return m
+}
+
+// fieldNum returns the number of the given field from its protobuf tag.
+func fieldNum(f reflect.StructField) (uint64, bool) {
+	tag, ok := f.Tag.Lookup("protobuf")
+	if !ok || strings.HasPrefix(f.Name, "XXX_") {
+		return 0, false
+	}
+
+	parts := strings.Split(tag, ",")
+	if len(parts) < 2 {
+		return 0, false
+	}
+	num, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return num, true
+}
+
+// Marshal returns the CBOR encoding of the given message, which should be a
+// pointer to a struct generated from protocol buffers.
+func Marshal(msg interface{}) ([]byte, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("marshal %T: not a message", msg)
+	}
+
+	return appendValue(nil, v)
+}
+
+// appendValue appends the encoding of the given value to the given bytes.
+func appendValue(b []byte, v reflect.Value) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return append(b, majorSimple<<5|simpleNull), nil
+		}
+		return appendMessage(b, v.Elem())
+	case reflect.Bool:
+		if v.Bool() {
+			return append(b, majorSimple<<5|simpleTrue), nil
+		}
+		return append(b, majorSimple<<5|simpleFalse), nil
+	case reflect.Int32, reflect.Int64:
+		if n := v.Int(); n < 0 {
+			return appendHead(b, majorNegInt, uint64(-(n + 1))), nil
+		}
+		return appendHead(b, majorUint, uint64(v.Int())), nil
+	case reflect.Uint32, reflect.Uint64:
+		return appendHead(b, majorUint, v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		b = append(b, majorSimple<<5|float64Info)
+		return binary.BigEndian.AppendUint64(b, math.Float64bits(v.Float())), nil
+	case reflect.String:
+		b = appendHead(b, majorText, uint64(v.Len()))
+		return append(b, v.String()...), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b = appendHead(b, majorBytes, uint64(v.Len()))
+			return append(b, v.Bytes()...), nil
+		}
+
+		b = appendHead(b, majorArray, uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			var err error
+			if b, err = appendValue(b, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+
+		b = appendHead(b, majorMap, uint64(len(keys)))
+		for _, key := range keys {
+			var err error
+			if b, err = appendValue(b, key); err != nil {
+				return nil, err
+			}
+			if b, err = appendValue(b, v.MapIndex(key)); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("marshal %s: unsupported type", v.Type())
+}
+
+// appendMessage appends the encoding of the given message, omitting the
+// fields of the zero values like protocol buffers.
+func appendMessage(b []byte, v reflect.Value) ([]byte, error) {
+	m := messageOf(v.Type())
+
+	type entry struct {
+		num   uint64
+		value reflect.Value
+	}
+	var entries []entry
+	for _, f := range m.fields {
+		fv := v.Field(f.index)
+		if fv.IsZero() || (fv.Kind() == reflect.Slice || fv.Kind() == reflect.Map) && fv.Len() == 0 {
+			continue
+		}
+
+		if f.num == 0 {
+			wrapper := fv.Elem()
+			num, ok := fieldNum(wrapper.Elem().Type().Field(0))
+			if !ok {
+				return nil, fmt.Errorf("marshal %s: unsupported oneof", wrapper.Type())
+			}
+			entries = append(entries, entry{num: num, value: wrapper.Elem().Field(0)})
+			continue
+		}
+		entries = append(entries, entry{num: f.num, value: fv})
+	}
+
+	b = appendHead(b, majorMap, uint64(len(entries)))
+	for _, e := range entries {
+		b = appendHead(b, majorUint, e.num)
+
+		var err error
+		if b, err = appendValue(b, e.value); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// appendHead appends the head of an item of the given major type with the
+// given argument.
+func appendHead(b []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(b, major<<5|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, major<<5|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major<<5|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major<<5|26), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(b, major<<5|27), n)
+}
+
+// Unmarshal decodes the given CBOR encoding into the given message, which
+// should be a pointer to a struct generated from protocol buffers.
+func Unmarshal(data []byte, msg interface{}) error {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unmarshal %T: not a message", msg)
+	}
+
+	d := &decoder{data: data}
+	if err := d.decodeMessage(v.Elem(), 0); err != nil {
+		return err
+	}
+	if d.off != len(d.data) {
+		return fmt.Errorf("%d trailing bytes: %w", len(d.data)-d.off, ErrInvalid)
+	}
+	return nil
+}
+
+// decoder decodes the items of the data from the offset.
+type decoder struct {
+	data []byte
+	off  int
+}
+
+// readHead reads the head of the next item and returns its major type, its
+// additional information and its argument.
+func (d *decoder) readHead() (byte, byte, uint64, error) {
+	if d.off >= len(d.data) {
+		return 0, 0, 0, fmt.Errorf("unexpected end: %w", ErrInvalid)
+	}
+	major, info := d.data[d.off]>>5, d.data[d.off]&0x1f
+	d.off++
+
+	var size int
+	switch {
+	case info < 24:
+		return major, info, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, 0, fmt.Errorf("additional information %d: %w", info, ErrInvalid)
+	}
+	if len(d.data)-d.off < size {
+		return 0, 0, 0, fmt.Errorf("unexpected end: %w", ErrInvalid)
+	}
+
+	var n uint64
+	for _, c := range d.data[d.off : d.off+size] {
+		n = n<<8 | uint64(c)
+	}
+	d.off += size
+	return major, info, n, nil
+}
+
+// readLength reads the head of the next item of the given major type and
+// returns its length, which is checked against the remaining data so that a
+// corrupted length cannot allocate a large amount of memory.
+func (d *decoder) readLength(major byte) (int, error) {
+	m, _, n, err := d.readHead()
+	if err != nil {
+		return 0, err
+	}
+	if m != major {
+		return 0, fmt.Errorf("major type %d, expected %d: %w", m, major, ErrInvalid)
+	}
+	if n > uint64(len(d.data)-d.off) {
+		return 0, fmt.Errorf("length %d: %w", n, ErrInvalid)
+	}
+	return int(n), nil
+}
+
+// isNull returns whether the next item is null, consuming it if so.
+func (d *decoder) isNull() bool {
+	if d.off < len(d.data) && d.data[d.off] == majorSimple<<5|simpleNull {
+		d.off++
+		return true
+	}
+	return false
+}
+
+// decodeMessage decodes the next item into the given struct of a message.
+func (d *decoder) decodeMessage(v reflect.Value, depth int) error {
+	if depth > maxDepth {
+		return fmt.Errorf("depth over %d: %w", maxDepth, ErrInvalid)
+	}
+
+	n, err := d.readLength(majorMap)
+	if err != nil {
+		return err
+	}
+
+	m := messageOf(v.Type())
+	for i := 0; i < n; i++ {
+		major, _, num, err := d.readHead()
+		if err != nil {
+			return err
+		}
+		if major != majorUint {
+			return fmt.Errorf("field number of major type %d: %w", major, ErrInvalid)
+		}
+
+		f, ok := m.byNum[num]
+		if !ok {
+			if err := d.skip(depth + 1); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if f.wrapper != nil {
+			wrapper := reflect.New(f.wrapper.Elem())
+			if err := d.decodeValue(wrapper.Elem().Field(0), depth+1); err != nil {
+				return err
+			}
+			v.Field(f.index).Set(wrapper)
+			continue
+		}
+		if err := d.decodeValue(v.Field(f.index), depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeValue decodes the next item into the given value.
+func (d *decoder) decodeValue(v reflect.Value, depth int) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if d.isNull() {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		elem := reflect.New(v.Type().Elem())
+		if err := d.decodeMessage(elem.Elem(), depth); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case reflect.Bool:
+		major, info, _, err := d.readHead()
+		if err != nil {
+			return err
+		}
+		if major != majorSimple || (info != simpleTrue && info != simpleFalse) {
+			return fmt.Errorf("bool of major type %d: %w", major, ErrInvalid)
+		}
+		v.SetBool(info == simpleTrue)
+		return nil
+	case reflect.Int32, reflect.Int64:
+		major, _, n, err := d.readHead()
+		if err != nil {
+			return err
+		}
+		if n > math.MaxInt64 {
+			return fmt.Errorf("integer %d: %w", n, ErrInvalid)
+		}
+		switch major {
+		case majorUint:
+			v.SetInt(int64(n))
+		case majorNegInt:
+			v.SetInt(-1 - int64(n))
+		default:
+			return fmt.Errorf("integer of major type %d: %w", major, ErrInvalid)
+		}
+		if v.OverflowInt(v.Int()) {
+			return fmt.Errorf("integer %d: %w", v.Int(), ErrInvalid)
+		}
+		return nil
+	case reflect.Uint32, reflect.Uint64:
+		major, _, n, err := d.readHead()
+		if err != nil {
+			return err
+		}
+		if major != majorUint || v.OverflowUint(n) {
+			return fmt.Errorf("unsigned integer %d: %w", n, ErrInvalid)
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		major, info, n, err := d.readHead()
+		if err != nil {
+			return err
+		}
+		switch {
+		case major == majorSimple && info == float64Info:
+			v.SetFloat(math.Float64frombits(n))
+		case major == majorSimple && info == float32Info:
+			v.SetFloat(float64(math.Float32frombits(uint32(n))))
+		default:
+			return fmt.Errorf("float of major type %d: %w", major, ErrInvalid)
+		}
+		return nil
+	case reflect.String:
+		n, err := d.readLength(majorText)
+		if err != nil {
+			return err
+		}
+		v.SetString(string(d.data[d.off : d.off+n]))
+		d.off += n
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			n, err := d.readLength(majorBytes)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(append([]byte(nil), d.data[d.off:d.off+n]...))
+			d.off += n
+			return nil
+		}
+
+		n, err := d.readLength(majorArray)
+		if err != nil {
+			return err
+		}
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			if err := d.decodeValue(s.Index(i), depth+1); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	case reflect.Map:
+		n, err := d.readLength(majorMap)
+		if err != nil {
+			return err
+		}
+		m := reflect.MakeMapWithSize(v.Type(), n)
+		for i := 0; i < n; i++ {
+			key := reflect.New(v.Type().Key()).Elem()
+			if err := d.decodeValue(key, depth+1); err != nil {
+				return err
+			}
+			value := reflect.New(v.Type().Elem()).Elem()
+			if err := d.decodeValue(value, depth+1); err != nil {
+				return err
+			}
+			m.SetMapIndex(key, value)
+		}
+		v.Set(m)
+		return nil
+	}
+
+	return fmt.Errorf("unmarshal %s: unsupported type", v.Type())
+}
+
+// skip skips the next item, which is a field unknown to the message.
+func (d *decoder) skip(depth int) error {
+	if depth > maxDepth {
+		return fmt.Errorf("depth over %d: %w", maxDepth, ErrInvalid)
+	}
+
+	major, _, n, err := d.readHead()
+	if err != nil {
+		return err
+	}
+
+	switch major {
+	case majorUint, majorNegInt, majorSimple:
+		return nil
+	case majorBytes, majorText:
+		if n > uint64(len(d.data)-d.off) {
+			return fmt.Errorf("length %d: %w", n, ErrInvalid)
+		}
+		d.off += int(n)
+		return nil
+	case majorArray, majorMap:
+		if n > uint64(len(d.data)-d.off) {
+			return fmt.Errorf("length %d: %w", n, ErrInvalid)
+		}
+		items := int(n)
+		if major == majorMap {
+			items *= 2
+		}
+		for i := 0; i < items; i++ {
+			if err := d.skip(depth + 1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("major type %d: %w", major, ErrInvalid)
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cbor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/internal/cbor"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+type messageV1 struct {
+	Number int64  `protobuf:"varint,1,opt,name=number,proto3"`
+	Text   string `protobuf:"bytes,2,opt,name=text,proto3"`
+}
+
+type messageV2 struct {
+	Number int64             `protobuf:"varint,1,opt,name=number,proto3"`
+	Text   string            `protobuf:"bytes,2,opt,name=text,proto3"`
+	Values []float64         `protobuf:"fixed64,3,rep,name=values,proto3"`
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3"`
+	Nested *messageV1        `protobuf:"bytes,5,opt,name=nested,proto3"`
+}
+
+func TestCBOR(t *testing.T) {
+	t.Run("round trip test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello").Style(0, 1, map[string]string{"b": "1"})
+			root.SetNewArray("k2").AddInteger(1, -2).AddDouble(math.Pi).AddBool(true)
+			root.SetNewCounter("k3", 1, int64(math.MaxInt64))
+			root.SetNewTree("k4").Edit(0, 0, &json.TreeNode{
+				Type:     "p",
+				Children: []json.TreeNode{{Type: "text", Value: "world"}},
+			})
+			root.SetNewObject("k5").SetNull("k5.1").SetBytes("k5.2", []byte{0, 1, 2})
+			p.Set("name", "alice")
+			return nil
+		}))
+
+		pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+		assert.NoError(t, err)
+		data, err := cbor.Marshal(pbPack)
+		assert.NoError(t, err)
+
+		decoded := &api.ChangePack{}
+		assert.NoError(t, cbor.Unmarshal(data, decoded))
+		assert.True(t, proto.Equal(pbPack, decoded))
+
+		v2 := &messageV2{
+			Number: -1 << 40,
+			Text:   "yorkie",
+			Values: []float64{math.Inf(1), 0.5},
+			Labels: map[string]string{"a": "1", "b": "2"},
+			Nested: &messageV1{},
+		}
+		data, err = cbor.Marshal(v2)
+		assert.NoError(t, err)
+		decodedV2 := &messageV2{}
+		assert.NoError(t, cbor.Unmarshal(data, decodedV2))
+		assert.Equal(t, v2, decodedV2)
+	})
+
+	t.Run("unknown field test", func(t *testing.T) {
+		data, err := cbor.Marshal(&messageV2{
+			Number: 10,
+			Text:   "yorkie",
+			Values: []float64{1},
+			Labels: map[string]string{"a": "1"},
+			Nested: &messageV1{Number: 1},
+		})
+		assert.NoError(t, err)
+
+		// the fields unknown to the older message are skipped.
+		v1 := &messageV1{}
+		assert.NoError(t, cbor.Unmarshal(data, v1))
+		assert.Equal(t, &messageV1{Number: 10, Text: "yorkie"}, v1)
+	})
+
+	t.Run("invalid data test", func(t *testing.T) {
+		data, err := cbor.Marshal(&messageV1{Number: 10, Text: "yorkie"})
+		assert.NoError(t, err)
+
+		for _, invalid := range [][]byte{
+			{},
+			data[:len(data)-1],
+			append(append([]byte{}, data...), 0),
+			{0xa1, 0x02, 0x7b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			{0xa1, 0x01, 0x62, 0x68, 0x69},
+			{0x80},
+		} {
+			assert.ErrorIs(t, cbor.Unmarshal(invalid, &messageV1{}), cbor.ErrInvalid)
+		}
+	})
+}
@@ -0,0 +1,356 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package compression provides the gRPC compressors that the server and the
+// client negotiate. The client chooses a compressor for its calls and the
+// server replies with the same compressor.
+//
+// The compressors are registered to gRPC globally, so the settings of this
+// package apply to all the servers and clients in the process.
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+)
+
+const (
+	// Gzip is the name of the gzip compressor.
+	Gzip = "gzip"
+
+	// Zstd is the name of the zstd compressor.
+	Zstd = "zstd"
+
+	// DefaultMinSize is the default size in bytes of the messages below which
+	// the compressors use the cheapest level.
+	DefaultMinSize = 1024
+)
+
+var (
+	// ErrUnsupportedCompressor is returned when the given compressor is not
+	// supported.
+	ErrUnsupportedCompressor = errors.New("unsupported compressor")
+
+	// ErrTooLarge is returned when the decompressed data exceeds the given
+	// maximum size.
+	ErrTooLarge = errors.New("decompressed data too large")
+)
+
+// Observer is called with the sizes in bytes of each message before and after
+// it is compressed by the given compressor.
+type Observer func(compressor string, uncompressed, compressed int)
+
+var (
+	minSize  atomic.Int64
+	observer atomic.Value
+)
+
+func init() {
+	minSize.Store(DefaultMinSize)
+
+	encoding.RegisterCompressor(newGzipCompressor())
+	encoding.RegisterCompressor(newZstdCompressor())
+}
+
+// Validate validates the given name of the compressor. The empty name means
+// that the messages are not compressed.
+func Validate(name string) error {
+	switch name {
+	case "", Gzip, Zstd:
+		return nil
+	}
+
+	return fmt.Errorf("%s: %w", name, ErrUnsupportedCompressor)
+}
+
+// Compress compresses the given data with the given compressor. Unlike the
+// messages of gRPC, it is used to compress the payloads of the messages, such
+// as the changes and the snapshots of change packs.
+func Compress(name string, data []byte) ([]byte, error) {
+	c := encoding.GetCompressor(name)
+	if c == nil {
+		return nil, fmt.Errorf("%s: %w", name, ErrUnsupportedCompressor)
+	}
+
+	var buf bytes.Buffer
+	w, err := c.Compress(&buf)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Decompress decompresses the given data with the given compressor. It
+// returns ErrTooLarge if the decompressed data exceeds maxSize bytes, so that
+// a small payload cannot be inflated without bound. A maxSize of zero means
+// that it is not limited.
+func Decompress(name string, data []byte, maxSize uint64) ([]byte, error) {
+	c := encoding.GetCompressor(name)
+	if c == nil {
+		return nil, fmt.Errorf("%s: %w", name, ErrUnsupportedCompressor)
+	}
+
+	r, err := c.Decompress(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if maxSize > 0 {
+		r = io.LimitReader(r, int64(maxSize)+1)
+	}
+
+	decompressed, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize > 0 && uint64(len(decompressed)) > maxSize {
+		return nil, fmt.Errorf("more than %d bytes: %w", maxSize, ErrTooLarge)
+	}
+
+	return decompressed, nil
+}
+
+// SetMinSize sets the size in bytes of the messages below which the
+// compressors use the cheapest level: gzip stores them without compression
+// and zstd uses its fastest level, since compressing small messages costs
+// more than it saves.
+func SetMinSize(size int) {
+	minSize.Store(int64(size))
+}
+
+// SetObserver sets the observer of the compressed messages.
+func SetObserver(o Observer) {
+	observer.Store(o)
+}
+
+// IsSmall returns whether the message of the given size is below the
+// minimum size.
+func IsSmall(size int) bool {
+	return int64(size) < minSize.Load()
+}
+
+// observe calls the observer with the sizes of the compressed message.
+func observe(compressor string, uncompressed, compressed int) {
+	if o, ok := observer.Load().(Observer); ok && o != nil {
+		o(compressor, uncompressed, compressed)
+	}
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += n
+	return n, err
+}
+
+// gzipCompressor is the gzip compressor with the pools of the writers of the
+// default level and of no compression.
+type gzipCompressor struct {
+	writers      sync.Pool
+	smallWriters sync.Pool
+}
+
+func newGzipCompressor() *gzipCompressor {
+	c := &gzipCompressor{}
+	c.writers.New = func() interface{} {
+		return gzip.NewWriter(io.Discard)
+	}
+	c.smallWriters.New = func() interface{} {
+		w, _ := gzip.NewWriterLevel(io.Discard, gzip.NoCompression)
+		return w
+	}
+	return c
+}
+
+// Name returns the name of this compressor.
+func (c *gzipCompressor) Name() string {
+	return Gzip
+}
+
+// Compress returns a writer that compresses the message written to it. gRPC
+// writes the whole message at once, so the level is chosen by its size.
+func (c *gzipCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &gzipWriter{c: c, dst: &countingWriter{w: w}}, nil
+}
+
+// Decompress returns a reader that decompresses the given reader.
+func (c *gzipCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return gzip.NewReader(r)
+}
+
+type gzipWriter struct {
+	c    *gzipCompressor
+	dst  *countingWriter
+	pool *sync.Pool
+	z    *gzip.Writer
+	n    int
+}
+
+func (w *gzipWriter) Write(p []byte) (int, error) {
+	if w.z == nil {
+		w.pool = &w.c.writers
+		if IsSmall(len(p)) {
+			w.pool = &w.c.smallWriters
+		}
+		w.z = w.pool.Get().(*gzip.Writer)
+		w.z.Reset(w.dst)
+	}
+
+	n, err := w.z.Write(p)
+	w.n += n
+	return n, err
+}
+
+func (w *gzipWriter) Close() error {
+	if w.z == nil {
+		return nil
+	}
+
+	err := w.z.Close()
+	w.pool.Put(w.z)
+	w.z = nil
+	observe(Gzip, w.n, w.dst.n)
+	return err
+}
+
+// zstdCompressor is the zstd compressor with the pools of the encoders of the
+// default level and of the fastest level.
+type zstdCompressor struct {
+	encoders      sync.Pool
+	smallEncoders sync.Pool
+	decoders      sync.Pool
+}
+
+func newZstdCompressor() *zstdCompressor {
+	c := &zstdCompressor{}
+	c.encoders.New = func() interface{} {
+		return newZstdEncoder(zstd.SpeedDefault)
+	}
+	c.smallEncoders.New = func() interface{} {
+		return newZstdEncoder(zstd.SpeedFastest)
+	}
+	c.decoders.New = func() interface{} {
+		d, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		return d
+	}
+	return c
+}
+
+func newZstdEncoder(level zstd.EncoderLevel) *zstd.Encoder {
+	e, _ := zstd.NewWriter(
+		nil,
+		zstd.WithEncoderLevel(level),
+		zstd.WithEncoderConcurrency(1),
+	)
+	return e
+}
+
+// Name returns the name of this compressor.
+func (c *zstdCompressor) Name() string {
+	return Zstd
+}
+
+// Compress returns a writer that compresses the message written to it. gRPC
+// writes the whole message at once, so the level is chosen by its size.
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &zstdWriter{c: c, dst: &countingWriter{w: w}}, nil
+}
+
+// Decompress returns a reader that decompresses the given reader. The decoder
+// is returned to the pool when the reader is read to the end.
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	d := c.decoders.Get().(*zstd.Decoder)
+	if err := d.Reset(r); err != nil {
+		c.decoders.Put(d)
+		return nil, err
+	}
+
+	return &zstdReader{c: c, d: d}, nil
+}
+
+type zstdWriter struct {
+	c    *zstdCompressor
+	dst  *countingWriter
+	pool *sync.Pool
+	e    *zstd.Encoder
+	n    int
+}
+
+func (w *zstdWriter) Write(p []byte) (int, error) {
+	if w.e == nil {
+		w.pool = &w.c.encoders
+		if IsSmall(len(p)) {
+			w.pool = &w.c.smallEncoders
+		}
+		w.e = w.pool.Get().(*zstd.Encoder)
+		w.e.Reset(w.dst)
+	}
+
+	n, err := w.e.Write(p)
+	w.n += n
+	return n, err
+}
+
+func (w *zstdWriter) Close() error {
+	if w.e == nil {
+		return nil
+	}
+
+	err := w.e.Close()
+	w.pool.Put(w.e)
+	w.e = nil
+	observe(Zstd, w.n, w.dst.n)
+	return err
+}
+
+type zstdReader struct {
+	c *zstdCompressor
+	d *zstd.Decoder
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.d == nil {
+		return 0, io.EOF
+	}
+
+	n, err := r.d.Read(p)
+	if errors.Is(err, io.EOF) {
+		// NOTE: Release the source reader before returning the decoder.
+		_ = r.d.Reset(bytes.NewReader(nil))
+		r.c.decoders.Put(r.d)
+		r.d = nil
+	}
+	return n, err
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compression_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/yorkie-team/yorkie/internal/compression"
+)
+
+func TestCompression(t *testing.T) {
+	t.Run("validate test", func(t *testing.T) {
+		assert.NoError(t, compression.Validate(""))
+		assert.NoError(t, compression.Validate(compression.Gzip))
+		assert.NoError(t, compression.Validate(compression.Zstd))
+		assert.ErrorIs(t, compression.Validate("br"), compression.ErrUnsupportedCompressor)
+	})
+
+	t.Run("round trip test", func(t *testing.T) {
+		type observation struct {
+			compressor   string
+			uncompressed int
+			compressed   int
+		}
+		var observations []observation
+		compression.SetObserver(func(compressor string, uncompressed, compressed int) {
+			observations = append(observations, observation{compressor, uncompressed, compressed})
+		})
+		defer compression.SetObserver(nil)
+
+		small := []byte("hello yorkie")
+		large := []byte(strings.Repeat("hello yorkie ", 1000))
+		for _, name := range []string{compression.Gzip, compression.Zstd} {
+			c := encoding.GetCompressor(name)
+			assert.NotNil(t, c)
+
+			for _, msg := range [][]byte{small, large, large} {
+				var buf bytes.Buffer
+				w, err := c.Compress(&buf)
+				assert.NoError(t, err)
+				_, err = w.Write(msg)
+				assert.NoError(t, err)
+				assert.NoError(t, w.Close())
+
+				r, err := c.Decompress(&buf)
+				assert.NoError(t, err)
+				decompressed, err := io.ReadAll(r)
+				assert.NoError(t, err)
+				assert.Equal(t, msg, decompressed)
+			}
+		}
+
+		assert.Len(t, observations, 6)
+		for _, o := range observations {
+			assert.Greater(t, o.compressed, 0)
+			if o.uncompressed == len(large) {
+				assert.Less(t, o.compressed, o.uncompressed/10, o.compressor)
+			} else {
+				assert.Equal(t, len(small), o.uncompressed)
+			}
+		}
+	})
+
+	t.Run("compress payload test", func(t *testing.T) {
+		data := []byte(strings.Repeat("hello yorkie ", 1000))
+		for _, name := range []string{compression.Gzip, compression.Zstd} {
+			compressed, err := compression.Compress(name, data)
+			assert.NoError(t, err)
+			assert.Less(t, len(compressed), len(data)/10)
+
+			decompressed, err := compression.Decompress(name, compressed, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, data, decompressed)
+
+			decompressed, err = compression.Decompress(name, compressed, uint64(len(data)))
+			assert.NoError(t, err)
+			assert.Equal(t, data, decompressed)
+
+			// the decompressed data should not exceed the max size.
+			_, err = compression.Decompress(name, compressed, uint64(len(data)-1))
+			assert.ErrorIs(t, err, compression.ErrTooLarge)
+		}
+
+		_, err := compression.Compress("br", data)
+		assert.ErrorIs(t, err, compression.ErrUnsupportedCompressor)
+	})
+
+	t.Run("min size test", func(t *testing.T) {
+		msg := []byte(strings.Repeat("a", 2048))
+		sizes := make(map[string]int)
+		compression.SetObserver(func(compressor string, uncompressed, compressed int) {
+			sizes[compressor] = compressed
+		})
+		defer compression.SetObserver(nil)
+		defer compression.SetMinSize(compression.DefaultMinSize)
+
+		c := encoding.GetCompressor(compression.Gzip)
+		compress := func() {
+			w, err := c.Compress(io.Discard)
+			assert.NoError(t, err)
+			_, err = w.Write(msg)
+			assert.NoError(t, err)
+			assert.NoError(t, w.Close())
+		}
+
+		// messages below the min size are stored without compression.
+		compression.SetMinSize(len(msg) + 1)
+		compress()
+		assert.Greater(t, sizes[compression.Gzip], len(msg))
+
+		compression.SetMinSize(len(msg))
+		compress()
+		assert.Less(t, sizes[compression.Gzip], len(msg)/10)
+	})
+}
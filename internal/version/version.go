@@ -17,6 +17,13 @@
 // Package version provides the version information of Yorkie.
 package version
 
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // At build time, the versions is replaced with the current version using the -X linker flag
 var (
 	// Version is the main version number that is being run at the moment.
@@ -25,3 +32,64 @@ var (
 	// BuildDate is the date the executable was built.
 	BuildDate string
 )
+
+// ErrInvalidVersion is returned when the given version string is not in the
+// form of "major.minor.patch".
+var ErrInvalidVersion = errors.New("invalid version")
+
+// Compare compares the two semantic versions and returns -1, 0 or 1 if v1 is
+// lower than, equal to or greater than v2. Pre-release and build suffixes
+// such as "-rc.1" are ignored.
+func Compare(v1, v2 string) (int, error) {
+	p1, err := parse(v1)
+	if err != nil {
+		return 0, err
+	}
+	p2, err := parse(v2)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < len(p1); i++ {
+		if p1[i] < p2[i] {
+			return -1, nil
+		}
+		if p1[i] > p2[i] {
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// Validate returns an error if the given version is not a valid semantic
+// version.
+func Validate(v string) error {
+	_, err := parse(v)
+	return err
+}
+
+// parse parses the given version into major, minor and patch numbers.
+func parse(v string) ([3]int, error) {
+	var result [3]int
+
+	v = strings.TrimPrefix(v, "v")
+	if idx := strings.IndexAny(v, "-+"); idx >= 0 {
+		v = v[:idx]
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return result, fmt.Errorf("%s: %w", v, ErrInvalidVersion)
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return result, fmt.Errorf("%s: %w", v, ErrInvalidVersion)
+		}
+		result[i] = n
+	}
+
+	return result, nil
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/internal/version"
+)
+
+func TestVersion(t *testing.T) {
+	t.Run("compare test", func(t *testing.T) {
+		tests := []struct {
+			v1, v2   string
+			expected int
+		}{
+			{"0.4.6", "0.4.6", 0},
+			{"0.4.5", "0.4.6", -1},
+			{"0.10.0", "0.9.9", 1},
+			{"v1.0.0", "1.0.0", 0},
+			{"0.4.6-rc.1", "0.4.6", 0},
+		}
+
+		for _, test := range tests {
+			result, err := version.Compare(test.v1, test.v2)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result, "%s vs %s", test.v1, test.v2)
+		}
+	})
+
+	t.Run("invalid version test", func(t *testing.T) {
+		_, err := version.Compare("0.4", "0.4.6")
+		assert.ErrorIs(t, err, version.ErrInvalidVersion)
+
+		_, err = version.Compare("0.4.6", "a.b.c")
+		assert.ErrorIs(t, err, version.ErrInvalidVersion)
+	})
+}
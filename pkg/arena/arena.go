@@ -0,0 +1,64 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package arena provides an allocator that allocates values of a type in
+// chunks to reduce the number of allocations of small objects.
+package arena
+
+// DefaultChunkSize is the default number of values in a chunk.
+const DefaultChunkSize = 64
+
+// Arena allocates values of T from chunks instead of allocating them one by
+// one. The zero value is ready to use with DefaultChunkSize, and a nil Arena
+// allocates values one by one. It is not safe for concurrent use.
+//
+// A chunk is not freed until all values allocated from it are unreachable.
+// So an arena should be owned by the values that live as long as each other,
+// such as the values decoded from the change pack or the snapshot of a
+// document, and should not be shared across documents.
+type Arena[T any] struct {
+	chunkSize int
+
+	// chunk holds the values that have not been allocated yet.
+	chunk []T
+}
+
+// New creates a new instance of Arena that allocates chunks of the given
+// size. If the size is not positive, DefaultChunkSize is used.
+func New[T any](chunkSize int) *Arena[T] {
+	return &Arena[T]{
+		chunkSize: chunkSize,
+	}
+}
+
+// Alloc returns a pointer to a new zero value of T.
+func (a *Arena[T]) Alloc() *T {
+	if a == nil {
+		return new(T)
+	}
+
+	if len(a.chunk) == 0 {
+		size := a.chunkSize
+		if size <= 0 {
+			size = DefaultChunkSize
+		}
+		a.chunk = make([]T, size)
+	}
+
+	value := &a.chunk[0]
+	a.chunk = a.chunk[1:]
+	return value
+}
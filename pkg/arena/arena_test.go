@@ -0,0 +1,65 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package arena_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/arena"
+)
+
+type value struct {
+	num int
+}
+
+func TestArena(t *testing.T) {
+	t.Run("alloc test", func(t *testing.T) {
+		a := arena.New[value](4)
+
+		values := make(map[*value]bool)
+		for i := 0; i < 10; i++ {
+			v := a.Alloc()
+			assert.Zero(t, v.num)
+			assert.False(t, values[v])
+
+			v.num = i
+			values[v] = true
+		}
+		assert.Len(t, values, 10)
+	})
+
+	t.Run("zero value alloc test", func(t *testing.T) {
+		var a arena.Arena[value]
+
+		prev := a.Alloc()
+		for i := 1; i < arena.DefaultChunkSize*2; i++ {
+			v := a.Alloc()
+			assert.NotSame(t, prev, v)
+			prev = v
+		}
+	})
+
+	t.Run("nil arena alloc test", func(t *testing.T) {
+		var a *arena.Arena[value]
+
+		v := a.Alloc()
+		assert.Zero(t, v.num)
+		assert.NotSame(t, v, a.Alloc())
+	})
+}
@@ -25,6 +25,8 @@ import (
 	"errors"
 	"sync"
 	"time"
+
+	"github.com/yorkie-team/yorkie/pkg/clock"
 )
 
 var (
@@ -35,7 +37,8 @@ var (
 // LRUExpireCache is a cache that ensures the mostly recently accessed keys are returned with
 // a ttl beyond which keys are forcibly expired.
 type LRUExpireCache[K comparable, V any] struct {
-	lock sync.Mutex
+	clock clock.Clock
+	lock  sync.Mutex
 
 	maxSize      int
 	evictionList list.List
@@ -44,11 +47,21 @@ type LRUExpireCache[K comparable, V any] struct {
 
 // NewLRUExpireCache creates an expiring cache with the given size
 func NewLRUExpireCache[K comparable, V any](maxSize int) (*LRUExpireCache[K, V], error) {
+	return NewLRUExpireCacheWithClock[K, V](maxSize, clock.New())
+}
+
+// NewLRUExpireCacheWithClock creates an expiring cache with the given size,
+// using the provided clock to obtain the current time.
+func NewLRUExpireCacheWithClock[K comparable, V any](
+	maxSize int,
+	clk clock.Clock,
+) (*LRUExpireCache[K, V], error) {
 	if maxSize <= 0 {
 		return nil, ErrInvalidMaxSize
 	}
 
 	return &LRUExpireCache[K, V]{
+		clock:   clk,
 		maxSize: maxSize,
 		entries: map[K]*list.Element{},
 	}, nil
@@ -73,7 +86,7 @@ func (c *LRUExpireCache[K, V]) Add(
 	if ok {
 		c.evictionList.MoveToFront(oldElement)
 		oldElement.Value.(*cacheEntry[K, V]).value = value
-		oldElement.Value.(*cacheEntry[K, V]).expireTime = time.Now().Add(ttl)
+		oldElement.Value.(*cacheEntry[K, V]).expireTime = c.clock.Now().Add(ttl)
 		return
 	}
 
@@ -86,7 +99,7 @@ func (c *LRUExpireCache[K, V]) Add(
 	element := c.evictionList.PushFront(&cacheEntry[K, V]{
 		key:        key,
 		value:      value,
-		expireTime: time.Now().Add(ttl),
+		expireTime: c.clock.Now().Add(ttl),
 	})
 	c.entries[key] = element
 }
@@ -103,7 +116,7 @@ func (c *LRUExpireCache[K, V]) Get(key K) (V, bool) {
 		return nilV, false
 	}
 
-	if time.Now().After(element.Value.(*cacheEntry[K, V]).expireTime) {
+	if c.clock.Now().After(element.Value.(*cacheEntry[K, V]).expireTime) {
 		c.evictionList.Remove(element)
 		delete(c.entries, key)
 		return nilV, false
@@ -113,3 +126,39 @@ func (c *LRUExpireCache[K, V]) Get(key K) (V, bool) {
 
 	return element.Value.(*cacheEntry[K, V]).value, true
 }
+
+// Range calls the given function for each of the values that are not expired
+// in the cache. The function must not access the cache.
+func (c *LRUExpireCache[K, V]) Range(f func(key K, value V)) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.clock.Now()
+	for _, element := range c.entries {
+		entry := element.Value.(*cacheEntry[K, V])
+		if now.After(entry.expireTime) {
+			continue
+		}
+		f(entry.key, entry.value)
+	}
+}
+
+// RemoveFunc removes the values whose keys satisfy the given function from the
+// cache. It returns the number of removed values.
+func (c *LRUExpireCache[K, V]) RemoveFunc(f func(key K) bool) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	removed := 0
+	for key, element := range c.entries {
+		if !f(key) {
+			continue
+		}
+
+		c.evictionList.Remove(element)
+		delete(c.entries, key)
+		removed++
+	}
+
+	return removed
+}
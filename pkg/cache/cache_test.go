@@ -1,12 +1,14 @@
 package cache_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/cache"
+	"github.com/yorkie-team/yorkie/pkg/clock"
 )
 
 func TestCache(t *testing.T) {
@@ -71,4 +73,64 @@ func TestCache(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, "response2", response2)
 	})
+
+	t.Run("expire with clock test", func(t *testing.T) {
+		clk := clock.NewFake(time.Now())
+		lruCache, err := cache.NewLRUExpireCacheWithClock[string, string](1, clk)
+		assert.NoError(t, err)
+
+		lruCache.Add("request", "response", time.Minute)
+		clk.Advance(time.Minute)
+		response, ok := lruCache.Get("request")
+		assert.True(t, ok)
+		assert.Equal(t, "response", response)
+
+		clk.Advance(time.Nanosecond)
+		_, ok = lruCache.Get("request")
+		assert.False(t, ok)
+	})
+
+	t.Run("remove func test", func(t *testing.T) {
+		lruCache, err := cache.NewLRUExpireCache[string, string](3)
+		assert.NoError(t, err)
+
+		lruCache.Add("doc1-1", "snapshot1", time.Minute)
+		lruCache.Add("doc1-2", "snapshot2", time.Minute)
+		lruCache.Add("doc2-1", "snapshot3", time.Minute)
+
+		removed := lruCache.RemoveFunc(func(key string) bool {
+			return strings.HasPrefix(key, "doc1-")
+		})
+		assert.Equal(t, 2, removed)
+
+		_, ok := lruCache.Get("doc1-1")
+		assert.False(t, ok)
+		_, ok = lruCache.Get("doc1-2")
+		assert.False(t, ok)
+		response, ok := lruCache.Get("doc2-1")
+		assert.True(t, ok)
+		assert.Equal(t, "snapshot3", response)
+
+		// the removed values do not occupy the cache anymore.
+		lruCache.Add("doc3-1", "snapshot4", time.Minute)
+		lruCache.Add("doc3-2", "snapshot5", time.Minute)
+		_, ok = lruCache.Get("doc2-1")
+		assert.True(t, ok)
+	})
+
+	t.Run("range test", func(t *testing.T) {
+		clk := clock.NewFake(time.Now())
+		lruCache, err := cache.NewLRUExpireCacheWithClock[string, string](3, clk)
+		assert.NoError(t, err)
+
+		lruCache.Add("doc1-1", "snapshot1", time.Minute)
+		lruCache.Add("doc1-2", "snapshot2", time.Hour)
+
+		clk.Advance(time.Minute + time.Nanosecond)
+		values := make(map[string]string)
+		lruCache.Range(func(key string, value string) {
+			values[key] = value
+		})
+		assert.Equal(t, map[string]string{"doc1-2": "snapshot2"}, values)
+	})
 }
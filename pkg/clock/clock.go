@@ -0,0 +1,51 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package clock provides the clock that time-dependent subsystems such as
+// housekeeping and caches read the time from, so that tests can control it.
+package clock
+
+import (
+	"time"
+)
+
+// Clock tells the current time and waits for durations.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the given duration to elapse and then sends the current
+	// time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the clock of the system.
+type realClock struct{}
+
+// New returns the clock of the system.
+func New() Clock {
+	return realClock{}
+}
+
+// Now returns the current time of the system.
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// After waits for the given duration to elapse in the system.
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// waiter is a channel waiting for a time of the fake clock.
+type waiter struct {
+	until time.Time
+	ch    chan time.Time
+}
+
+// Fake is a clock for testing whose time moves only when it is advanced.
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*waiter
+}
+
+// NewFake returns a fake clock that starts at the given time.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the current time of this clock.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// After returns a channel that receives the time of this clock once it is
+// advanced by the given duration.
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- f.now
+		return ch
+	}
+
+	f.waiters = append(f.waiters, &waiter{until: f.now.Add(d), ch: ch})
+	return ch
+}
+
+// Advance moves the time of this clock forward by the given duration and
+// wakes up the waiters whose time has come.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+
+	var waiters []*waiter
+	for _, w := range f.waiters {
+		if w.until.After(f.now) {
+			waiters = append(waiters, w)
+			continue
+		}
+		w.ch <- f.now
+	}
+	f.waiters = waiters
+}
+
+// Waiters returns the number of waiters that are waiting for this clock to
+// be advanced. Tests use it to know that a subsystem started to wait.
+func (f *Fake) Waiters() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.waiters)
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/clock"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("advance test", func(t *testing.T) {
+		clk := clock.NewFake(start)
+		assert.Equal(t, start, clk.Now())
+
+		clk.Advance(time.Hour)
+		assert.Equal(t, start.Add(time.Hour), clk.Now())
+	})
+
+	t.Run("after test", func(t *testing.T) {
+		clk := clock.NewFake(start)
+		ch := clk.After(time.Minute)
+		assert.Equal(t, 1, clk.Waiters())
+
+		clk.Advance(30 * time.Second)
+		select {
+		case <-ch:
+			assert.Fail(t, "the waiter should not be woken up")
+		default:
+		}
+
+		clk.Advance(30 * time.Second)
+		assert.Equal(t, start.Add(time.Minute), <-ch)
+		assert.Equal(t, 0, clk.Waiters())
+
+		assert.Equal(t, start.Add(time.Minute), <-clk.After(0))
+	})
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package change
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/arena"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+// Arena allocates the changes decoded from a change pack of a document. It
+// should be created for each decoding of a change pack so that the memory of
+// a document is not pinned by the others. A nil Arena allocates them one by
+// one.
+type Arena struct {
+	changes arena.Arena[Change]
+}
+
+// NewArena creates a new instance of Arena.
+func NewArena() *Arena {
+	return &Arena{}
+}
+
+// New is like New, but allocates the change from this arena.
+func (a *Arena) New(
+	id ID,
+	message string,
+	operations []operations.Operation,
+	p *innerpresence.PresenceChange,
+) *Change {
+	if a == nil {
+		return New(id, message, operations, p)
+	}
+
+	c := a.changes.Alloc()
+	c.id = id
+	c.message = message
+	c.operations = operations
+	c.presenceChange = p
+	return c
+}
@@ -74,6 +74,21 @@ func (id ID) Next() ID {
 	}
 }
 
+// NextWith creates a next ID of this ID whose lamport timestamp is issued by
+// the given source. If the source is nil or issues a timestamp that is not
+// after the timestamp of this ID, it is the same as Next.
+func (id ID) NextWith(source LamportSource) ID {
+	next := id.Next()
+	if source == nil {
+		return next
+	}
+
+	if lamport := source.NextLamport(id.lamport); lamport > id.lamport {
+		next.lamport = lamport
+	}
+	return next
+}
+
 // NewTimeTicket creates a ticket of the given delimiter.
 func (id ID) NewTimeTicket(delimiter uint32) *time.Ticket {
 	return time.NewTicket(
@@ -0,0 +1,34 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package change
+
+// LamportSource issues the lamport timestamps of new changes. It is used to
+// make the tickets of changes predictable, e.g. in tests.
+type LamportSource interface {
+	// NextLamport returns the lamport timestamp of the change following the
+	// change of the given lamport timestamp.
+	NextLamport(lamport int64) int64
+}
+
+// LamportSourceFunc is an adapter to use an ordinary function as a
+// LamportSource.
+type LamportSourceFunc func(lamport int64) int64
+
+// NextLamport calls f(lamport).
+func (f LamportSourceFunc) NextLamport(lamport int64) int64 {
+	return f(lamport)
+}
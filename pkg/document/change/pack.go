@@ -41,6 +41,10 @@ type Pack struct {
 
 	// IsRemoved is a flag that indicates whether the document is removed.
 	IsRemoved bool
+
+	// IdempotencyKey identifies the push of the changes. The pushes retried
+	// with the same key are answered with the response of the first push.
+	IdempotencyKey string
 }
 
 // NewPack creates a new instance of Pack.
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/arena"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/splay"
+)
+
+// Arena allocates the elements decoded from a document, such as the nodes of
+// texts. It should be created for each decoding of a document so that the
+// memory of a document is not pinned by the others. A nil Arena allocates
+// them one by one.
+type Arena struct {
+	nodeIDs    arena.Arena[RGATreeSplitNodeID]
+	nodePoses  arena.Arena[RGATreeSplitNodePos]
+	textNodes  arena.Arena[RGATreeSplitNode[*TextValue]]
+	textValues arena.Arena[TextValue]
+}
+
+// NewArena creates a new instance of Arena.
+func NewArena() *Arena {
+	return &Arena{}
+}
+
+// NewRGATreeSplitNodeID is like NewRGATreeSplitNodeID, but allocates the ID
+// from this arena.
+func (a *Arena) NewRGATreeSplitNodeID(createdAt *time.Ticket, offset int) *RGATreeSplitNodeID {
+	if a == nil {
+		return NewRGATreeSplitNodeID(createdAt, offset)
+	}
+
+	id := a.nodeIDs.Alloc()
+	id.createdAt = createdAt
+	id.offset = offset
+	return id
+}
+
+// NewRGATreeSplitNodePos is like NewRGATreeSplitNodePos, but allocates the
+// position from this arena.
+func (a *Arena) NewRGATreeSplitNodePos(id *RGATreeSplitNodeID, offset int) *RGATreeSplitNodePos {
+	if a == nil {
+		return NewRGATreeSplitNodePos(id, offset)
+	}
+
+	pos := a.nodePoses.Alloc()
+	pos.id = id
+	pos.relativeOffset = offset
+	return pos
+}
+
+// NewTextNode is like NewRGATreeSplitNode with a NewTextValue, but allocates
+// the node and its value from this arena.
+func (a *Arena) NewTextNode(id *RGATreeSplitNodeID, value string, attrs *RHT) *RGATreeSplitNode[*TextValue] {
+	if a == nil {
+		return NewRGATreeSplitNode(id, NewTextValue(value, attrs))
+	}
+
+	textValue := a.textValues.Alloc()
+	textValue.value = value
+	textValue.attrs = attrs
+
+	node := a.textNodes.Alloc()
+	node.id = id
+	node.value = textValue
+	node.indexNode = splay.NewNode(node)
+	return node
+}
@@ -56,10 +56,15 @@ func (a *Array) Get(idx int) (Element, error) {
 	return node.elem, nil
 }
 
-// FindPrevCreatedAt returns the creation time of the previous element of the
+// FindPositionedAt returns the position of the given element.
+func (a *Array) FindPositionedAt(createdAt *time.Ticket) (*time.Ticket, error) {
+	return a.elements.FindPositionedAt(createdAt)
+}
+
+// FindPrevPositionedAt returns the position of the previous element of the
 // given element.
-func (a *Array) FindPrevCreatedAt(createdAt *time.Ticket) (*time.Ticket, error) {
-	return a.elements.FindPrevCreatedAt(createdAt)
+func (a *Array) FindPrevPositionedAt(createdAt *time.Ticket) (*time.Ticket, error) {
+	return a.elements.FindPrevPositionedAt(createdAt)
 }
 
 // Delete deletes the element of the given index.
@@ -71,30 +76,46 @@ func (a *Array) Delete(idx int, deletedAt *time.Ticket) (Element, error) {
 	return node.elem, nil
 }
 
-// MoveAfter moves the given `createdAt` element after the `prevCreatedAt`
-// element.
-func (a *Array) MoveAfter(prevCreatedAt, createdAt, executedAt *time.Ticket) error {
-	return a.elements.MoveAfter(prevCreatedAt, createdAt, executedAt)
+// MoveAfter moves the given `createdAt` element after the `prevPositionedAt`
+// position.
+func (a *Array) MoveAfter(prevPositionedAt, createdAt, executedAt *time.Ticket) error {
+	return a.elements.MoveAfter(prevPositionedAt, createdAt, executedAt)
 }
 
 // Elements returns an array of elements contained in this RGATreeList.
 func (a *Array) Elements() []Element {
-	var elements []Element
-	for _, node := range a.elements.Nodes() {
-		if node.isRemoved() {
-			continue
-		}
-		elements = append(elements, node.elem)
+	if a.Len() == 0 {
+		return nil
 	}
 
+	elements := make([]Element, 0, a.Len())
+	_ = a.elements.Iterate(0, func(idx int, node *RGATreeListNode) bool {
+		elements = append(elements, node.elem)
+		return false
+	})
+
 	return elements
 }
 
+// Iterate calls the given callback with the elements of this array from the
+// given index in order. It stops if the callback returns true.
+func (a *Array) Iterate(from int, callback func(idx int, elem Element) bool) error {
+	return a.elements.Iterate(from, func(idx int, node *RGATreeListNode) bool {
+		return callback(idx, node.elem)
+	})
+}
+
 // Marshal returns the JSON encoding of this Array.
 func (a *Array) Marshal() string {
 	return a.elements.Marshal()
 }
 
+// AppendJSON appends the JSON encoding of this Array to dst and returns the
+// extended buffer.
+func (a *Array) AppendJSON(dst []byte) []byte {
+	return a.elements.AppendJSON(dst)
+}
+
 // StructureAsString returns a String containing the metadata of the elements
 // for debugging purpose.
 func (a *Array) StructureAsString() string {
@@ -106,16 +127,24 @@ func (a *Array) DeepCopy() (Element, error) {
 	elements := NewRGATreeList()
 
 	for _, node := range a.elements.Nodes() {
+		if node.elem == nil {
+			if err := elements.AddVacated(node.positionedAt, node.vacatedAt); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		copiedNode, err := node.elem.DeepCopy()
 		if err != nil {
 			return nil, err
 		}
-		if err = elements.Add(copiedNode); err != nil {
+		if err = elements.AddAt(copiedNode, node.positionedAt); err != nil {
 			return nil, err
 		}
 	}
 
 	array := NewArray(elements, a.createdAt)
+	array.movedAt = a.movedAt
 	array.removedAt = a.removedAt
 	return array, nil
 }
@@ -155,14 +184,14 @@ func (a *Array) Remove(removedAt *time.Ticket) bool {
 	return false
 }
 
-// LastCreatedAt returns the creation time of the last element.
-func (a *Array) LastCreatedAt() *time.Ticket {
-	return a.elements.LastCreatedAt()
+// LastPositionedAt returns the position of the last node.
+func (a *Array) LastPositionedAt() *time.Ticket {
+	return a.elements.LastPositionedAt()
 }
 
-// InsertAfter inserts the given element after the given previous element.
-func (a *Array) InsertAfter(prevCreatedAt *time.Ticket, element Element) error {
-	return a.elements.InsertAfter(prevCreatedAt, element)
+// InsertAfter inserts the given element after the given previous position.
+func (a *Array) InsertAfter(prevPositionedAt *time.Ticket, element Element) error {
+	return a.elements.InsertAfter(prevPositionedAt, element)
 }
 
 // DeleteByCreatedAt deletes the given element.
@@ -182,6 +211,9 @@ func (a *Array) Len() int {
 // Descendants traverse the descendants of this array.
 func (a *Array) Descendants(callback func(elem Element, parent Container) bool) {
 	for _, node := range a.elements.Nodes() {
+		if node.elem == nil {
+			continue
+		}
 		if callback(node.elem, a) {
 			return
 		}
@@ -195,6 +227,17 @@ func (a *Array) Descendants(callback func(elem Element, parent Container) bool)
 	}
 }
 
+// removedNodesLen returns the number of the positions vacated by moves.
+func (a *Array) removedNodesLen() int {
+	return a.elements.vacatedNodesLen()
+}
+
+// purgeRemovedNodesBefore physically purges the positions vacated by moves
+// before the given time.
+func (a *Array) purgeRemovedNodesBefore(ticket *time.Ticket) (int, error) {
+	return a.elements.purgeVacatedNodesBefore(ticket), nil
+}
+
 // RGANodes returns the slices of RGATreeListNode.
 func (a *Array) RGANodes() []*RGATreeListNode {
 	return a.elements.Nodes()
@@ -20,6 +20,8 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -28,12 +30,21 @@ import (
 var ErrUnsupportedType = errors.New("unsupported type")
 
 // CounterType represents any type that can be used as a counter.
+//
+// The overflow behavior depends on the type. IntegerCnt and LongCnt wrap
+// around in two's complement on overflow, e.g. increasing an IntegerCnt of
+// MaxInt32 by 1 results in MinInt32. Since wrapping additions are still
+// commutative, all replicas converge to the same value regardless of the
+// order of the increases. DoubleCnt follows IEEE 754, so it saturates to
+// infinity instead, but replicas applying the increases in different orders
+// may differ in rounding.
 type CounterType int
 
 // The values below are the types that can be used as counters.
 const (
 	IntegerCnt CounterType = iota
 	LongCnt
+	DoubleCnt
 )
 
 // CounterValueFromBytes parses the given bytes into value.
@@ -44,6 +55,8 @@ func CounterValueFromBytes(counterType CounterType, value []byte) (interface{},
 		return int(val), nil
 	case LongCnt:
 		return int64(binary.LittleEndian.Uint64(value)), nil
+	case DoubleCnt:
+		return math.Float64frombits(binary.LittleEndian.Uint64(value)), nil
 	default:
 		return nil, ErrUnsupportedType
 	}
@@ -81,6 +94,16 @@ func NewCounter(valueType CounterType, value interface{}, createdAt *time.Ticket
 			value:     longValue,
 			createdAt: createdAt,
 		}, nil
+	case DoubleCnt:
+		doubleValue, err := castToDouble(value)
+		if err != nil {
+			return nil, err
+		}
+		return &Counter{
+			valueType: DoubleCnt,
+			value:     doubleValue,
+			createdAt: createdAt,
+		}, nil
 	default:
 		return nil, ErrUnsupportedType
 	}
@@ -97,6 +120,10 @@ func (p *Counter) Bytes() ([]byte, error) {
 		bytes := [8]byte{}
 		binary.LittleEndian.PutUint64(bytes[:], uint64(val))
 		return bytes[:], nil
+	case float64:
+		bytes := [8]byte{}
+		binary.LittleEndian.PutUint64(bytes[:], math.Float64bits(val))
+		return bytes[:], nil
 	default:
 		return nil, ErrUnsupportedType
 	}
@@ -104,7 +131,22 @@ func (p *Counter) Bytes() ([]byte, error) {
 
 // Marshal returns the JSON encoding of the value.
 func (p *Counter) Marshal() string {
-	return fmt.Sprintf("%d", p.value)
+	return string(p.AppendJSON(nil))
+}
+
+// AppendJSON appends the JSON encoding of the value to dst and returns the
+// extended buffer.
+func (p *Counter) AppendJSON(dst []byte) []byte {
+	switch val := p.value.(type) {
+	case int32:
+		return strconv.AppendInt(dst, int64(val), 10)
+	case int64:
+		return strconv.AppendInt(dst, val, 10)
+	case float64:
+		return strconv.AppendFloat(dst, val, 'f', 6, 64)
+	}
+
+	return fmt.Appendf(dst, "%d", p.value)
 }
 
 // DeepCopy copies itself deeply.
@@ -148,16 +190,20 @@ func (p *Counter) Remove(removedAt *time.Ticket) bool {
 	return false
 }
 
+// Value returns the value of Counter.
+func (p *Counter) Value() interface{} {
+	return p.value
+}
+
 // ValueType returns the type of the value.
 func (p *Counter) ValueType() CounterType {
 	return p.valueType
 }
 
-// Increase increases integer, long or double.
-// If the result of the operation is greater than MaxInt32 or less
-// than MinInt32, Counter's value type can be changed Integer to Long.
-// Because in golang, int can be either int32 or int64.
-// So we need to assert int to int32.
+// Increase increases integer, long or double. The given value is cast to the
+// type of the counter, truncating the fraction for integer types, and the
+// result overflows as described in CounterType. Decreases are represented as
+// increases by negative values.
 func (p *Counter) Increase(v *Primitive) (*Counter, error) {
 	if !p.IsNumericType() || !v.IsNumericType() {
 		return nil, ErrUnsupportedType
@@ -175,6 +221,12 @@ func (p *Counter) Increase(v *Primitive) (*Counter, error) {
 			return nil, err
 		}
 		p.value = p.value.(int64) + longValue
+	case DoubleCnt:
+		doubleValue, err := castToDouble(v.value)
+		if err != nil {
+			return nil, err
+		}
+		p.value = p.value.(float64) + doubleValue
 	default:
 		return nil, ErrUnsupportedType
 	}
@@ -185,7 +237,7 @@ func (p *Counter) Increase(v *Primitive) (*Counter, error) {
 // IsNumericType checks for numeric types.
 func (p *Counter) IsNumericType() bool {
 	t := p.valueType
-	return t == IntegerCnt || t == LongCnt
+	return t == IntegerCnt || t == LongCnt || t == DoubleCnt
 }
 
 // castToInt casts numeric type to int32.
@@ -223,3 +275,21 @@ func castToLong(value interface{}) (int64, error) {
 		return 0, ErrUnsupportedType
 	}
 }
+
+// castToDouble casts numeric type to float64.
+func castToDouble(value interface{}) (float64, error) {
+	switch val := value.(type) {
+	case float64:
+		return val, nil
+	case float32:
+		return float64(val), nil
+	case int32:
+		return float64(val), nil
+	case int64:
+		return float64(val), nil
+	case int:
+		return float64(val), nil
+	default:
+		return 0, ErrUnsupportedType
+	}
+}
@@ -135,4 +135,28 @@ func TestCounter(t *testing.T) {
 		assert.Equal(t, integer.ValueType(), crdt.IntegerCnt)
 		assert.Equal(t, integer.Marshal(), strconv.FormatInt(math.MinInt32, 10))
 	})
+
+	t.Run("double counter test", func(t *testing.T) {
+		double, err := crdt.NewCounter(crdt.DoubleCnt, 1, time.InitialTicket)
+		assert.NoError(t, err)
+		assert.Equal(t, crdt.DoubleCnt, double.ValueType())
+
+		_, err = double.Increase(crdt.NewPrimitive(0.5, time.InitialTicket))
+		assert.NoError(t, err)
+		_, err = double.Increase(crdt.NewPrimitive(int64(-3), time.InitialTicket))
+		assert.NoError(t, err)
+		assert.Equal(t, "-1.500000", double.Marshal())
+
+		bytes, err := double.Bytes()
+		assert.NoError(t, err)
+		value, err := crdt.CounterValueFromBytes(crdt.DoubleCnt, bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, -1.5, value)
+
+		_, err = double.Increase(crdt.NewPrimitive(math.MaxFloat64, time.InitialTicket))
+		assert.NoError(t, err)
+		_, err = double.Increase(crdt.NewPrimitive(math.MaxFloat64, time.InitialTicket))
+		assert.NoError(t, err)
+		assert.Equal(t, "+Inf", double.Marshal())
+	})
 }
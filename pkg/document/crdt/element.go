@@ -51,6 +51,10 @@ type Element interface {
 	// Marshal returns the JSON encoding of this element.
 	Marshal() string
 
+	// AppendJSON appends the JSON encoding of this element to dst and returns
+	// the extended buffer.
+	AppendJSON(dst []byte) []byte
+
 	// DeepCopy copies itself deeply.
 	DeepCopy() (Element, error)
 
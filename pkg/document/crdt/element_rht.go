@@ -19,7 +19,6 @@ package crdt
 import (
 	"fmt"
 	"sort"
-	"strings"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -155,13 +154,16 @@ func (rht *ElementRHT) Elements() map[string]Element {
 	return members
 }
 
-// Nodes returns a map of elements because the map easy to use for loop.
-// TODO: If we encounter performance issues, we need to replace this with other solution.
+// Nodes returns the nodes of this hashtable sorted by key, so that the
+// encoding of the hashtable is deterministic.
 func (rht *ElementRHT) Nodes() []*ElementRHTNode {
-	var nodes []*ElementRHTNode
+	nodes := make([]*ElementRHTNode, 0, len(rht.nodeMapByKey))
 	for _, node := range rht.nodeMapByKey {
 		nodes = append(nodes, node)
 	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].key < nodes[j].key
+	})
 
 	return nodes
 }
@@ -182,8 +184,33 @@ func (rht *ElementRHT) purge(elem Element) error {
 	return nil
 }
 
+// detach removes the given node from this hashtable without marking it as
+// removed.
+func (rht *ElementRHT) detach(node *ElementRHTNode) {
+	delete(rht.nodeMapByCreatedAt, node.elem.CreatedAt().Key())
+	if rht.nodeMapByKey[node.key] == node {
+		delete(rht.nodeMapByKey, node.key)
+	}
+}
+
+// attach adds the given node to this hashtable. The node becomes the node of
+// its key if it is created after the existing one.
+func (rht *ElementRHT) attach(node *ElementRHTNode) {
+	rht.nodeMapByCreatedAt[node.elem.CreatedAt().Key()] = node
+	prev, ok := rht.nodeMapByKey[node.key]
+	if !ok || node.elem.CreatedAt().After(prev.elem.CreatedAt()) {
+		rht.nodeMapByKey[node.key] = node
+	}
+}
+
 // Marshal returns the JSON encoding of this map.
 func (rht *ElementRHT) Marshal() string {
+	return string(rht.AppendJSON(nil))
+}
+
+// AppendJSON appends the JSON encoding of this map to dst and returns the
+// extended buffer.
+func (rht *ElementRHT) AppendJSON(dst []byte) []byte {
 	members := rht.Elements()
 
 	size := len(members)
@@ -195,16 +222,15 @@ func (rht *ElementRHT) Marshal() string {
 	}
 	sort.Strings(keys)
 
-	sb := strings.Builder{}
-	sb.WriteString("{")
+	dst = append(dst, '{')
 	for idx, k := range keys {
 		if idx > 0 {
-			sb.WriteString(",")
+			dst = append(dst, ',')
 		}
-		value := members[k]
-		sb.WriteString(fmt.Sprintf(`"%s":%s`, EscapeString(k), value.Marshal()))
+		dst = appendQuotedString(dst, k)
+		dst = append(dst, ':')
+		dst = members[k].AppendJSON(dst)
 	}
-	sb.WriteString("}")
 
-	return sb.String()
+	return append(dst, '}')
 }
@@ -17,16 +17,44 @@
 package crdt
 
 import (
+	"errors"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// ConflictPolicy represents how an object is resolved when it conflicts with
+// another object set concurrently to the same key.
+type ConflictPolicy int
+
+// The values below are the conflict policies of objects.
+const (
+	// LWW keeps only the object set last(last-write-wins).
+	LWW ConflictPolicy = iota
+
+	// Merge merges the members of the objects if both of them have this
+	// policy. The members are resolved with their own policies, and the
+	// object set last keeps the merged members.
+	Merge
+)
+
 // Object represents a JSON object, but unlike regular JSON, it has time
 // tickets which is created by logical clock.
 type Object struct {
 	memberNodes *ElementRHT
+	policy      ConflictPolicy
 	createdAt   *time.Ticket
 	movedAt     *time.Ticket
 	removedAt   *time.Ticket
+
+	// mergedInto is the object that this object is merged into. The changes
+	// to this object are forwarded to it, since the changes made concurrently
+	// with the merge should be applied to the merged members.
+	mergedInto *Object
+
+	// merged is the creation times of the objects merged into this object.
+	// It is kept in snapshots so that the changes to the merged objects can
+	// be forwarded to this object even after they are dropped.
+	merged []*time.Ticket
 }
 
 // NewObject creates a new instance of Object.
@@ -37,41 +65,145 @@ func NewObject(memberNodes *ElementRHT, createdAt *time.Ticket) *Object {
 	}
 }
 
+// Policy returns the conflict policy of this object.
+func (o *Object) Policy() ConflictPolicy {
+	return o.policy
+}
+
+// SetPolicy sets the conflict policy of this object.
+func (o *Object) SetPolicy(policy ConflictPolicy) {
+	o.policy = policy
+}
+
+// Merged returns the creation times of the objects merged into this object.
+func (o *Object) Merged() []*time.Ticket {
+	return o.merged
+}
+
+// SetMerged sets the creation times of the objects merged into this object.
+func (o *Object) SetMerged(merged []*time.Ticket) {
+	o.merged = merged
+}
+
 // Purge physically purge child element.
 func (o *Object) Purge(elem Element) error {
-	return o.memberNodes.purge(elem)
+	err := o.memberNodes.purge(elem)
+	if errors.Is(err, ErrChildNotFound) && o.mergedInto != nil {
+		return o.mergedInto.Purge(elem)
+	}
+	return err
 }
 
-// Set sets the given element of the given key.
+// Set sets the given element of the given key. It returns the element removed
+// by the setting. If the given element and the existing one are objects with
+// the Merge policy, they are merged into the one set later and the other one
+// is removed.
 func (o *Object) Set(k string, v Element) Element {
-	return o.memberNodes.Set(k, v)
+	if o.mergedInto != nil {
+		return o.mergedInto.Set(k, v)
+	}
+
+	node, ok := o.memberNodes.nodeMapByKey[k]
+	removed := o.memberNodes.Set(k, v)
+	if !ok {
+		return removed
+	}
+
+	prev, ok := node.elem.(*Object)
+	if !ok || !isMergeable(prev, v) {
+		return removed
+	}
+
+	obj := v.(*Object)
+	if obj.createdAt.After(prev.createdAt) {
+		obj.merge(prev)
+		return removed
+	}
+
+	prev.merge(obj)
+	if obj.Remove(prev.createdAt) {
+		return obj
+	}
+	return nil
 }
 
 // Members returns the member of this object as a map.
 func (o *Object) Members() map[string]Element {
+	if o.mergedInto != nil {
+		return o.mergedInto.Members()
+	}
 	return o.memberNodes.Elements()
 }
 
 // Get returns the value of the given key.
 func (o *Object) Get(k string) Element {
+	if o.mergedInto != nil {
+		return o.mergedInto.Get(k)
+	}
 	return o.memberNodes.Get(k)
 }
 
 // Has returns whether the element exists of the given key or not.
 func (o *Object) Has(k string) bool {
+	if o.mergedInto != nil {
+		return o.mergedInto.Has(k)
+	}
 	return o.memberNodes.Has(k)
 }
 
 // DeleteByCreatedAt deletes the element of the given creation time.
 func (o *Object) DeleteByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticket) (Element, error) {
-	return o.memberNodes.DeleteByCreatedAt(createdAt, deletedAt)
+	elem, err := o.memberNodes.DeleteByCreatedAt(createdAt, deletedAt)
+	if errors.Is(err, ErrChildNotFound) && o.mergedInto != nil {
+		return o.mergedInto.DeleteByCreatedAt(createdAt, deletedAt)
+	}
+	return elem, err
 }
 
 // Delete deletes the element of the given key.
 func (o *Object) Delete(k string, deletedAt *time.Ticket) Element {
+	if o.mergedInto != nil {
+		return o.mergedInto.Delete(k, deletedAt)
+	}
 	return o.memberNodes.Delete(k, deletedAt)
 }
 
+// merge merges the members of the given object set earlier into this object.
+// For each key, the member created later wins as in ElementRHT.Set, and the
+// live member that loses is moved to the given object, which is removed, so
+// that it is purged with the object by GC. Removed members are not moved as
+// they are already registered to be purged from their parents.
+func (o *Object) merge(other *Object) {
+	other.mergedInto = o
+	o.merged = append(o.merged, other.createdAt)
+	o.merged = append(o.merged, other.merged...)
+
+	for _, theirs := range other.memberNodes.Nodes() {
+		ours, ok := o.memberNodes.nodeMapByKey[theirs.key]
+		if !ok {
+			other.memberNodes.detach(theirs)
+			o.memberNodes.attach(theirs)
+			continue
+		}
+
+		winner, loser := ours.elem, theirs.elem
+		if theirs.elem.CreatedAt().After(ours.elem.CreatedAt()) {
+			winner, loser = theirs.elem, ours.elem
+
+			other.memberNodes.detach(theirs)
+			if !ours.isRemoved() {
+				o.memberNodes.detach(ours)
+				other.memberNodes.attach(ours)
+			}
+			o.memberNodes.attach(theirs)
+		}
+
+		if isMergeable(winner, loser) {
+			winner.(*Object).merge(loser.(*Object))
+		}
+	}
+}
+
 // Descendants traverse the descendants of this object.
 func (o *Object) Descendants(callback func(elem Element, parent Container) bool) {
 	for _, node := range o.memberNodes.Nodes() {
@@ -93,6 +225,12 @@ func (o *Object) Marshal() string {
 	return o.memberNodes.Marshal()
 }
 
+// AppendJSON appends the JSON encoding of this object to dst and returns the
+// extended buffer.
+func (o *Object) AppendJSON(dst []byte) []byte {
+	return o.memberNodes.AppendJSON(dst)
+}
+
 // DeepCopy copies itself deeply.
 func (o *Object) DeepCopy() (Element, error) {
 	members := NewElementRHT()
@@ -106,7 +244,9 @@ func (o *Object) DeepCopy() (Element, error) {
 	}
 
 	obj := NewObject(members, o.createdAt)
+	obj.policy = o.policy
 	obj.removedAt = o.removedAt
+	obj.merged = append([]*time.Ticket(nil), o.merged...)
 	return obj, nil
 }
 
@@ -149,3 +289,17 @@ func (o *Object) Remove(removedAt *time.Ticket) bool {
 func (o *Object) RHTNodes() []*ElementRHTNode {
 	return o.memberNodes.Nodes()
 }
+
+// isMergeable returns whether the given elements are objects to be merged.
+func isMergeable(a, b Element) bool {
+	objA, ok := a.(*Object)
+	if !ok {
+		return false
+	}
+	objB, ok := b.(*Object)
+	if !ok {
+		return false
+	}
+
+	return objA.policy == Merge && objB.policy == Merge
+}
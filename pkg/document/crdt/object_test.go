@@ -17,11 +17,13 @@
 package crdt_test
 
 import (
+	gojson "encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -39,4 +41,67 @@ func TestObject(t *testing.T) {
 		obj.Delete("k1", ctx.IssueTimeTicket())
 		assert.Equal(t, `{"k2":"v2"}`, obj.Marshal())
 	})
+
+	t.Run("append json test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		obj.Set("k\"1", crdt.NewPrimitive("v\n\x01\xff", ctx.IssueTimeTicket()))
+		arr := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+		assert.NoError(t, arr.Add(crdt.NewPrimitive(int64(1), ctx.IssueTimeTicket())))
+		assert.NoError(t, arr.Add(crdt.NewPrimitive(true, ctx.IssueTimeTicket())))
+		obj.Set("k2", arr)
+
+		dst := obj.AppendJSON([]byte("prefix:"))
+		assert.Equal(t, `prefix:{"k\"1":"v\n\u0001\ufffd","k2":[1,true]}`, string(dst))
+		assert.Equal(t, string(dst[len("prefix:"):]), obj.Marshal())
+		assert.True(t, gojson.Valid([]byte(obj.Marshal())))
+	})
+	t.Run("merge policy test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		newObject := func(ticket *time.Ticket, policy crdt.ConflictPolicy) *crdt.Object {
+			obj := crdt.NewObject(crdt.NewElementRHT(), ticket)
+			obj.SetPolicy(policy)
+			return obj
+		}
+
+		// NOTE: A and B set objects to the same key concurrently, and then set
+		// members to their own objects.
+		applyA := func(parent *crdt.Object, policy crdt.ConflictPolicy) {
+			obj := newObject(time.NewTicket(2, 0, actorA), policy)
+			parent.Set("k", obj)
+			obj.Set("x", crdt.NewPrimitive(1, time.NewTicket(4, 0, actorA)))
+			obj.Set("y", crdt.NewPrimitive(1, time.NewTicket(5, 0, actorA)))
+		}
+		applyB := func(parent *crdt.Object, policy crdt.ConflictPolicy) {
+			obj := newObject(time.NewTicket(3, 0, actorB), policy)
+			parent.Set("k", obj)
+			obj.Set("x", crdt.NewPrimitive(2, time.NewTicket(6, 0, actorB)))
+			obj.Set("z", crdt.NewPrimitive(3, time.NewTicket(7, 0, actorB)))
+		}
+
+		for _, tc := range []struct {
+			policy   crdt.ConflictPolicy
+			expected string
+		}{
+			{crdt.LWW, `{"k":{"x":2,"z":3}}`},
+			{crdt.Merge, `{"k":{"x":2,"y":1,"z":3}}`},
+		} {
+			obj1 := newObject(time.InitialTicket, crdt.LWW)
+			applyA(obj1, tc.policy)
+			applyB(obj1, tc.policy)
+
+			obj2 := newObject(time.InitialTicket, crdt.LWW)
+			applyB(obj2, tc.policy)
+			applyA(obj2, tc.policy)
+
+			assert.Equal(t, tc.expected, obj1.Marshal())
+			assert.Equal(t, tc.expected, obj2.Marshal())
+		}
+	})
 }
@@ -18,8 +18,8 @@ package crdt
 
 import (
 	"encoding/binary"
-	"fmt"
 	"math"
+	"strconv"
 	gotime "time"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
@@ -194,25 +194,33 @@ func (p *Primitive) Bytes() []byte {
 
 // Marshal returns the JSON encoding of the value.
 func (p *Primitive) Marshal() string {
+	return string(p.AppendJSON(nil))
+}
+
+// AppendJSON appends the JSON encoding of the value to dst and returns the
+// extended buffer.
+func (p *Primitive) AppendJSON(dst []byte) []byte {
 	switch p.valueType {
 	case Null:
-		return "null"
+		return append(dst, "null"...)
 	case Boolean:
-		return fmt.Sprintf("%t", p.value)
+		return strconv.AppendBool(dst, p.value.(bool))
 	case Integer:
-		return fmt.Sprintf("%d", p.value)
+		return strconv.AppendInt(dst, int64(p.value.(int32)), 10)
 	case Long:
-		return fmt.Sprintf("%d", p.value)
+		return strconv.AppendInt(dst, p.value.(int64), 10)
 	case Double:
-		return fmt.Sprintf("%f", p.value)
+		return strconv.AppendFloat(dst, p.value.(float64), 'f', 6, 64)
 	case String:
-		return fmt.Sprintf(`"%s"`, EscapeString(p.value.(string)))
+		return appendQuotedString(dst, p.value.(string))
 	case Bytes:
 		// TODO: JSON.stringify({a: new Uint8Array([1,2]), b: 2})
 		// {"a":{"0":1,"1":2},"b":2}
-		return fmt.Sprintf(`"%s"`, p.value)
+		return appendQuotedString(dst, string(p.value.([]byte)))
 	case Date:
-		return fmt.Sprintf(`"%s"`, p.value.(gotime.Time).Format(gotime.RFC3339))
+		dst = append(dst, '"')
+		dst = p.value.(gotime.Time).AppendFormat(dst, gotime.RFC3339)
+		return append(dst, '"')
 	}
 
 	panic("unsupported type")
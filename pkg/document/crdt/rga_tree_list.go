@@ -18,7 +18,6 @@ package crdt
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/splay"
@@ -29,23 +28,38 @@ type RGATreeListNode struct {
 	indexNode *splay.Node[*RGATreeListNode]
 	elem      Element
 
+	// positionedAt is the time this position was created. It is the creation
+	// time of the element for inserted nodes, and the execution time of the
+	// move for nodes created by moves. Operations refer to positions with it.
+	positionedAt *time.Ticket
+
+	// vacatedAt is the time the element of this node was moved out of it. A
+	// vacated node has no element, but it is kept as a tombstone so that the
+	// operations referring to the position can be applied on every replica.
+	vacatedAt *time.Ticket
+
 	prev *RGATreeListNode
 	next *RGATreeListNode
 }
 
-func newRGATreeListNode(elem Element) *RGATreeListNode {
+func newRGATreeListNode(elem Element, positionedAt *time.Ticket) *RGATreeListNode {
 	node := &RGATreeListNode{
-		prev: nil,
-		next: nil,
-		elem: elem,
+		prev:         nil,
+		next:         nil,
+		elem:         elem,
+		positionedAt: positionedAt,
 	}
 	node.indexNode = splay.NewNode(node)
 
 	return node
 }
 
-func newRGATreeListNodeAfter(prev *RGATreeListNode, elem Element) *RGATreeListNode {
-	newNode := newRGATreeListNode(elem)
+func newRGATreeListNodeAfter(
+	prev *RGATreeListNode,
+	elem Element,
+	positionedAt *time.Ticket,
+) *RGATreeListNode {
+	newNode := newRGATreeListNode(elem, positionedAt)
 	prevNext := prev.next
 
 	prev.next = newNode
@@ -58,23 +72,30 @@ func newRGATreeListNodeAfter(prev *RGATreeListNode, elem Element) *RGATreeListNo
 	return prev.next
 }
 
-// Element returns the element of this node.
+// Element returns the element of this node. It returns nil if the node has
+// been vacated.
 func (n *RGATreeListNode) Element() Element {
 	return n.elem
 }
 
-// CreatedAt returns the creation time of this element.
+// CreatedAt returns the creation time of this element. It returns nil if the
+// node has been vacated.
 func (n *RGATreeListNode) CreatedAt() *time.Ticket {
+	if n.elem == nil {
+		return nil
+	}
+
 	return n.elem.CreatedAt()
 }
 
-// PositionedAt returns the time this element was positioned.
+// PositionedAt returns the time this position was created.
 func (n *RGATreeListNode) PositionedAt() *time.Ticket {
-	if n.elem.MovedAt() != nil {
-		return n.elem.MovedAt()
-	}
+	return n.positionedAt
+}
 
-	return n.elem.CreatedAt()
+// VacatedAt returns the time the element of this node was moved out of it.
+func (n *RGATreeListNode) VacatedAt() *time.Ticket {
+	return n.vacatedAt
 }
 
 // Len returns the length of this node.
@@ -87,45 +108,64 @@ func (n *RGATreeListNode) Len() int {
 
 // String returns the string representation of this node.
 func (n *RGATreeListNode) String() string {
+	if n.elem == nil {
+		return ""
+	}
+
 	return n.elem.Marshal()
 }
 
 func (n *RGATreeListNode) isRemoved() bool {
-	return n.elem.RemovedAt() != nil
+	return n.elem == nil || n.elem.RemovedAt() != nil
 }
 
 // RGATreeList is a list with improved index-based lookup in RGA. RGA is a
 // linked list that has a logical clock and tombstone. Since RGA is composed as
 // a linked list, index-based element search is slow, O(n). To optimise for fast
 // insertions and removals at any index in the list, RGATreeList has a tree.
+//
+// An element is moved by inserting a new position for it and vacating its old
+// position, so that the positions are the same regardless of the order the
+// moves are applied in.
 type RGATreeList struct {
-	dummyHead          *RGATreeListNode
-	last               *RGATreeListNode
-	nodeMapByIndex     *splay.Tree[*RGATreeListNode]
-	nodeMapByCreatedAt map[string]*RGATreeListNode
+	dummyHead             *RGATreeListNode
+	last                  *RGATreeListNode
+	nodeMapByIndex        *splay.Tree[*RGATreeListNode]
+	nodeMapByCreatedAt    map[string]*RGATreeListNode
+	nodeMapByPositionedAt map[string]*RGATreeListNode
+	vacatedNodeMap        map[string]*RGATreeListNode
 }
 
 // NewRGATreeList creates a new instance of RGATreeList.
 func NewRGATreeList() *RGATreeList {
 	dummyValue := NewPrimitive(0, time.InitialTicket)
 	dummyValue.SetRemovedAt(time.InitialTicket)
-	dummyHead := newRGATreeListNode(dummyValue)
+	dummyHead := newRGATreeListNode(dummyValue, time.InitialTicket)
 	nodeMapByIndex := splay.NewTree(dummyHead.indexNode)
 	nodeMapByCreatedAt := make(map[string]*RGATreeListNode)
 	nodeMapByCreatedAt[dummyHead.CreatedAt().Key()] = dummyHead
+	nodeMapByPositionedAt := make(map[string]*RGATreeListNode)
+	nodeMapByPositionedAt[dummyHead.PositionedAt().Key()] = dummyHead
 
 	return &RGATreeList{
-		dummyHead:          dummyHead,
-		last:               dummyHead,
-		nodeMapByIndex:     nodeMapByIndex,
-		nodeMapByCreatedAt: nodeMapByCreatedAt,
+		dummyHead:             dummyHead,
+		last:                  dummyHead,
+		nodeMapByIndex:        nodeMapByIndex,
+		nodeMapByCreatedAt:    nodeMapByCreatedAt,
+		nodeMapByPositionedAt: nodeMapByPositionedAt,
+		vacatedNodeMap:        make(map[string]*RGATreeListNode),
 	}
 }
 
 // Marshal returns the JSON encoding of this RGATreeList.
 func (a *RGATreeList) Marshal() string {
-	sb := strings.Builder{}
-	sb.WriteString("[")
+	return string(a.AppendJSON(nil))
+}
+
+// AppendJSON appends the JSON encoding of this RGATreeList to dst and returns
+// the extended buffer.
+func (a *RGATreeList) AppendJSON(dst []byte) []byte {
+	dst = append(dst, '[')
 
 	current := a.dummyHead.next
 	isFirst := true
@@ -134,26 +174,37 @@ func (a *RGATreeList) Marshal() string {
 			if isFirst {
 				isFirst = false
 			} else {
-				sb.WriteString(",")
+				dst = append(dst, ',')
 			}
 
-			sb.WriteString(current.elem.Marshal())
+			dst = current.elem.AppendJSON(dst)
 		}
 
 		current = current.next
 	}
 
-	sb.WriteString("]")
-
-	return sb.String()
+	return append(dst, ']')
 }
 
 // Add adds the given element at the last.
 func (a *RGATreeList) Add(elem Element) error {
-	return a.insertAfter(a.last.CreatedAt(), elem, elem.CreatedAt())
+	return a.insertAfter(a.last.PositionedAt(), elem, elem.CreatedAt())
 }
 
-// Nodes returns an array of elements contained in this RGATreeList.
+// AddAt adds the given element at the last with the given position. It is
+// used to restore a list, e.g. from a snapshot.
+func (a *RGATreeList) AddAt(elem Element, positionedAt *time.Ticket) error {
+	return a.insertAfter(a.last.PositionedAt(), elem, positionedAt)
+}
+
+// AddVacated adds a vacated node of the given position at the last. It is
+// used to restore a list, e.g. from a snapshot.
+func (a *RGATreeList) AddVacated(positionedAt, vacatedAt *time.Ticket) error {
+	return a.insertVacatedAfter(a.last.PositionedAt(), positionedAt, vacatedAt)
+}
+
+// Nodes returns an array of nodes contained in this RGATreeList including
+// the vacated ones.
 // TODO: If we encounter performance issues, we need to replace this with other solution.
 func (a *RGATreeList) Nodes() []*RGATreeListNode {
 	var nodes []*RGATreeListNode
@@ -169,41 +220,56 @@ func (a *RGATreeList) Nodes() []*RGATreeListNode {
 	return nodes
 }
 
-// LastCreatedAt returns the creation time of last elements.
-func (a *RGATreeList) LastCreatedAt() *time.Ticket {
-	return a.last.CreatedAt()
+// LastPositionedAt returns the position of the last node.
+func (a *RGATreeList) LastPositionedAt() *time.Ticket {
+	return a.last.PositionedAt()
 }
 
-// InsertAfter inserts the given element after the given previous element.
-func (a *RGATreeList) InsertAfter(prevCreatedAt *time.Ticket, elem Element) error {
-	return a.insertAfter(prevCreatedAt, elem, elem.CreatedAt())
+// InsertAfter inserts the given element after the given previous position.
+func (a *RGATreeList) InsertAfter(prevPositionedAt *time.Ticket, elem Element) error {
+	return a.insertAfter(prevPositionedAt, elem, elem.CreatedAt())
 }
 
 // Get returns the element of the given index.
 func (a *RGATreeList) Get(idx int) (*RGATreeListNode, error) {
-	splayNode, offset, err := a.nodeMapByIndex.Find(idx)
+	if idx < 0 || idx >= a.Len() {
+		return nil, fmt.Errorf("Get %d: %w", idx, splay.ErrOutOfIndex)
+	}
+
+	// NOTE: Since the weight of removed nodes is 0, the node that
+	// covers the (idx+1)-th unit of the weight is the live node of the index.
+	// So we can find it without visiting tombstones.
+	splayNode, _, err := a.nodeMapByIndex.Find(idx + 1)
 	if err != nil {
 		return nil, err
 	}
-	node := splayNode.Value()
 
-	if idx == 0 && splayNode == a.dummyHead.indexNode {
-		for {
-			node = node.next
-			if !node.isRemoved() {
-				break
-			}
+	return splayNode.Value(), nil
+}
+
+// Iterate calls the given callback with the live nodes of this RGATreeList
+// from the given index in order. It stops if the callback returns true.
+func (a *RGATreeList) Iterate(from int, callback func(idx int, node *RGATreeListNode) bool) error {
+	if from == a.Len() {
+		return nil
+	}
+
+	node, err := a.Get(from)
+	if err != nil {
+		return err
+	}
+
+	for idx := from; node != nil; node = node.next {
+		if node.isRemoved() {
+			continue
 		}
-	} else if offset > 0 {
-		for {
-			node = node.next
-			if !node.isRemoved() {
-				break
-			}
+		if callback(idx, node) {
+			return nil
 		}
+		idx++
 	}
 
-	return node, nil
+	return nil
 }
 
 // DeleteByCreatedAt deletes the given element.
@@ -240,12 +306,12 @@ func (a *RGATreeList) Delete(idx int, deletedAt *time.Ticket) (*RGATreeListNode,
 	return a.DeleteByCreatedAt(target.CreatedAt(), deletedAt)
 }
 
-// MoveAfter moves the given `createdAt` element after the `prevCreatedAt`
-// element.
-func (a *RGATreeList) MoveAfter(prevCreatedAt, createdAt, executedAt *time.Ticket) error {
-	prevNode, ok := a.nodeMapByCreatedAt[prevCreatedAt.Key()]
-	if !ok {
-		return fmt.Errorf("MoveAfter %s: %w", prevCreatedAt.Key(), ErrChildNotFound)
+// MoveAfter moves the given `createdAt` element after the `prevPositionedAt`
+// position. The move inserts a new position for the element even if it loses
+// to a later move, so that every replica has the same positions.
+func (a *RGATreeList) MoveAfter(prevPositionedAt, createdAt, executedAt *time.Ticket) error {
+	if _, ok := a.nodeMapByPositionedAt[prevPositionedAt.Key()]; !ok {
+		return fmt.Errorf("MoveAfter %s: %w", prevPositionedAt.Key(), ErrChildNotFound)
 	}
 
 	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
@@ -253,22 +319,35 @@ func (a *RGATreeList) MoveAfter(prevCreatedAt, createdAt, executedAt *time.Ticke
 		return fmt.Errorf("MoveAfter %s: %w", createdAt.Key(), ErrChildNotFound)
 	}
 
-	if node.elem.MovedAt() == nil || executedAt.After(node.elem.MovedAt()) {
-		a.release(node)
-		if err := a.insertAfter(prevNode.CreatedAt(), node.elem, executedAt); err != nil {
-			return err
-		}
-		node.elem.SetMovedAt(executedAt)
+	elem := node.elem
+	if elem.MovedAt() != nil && !executedAt.After(elem.MovedAt()) {
+		return a.insertVacatedAfter(prevPositionedAt, executedAt, executedAt)
 	}
+
+	a.vacate(node, executedAt)
+	if err := a.insertAfter(prevPositionedAt, elem, executedAt); err != nil {
+		return err
+	}
+	elem.SetMovedAt(executedAt)
 	return nil
 }
 
-// FindPrevCreatedAt returns the creation time of the previous element of the
+// FindPositionedAt returns the position of the given element.
+func (a *RGATreeList) FindPositionedAt(createdAt *time.Ticket) (*time.Ticket, error) {
+	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok {
+		return nil, fmt.Errorf("FindPositionedAt %s: %w", createdAt.Key(), ErrChildNotFound)
+	}
+
+	return node.PositionedAt(), nil
+}
+
+// FindPrevPositionedAt returns the position of the previous element of the
 // given element.
-func (a *RGATreeList) FindPrevCreatedAt(createdAt *time.Ticket) (*time.Ticket, error) {
+func (a *RGATreeList) FindPrevPositionedAt(createdAt *time.Ticket) (*time.Ticket, error) {
 	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
 	if !ok {
-		return nil, fmt.Errorf("FindPrevCreatedAt %s: %w", createdAt.Key(), ErrChildNotFound)
+		return nil, fmt.Errorf("FindPrevPositionedAt %s: %w", createdAt.Key(), ErrChildNotFound)
 	}
 
 	for {
@@ -278,7 +357,7 @@ func (a *RGATreeList) FindPrevCreatedAt(createdAt *time.Ticket) (*time.Ticket, e
 		}
 	}
 
-	return node.CreatedAt(), nil
+	return node.PositionedAt(), nil
 }
 
 // purge physically purge child element.
@@ -293,13 +372,32 @@ func (a *RGATreeList) purge(elem Element) error {
 	return nil
 }
 
+// vacatedNodesLen returns the number of vacated nodes.
+func (a *RGATreeList) vacatedNodesLen() int {
+	return len(a.vacatedNodeMap)
+}
+
+// purgeVacatedNodesBefore physically purges the nodes that have been vacated
+// before the given time.
+func (a *RGATreeList) purgeVacatedNodesBefore(ticket *time.Ticket) int {
+	count := 0
+	for _, node := range a.vacatedNodeMap {
+		if ticket.Compare(node.vacatedAt) >= 0 {
+			a.release(node)
+			count++
+		}
+	}
+
+	return count
+}
+
 func (a *RGATreeList) findNextBeforeExecutedAt(
-	createdAt *time.Ticket,
+	positionedAt *time.Ticket,
 	executedAt *time.Ticket,
 ) (*RGATreeListNode, error) {
-	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	node, ok := a.nodeMapByPositionedAt[positionedAt.Key()]
 	if !ok {
-		return nil, fmt.Errorf("findNextBeforeExecutedAt %s: %w", createdAt.Key(), ErrChildNotFound)
+		return nil, fmt.Errorf("findNextBeforeExecutedAt %s: %w", positionedAt.Key(), ErrChildNotFound)
 	}
 
 	for node.next != nil && node.next.PositionedAt().After(executedAt) {
@@ -321,25 +419,70 @@ func (a *RGATreeList) release(node *RGATreeListNode) {
 	node.prev, node.next = nil, nil
 
 	a.nodeMapByIndex.Delete(node.indexNode)
-	delete(a.nodeMapByCreatedAt, node.CreatedAt().Key())
+	delete(a.nodeMapByPositionedAt, node.PositionedAt().Key())
+	delete(a.vacatedNodeMap, node.PositionedAt().Key())
+	if node.elem != nil {
+		delete(a.nodeMapByCreatedAt, node.CreatedAt().Key())
+	}
+}
+
+// vacate moves the element out of the given node, leaving the node as a
+// tombstone of the position.
+func (a *RGATreeList) vacate(node *RGATreeListNode, vacatedAt *time.Ticket) {
+	wasRemoved := node.isRemoved()
+	node.elem = nil
+	node.vacatedAt = vacatedAt
+	a.vacatedNodeMap[node.PositionedAt().Key()] = node
+	if !wasRemoved {
+		a.nodeMapByIndex.Splay(node.indexNode)
+	}
 }
 
 func (a *RGATreeList) insertAfter(
-	prevCreatedAt *time.Ticket,
+	prevPositionedAt *time.Ticket,
 	value Element,
-	executedAt *time.Ticket,
+	positionedAt *time.Ticket,
 ) error {
-	prevNode, err := a.findNextBeforeExecutedAt(prevCreatedAt, executedAt)
+	newNode, err := a.insertNodeAfter(prevPositionedAt, value, positionedAt)
 	if err != nil {
 		return err
 	}
 
-	newNode := newRGATreeListNodeAfter(prevNode, value)
+	a.nodeMapByCreatedAt[value.CreatedAt().Key()] = newNode
+	return nil
+}
+
+func (a *RGATreeList) insertVacatedAfter(
+	prevPositionedAt *time.Ticket,
+	positionedAt *time.Ticket,
+	vacatedAt *time.Ticket,
+) error {
+	newNode, err := a.insertNodeAfter(prevPositionedAt, nil, positionedAt)
+	if err != nil {
+		return err
+	}
+
+	newNode.vacatedAt = vacatedAt
+	a.vacatedNodeMap[positionedAt.Key()] = newNode
+	return nil
+}
+
+func (a *RGATreeList) insertNodeAfter(
+	prevPositionedAt *time.Ticket,
+	value Element,
+	positionedAt *time.Ticket,
+) (*RGATreeListNode, error) {
+	prevNode, err := a.findNextBeforeExecutedAt(prevPositionedAt, positionedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	newNode := newRGATreeListNodeAfter(prevNode, value, positionedAt)
 	if prevNode == a.last {
 		a.last = newNode
 	}
 
 	a.nodeMapByIndex.InsertAfter(prevNode.indexNode, newNode.indexNode)
-	a.nodeMapByCreatedAt[value.CreatedAt().Key()] = newNode
-	return nil
+	a.nodeMapByPositionedAt[positionedAt.Key()] = newNode
+	return newNode, nil
 }
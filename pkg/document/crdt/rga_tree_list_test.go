@@ -1,11 +1,14 @@
 package crdt_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/splay"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -30,7 +33,7 @@ func TestRGATreeList(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, `"2"`, targetElement.Element().Marshal())
 
-		prevCreatedAt, err := elements.FindPrevCreatedAt(targetElement.CreatedAt())
+		prevCreatedAt, err := elements.FindPrevPositionedAt(targetElement.CreatedAt())
 		assert.NoError(t, err)
 		assert.Equal(t, prevCreatedAt.Compare(targetElement.CreatedAt()), -1)
 
@@ -48,6 +51,43 @@ func TestRGATreeList(t *testing.T) {
 
 	})
 
+	t.Run("get and iterate with tombstones test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		elements := crdt.NewRGATreeList()
+		for i := 0; i < 10; i++ {
+			assert.NoError(t, elements.Add(crdt.NewPrimitive(i, ctx.IssueTimeTicket())))
+		}
+		for _, idx := range []int{8, 5, 4, 0} {
+			_, err := elements.Delete(idx, ctx.IssueTimeTicket())
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, `[1,2,3,6,7,9]`, elements.Marshal())
+
+		for idx, expected := range []string{"1", "2", "3", "6", "7", "9"} {
+			node, err := elements.Get(idx)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, node.Element().Marshal())
+		}
+		_, err := elements.Get(6)
+		assert.ErrorIs(t, err, splay.ErrOutOfIndex)
+		_, err = elements.Get(-1)
+		assert.ErrorIs(t, err, splay.ErrOutOfIndex)
+
+		var values []string
+		assert.NoError(t, elements.Iterate(2, func(idx int, node *crdt.RGATreeListNode) bool {
+			values = append(values, fmt.Sprintf("%d:%s", idx, node.Element().Marshal()))
+			return idx == 4
+		}))
+		assert.Equal(t, []string{"2:3", "3:6", "4:7"}, values)
+
+		assert.NoError(t, elements.Iterate(6, func(idx int, node *crdt.RGATreeListNode) bool {
+			assert.Fail(t, "callback should not be called")
+			return false
+		}))
+	})
+
 	t.Run("invalid createdAt test", func(t *testing.T) {
 		root := helper.TestRoot()
 		ctx := helper.TextChangeContext(root)
@@ -66,7 +106,66 @@ func TestRGATreeList(t *testing.T) {
 		err = elements.MoveAfter(invalidCreatedAt, validCreatedAt, ctx.IssueTimeTicket())
 		assert.ErrorIs(t, err, crdt.ErrChildNotFound)
 
-		_, err = elements.FindPrevCreatedAt(invalidCreatedAt)
+		_, err = elements.FindPrevPositionedAt(invalidCreatedAt)
 		assert.ErrorIs(t, err, crdt.ErrChildNotFound)
 	})
+	t.Run("move after itself test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		elements := crdt.NewRGATreeList()
+		for _, v := range []string{"1", "2", "3"} {
+			assert.NoError(t, elements.Add(crdt.NewPrimitive(v, ctx.IssueTimeTicket())))
+		}
+
+		target, err := elements.Get(1)
+		assert.NoError(t, err)
+		err = elements.MoveAfter(target.CreatedAt(), target.CreatedAt(), ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, `["1","2","3"]`, elements.Marshal())
+		assert.Equal(t, 3, elements.Len())
+	})
+	t.Run("concurrent move convergence test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		ta, tb, tc := ctx.IssueTimeTicket(), ctx.IssueTimeTicket(), ctx.IssueTimeTicket()
+		newList := func() *crdt.RGATreeList {
+			elements := crdt.NewRGATreeList()
+			assert.NoError(t, elements.Add(crdt.NewPrimitive("a", ta)))
+			assert.NoError(t, elements.Add(crdt.NewPrimitive("b", tb)))
+			assert.NoError(t, elements.Add(crdt.NewPrimitive("c", tc)))
+			return elements
+		}
+
+		// 01. A move after an element that is moved concurrently.
+		t1, t2 := ctx.IssueTimeTicket(), ctx.IssueTimeTicket()
+		moveALast := func(l *crdt.RGATreeList) error { return l.MoveAfter(tc, ta, t1) }
+		moveBAfterA := func(l *crdt.RGATreeList) error { return l.MoveAfter(ta, tb, t2) }
+
+		l1, l2 := newList(), newList()
+		assert.NoError(t, moveALast(l1))
+		assert.NoError(t, moveBAfterA(l1))
+		assert.NoError(t, moveBAfterA(l2))
+		assert.NoError(t, moveALast(l2))
+		assert.Equal(t, l1.Marshal(), l2.Marshal())
+		assert.Equal(t, `["b","c","a"]`, l1.Marshal())
+
+		// 02. A move after the position of a move that loses to a later one.
+		t3, t4, t5 := ctx.IssueTimeTicket(), ctx.IssueTimeTicket(), ctx.IssueTimeTicket()
+		moveCFront := func(l *crdt.RGATreeList) error { return l.MoveAfter(time.InitialTicket, tc, t3) }
+		moveCAfterB := func(l *crdt.RGATreeList) error { return l.MoveAfter(tb, tc, t5) }
+		moveAAfterC := func(l *crdt.RGATreeList) error { return l.MoveAfter(t3, ta, t4) }
+
+		l1, l2 = newList(), newList()
+		for _, op := range []func(*crdt.RGATreeList) error{moveCFront, moveAAfterC, moveCAfterB} {
+			assert.NoError(t, op(l1))
+		}
+		for _, op := range []func(*crdt.RGATreeList) error{moveCAfterB, moveCFront, moveAAfterC} {
+			assert.NoError(t, op(l2))
+		}
+		assert.Equal(t, l1.Marshal(), l2.Marshal())
+		assert.Equal(t, `["a","b","c"]`, l1.Marshal())
+		assert.Equal(t, 3, l1.Len())
+	})
 }
@@ -21,6 +21,7 @@ type RGATreeSplitValue interface {
 	DeepCopy() RGATreeSplitValue
 	String() string
 	Marshal() string
+	AppendJSON(dst []byte) []byte
 	structureAsString() string
 }
 
@@ -204,6 +205,12 @@ func (s *RGATreeSplitNode[V]) Marshal() string {
 	return s.value.Marshal()
 }
 
+// AppendJSON appends the JSON encoding of this node to dst and returns the
+// extended buffer.
+func (s *RGATreeSplitNode[V]) AppendJSON(dst []byte) []byte {
+	return s.value.AppendJSON(dst)
+}
+
 // String returns the string representation of this node.
 func (s *RGATreeSplitNode[V]) String() string {
 	return s.value.String()
@@ -324,6 +331,36 @@ func (s *RGATreeSplit[V]) findNodePos(index int) (*RGATreeSplitNodePos, error) {
 	}, nil
 }
 
+// indexOfPos returns the index of the given position. If the node of the
+// position has been removed, the index where the node was is returned. It
+// returns false if the node of the position does not exist.
+func (s *RGATreeSplit[V]) indexOfPos(pos *RGATreeSplitNodePos) (int, bool) {
+	absoluteID := pos.getAbsoluteID()
+	node := s.findFloorNode(absoluteID)
+	if node == nil {
+		return 0, false
+	}
+	if absoluteID.offset > 0 && node.id.offset == absoluteID.offset && node.insPrev != nil {
+		node = node.insPrev
+	}
+
+	index := s.treeByIndex.IndexOf(node.indexNode)
+	if index < 0 {
+		return 0, false
+	}
+	if node.removedAt == nil {
+		// NOTE: The rest of the node may have been purged by GC. In that case,
+		// the end of the node is used.
+		offset := absoluteID.offset - node.id.offset
+		if offset > node.contentLen() {
+			offset = node.contentLen()
+		}
+		index += offset
+	}
+
+	return index, true
+}
+
 func (s *RGATreeSplit[V]) findNodeWithSplit(
 	pos *RGATreeSplitNodePos,
 	updatedAt *time.Ticket,
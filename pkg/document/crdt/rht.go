@@ -136,13 +136,16 @@ func (rht *RHT) Elements() map[string]string {
 	return members
 }
 
-// Nodes returns a map of elements because the map easy to use for loop.
-// TODO: If we encounter performance issues, we need to replace this with other solution.
+// Nodes returns the nodes of this hashtable sorted by key, so that the
+// encoding of the hashtable is deterministic.
 func (rht *RHT) Nodes() []*RHTNode {
-	var nodes []*RHTNode
+	nodes := make([]*RHTNode, 0, len(rht.nodeMapByKey))
 	for _, node := range rht.nodeMapByKey {
 		nodes = append(nodes, node)
 	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].key < nodes[j].key
+	})
 
 	return nodes
 }
@@ -164,6 +167,12 @@ func (rht *RHT) DeepCopy() *RHT {
 
 // Marshal returns the JSON encoding of this hashtable.
 func (rht *RHT) Marshal() string {
+	return string(rht.AppendJSON(nil))
+}
+
+// AppendJSON appends the JSON encoding of this hashtable to dst and returns
+// the extended buffer.
+func (rht *RHT) AppendJSON(dst []byte) []byte {
 	members := rht.Elements()
 
 	size := len(members)
@@ -175,18 +184,17 @@ func (rht *RHT) Marshal() string {
 	}
 	sort.Strings(keys)
 
-	sb := strings.Builder{}
-	sb.WriteString("{")
+	dst = append(dst, '{')
 	for idx, k := range keys {
 		if idx > 0 {
-			sb.WriteString(",")
+			dst = append(dst, ',')
 		}
-		value := members[k]
-		sb.WriteString(fmt.Sprintf(`"%s":"%s"`, EscapeString(k), EscapeString(value)))
+		dst = appendQuotedString(dst, k)
+		dst = append(dst, ':')
+		dst = appendQuotedString(dst, members[k])
 	}
-	sb.WriteString("}")
 
-	return sb.String()
+	return append(dst, '}')
 }
 
 // ToXML returns the XML representation of this hashtable.
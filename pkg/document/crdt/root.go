@@ -20,6 +20,8 @@
 package crdt
 
 import (
+	"strconv"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -52,9 +54,13 @@ func NewRoot(root *Object) *Root {
 
 	r.object = root
 	r.RegisterElement(root)
+	r.registerMerged(root)
 
 	root.Descendants(func(elem Element, parent Container) bool {
 		r.RegisterElement(elem)
+		if obj, ok := elem.(*Object); ok {
+			r.registerMerged(obj)
+		}
 		if elem.RemovedAt() != nil {
 			r.RegisterRemovedElementPair(parent, elem)
 		}
@@ -80,11 +86,30 @@ func (r *Root) RegisterElement(elem Element) {
 	r.elementMapByCreatedAt[elem.CreatedAt().Key()] = elem
 }
 
+// registerMerged registers the given object with the creation times of the
+// objects merged into it. The merged objects are not kept in snapshots, so
+// the changes to them are applied to the given object instead.
+func (r *Root) registerMerged(obj *Object) {
+	for _, createdAt := range obj.Merged() {
+		if _, ok := r.elementMapByCreatedAt[createdAt.Key()]; !ok {
+			r.elementMapByCreatedAt[createdAt.Key()] = obj
+		}
+	}
+}
+
 // DeregisterElement deregister the given element from hash tables.
 func (r *Root) DeregisterElement(elem Element) {
 	createdAt := elem.CreatedAt().Key()
 	delete(r.elementMapByCreatedAt, createdAt)
 	delete(r.removedElementPairMapByCreatedAt, createdAt)
+
+	if obj, ok := elem.(*Object); ok {
+		for _, merged := range obj.Merged() {
+			if r.elementMapByCreatedAt[merged.Key()] == elem {
+				delete(r.elementMapByCreatedAt, merged.Key())
+			}
+		}
+	}
 }
 
 // RegisterRemovedElementPair register the given element pair to hash table.
@@ -138,6 +163,22 @@ func (r *Root) GarbageCollect(ticket *time.Ticket) (int, error) {
 	return count, nil
 }
 
+// FindPaths returns the paths of the live elements of the given creation
+// times in the form of "$.key.0", keyed by the creation time. Elements that
+// are not reachable from the root, such as removed ones, are omitted.
+func (r *Root) FindPaths(createdAts []*time.Ticket) map[string]string {
+	targets := make(map[string]bool, len(createdAts))
+	for _, createdAt := range createdAts {
+		targets[createdAt.Key()] = true
+	}
+
+	paths := make(map[string]string, len(targets))
+	if len(targets) > 0 {
+		findPaths(r.object, "$", targets, paths)
+	}
+	return paths
+}
+
 // ElementMapLen returns the size of element map.
 func (r *Root) ElementMapLen() int {
 	return len(r.elementMapByCreatedAt)
@@ -190,3 +231,33 @@ func (r *Root) garbageCollect(elem Element) int {
 
 	return count
 }
+
+// findPaths collects the paths of the targets under the given element. It
+// returns true when all targets are found so that the traversal can stop.
+func findPaths(elem Element, path string, targets map[string]bool, paths map[string]string) bool {
+	key := elem.CreatedAt().Key()
+	if targets[key] {
+		paths[key] = path
+		if len(paths) == len(targets) {
+			return true
+		}
+	}
+
+	switch elem := elem.(type) {
+	case *Object:
+		for k, member := range elem.Members() {
+			if findPaths(member, path+"."+k, targets, paths) {
+				return true
+			}
+		}
+	case *Array:
+		done := false
+		_ = elem.Iterate(0, func(idx int, child Element) bool {
+			done = findPaths(child, path+"."+strconv.Itoa(idx), targets, paths)
+			return done
+		})
+		return done
+	}
+
+	return false
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// SetMember is a value of Set with the tags of its additions that are not
+// removed yet.
+type SetMember struct {
+	value *Primitive
+	tags  map[string]*time.Ticket
+}
+
+// Value returns the value of this member.
+func (m *SetMember) Value() *Primitive {
+	return m.value
+}
+
+// Tags returns the tags of this member sorted by time.
+func (m *SetMember) Tags() []*time.Ticket {
+	tags := make([]*time.Ticket, 0, len(m.tags))
+	for _, tag := range m.tags {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[j].After(tags[i])
+	})
+
+	return tags
+}
+
+// Set represents a set of primitive values. Each addition of a value is
+// tagged with its time ticket, and a removal only removes the tags it has
+// observed. So when a value is added and removed concurrently, the addition
+// wins(add-wins set).
+type Set struct {
+	members   map[string]*SetMember
+	createdAt *time.Ticket
+	movedAt   *time.Ticket
+	removedAt *time.Ticket
+}
+
+// NewSet creates a new instance of Set.
+func NewSet(createdAt *time.Ticket) *Set {
+	return &Set{
+		members:   make(map[string]*SetMember),
+		createdAt: createdAt,
+	}
+}
+
+// Add adds the given value with the given tag.
+func (s *Set) Add(value *Primitive, tag *time.Ticket) {
+	key := setMemberKey(value)
+	member, ok := s.members[key]
+	if !ok {
+		member = &SetMember{
+			value: value,
+			tags:  make(map[string]*time.Ticket),
+		}
+		s.members[key] = member
+	}
+
+	member.tags[tag.Key()] = tag
+}
+
+// Delete removes the tags of the given value observed by the deletion. The
+// tags created after the latest creation time of their actor in the given map
+// are kept since they are concurrent additions. If the map is nil, all tags
+// are removed as a local deletion observes all of them. It returns the latest
+// creation time of the removed tags by actor.
+func (s *Set) Delete(
+	value *Primitive,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+) map[string]*time.Ticket {
+	createdAtMapByActor := make(map[string]*time.Ticket)
+
+	key := setMemberKey(value)
+	member, ok := s.members[key]
+	if !ok {
+		return createdAtMapByActor
+	}
+
+	for tagKey, tag := range member.tags {
+		actorIDHex := tag.ActorIDHex()
+		if latestCreatedAtMapByActor != nil {
+			latestCreatedAt, ok := latestCreatedAtMapByActor[actorIDHex]
+			if !ok || tag.After(latestCreatedAt) {
+				continue
+			}
+		}
+
+		delete(member.tags, tagKey)
+		if latest := createdAtMapByActor[actorIDHex]; latest == nil || tag.After(latest) {
+			createdAtMapByActor[actorIDHex] = tag
+		}
+	}
+
+	if len(member.tags) == 0 {
+		delete(s.members, key)
+	}
+
+	return createdAtMapByActor
+}
+
+// Has returns whether the given value is in this set.
+func (s *Set) Has(value *Primitive) bool {
+	_, ok := s.members[setMemberKey(value)]
+	return ok
+}
+
+// Len returns the number of values in this set.
+func (s *Set) Len() int {
+	return len(s.members)
+}
+
+// Members returns the members of this set sorted by their values, so that
+// the encoding of the set is deterministic.
+func (s *Set) Members() []*SetMember {
+	keys := make([]string, 0, len(s.members))
+	for key := range s.members {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	members := make([]*SetMember, 0, len(keys))
+	for _, key := range keys {
+		members = append(members, s.members[key])
+	}
+
+	return members
+}
+
+// Values returns the values of this set.
+func (s *Set) Values() []*Primitive {
+	var values []*Primitive
+	for _, member := range s.Members() {
+		values = append(values, member.value)
+	}
+
+	return values
+}
+
+// Marshal returns the JSON encoding of this set.
+func (s *Set) Marshal() string {
+	return string(s.AppendJSON(nil))
+}
+
+// AppendJSON appends the JSON encoding of this set to dst and returns the
+// extended buffer. The set is encoded as an array of its values.
+func (s *Set) AppendJSON(dst []byte) []byte {
+	dst = append(dst, '[')
+	for idx, member := range s.Members() {
+		if idx > 0 {
+			dst = append(dst, ',')
+		}
+		dst = member.value.AppendJSON(dst)
+	}
+
+	return append(dst, ']')
+}
+
+// DeepCopy copies itself deeply.
+func (s *Set) DeepCopy() (Element, error) {
+	set := NewSet(s.createdAt)
+	for key, member := range s.members {
+		tags := make(map[string]*time.Ticket, len(member.tags))
+		for tagKey, tag := range member.tags {
+			tags[tagKey] = tag
+		}
+		set.members[key] = &SetMember{
+			value: member.value,
+			tags:  tags,
+		}
+	}
+	set.movedAt = s.movedAt
+	set.removedAt = s.removedAt
+
+	return set, nil
+}
+
+// CreatedAt returns the creation time of this set.
+func (s *Set) CreatedAt() *time.Ticket {
+	return s.createdAt
+}
+
+// MovedAt returns the move time of this set.
+func (s *Set) MovedAt() *time.Ticket {
+	return s.movedAt
+}
+
+// SetMovedAt sets the move time of this set.
+func (s *Set) SetMovedAt(movedAt *time.Ticket) {
+	s.movedAt = movedAt
+}
+
+// RemovedAt returns the removal time of this set.
+func (s *Set) RemovedAt() *time.Ticket {
+	return s.removedAt
+}
+
+// SetRemovedAt sets the removal time of this set.
+func (s *Set) SetRemovedAt(removedAt *time.Ticket) {
+	s.removedAt = removedAt
+}
+
+// Remove removes this set.
+func (s *Set) Remove(removedAt *time.Ticket) bool {
+	if (removedAt != nil && removedAt.After(s.createdAt)) &&
+		(s.removedAt == nil || removedAt.After(s.removedAt)) {
+		s.removedAt = removedAt
+		return true
+	}
+	return false
+}
+
+// setMemberKey returns the key of the member of the given value. Values of
+// different types are different members even if their bytes are the same.
+func setMemberKey(value *Primitive) string {
+	return strconv.Itoa(int(value.ValueType())) + ":" + string(value.Bytes())
+}
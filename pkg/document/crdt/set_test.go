@@ -0,0 +1,79 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestSet(t *testing.T) {
+	actorA, err := time.ActorIDFromHex("000000000000000000000001")
+	assert.NoError(t, err)
+	actorB, err := time.ActorIDFromHex("000000000000000000000002")
+	assert.NoError(t, err)
+
+	t.Run("add and delete test", func(t *testing.T) {
+		set := crdt.NewSet(time.InitialTicket)
+		set.Add(crdt.NewPrimitive("b", nil), time.NewTicket(1, 0, actorA))
+		set.Add(crdt.NewPrimitive("a", nil), time.NewTicket(2, 0, actorA))
+		set.Add(crdt.NewPrimitive("a", nil), time.NewTicket(3, 0, actorA))
+		set.Add(crdt.NewPrimitive(int64(1), nil), time.NewTicket(4, 0, actorA))
+		assert.Equal(t, 3, set.Len())
+		assert.True(t, set.Has(crdt.NewPrimitive("a", nil)))
+		assert.False(t, set.Has(crdt.NewPrimitive(1, nil)))
+
+		createdAtMapByActor := set.Delete(crdt.NewPrimitive("a", nil), nil)
+		assert.Len(t, createdAtMapByActor, 1)
+		assert.Equal(t, "3:0:"+actorA.String(), createdAtMapByActor[actorA.String()].Key())
+		assert.False(t, set.Has(crdt.NewPrimitive("a", nil)))
+		assert.Equal(t, 2, set.Len())
+	})
+
+	t.Run("concurrent add and delete test", func(t *testing.T) {
+		value := crdt.NewPrimitive("v", nil)
+		set1 := crdt.NewSet(time.InitialTicket)
+		set1.Add(value, time.NewTicket(1, 0, actorA))
+		set2, err := set1.DeepCopy()
+		assert.NoError(t, err)
+
+		// NOTE: A deletes the value while B adds the value concurrently.
+		latest := set1.Delete(value, nil)
+		set2.(*crdt.Set).Add(value, time.NewTicket(2, 0, actorB))
+
+		set1.Add(value, time.NewTicket(2, 0, actorB))
+		set2.(*crdt.Set).Delete(value, latest)
+
+		assert.True(t, set1.Has(value))
+		assert.Equal(t, set1.Marshal(), set2.Marshal())
+		assert.Equal(t, `["v"]`, set1.Marshal())
+	})
+
+	t.Run("marshal test", func(t *testing.T) {
+		set := crdt.NewSet(time.InitialTicket)
+		assert.Equal(t, `[]`, set.Marshal())
+
+		set.Add(crdt.NewPrimitive("b", nil), time.NewTicket(1, 0, actorA))
+		set.Add(crdt.NewPrimitive("a", nil), time.NewTicket(2, 0, actorA))
+		set.Add(crdt.NewPrimitive(true, nil), time.NewTicket(3, 0, actorA))
+		assert.Equal(t, `[true,"a","b"]`, set.Marshal())
+	})
+}
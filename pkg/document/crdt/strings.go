@@ -16,53 +16,68 @@
 
 package crdt
 
-import "bytes"
+import "unicode/utf8"
 
 const hex = "0123456789abcdef"
 
 // EscapeString returns a string that is safe to embed in a JSON document.
 func EscapeString(s string) string {
-	var buf bytes.Buffer
+	return string(appendEscapedString(nil, s))
+}
+
+// appendQuotedString appends the given string quoted and escaped to be a JSON
+// string to dst and returns the extended buffer.
+func appendQuotedString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	dst = appendEscapedString(dst, s)
+	return append(dst, '"')
+}
 
-	l := len(s)
-	for i := 0; i < l; i++ {
+// appendEscapedString appends the given string escaped to be safe to embed in
+// a JSON document to dst and returns the extended buffer. Invalid UTF-8
+// sequences are replaced with U+FFFD.
+func appendEscapedString(dst []byte, s string) []byte {
+	start := 0
+	for i := 0; i < len(s); {
 		c := s[i]
-		if c >= 0x20 && c != '\\' && c != '"' {
-			buf.WriteByte(c)
+		if c < utf8.RuneSelf {
+			if c >= 0x20 && c != '\\' && c != '"' {
+				i++
+				continue
+			}
+
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '\\', '"':
+				dst = append(dst, '\\', c)
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
 			continue
 		}
-		switch c {
-		case '\\':
-			buf.WriteByte('\\')
-			buf.WriteByte('\\')
-		case '"':
-			buf.WriteByte('\\')
-			buf.WriteByte('"')
-		case '\n':
-			buf.WriteByte('\\')
-			buf.WriteByte('n')
-		case '\f':
-			buf.WriteByte('\\')
-			buf.WriteByte('f')
-		case '\b':
-			buf.WriteByte('\\')
-			buf.WriteByte('b')
-		case '\r':
-			buf.WriteByte('\\')
-			buf.WriteByte('r')
-		case '\t':
-			buf.WriteByte('\\')
-			buf.WriteByte('t')
-		default:
-			buf.WriteByte('\\')
-			buf.WriteByte('u')
-			buf.WriteByte('0')
-			buf.WriteByte('0')
-			buf.WriteByte(hex[c>>4])
-			buf.WriteByte(hex[c&0xF])
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, `\ufffd`...)
+			i += size
+			start = i
+			continue
 		}
-		continue
+		i += size
 	}
 
-	return buf.String()
+	return append(dst, s[start:]...)
 }
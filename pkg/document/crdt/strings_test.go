@@ -43,4 +43,11 @@ func TestEscapeString(t *testing.T) {
 		actual := EscapeString(str)
 		assert.Equal(t, expected, actual)
 	})
+
+	t.Run("escape string with invalid utf-8 sequences", func(t *testing.T) {
+		str := "hello\xffworld\x01"
+		expected := `hello\ufffdworld\u0001`
+		actual := EscapeString(str)
+		assert.Equal(t, expected, actual)
+	})
 }
@@ -18,6 +18,7 @@ package crdt
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"unicode/utf16"
 
@@ -63,15 +64,21 @@ func (t *TextValue) String() string {
 
 // Marshal returns the JSON encoding of this text.
 func (t *TextValue) Marshal() string {
-	if len(t.attrs.Elements()) == 0 {
-		return fmt.Sprintf(`{"val":"%s"}`, EscapeString(t.value))
-	}
+	return string(t.AppendJSON(nil))
+}
 
-	return fmt.Sprintf(
-		`{"attrs":%s,"val":"%s"}`,
-		t.attrs.Marshal(),
-		EscapeString(t.value),
-	)
+// AppendJSON appends the JSON encoding of this text to dst and returns the
+// extended buffer.
+func (t *TextValue) AppendJSON(dst []byte) []byte {
+	dst = append(dst, '{')
+	if len(t.attrs.Elements()) > 0 {
+		dst = append(dst, `"attrs":`...)
+		dst = t.attrs.AppendJSON(dst)
+		dst = append(dst, ',')
+	}
+	dst = append(dst, `"val":`...)
+	dst = appendQuotedString(dst, t.value)
+	return append(dst, '}')
 }
 
 // structureAsString returns a String containing the metadata of this value
@@ -113,9 +120,79 @@ func InitialTextNode() *RGATreeSplitNode[*TextValue] {
 	})
 }
 
+// TextAnnotation is a named annotation of Text such as a comment. It is
+// anchored to the positions of the nodes of Text, not to the indexes, so that
+// the range of the annotation follows the text as it is edited.
+type TextAnnotation struct {
+	name      string
+	from      *RGATreeSplitNodePos
+	to        *RGATreeSplitNodePos
+	value     string
+	updatedAt *time.Ticket
+	isRemoved bool
+}
+
+// NewTextAnnotation creates a new instance of TextAnnotation.
+func NewTextAnnotation(
+	name string,
+	from *RGATreeSplitNodePos,
+	to *RGATreeSplitNodePos,
+	value string,
+	updatedAt *time.Ticket,
+	isRemoved bool,
+) *TextAnnotation {
+	return &TextAnnotation{
+		name:      name,
+		from:      from,
+		to:        to,
+		value:     value,
+		updatedAt: updatedAt,
+		isRemoved: isRemoved,
+	}
+}
+
+// Name returns the name of this annotation.
+func (a *TextAnnotation) Name() string {
+	return a.name
+}
+
+// From returns the start position of this annotation.
+func (a *TextAnnotation) From() *RGATreeSplitNodePos {
+	return a.from
+}
+
+// To returns the end position of this annotation.
+func (a *TextAnnotation) To() *RGATreeSplitNodePos {
+	return a.to
+}
+
+// Value returns the value of this annotation.
+func (a *TextAnnotation) Value() string {
+	return a.value
+}
+
+// UpdatedAt returns the last update time of this annotation.
+func (a *TextAnnotation) UpdatedAt() *time.Ticket {
+	return a.updatedAt
+}
+
+// IsRemoved returns whether this annotation is removed or not.
+func (a *TextAnnotation) IsRemoved() bool {
+	return a.isRemoved
+}
+
+// TextAnnotationRange is the range of an annotation of Text in indexes.
+type TextAnnotationRange struct {
+	Name  string
+	From  int
+	To    int
+	Value string
+}
+
 // Text is an extended data type for the contents of a text editor.
 type Text struct {
 	rgaTreeSplit *RGATreeSplit[*TextValue]
+	annotations  map[string]*TextAnnotation
 	createdAt    *time.Ticket
 	movedAt      *time.Ticket
 	removedAt    *time.Ticket
@@ -125,6 +202,7 @@ type Text struct {
 func NewText(elements *RGATreeSplit[*TextValue], createdAt *time.Ticket) *Text {
 	return &Text{
 		rgaTreeSplit: elements,
+		annotations:  make(map[string]*TextAnnotation),
 		createdAt:    createdAt,
 	}
 }
@@ -148,19 +226,31 @@ func (t *Text) String() string {
 
 // Marshal returns the JSON encoding of this Text.
 func (t *Text) Marshal() string {
-	var values []string
+	return string(t.AppendJSON(nil))
+}
+
+// AppendJSON appends the JSON encoding of this Text to dst and returns the
+// extended buffer.
+func (t *Text) AppendJSON(dst []byte) []byte {
+	dst = append(dst, '[')
 
+	isFirst := true
 	node := t.rgaTreeSplit.initialHead.next
 	for node != nil {
 		if node.createdAt().Compare(t.createdAt) == 0 {
 			// last line
 		} else if node.removedAt == nil {
-			values = append(values, node.Marshal())
+			if isFirst {
+				isFirst = false
+			} else {
+				dst = append(dst, ',')
+			}
+			dst = node.AppendJSON(dst)
 		}
 		node = node.next
 	}
 
-	return fmt.Sprintf("[%s]", strings.Join(values, ","))
+	return append(dst, ']')
 }
 
 // DeepCopy copies itself deeply.
@@ -180,7 +270,13 @@ func (t *Text) DeepCopy() (Element, error) {
 		}
 	}
 
-	return NewText(rgaTreeSplit, t.createdAt), nil
+	text := NewText(rgaTreeSplit, t.createdAt)
+	for name, annotation := range t.annotations {
+		copied := *annotation
+		text.annotations[name] = &copied
+	}
+
+	return text, nil
 }
 
 // CreatedAt returns the creation time of this Text.
@@ -246,32 +342,143 @@ func (t *Text) Edit(
 	)
 }
 
-// Style applies the given attributes of the given range.
+// Style applies the given attributes of the given range. Nodes created after
+// the given latestCreatedAtMapByActor, which the editor has not seen, are not
+// styled. It returns the latest creation time of the styled nodes by actor.
 func (t *Text) Style(
 	from,
 	to *RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
 	attributes map[string]string,
 	executedAt *time.Ticket,
-) error {
+) (map[string]*time.Ticket, error) {
 	// 01. Split nodes with from and to
 	_, toRight, err := t.rgaTreeSplit.findNodeWithSplit(to, executedAt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	_, fromRight, err := t.rgaTreeSplit.findNodeWithSplit(from, executedAt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// 02. style nodes between from and to
+	createdAtMapByActor := make(map[string]*time.Ticket)
 	nodes := t.rgaTreeSplit.findBetween(fromRight, toRight)
 	for _, node := range nodes {
+		actorIDHex := node.createdAt().ActorIDHex()
+
+		var latestCreatedAt *time.Ticket
+		if latestCreatedAtMapByActor == nil {
+			latestCreatedAt = time.MaxTicket
+		} else {
+			createdAt, ok := latestCreatedAtMapByActor[actorIDHex]
+			if ok {
+				latestCreatedAt = createdAt
+			} else {
+				latestCreatedAt = time.InitialTicket
+			}
+		}
+
+		if node.createdAt().After(latestCreatedAt) {
+			continue
+		}
+
+		if prev, ok := createdAtMapByActor[actorIDHex]; !ok || node.createdAt().After(prev) {
+			createdAtMapByActor[actorIDHex] = node.createdAt()
+		}
+
 		val := node.value
 		for key, value := range attributes {
 			val.attrs.Set(key, value, executedAt)
 		}
 	}
-	return nil
+	return createdAtMapByActor, nil
+}
+
+// AddAnnotation adds the annotation of the given name to the given range. If
+// the annotation already exists, it is replaced when the given time is later
+// than the last update of the annotation.
+func (t *Text) AddAnnotation(
+	name string,
+	from,
+	to *RGATreeSplitNodePos,
+	value string,
+	executedAt *time.Ticket,
+) {
+	t.setAnnotation(NewTextAnnotation(name, from, to, value, executedAt, false))
+}
+
+// RemoveAnnotation removes the annotation of the given name.
+func (t *Text) RemoveAnnotation(name string, executedAt *time.Ticket) {
+	prev, ok := t.annotations[name]
+	if !ok {
+		return
+	}
+
+	t.setAnnotation(NewTextAnnotation(name, prev.from, prev.to, prev.value, executedAt, true))
+}
+
+// SetAnnotation sets the given annotation as it is. It is used to restore the
+// annotations of Text from a snapshot.
+func (t *Text) SetAnnotation(annotation *TextAnnotation) {
+	t.setAnnotation(annotation)
+}
+
+func (t *Text) setAnnotation(annotation *TextAnnotation) {
+	prev, ok := t.annotations[annotation.name]
+	if ok && !annotation.updatedAt.After(prev.updatedAt) {
+		return
+	}
+
+	t.annotations[annotation.name] = annotation
+}
+
+// AllAnnotations returns the annotations of this Text including the removed
+// ones as tombstones.
+func (t *Text) AllAnnotations() []*TextAnnotation {
+	var annotations []*TextAnnotation
+	for _, annotation := range t.annotations {
+		annotations = append(annotations, annotation)
+	}
+	sort.Slice(annotations, func(i, j int) bool {
+		return annotations[i].name < annotations[j].name
+	})
+
+	return annotations
+}
+
+// Annotations returns the ranges of the annotations of this Text sorted by
+// their names. Annotations whose anchors are no longer in the text, e.g. have
+// been purged by garbage collection, are skipped.
+func (t *Text) Annotations() []TextAnnotationRange {
+	var ranges []TextAnnotationRange
+	for _, annotation := range t.AllAnnotations() {
+		if annotation.isRemoved {
+			continue
+		}
+
+		from, ok := t.rgaTreeSplit.indexOfPos(annotation.from)
+		if !ok {
+			continue
+		}
+		to, ok := t.rgaTreeSplit.indexOfPos(annotation.to)
+		if !ok {
+			continue
+		}
+		if to < from {
+			to = from
+		}
+
+		ranges = append(ranges, TextAnnotationRange{
+			Name:  annotation.name,
+			From:  from,
+			To:    to,
+			Value: annotation.value,
+		})
+	}
+
+	return ranges
 }
 
 // Nodes returns the internal nodes of this Text.
@@ -80,7 +80,7 @@ func TestText(t *testing.T) {
 		assert.Equal(t, `[{"val":"Hello "},{"val":"Yorkie"}]`, text.Marshal())
 
 		fromPos, toPos, _ = text.CreateRange(0, 1)
-		err = text.Style(fromPos, toPos, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
+		_, err = text.Style(fromPos, toPos, nil, map[string]string{"b": "1"}, ctx.IssueTimeTicket())
 		assert.NoError(t, err)
 		assert.Equal(
 			t,
@@ -88,4 +88,82 @@ func TestText(t *testing.T) {
 			text.Marshal(),
 		)
 	})
+
+	t.Run("style concurrently inserted text test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos, _ := text.CreateRange(0, 0)
+		_, _, err := text.Edit(fromPos, toPos, nil, "ab", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		// 01. Style "ab" without seeing the concurrently inserted "c".
+		fromPos, toPos, _ = text.CreateRange(0, 2)
+		styles := map[string]string{"b": "1"}
+		latestCreatedAtMapByActor, err := text.Style(fromPos, toPos, nil, styles, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, `[{"attrs":{"b":"1"},"val":"ab"}]`, text.Marshal())
+
+		fromPos, toPos, _ = text.CreateRange(1, 1)
+		_, _, err = text.Edit(fromPos, toPos, nil, "c", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		// 02. Apply the style again as a remote replica would. "c" is not styled.
+		fromPos, toPos, _ = text.CreateRange(0, 3)
+		styles = map[string]string{"b": "2"}
+		_, err = text.Style(fromPos, toPos, latestCreatedAtMapByActor, styles, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`[{"attrs":{"b":"2"},"val":"a"},{"val":"c"},{"attrs":{"b":"2"},"val":"b"}]`,
+			text.Marshal(),
+		)
+	})
+
+	t.Run("annotation test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos, _ := text.CreateRange(0, 0)
+		_, _, err := text.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		// 01. Annotate "World".
+		fromPos, toPos, _ = text.CreateRange(6, 11)
+		text.AddAnnotation("c1", fromPos, toPos, "comment", ctx.IssueTimeTicket())
+		assert.Equal(t, []crdt.TextAnnotationRange{
+			{Name: "c1", From: 6, To: 11, Value: "comment"},
+		}, text.Annotations())
+
+		// 02. The range of the annotation follows the inserted and deleted text.
+		fromPos, toPos, _ = text.CreateRange(0, 0)
+		_, _, err = text.Edit(fromPos, toPos, nil, "Oh, ", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		fromPos, toPos, _ = text.CreateRange(12, 13)
+		_, _, err = text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, "Oh, Hello Wold", text.String())
+		assert.Equal(t, []crdt.TextAnnotationRange{
+			{Name: "c1", From: 10, To: 14, Value: "comment"},
+		}, text.Annotations())
+
+		// 03. The annotation collapses when its text is deleted.
+		fromPos, toPos, _ = text.CreateRange(8, 14)
+		_, _, err = text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, []crdt.TextAnnotationRange{
+			{Name: "c1", From: 8, To: 8, Value: "comment"},
+		}, text.Annotations())
+
+		// 04. An older update does not overwrite the newer one.
+		removedAt := ctx.IssueTimeTicket()
+		text.RemoveAnnotation("c1", removedAt)
+		assert.Empty(t, text.Annotations())
+		fromPos, toPos, _ = text.CreateRange(0, 2)
+		text.AddAnnotation("c1", fromPos, toPos, "stale", root.Object().CreatedAt())
+		assert.Empty(t, text.Annotations())
+		assert.Len(t, text.AllAnnotations(), 1)
+	})
 }
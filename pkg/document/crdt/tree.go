@@ -20,7 +20,6 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 	"unicode/utf16"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
@@ -371,9 +370,13 @@ func NewTree(root *TreeNode, createdAt *time.Ticket) *Tree {
 
 // Marshal returns the JSON encoding of this Tree.
 func (t *Tree) Marshal() string {
-	builder := &strings.Builder{}
-	marshal(builder, t.Root())
-	return builder.String()
+	return string(t.AppendJSON(nil))
+}
+
+// AppendJSON appends the JSON encoding of this Tree to dst and returns the
+// extended buffer.
+func (t *Tree) AppendJSON(dst []byte) []byte {
+	return appendTreeNodeJSON(dst, t.Root())
 }
 
 // removedNodesLen returns the length of removed nodes.
@@ -426,28 +429,32 @@ func (t *Tree) purgeNode(node *TreeNode) error {
 	return nil
 }
 
-// marshal returns the JSON encoding of this Tree.
-func marshal(builder *strings.Builder, node *TreeNode) {
+// appendTreeNodeJSON appends the JSON encoding of the given node to dst and
+// returns the extended buffer.
+func appendTreeNodeJSON(dst []byte, node *TreeNode) []byte {
+	dst = append(dst, `{"type":`...)
+	dst = appendQuotedString(dst, node.Type())
 	if node.IsText() {
-		builder.WriteString(fmt.Sprintf(`{"type":"%s","value":"%s"}`, node.Type(), node.Value))
-		return
+		dst = append(dst, `,"value":`...)
+		dst = appendQuotedString(dst, node.Value)
+		return append(dst, '}')
 	}
 
-	builder.WriteString(fmt.Sprintf(`{"type":"%s","children":[`, node.Type()))
+	dst = append(dst, `,"children":[`...)
 	for idx, child := range node.IndexTreeNode.Children() {
 		if idx != 0 {
-			builder.WriteString(",")
+			dst = append(dst, ',')
 		}
-		marshal(builder, child.Value)
+		dst = appendTreeNodeJSON(dst, child.Value)
 	}
-	builder.WriteString(`]`)
+	dst = append(dst, ']')
 
 	if node.Attrs != nil && node.Attrs.Len() > 0 {
-		builder.WriteString(fmt.Sprintf(`,"attributes":`))
-		builder.WriteString(node.Attrs.Marshal())
+		dst = append(dst, `,"attributes":`...)
+		dst = node.Attrs.AppendJSON(dst)
 	}
 
-	builder.WriteString(`}`)
+	return append(dst, '}')
 }
 
 // DeepCopy copies itself deeply.
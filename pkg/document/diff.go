@@ -0,0 +1,155 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+)
+
+// DiffType represents the type of difference between two documents.
+type DiffType string
+
+// The values below are types of DiffType.
+const (
+	DiffAdded   DiffType = "added"
+	DiffRemoved DiffType = "removed"
+	DiffChanged DiffType = "changed"
+)
+
+// Diff represents a difference at a path between two documents. From and To
+// are the JSON encodings of the element before and after the difference.
+type Diff struct {
+	Type DiffType
+	Path string
+	From string
+	To   string
+}
+
+// DiffSnapshots returns the differences between the root objects of the given
+// snapshots.
+func DiffSnapshots(from, to []byte) ([]Diff, error) {
+	fromRoot, _, err := converter.BytesToSnapshot(from)
+	if err != nil {
+		return nil, fmt.Errorf("decode from snapshot: %w", err)
+	}
+	toRoot, _, err := converter.BytesToSnapshot(to)
+	if err != nil {
+		return nil, fmt.Errorf("decode to snapshot: %w", err)
+	}
+
+	return DiffRoots(fromRoot, toRoot), nil
+}
+
+// DiffRoots returns the differences between the given root objects. Objects
+// are compared by key and arrays by index, and the others are compared by
+// their JSON encodings. Paths are in the form of "$.key.0".
+func DiffRoots(from, to *crdt.Object) []Diff {
+	var diffs []Diff
+	diffElements("$", from, to, &diffs)
+	return diffs
+}
+
+func diffElements(path string, from, to crdt.Element, diffs *[]Diff) {
+	switch fromElem := from.(type) {
+	case *crdt.Object:
+		if toElem, ok := to.(*crdt.Object); ok {
+			diffObjects(path, fromElem, toElem, diffs)
+			return
+		}
+	case *crdt.Array:
+		if toElem, ok := to.(*crdt.Array); ok {
+			diffArrays(path, fromElem, toElem, diffs)
+			return
+		}
+	}
+
+	if fromJSON, toJSON := from.Marshal(), to.Marshal(); fromJSON != toJSON {
+		*diffs = append(*diffs, Diff{
+			Type: DiffChanged,
+			Path: path,
+			From: fromJSON,
+			To:   toJSON,
+		})
+	}
+}
+
+func diffObjects(path string, from, to *crdt.Object, diffs *[]Diff) {
+	fromMembers := from.Members()
+	toMembers := to.Members()
+
+	var keys []string
+	for k := range fromMembers {
+		keys = append(keys, k)
+	}
+	for k := range toMembers {
+		if _, ok := fromMembers[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fromElem, inFrom := fromMembers[k]
+		toElem, inTo := toMembers[k]
+		switch {
+		case !inTo:
+			*diffs = append(*diffs, Diff{
+				Type: DiffRemoved,
+				Path: path + "." + k,
+				From: fromElem.Marshal(),
+			})
+		case !inFrom:
+			*diffs = append(*diffs, Diff{
+				Type: DiffAdded,
+				Path: path + "." + k,
+				To:   toElem.Marshal(),
+			})
+		default:
+			diffElements(path+"."+k, fromElem, toElem, diffs)
+		}
+	}
+}
+
+func diffArrays(path string, from, to *crdt.Array, diffs *[]Diff) {
+	fromElems := from.Elements()
+	toElems := to.Elements()
+
+	for i := 0; i < len(fromElems) || i < len(toElems); i++ {
+		elemPath := path + "." + strconv.Itoa(i)
+		switch {
+		case i >= len(toElems):
+			*diffs = append(*diffs, Diff{
+				Type: DiffRemoved,
+				Path: elemPath,
+				From: fromElems[i].Marshal(),
+			})
+		case i >= len(fromElems):
+			*diffs = append(*diffs, Diff{
+				Type: DiffAdded,
+				Path: elemPath,
+				To:   toElems[i].Marshal(),
+			})
+		default:
+			diffElements(elemPath, fromElems[i], toElems[i], diffs)
+		}
+	}
+}
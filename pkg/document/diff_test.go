@@ -0,0 +1,77 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("diff same roots test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("k1").SetString("k1.1", "a")
+			root.SetNewArray("k2").AddInteger(1, 2)
+			return nil
+		}))
+
+		assert.Empty(t, document.DiffRoots(doc.RootObject(), doc.RootObject()))
+	})
+
+	t.Run("diff snapshots test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("k1").SetString("k1.1", "a").SetString("k1.2", "b")
+			root.SetNewArray("k2").AddInteger(1, 2)
+			root.SetNewText("k3").Edit(0, 0, "hello")
+			return nil
+		}))
+		from, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+		assert.NoError(t, err)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("k1").Delete("k1.2")
+			root.GetObject("k1").SetString("k1.3", "c")
+			root.GetArray("k2").AddInteger(3)
+			root.GetText("k3").Edit(0, 5, "world")
+			root.SetInteger("k4", 4)
+			return nil
+		}))
+		to, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+		assert.NoError(t, err)
+
+		diffs, err := document.DiffSnapshots(from, to)
+		assert.NoError(t, err)
+		assert.Equal(t, []document.Diff{
+			{Type: document.DiffRemoved, Path: "$.k1.k1.2", From: `"b"`},
+			{Type: document.DiffAdded, Path: "$.k1.k1.3", To: `"c"`},
+			{Type: document.DiffAdded, Path: "$.k2.2", To: `3`},
+			{Type: document.DiffChanged, Path: "$.k3", From: `[{"val":"hello"}]`, To: `[{"val":"world"}]`},
+			{Type: document.DiffAdded, Path: "$.k4", To: `4`},
+		}, diffs)
+
+		_, err = document.DiffSnapshots([]byte("invalid"), to)
+		assert.Error(t, err)
+	})
+}
@@ -19,6 +19,8 @@ package document
 
 import (
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
@@ -52,6 +54,10 @@ const (
 	PresenceChangedEvent DocEventType = "presence-changed"
 )
 
+// changeBatchSize is the number of remote changes that are applied to the
+// document at once while holding the lock.
+const changeBatchSize = 100
+
 // Document represents a document accessible to the user.
 //
 // How document works:
@@ -59,10 +65,24 @@ const (
 // the clone. Then the operations will apply the changes into the base json
 // root. This is to protect the base json from errors that may occur while user
 // edit the document.
+//
+// Reads through View or Marshal do not need to be guarded by the caller. They
+// read an immutable view of the base json root that is rebuilt after the root
+// is changed, so only the first read after a change waits for the lock that
+// changes of the root hold.
 type Document struct {
 	// doc is the original data of the actual document.
 	doc *InternalDocument
 
+	// lock guards the changes of `doc.root` against building views.
+	lock sync.Mutex
+
+	// version is increased whenever `doc.root` is changed.
+	version atomic.Uint64
+
+	// view is the immutable view of `doc.root` of a version.
+	view atomic.Pointer[View]
+
 	// cloneRoot is a copy of `doc.root` to be exposed to the user and is used to
 	// protect `doc.root`.
 	cloneRoot *crdt.Root
@@ -73,13 +93,38 @@ type Document struct {
 
 	// events is the channel to send events that occurred in the document.
 	events chan DocEvent
+
+	// stats records the time spent in updates if the stats are enabled.
+	stats atomic.Pointer[statsRecorder]
+
+	// lamportSource issues the lamport timestamps of local changes.
+	lamportSource change.LamportSource
+
+	// presenceDelta is the keys of the presence of this client set by
+	// SetPresence that are not yet delivered to the other clients.
+	presenceDelta innerpresence.Presence
+
+	// validator validates the root after each local update. If it returns an
+	// error, the update is rejected.
+	validator func(root *crdt.Object) error
 }
 
 // New creates a new instance of Document.
-func New(key key.Key) *Document {
+func New(key key.Key, opts ...Option) *Document {
+	var options Options
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	doc := NewInternalDocument(key)
+	if options.ActorID != nil {
+		doc.SetActor(options.ActorID)
+	}
+
 	return &Document{
-		doc:    NewInternalDocument(key),
-		events: make(chan DocEvent, 1),
+		doc:           doc,
+		events:        make(chan DocEvent, 1),
+		lamportSource: options.LamportSource,
 	}
 }
 
@@ -96,12 +141,16 @@ func (d *Document) Update(
 		return err
 	}
 
+	stats := d.stats.Load()
+	stats.countUpdate()
+
 	ctx := change.NewContext(
-		d.doc.changeID.Next(),
+		d.doc.changeID.NextWith(d.lamportSource),
 		messageFromMsgAndArgs(msgAndArgs...),
 		d.cloneRoot,
 	)
 
+	start := stats.now()
 	if err := updater(
 		json.NewObject(ctx, d.cloneRoot.Object()),
 		presence.New(ctx, d.clonePresences.LoadOrStore(d.ActorID().String(), innerpresence.NewPresence())),
@@ -111,12 +160,26 @@ func (d *Document) Update(
 		d.clonePresences = nil
 		return err
 	}
+	stats.observe(phaseGeneration, start)
+
+	if ctx.HasChange() && d.validator != nil {
+		if err := d.validator(d.cloneRoot.Object()); err != nil {
+			// drop cloneRoot because it has the rejected change.
+			d.cloneRoot = nil
+			d.clonePresences = nil
+			return fmt.Errorf("validate update: %w", err)
+		}
+	}
 
 	if ctx.HasChange() {
 		c := ctx.ToChange()
-		if err := c.Execute(d.doc.root, d.doc.presences); err != nil {
+		start := stats.now()
+		if err := d.mutate(func() error {
+			return c.Execute(d.doc.root, d.doc.presences)
+		}); err != nil {
 			return err
 		}
+		stats.observe(phaseMutation, start)
 
 		d.doc.localChanges = append(d.doc.localChanges, c)
 		d.doc.changeID = ctx.ID()
@@ -127,31 +190,66 @@ func (d *Document) Update(
 
 // ApplyChangePack applies the given change pack into this document.
 func (d *Document) ApplyChangePack(pack *change.Pack) error {
+	stats := d.stats.Load()
+
 	// 01. Apply remote changes to both the cloneRoot and the document.
 	if len(pack.Snapshot) > 0 {
 		d.cloneRoot = nil
 		d.clonePresences = nil
-		if err := d.doc.applySnapshot(pack.Snapshot, pack.Checkpoint.ServerSeq); err != nil {
+		start := stats.now()
+		if err := d.mutate(func() error {
+			return d.doc.applySnapshot(pack.Snapshot, pack.Checkpoint)
+		}); err != nil {
 			return err
 		}
+		stats.observe(phaseMutation, start)
 	} else {
-		if err := d.ensureClone(); err != nil {
-			return err
-		}
-
-		for _, c := range pack.Changes {
-			if err := c.Execute(d.cloneRoot, d.clonePresences); err != nil {
+		stats.countRemoteChanges(len(pack.Changes))
+		start := stats.now()
+		// NOTE: Executing many changes on the cloneRoot doubles the
+		// cost of catching up. In that case, we drop the cloneRoot and copy it
+		// from the root again in the next update.
+		if len(pack.Changes) > changeBatchSize {
+			d.cloneRoot = nil
+			d.clonePresences = nil
+		} else {
+			if err := d.ensureClone(); err != nil {
 				return err
 			}
-		}
 
-		events, err := d.doc.ApplyChanges(pack.Changes...)
-		if err != nil {
-			return err
+			for _, c := range pack.Changes {
+				if err := c.Execute(d.cloneRoot, d.clonePresences); err != nil {
+					return err
+				}
+			}
 		}
+		stats.observe(phaseMutation, start)
+
+		// NOTE: Changes are applied in batches so that the readers
+		// of the document are not blocked until all the changes are applied.
+		for start := 0; start < len(pack.Changes); start += changeBatchSize {
+			end := start + changeBatchSize
+			if end > len(pack.Changes) {
+				end = len(pack.Changes)
+			}
+			batch := pack.Changes[start:end]
 
-		for _, e := range events {
-			d.events <- e
+			var events []DocEvent
+			start := stats.now()
+			if err := d.mutate(func() error {
+				var err error
+				events, err = d.doc.ApplyChanges(batch...)
+				return err
+			}); err != nil {
+				return err
+			}
+			stats.observe(phaseMutation, start)
+
+			start = stats.now()
+			for _, e := range events {
+				d.events <- e
+			}
+			stats.observe(phaseEventEmission, start)
 		}
 	}
 
@@ -168,7 +266,9 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 	d.doc.checkpoint = d.doc.checkpoint.Forward(pack.Checkpoint)
 
 	// 04. Do Garbage collection.
-	d.GarbageCollect(pack.MinSyncedTicket)
+	if pack.MinSyncedTicket != nil {
+		d.GarbageCollect(pack.MinSyncedTicket)
+	}
 
 	// 05. Update the status.
 	if pack.IsRemoved {
@@ -200,7 +300,37 @@ func (d *Document) HasLocalChanges() bool {
 
 // Marshal returns the JSON encoding of this document.
 func (d *Document) Marshal() string {
-	return d.doc.Marshal()
+	return d.View().Marshal()
+}
+
+// View returns the immutable view of the current root of this document. It
+// is safe to call View concurrently with the changes of this document.
+func (d *Document) View() *View {
+	if v := d.view.Load(); v != nil && v.version == d.version.Load() {
+		return v
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	version := d.version.Load()
+	if v := d.view.Load(); v != nil && v.version == version {
+		return v
+	}
+
+	v := newView(version, d.doc.Marshal())
+	d.view.Store(v)
+	return v
+}
+
+// mutate runs the given function that changes `doc.root` while holding the
+// lock, then increases the version so that the next read rebuilds the view.
+func (d *Document) mutate(f func() error) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	defer d.version.Add(1)
+
+	return f()
 }
 
 // CreateChangePack creates pack of the local changes to send to the server.
@@ -208,12 +338,34 @@ func (d *Document) CreateChangePack() *change.Pack {
 	return d.doc.CreateChangePack()
 }
 
+// RestoreLocalChanges restores the given local changes that are not yet sent
+// to the server, such as the ones persisted before the process restarts. The
+// root of this document should already contain the changes, and the given
+// lamport is the lamport clock of the document when they were persisted.
+func (d *Document) RestoreLocalChanges(lamport int64, changes []*change.Change) {
+	d.doc.RestoreLocalChanges(lamport, changes)
+}
+
 // SetActor sets actor into this document. This is also applied in the local
 // changes the document has.
 func (d *Document) SetActor(actor *time.ActorID) {
 	d.doc.SetActor(actor)
 }
 
+// SetValidator sets the validator of the root that runs after each local
+// update. The update is rejected if the validator returns an error. If the
+// validator is nil, updates are not validated.
+func (d *Document) SetValidator(validator func(root *crdt.Object) error) {
+	d.validator = validator
+}
+
+// ResetActor sets the given actor to the changes made from now on, keeping
+// the actor of the local changes that are not yet sent to the server. It is
+// used to replay the local changes when the client is re-activated.
+func (d *Document) ResetActor(actor *time.ActorID) {
+	d.doc.ResetActor(actor)
+}
+
 // ActorID returns ID of the actor currently editing the document.
 func (d *Document) ActorID() *time.ActorID {
 	return d.doc.ActorID()
@@ -245,7 +397,7 @@ func (d *Document) Root() *json.Object {
 		panic(err)
 	}
 
-	ctx := change.NewContext(d.doc.changeID.Next(), "", d.cloneRoot)
+	ctx := change.NewContext(d.doc.changeID.NextWith(d.lamportSource), "", d.cloneRoot)
 	return json.NewObject(ctx, d.cloneRoot.Object())
 }
 
@@ -257,8 +409,12 @@ func (d *Document) GarbageCollect(ticket *time.Ticket) int {
 		}
 	}
 
-	n, err := d.doc.GarbageCollect(ticket)
-	if err != nil {
+	var n int
+	if err := d.mutate(func() error {
+		var err error
+		n, err = d.doc.GarbageCollect(ticket)
+		return err
+	}); err != nil {
 		panic(err)
 	}
 
@@ -315,6 +471,53 @@ func (d *Document) AllPresences() map[string]innerpresence.Presence {
 	return d.doc.AllPresences()
 }
 
+// ApplyPresenceDelta sets the given keys of the presence of the given client
+// without creating a change. It is used for the presence updates delivered to
+// the watchers of the document, which are not stored in the document.
+func (d *Document) ApplyPresenceDelta(clientID string, delta innerpresence.Presence) {
+	d.doc.ApplyPresenceDelta(clientID, delta)
+
+	// NOTE: Drop the clonePresences so that the next update starts from the
+	// presences including the delta.
+	d.clonePresences = nil
+}
+
+// SetPresence sets the given key of the presence of this client without
+// creating a change. The client delivers it to the other clients watching the
+// document on the next sync, so it suits the presences updated at a high
+// frequency, such as cursors and selections, without polluting the history of
+// the document. Repeated updates of a key before the delivery are coalesced
+// into the last value.
+func (d *Document) SetPresence(key, value string) {
+	if d.presenceDelta == nil {
+		d.presenceDelta = innerpresence.NewPresence()
+	}
+	d.presenceDelta.Set(key, value)
+
+	d.ApplyPresenceDelta(d.ActorID().String(), innerpresence.Presence{key: value})
+}
+
+// TakePresenceDelta returns the keys of the presence set by SetPresence since
+// the last call and clears them. It returns nil if there are no such keys.
+func (d *Document) TakePresenceDelta() innerpresence.Presence {
+	delta := d.presenceDelta
+	d.presenceDelta = nil
+	return delta
+}
+
+// RestorePresenceDelta puts back the given delta taken by TakePresenceDelta
+// when it failed to be delivered. The keys set again in the meantime are kept.
+func (d *Document) RestorePresenceDelta(delta innerpresence.Presence) {
+	for k, v := range delta {
+		if d.presenceDelta == nil {
+			d.presenceDelta = innerpresence.NewPresence()
+		}
+		if _, ok := d.presenceDelta[k]; !ok {
+			d.presenceDelta.Set(k, v)
+		}
+	}
+}
+
 // SetOnlineClients sets the online clients.
 func (d *Document) SetOnlineClients(clientIDs ...string) {
 	d.doc.SetOnlineClients(clientIDs...)
@@ -330,6 +533,23 @@ func (d *Document) RemoveOnlineClient(clientID string) {
 	d.doc.RemoveOnlineClient(clientID)
 }
 
+// EnableStats starts recording the time spent in each phase of local updates
+// and remote changes of this document. The previous stats are reset.
+func (d *Document) EnableStats() {
+	d.stats.Store(&statsRecorder{})
+}
+
+// DisableStats stops recording the stats of this document.
+func (d *Document) DisableStats() {
+	d.stats.Store(nil)
+}
+
+// Stats returns the stats recorded since the stats were enabled. It returns
+// zero stats if the stats are not enabled.
+func (d *Document) Stats() Stats {
+	return d.stats.Load().stats()
+}
+
 // Events returns the events of this document.
 func (d *Document) Events() <-chan DocEvent {
 	return d.events
@@ -17,15 +17,20 @@
 package document_test
 
 import (
+	gojson "encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
@@ -524,4 +529,361 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, "{}", doc.Marshal())
 		assert.Equal(t, 0, doc.GarbageLen())
 	})
+	t.Run("view test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("k1").SetString("k1.1", "a")
+			root.SetNewArray("k2").AddInteger(1, 2)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		view := doc.View()
+		assert.Equal(t, `{"k1":{"k1.1":"a"},"k2":[1,2]}`, view.Marshal())
+		assert.Same(t, view, doc.View())
+
+		value, err := view.Lookup("$.k2.1")
+		assert.NoError(t, err)
+		assert.Equal(t, gojson.Number("2"), value)
+		_, err = view.Lookup("$.k2.2")
+		assert.ErrorIs(t, err, document.ErrPathNotFound)
+		_, err = view.Lookup("$.k3")
+		assert.ErrorIs(t, err, document.ErrPathNotFound)
+
+		// NOTE: A view is not affected by the later changes.
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k2").AddInteger(3)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":{"k1.1":"a"},"k2":[1,2]}`, view.Marshal())
+		assert.Equal(t, `{"k1":{"k1.1":"a"},"k2":[1,2,3]}`, doc.Marshal())
+	})
+
+	t.Run("validate path test", func(t *testing.T) {
+		assert.NoError(t, document.ValidatePath("$"))
+		assert.NoError(t, document.ValidatePath("$.k1"))
+		assert.NoError(t, document.ValidatePath("$.k2.1"))
+		assert.ErrorIs(t, document.ValidatePath(""), document.ErrInvalidPath)
+		assert.ErrorIs(t, document.ValidatePath("k1"), document.ErrInvalidPath)
+		assert.ErrorIs(t, document.ValidatePath("$."), document.ErrInvalidPath)
+		assert.ErrorIs(t, document.ValidatePath("$.k1..k2"), document.ErrInvalidPath)
+	})
+
+	t.Run("concurrent read while updating test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		wg := sync.WaitGroup{}
+		done := make(chan struct{})
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-done:
+						return
+					default:
+						_, err := doc.View().Lookup("$.text")
+						assert.NoError(t, err)
+					}
+				}
+			}()
+		}
+
+		for i := 0; i < 100; i++ {
+			err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.GetText("text").Edit(i, i, "a")
+				return nil
+			})
+			assert.NoError(t, err)
+		}
+		close(done)
+		wg.Wait()
+
+		assert.Equal(t, strings.Repeat("a", 100), doc.Root().GetText("text").String())
+	})
+	t.Run("apply changes in batches test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		err := doc1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text")
+			return nil
+		})
+		assert.NoError(t, err)
+		for i := 0; i < 250; i++ {
+			err := doc1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.GetText("text").Edit(i, i, "a")
+				return nil
+			})
+			assert.NoError(t, err)
+		}
+
+		doc2 := document.New("d1")
+		assert.NoError(t, doc2.ApplyChangePack(doc1.CreateChangePack()))
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// NOTE: The clone of the document is copied again from the
+		// root in the update after applying many changes.
+		err = doc2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(0, 0, "b")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "b"+strings.Repeat("a", 250), doc2.Root().GetText("text").String())
+	})
+
+	t.Run("coalesce presence changes test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		for i := 0; i < 3; i++ {
+			err := doc1.Update(func(root *json.Object, p *presence.Presence) error {
+				p.Set("cursor", fmt.Sprintf("%d", i))
+				return nil
+			})
+			assert.NoError(t, err)
+		}
+
+		clientID := doc1.ActorID().String()
+		doc2 := document.NewInternalDocument("d1")
+		doc2.AddOnlineClient(clientID)
+		events, err := doc2.ApplyChanges(doc1.CreateChangePack().Changes...)
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, document.WatchedEvent, events[0].Type)
+		assert.Equal(t, document.PresenceChangedEvent, events[1].Type)
+		assert.Equal(t, "2", events[1].Presences[clientID]["cursor"])
+	})
+	t.Run("apply presence delta test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Set("name", "a")
+			return nil
+		}))
+		doc.SetStatus(document.StatusAttached)
+		changesLen := doc.CreateChangePack().ChangesLen()
+
+		clientID := doc.ActorID().String()
+		before := doc.MyPresence()
+		doc.ApplyPresenceDelta(clientID, innerpresence.Presence{"cursor": "1"})
+		assert.Equal(t, innerpresence.Presence{"name": "a", "cursor": "1"}, doc.MyPresence())
+		assert.Equal(t, innerpresence.Presence{"name": "a"}, before)
+		assert.Equal(t, changesLen, doc.CreateChangePack().ChangesLen())
+
+		// the next update starts from the presence including the delta.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Set("name", "b")
+			return nil
+		}))
+		assert.Equal(t, innerpresence.Presence{"name": "b", "cursor": "1"}, doc.MyPresence())
+	})
+	t.Run("set presence test", func(t *testing.T) {
+		doc := document.New("d1")
+		doc.SetStatus(document.StatusAttached)
+
+		doc.SetPresence("cursor", "1")
+		doc.SetPresence("cursor", "2")
+		doc.SetPresence("selection", "1-2")
+		assert.Equal(t, innerpresence.Presence{"cursor": "2", "selection": "1-2"}, doc.MyPresence())
+		assert.False(t, doc.HasLocalChanges())
+
+		// the delta is coalesced and cleared when taken.
+		delta := doc.TakePresenceDelta()
+		assert.Equal(t, innerpresence.Presence{"cursor": "2", "selection": "1-2"}, delta)
+		assert.Nil(t, doc.TakePresenceDelta())
+
+		// the keys set again are kept when the delta is restored.
+		doc.SetPresence("cursor", "3")
+		doc.RestorePresenceDelta(delta)
+		assert.Equal(t, innerpresence.Presence{"cursor": "3", "selection": "1-2"}, doc.TakePresenceDelta())
+	})
+	t.Run("stats test", func(t *testing.T) {
+		doc1 := document.New("d1")
+		err := doc1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, document.Stats{}, doc1.Stats())
+
+		doc1.EnableStats()
+		err = doc1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		})
+		assert.NoError(t, err)
+		stats := doc1.Stats()
+		assert.Equal(t, int64(1), stats.Updates)
+		assert.Positive(t, stats.Mutation)
+
+		doc2 := document.New("d1")
+		doc2.EnableStats()
+		assert.NoError(t, doc2.ApplyChangePack(doc1.CreateChangePack()))
+		stats = doc2.Stats()
+		assert.Equal(t, int64(0), stats.Updates)
+		assert.Equal(t, int64(2), stats.RemoteChanges)
+		assert.Positive(t, stats.Mutation)
+
+		doc2.DisableStats()
+		assert.Equal(t, document.Stats{}, doc2.Stats())
+	})
+
+	t.Run("actor and lamport source options test", func(t *testing.T) {
+		actorID, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		source := change.LamportSourceFunc(func(lamport int64) int64 {
+			return lamport + 10
+		})
+
+		doc := document.New("d1", document.WithActorID(actorID), document.WithLamportSource(source))
+		assert.Equal(t, actorID, doc.ActorID())
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString("k1", "v1")
+				return nil
+			}))
+		}
+
+		changes := doc.CreateChangePack().Changes
+		assert.Len(t, changes, 2)
+		assert.Equal(t, int64(10), changes[0].ID().Lamport())
+		assert.Equal(t, int64(20), changes[1].ID().Lamport())
+		assert.Equal(t, actorID, changes[1].ID().ActorID())
+
+		// NOTE: A source can not move the lamport timestamp backward.
+		doc = document.New("d1", document.WithLamportSource(change.LamportSourceFunc(func(int64) int64 {
+			return 0
+		})))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.Equal(t, int64(1), doc.CreateChangePack().Changes[0].ID().Lamport())
+	})
+
+	t.Run("affected paths test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("rows").SetNewObject("r1").SetNewArray("cells").AddString("a")
+			root.SetString("title", "t1")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("rows").GetObject("r1").GetArray("cells").AddString("b")
+			root.Delete("title")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Set("k", "v")
+			return nil
+		}))
+
+		replica := document.NewInternalDocument("d1")
+		var paths [][]string
+		for _, c := range doc.CreateChangePack().Changes {
+			affected, err := replica.ApplyChangeWithPaths(c)
+			assert.NoError(t, err)
+			paths = append(paths, affected)
+		}
+		assert.Len(t, paths, 3)
+		assert.Equal(t, []string{"$.rows", "$.rows.r1", "$.rows.r1.cells", "$.title"}, paths[0])
+		assert.Equal(t, []string{"$.rows.r1.cells", "$.title"}, paths[1])
+		assert.Nil(t, paths[2])
+	})
+
+	t.Run("prune by path filter test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			rows := root.SetNewArray("rows")
+			rows.AddNewObject().SetNewArray("cells").AddString("a")
+			rows.AddNewObject().SetString("style", "bold")
+			root.SetString("title", "t1")
+			return nil
+		}))
+
+		replica := document.NewInternalDocument("d1")
+		_, err := replica.ApplyChanges(doc.CreateChangePack().Changes...)
+		assert.NoError(t, err)
+		elements := replica.Root().ElementMapLen()
+
+		assert.NoError(t, document.PruneByPathFilter(replica.Root(), "$.rows.*.cells"))
+		assert.Equal(t, `{"rows":[{"cells":["a"]},{}]}`, replica.Marshal())
+		assert.Equal(t, elements-2, replica.Root().ElementMapLen())
+	})
+
+	t.Run("match path filter test", func(t *testing.T) {
+		filter, err := document.NormalizePathFilter("$.rows[*].cells")
+		assert.NoError(t, err)
+		assert.Equal(t, "$.rows.*.cells", filter)
+		_, err = document.NormalizePathFilter("rows")
+		assert.ErrorIs(t, err, document.ErrInvalidPath)
+
+		assert.True(t, document.MatchPathFilter(filter, []string{"$.rows"}))
+		assert.True(t, document.MatchPathFilter(filter, []string{"$.rows.3.cells.1"}))
+		assert.True(t, document.MatchPathFilter(filter, nil))
+		assert.True(t, document.MatchPathFilter("", []string{"$.title"}))
+		assert.False(t, document.MatchPathFilter(filter, []string{"$.title"}))
+		assert.False(t, document.MatchPathFilter(filter, []string{"$.rows.3.style"}))
+	})
+
+	t.Run("keep local changes after applying snapshot test", func(t *testing.T) {
+		remote := document.New("d1")
+		assert.NoError(t, remote.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		snapshot, err := converter.SnapshotToBytes(remote.RootObject(), remote.AllPresences())
+		assert.NoError(t, err)
+
+		// NOTE: The snapshot does not have the local change since the server
+		// has not received it, so the change is executed again on it.
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, doc.ApplyChangePack(change.NewPack(
+			"d1",
+			change.InitialCheckpoint.NextServerSeq(1),
+			nil,
+			snapshot,
+		)))
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, doc.Marshal())
+		assert.True(t, doc.HasLocalChanges())
+	})
+	t.Run("validator test", func(t *testing.T) {
+		doc := document.New("d1")
+		doc.SetValidator(func(root *crdt.Object) error {
+			if !root.Has("title") {
+				return errDummy
+			}
+			return nil
+		})
+
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("body", "b")
+			return nil
+		})
+		assert.ErrorIs(t, err, errDummy)
+		assert.Equal(t, `{}`, doc.Marshal())
+		assert.False(t, doc.HasLocalChanges())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "t")
+			root.SetString("body", "b")
+			return nil
+		}))
+		assert.Equal(t, `{"body":"b","title":"t"}`, doc.Marshal())
+
+		// NOTE: The rejected update should not be left in the clone.
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("title")
+			return nil
+		})
+		assert.ErrorIs(t, err, errDummy)
+		assert.Equal(t, `{"body":"b","title":"t"}`, doc.Root().Marshal())
+	})
 }
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+func FuzzApplyChangePack(f *testing.F) {
+	doc := document.New("d1")
+	assert.NoError(f, doc.Update(func(root *json.Object, p *presence.Presence) error {
+		root.SetNewObject("k1").SetString("k1.1", "a")
+		root.SetNewArray("k2").AddInteger(1, 2).Delete(0)
+		root.SetNewText("k3").Edit(0, 0, "hello").Style(0, 2, map[string]string{"b": "1"})
+		root.SetNewCounter("k4", crdt.IntegerCnt, 0).Increase(1)
+		root.SetNewTree("k5", &json.TreeNode{
+			Type:     "r",
+			Children: []json.TreeNode{{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "ab"}}}},
+		})
+		p.Set("name", "a")
+		return nil
+	}))
+
+	bytes, err := converter.ChangePackToBytes(doc.CreateChangePack())
+	assert.NoError(f, err)
+	f.Add(bytes)
+
+	snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+	assert.NoError(f, err)
+	bytes, err = converter.ChangePackToBytes(change.NewPack(
+		doc.Key(),
+		change.NewCheckpoint(1, 0),
+		nil,
+		snapshot,
+	))
+	assert.NoError(f, err)
+	f.Add(bytes)
+
+	f.Fuzz(func(t *testing.T, bytes []byte) {
+		pack, err := converter.BytesToChangePack(bytes)
+		if err != nil {
+			return
+		}
+
+		// NOTE: Drain the events so that applying changes of
+		// presences does not block.
+		doc := document.New("d1")
+		go func() {
+			for range doc.Events() {
+			}
+		}()
+
+		if err := doc.ApplyChangePack(pack); err != nil {
+			return
+		}
+		_ = doc.Marshal()
+	})
+}
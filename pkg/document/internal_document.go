@@ -145,7 +145,7 @@ func (d *InternalDocument) HasLocalChanges() bool {
 func (d *InternalDocument) ApplyChangePack(pack *change.Pack) error {
 	// 01. Apply remote changes to both the cloneRoot and the document.
 	if len(pack.Snapshot) > 0 {
-		if err := d.applySnapshot(pack.Snapshot, pack.Checkpoint.ServerSeq); err != nil {
+		if err := d.applySnapshot(pack.Snapshot, pack.Checkpoint); err != nil {
 			return err
 		}
 	} else {
@@ -190,6 +190,11 @@ func (d *InternalDocument) Marshal() string {
 	return d.root.Object().Marshal()
 }
 
+// View returns the view of the current root of this document.
+func (d *InternalDocument) View() *View {
+	return newView(0, d.Marshal())
+}
+
 // CreateChangePack creates pack of the local changes to send to the server.
 func (d *InternalDocument) CreateChangePack() *change.Pack {
 	changes := d.localChanges
@@ -198,6 +203,21 @@ func (d *InternalDocument) CreateChangePack() *change.Pack {
 	return change.NewPack(d.key, cp, changes, nil)
 }
 
+// RestoreLocalChanges restores the given local changes that are not yet sent
+// to the server. The root of this document should already contain them.
+func (d *InternalDocument) RestoreLocalChanges(lamport int64, changes []*change.Change) {
+	clientSeq := d.checkpoint.ClientSeq
+	if len(changes) > 0 {
+		clientSeq = changes[len(changes)-1].ClientSeq()
+	}
+	if lamport < d.changeID.Lamport() {
+		lamport = d.changeID.Lamport()
+	}
+
+	d.localChanges = changes
+	d.changeID = change.NewID(clientSeq, change.InitialServerSeq, lamport, d.changeID.ActorID())
+}
+
 // SetActor sets actor into this document. This is also applied in the local
 // changes the document has.
 func (d *InternalDocument) SetActor(actor *time.ActorID) {
@@ -207,6 +227,13 @@ func (d *InternalDocument) SetActor(actor *time.ActorID) {
 	d.changeID = d.changeID.SetActor(actor)
 }
 
+// ResetActor sets the given actor to the changes made from now on. Unlike
+// SetActor, it keeps the actor of the local changes, so that they are pushed
+// as they were made and match the elements that they created in the root.
+func (d *InternalDocument) ResetActor(actor *time.ActorID) {
+	d.changeID = d.changeID.SetActor(actor)
+}
+
 // Lamport returns the Lamport clock of this document.
 func (d *InternalDocument) Lamport() int64 {
 	return d.changeID.Lamport()
@@ -237,7 +264,7 @@ func (d *InternalDocument) RootObject() *crdt.Object {
 	return d.root.Object()
 }
 
-func (d *InternalDocument) applySnapshot(snapshot []byte, serverSeq int64) error {
+func (d *InternalDocument) applySnapshot(snapshot []byte, cp change.Checkpoint) error {
 	rootObj, presences, err := converter.BytesToSnapshot(snapshot)
 	if err != nil {
 		return err
@@ -245,14 +272,32 @@ func (d *InternalDocument) applySnapshot(snapshot []byte, serverSeq int64) error
 
 	d.root = crdt.NewRoot(rootObj)
 	d.presences = presences
-	d.changeID = d.changeID.SyncLamport(serverSeq)
+	d.changeID = d.changeID.SyncLamport(cp.ServerSeq)
+
+	// NOTE: The snapshot does not have the local changes that the server has
+	// not received yet, such as the ones kept while pulling only, so they
+	// are executed again on the root of the snapshot.
+	for _, c := range d.localChanges {
+		if c.ClientSeq() <= cp.ClientSeq {
+			continue
+		}
+		if err := c.Execute(d.root, d.presences); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
-// ApplyChanges applies remote changes to the document.
+// ApplyChanges applies remote changes to the document. Presence changes of a
+// client are coalesced so that only its latest presence is reported by a
+// single PresenceChangedEvent.
 func (d *InternalDocument) ApplyChanges(changes ...*change.Change) ([]DocEvent, error) {
 	var events []DocEvent
+
+	// changedEvents is the index of the PresenceChangedEvent of each client in
+	// events, which is updated by the later presence changes of the client.
+	changedEvents := make(map[string]int)
 	for _, c := range changes {
 		if c.PresenceChange() != nil {
 			clientID := c.ID().ActorID().String()
@@ -265,6 +310,11 @@ func (d *InternalDocument) ApplyChanges(changes ...*change.Change) ([]DocEvent,
 					eventType := PresenceChangedEvent
 					if !d.presences.Has(clientID) {
 						eventType = WatchedEvent
+					} else if idx, ok := changedEvents[clientID]; ok {
+						events[idx].Presences[clientID] = c.PresenceChange().Presence
+						break
+					} else {
+						changedEvents[clientID] = len(events)
 					}
 					event := DocEvent{
 						Type: eventType,
@@ -286,6 +336,7 @@ func (d *InternalDocument) ApplyChanges(changes ...*change.Change) ([]DocEvent,
 						},
 					}
 					events = append(events, event)
+					delete(changedEvents, clientID)
 					d.RemoveOnlineClient(clientID)
 				}
 			}
@@ -351,6 +402,20 @@ func (d *InternalDocument) AllPresences() map[string]innerpresence.Presence {
 	return presences
 }
 
+// ApplyPresenceDelta sets the given keys of the presence of the given client
+// without a change. The presence is replaced rather than modified, so that
+// the copies returned to the readers are not affected.
+func (d *InternalDocument) ApplyPresenceDelta(clientID string, delta innerpresence.Presence) {
+	p := d.presences.Load(clientID).DeepCopy()
+	if p == nil {
+		p = innerpresence.NewPresence()
+	}
+	for k, v := range delta {
+		p.Set(k, v)
+	}
+	d.presences.Store(clientID, p)
+}
+
 // SetOnlineClients sets the online clients.
 func (d *InternalDocument) SetOnlineClients(ids ...string) {
 	d.onlineClients.Range(func(key, value interface{}) bool {
@@ -126,6 +126,15 @@ func (p *Array) AddDate(values ...gotime.Time) *Array {
 	return p
 }
 
+// AddNewObject adds a new object at the last.
+func (p *Array) AddNewObject() *Object {
+	v := p.addInternal(func(ticket *time.Ticket) crdt.Element {
+		return NewObject(p.context, crdt.NewObject(crdt.NewElementRHT(), ticket))
+	})
+
+	return v.(*Object)
+}
+
 // AddNewArray adds a new array at the last.
 func (p *Array) AddNewArray() *Array {
 	v := p.addInternal(func(ticket *time.Ticket) crdt.Element {
@@ -137,13 +146,44 @@ func (p *Array) AddNewArray() *Array {
 
 // MoveBefore moves the given element to its new position before the given next element.
 func (p *Array) MoveBefore(nextCreatedAt, createdAt *time.Ticket) {
-	p.moveBeforeInternal(nextCreatedAt, createdAt)
+	prevPositionedAt, err := p.FindPrevPositionedAt(nextCreatedAt)
+	if err != nil {
+		panic(err)
+	}
+
+	p.moveAfterInternal(prevPositionedAt, createdAt)
+}
+
+// MoveAfter moves the given element to its new position after the given
+// previous element.
+func (p *Array) MoveAfter(prevCreatedAt, createdAt *time.Ticket) {
+	prevPositionedAt, err := p.FindPositionedAt(prevCreatedAt)
+	if err != nil {
+		panic(err)
+	}
+
+	p.moveAfterInternal(prevPositionedAt, createdAt)
+}
+
+// MoveFront moves the given element to the front of this Array.
+func (p *Array) MoveFront(createdAt *time.Ticket) {
+	p.moveAfterInternal(time.InitialTicket, createdAt)
+}
+
+// MoveLast moves the given element to the end of this Array.
+func (p *Array) MoveLast(createdAt *time.Ticket) {
+	p.moveAfterInternal(p.Array.LastPositionedAt(), createdAt)
 }
 
 // InsertIntegerAfter inserts the given integer after the given previous
 // element.
 func (p *Array) InsertIntegerAfter(index int, v int) *Array {
-	p.insertAfterInternal(p.Get(index).CreatedAt(), func(ticket *time.Ticket) crdt.Element {
+	prevPositionedAt, err := p.FindPositionedAt(p.Get(index).CreatedAt())
+	if err != nil {
+		panic(err)
+	}
+
+	p.insertAfterInternal(prevPositionedAt, func(ticket *time.Ticket) crdt.Element {
 		return crdt.NewPrimitive(v, ticket)
 	})
 
@@ -192,11 +232,11 @@ func (p *Array) Len() int {
 func (p *Array) addInternal(
 	creator func(ticket *time.Ticket) crdt.Element,
 ) crdt.Element {
-	return p.insertAfterInternal(p.Array.LastCreatedAt(), creator)
+	return p.insertAfterInternal(p.Array.LastPositionedAt(), creator)
 }
 
 func (p *Array) insertAfterInternal(
-	prevCreatedAt *time.Ticket,
+	prevPositionedAt *time.Ticket,
 	creator func(ticket *time.Ticket) crdt.Element,
 ) crdt.Element {
 	ticket := p.context.IssueTimeTicket()
@@ -209,12 +249,12 @@ func (p *Array) insertAfterInternal(
 	}
 	p.context.Push(operations.NewAdd(
 		p.Array.CreatedAt(),
-		prevCreatedAt,
+		prevPositionedAt,
 		copiedValue,
 		ticket,
 	))
 
-	if err = p.InsertAfter(prevCreatedAt, value); err != nil {
+	if err = p.InsertAfter(prevPositionedAt, value); err != nil {
 		panic(err)
 	}
 	p.context.RegisterElement(value)
@@ -222,22 +262,18 @@ func (p *Array) insertAfterInternal(
 	return elem
 }
 
-func (p *Array) moveBeforeInternal(nextCreatedAt, createdAt *time.Ticket) {
+func (p *Array) moveAfterInternal(prevPositionedAt, createdAt *time.Ticket) {
 	ticket := p.context.IssueTimeTicket()
 
-	prevCreatedAt, err := p.FindPrevCreatedAt(nextCreatedAt)
-	if err != nil {
-		panic(err)
-	}
-
 	p.context.Push(operations.NewMove(
 		p.Array.CreatedAt(),
-		prevCreatedAt,
+		prevPositionedAt,
 		createdAt,
 		ticket,
 	))
 
-	if err = p.MoveAfter(prevCreatedAt, createdAt, ticket); err != nil {
+	if err := p.Array.MoveAfter(prevPositionedAt, createdAt, ticket); err != nil {
 		panic(err)
 	}
+	p.context.RegisterElementHasRemovedNodes(p.Array)
 }
@@ -46,6 +46,17 @@ func NewCounter(ctx *change.Context, counter *crdt.Counter) *Counter {
 // Only numeric types are allowed as operand values, excluding
 // uint64 and uintptr.
 func (p *Counter) Increase(v interface{}) *Counter {
+	return p.increase(v, false)
+}
+
+// Decrease adds an increase operation with the negated operand, so it
+// commutes with the other increases and decreases. The operand types are the
+// same as Increase.
+func (p *Counter) Decrease(v interface{}) *Counter {
+	return p.increase(v, true)
+}
+
+func (p *Counter) increase(v interface{}, negate bool) *Counter {
 	if !isAllowedOperand(v) {
 		panic("unsupported type")
 	}
@@ -54,6 +65,12 @@ func (p *Counter) Increase(v interface{}) *Counter {
 
 	value, kind := convertAssertableOperand(v)
 	isInt := kind == reflect.Int
+	if negate && isInt {
+		value = -value.(int)
+	} else if negate {
+		value = -value.(float64)
+	}
+
 	switch p.ValueType() {
 	case crdt.LongCnt:
 		if isInt {
@@ -67,6 +84,12 @@ func (p *Counter) Increase(v interface{}) *Counter {
 		} else {
 			primitive = crdt.NewPrimitive(int32(value.(float64)), ticket)
 		}
+	case crdt.DoubleCnt:
+		if isInt {
+			primitive = crdt.NewPrimitive(float64(value.(int)), ticket)
+		} else {
+			primitive = crdt.NewPrimitive(value.(float64), ticket)
+		}
 	default:
 		panic("unsupported type")
 	}
@@ -31,6 +31,8 @@ func toOriginal(elem crdt.Element) crdt.Element {
 		return elem.Counter
 	case *Tree:
 		return elem.Tree
+	case *Set:
+		return elem.Set
 	case *crdt.Primitive:
 		return elem
 	}
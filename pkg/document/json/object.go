@@ -40,10 +40,16 @@ func NewObject(ctx *change.Context, root *crdt.Object) *Object {
 	}
 }
 
-// SetNewObject sets a new Object for the given key.
-func (p *Object) SetNewObject(k string) *Object {
+// SetNewObject sets a new Object for the given key. The conflict policy of
+// the object can be given, which is crdt.LWW by default. If objects with
+// crdt.Merge are set concurrently to the key, their members are merged.
+func (p *Object) SetNewObject(k string, policy ...crdt.ConflictPolicy) *Object {
 	v := p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
-		return NewObject(p.context, crdt.NewObject(crdt.NewElementRHT(), ticket))
+		obj := crdt.NewObject(crdt.NewElementRHT(), ticket)
+		if len(policy) > 0 {
+			obj.SetPolicy(policy[0])
+		}
+		return NewObject(p.context, obj)
 	})
 
 	return v.(*Object)
@@ -74,17 +80,8 @@ func (p *Object) SetNewText(k string) *Text {
 func (p *Object) SetNewCounter(k string, t crdt.CounterType, n interface{}) *Counter {
 	v := p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
 		switch t {
-		case crdt.IntegerCnt:
-			counter, err := crdt.NewCounter(crdt.IntegerCnt, n, ticket)
-			if err != nil {
-				panic(err)
-			}
-			return NewCounter(
-				p.context,
-				counter,
-			)
-		case crdt.LongCnt:
-			counter, err := crdt.NewCounter(crdt.LongCnt, n, ticket)
+		case crdt.IntegerCnt, crdt.LongCnt, crdt.DoubleCnt:
+			counter, err := crdt.NewCounter(t, n, ticket)
 			if err != nil {
 				panic(err)
 			}
@@ -117,6 +114,15 @@ func (p *Object) SetNewTree(k string, initialRoot ...*TreeNode) *Tree {
 	return v.(*Tree)
 }
 
+// SetNewSet sets a new Set for the given key.
+func (p *Object) SetNewSet(k string) *Set {
+	v := p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
+		return NewSet(p.context, crdt.NewSet(ticket))
+	})
+
+	return v.(*Set)
+}
+
 // SetNull sets the null for the given key.
 func (p *Object) SetNull(k string) *Object {
 	p.setInternal(k, func(ticket *time.Ticket) crdt.Element {
@@ -291,6 +297,23 @@ func (p *Object) GetTree(k string) *Tree {
 	}
 }
 
+// GetSet returns Set of the given key.
+func (p *Object) GetSet(k string) *Set {
+	elem := p.Object.Get(k)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := p.Object.Get(k).(type) {
+	case *crdt.Set:
+		return NewSet(p.context, elem)
+	case *Set:
+		return elem
+	default:
+		panic("unsupported type")
+	}
+}
+
 func (p *Object) setInternal(
 	k string,
 	creator func(ticket *time.Ticket) crdt.Element,
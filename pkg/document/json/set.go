@@ -0,0 +1,81 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+// Set represents a set of primitive values in the document. As a proxy for the
+// CRDT set, it is used when the user manipulates the set from the outside.
+type Set struct {
+	*crdt.Set
+	context *change.Context
+}
+
+// NewSet creates a new instance of Set.
+func NewSet(ctx *change.Context, set *crdt.Set) *Set {
+	return &Set{
+		Set:     set,
+		context: ctx,
+	}
+}
+
+// Add adds the given value to this set. The types of values are the same as
+// the primitives of Object, and values of different types are different
+// values, e.g. int64(1) and 1 are different.
+func (p *Set) Add(v interface{}) *Set {
+	ticket := p.context.IssueTimeTicket()
+	value := crdt.NewPrimitive(v, ticket)
+
+	p.Set.Add(value, ticket)
+	p.context.Push(operations.NewSetAdd(
+		p.CreatedAt(),
+		value,
+		ticket,
+	))
+
+	return p
+}
+
+// Delete removes the given value from this set. The additions of the value
+// made concurrently with the removal are kept.
+func (p *Set) Delete(v interface{}) *Set {
+	ticket := p.context.IssueTimeTicket()
+	value := crdt.NewPrimitive(v, ticket)
+
+	latestCreatedAtMapByActor := p.Set.Delete(value, nil)
+	if len(latestCreatedAtMapByActor) == 0 {
+		return p
+	}
+
+	p.context.Push(operations.NewSetRemove(
+		p.CreatedAt(),
+		value,
+		latestCreatedAtMapByActor,
+		ticket,
+	))
+
+	return p
+}
+
+// Has returns whether the given value is in this set.
+func (p *Set) Has(v interface{}) bool {
+	return p.Set.Has(crdt.NewPrimitive(v, nil))
+}
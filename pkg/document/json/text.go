@@ -103,22 +103,72 @@ func (p *Text) Style(from, to int, attributes map[string]string) *Text {
 	}
 
 	ticket := p.context.IssueTimeTicket()
-	if err := p.Text.Style(
+	maxCreationMapByActor, err := p.Text.Style(
 		fromPos,
 		toPos,
+		nil,
 		attributes,
 		ticket,
-	); err != nil {
+	)
+	if err != nil {
 		panic(err)
 	}
 
+	// NOTE(hackerwins): An empty map would be decoded as a Style of the
+	// previous versions that styles all nodes, so a Style that styles no node
+	// is not pushed.
+	if len(maxCreationMapByActor) == 0 {
+		return p
+	}
+
 	p.context.Push(operations.NewStyle(
 		p.CreatedAt(),
 		fromPos,
 		toPos,
+		maxCreationMapByActor,
 		attributes,
 		ticket,
 	))
 
 	return p
 }
+
+// AddAnnotation adds the annotation of the given name to the given range. The
+// annotation follows the text of the range as the text is edited.
+func (p *Text) AddAnnotation(name string, from, to int, value string) *Text {
+	if from > to {
+		panic("from should be less than or equal to to")
+	}
+	fromPos, toPos, err := p.Text.CreateRange(from, to)
+	if err != nil {
+		panic(err)
+	}
+
+	ticket := p.context.IssueTimeTicket()
+	p.Text.AddAnnotation(name, fromPos, toPos, value, ticket)
+
+	p.context.Push(operations.NewAddAnnotation(
+		p.CreatedAt(),
+		name,
+		fromPos,
+		toPos,
+		value,
+		ticket,
+	))
+
+	return p
+}
+
+// RemoveAnnotation removes the annotation of the given name.
+func (p *Text) RemoveAnnotation(name string) *Text {
+	ticket := p.context.IssueTimeTicket()
+	p.Text.RemoveAnnotation(name, ticket)
+
+	p.context.Push(operations.NewRemoveAnnotation(
+		p.CreatedAt(),
+		name,
+		ticket,
+	))
+
+	return p
+}
@@ -251,6 +251,33 @@ func (t *Tree) Style(fromIdx, toIdx int, attributes map[string]string) bool {
 		panic(err)
 	}
 
+	return t.style(fromPos, toPos, attributes)
+}
+
+// StyleByPath sets the attributes to the elements of the given range of
+// paths.
+func (t *Tree) StyleByPath(fromPath []int, toPath []int, attributes map[string]string) bool {
+	if len(fromPath) != len(toPath) {
+		panic(ErrPathLenDiff)
+	}
+
+	if len(fromPath) == 0 || len(toPath) == 0 {
+		panic(ErrEmptyPath)
+	}
+
+	fromPos, err := t.Tree.PathToPos(fromPath)
+	if err != nil {
+		panic(err)
+	}
+	toPos, err := t.Tree.PathToPos(toPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return t.style(fromPos, toPos, attributes)
+}
+
+func (t *Tree) style(fromPos, toPos *crdt.TreePos, attributes map[string]string) bool {
 	ticket := t.context.IssueTimeTicket()
 	if err := t.Tree.Style(fromPos, toPos, attributes, ticket); err != nil {
 		panic(err)
@@ -26,7 +26,8 @@ type Add struct {
 	// parentCreatedAt is the creation time of the Array that executes Add.
 	parentCreatedAt *time.Ticket
 
-	// prevCreatedAt is the creation time of the previous element.
+	// prevCreatedAt is the position of the previous element. It is the
+	// creation time of the element unless the element has been moved.
 	prevCreatedAt *time.Ticket
 
 	// value is an element added by the insert operations.
@@ -93,7 +94,7 @@ func (o *Add) SetActor(actorID *time.ActorID) {
 	o.executedAt = o.executedAt.SetActorID(actorID)
 }
 
-// PrevCreatedAt returns the creation time of previous element.
+// PrevCreatedAt returns the position of the previous element.
 func (o *Add) PrevCreatedAt() *time.Ticket {
 	return o.prevCreatedAt
 }
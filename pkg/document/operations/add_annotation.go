@@ -0,0 +1,111 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// AddAnnotation is an operation that adds a named annotation of the given
+// range to Text.
+type AddAnnotation struct {
+	// parentCreatedAt is the creation time of the Text that executes
+	// AddAnnotation.
+	parentCreatedAt *time.Ticket
+
+	// name is the name of the annotation.
+	name string
+
+	// from is the starting point of the range of the annotation.
+	from *crdt.RGATreeSplitNodePos
+
+	// to is the end point of the range of the annotation.
+	to *crdt.RGATreeSplitNodePos
+
+	// value is the value of the annotation such as the content of a comment.
+	value string
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewAddAnnotation creates a new instance of AddAnnotation.
+func NewAddAnnotation(
+	parentCreatedAt *time.Ticket,
+	name string,
+	from *crdt.RGATreeSplitNodePos,
+	to *crdt.RGATreeSplitNodePos,
+	value string,
+	executedAt *time.Ticket,
+) *AddAnnotation {
+	return &AddAnnotation{
+		parentCreatedAt: parentCreatedAt,
+		name:            name,
+		from:            from,
+		to:              to,
+		value:           value,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (e *AddAnnotation) Execute(root *crdt.Root) error {
+	parent := root.FindByCreatedAt(e.parentCreatedAt)
+	obj, ok := parent.(*crdt.Text)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	obj.AddAnnotation(e.name, e.from, e.to, e.value, e.executedAt)
+	return nil
+}
+
+// Name returns the name of the annotation.
+func (e *AddAnnotation) Name() string {
+	return e.name
+}
+
+// From returns the start point of the range of the annotation.
+func (e *AddAnnotation) From() *crdt.RGATreeSplitNodePos {
+	return e.from
+}
+
+// To returns the end point of the range of the annotation.
+func (e *AddAnnotation) To() *crdt.RGATreeSplitNodePos {
+	return e.to
+}
+
+// Value returns the value of the annotation.
+func (e *AddAnnotation) Value() string {
+	return e.value
+}
+
+// ExecutedAt returns execution time of this operation.
+func (e *AddAnnotation) ExecutedAt() *time.Ticket {
+	return e.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (e *AddAnnotation) SetActor(actorID *time.ActorID) {
+	e.executedAt = e.executedAt.SetActorID(actorID)
+}
+
+// ParentCreatedAt returns the creation time of the Text.
+func (e *AddAnnotation) ParentCreatedAt() *time.Ticket {
+	return e.parentCreatedAt
+}
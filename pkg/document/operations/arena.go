@@ -0,0 +1,333 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/arena"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Arena allocates the operations decoded from a change pack of a document. It
+// should be created for each decoding of a change pack so that the memory of
+// a document is not pinned by the others. A nil Arena allocates them one by
+// one.
+type Arena struct {
+	sets              arena.Arena[Set]
+	adds              arena.Arena[Add]
+	moves             arena.Arena[Move]
+	removes           arena.Arena[Remove]
+	edits             arena.Arena[Edit]
+	editReverses      arena.Arena[EditReverse]
+	styles            arena.Arena[Style]
+	addAnnotations    arena.Arena[AddAnnotation]
+	removeAnnotations arena.Arena[RemoveAnnotation]
+	increases         arena.Arena[Increase]
+	setAdds           arena.Arena[SetAdd]
+	setRemoves        arena.Arena[SetRemove]
+	treeEdits         arena.Arena[TreeEdit]
+	treeStyles        arena.Arena[TreeStyle]
+}
+
+// NewArena creates a new instance of Arena.
+func NewArena() *Arena {
+	return &Arena{}
+}
+
+// NewSet is like NewSet, but allocates the operation from this arena.
+func (a *Arena) NewSet(
+	parentCreatedAt *time.Ticket,
+	key string,
+	value crdt.Element,
+	executedAt *time.Ticket,
+) *Set {
+	if a == nil {
+		return NewSet(parentCreatedAt, key, value, executedAt)
+	}
+
+	op := a.sets.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.key = key
+	op.value = value
+	op.executedAt = executedAt
+	return op
+}
+
+// NewAdd is like NewAdd, but allocates the operation from this arena.
+func (a *Arena) NewAdd(
+	parentCreatedAt *time.Ticket,
+	prevCreatedAt *time.Ticket,
+	value crdt.Element,
+	executedAt *time.Ticket,
+) *Add {
+	if a == nil {
+		return NewAdd(parentCreatedAt, prevCreatedAt, value, executedAt)
+	}
+
+	op := a.adds.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.prevCreatedAt = prevCreatedAt
+	op.value = value
+	op.executedAt = executedAt
+	return op
+}
+
+// NewMove is like NewMove, but allocates the operation from this arena.
+func (a *Arena) NewMove(
+	parentCreatedAt *time.Ticket,
+	prevCreatedAt *time.Ticket,
+	createdAt *time.Ticket,
+	executedAt *time.Ticket,
+) *Move {
+	if a == nil {
+		return NewMove(parentCreatedAt, prevCreatedAt, createdAt, executedAt)
+	}
+
+	op := a.moves.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.prevCreatedAt = prevCreatedAt
+	op.createdAt = createdAt
+	op.executedAt = executedAt
+	return op
+}
+
+// NewRemove is like NewRemove, but allocates the operation from this arena.
+func (a *Arena) NewRemove(
+	parentCreatedAt *time.Ticket,
+	createdAt *time.Ticket,
+	executedAt *time.Ticket,
+) *Remove {
+	if a == nil {
+		return NewRemove(parentCreatedAt, createdAt, executedAt)
+	}
+
+	op := a.removes.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.createdAt = createdAt
+	op.executedAt = executedAt
+	return op
+}
+
+// NewEdit is like NewEdit, but allocates the operation from this arena.
+func (a *Arena) NewEdit(
+	parentCreatedAt *time.Ticket,
+	from *crdt.RGATreeSplitNodePos,
+	to *crdt.RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	content string,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) *Edit {
+	if a == nil {
+		return NewEdit(parentCreatedAt, from, to, latestCreatedAtMapByActor, content, attributes, executedAt)
+	}
+
+	op := a.edits.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.from = from
+	op.to = to
+	op.latestCreatedAtMapByActor = latestCreatedAtMapByActor
+	op.content = content
+	op.attributes = attributes
+	op.executedAt = executedAt
+	return op
+}
+
+// NewEditReverse is like NewEditReverse, but allocates the operation from this arena.
+func (a *Arena) NewEditReverse(
+	parentCreatedAt *time.Ticket,
+	fromIdx int32,
+	toIdx int32,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	content string,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) *EditReverse {
+	if a == nil {
+		return NewEditReverse(parentCreatedAt, fromIdx, toIdx, latestCreatedAtMapByActor, content, attributes, executedAt)
+	}
+
+	op := a.editReverses.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.fromIdx = fromIdx
+	op.toIdx = toIdx
+	op.latestCreatedAtMapByActor = latestCreatedAtMapByActor
+	op.content = content
+	op.attributes = attributes
+	op.executedAt = executedAt
+	return op
+}
+
+// NewStyle is like NewStyle, but allocates the operation from this arena.
+func (a *Arena) NewStyle(
+	parentCreatedAt *time.Ticket,
+	from *crdt.RGATreeSplitNodePos,
+	to *crdt.RGATreeSplitNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) *Style {
+	if a == nil {
+		return NewStyle(parentCreatedAt, from, to, latestCreatedAtMapByActor, attributes, executedAt)
+	}
+
+	op := a.styles.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.from = from
+	op.to = to
+	op.latestCreatedAtMapByActor = latestCreatedAtMapByActor
+	op.attributes = attributes
+	op.executedAt = executedAt
+	return op
+}
+
+// NewAddAnnotation is like NewAddAnnotation, but allocates the operation from this arena.
+func (a *Arena) NewAddAnnotation(
+	parentCreatedAt *time.Ticket,
+	name string,
+	from *crdt.RGATreeSplitNodePos,
+	to *crdt.RGATreeSplitNodePos,
+	value string,
+	executedAt *time.Ticket,
+) *AddAnnotation {
+	if a == nil {
+		return NewAddAnnotation(parentCreatedAt, name, from, to, value, executedAt)
+	}
+
+	op := a.addAnnotations.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.name = name
+	op.from = from
+	op.to = to
+	op.value = value
+	op.executedAt = executedAt
+	return op
+}
+
+// NewRemoveAnnotation is like NewRemoveAnnotation, but allocates the operation from this arena.
+func (a *Arena) NewRemoveAnnotation(
+	parentCreatedAt *time.Ticket,
+	name string,
+	executedAt *time.Ticket,
+) *RemoveAnnotation {
+	if a == nil {
+		return NewRemoveAnnotation(parentCreatedAt, name, executedAt)
+	}
+
+	op := a.removeAnnotations.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.name = name
+	op.executedAt = executedAt
+	return op
+}
+
+// NewIncrease is like NewIncrease, but allocates the operation from this arena.
+func (a *Arena) NewIncrease(
+	parentCreatedAt *time.Ticket,
+	value crdt.Element,
+	executedAt *time.Ticket,
+) *Increase {
+	if a == nil {
+		return NewIncrease(parentCreatedAt, value, executedAt)
+	}
+
+	op := a.increases.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.value = value
+	op.executedAt = executedAt
+	return op
+}
+
+// NewSetAdd is like NewSetAdd, but allocates the operation from this arena.
+func (a *Arena) NewSetAdd(
+	parentCreatedAt *time.Ticket,
+	value crdt.Element,
+	executedAt *time.Ticket,
+) *SetAdd {
+	if a == nil {
+		return NewSetAdd(parentCreatedAt, value, executedAt)
+	}
+
+	op := a.setAdds.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.value = value
+	op.executedAt = executedAt
+	return op
+}
+
+// NewSetRemove is like NewSetRemove, but allocates the operation from this arena.
+func (a *Arena) NewSetRemove(
+	parentCreatedAt *time.Ticket,
+	value crdt.Element,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	executedAt *time.Ticket,
+) *SetRemove {
+	if a == nil {
+		return NewSetRemove(parentCreatedAt, value, latestCreatedAtMapByActor, executedAt)
+	}
+
+	op := a.setRemoves.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.value = value
+	op.latestCreatedAtMapByActor = latestCreatedAtMapByActor
+	op.executedAt = executedAt
+	return op
+}
+
+// NewTreeEdit is like NewTreeEdit, but allocates the operation from this arena.
+func (a *Arena) NewTreeEdit(
+	parentCreatedAt *time.Ticket,
+	from *crdt.TreePos,
+	to *crdt.TreePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	contents []*crdt.TreeNode,
+	executedAt *time.Ticket,
+) *TreeEdit {
+	if a == nil {
+		return NewTreeEdit(parentCreatedAt, from, to, latestCreatedAtMapByActor, contents, executedAt)
+	}
+
+	op := a.treeEdits.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.from = from
+	op.to = to
+	op.latestCreatedAtMapByActor = latestCreatedAtMapByActor
+	op.contents = contents
+	op.executedAt = executedAt
+	return op
+}
+
+// NewTreeStyle is like NewTreeStyle, but allocates the operation from this arena.
+func (a *Arena) NewTreeStyle(
+	parentCreatedAt *time.Ticket,
+	from *crdt.TreePos,
+	to *crdt.TreePos,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) *TreeStyle {
+	if a == nil {
+		return NewTreeStyle(parentCreatedAt, from, to, attributes, executedAt)
+	}
+
+	op := a.treeStyles.Alloc()
+	op.parentCreatedAt = parentCreatedAt
+	op.from = from
+	op.to = to
+	op.attributes = attributes
+	op.executedAt = executedAt
+	return op
+}
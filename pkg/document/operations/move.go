@@ -26,7 +26,8 @@ type Move struct {
 	// parentCreatedAt is the creation time of the Array that executes Move.
 	parentCreatedAt *time.Ticket
 
-	// prevCreatedAt is the creation time of the previous element.
+	// prevCreatedAt is the position of the previous element. It is the
+	// creation time of the element unless the element has been moved.
 	prevCreatedAt *time.Ticket
 
 	// createdAt is the creation time of the target element to move.
@@ -60,7 +61,12 @@ func (o *Move) Execute(root *crdt.Root) error {
 		return ErrNotApplicableDataType
 	}
 
-	return obj.MoveAfter(o.prevCreatedAt, o.createdAt, o.executedAt)
+	if err := obj.MoveAfter(o.prevCreatedAt, o.createdAt, o.executedAt); err != nil {
+		return err
+	}
+	root.RegisterElementHasRemovedNodes(obj)
+
+	return nil
 }
 
 // CreatedAt returns the creation time of the target element.
@@ -83,7 +89,7 @@ func (o *Move) SetActor(actorID *time.ActorID) {
 	o.executedAt = o.executedAt.SetActorID(actorID)
 }
 
-// PrevCreatedAt returns the creation time of previous element.
+// PrevCreatedAt returns the position of the previous element.
 func (o *Move) PrevCreatedAt() *time.Ticket {
 	return o.prevCreatedAt
 }
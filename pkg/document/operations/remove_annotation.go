@@ -0,0 +1,80 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// RemoveAnnotation is an operation that removes a named annotation of Text.
+type RemoveAnnotation struct {
+	// parentCreatedAt is the creation time of the Text that executes
+	// RemoveAnnotation.
+	parentCreatedAt *time.Ticket
+
+	// name is the name of the annotation to remove.
+	name string
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewRemoveAnnotation creates a new instance of RemoveAnnotation.
+func NewRemoveAnnotation(
+	parentCreatedAt *time.Ticket,
+	name string,
+	executedAt *time.Ticket,
+) *RemoveAnnotation {
+	return &RemoveAnnotation{
+		parentCreatedAt: parentCreatedAt,
+		name:            name,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (e *RemoveAnnotation) Execute(root *crdt.Root) error {
+	parent := root.FindByCreatedAt(e.parentCreatedAt)
+	obj, ok := parent.(*crdt.Text)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	obj.RemoveAnnotation(e.name, e.executedAt)
+	return nil
+}
+
+// Name returns the name of the annotation.
+func (e *RemoveAnnotation) Name() string {
+	return e.name
+}
+
+// ExecutedAt returns execution time of this operation.
+func (e *RemoveAnnotation) ExecutedAt() *time.Ticket {
+	return e.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (e *RemoveAnnotation) SetActor(actorID *time.ActorID) {
+	e.executedAt = e.executedAt.SetActorID(actorID)
+}
+
+// ParentCreatedAt returns the creation time of the Text.
+func (e *RemoveAnnotation) ParentCreatedAt() *time.Ticket {
+	return e.parentCreatedAt
+}
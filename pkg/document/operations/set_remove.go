@@ -0,0 +1,97 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// SetRemove is an operation that removes a value from Set.
+type SetRemove struct {
+	// parentCreatedAt is the creation time of the Set that executes
+	// SetRemove.
+	parentCreatedAt *time.Ticket
+
+	// value is the value to remove.
+	value crdt.Element
+
+	// latestCreatedAtMapByActor is a map that stores the latest creation time
+	// by actor for the tags of the value observed by the removal.
+	latestCreatedAtMapByActor map[string]*time.Ticket
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewSetRemove creates a new instance of SetRemove.
+func NewSetRemove(
+	parentCreatedAt *time.Ticket,
+	value crdt.Element,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	executedAt *time.Ticket,
+) *SetRemove {
+	return &SetRemove{
+		parentCreatedAt:           parentCreatedAt,
+		value:                     value,
+		latestCreatedAtMapByActor: latestCreatedAtMapByActor,
+		executedAt:                executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (o *SetRemove) Execute(root *crdt.Root) error {
+	parent := root.FindByCreatedAt(o.parentCreatedAt)
+	set, ok := parent.(*crdt.Set)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	value, ok := o.value.(*crdt.Primitive)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	set.Delete(value, o.latestCreatedAtMapByActor)
+	return nil
+}
+
+// Value returns the value of this operation.
+func (o *SetRemove) Value() crdt.Element {
+	return o.value
+}
+
+// CreatedAtMapByActor returns the map that stores the latest creation time
+// by actor for the tags of the value observed by the removal.
+func (o *SetRemove) CreatedAtMapByActor() map[string]*time.Ticket {
+	return o.latestCreatedAtMapByActor
+}
+
+// ParentCreatedAt returns the creation time of the Set.
+func (o *SetRemove) ParentCreatedAt() *time.Ticket {
+	return o.parentCreatedAt
+}
+
+// ExecutedAt returns execution time of this operation.
+func (o *SetRemove) ExecutedAt() *time.Ticket {
+	return o.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (o *SetRemove) SetActor(actorID *time.ActorID) {
+	o.executedAt = o.executedAt.SetActorID(actorID)
+}
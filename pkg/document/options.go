@@ -0,0 +1,47 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Option configures Options.
+type Option func(*Options)
+
+// Options configures how we set up the document.
+type Options struct {
+	// ActorID is the ID of the actor who edits the document until the document
+	// is attached to a client, which sets the actor to the ID of the client.
+	ActorID *time.ActorID
+
+	// LamportSource issues the lamport timestamps of the local changes of the
+	// document. If it is nil, the timestamps are increased one by one.
+	LamportSource change.LamportSource
+}
+
+// WithActorID configures the ID of the actor who edits the document.
+func WithActorID(actorID *time.ActorID) Option {
+	return func(o *Options) { o.ActorID = actorID }
+}
+
+// WithLamportSource configures the source of the lamport timestamps of the
+// local changes of the document.
+func WithLamportSource(source change.LamportSource) Option {
+	return func(o *Options) { o.LamportSource = source }
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// pathWildcard is the segment of a path filter that matches any key or index.
+const pathWildcard = "*"
+
+// NormalizePathFilter converts the given path filter into the form of
+// "$.key.*.key". Both "$.rows[*].cells" and "$.rows.*.cells" are accepted.
+func NormalizePathFilter(filter string) (string, error) {
+	normalized := strings.NewReplacer("[", ".", "]", "").Replace(filter)
+	if err := ValidatePath(normalized); err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// MatchPathFilter returns whether a change with the given affected paths has
+// to be delivered to a client subscribing to the given normalized filter. A
+// change matches if one of its paths is an ancestor or a descendant of the
+// filter. Changes without paths, such as the ones on the elements that are no
+// longer reachable, always match since the affected elements are unknown.
+func MatchPathFilter(filter string, affectedPaths []string) bool {
+	if filter == "" || filter == "$" || len(affectedPaths) == 0 {
+		return true
+	}
+
+	filterSegments := strings.Split(filter, ".")
+	for _, path := range affectedPaths {
+		if matchSegments(filterSegments, strings.Split(path, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchSegments compares the common prefix of the given segments.
+func matchSegments(filter, path []string) bool {
+	for i := 0; i < len(filter) && i < len(path); i++ {
+		if filter[i] != pathWildcard && filter[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyChangeWithPaths applies the given change to this document and returns
+// the paths of the elements affected by its operations. Set and Remove
+// operations are tagged with the path of the member they set or remove, and
+// others with the path of their target element.
+func (d *InternalDocument) ApplyChangeWithPaths(c *change.Change) ([]string, error) {
+	// NOTE: The paths of the removed elements are found before the change is
+	// applied since they are not reachable after it, and the others after it
+	// since they may be created by the preceding operations of the change.
+	var removedAts []*time.Ticket
+	for _, op := range c.Operations() {
+		if remove, ok := op.(*operations.Remove); ok {
+			removedAts = append(removedAts, remove.CreatedAt())
+		}
+	}
+	removedPaths := d.root.FindPaths(removedAts)
+
+	if _, err := d.ApplyChanges(c); err != nil {
+		return nil, err
+	}
+
+	var createdAts []*time.Ticket
+	for _, op := range c.Operations() {
+		createdAts = append(createdAts, op.ParentCreatedAt())
+	}
+	paths := d.root.FindPaths(createdAts)
+
+	var affected []string
+	seen := make(map[string]bool)
+	for _, op := range c.Operations() {
+		var path string
+		var ok bool
+		switch op := op.(type) {
+		case *operations.Remove:
+			path, ok = removedPaths[op.CreatedAt().Key()]
+		case *operations.Set:
+			path, ok = paths[op.ParentCreatedAt().Key()]
+			path += "." + op.Key()
+		default:
+			path, ok = paths[op.ParentCreatedAt().Key()]
+		}
+		if !ok || seen[path] {
+			continue
+		}
+
+		seen[path] = true
+		affected = append(affected, path)
+	}
+
+	return affected, nil
+}
+
+// PruneByPathFilter removes the members of the objects in the given root that
+// are neither ancestors nor descendants of the given normalized filter, so
+// that the snapshot for a client subscribing to the filter carries only the
+// subtree of the filter. The elements of arrays are kept even if they do not
+// match, since the operations on the arrays refer to their positions.
+func PruneByPathFilter(root *crdt.Root, filter string) error {
+	if filter == "" || filter == "$" {
+		return nil
+	}
+
+	return pruneByPathFilter(root, root.Object(), strings.Split(filter, "."), 1)
+}
+
+// pruneByPathFilter prunes the descendants of the given element at the given
+// depth of the filter.
+func pruneByPathFilter(root *crdt.Root, elem crdt.Element, filter []string, depth int) error {
+	if depth >= len(filter) {
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		for k, member := range elem.Members() {
+			if filter[depth] == pathWildcard || filter[depth] == k {
+				if err := pruneByPathFilter(root, member, filter, depth+1); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := elem.Purge(member); err != nil {
+				return err
+			}
+			root.DeregisterElement(member)
+			if container, ok := member.(crdt.Container); ok {
+				container.Descendants(func(elem crdt.Element, parent crdt.Container) bool {
+					root.DeregisterElement(elem)
+					return false
+				})
+			}
+		}
+	case *crdt.Array:
+		var err error
+		_ = elem.Iterate(0, func(idx int, child crdt.Element) bool {
+			if filter[depth] != pathWildcard && filter[depth] != strconv.Itoa(idx) {
+				return false
+			}
+			err = pruneByPathFilter(root, child, filter, depth+1)
+			return err != nil
+		})
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"sync/atomic"
+	gotime "time"
+)
+
+// Stats is the time that a document has spent in each phase of applying local
+// updates and remote changes since the stats were enabled.
+type Stats struct {
+	// Updates is the number of local updates.
+	Updates int64
+
+	// RemoteChanges is the number of remote changes applied.
+	RemoteChanges int64
+
+	// Generation is the time spent in running the updaters of local updates
+	// to generate operations.
+	Generation gotime.Duration
+
+	// Mutation is the time spent in executing local updates and remote
+	// changes on the root of the document.
+	Mutation gotime.Duration
+
+	// EventEmission is the time spent in sending the events of remote changes
+	// to the events channel, including waiting for the receiver.
+	EventEmission gotime.Duration
+}
+
+// phase is a phase of applying local updates and remote changes.
+type phase int
+
+const (
+	phaseGeneration phase = iota
+	phaseMutation
+	phaseEventEmission
+	phaseCount
+)
+
+// statsRecorder records the stats of a document. A nil recorder records
+// nothing, so the document pays nothing when the stats are not enabled.
+type statsRecorder struct {
+	updates       atomic.Int64
+	remoteChanges atomic.Int64
+	durations     [phaseCount]atomic.Int64
+}
+
+// now returns the current time if this recorder is enabled.
+func (r *statsRecorder) now() gotime.Time {
+	if r == nil {
+		return gotime.Time{}
+	}
+	return gotime.Now()
+}
+
+// observe adds the time elapsed since the given start to the given phase.
+func (r *statsRecorder) observe(p phase, start gotime.Time) {
+	if r == nil {
+		return
+	}
+	r.durations[p].Add(int64(gotime.Since(start)))
+}
+
+// countUpdate counts a local update.
+func (r *statsRecorder) countUpdate() {
+	if r == nil {
+		return
+	}
+	r.updates.Add(1)
+}
+
+// countRemoteChanges counts the given number of remote changes.
+func (r *statsRecorder) countRemoteChanges(n int) {
+	if r == nil {
+		return
+	}
+	r.remoteChanges.Add(int64(n))
+}
+
+// stats returns the stats recorded so far.
+func (r *statsRecorder) stats() Stats {
+	if r == nil {
+		return Stats{}
+	}
+	return Stats{
+		Updates:       r.updates.Load(),
+		RemoteChanges: r.remoteChanges.Load(),
+		Generation:    gotime.Duration(r.durations[phaseGeneration].Load()),
+		Mutation:      gotime.Duration(r.durations[phaseMutation].Load()),
+		EventEmission: gotime.Duration(r.durations[phaseEventEmission].Load()),
+	}
+}
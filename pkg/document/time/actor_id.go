@@ -29,25 +29,23 @@ const actorIDSize = 12
 
 var (
 	// InitialActorID represents the initial value of ActorID.
-	InitialActorID = &ActorID{}
+	InitialActorID = newActorID([actorIDSize]byte{})
 
 	// MaxActorID represents the maximum value of ActorID.
-	MaxActorID = &ActorID{
-		bytes: [actorIDSize]byte{
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-		},
-	}
+	MaxActorID = newActorID([actorIDSize]byte{
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+		math.MaxUint8,
+	})
 
 	// ErrInvalidHexString is returned when the given string is not valid hex.
 	ErrInvalidHexString = errors.New("invalid hex string")
@@ -61,9 +59,19 @@ var (
 type ActorID struct {
 	bytes [actorIDSize]byte
 
+	// cachedString is the hexadecimal string of the bytes. It is computed when
+	// the ID is created, so that the ID can be read by multiple goroutines.
 	cachedString string
 }
 
+// newActorID creates a new instance of ActorID with the given bytes.
+func newActorID(bytes [actorIDSize]byte) *ActorID {
+	return &ActorID{
+		bytes:        bytes,
+		cachedString: hex.EncodeToString(bytes[:]),
+	}
+}
+
 // ActorIDFromHex returns the bytes represented by the hexadecimal string str.
 func ActorIDFromHex(str string) (*ActorID, error) {
 	actorID := &ActorID{}
@@ -81,8 +89,9 @@ func ActorIDFromHex(str string) (*ActorID, error) {
 		return actorID, fmt.Errorf("decoded length %d: %w", len(decoded), ErrInvalidHexString)
 	}
 
-	copy(actorID.bytes[:], decoded[:actorIDSize])
-	return actorID, nil
+	var bytes [actorIDSize]byte
+	copy(bytes[:], decoded[:actorIDSize])
+	return newActorID(bytes), nil
 }
 
 // ActorIDFromBytes returns the bytes represented by the bytes of decoded hexadecimal string itself.
@@ -97,15 +106,16 @@ func ActorIDFromBytes(bytes []byte) (*ActorID, error) {
 		return actorID, fmt.Errorf("bytes length %d: %w", len(bytes), ErrInvalidActorID)
 	}
 
-	copy(actorID.bytes[:], bytes)
-	return actorID, nil
+	var id [actorIDSize]byte
+	copy(id[:], bytes)
+	return newActorID(id), nil
 }
 
 // String returns the hexadecimal encoding of ActorID.
 // If the receiver is nil, it would return empty string.
 func (id *ActorID) String() string {
 	if id.cachedString == "" {
-		id.cachedString = hex.EncodeToString(id.bytes[:])
+		return hex.EncodeToString(id.bytes[:])
 	}
 
 	return id.cachedString
@@ -148,5 +158,6 @@ func (id *ActorID) UnmarshalJSON(bytes []byte) error {
 	}
 
 	id.bytes = temp.Bytes
+	id.cachedString = hex.EncodeToString(id.bytes[:])
 	return nil
 }
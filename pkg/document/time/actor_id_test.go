@@ -3,6 +3,7 @@ package time_test
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,4 +57,36 @@ func TestActorID(t *testing.T) {
 		_, err := time.ActorIDFromBytes(invalidBytes)
 		assert.ErrorIs(t, err, time.ErrInvalidActorID)
 	})
+
+	t.Run("read ActorID concurrently test", func(t *testing.T) {
+		bytes := make([]byte, 12)
+		_, err := rand.Read(bytes)
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytes)
+		assert.NoError(t, err)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Equal(t, hex.EncodeToString(bytes), actorID.String())
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("decode ActorID with arena test", func(t *testing.T) {
+		bytes := make([]byte, 12)
+		_, err := rand.Read(bytes)
+		assert.NoError(t, err)
+
+		a := time.NewArena()
+		id1, err := a.ActorIDFromBytes(bytes)
+		assert.NoError(t, err)
+		id2, err := a.ActorIDFromBytes(bytes)
+		assert.NoError(t, err)
+		assert.Same(t, id1, id2)
+		assert.Equal(t, hex.EncodeToString(bytes), id1.String())
+	})
 }
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package time
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/arena"
+)
+
+// Arena allocates the tickets and the actor IDs decoded from a document. It
+// should be created for each decoding of a document so that the memory of a
+// document is not pinned by the others. A nil Arena allocates them one by
+// one.
+type Arena struct {
+	tickets arena.Arena[Ticket]
+
+	// actorIDs holds the actor IDs decoded so far. The tickets of a document
+	// are issued by a few actors, so they share the same ActorID.
+	actorIDs map[[actorIDSize]byte]*ActorID
+}
+
+// NewArena creates a new instance of Arena.
+func NewArena() *Arena {
+	return &Arena{
+		actorIDs: make(map[[actorIDSize]byte]*ActorID),
+	}
+}
+
+// NewTicket is like NewTicket, but allocates the ticket from this arena.
+func (a *Arena) NewTicket(
+	lamport int64,
+	delimiter uint32,
+	actorID *ActorID,
+) *Ticket {
+	if a == nil {
+		return NewTicket(lamport, delimiter, actorID)
+	}
+
+	ticket := a.tickets.Alloc()
+	ticket.lamport = lamport
+	ticket.delimiter = delimiter
+	ticket.actorID = actorID
+	return ticket
+}
+
+// ActorIDFromBytes is like ActorIDFromBytes, but returns the same ActorID for
+// the same bytes decoded by this arena.
+func (a *Arena) ActorIDFromBytes(bytes []byte) (*ActorID, error) {
+	if a == nil || len(bytes) != actorIDSize {
+		return ActorIDFromBytes(bytes)
+	}
+
+	var key [actorIDSize]byte
+	copy(key[:], bytes)
+	if actorID, ok := a.actorIDs[key]; ok {
+		return actorID, nil
+	}
+
+	actorID := newActorID(key)
+	a.actorIDs[key] = actorID
+	return actorID, nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrPathNotFound is returned when the given path does not exist in the view.
+	ErrPathNotFound = errors.New("path not found")
+
+	// ErrInvalidPath is returned when the given path is not in the form of
+	// "$.key.0".
+	ErrInvalidPath = errors.New("invalid path")
+)
+
+// View is an immutable view of the root of a document at a point in time.
+// Since it is never modified, it can be read from multiple goroutines while
+// the document is being updated.
+type View struct {
+	version uint64
+	json    string
+
+	decodeOnce sync.Once
+	decoded    interface{}
+	decodeErr  error
+}
+
+func newView(version uint64, json string) *View {
+	return &View{
+		version: version,
+		json:    json,
+	}
+}
+
+// ValidatePath validates the given path of Lookup.
+func ValidatePath(path string) error {
+	if path == "$" {
+		return nil
+	}
+	if !strings.HasPrefix(path, "$.") {
+		return fmt.Errorf("%s: %w", path, ErrInvalidPath)
+	}
+
+	for _, segment := range strings.Split(path, ".")[1:] {
+		if segment == "" {
+			return fmt.Errorf("%s: empty segment: %w", path, ErrInvalidPath)
+		}
+	}
+
+	return nil
+}
+
+// Marshal returns the JSON encoding of the root of this view.
+func (v *View) Marshal() string {
+	return v.json
+}
+
+// Lookup returns the value at the given path of this view. The path is in the
+// form of "$.key.0", where keys select members of objects and indexes select
+// elements of arrays. Objects are returned as map[string]interface{}, arrays
+// as []interface{} and numbers as json.Number. They are shared by the callers
+// and must not be modified.
+func (v *View) Lookup(path string) (interface{}, error) {
+	v.decodeOnce.Do(func() {
+		decoder := gojson.NewDecoder(strings.NewReader(v.json))
+		decoder.UseNumber()
+		v.decodeErr = decoder.Decode(&v.decoded)
+	})
+	if v.decodeErr != nil {
+		return nil, fmt.Errorf("decode view: %w", v.decodeErr)
+	}
+
+	if path != "$" && !strings.HasPrefix(path, "$.") {
+		return nil, fmt.Errorf("%s: %w", path, ErrPathNotFound)
+	}
+
+	current := v.decoded
+	for _, segment := range strings.Split(path, ".")[1:] {
+		switch value := current.(type) {
+		case map[string]interface{}:
+			elem, ok := value[segment]
+			if !ok {
+				return nil, fmt.Errorf("%s: %w", path, ErrPathNotFound)
+			}
+			current = elem
+		case []interface{}:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(value) {
+				return nil, fmt.Errorf("%s: %w", path, ErrPathNotFound)
+			}
+			current = value[idx]
+		default:
+			return nil, fmt.Errorf("%s: %w", path, ErrPathNotFound)
+		}
+	}
+
+	return current, nil
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package jwks provides the JSON Web Key Sets(RFC 7517) to verify the
+// signatures of JWTs.
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+)
+
+var (
+	// ErrKeyNotFound is returned when the key of the given ID is not in the set.
+	ErrKeyNotFound = errors.New("key not found in the key set")
+
+	// ErrUnsupportedKey is returned when the type or the curve of a key is
+	// not supported.
+	ErrUnsupportedKey = errors.New("unsupported key")
+)
+
+// jsonWebKey is a JSON Web Key. Only the public keys of RSA and EC are used.
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+
+	// N and E are the modulus and the exponent of an RSA key.
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// Curve, X and Y are the curve and the coordinates of an EC key.
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+// KeySet is a set of the public keys by their key IDs.
+type KeySet struct {
+	keys map[string]crypto.PublicKey
+}
+
+// Parse parses the given JSON Web Key Set. The keys that are not for
+// signatures or not supported are skipped.
+func Parse(reader io.Reader) (*KeySet, error) {
+	var raw struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(reader).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("decode key set: %w", err)
+	}
+
+	set := &KeySet{keys: make(map[string]crypto.PublicKey)}
+	for _, jwk := range raw.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if errors.Is(err, ErrUnsupportedKey) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		set.keys[jwk.KeyID] = key
+	}
+
+	return set, nil
+}
+
+// Fetch fetches the JSON Web Key Set from the given URL.
+func Fetch(ctx context.Context, url string) (*KeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch key set: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch key set: unexpected status code %d", resp.StatusCode)
+	}
+
+	return Parse(resp.Body)
+}
+
+// Key returns the public key of the given key ID. If the ID is empty and the
+// set has only one key, the key is returned.
+func (s *KeySet) Key(kid string) (crypto.PublicKey, error) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, nil
+		}
+	}
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", kid, ErrKeyNotFound)
+	}
+
+	return key, nil
+}
+
+// Len returns the number of the keys in this set.
+func (s *KeySet) Len() int {
+	return len(s.keys)
+}
+
+// publicKey returns the public key of this JSON Web Key.
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("curve %s: %w", k.Curve, ErrUnsupportedKey)
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("key type %s: %w", k.KeyType, ErrUnsupportedKey)
+	}
+}
+
+// decodeBigInt decodes the given base64url-encoded big-endian integer.
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode key parameter: %w", err)
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jwks_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/jwks"
+)
+
+const keySet = `{
+  "keys": [
+    {"kty": "RSA", "kid": "rsa", "use": "sig", "n": "sXchDaQebHnPiGvyDOAT4saGEUetSyo9MKLOoWFsueri23bOdgWp4Dy1WlUzewbgBHod5pcM9H95GQRV3JDXboIRROSBigeC5yjU1hGzHHyXss8UDprecbAYxknTcQkhslANGRUZmdTOQ5qTRsLAt6BTYuyvVRdhS8exSZEy_c4gs_7svlJJQ4H9_NxsiIoLwAEk7-Q3UXERGYw_75IDrGA84-lA_-Ct4eTlXHBIY2EaV7t7LjJaynVJCpkv4LKjTTAumiGUIuQhrNhZLuF_RJLqHpM2kgWFLU7-VTdL1VbC2tejvcI2BlMkEpk1BzBZI0KQB0GaDWFLN-aEAw3vRw", "e": "AQAB"},
+    {"kty": "EC", "kid": "ec", "crv": "P-256", "x": "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU", "y": "x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"},
+    {"kty": "RSA", "kid": "enc", "use": "enc", "n": "AQAB", "e": "AQAB"},
+    {"kty": "oct", "kid": "oct", "k": "c2VjcmV0"}
+  ]
+}`
+
+func TestKeySet(t *testing.T) {
+	t.Run("parse test", func(t *testing.T) {
+		set, err := jwks.Parse(strings.NewReader(keySet))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, set.Len())
+
+		key, err := set.Key("rsa")
+		assert.NoError(t, err)
+		assert.IsType(t, &rsa.PublicKey{}, key)
+		assert.Equal(t, 65537, key.(*rsa.PublicKey).E)
+
+		key, err = set.Key("ec")
+		assert.NoError(t, err)
+		assert.IsType(t, &ecdsa.PublicKey{}, key)
+
+		_, err = set.Key("enc")
+		assert.ErrorIs(t, err, jwks.ErrKeyNotFound)
+		_, err = set.Key("oct")
+		assert.ErrorIs(t, err, jwks.ErrKeyNotFound)
+		_, err = set.Key("")
+		assert.ErrorIs(t, err, jwks.ErrKeyNotFound)
+	})
+
+	t.Run("key without id test", func(t *testing.T) {
+		set, err := jwks.Parse(strings.NewReader(`{"keys": [{"kty": "RSA", "n": "AQAB", "e": "AQAB"}]}`))
+		assert.NoError(t, err)
+
+		_, err = set.Key("")
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid key set test", func(t *testing.T) {
+		_, err := jwks.Parse(strings.NewReader(`{"keys": [{"kty": "RSA", "n": "!", "e": "AQAB"}]}`))
+		assert.Error(t, err)
+
+		_, err = jwks.Parse(strings.NewReader(`not json`))
+		assert.Error(t, err)
+	})
+}
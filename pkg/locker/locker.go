@@ -160,3 +160,28 @@ func (l *Locker) Unlock(name string) error {
 	l.mu.Unlock()
 	return nil
 }
+
+// Count returns the number of callers holding or waiting for the lock of the
+// given name.
+func (l *Locker) Count(name string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	nameLock, ok := l.locks[name]
+	if !ok {
+		return 0
+	}
+	return int(nameLock.count()) + 1
+}
+
+// Counts returns the number of callers holding or waiting for each lock.
+func (l *Locker) Counts() map[string]int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	counts := make(map[string]int, len(l.locks))
+	for name, nameLock := range l.locks {
+		counts[name] = int(nameLock.count()) + 1
+	}
+	return counts
+}
@@ -165,3 +165,31 @@ func TestTryLock(t *testing.T) {
 		}
 	}
 }
+
+func TestLockerCounts(t *testing.T) {
+	l := New()
+	assert.Empty(t, l.Counts())
+
+	assert.Equal(t, 0, l.Count("test"))
+
+	l.Lock("test")
+	assert.Equal(t, map[string]int{"test": 1}, l.Counts())
+	assert.Equal(t, 1, l.Count("test"))
+
+	chDone := make(chan struct{})
+	go func() {
+		l.Lock("test")
+		close(chDone)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return l.Count("test") == 2
+	}, 3*time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, l.Unlock("test"))
+	<-chDone
+	assert.Equal(t, map[string]int{"test": 1}, l.Counts())
+
+	assert.NoError(t, l.Unlock("test"))
+	assert.Empty(t, l.Counts())
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ratelimit provides a rate limiter based on the token bucket
+// algorithm.
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/yorkie-team/yorkie/pkg/cache"
+	"github.com/yorkie-team/yorkie/pkg/clock"
+)
+
+var (
+	// ErrInvalidRate is returned when the given rate is not positive.
+	ErrInvalidRate = errors.New("rate must be > 0")
+
+	// ErrInvalidBurst is returned when the given burst is negative.
+	ErrInvalidBurst = errors.New("burst must be >= 0")
+)
+
+// bucket is the token bucket of a key.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter limits the number of events per second of each key. Each key has
+// its own bucket that holds up to burst tokens and is refilled at the rate.
+// Buckets of the keys that are not used recently are evicted.
+type Limiter struct {
+	clock clock.Clock
+	rate  float64
+	burst float64
+
+	lock    sync.Mutex
+	buckets *cache.LRUExpireCache[string, *bucket]
+}
+
+// New creates a new instance of Limiter that allows rate events per second
+// with bursts of at most burst events for each key. If burst is zero, it is
+// derived from the rate. maxKeys is the maximum number of keys to track.
+func New(clk clock.Clock, rate float64, burst int, maxKeys int) (*Limiter, error) {
+	if rate <= 0 {
+		return nil, ErrInvalidRate
+	}
+	if burst < 0 {
+		return nil, ErrInvalidBurst
+	}
+	if burst == 0 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+
+	buckets, err := cache.NewLRUExpireCacheWithClock[string, *bucket](maxKeys, clk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Limiter{
+		clock:   clk,
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: buckets,
+	}, nil
+}
+
+// Allow reports whether an event of the given key may happen now. If not, it
+// returns the duration to wait until the next event is allowed.
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.clock.Now()
+	b, ok := l.buckets.Get(key)
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+	}
+
+	// 01. Refill the bucket with the tokens accumulated since the last event.
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(l.burst, b.tokens+elapsed.Seconds()*l.rate)
+		b.last = now
+	}
+
+	// 02. Take a token if there is one.
+	allowed := b.tokens >= 1
+	var wait time.Duration
+	if allowed {
+		b.tokens--
+	} else {
+		wait = l.durationOf(1 - b.tokens)
+	}
+
+	// NOTE: A bucket is full again after the duration below, so it can be
+	// evicted then without changing the result of the next event.
+	l.buckets.Add(key, b, l.durationOf(l.burst-b.tokens))
+
+	return allowed, wait
+}
+
+// durationOf returns the duration to accumulate the given number of tokens.
+func (l *Limiter) durationOf(tokens float64) time.Duration {
+	return time.Duration(math.Ceil(tokens / l.rate * float64(time.Second)))
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/clock"
+	"github.com/yorkie-team/yorkie/pkg/ratelimit"
+)
+
+func TestLimiter(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("invalid arguments test", func(t *testing.T) {
+		_, err := ratelimit.New(clock.NewFake(start), 0, 1, 10)
+		assert.ErrorIs(t, err, ratelimit.ErrInvalidRate)
+
+		_, err = ratelimit.New(clock.NewFake(start), 1, -1, 10)
+		assert.ErrorIs(t, err, ratelimit.ErrInvalidBurst)
+	})
+
+	t.Run("burst and refill test", func(t *testing.T) {
+		clk := clock.NewFake(start)
+		limiter, err := ratelimit.New(clk, 2, 3, 10)
+		assert.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			allowed, _ := limiter.Allow("a")
+			assert.True(t, allowed)
+		}
+		allowed, wait := limiter.Allow("a")
+		assert.False(t, allowed)
+		assert.Equal(t, 500*time.Millisecond, wait)
+
+		// other keys have their own buckets.
+		allowed, _ = limiter.Allow("b")
+		assert.True(t, allowed)
+
+		clk.Advance(500 * time.Millisecond)
+		allowed, _ = limiter.Allow("a")
+		assert.True(t, allowed)
+		allowed, _ = limiter.Allow("a")
+		assert.False(t, allowed)
+
+		// the bucket does not hold more than the burst.
+		clk.Advance(time.Hour)
+		for i := 0; i < 3; i++ {
+			allowed, _ = limiter.Allow("a")
+			assert.True(t, allowed)
+		}
+		allowed, _ = limiter.Allow("a")
+		assert.False(t, allowed)
+	})
+
+	t.Run("default burst test", func(t *testing.T) {
+		limiter, err := ratelimit.New(clock.NewFake(start), 0.5, 0, 10)
+		assert.NoError(t, err)
+
+		allowed, _ := limiter.Allow("a")
+		assert.True(t, allowed)
+		allowed, wait := limiter.Allow("a")
+		assert.False(t, allowed)
+		assert.Equal(t, 2*time.Second, wait)
+	})
+}
@@ -26,6 +26,15 @@ import (
 // ErrOutOfIndex is returned when the given index is out of index.
 var ErrOutOfIndex = fmt.Errorf("out of index")
 
+// maxLinearCount is the number of consecutive insertions after a node near the
+// root over which a node of the tree is splayed to rebalance it.
+const maxLinearCount = 16
+
+// goldenRatio is the fractional part of the golden ratio in 32 bits. It is
+// added to the rebalance sequence so that the splayed positions are spread
+// evenly over the tree.
+const goldenRatio = 0x9E3779B9
+
 // Value represents the data stored in the nodes of Tree.
 type Value interface {
 	Len() int
@@ -91,8 +100,19 @@ func (t *Node[V]) hasLinks() bool {
 
 // Tree is weighted binary search tree which is based on Splay tree.
 // original paper on Splay Trees: https://www.cs.cmu.edu/~sleator/papers/self-adjusting.pdf
+//
+// NOTE: Inserting nodes after a node near the root, such as typing at the end
+// of a text or inserting after the same node repeatedly, makes the tree a
+// linked list since each splay is too short to restructure it. To keep the
+// tree shallow, InsertAfter tracks the depth of the previous node and splays
+// a node spread over the tree if there are more than maxLinearCount of such
+// insertions in a row. Find and IndexOf do not modify the tree, so that
+// they can be called concurrently by readers.
 type Tree[V Value] struct {
 	root *Node[V]
+
+	linearCount  int
+	rebalanceSeq uint32
 }
 
 // NewTree creates a new instance of Tree.
@@ -109,11 +129,17 @@ func (t *Tree[V]) Insert(node *Node[V]) *Node[V] {
 		return node
 	}
 
-	return t.InsertAfter(t.root, node)
+	return t.InsertAfter(t.rightmost(), node)
 }
 
 // InsertAfter inserts the node after the given previous node.
 func (t *Tree[V]) InsertAfter(prev *Node[V], node *Node[V]) *Node[V] {
+	if depth(prev) <= 1 {
+		t.linearCount++
+	} else {
+		t.linearCount = 0
+	}
+
 	t.Splay(prev)
 	t.root = node
 	node.right = prev.right
@@ -127,9 +153,23 @@ func (t *Tree[V]) InsertAfter(prev *Node[V], node *Node[V]) *Node[V] {
 	t.UpdateWeight(prev)
 	t.UpdateWeight(node)
 
+	if t.linearCount > maxLinearCount {
+		t.rebalance()
+	}
+
 	return node
 }
 
+// rebalance splays the node at the next position of the rebalance sequence.
+func (t *Tree[V]) rebalance() {
+	t.linearCount = 0
+	t.rebalanceSeq += goldenRatio
+	index := int(uint64(t.root.weight) * uint64(t.rebalanceSeq) >> 32)
+	if node, _, err := t.Find(index); err == nil {
+		t.Splay(node)
+	}
+}
+
 // Splay moves the given node to the root.
 func (t *Tree[V]) Splay(node *Node[V]) {
 	if node == nil {
@@ -252,6 +292,12 @@ func (t *Tree[V]) CheckWeight() bool {
 	return true
 }
 
+// Height returns the height of this Tree.
+// for debugging purpose.
+func (t *Tree[V]) Height() int {
+	return height(t.root)
+}
+
 // UpdateWeight recalculates the weight of this node with the value and children.
 func (t *Tree[V]) UpdateWeight(node *Node[V]) {
 	node.InitWeight()
@@ -417,6 +463,28 @@ func traversePostorder[V Value](node *Node[V], callback func(node *Node[V])) {
 	callback(node)
 }
 
+// depth returns the number of the ancestors of the given node.
+func depth[V Value](node *Node[V]) int {
+	d := 0
+	for node.parent != nil {
+		node = node.parent
+		d++
+	}
+	return d
+}
+
+func height[V Value](node *Node[V]) int {
+	if node == nil {
+		return 0
+	}
+
+	left, right := height(node.left), height(node.right)
+	if left > right {
+		return left + 1
+	}
+	return right + 1
+}
+
 func isLeftChild[V Value](node *Node[V]) bool {
 	return node != nil && node.parent != nil && node.parent.left == node
 }
@@ -0,0 +1,45 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package splay_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/splay"
+)
+
+func BenchmarkSplayTree(b *testing.B) {
+	b.Run("random access after appending 100000 nodes test", func(b *testing.B) {
+		tree := splay.NewTree[*stringValue](nil)
+		var nodes []*splay.Node[*stringValue]
+		for i := 0; i < 100000; i++ {
+			nodes = append(nodes, tree.Insert(newSplayNode("A")))
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _, err := tree.Find(rand.Intn(len(nodes)))
+			assert.NoError(b, err)
+			tree.IndexOf(nodes[rand.Intn(len(nodes))])
+		}
+	})
+}
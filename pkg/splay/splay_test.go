@@ -17,6 +17,8 @@
 package splay_test
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -155,6 +157,53 @@ func TestSplayTree(t *testing.T) {
 		tree.Delete(node)
 		assert.Equal(t, -1, tree.IndexOf(node))
 	})
+
+	t.Run("rebalance on linear insertion test", func(t *testing.T) {
+		tree := splay.NewTree[*stringValue](nil)
+		var nodes []*splay.Node[*stringValue]
+		for i := 0; i < 1000; i++ {
+			nodes = append(nodes, tree.Insert(newSplayNode(strconv.Itoa(i % 10))))
+		}
+		assert.Less(t, tree.Height(), 100)
+		assert.True(t, tree.CheckWeight())
+		assert.Equal(t, strings.Repeat("0123456789", 100), tree.String())
+
+		// reads do not modify the tree.
+		height := tree.Height()
+		structure := tree.StructureAsString()
+		for i := 0; i < 100; i++ {
+			node, offset, err := tree.Find(i*10 + 1)
+			assert.NoError(t, err)
+			assert.Equal(t, nodes[i*10], node)
+			assert.Equal(t, 1, offset)
+			assert.Equal(t, i*10, tree.IndexOf(nodes[i*10]))
+		}
+		assert.Equal(t, height, tree.Height())
+		assert.Equal(t, structure, tree.StructureAsString())
+	})
+
+	t.Run("rebalance on insertion after the same node test", func(t *testing.T) {
+		// 01. prepend nodes by inserting them after the head.
+		tree := splay.NewTree[*stringValue](nil)
+		head := tree.Insert(newSplayNode(""))
+		for i := 0; i < 1000; i++ {
+			tree.InsertAfter(head, newSplayNode(strconv.Itoa(i%10)))
+		}
+		assert.Less(t, tree.Height(), 100)
+		assert.True(t, tree.CheckWeight())
+		assert.Equal(t, strings.Repeat("9876543210", 100), tree.String())
+
+		// 02. insert nodes after the same node in the middle.
+		tree = splay.NewTree[*stringValue](nil)
+		anchor := tree.Insert(newSplayNode("A"))
+		tree.Insert(newSplayNode("B"))
+		for i := 0; i < 1000; i++ {
+			tree.InsertAfter(anchor, newSplayNode(strconv.Itoa(i%10)))
+		}
+		assert.Less(t, tree.Height(), 100)
+		assert.True(t, tree.CheckWeight())
+		assert.Equal(t, "A"+strings.Repeat("9876543210", 100)+"B", tree.String())
+	})
 }
 
 func makeSampleTree() (*splay.Tree[*stringValue], []*splay.Node[*stringValue]) {
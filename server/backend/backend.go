@@ -26,39 +26,89 @@ import (
 	"time"
 
 	"github.com/rs/xid"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/cache"
+	"github.com/yorkie-team/yorkie/pkg/clock"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/jwks"
 	"github.com/yorkie-team/yorkie/server/backend/background"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	memdb "github.com/yorkie-team/yorkie/server/backend/database/memory"
 	"github.com/yorkie-team/yorkie/server/backend/database/mongo"
+	"github.com/yorkie-team/yorkie/server/backend/database/postgres"
+	"github.com/yorkie-team/yorkie/server/backend/delta"
+	"github.com/yorkie-team/yorkie/server/backend/eventbus"
+	"github.com/yorkie-team/yorkie/server/backend/faults"
 	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/server/backend/objectstorage"
+	"github.com/yorkie-team/yorkie/server/backend/objectstorage/s3"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	memsync "github.com/yorkie-team/yorkie/server/backend/sync/memory"
+	redissync "github.com/yorkie-team/yorkie/server/backend/sync/redis"
 	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
 )
 
+// documentMemoryMetricsLimit is the number of documents whose memory is
+// exported as metrics.
+const documentMemoryMetricsLimit = 10
+
+// SnapshotCacheKey is the key of the snapshot cache, which identifies the
+// snapshot of a document at a server seq. The project and the key of the
+// document are kept to report the memory of the documents of a project.
+type SnapshotCacheKey struct {
+	ProjectID types.ID
+	DocID     types.ID
+	DocKey    key.Key
+	ServerSeq int64
+}
+
 // Backend manages Yorkie's backend such as Database and Coordinator. And it
 // has the server status such as the information of this Server.
 type Backend struct {
 	Config     *Config
 	serverInfo *sync.ServerInfo
 
+	// Clock is the clock that the time-dependent parts of the backend read
+	// the time from.
+	Clock clock.Clock
+
 	DB           database.Database
 	Coordinator  sync.Coordinator
 	Metrics      *prometheus.Metrics
 	Background   *background.Background
 	Housekeeping *housekeeping.Housekeeping
 
+	// Cluster assigns the documents to the servers of the cluster. It is nil
+	// if the server does not run in cluster mode.
+	Cluster *Cluster
+
+	// EventBus is the bus of the lifecycle events that embedders of the
+	// server can subscribe to.
+	EventBus *eventbus.Bus
+
 	AuthWebhookCache *cache.LRUExpireCache[string, *types.AuthWebhookResponse]
+
+	// AuthJWKSCache is the cache of the JSON Web Key Sets by their URLs.
+	AuthJWKSCache *cache.LRUExpireCache[string, *jwks.KeySet]
+
+	// SnapshotCache is the cache of the snapshots built for pulling documents.
+	// It is nil if the snapshots are not cached.
+	SnapshotCache *cache.LRUExpireCache[SnapshotCacheKey, *database.SnapshotInfo]
+
+	// SnapshotBuilds shares the concurrent builds of the snapshot of a
+	// document at the same server seq.
+	SnapshotBuilds singleflight.Group
 }
 
 // New creates a new instance of Backend.
 func New(
 	conf *Config,
-	mongoConf *mongo.Config,
+	dbConf interface{},
+	objectStorageConf *objectstorage.Config,
+	redisConf *redissync.Config,
 	housekeepingConf *housekeeping.Config,
 	metrics *prometheus.Metrics,
 ) (*Backend, error) {
@@ -74,47 +124,120 @@ func New(
 	serverInfo := &sync.ServerInfo{
 		ID:        xid.New().String(),
 		Hostname:  hostname,
+		RPCAddr:   conf.ClusterRPCAddr,
 		UpdatedAt: time.Now(),
 	}
 
+	clk := conf.Clock
+	if clk == nil {
+		clk = clock.New()
+	}
+
 	bg := background.New()
 
-	var db database.Database
-	var err error
-	if mongoConf != nil {
-		db, err = mongo.Dial(mongoConf)
+	// NOTE: For backward compatibility, the database is chosen by
+	// the given config if the name of the database is not specified.
+	dbName := conf.Database
+	if dbName == "" {
+		switch dbConf.(type) {
+		case *mongo.Config:
+			dbName = mongo.Name
+		case *postgres.Config:
+			dbName = postgres.Name
+		default:
+			dbName = memdb.Name
+		}
+	}
+
+	db, err := database.New(dbName, dbConf, clk)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(hackerwins): Implement the coordinator for a shard. For now, we
+	//  distribute workloads to all shards per document. In the future, we
+	//  will need to distribute workloads of a document.
+	var coordinator sync.Coordinator = memsync.NewCoordinator(serverInfo)
+	if redisConf != nil {
+		coordinator, err = redissync.Dial(redisConf, serverInfo)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		db, err = memdb.New()
+	}
+
+	var cluster *Cluster
+	if conf.ClusterRPCAddr != "" {
+		cluster = newCluster(coordinator, serverInfo)
+	}
+
+	if conf.FaultInjector != nil {
+		db = faults.NewDatabase(db, conf.FaultInjector)
+		coordinator = faults.NewCoordinator(coordinator, conf.FaultInjector)
+	}
+
+	if objectStorageConf != nil {
+		storage, err := s3.Dial(objectStorageConf)
 		if err != nil {
 			return nil, err
 		}
+		db = objectstorage.NewDatabase(db, storage, objectStorageConf.SnapshotThreshold)
 	}
 
-	// TODO(hackerwins): Implement the coordinator for a shard. For now, we
-	//  distribute workloads to all shards per document. In the future, we
-	//  will need to distribute workloads of a document.
-	coordinator := memsync.NewCoordinator(serverInfo)
+	if conf.SnapshotMode == SnapshotModeDelta {
+		db = delta.NewDatabase(db, conf.SnapshotMaxDeltas)
+	}
+
+	authWebhookCache, err := cache.NewLRUExpireCacheWithClock[string, *types.AuthWebhookResponse](
+		conf.AuthWebhookCacheSize,
+		clk,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	authWebhookCache, err := cache.NewLRUExpireCache[string, *types.AuthWebhookResponse](conf.AuthWebhookCacheSize)
+	// NOTE: A project has at most one JSON Web Key Set, so the
+	// cache is as large as the cache of the project info.
+	authJWKSCache, err := cache.NewLRUExpireCacheWithClock[string, *jwks.KeySet](
+		conf.ProjectInfoCacheSize,
+		clk,
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	var snapshotCache *cache.LRUExpireCache[SnapshotCacheKey, *database.SnapshotInfo]
+	if conf.SnapshotCacheSize > 0 {
+		snapshotCache, err = cache.NewLRUExpireCacheWithClock[SnapshotCacheKey, *database.SnapshotInfo](
+			conf.SnapshotCacheSize,
+			clk,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := metrics.RegisterDocumentMemories(func() []*types.DocumentMemory {
+		memories := documentMemories(coordinator, snapshotCache, "")
+		return types.TopDocumentMemories(memories, documentMemoryMetricsLimit)
+	}); err != nil {
+		return nil, err
+	}
+
 	keeping, err := housekeeping.Start(
 		housekeepingConf,
 		db,
 		coordinator,
+		clk,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	dbInfo := "memory"
-	if mongoConf != nil {
+	dbInfo := dbName
+	if mongoConf, ok := dbConf.(*mongo.Config); ok {
 		dbInfo = mongoConf.ConnectionURI
+	} else if postgresConf, ok := dbConf.(*postgres.Config); ok {
+		dbInfo = postgresConf.ConnectionURI
 	}
 
 	logging.DefaultLogger().Infof(
@@ -136,20 +259,68 @@ func New(
 	return &Backend{
 		Config:     conf,
 		serverInfo: serverInfo,
+		Clock:      clk,
 
 		Background:   bg,
 		Metrics:      metrics,
 		DB:           db,
 		Coordinator:  coordinator,
 		Housekeeping: keeping,
+		Cluster:      cluster,
+		EventBus:     eventbus.New(),
 
 		AuthWebhookCache: authWebhookCache,
+		AuthJWKSCache:    authJWKSCache,
+		SnapshotCache:    snapshotCache,
 	}, nil
 }
 
+// DocumentMemories returns the approximate memory that this server holds for
+// the subscriptions and the cached snapshots of each document of the given
+// project. The documents of all the projects are returned if projectID is
+// empty.
+func (b *Backend) DocumentMemories(projectID types.ID) []*types.DocumentMemory {
+	return documentMemories(b.Coordinator, b.SnapshotCache, projectID)
+}
+
+// documentMemories returns the memories of the documents of the given project
+// held by the given coordinator and snapshot cache.
+func documentMemories(
+	coordinator sync.Coordinator,
+	snapshotCache *cache.LRUExpireCache[SnapshotCacheKey, *database.SnapshotInfo],
+	projectID types.ID,
+) []*types.DocumentMemory {
+	memories := coordinator.DocumentMemories(projectID)
+	if snapshotCache == nil {
+		return memories
+	}
+
+	memoryByID := make(map[types.ID]*types.DocumentMemory, len(memories))
+	for _, memory := range memories {
+		memoryByID[memory.ID] = memory
+	}
+	snapshotCache.Range(func(k SnapshotCacheKey, info *database.SnapshotInfo) {
+		if projectID != "" && k.ProjectID != projectID {
+			return
+		}
+
+		memory, ok := memoryByID[k.DocID]
+		if !ok {
+			memory = &types.DocumentMemory{ID: k.DocID, Key: k.DocKey}
+			memoryByID[k.DocID] = memory
+			memories = append(memories, memory)
+		}
+		memory.Snapshots++
+		memory.SnapshotBytes += info.Size
+	})
+
+	return memories
+}
+
 // Shutdown closes all resources of this instance.
 func (b *Backend) Shutdown() error {
 	b.Background.Close()
+	b.EventBus.Close()
 
 	if err := b.Housekeeping.Stop(); err != nil {
 		return err
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	gosync "sync"
+	"time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+)
+
+// clusterRefreshInterval is the interval at which the ring of the cluster is
+// rebuilt from the members of the cluster.
+const clusterRefreshInterval = time.Second
+
+// Cluster assigns the documents to the servers of the cluster by consistent
+// hashing, so that the changes of a document are stored by a single server
+// without contending with the other servers on the server seq.
+type Cluster struct {
+	coordinator sync.Coordinator
+	self        *sync.ServerInfo
+
+	mu          gosync.Mutex
+	ring        *sync.HashRing
+	refreshedAt time.Time
+}
+
+// newCluster creates an instance of Cluster.
+func newCluster(coordinator sync.Coordinator, self *sync.ServerInfo) *Cluster {
+	return &Cluster{
+		coordinator: coordinator,
+		self:        self,
+	}
+}
+
+// Owner returns the server that owns the given document. It returns nil if
+// the document is owned by this server.
+func (c *Cluster) Owner(projectID types.ID, docKey key.Key) *sync.ServerInfo {
+	owner := c.hashRing().Owner(projectID.String() + "/" + docKey.String())
+	if owner == nil || owner.ID == c.self.ID {
+		return nil
+	}
+
+	return owner
+}
+
+// Self returns the information of this server.
+func (c *Cluster) Self() *sync.ServerInfo {
+	return c.self
+}
+
+// hashRing returns the ring of the members of the cluster. The ring is
+// rebuilt if it is older than the refresh interval. The members that cannot
+// receive forwarded requests are not on the ring.
+func (c *Cluster) hashRing() *sync.HashRing {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ring != nil && time.Since(c.refreshedAt) < clusterRefreshInterval {
+		return c.ring
+	}
+
+	members := make(map[string]*sync.ServerInfo)
+	for id, info := range c.coordinator.Members() {
+		if info.RPCAddr != "" {
+			members[id] = info
+		}
+	}
+	members[c.self.ID] = c.self
+
+	c.ring = sync.NewHashRing(members)
+	c.refreshedAt = time.Now()
+	return c.ring
+}
@@ -17,13 +17,34 @@
 package backend
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"time"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/internal/version"
+	"github.com/yorkie-team/yorkie/pkg/clock"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/faults"
+)
+
+const (
+	// SnapshotModeFull stores every snapshot with the whole document.
+	SnapshotModeFull = "full"
+
+	// SnapshotModeDelta stores snapshots as the deltas from the previous
+	// snapshots, storing a full snapshot once in a while.
+	SnapshotModeDelta = "delta"
 )
 
 // Config is the configuration for creating a Backend instance.
 type Config struct {
+	// Database is the name of the database registered by database.Register,
+	// e.g. "memory" or "mongo". If it is empty, "mongo" is used when MongoDB
+	// is configured and "memory" otherwise.
+	Database string `yaml:"Database"`
+
 	// AdminUser is the name of the default admin user who has full permissions.
 	// Set once on first-run. Default is "admin".
 	AdminUser string `yaml:"AdminUser"`
@@ -55,6 +76,24 @@ type Config struct {
 	// SnapshotWithPurgingChanges is whether to delete previous changes when the snapshot is created.
 	SnapshotWithPurgingChanges bool `yaml:"SnapshotWithPurgingChages"`
 
+	// SnapshotBuildBatchSize is the number of changes that are read from the
+	// database at once when building a document from the snapshot and changes.
+	SnapshotBuildBatchSize int64 `yaml:"SnapshotBuildBatchSize"`
+
+	// SnapshotMode is the way of storing snapshots, either "full" or "delta".
+	// If it is empty, "full" is used.
+	SnapshotMode string `yaml:"SnapshotMode"`
+
+	// SnapshotMaxDeltas is the maximum number of delta snapshots in a row
+	// before a full snapshot is stored in the delta mode. It bounds the
+	// number of deltas applied to rebuild a snapshot.
+	SnapshotMaxDeltas int `yaml:"SnapshotMaxDeltas"`
+
+	// SnapshotCodec is the name of the codec that encodes the stored
+	// snapshots, either "protobuf" or "cbor". If it is empty, "protobuf" is
+	// used. The snapshots stored with other codecs are still readable.
+	SnapshotCodec string `yaml:"SnapshotCodec"`
+
 	// AuthWebhookMaxRetries is the max count that retries the authorization webhook.
 	AuthWebhookMaxRetries uint64 `yaml:"AuthWebhookMaxRetries"`
 
@@ -70,18 +109,173 @@ type Config struct {
 	// AuthWebhookCacheUnauthTTL is the TTL value to set when caching the unauthorized result.
 	AuthWebhookCacheUnauthTTL string `yaml:"AuthWebhookCacheUnauthTTL"`
 
+	// AuthJWKSCacheTTL is the TTL value to set when caching the JSON Web Key
+	// Sets of projects.
+	AuthJWKSCacheTTL string `yaml:"AuthJWKSCacheTTL"`
+
+	// EventWebhookMaxRetries is the max count that retries the event webhook.
+	EventWebhookMaxRetries uint64 `yaml:"EventWebhookMaxRetries"`
+
+	// EventWebhookMaxWaitInterval is the max interval that waits before retrying the event webhook.
+	EventWebhookMaxWaitInterval string `yaml:"EventWebhookMaxWaitInterval"`
+
 	// ProjectInfoCacheSize is the cache size of the project info.
 	ProjectInfoCacheSize int `yaml:"ProjectInfoCacheSize"`
 
 	// ProjectInfoCacheTTL is the TTL value to set when caching the project info.
 	ProjectInfoCacheTTL string `yaml:"ProjectInfoCacheTTL"`
 
+	// SnapshotCacheSize is the cache size of the snapshots built for pulling
+	// documents. If it is negative, the snapshots are not cached.
+	SnapshotCacheSize int `yaml:"SnapshotCacheSize"`
+
+	// SnapshotCacheTTL is the TTL value to set when caching the snapshot.
+	SnapshotCacheTTL string `yaml:"SnapshotCacheTTL"`
+
+	// PushPullCacheSize is the cache size of the responses of PushPull by the
+	// idempotency keys of the pushed packs. If it is negative, the responses
+	// are not cached and retried pushes are handled as new pushes.
+	PushPullCacheSize int `yaml:"PushPullCacheSize"`
+
+	// PushPullCacheTTL is the TTL value to set when caching the response of
+	// PushPull, which is the window in which a retried push is deduplicated.
+	PushPullCacheTTL string `yaml:"PushPullCacheTTL"`
+
+	// WatchHeartbeatTimeout is the duration after which the watch stream of a
+	// client that has stopped sending heartbeats is closed, so that its peers
+	// do not see it online until the connection times out. Clients that have
+	// never sent a heartbeat are not evicted. If it is empty or zero, watch
+	// streams are not evicted.
+	WatchHeartbeatTimeout string `yaml:"WatchHeartbeatTimeout"`
+
 	// Hostname is yorkie server hostname. hostname is used by metrics.
 	Hostname string `yaml:"Hostname"`
+
+	// ClusterRPCAddr is the address of the RPC server through which the other
+	// servers of the cluster forward requests to this server. If it is set,
+	// each document is owned by a server of the cluster by consistent hashing
+	// and PushPull requests are forwarded to the owner of the document.
+	ClusterRPCAddr string `yaml:"ClusterRPCAddr"`
+
+	// ClusterSecret is the secret shared by the servers of the cluster. The
+	// requests forwarded to the owners of documents are signed with it, so
+	// that clients can not pretend to be forwarded. It is required if
+	// ClusterRPCAddr is set.
+	ClusterSecret string `yaml:"ClusterSecret"`
+
+	// MinClientVersion is the minimum version of SDKs that the server accepts.
+	// Requests from SDKs below this version are rejected. If it is empty, all
+	// versions are accepted.
+	MinClientVersion string `yaml:"MinClientVersion"`
+
+	// RecommendedClientVersion is the version of SDKs that the server recommends.
+	// Requests from SDKs below this version are served with a deprecation
+	// warning. If it is empty, no warning is returned.
+	RecommendedClientVersion string `yaml:"RecommendedClientVersion"`
+
+	// PresenceEncryptionKey is the hex-encoded AES key that encrypts the
+	// values of the sensitive presence keys of projects. If it is empty,
+	// projects cannot have sensitive presence keys.
+	PresenceEncryptionKey string `yaml:"PresenceEncryptionKey"`
+
+	// MaxOperationsPerChange is the maximum number of operations in a change
+	// that the server accepts. If it is zero, it is not limited.
+	MaxOperationsPerChange int `yaml:"MaxOperationsPerChange"`
+
+	// MaxChangeDepth is the maximum nesting depth of the elements in a change
+	// that the server accepts. If it is zero, it is not limited.
+	MaxChangeDepth int `yaml:"MaxChangeDepth"`
+
+	// MaxStringLength is the maximum length in bytes of a string in an
+	// operation that the server accepts. If it is zero, it is not limited.
+	MaxStringLength int `yaml:"MaxStringLength"`
+
+	// MaxChangePackSize is the maximum size in bytes of the changes of a pack
+	// that the server accepts. If it is zero, it is not limited.
+	MaxChangePackSize int64 `yaml:"MaxChangePackSize"`
+
+	// MaxDocumentSize is the maximum size in bytes of the snapshot of a
+	// document. Pushes that would make the snapshot larger are rejected. If it
+	// is zero, it is not limited.
+	MaxDocumentSize int64 `yaml:"MaxDocumentSize"`
+
+	// MaxDocumentElements is the maximum number of elements of a document,
+	// including the removed ones not yet collected. Pushes that would make the
+	// document have more elements are rejected. If it is zero, it is not
+	// limited.
+	MaxDocumentElements int64 `yaml:"MaxDocumentElements"`
+
+	// ClientRateLimit is the number of PushPull and WatchDocument calls per
+	// second that a client can make. If it is zero, it is not limited.
+	ClientRateLimit float64 `yaml:"ClientRateLimit"`
+
+	// ClientRateLimitBurst is the maximum number of calls that a client can
+	// make at once. If it is zero, it is derived from ClientRateLimit.
+	ClientRateLimitBurst int `yaml:"ClientRateLimitBurst"`
+
+	// ProjectRateLimit is the number of PushPull, WatchDocument and
+	// ReadDocument calls per second that the clients of a project can make in
+	// total. If it is zero, it is not limited.
+	ProjectRateLimit float64 `yaml:"ProjectRateLimit"`
+
+	// ProjectRateLimitBurst is the maximum number of calls that the clients of
+	// a project can make at once. If it is zero, it is derived from
+	// ProjectRateLimit.
+	ProjectRateLimitBurst int `yaml:"ProjectRateLimitBurst"`
+
+	// FaultInjector is the injector of faults into the calls of the database
+	// and the pubsub for chaos testing. It cannot be set by the config file.
+	FaultInjector faults.Injector `yaml:"-"`
+
+	// Clock is the clock that housekeeping, client deactivation and caches
+	// read the time from. If it is nil, the clock of the system is used. It
+	// cannot be set by the config file.
+	Clock clock.Clock `yaml:"-"`
 }
 
 // Validate validates this config.
 func (c *Config) Validate() error {
+	if c.Database != "" && !database.IsRegistered(c.Database) {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-database" flag: %w`,
+			c.Database,
+			database.ErrUnknownDatabase,
+		)
+	}
+
+	if c.ClusterRPCAddr != "" && c.ClusterSecret == "" {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-cluster-rpc-addr" flag: cluster secret must be configured`,
+			c.ClusterRPCAddr,
+		)
+	}
+
+	if c.SnapshotMode != "" && c.SnapshotMode != SnapshotModeFull && c.SnapshotMode != SnapshotModeDelta {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-snapshot-mode" flag: must be "%s" or "%s"`,
+			c.SnapshotMode,
+			SnapshotModeFull,
+			SnapshotModeDelta,
+		)
+	}
+
+	if c.SnapshotCodec != "" {
+		if _, err := converter.SnapshotCodecByName(c.SnapshotCodec); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--backend-snapshot-codec" flag: %w`,
+				c.SnapshotCodec,
+				converter.ErrUnsupportedSnapshotCodec,
+			)
+		}
+	}
+
+	if c.SnapshotMaxDeltas < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-snapshot-max-deltas" flag: must not be negative`,
+			c.SnapshotMaxDeltas,
+		)
+	}
+
 	if _, err := time.ParseDuration(c.ClientDeactivateThreshold); err != nil {
 		return fmt.Errorf(
 			`invalid argument "%s" for "--client-deactivate-threshold" flag: %w`,
@@ -114,6 +308,22 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if _, err := time.ParseDuration(c.AuthJWKSCacheTTL); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--auth-jwks-cache-ttl" flag: %w`,
+			c.AuthJWKSCacheTTL,
+			err,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.EventWebhookMaxWaitInterval); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--event-webhook-max-wait-interval" flag: %w`,
+			c.EventWebhookMaxWaitInterval,
+			err,
+		)
+	}
+
 	if _, err := time.ParseDuration(c.ProjectInfoCacheTTL); err != nil {
 		return fmt.Errorf(
 			`invalid argument "%s" for "--project-info-cache-ttl" flag: %w`,
@@ -122,9 +332,154 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	// NOTE: The TTL of the snapshot cache is not used if the cache is
+	// disabled.
+	if c.SnapshotCacheSize > 0 {
+		if _, err := time.ParseDuration(c.SnapshotCacheTTL); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--snapshot-cache-ttl" flag: %w`,
+				c.SnapshotCacheTTL,
+				err,
+			)
+		}
+	}
+
+	if _, err := time.ParseDuration(c.PushPullCacheTTL); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--push-pull-cache-ttl" flag: %w`,
+			c.PushPullCacheTTL,
+			err,
+		)
+	}
+
+	if c.WatchHeartbeatTimeout != "" {
+		if _, err := time.ParseDuration(c.WatchHeartbeatTimeout); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--watch-heartbeat-timeout" flag: %w`,
+				c.WatchHeartbeatTimeout,
+				err,
+			)
+		}
+	}
+
+	if c.MaxOperationsPerChange < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-max-operations-per-change" flag: must not be negative`,
+			c.MaxOperationsPerChange,
+		)
+	}
+
+	if c.MaxChangeDepth < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-max-change-depth" flag: must not be negative`,
+			c.MaxChangeDepth,
+		)
+	}
+
+	if c.MaxStringLength < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-max-string-length" flag: must not be negative`,
+			c.MaxStringLength,
+		)
+	}
+
+	if c.MaxChangePackSize < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-max-change-pack-size" flag: must not be negative`,
+			c.MaxChangePackSize,
+		)
+	}
+
+	if c.MaxDocumentSize < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-max-document-size" flag: must not be negative`,
+			c.MaxDocumentSize,
+		)
+	}
+
+	if c.MaxDocumentElements < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-max-document-elements" flag: must not be negative`,
+			c.MaxDocumentElements,
+		)
+	}
+
+	if c.ClientRateLimit < 0 {
+		return fmt.Errorf(
+			`invalid argument "%g" for "--backend-client-rate-limit" flag: must not be negative`,
+			c.ClientRateLimit,
+		)
+	}
+
+	if c.ClientRateLimitBurst < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-client-rate-limit-burst" flag: must not be negative`,
+			c.ClientRateLimitBurst,
+		)
+	}
+
+	if c.ProjectRateLimit < 0 {
+		return fmt.Errorf(
+			`invalid argument "%g" for "--backend-project-rate-limit" flag: must not be negative`,
+			c.ProjectRateLimit,
+		)
+	}
+
+	if c.ProjectRateLimitBurst < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-project-rate-limit-burst" flag: must not be negative`,
+			c.ProjectRateLimitBurst,
+		)
+	}
+
+	if c.MinClientVersion != "" {
+		if err := version.Validate(c.MinClientVersion); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--backend-min-client-version" flag: %w`,
+				c.MinClientVersion,
+				err,
+			)
+		}
+	}
+
+	if c.RecommendedClientVersion != "" {
+		if err := version.Validate(c.RecommendedClientVersion); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--backend-recommended-client-version" flag: %w`,
+				c.RecommendedClientVersion,
+				err,
+			)
+		}
+	}
+
+	if c.PresenceEncryptionKey != "" {
+		key, err := hex.DecodeString(c.PresenceEncryptionKey)
+		if err != nil {
+			return fmt.Errorf(
+				`invalid argument for "--backend-presence-encryption-key" flag: %w`,
+				err,
+			)
+		}
+		if len(key) != 16 && len(key) != 24 && len(key) != 32 {
+			return fmt.Errorf(
+				`invalid argument for "--backend-presence-encryption-key" flag: %d bytes, must be 16, 24 or 32 bytes`,
+				len(key),
+			)
+		}
+	}
+
 	return nil
 }
 
+// ChangeLimits returns the limits of the changes that the server accepts.
+func (c *Config) ChangeLimits() converter.Limits {
+	return converter.Limits{
+		MaxOperationsPerChange: c.MaxOperationsPerChange,
+		MaxDepth:               c.MaxChangeDepth,
+		MaxStringLength:        c.MaxStringLength,
+	}
+}
+
 // ParseAdminTokenDuration returns admin token duration.
 func (c *Config) ParseAdminTokenDuration() time.Duration {
 	result, err := time.ParseDuration(c.AdminTokenDuration)
@@ -169,6 +524,28 @@ func (c *Config) ParseAuthWebhookCacheUnauthTTL() time.Duration {
 	return result
 }
 
+// ParseAuthJWKSCacheTTL returns TTL for the cache of JSON Web Key Sets.
+func (c *Config) ParseAuthJWKSCacheTTL() time.Duration {
+	result, err := time.ParseDuration(c.AuthJWKSCacheTTL)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse auth jwks cache ttl: %w", err)
+		os.Exit(1)
+	}
+
+	return result
+}
+
+// ParseEventWebhookMaxWaitInterval returns max wait interval of the event webhook.
+func (c *Config) ParseEventWebhookMaxWaitInterval() time.Duration {
+	result, err := time.ParseDuration(c.EventWebhookMaxWaitInterval)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse event webhook max wait interval: %w", err)
+		os.Exit(1)
+	}
+
+	return result
+}
+
 // ParseProjectInfoCacheTTL returns TTL for project info cache.
 func (c *Config) ParseProjectInfoCacheTTL() time.Duration {
 	result, err := time.ParseDuration(c.ProjectInfoCacheTTL)
@@ -179,3 +556,41 @@ func (c *Config) ParseProjectInfoCacheTTL() time.Duration {
 
 	return result
 }
+
+// ParseSnapshotCacheTTL returns TTL for snapshot cache.
+func (c *Config) ParseSnapshotCacheTTL() time.Duration {
+	result, err := time.ParseDuration(c.SnapshotCacheTTL)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse snapshot cache ttl: %w", err)
+		os.Exit(1)
+	}
+
+	return result
+}
+
+// ParsePushPullCacheTTL returns TTL for PushPull cache.
+func (c *Config) ParsePushPullCacheTTL() time.Duration {
+	result, err := time.ParseDuration(c.PushPullCacheTTL)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse push pull cache ttl: %w", err)
+		os.Exit(1)
+	}
+
+	return result
+}
+
+// ParseWatchHeartbeatTimeout returns the heartbeat timeout of watch streams.
+// It returns zero if the eviction of watch streams is disabled.
+func (c *Config) ParseWatchHeartbeatTimeout() time.Duration {
+	if c.WatchHeartbeatTimeout == "" {
+		return 0
+	}
+
+	result, err := time.ParseDuration(c.WatchHeartbeatTimeout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse watch heartbeat timeout: %w", err)
+		os.Exit(1)
+	}
+
+	return result
+}
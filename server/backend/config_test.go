@@ -21,17 +21,22 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/server/backend"
 )
 
 func TestConfig(t *testing.T) {
 	t.Run("validate test", func(t *testing.T) {
 		validConf := backend.Config{
-			ClientDeactivateThreshold:  "1h",
-			AuthWebhookMaxWaitInterval: "0ms",
-			AuthWebhookCacheAuthTTL:    "10s",
-			AuthWebhookCacheUnauthTTL:  "10s",
-			ProjectInfoCacheTTL:        "10m",
+			ClientDeactivateThreshold:   "1h",
+			AuthWebhookMaxWaitInterval:  "0ms",
+			AuthWebhookCacheAuthTTL:     "10s",
+			AuthWebhookCacheUnauthTTL:   "10s",
+			AuthJWKSCacheTTL:            "10m",
+			EventWebhookMaxWaitInterval: "0ms",
+			ProjectInfoCacheTTL:         "10m",
+			SnapshotCacheTTL:            "1m",
+			PushPullCacheTTL:            "1m",
 		}
 		assert.NoError(t, validConf.Validate())
 
@@ -54,5 +59,76 @@ func TestConfig(t *testing.T) {
 		conf5 := validConf
 		conf5.ProjectInfoCacheTTL = "10 minutes"
 		assert.Error(t, conf5.Validate())
+
+		conf6 := validConf
+		conf6.MinClientVersion = "0.4"
+		assert.Error(t, conf6.Validate())
+
+		conf7 := validConf
+		conf7.RecommendedClientVersion = "latest"
+		assert.Error(t, conf7.Validate())
+
+		conf8 := validConf
+		conf8.AuthJWKSCacheTTL = "10 minutes"
+		assert.Error(t, conf8.Validate())
+
+		conf9 := validConf
+		conf9.PresenceEncryptionKey = "not hex"
+		assert.Error(t, conf9.Validate())
+
+		conf10 := validConf
+		conf10.PresenceEncryptionKey = "0011223344"
+		assert.Error(t, conf10.Validate())
+
+		conf11 := validConf
+		conf11.PresenceEncryptionKey = "00112233445566778899aabbccddeeff"
+		assert.NoError(t, conf11.Validate())
+
+		conf12 := validConf
+		conf12.EventWebhookMaxWaitInterval = "3 seconds"
+		assert.Error(t, conf12.Validate())
+
+		conf13 := validConf
+		conf13.Database = "unknown"
+		assert.Error(t, conf13.Validate())
+
+		conf14 := validConf
+		conf14.Database = "memory"
+		assert.NoError(t, conf14.Validate())
+
+		conf15 := validConf
+		conf15.WatchHeartbeatTimeout = "30 seconds"
+		assert.Error(t, conf15.Validate())
+
+		conf16 := validConf
+		conf16.SnapshotCacheSize = 128
+		conf16.SnapshotCacheTTL = "1 minute"
+		assert.Error(t, conf16.Validate())
+
+		conf17 := validConf
+		conf17.PushPullCacheTTL = "1 minute"
+		assert.Error(t, conf17.Validate())
+
+		conf18 := validConf
+		conf18.SnapshotMode = "partial"
+		assert.Error(t, conf18.Validate())
+
+		conf19 := validConf
+		conf19.SnapshotMaxDeltas = -1
+		assert.Error(t, conf19.Validate())
+
+		conf20 := validConf
+		conf20.SnapshotCodec = "json"
+		assert.ErrorIs(t, conf20.Validate(), converter.ErrUnsupportedSnapshotCodec)
+
+		conf21 := validConf
+		conf21.SnapshotCacheTTL = ""
+		assert.NoError(t, conf21.Validate())
+
+		conf22 := validConf
+		conf22.ClusterRPCAddr = "localhost:11101"
+		assert.Error(t, conf22.Validate())
+		conf22.ClusterSecret = "cluster-secret"
+		assert.NoError(t, conf22.Validate())
 	})
 }
@@ -46,6 +46,15 @@ type ChangeInfo struct {
 	PresenceChange string   `bson:"presence_change"`
 }
 
+// DocChanges is a structure representing the changes of a document to be
+// stored together with the changes of other documents.
+type DocChanges struct {
+	DocInfo          *DocInfo
+	InitialServerSeq int64
+	Changes          []*change.Change
+	IsRemoved        bool
+}
+
 // EncodeOperations encodes the given operations into bytes array.
 func EncodeOperations(operations []operations.Operation) ([][]byte, error) {
 	var encodedOps [][]byte
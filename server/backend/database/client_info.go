@@ -52,6 +52,10 @@ type ClientDocInfo struct {
 	Status    string `bson:"status"`
 	ServerSeq int64  `bson:"server_seq"`
 	ClientSeq uint32 `bson:"client_seq"`
+
+	// PathFilter is the path of the subtree that the client subscribes to.
+	// If it is empty, the client receives the changes of the whole document.
+	PathFilter string `bson:"path_filter"`
 }
 
 // ClientInfo is a structure representing information of a client.
@@ -71,6 +75,10 @@ type ClientInfo struct {
 	// Documents is a map of document which is attached to the client.
 	Documents map[types.ID]*ClientDocInfo `bson:"documents"`
 
+	// Connection is the information of the connection that the client was
+	// activated with.
+	Connection types.ConnectionInfo `bson:"connection"`
+
 	// CreatedAt is the time when the client was created.
 	CreatedAt time.Time `bson:"created_at"`
 
@@ -133,6 +141,7 @@ func (i *ClientInfo) DetachDocument(docID types.ID) error {
 	i.Documents[docID].Status = DocumentDetached
 	i.Documents[docID].ClientSeq = 0
 	i.Documents[docID].ServerSeq = 0
+	i.Documents[docID].PathFilter = ""
 	i.UpdatedAt = time.Now()
 
 	return nil
@@ -187,6 +196,26 @@ func (i *ClientInfo) UpdateCheckpoint(
 	return nil
 }
 
+// PathFilter returns the path filter of the given document.
+func (i *ClientInfo) PathFilter(docID types.ID) string {
+	clientDocInfo := i.Documents[docID]
+	if clientDocInfo == nil {
+		return ""
+	}
+
+	return clientDocInfo.PathFilter
+}
+
+// SetPathFilter sets the path filter of the given document.
+func (i *ClientInfo) SetPathFilter(docID types.ID, filter string) error {
+	if !i.hasDocument(docID) {
+		return fmt.Errorf("set path filter in document(%s): %w", docID.String(), ErrDocumentNeverAttached)
+	}
+
+	i.Documents[docID].PathFilter = filter
+	return nil
+}
+
 // EnsureDocumentAttached ensures the given document is attached.
 func (i *ClientInfo) EnsureDocumentAttached(docID types.ID) error {
 	if i.Status != ClientActivated {
@@ -217,20 +246,22 @@ func (i *ClientInfo) DeepCopy() *ClientInfo {
 	documents := make(map[types.ID]*ClientDocInfo, len(i.Documents))
 	for k, v := range i.Documents {
 		documents[k] = &ClientDocInfo{
-			Status:    v.Status,
-			ServerSeq: v.ServerSeq,
-			ClientSeq: v.ClientSeq,
+			Status:     v.Status,
+			ServerSeq:  v.ServerSeq,
+			ClientSeq:  v.ClientSeq,
+			PathFilter: v.PathFilter,
 		}
 	}
 
 	return &ClientInfo{
-		ID:        i.ID,
-		ProjectID: i.ProjectID,
-		Key:       i.Key,
-		Status:    i.Status,
-		Documents: documents,
-		CreatedAt: i.CreatedAt,
-		UpdatedAt: i.UpdatedAt,
+		ID:         i.ID,
+		ProjectID:  i.ProjectID,
+		Key:        i.Key,
+		Status:     i.Status,
+		Documents:  documents,
+		Connection: i.Connection,
+		CreatedAt:  i.CreatedAt,
+		UpdatedAt:  i.UpdatedAt,
 	}
 }
 
@@ -55,6 +55,13 @@ var (
 
 	// ErrProjectNameAlreadyExists is returned when the project name already exists.
 	ErrProjectNameAlreadyExists = errors.New("project name already exists")
+
+	// ErrTemplateNotFound is returned when the template could not be found.
+	ErrTemplateNotFound = errors.New("template not found")
+
+	// ErrTransactionNotSupported is returned when the database can not store
+	// the changes of multiple documents atomically, e.g. a standalone MongoDB.
+	ErrTransactionNotSupported = errors.New("transaction not supported")
 )
 
 // Database represents database which reads or saves Yorkie data.
@@ -121,8 +128,14 @@ type Database interface {
 	// ListUserInfos returns all users.
 	ListUserInfos(ctx context.Context) ([]*UserInfo, error)
 
-	// ActivateClient activates the client of the given key.
-	ActivateClient(ctx context.Context, projectID types.ID, key string) (*ClientInfo, error)
+	// ActivateClient activates the client of the given key with the given
+	// connection.
+	ActivateClient(
+		ctx context.Context,
+		projectID types.ID,
+		key string,
+		connection types.ConnectionInfo,
+	) (*ClientInfo, error)
 
 	// DeactivateClient deactivates the client of the given ID.
 	DeactivateClient(ctx context.Context, projectID, clientID types.ID) (*ClientInfo, error)
@@ -134,6 +147,13 @@ type Database interface {
 	// after handling PushPull.
 	UpdateClientInfoAfterPushPull(ctx context.Context, clientInfo *ClientInfo, docInfo *DocInfo) error
 
+	// FindClientInfosByPaging returns the clientInfos of the given paging.
+	FindClientInfosByPaging(
+		ctx context.Context,
+		projectID types.ID,
+		paging types.Paging[types.ID],
+	) ([]*ClientInfo, error)
+
 	// FindDeactivateCandidates finds the housekeeping candidates.
 	FindDeactivateCandidates(
 		ctx context.Context,
@@ -142,6 +162,16 @@ type Database interface {
 		lastProjectID types.ID,
 	) (types.ID, []*ClientInfo, error)
 
+	// FindCompactionCandidates finds the documents whose change logs are
+	// compacted by housekeeping, in the order of their IDs after the given
+	// last document ID. It returns the ID to continue from in the next run,
+	// which is empty if all the documents have been visited.
+	FindCompactionCandidates(
+		ctx context.Context,
+		candidatesLimit int,
+		lastDocID types.ID,
+	) (types.ID, []*DocInfo, error)
+
 	// FindDocInfoByKey finds the document of the given key.
 	FindDocInfoByKey(
 		ctx context.Context,
@@ -174,6 +204,62 @@ type Database interface {
 		docID types.ID,
 	) error
 
+	// UpdateDocInfoACL updates the access control list of the given document.
+	UpdateDocInfoACL(
+		ctx context.Context,
+		projectID types.ID,
+		docID types.ID,
+		acl *types.DocumentACL,
+	) error
+
+	// UpdateDocInfoReadOnlyReason updates the reason why the given document is
+	// read-only. An empty reason restores the write access of the document.
+	UpdateDocInfoReadOnlyReason(
+		ctx context.Context,
+		projectID types.ID,
+		docID types.ID,
+		reason string,
+	) error
+
+	// UpdateDocInfoLabels updates the labels of the given document.
+	UpdateDocInfoLabels(
+		ctx context.Context,
+		projectID types.ID,
+		docID types.ID,
+		labels map[string]string,
+	) error
+
+	// UpdateDocInfoSnapshotConfig updates the snapshot threshold and interval
+	// that override the ones of the project for the given document.
+	UpdateDocInfoSnapshotConfig(
+		ctx context.Context,
+		projectID types.ID,
+		docID types.ID,
+		threshold int64,
+		interval int64,
+	) error
+
+	// UpsertTemplateInfo creates or updates the template of the given collection.
+	UpsertTemplateInfo(
+		ctx context.Context,
+		projectID types.ID,
+		collection string,
+		root string,
+	) (*TemplateInfo, error)
+
+	// ListTemplateInfos returns all the templates of the given project.
+	ListTemplateInfos(
+		ctx context.Context,
+		projectID types.ID,
+	) ([]*TemplateInfo, error)
+
+	// DeleteTemplateInfo deletes the template of the given collection.
+	DeleteTemplateInfo(
+		ctx context.Context,
+		projectID types.ID,
+		collection string,
+	) error
+
 	// CreateChangeInfos stores the given changes then updates the given docInfo.
 	CreateChangeInfos(
 		ctx context.Context,
@@ -184,6 +270,14 @@ type Database interface {
 		isRemoved bool,
 	) error
 
+	// CreateChangeInfosOfDocs stores the changes of the given documents then
+	// updates their docInfos. Nothing is stored if one of them fails.
+	CreateChangeInfosOfDocs(
+		ctx context.Context,
+		projectID types.ID,
+		docChanges []*DocChanges,
+	) error
+
 	// PurgeStaleChanges delete changes before the smallest in `syncedseqs` to
 	// save storage.
 	PurgeStaleChanges(
@@ -191,6 +285,17 @@ type Database interface {
 		docID types.ID,
 	) error
 
+	// PurgeChangeInfos deletes the changes of the given document whose server
+	// seqs are less than or equal to the given server seq, and returns the
+	// number of the deleted changes. The changes after the checkpoints of the
+	// clients attached to the document are kept.
+	PurgeChangeInfos(
+		ctx context.Context,
+		projectID types.ID,
+		docID types.ID,
+		serverSeq int64,
+	) (int64, error)
+
 	// FindChangesBetweenServerSeqs returns the changes between two server sequences.
 	FindChangesBetweenServerSeqs(
 		ctx context.Context,
@@ -207,8 +312,37 @@ type Database interface {
 		to int64,
 	) ([]*ChangeInfo, error)
 
-	// CreateSnapshotInfo stores the snapshot of the given document.
-	CreateSnapshotInfo(ctx context.Context, docID types.ID, doc *document.InternalDocument) error
+	// CreateSnapshotInfo stores the given encoded snapshot of the given
+	// document.
+	CreateSnapshotInfo(
+		ctx context.Context,
+		docID types.ID,
+		doc *document.InternalDocument,
+		snapshot []byte,
+	) error
+
+	// CreateOffloadedSnapshotInfo stores the metadata of the snapshot of the
+	// given document whose data is stored in an object storage with the given
+	// key.
+	CreateOffloadedSnapshotInfo(
+		ctx context.Context,
+		docID types.ID,
+		doc *document.InternalDocument,
+		size int64,
+		storageKey string,
+	) error
+
+	// CreateDeltaSnapshotInfo stores the snapshot of the given document as the
+	// given delta from the snapshot at the given base server seq. The size is
+	// the size of the full snapshot.
+	CreateDeltaSnapshotInfo(
+		ctx context.Context,
+		docID types.ID,
+		doc *document.InternalDocument,
+		baseServerSeq int64,
+		delta []byte,
+		size int64,
+	) error
 
 	// FindSnapshotInfoByID returns the snapshot by the given id.
 	FindSnapshotInfoByID(ctx context.Context, id types.ID) (*SnapshotInfo, error)
@@ -241,13 +375,19 @@ type Database interface {
 		serverSeq int64,
 	) error
 
-	// FindDocInfosByPaging returns the documentInfos of the given paging.
+	// FindDocInfosByPaging returns the documentInfos of the given paging,
+	// which match the given filter.
 	FindDocInfosByPaging(
 		ctx context.Context,
 		projectID types.ID,
+		filter types.DocumentFilter,
 		paging types.Paging[types.ID],
 	) ([]*DocInfo, error)
 
+	// FindDocInfosBySample returns at most the given number of documentInfos
+	// that are sampled randomly from the documents of all projects.
+	FindDocInfosBySample(ctx context.Context, size int) ([]*DocInfo, error)
+
 	// FindDocInfosByQuery returns the documentInfos which match the given query.
 	FindDocInfosByQuery(
 		ctx context.Context,
@@ -263,4 +403,12 @@ type Database interface {
 		docID types.ID,
 		excludeClientID types.ID,
 	) (bool, error)
+
+	// FindAttachedClientInfosByDocID returns the clients that the given
+	// document is attached to.
+	FindAttachedClientInfosByDocID(
+		ctx context.Context,
+		projectID types.ID,
+		docID types.ID,
+	) ([]*ClientInfo, error)
 }
@@ -51,6 +51,25 @@ type DocInfo struct {
 
 	// RemovedAt is the time when the document is removed.
 	RemovedAt time.Time `bson:"removed_at"`
+
+	// ACL is the access control list of the document.
+	ACL *types.DocumentACL `bson:"acl"`
+
+	// Labels are the key/value labels of the document, which are used to
+	// operate on logical groups of documents.
+	Labels map[string]string `bson:"labels"`
+
+	// ReadOnlyReason is the reason why the document is read-only. The
+	// document becomes read-only when it exceeds the quota of the project.
+	ReadOnlyReason string `bson:"read_only_reason"`
+
+	// SnapshotThreshold overrides the snapshot threshold of the project and
+	// the server for the document. Zero means no override.
+	SnapshotThreshold int64 `bson:"snapshot_threshold"`
+
+	// SnapshotInterval overrides the snapshot interval of the project and the
+	// server for the document. Zero means no override.
+	SnapshotInterval int64 `bson:"snapshot_interval"`
 }
 
 // IncreaseServerSeq increases server sequence of the document.
@@ -64,6 +83,11 @@ func (info *DocInfo) IsRemoved() bool {
 	return !info.RemovedAt.IsZero()
 }
 
+// IsReadOnly returns true if the document is read-only.
+func (info *DocInfo) IsReadOnly() bool {
+	return info.ReadOnlyReason != ""
+}
+
 // DeepCopy creates a deep copy of this DocInfo.
 func (info *DocInfo) DeepCopy() *DocInfo {
 	if info == nil {
@@ -71,14 +95,32 @@ func (info *DocInfo) DeepCopy() *DocInfo {
 	}
 
 	return &DocInfo{
-		ID:         info.ID,
-		ProjectID:  info.ProjectID,
-		Key:        info.Key,
-		ServerSeq:  info.ServerSeq,
-		Owner:      info.Owner,
-		CreatedAt:  info.CreatedAt,
-		AccessedAt: info.AccessedAt,
-		UpdatedAt:  info.UpdatedAt,
-		RemovedAt:  info.RemovedAt,
+		ID:                info.ID,
+		ProjectID:         info.ProjectID,
+		Key:               info.Key,
+		ServerSeq:         info.ServerSeq,
+		Owner:             info.Owner,
+		CreatedAt:         info.CreatedAt,
+		AccessedAt:        info.AccessedAt,
+		UpdatedAt:         info.UpdatedAt,
+		RemovedAt:         info.RemovedAt,
+		ACL:               info.ACL.DeepCopy(),
+		Labels:            copyLabels(info.Labels),
+		ReadOnlyReason:    info.ReadOnlyReason,
+		SnapshotThreshold: info.SnapshotThreshold,
+		SnapshotInterval:  info.SnapshotInterval,
+	}
+}
+
+// copyLabels returns a copy of the given labels.
+func copyLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+
+	copied := make(map[string]string, len(labels))
+	for k, v := range labels {
+		copied[k] = v
 	}
+	return copied
 }
@@ -20,13 +20,15 @@ package memory
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sort"
 	gotime "time"
 
 	"github.com/hashicorp/go-memdb"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
-	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/clock"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
@@ -34,20 +36,37 @@ import (
 	"github.com/yorkie-team/yorkie/server/backend/database"
 )
 
+// Name is the name of the in-memory database registered to database.Register.
+const Name = "memory"
+
+func init() {
+	database.Register(Name, func(_ interface{}, clk clock.Clock) (database.Database, error) {
+		return NewWithClock(clk)
+	})
+}
+
 // DB is an in-memory database for testing or temporarily.
 type DB struct {
-	db *memdb.MemDB
+	db    *memdb.MemDB
+	clock clock.Clock
 }
 
 // New returns a new in-memory database.
 func New() (*DB, error) {
+	return NewWithClock(clock.New())
+}
+
+// NewWithClock returns a new in-memory database that reads the time of
+// updates and deactivation from the given clock.
+func NewWithClock(clk clock.Clock) (*DB, error) {
 	memDB, err := memdb.NewMemDB(schema)
 	if err != nil {
 		return nil, fmt.Errorf("new memdb: %w", err)
 	}
 
 	return &DB{
-		db: memDB,
+		db:    memDB,
+		clock: clk,
 	}, nil
 }
 
@@ -327,7 +346,7 @@ func (d *DB) UpdateProjectInfo(
 	}
 
 	info.UpdateFields(fields)
-	info.UpdatedAt = gotime.Now()
+	info.UpdatedAt = d.clock.Now()
 	if err := txn.Insert(tblProjects, info); err != nil {
 		return nil, fmt.Errorf("update project: %w", err)
 	}
@@ -403,11 +422,12 @@ func (d *DB) ListUserInfos(
 	return infos, nil
 }
 
-// ActivateClient activates a client.
+// ActivateClient activates a client with the given connection.
 func (d *DB) ActivateClient(
 	ctx context.Context,
 	projectID types.ID,
 	key string,
+	connection types.ConnectionInfo,
 ) (*database.ClientInfo, error) {
 	txn := d.db.Txn(true)
 	defer txn.Abort()
@@ -417,13 +437,14 @@ func (d *DB) ActivateClient(
 		return nil, fmt.Errorf("find client by project id and key: %w", err)
 	}
 
-	now := gotime.Now()
+	now := d.clock.Now()
 
 	clientInfo := &database.ClientInfo{
-		ProjectID: projectID,
-		Key:       key,
-		Status:    database.ClientActivated,
-		UpdatedAt: now,
+		ProjectID:  projectID,
+		Key:        key,
+		Status:     database.ClientActivated,
+		Connection: connection,
+		UpdatedAt:  now,
 	}
 
 	if raw == nil {
@@ -534,7 +555,7 @@ func (d *DB) UpdateClientInfoAfterPushPull(
 		loaded.Documents[docInfo.ID] = &database.ClientDocInfo{
 			Status: clientDocInfo.Status,
 		}
-		loaded.UpdatedAt = gotime.Now()
+		loaded.UpdatedAt = d.clock.Now()
 	} else {
 		if _, ok := loaded.Documents[docInfo.ID]; !ok {
 			loaded.Documents[docInfo.ID] = &database.ClientDocInfo{}
@@ -550,11 +571,12 @@ func (d *DB) UpdateClientInfoAfterPushPull(
 			clientSeq = clientDocInfo.ClientSeq
 		}
 		loaded.Documents[docInfo.ID] = &database.ClientDocInfo{
-			ServerSeq: serverSeq,
-			ClientSeq: clientSeq,
-			Status:    clientDocInfo.Status,
+			ServerSeq:  serverSeq,
+			ClientSeq:  clientSeq,
+			Status:     clientDocInfo.Status,
+			PathFilter: clientDocInfo.PathFilter,
 		}
-		loaded.UpdatedAt = gotime.Now()
+		loaded.UpdatedAt = d.clock.Now()
 	}
 
 	if err := txn.Insert(tblClients, loaded); err != nil {
@@ -565,6 +587,56 @@ func (d *DB) UpdateClientInfoAfterPushPull(
 	return nil
 }
 
+// FindClientInfosByPaging returns the clientInfos of the given paging.
+func (d *DB) FindClientInfosByPaging(
+	ctx context.Context,
+	projectID types.ID,
+	paging types.Paging[types.ID],
+) ([]*database.ClientInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	var iterator memdb.ResultIterator
+	var err error
+	if paging.IsForward {
+		iterator, err = txn.LowerBound(
+			tblClients,
+			"project_id_id",
+			projectID.String(),
+			paging.Offset.String(),
+		)
+	} else {
+		offset := paging.Offset
+		if paging.Offset == "" {
+			offset = types.IDFromActorID(time.MaxActorID)
+		}
+
+		iterator, err = txn.ReverseLowerBound(
+			tblClients,
+			"project_id_id",
+			projectID.String(),
+			offset.String(),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetch clients of %s: %w", projectID.String(), err)
+	}
+
+	var clientInfos []*database.ClientInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.ClientInfo)
+		if len(clientInfos) >= paging.PageSize || info.ProjectID != projectID {
+			break
+		}
+
+		if info.ID != paging.Offset {
+			clientInfos = append(clientInfos, info.DeepCopy())
+		}
+	}
+
+	return clientInfos, nil
+}
+
 // findDeactivateCandidatesPerProject finds the clients that need housekeeping per project.
 func (d *DB) findDeactivateCandidatesPerProject(
 	ctx context.Context,
@@ -579,7 +651,7 @@ func (d *DB) findDeactivateCandidatesPerProject(
 		return nil, err
 	}
 
-	offset := gotime.Now().Add(-clientDeactivateThreshold)
+	offset := d.clock.Now().Add(-clientDeactivateThreshold)
 
 	var infos []*database.ClientInfo
 	iterator, err := txn.ReverseLowerBound(
@@ -638,6 +710,36 @@ func (d *DB) FindDeactivateCandidates(
 	return topProjectID, candidates, nil
 }
 
+// FindCompactionCandidates finds the documents whose change logs are
+// compacted by housekeeping.
+func (d *DB) FindCompactionCandidates(
+	ctx context.Context,
+	candidatesLimit int,
+	lastDocID types.ID,
+) (types.ID, []*database.DocInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.LowerBound(tblDocuments, "id", lastDocID.String())
+	if err != nil {
+		return "", nil, fmt.Errorf("fetch documents after %s: %w", lastDocID, err)
+	}
+
+	var infos []*database.DocInfo
+	for raw := iterator.Next(); raw != nil && len(infos) < candidatesLimit; raw = iterator.Next() {
+		info := raw.(*database.DocInfo)
+		if info.ID == lastDocID {
+			continue
+		}
+		infos = append(infos, info.DeepCopy())
+	}
+
+	if len(infos) < candidatesLimit {
+		return "", infos, nil
+	}
+	return infos[len(infos)-1].ID, infos, nil
+}
+
 // FindDocInfoByKeyAndOwner finds the document of the given key. If the
 // createDocIfNotExist condition is true, create the document if it does not
 // exist.
@@ -678,7 +780,7 @@ func (d *DB) FindDocInfoByKeyAndOwner(
 		}
 	}
 
-	now := gotime.Now()
+	now := d.clock.Now()
 	var docInfo *database.DocInfo
 	if raw == nil {
 		docInfo = &database.DocInfo{
@@ -770,7 +872,7 @@ func (d *DB) UpdateDocInfoStatusToRemoved(
 		return fmt.Errorf("finding doc info by ID(%s): %w", id, database.ErrDocumentNotFound)
 	}
 
-	docInfo.RemovedAt = gotime.Now()
+	docInfo.RemovedAt = d.clock.Now()
 
 	if err := txn.Delete(tblDocuments, docInfo); err != nil {
 		return fmt.Errorf("delete document: %w", err)
@@ -784,6 +886,254 @@ func (d *DB) UpdateDocInfoStatusToRemoved(
 	return nil
 }
 
+// UpdateDocInfoACL updates the access control list of the given document.
+func (d *DB) UpdateDocInfoACL(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	acl *types.DocumentACL,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", id.String())
+	if err != nil {
+		return fmt.Errorf("find document by id: %w", err)
+	}
+
+	if raw == nil {
+		return fmt.Errorf("finding doc info by ID(%s): %w", id, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID {
+		return fmt.Errorf("finding doc info by ID(%s): %w", id, database.ErrDocumentNotFound)
+	}
+
+	docInfo.ACL = acl.DeepCopy()
+
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return fmt.Errorf("update document: %w", err)
+	}
+
+	txn.Commit()
+
+	return nil
+}
+
+// UpdateDocInfoReadOnlyReason updates the reason why the given document is
+// read-only.
+func (d *DB) UpdateDocInfoReadOnlyReason(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	reason string,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", id.String())
+	if err != nil {
+		return fmt.Errorf("find document by id: %w", err)
+	}
+
+	if raw == nil {
+		return fmt.Errorf("finding doc info by ID(%s): %w", id, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID {
+		return fmt.Errorf("finding doc info by ID(%s): %w", id, database.ErrDocumentNotFound)
+	}
+
+	docInfo.ReadOnlyReason = reason
+
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return fmt.Errorf("update document: %w", err)
+	}
+
+	txn.Commit()
+
+	return nil
+}
+
+// UpdateDocInfoLabels updates the labels of the given document.
+func (d *DB) UpdateDocInfoLabels(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	labels map[string]string,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", id.String())
+	if err != nil {
+		return fmt.Errorf("find document by id: %w", err)
+	}
+
+	if raw == nil {
+		return fmt.Errorf("finding doc info by ID(%s): %w", id, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID {
+		return fmt.Errorf("finding doc info by ID(%s): %w", id, database.ErrDocumentNotFound)
+	}
+
+	docInfo.Labels = nil
+	if len(labels) > 0 {
+		docInfo.Labels = make(map[string]string, len(labels))
+		for k, v := range labels {
+			docInfo.Labels[k] = v
+		}
+	}
+
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return fmt.Errorf("update document: %w", err)
+	}
+
+	txn.Commit()
+
+	return nil
+}
+
+// UpdateDocInfoSnapshotConfig updates the snapshot threshold and interval
+// that override the ones of the project for the given document.
+func (d *DB) UpdateDocInfoSnapshotConfig(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	threshold int64,
+	interval int64,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", id.String())
+	if err != nil {
+		return fmt.Errorf("find document by id: %w", err)
+	}
+
+	if raw == nil {
+		return fmt.Errorf("finding doc info by ID(%s): %w", id, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID {
+		return fmt.Errorf("finding doc info by ID(%s): %w", id, database.ErrDocumentNotFound)
+	}
+
+	docInfo.SnapshotThreshold = threshold
+	docInfo.SnapshotInterval = interval
+
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return fmt.Errorf("update document: %w", err)
+	}
+
+	txn.Commit()
+
+	return nil
+}
+
+// UpsertTemplateInfo creates or updates the template of the given collection.
+func (d *DB) UpsertTemplateInfo(
+	ctx context.Context,
+	projectID types.ID,
+	collection string,
+	root string,
+) (*database.TemplateInfo, error) {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(
+		tblTemplates,
+		"project_id_collection",
+		projectID.String(),
+		collection,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find template by collection: %w", err)
+	}
+
+	now := d.clock.Now()
+	var info *database.TemplateInfo
+	if raw == nil {
+		info = &database.TemplateInfo{
+			ID:         newID(),
+			ProjectID:  projectID,
+			Collection: collection,
+			CreatedAt:  now,
+		}
+	} else {
+		info = raw.(*database.TemplateInfo).DeepCopy()
+	}
+	info.Root = root
+	info.UpdatedAt = now
+
+	if err := txn.Insert(tblTemplates, info); err != nil {
+		return nil, fmt.Errorf("upsert template: %w", err)
+	}
+	txn.Commit()
+
+	return info.DeepCopy(), nil
+}
+
+// ListTemplateInfos returns all the templates of the given project.
+func (d *DB) ListTemplateInfos(
+	ctx context.Context,
+	projectID types.ID,
+) ([]*database.TemplateInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iter, err := txn.Get(tblTemplates, "project_id", projectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("fetch templates by project id: %w", err)
+	}
+
+	var infos []*database.TemplateInfo
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		infos = append(infos, raw.(*database.TemplateInfo).DeepCopy())
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Collection < infos[j].Collection
+	})
+
+	return infos, nil
+}
+
+// DeleteTemplateInfo deletes the template of the given collection.
+func (d *DB) DeleteTemplateInfo(
+	ctx context.Context,
+	projectID types.ID,
+	collection string,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(
+		tblTemplates,
+		"project_id_collection",
+		projectID.String(),
+		collection,
+	)
+	if err != nil {
+		return fmt.Errorf("find template by collection: %w", err)
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", collection, database.ErrTemplateNotFound)
+	}
+
+	if err := txn.Delete(tblTemplates, raw); err != nil {
+		return fmt.Errorf("delete template: %w", err)
+	}
+	txn.Commit()
+
+	return nil
+}
+
 // CreateChangeInfos stores the given changes and doc info. If the
 // removeDoc condition is true, mark IsRemoved to true in doc info.
 func (d *DB) CreateChangeInfos(
@@ -793,11 +1143,52 @@ func (d *DB) CreateChangeInfos(
 	initialServerSeq int64,
 	changes []*change.Change,
 	isRemoved bool,
+) error {
+	return d.CreateChangeInfosOfDocs(ctx, projectID, []*database.DocChanges{{
+		DocInfo:          docInfo,
+		InitialServerSeq: initialServerSeq,
+		Changes:          changes,
+		IsRemoved:        isRemoved,
+	}})
+}
+
+// CreateChangeInfosOfDocs stores the changes of the given documents and their
+// doc infos in a single transaction.
+func (d *DB) CreateChangeInfosOfDocs(
+	ctx context.Context,
+	projectID types.ID,
+	docChanges []*database.DocChanges,
 ) error {
 	txn := d.db.Txn(true)
 	defer txn.Abort()
 
-	for _, cn := range changes {
+	now := d.clock.Now()
+	for _, dc := range docChanges {
+		if err := createChangeInfos(txn, projectID, dc, now); err != nil {
+			return err
+		}
+	}
+	txn.Commit()
+
+	for _, dc := range docChanges {
+		if dc.IsRemoved {
+			dc.DocInfo.RemovedAt = now
+		}
+	}
+
+	return nil
+}
+
+// createChangeInfos stores the changes and the doc info of the given document
+// within the given transaction.
+func createChangeInfos(
+	txn *memdb.Txn,
+	projectID types.ID,
+	dc *database.DocChanges,
+	now gotime.Time,
+) error {
+	docInfo := dc.DocInfo
+	for _, cn := range dc.Changes {
 		encodedOperations, err := database.EncodeOperations(cn.Operations())
 		if err != nil {
 			return err
@@ -835,24 +1226,18 @@ func (d *DB) CreateChangeInfos(
 		return fmt.Errorf("%s: %w", docInfo.ID, database.ErrDocumentNotFound)
 	}
 	loadedDocInfo := raw.(*database.DocInfo).DeepCopy()
-	if loadedDocInfo.ServerSeq != initialServerSeq {
+	if loadedDocInfo.ServerSeq != dc.InitialServerSeq {
 		return fmt.Errorf("%s: %w", docInfo.ID, database.ErrConflictOnUpdate)
 	}
 
-	now := gotime.Now()
 	loadedDocInfo.ServerSeq = docInfo.ServerSeq
 	loadedDocInfo.UpdatedAt = now
-	if isRemoved {
+	if dc.IsRemoved {
 		loadedDocInfo.RemovedAt = now
 	}
 	if err := txn.Insert(tblDocuments, loadedDocInfo); err != nil {
 		return fmt.Errorf("update document: %w", err)
 	}
-	txn.Commit()
-
-	if isRemoved {
-		docInfo.RemovedAt = now
-	}
 
 	return nil
 }
@@ -904,6 +1289,58 @@ func (d *DB) PurgeStaleChanges(
 	return nil
 }
 
+// PurgeChangeInfos deletes the changes of the given document whose server
+// seqs are less than or equal to the given server seq, except the changes
+// after the checkpoints of the clients attached to the document.
+func (d *DB) PurgeChangeInfos(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	serverSeq int64,
+) (int64, error) {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	it, err := txn.Get(tblClients, "project_id", projectID.String())
+	if err != nil {
+		return 0, fmt.Errorf("fetch clients of %s: %w", projectID, err)
+	}
+	for raw := it.Next(); raw != nil; raw = it.Next() {
+		clientDocInfo := raw.(*database.ClientInfo).Documents[docID]
+		if clientDocInfo == nil || clientDocInfo.Status != database.DocumentAttached {
+			continue
+		}
+		if clientDocInfo.ServerSeq < serverSeq {
+			serverSeq = clientDocInfo.ServerSeq
+		}
+	}
+
+	iterator, err := txn.ReverseLowerBound(
+		tblChanges,
+		"doc_id_server_seq",
+		docID.String(),
+		serverSeq,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("fetch changes before %d: %w", serverSeq, err)
+	}
+
+	var deleted int64
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.ChangeInfo)
+		if info.DocID != docID {
+			break
+		}
+		if err := txn.Delete(tblChanges, info); err != nil {
+			return 0, fmt.Errorf("delete change %s: %w", info.ID, err)
+		}
+		deleted++
+	}
+
+	txn.Commit()
+	return deleted, nil
+}
+
 // FindChangesBetweenServerSeqs returns the changes between two server sequences.
 func (d *DB) FindChangesBetweenServerSeqs(
 	ctx context.Context,
@@ -961,17 +1398,13 @@ func (d *DB) FindChangeInfosBetweenServerSeqs(
 	return infos, nil
 }
 
-// CreateSnapshotInfo stores the snapshot of the given document.
+// CreateSnapshotInfo stores the given encoded snapshot of the given document.
 func (d *DB) CreateSnapshotInfo(
 	ctx context.Context,
 	docID types.ID,
 	doc *document.InternalDocument,
+	snapshot []byte,
 ) error {
-	snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
-	if err != nil {
-		return err
-	}
-
 	txn := d.db.Txn(true)
 	defer txn.Abort()
 
@@ -981,7 +1414,67 @@ func (d *DB) CreateSnapshotInfo(
 		ServerSeq: doc.Checkpoint().ServerSeq,
 		Lamport:   doc.Lamport(),
 		Snapshot:  snapshot,
-		CreatedAt: gotime.Now(),
+		Size:      int64(len(snapshot)),
+		Elements:  int64(doc.Root().ElementMapLen()),
+		CreatedAt: d.clock.Now(),
+	}); err != nil {
+		return fmt.Errorf("create snapshot: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// CreateOffloadedSnapshotInfo stores the metadata of the snapshot of the
+// given document whose data is stored in an object storage.
+func (d *DB) CreateOffloadedSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	size int64,
+	storageKey string,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	if err := txn.Insert(tblSnapshots, &database.SnapshotInfo{
+		ID:         newID(),
+		DocID:      docID,
+		ServerSeq:  doc.Checkpoint().ServerSeq,
+		Lamport:    doc.Lamport(),
+		Size:       size,
+		Elements:   int64(doc.Root().ElementMapLen()),
+		StorageKey: storageKey,
+		CreatedAt:  d.clock.Now(),
+	}); err != nil {
+		return fmt.Errorf("create snapshot: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// CreateDeltaSnapshotInfo stores the snapshot of the given document as the
+// given delta from the snapshot at the given base server seq.
+func (d *DB) CreateDeltaSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	baseServerSeq int64,
+	delta []byte,
+	size int64,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	if err := txn.Insert(tblSnapshots, &database.SnapshotInfo{
+		ID:            newID(),
+		DocID:         docID,
+		ServerSeq:     doc.Checkpoint().ServerSeq,
+		Lamport:       doc.Lamport(),
+		Snapshot:      delta,
+		Size:          size,
+		Elements:      int64(doc.Root().ElementMapLen()),
+		BaseServerSeq: baseServerSeq,
+		CreatedAt:     d.clock.Now(),
 	}); err != nil {
 		return fmt.Errorf("create snapshot: %w", err)
 	}
@@ -1029,11 +1522,15 @@ func (d *DB) FindClosestSnapshotInfo(
 		info := raw.(*database.SnapshotInfo)
 		if info.DocID == docID {
 			snapshotInfo = &database.SnapshotInfo{
-				ID:        info.ID,
-				DocID:     info.DocID,
-				ServerSeq: info.ServerSeq,
-				Lamport:   info.Lamport,
-				CreatedAt: info.CreatedAt,
+				ID:            info.ID,
+				DocID:         info.DocID,
+				ServerSeq:     info.ServerSeq,
+				Lamport:       info.Lamport,
+				Size:          info.Size,
+				Elements:      info.Elements,
+				StorageKey:    info.StorageKey,
+				BaseServerSeq: info.BaseServerSeq,
+				CreatedAt:     info.CreatedAt,
 			}
 			if includeSnapshot {
 				snapshotInfo.Snapshot = info.Snapshot
@@ -1206,6 +1703,7 @@ func (d *DB) UpdateSyncedSeq(
 func (d *DB) FindDocInfosByPaging(
 	ctx context.Context,
 	projectID types.ID,
+	filter types.DocumentFilter,
 	paging types.Paging[types.ID],
 ) ([]*database.DocInfo, error) {
 	txn := d.db.Txn(false)
@@ -1244,7 +1742,9 @@ func (d *DB) FindDocInfosByPaging(
 			break
 		}
 
-		if info.ID != paging.Offset && info.RemovedAt.IsZero() {
+		if info.ID != paging.Offset &&
+			info.RemovedAt.IsZero() &&
+			filter.Matches(info.Key.String(), info.Labels, info.UpdatedAt) {
 			docInfos = append(docInfos, info)
 		}
 	}
@@ -1252,6 +1752,41 @@ func (d *DB) FindDocInfosByPaging(
 	return docInfos, nil
 }
 
+// FindDocInfosBySample returns at most the given number of docInfos that are
+// sampled randomly from the documents of all projects.
+func (d *DB) FindDocInfosBySample(
+	ctx context.Context,
+	size int,
+) ([]*database.DocInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblDocuments, "id")
+	if err != nil {
+		return nil, fmt.Errorf("fetch documents: %w", err)
+	}
+
+	// NOTE: Reservoir sampling is used to sample documents in a
+	// single pass without knowing the number of documents.
+	var docInfos []*database.DocInfo
+	seen := 0
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.DocInfo)
+		if !info.RemovedAt.IsZero() {
+			continue
+		}
+
+		seen++
+		if len(docInfos) < size {
+			docInfos = append(docInfos, info.DeepCopy())
+		} else if i := rand.Intn(seen); i < size {
+			docInfos[i] = info.DeepCopy()
+		}
+	}
+
+	return docInfos, nil
+}
+
 // FindDocInfosByQuery returns the docInfos which match the given query.
 func (d *DB) FindDocInfosByQuery(
 	ctx context.Context,
@@ -1318,6 +1853,34 @@ func (d *DB) IsDocumentAttached(
 	return false, nil
 }
 
+// FindAttachedClientInfosByDocID returns the clients that the given document
+// is attached to.
+func (d *DB) FindAttachedClientInfosByDocID(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) ([]*database.ClientInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.Get(tblClients, "project_id", projectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("find clients of %s: %w", projectID, err)
+	}
+
+	var infos []*database.ClientInfo
+	for raw := it.Next(); raw != nil; raw = it.Next() {
+		clientInfo := raw.(*database.ClientInfo)
+		clientDocInfo := clientInfo.Documents[docID]
+		if clientDocInfo == nil || clientDocInfo.Status != database.DocumentAttached {
+			continue
+		}
+		infos = append(infos, clientInfo.DeepCopy())
+	}
+
+	return infos, nil
+}
+
 func (d *DB) findTicketByServerSeq(
 	txn *memdb.Txn,
 	docID types.ID,
@@ -30,6 +30,7 @@ const (
 	projectID    = types.ID("000000000000000000000000")
 	projectOneID = types.ID("000000000000000000000001")
 	projectTwoID = types.ID("000000000000000000000002")
+	projectThrID = types.ID("000000000000000000000003")
 )
 
 func TestDB(t *testing.T) {
@@ -44,6 +45,18 @@ func TestDB(t *testing.T) {
 		testcases.RunFindDocInfosByQueryTest(t, db, projectOneID)
 	})
 
+	t.Run("RunFindDocInfosBySample test", func(t *testing.T) {
+		testcases.RunFindDocInfosBySampleTest(t, db, projectOneID)
+	})
+
+	t.Run("RunUpdateDocInfoACL test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoACLTest(t, db, projectOneID)
+	})
+
+	t.Run("RunUpdateDocInfoReadOnlyReason test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoReadOnlyReasonTest(t, db, projectOneID)
+	})
+
 	t.Run("RunFindChangesBetweenServerSeqs test", func(t *testing.T) {
 		testcases.RunFindChangesBetweenServerSeqsTest(t, db, projectID)
 	})
@@ -72,6 +85,22 @@ func TestDB(t *testing.T) {
 		testcases.RunFindDocInfosByPagingTest(t, db, projectTwoID)
 	})
 
+	t.Run("UpdateDocInfoLabels test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoLabelsTest(t, db, projectOneID)
+	})
+
+	t.Run("UpdateDocInfoSnapshotConfig test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoSnapshotConfigTest(t, db, projectOneID)
+	})
+
+	t.Run("FindClientInfosByPaging test", func(t *testing.T) {
+		testcases.RunFindClientInfosByPagingTest(t, db, projectThrID)
+	})
+
+	t.Run("TemplateInfos test", func(t *testing.T) {
+		testcases.RunTemplateInfosTest(t, db, projectTwoID)
+	})
+
 	t.Run("CreateClientInfo test", func(t *testing.T) {
 		testcases.RunCreateChangeInfosTest(t, db, projectID)
 	})
@@ -83,4 +112,16 @@ func TestDB(t *testing.T) {
 	t.Run("IsDocumentAttached test", func(t *testing.T) {
 		testcases.RunIsDocumentAttachedTest(t, db, projectID)
 	})
+
+	t.Run("FindCompactionCandidates test", func(t *testing.T) {
+		testcases.RunFindCompactionCandidatesTest(t, db, projectID)
+	})
+
+	t.Run("PurgeChangeInfos test", func(t *testing.T) {
+		testcases.RunPurgeChangeInfosTest(t, db, projectID)
+	})
+
+	t.Run("FindAttachedClientInfosByDocID test", func(t *testing.T) {
+		testcases.RunFindAttachedClientInfosByDocIDTest(t, db, projectID)
+	})
 }
@@ -28,6 +28,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	monkey "github.com/undefinedlabs/go-mpatch"
 
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/clock"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/backend/database/memory"
 )
@@ -51,16 +53,16 @@ func TestHousekeeping(t *testing.T) {
 		if err != nil {
 			log.Fatal(err)
 		}
-		clientA, err := memdb.ActivateClient(ctx, project.ID, fmt.Sprintf("%s-A", t.Name()))
+		clientA, err := memdb.ActivateClient(ctx, project.ID, fmt.Sprintf("%s-A", t.Name()), types.ConnectionInfo{})
 		assert.NoError(t, err)
-		clientB, err := memdb.ActivateClient(ctx, project.ID, fmt.Sprintf("%s-B", t.Name()))
+		clientB, err := memdb.ActivateClient(ctx, project.ID, fmt.Sprintf("%s-B", t.Name()), types.ConnectionInfo{})
 		assert.NoError(t, err)
 		err = patch.Unpatch()
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		clientC, err := memdb.ActivateClient(ctx, project.ID, fmt.Sprintf("%s-C", t.Name()))
+		clientC, err := memdb.ActivateClient(ctx, project.ID, fmt.Sprintf("%s-C", t.Name()), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		_, candidates, err := memdb.FindDeactivateCandidates(
@@ -76,6 +78,36 @@ func TestHousekeeping(t *testing.T) {
 		assert.NotContains(t, candidates, clientC)
 	})
 
+	t.Run("housekeeping with clock test", func(t *testing.T) {
+		ctx := context.Background()
+		clk := clock.NewFake(gotime.Now())
+		memdb, err := memory.NewWithClock(clk)
+		assert.NoError(t, err)
+
+		userInfo, err := memdb.CreateUserInfo(ctx, "test", "test")
+		assert.NoError(t, err)
+		project, err := memdb.CreateProjectInfo(ctx, database.DefaultProjectName, userInfo.ID, "23h")
+		assert.NoError(t, err)
+
+		clientA, err := memdb.ActivateClient(ctx, project.ID, fmt.Sprintf("%s-A", t.Name()), types.ConnectionInfo{})
+		assert.NoError(t, err)
+		clk.Advance(gotime.Hour)
+		clientB, err := memdb.ActivateClient(ctx, project.ID, fmt.Sprintf("%s-B", t.Name()), types.ConnectionInfo{})
+		assert.NoError(t, err)
+
+		clk.Advance(23 * gotime.Hour)
+		_, candidates, err := memdb.FindDeactivateCandidates(ctx, 10, 10, database.DefaultProjectID)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 1)
+		assert.Contains(t, candidates, clientA)
+
+		clk.Advance(gotime.Hour)
+		_, candidates, err = memdb.FindDeactivateCandidates(ctx, 10, 10, database.DefaultProjectID)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 2)
+		assert.Contains(t, candidates, clientB)
+	})
+
 	t.Run("housekeeping pagination test", func(t *testing.T) {
 		ctx := context.Background()
 		memdb, projects := createDBandProjects(t)
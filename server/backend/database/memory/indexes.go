@@ -26,6 +26,7 @@ var (
 	tblChanges    = "changes"
 	tblSnapshots  = "snapshots"
 	tblSyncedSeqs = "syncedseqs"
+	tblTemplates  = "templates"
 )
 
 var schema = &memdb.DBSchema{
@@ -87,6 +88,16 @@ var schema = &memdb.DBSchema{
 					Name:    "project_id",
 					Indexer: &memdb.StringFieldIndex{Field: "ProjectID"},
 				},
+				"project_id_id": {
+					Name:   "project_id_id",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "ProjectID"},
+							&memdb.StringFieldIndex{Field: "ID"},
+						},
+					},
+				},
 				"project_id_key": {
 					Name:   "project_id_key",
 					Unique: true,
@@ -218,5 +229,29 @@ var schema = &memdb.DBSchema{
 				},
 			},
 		},
+		tblTemplates: {
+			Name: tblTemplates,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "ID"},
+				},
+				"project_id": {
+					Name:    "project_id",
+					Indexer: &memdb.StringFieldIndex{Field: "ProjectID"},
+				},
+				"project_id_collection": {
+					Name:   "project_id_collection",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "ProjectID"},
+							&memdb.StringFieldIndex{Field: "Collection"},
+						},
+					},
+				},
+			},
+		},
 	},
 }
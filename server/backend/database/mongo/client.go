@@ -30,8 +30,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 
-	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/clock"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
@@ -40,14 +40,39 @@ import (
 	"github.com/yorkie-team/yorkie/server/logging"
 )
 
+// Name is the name of the MongoDB database registered to database.Register.
+const Name = "mongo"
+
+func init() {
+	database.Register(Name, func(conf interface{}, clk clock.Clock) (database.Database, error) {
+		mongoConf, ok := conf.(*Config)
+		if !ok || mongoConf == nil {
+			return nil, fmt.Errorf("%s: %w", Name, database.ErrInvalidDatabaseConfig)
+		}
+
+		return DialWithClock(mongoConf, clk)
+	})
+}
+
 // Client is a client that connects to Mongo DB and reads or saves Yorkie data.
 type Client struct {
 	config *Config
 	client *mongo.Client
+	clock  clock.Clock
+
+	// supportsTransaction is whether the server supports multi-document
+	// transactions. Only replica sets and sharded clusters support them.
+	supportsTransaction bool
 }
 
 // Dial creates an instance of Client and dials the given MongoDB.
 func Dial(conf *Config) (*Client, error) {
+	return DialWithClock(conf, clock.New())
+}
+
+// DialWithClock creates an instance of Client that reads the time of updates
+// and deactivation from the given clock, and dials the given MongoDB.
+func DialWithClock(conf *Config, clk clock.Clock) (*Client, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), conf.ParseConnectionTimeout())
 	defer cancel()
 
@@ -73,14 +98,47 @@ func Dial(conf *Config) (*Client, error) {
 		return nil, err
 	}
 
+	supportsTransaction, err := isTransactionSupported(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
 	logging.DefaultLogger().Infof("MongoDB connected, URI: %s, DB: %s", conf.ConnectionURI, conf.YorkieDatabase)
+	if !supportsTransaction {
+		logging.DefaultLogger().Warn(
+			"MongoDB is a standalone server, pushing multiple documents at once is not supported",
+		)
+	}
 
 	return &Client{
-		config: conf,
-		client: client,
+		config:              conf,
+		client:              client,
+		clock:               clk,
+		supportsTransaction: supportsTransaction,
 	}, nil
 }
 
+// isTransactionSupported returns whether the connected server supports
+// multi-document transactions. A standalone server does not support them,
+// while the members of replica sets and the routers of sharded clusters do.
+func isTransactionSupported(ctx context.Context, client *mongo.Client) (bool, error) {
+	var result struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+
+	// NOTE: "hello" is supported since MongoDB 4.4.2, so "isMaster" is used
+	// for the older servers.
+	admin := client.Database("admin")
+	if err := admin.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&result); err != nil {
+		if err := admin.RunCommand(ctx, bson.D{{Key: "isMaster", Value: 1}}).Decode(&result); err != nil {
+			return false, fmt.Errorf("check topology of mongo: %w", err)
+		}
+	}
+
+	return result.SetName != "" || result.Msg == "isdbgrid", nil
+}
+
 // Close all resources of this client.
 func (c *Client) Close() error {
 	if err := c.client.Disconnect(context.Background()); err != nil {
@@ -382,7 +440,7 @@ func (c *Client) UpdateProjectInfo(
 	if err = bson.Unmarshal(data, &updatableFields); err != nil {
 		return nil, fmt.Errorf("unmarshal updatable fields: %w", err)
 	}
-	updatableFields["updated_at"] = gotime.Now()
+	updatableFields["updated_at"] = c.clock.Now()
 
 	res := c.collection(colProjects).FindOneAndUpdate(ctx, bson.M{
 		"_id":   encodedID,
@@ -463,20 +521,27 @@ func (c *Client) ListUserInfos(
 	return infos, nil
 }
 
-// ActivateClient activates the client of the given key.
-func (c *Client) ActivateClient(ctx context.Context, projectID types.ID, key string) (*database.ClientInfo, error) {
+// ActivateClient activates the client of the given key with the given
+// connection.
+func (c *Client) ActivateClient(
+	ctx context.Context,
+	projectID types.ID,
+	key string,
+	connection types.ConnectionInfo,
+) (*database.ClientInfo, error) {
 	encodedProjectID, err := encodeID(projectID)
 	if err != nil {
 		return nil, err
 	}
 
-	now := gotime.Now()
+	now := c.clock.Now()
 	res, err := c.collection(colClients).UpdateOne(ctx, bson.M{
 		"project_id": encodedProjectID,
 		"key":        key,
 	}, bson.M{
 		"$set": bson.M{
 			"status":     database.ClientActivated,
+			"connection": connection,
 			"updated_at": now,
 		},
 	}, options.Update().SetUpsert(true))
@@ -524,7 +589,7 @@ func (c *Client) DeactivateClient(ctx context.Context, projectID, clientID types
 	}, bson.M{
 		"$set": bson.M{
 			"status":     database.ClientDeactivated,
-			"updated_at": gotime.Now(),
+			"updated_at": c.clock.Now(),
 		},
 	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
 
@@ -555,7 +620,7 @@ func (c *Client) FindClientInfoByID(ctx context.Context, projectID, clientID typ
 		"project_id": encodedProjectID,
 	}, bson.M{
 		"$set": bson.M{
-			"updated_at": gotime.Now(),
+			"updated_at": c.clock.Now(),
 		},
 	})
 
@@ -593,8 +658,9 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 			clientDocInfoKey + "client_seq": clientDocInfo.ClientSeq,
 		},
 		"$set": bson.M{
-			clientDocInfoKey + "status": clientDocInfo.Status,
-			"updated_at":                clientInfo.UpdatedAt,
+			clientDocInfoKey + "status":      clientDocInfo.Status,
+			clientDocInfoKey + "path_filter": clientDocInfo.PathFilter,
+			"updated_at":                     clientInfo.UpdatedAt,
 		},
 	}
 
@@ -606,10 +672,11 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 	if !attached {
 		updater = bson.M{
 			"$set": bson.M{
-				clientDocInfoKey + "server_seq": 0,
-				clientDocInfoKey + "client_seq": 0,
-				clientDocInfoKey + "status":     clientDocInfo.Status,
-				"updated_at":                    clientInfo.UpdatedAt,
+				clientDocInfoKey + "server_seq":  0,
+				clientDocInfoKey + "client_seq":  0,
+				clientDocInfoKey + "status":      clientDocInfo.Status,
+				clientDocInfoKey + "path_filter": "",
+				"updated_at":                     clientInfo.UpdatedAt,
 			},
 		}
 	}
@@ -628,6 +695,57 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 	return nil
 }
 
+// FindClientInfosByPaging returns the clientInfos of the given paging.
+func (c *Client) FindClientInfosByPaging(
+	ctx context.Context,
+	projectID types.ID,
+	paging types.Paging[types.ID],
+) ([]*database.ClientInfo, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"project_id": bson.M{
+			"$eq": encodedProjectID,
+		},
+	}
+	if paging.Offset != "" {
+		encodedOffset, err := encodeID(paging.Offset)
+		if err != nil {
+			return nil, err
+		}
+
+		k := "$lt"
+		if paging.IsForward {
+			k = "$gt"
+		}
+		filter["_id"] = bson.M{
+			k: encodedOffset,
+		}
+	}
+
+	opts := options.Find().SetLimit(int64(paging.PageSize))
+	if paging.IsForward {
+		opts = opts.SetSort(map[string]int{"_id": 1})
+	} else {
+		opts = opts.SetSort(map[string]int{"_id": -1})
+	}
+
+	cursor, err := c.collection(colClients).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find clients: %w", err)
+	}
+
+	var infos []*database.ClientInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, fmt.Errorf("fetch client infos: %w", err)
+	}
+
+	return infos, nil
+}
+
 // findDeactivateCandidatesPerProject finds the clients that need housekeeping per project.
 func (c *Client) findDeactivateCandidatesPerProject(
 	ctx context.Context,
@@ -648,7 +766,7 @@ func (c *Client) findDeactivateCandidatesPerProject(
 		"project_id": encodedProjectID,
 		"status":     database.ClientActivated,
 		"updated_at": bson.M{
-			"$lte": gotime.Now().Add(-clientDeactivateThreshold),
+			"$lte": c.clock.Now().Add(-clientDeactivateThreshold),
 		},
 	}, options.Find().SetLimit(int64(candidatesLimit)))
 
@@ -695,6 +813,42 @@ func (c *Client) FindDeactivateCandidates(
 	return topProjectID, candidates, nil
 }
 
+// FindCompactionCandidates finds the documents whose change logs are
+// compacted by housekeeping.
+func (c *Client) FindCompactionCandidates(
+	ctx context.Context,
+	candidatesLimit int,
+	lastDocID types.ID,
+) (types.ID, []*database.DocInfo, error) {
+	filter := bson.M{}
+	if lastDocID != "" {
+		encodedDocID, err := encodeID(lastDocID)
+		if err != nil {
+			return "", nil, err
+		}
+		filter["_id"] = bson.M{"$gt": encodedDocID}
+	}
+
+	opts := options.Find()
+	opts.SetSort(bson.M{"_id": 1})
+	opts.SetLimit(int64(candidatesLimit))
+
+	cursor, err := c.collection(colDocuments).Find(ctx, filter, opts)
+	if err != nil {
+		return "", nil, fmt.Errorf("find compaction candidates: %w", err)
+	}
+
+	var infos []*database.DocInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		return "", nil, fmt.Errorf("fetch compaction candidates: %w", err)
+	}
+
+	if len(infos) < candidatesLimit {
+		return "", infos, nil
+	}
+	return infos[len(infos)-1].ID, infos, nil
+}
+
 // FindDocInfoByKeyAndOwner finds the document of the given key. If the
 // createDocIfNotExist condition is true, create the document if it does not
 // exist.
@@ -721,7 +875,7 @@ func (c *Client) FindDocInfoByKeyAndOwner(
 			"$exists": false,
 		},
 	}
-	now := gotime.Now()
+	now := c.clock.Now()
 	res, err := c.collection(colDocuments).UpdateOne(ctx, filter, bson.M{
 		"$set": bson.M{
 			"accessed_at": now,
@@ -849,7 +1003,7 @@ func (c *Client) UpdateDocInfoStatusToRemoved(
 		"project_id": encodedProjectID,
 	}, bson.M{
 		"$set": bson.M{
-			"removed_at": gotime.Now(),
+			"removed_at": c.clock.Now(),
 		},
 	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
 
@@ -863,6 +1017,233 @@ func (c *Client) UpdateDocInfoStatusToRemoved(
 	return nil
 }
 
+// UpdateDocInfoACL updates the access control list of the given document.
+func (c *Client) UpdateDocInfoACL(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	acl *types.DocumentACL,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+
+	encodedDocID, err := encodeID(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+	}, bson.M{
+		"$set": bson.M{
+			"acl": acl,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("update document info acl: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpdateDocInfoReadOnlyReason updates the reason why the given document is
+// read-only.
+func (c *Client) UpdateDocInfoReadOnlyReason(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	reason string,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+
+	encodedDocID, err := encodeID(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+	}, bson.M{
+		"$set": bson.M{
+			"read_only_reason": reason,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("update document info read only reason: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpdateDocInfoLabels updates the labels of the given document.
+func (c *Client) UpdateDocInfoLabels(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	labels map[string]string,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+
+	encodedDocID, err := encodeID(id)
+	if err != nil {
+		return err
+	}
+
+	updater := bson.M{"$set": bson.M{"labels": labels}}
+	if len(labels) == 0 {
+		updater = bson.M{"$unset": bson.M{"labels": ""}}
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+	}, updater)
+	if err != nil {
+		return fmt.Errorf("update document info labels: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpdateDocInfoSnapshotConfig updates the snapshot threshold and interval
+// that override the ones of the project for the given document.
+func (c *Client) UpdateDocInfoSnapshotConfig(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	threshold int64,
+	interval int64,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+
+	encodedDocID, err := encodeID(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+	}, bson.M{"$set": bson.M{
+		"snapshot_threshold": threshold,
+		"snapshot_interval":  interval,
+	}})
+	if err != nil {
+		return fmt.Errorf("update document info snapshot config: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpsertTemplateInfo creates or updates the template of the given collection.
+func (c *Client) UpsertTemplateInfo(
+	ctx context.Context,
+	projectID types.ID,
+	collection string,
+	root string,
+) (*database.TemplateInfo, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := c.clock.Now()
+	res := c.collection(colTemplates).FindOneAndUpdate(ctx, bson.M{
+		"project_id": encodedProjectID,
+		"collection": collection,
+	}, bson.M{
+		"$set": bson.M{
+			"root":       root,
+			"updated_at": now,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": now,
+		},
+	}, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After))
+
+	info := database.TemplateInfo{}
+	if err := res.Decode(&info); err != nil {
+		return nil, fmt.Errorf("decode template info: %w", err)
+	}
+
+	return &info, nil
+}
+
+// ListTemplateInfos returns all the templates of the given project.
+func (c *Client) ListTemplateInfos(
+	ctx context.Context,
+	projectID types.ID,
+) ([]*database.TemplateInfo, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.collection(colTemplates).Find(ctx, bson.M{
+		"project_id": encodedProjectID,
+	}, options.Find().SetSort(bson.M{"collection": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("fetch template infos: %w", err)
+	}
+
+	var infos []*database.TemplateInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, fmt.Errorf("fetch template infos: %w", err)
+	}
+
+	return infos, nil
+}
+
+// DeleteTemplateInfo deletes the template of the given collection.
+func (c *Client) DeleteTemplateInfo(
+	ctx context.Context,
+	projectID types.ID,
+	collection string,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.collection(colTemplates).DeleteOne(ctx, bson.M{
+		"project_id": encodedProjectID,
+		"collection": collection,
+	})
+	if err != nil {
+		return fmt.Errorf("delete template info: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", collection, database.ErrTemplateNotFound)
+	}
+
+	return nil
+}
+
 // CreateChangeInfos stores the given changes and doc info.
 func (c *Client) CreateChangeInfos(
 	ctx context.Context,
@@ -872,13 +1253,110 @@ func (c *Client) CreateChangeInfos(
 	changes []*change.Change,
 	isRemoved bool,
 ) error {
-	encodedDocID, err := encodeID(docInfo.ID)
+	now := c.clock.Now()
+
+	// NOTE: The server seqs are allocated before the changes are stored, so
+	// the changes are missing but not duplicated if storing them fails.
+	if err := c.createChangeInfos(ctx, &database.DocChanges{
+		DocInfo:          docInfo,
+		InitialServerSeq: initialServerSeq,
+		Changes:          changes,
+		IsRemoved:        isRemoved,
+	}, now); err != nil {
+		return err
+	}
+	if isRemoved {
+		docInfo.RemovedAt = now
+	}
+
+	return nil
+}
+
+// CreateChangeInfosOfDocs stores the changes of the given documents and their
+// doc infos in a single transaction.
+//
+// NOTE: MongoDB supports multi-document transactions only on replica sets and
+// sharded clusters, so this returns ErrTransactionNotSupported on a standalone
+// server.
+func (c *Client) CreateChangeInfosOfDocs(
+	ctx context.Context,
+	projectID types.ID,
+	docChanges []*database.DocChanges,
+) error {
+	if !c.supportsTransaction {
+		return fmt.Errorf(
+			"store changes of %d documents on standalone mongo: %w",
+			len(docChanges),
+			database.ErrTransactionNotSupported,
+		)
+	}
+
+	session, err := c.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	now := c.clock.Now()
+	if _, err := session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		for _, dc := range docChanges {
+			if err := c.createChangeInfos(sessCtx, dc, now); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, dc := range docChanges {
+		if dc.IsRemoved {
+			dc.DocInfo.RemovedAt = now
+		}
+	}
+
+	return nil
+}
+
+// createChangeInfos stores the changes and the doc info of the given document.
+func (c *Client) createChangeInfos(
+	ctx context.Context,
+	dc *database.DocChanges,
+	now gotime.Time,
+) error {
+	encodedDocID, err := encodeID(dc.DocInfo.ID)
 	if err != nil {
 		return err
 	}
 
+	// 01. allocate the server seqs of the changes by updating the document
+	// only if its server seq is not changed by the other pushes. The update
+	// of a document is atomic, so the changes of the pushes that lose the
+	// race are not stored over the changes of the winner.
+	updateFields := bson.M{
+		"server_seq": dc.DocInfo.ServerSeq,
+		"updated_at": now,
+	}
+	if dc.IsRemoved {
+		updateFields["removed_at"] = now
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"server_seq": dc.InitialServerSeq,
+	}, bson.M{
+		"$set": updateFields,
+	})
+	if err != nil {
+		return fmt.Errorf("update document: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", dc.DocInfo.ID, database.ErrConflictOnUpdate)
+	}
+
+	// 02. store the changes with the allocated server seqs.
 	var models []mongo.WriteModel
-	for _, cn := range changes {
+	for _, cn := range dc.Changes {
 		encodedOperations, err := database.EncodeOperations(cn.Operations())
 		if err != nil {
 			return err
@@ -901,9 +1379,7 @@ func (c *Client) CreateChangeInfos(
 		}}).SetUpsert(true))
 	}
 
-	// TODO(hackerwins): We need to handle the updates for the two collections
-	// below atomically.
-	if len(changes) > 0 {
+	if len(models) > 0 {
 		if _, err = c.collection(colChanges).BulkWrite(
 			ctx,
 			models,
@@ -913,31 +1389,6 @@ func (c *Client) CreateChangeInfos(
 		}
 	}
 
-	now := gotime.Now()
-	updateFields := bson.M{
-		"server_seq": docInfo.ServerSeq,
-		"updated_at": now,
-	}
-	if isRemoved {
-		updateFields["removed_at"] = now
-	}
-
-	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
-		"_id":        encodedDocID,
-		"server_seq": initialServerSeq,
-	}, bson.M{
-		"$set": updateFields,
-	})
-	if err != nil {
-		return fmt.Errorf("update document: %w", err)
-	}
-	if res.MatchedCount == 0 {
-		return fmt.Errorf("%s: %w", docInfo.ID, database.ErrConflictOnUpdate)
-	}
-	if isRemoved {
-		docInfo.RemovedAt = now
-	}
-
 	return nil
 }
 
@@ -985,6 +1436,55 @@ func (c *Client) PurgeStaleChanges(
 	return nil
 }
 
+// PurgeChangeInfos deletes the changes of the given document whose server
+// seqs are less than or equal to the given server seq, except the changes
+// after the checkpoints of the clients attached to the document.
+func (c *Client) PurgeChangeInfos(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	serverSeq int64,
+) (int64, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return 0, err
+	}
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return 0, err
+	}
+
+	// 01. find the smallest checkpoint of the clients attached to the document.
+	clientDocInfoKey := "documents." + docID.String() + "."
+	result := c.collection(colClients).FindOne(ctx, bson.M{
+		"project_id":                encodedProjectID,
+		clientDocInfoKey + "status": database.DocumentAttached,
+	}, options.FindOne().SetSort(bson.M{clientDocInfoKey + "server_seq": 1}))
+	if result.Err() != nil && result.Err() != mongo.ErrNoDocuments {
+		return 0, fmt.Errorf("find attached clients: %w", result.Err())
+	}
+	if result.Err() == nil {
+		clientInfo := &database.ClientInfo{}
+		if err := result.Decode(clientInfo); err != nil {
+			return 0, fmt.Errorf("decode client info: %w", err)
+		}
+		if clientDocInfo := clientInfo.Documents[docID]; clientDocInfo.ServerSeq < serverSeq {
+			serverSeq = clientDocInfo.ServerSeq
+		}
+	}
+
+	// 02. delete the changes before the server seq.
+	deleted, err := c.collection(colChanges).DeleteMany(ctx, bson.M{
+		"doc_id":     encodedDocID,
+		"server_seq": bson.M{"$lte": serverSeq},
+	}, options.Delete())
+	if err != nil {
+		return 0, fmt.Errorf("delete changes: %w", err)
+	}
+
+	return deleted.DeletedCount, nil
+}
+
 // FindChangesBetweenServerSeqs returns the changes between two server sequences.
 func (c *Client) FindChangesBetweenServerSeqs(
 	ctx context.Context,
@@ -1040,27 +1540,86 @@ func (c *Client) FindChangeInfosBetweenServerSeqs(
 	return infos, nil
 }
 
-// CreateSnapshotInfo stores the snapshot of the given document.
+// CreateSnapshotInfo stores the given encoded snapshot of the given document.
 func (c *Client) CreateSnapshotInfo(
 	ctx context.Context,
 	docID types.ID,
 	doc *document.InternalDocument,
+	snapshot []byte,
 ) error {
 	encodedDocID, err := encodeID(docID)
 	if err != nil {
 		return err
 	}
-	snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
-	if err != nil {
-		return err
-	}
 
 	if _, err := c.collection(colSnapshots).InsertOne(ctx, bson.M{
 		"doc_id":     encodedDocID,
 		"server_seq": doc.Checkpoint().ServerSeq,
 		"lamport":    doc.Lamport(),
 		"snapshot":   snapshot,
-		"created_at": gotime.Now(),
+		"size":       int64(len(snapshot)),
+		"elements":   int64(doc.Root().ElementMapLen()),
+		"created_at": c.clock.Now(),
+	}); err != nil {
+		return fmt.Errorf("insert snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// CreateOffloadedSnapshotInfo stores the metadata of the snapshot of the
+// given document whose data is stored in an object storage.
+func (c *Client) CreateOffloadedSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	size int64,
+	storageKey string,
+) error {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.collection(colSnapshots).InsertOne(ctx, bson.M{
+		"doc_id":      encodedDocID,
+		"server_seq":  doc.Checkpoint().ServerSeq,
+		"lamport":     doc.Lamport(),
+		"size":        size,
+		"elements":    int64(doc.Root().ElementMapLen()),
+		"storage_key": storageKey,
+		"created_at":  c.clock.Now(),
+	}); err != nil {
+		return fmt.Errorf("insert snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// CreateDeltaSnapshotInfo stores the snapshot of the given document as the
+// given delta from the snapshot at the given base server seq.
+func (c *Client) CreateDeltaSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	baseServerSeq int64,
+	delta []byte,
+	size int64,
+) error {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.collection(colSnapshots).InsertOne(ctx, bson.M{
+		"doc_id":          encodedDocID,
+		"server_seq":      doc.Checkpoint().ServerSeq,
+		"lamport":         doc.Lamport(),
+		"snapshot":        delta,
+		"size":            size,
+		"elements":        int64(doc.Root().ElementMapLen()),
+		"base_server_seq": baseServerSeq,
+		"created_at":      c.clock.Now(),
 	}); err != nil {
 		return fmt.Errorf("insert snapshot: %w", err)
 	}
@@ -1225,6 +1784,7 @@ func (c *Client) UpdateAndFindMinSyncedTicket(
 func (c *Client) FindDocInfosByPaging(
 	ctx context.Context,
 	projectID types.ID,
+	docFilter types.DocumentFilter,
 	paging types.Paging[types.ID],
 ) ([]*database.DocInfo, error) {
 	encodedProjectID, err := encodeID(projectID)
@@ -1240,6 +1800,24 @@ func (c *Client) FindDocInfosByPaging(
 			"$exists": false,
 		},
 	}
+	if !docFilter.Labels.IsEmpty() {
+		filter["$and"] = labelSelectorFilter(docFilter.Labels)
+	}
+	if docFilter.KeyPrefix != "" {
+		filter["key"] = bson.M{"$regex": primitive.Regex{
+			Pattern: "^" + escapeRegex(docFilter.KeyPrefix),
+		}}
+	}
+	if docFilter.HasUpdatedRange() {
+		updatedAt := bson.M{"$exists": true}
+		if !docFilter.UpdatedAfter.IsZero() {
+			updatedAt["$gte"] = docFilter.UpdatedAfter
+		}
+		if !docFilter.UpdatedBefore.IsZero() {
+			updatedAt["$lt"] = docFilter.UpdatedBefore
+		}
+		filter["updated_at"] = updatedAt
+	}
 	if paging.Offset != "" {
 		encodedOffset, err := encodeID(paging.Offset)
 		if err != nil {
@@ -1275,6 +1853,47 @@ func (c *Client) FindDocInfosByPaging(
 	return infos, nil
 }
 
+// labelSelectorFilter returns the filters of the given label selector.
+func labelSelectorFilter(selector types.LabelSelector) bson.A {
+	var filters bson.A
+	for _, req := range selector {
+		field := "labels." + req.Key
+		switch req.Operator {
+		case types.LabelEquals:
+			filters = append(filters, bson.M{field: req.Value})
+		case types.LabelNotEquals:
+			filters = append(filters, bson.M{field: bson.M{"$ne": req.Value}})
+		case types.LabelExists:
+			filters = append(filters, bson.M{field: bson.M{"$exists": true}})
+		case types.LabelNotExists:
+			filters = append(filters, bson.M{field: bson.M{"$exists": false}})
+		}
+	}
+	return filters
+}
+
+// FindDocInfosBySample returns at most the given number of docInfos that are
+// sampled randomly from the documents of all projects.
+func (c *Client) FindDocInfosBySample(
+	ctx context.Context,
+	size int,
+) ([]*database.DocInfo, error) {
+	cursor, err := c.collection(colDocuments).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"removed_at": bson.M{"$exists": false}}}},
+		{{Key: "$sample", Value: bson.M{"size": size}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sample documents: %w", err)
+	}
+
+	var infos []*database.DocInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, fmt.Errorf("fetch document infos: %w", err)
+	}
+
+	return infos, nil
+}
+
 // FindDocInfosByQuery returns the docInfos which match the given query.
 func (c *Client) FindDocInfosByQuery(
 	ctx context.Context,
@@ -1410,6 +2029,34 @@ func (c *Client) IsDocumentAttached(
 	return true, nil
 }
 
+// FindAttachedClientInfosByDocID returns the clients that the given document
+// is attached to.
+func (c *Client) FindAttachedClientInfosByDocID(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) ([]*database.ClientInfo, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.collection(colClients).Find(ctx, bson.M{
+		"project_id": encodedProjectID,
+		"documents." + docID.String() + ".status": database.DocumentAttached,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find attached clients: %w", err)
+	}
+
+	var clientInfos []*database.ClientInfo
+	if err := cursor.All(ctx, &clientInfos); err != nil {
+		return nil, fmt.Errorf("fetch attached clients: %w", err)
+	}
+
+	return clientInfos, nil
+}
+
 func (c *Client) findTicketByServerSeq(
 	ctx context.Context,
 	docID types.ID,
@@ -31,6 +31,7 @@ const (
 	dummyProjectID = types.ID("000000000000000000000000")
 	projectOneID   = types.ID("000000000000000000000001")
 	projectTwoID   = types.ID("000000000000000000000002")
+	projectThrID   = types.ID("000000000000000000000003")
 )
 
 func setupTestWithDummyData(t *testing.T) *mongo.Client {
@@ -60,6 +61,18 @@ func TestClient(t *testing.T) {
 		testcases.RunFindDocInfosByQueryTest(t, cli, projectOneID)
 	})
 
+	t.Run("RunFindDocInfosBySample test", func(t *testing.T) {
+		testcases.RunFindDocInfosBySampleTest(t, cli, projectOneID)
+	})
+
+	t.Run("RunUpdateDocInfoACL test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoACLTest(t, cli, projectOneID)
+	})
+
+	t.Run("RunUpdateDocInfoReadOnlyReason test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoReadOnlyReasonTest(t, cli, projectOneID)
+	})
+
 	t.Run("RunFindChangesBetweenServerSeqs test", func(t *testing.T) {
 		testcases.RunFindChangesBetweenServerSeqsTest(t, cli, dummyProjectID)
 	})
@@ -89,6 +102,22 @@ func TestClient(t *testing.T) {
 		testcases.RunFindDocInfosByPagingTest(t, cli, projectTwoID)
 	})
 
+	t.Run("UpdateDocInfoLabels test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoLabelsTest(t, cli, projectOneID)
+	})
+
+	t.Run("UpdateDocInfoSnapshotConfig test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoSnapshotConfigTest(t, cli, projectOneID)
+	})
+
+	t.Run("FindClientInfosByPaging test", func(t *testing.T) {
+		testcases.RunFindClientInfosByPagingTest(t, cli, projectThrID)
+	})
+
+	t.Run("TemplateInfos test", func(t *testing.T) {
+		testcases.RunTemplateInfosTest(t, cli, projectTwoID)
+	})
+
 	t.Run("CreateChangeInfo test", func(t *testing.T) {
 		testcases.RunCreateChangeInfosTest(t, cli, dummyProjectID)
 	})
@@ -101,6 +130,18 @@ func TestClient(t *testing.T) {
 		testcases.RunIsDocumentAttachedTest(t, cli, dummyProjectID)
 	})
 
+	t.Run("FindCompactionCandidates test", func(t *testing.T) {
+		testcases.RunFindCompactionCandidatesTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("PurgeChangeInfos test", func(t *testing.T) {
+		testcases.RunPurgeChangeInfosTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("FindAttachedClientInfosByDocID test", func(t *testing.T) {
+		testcases.RunFindAttachedClientInfosByDocIDTest(t, cli, dummyProjectID)
+	})
+
 	t.Run("FindDeactivateCandidates test", func(t *testing.T) {
 		testcases.RunFindDeactivateCandidates(t, cli)
 	})
@@ -33,6 +33,7 @@ const (
 	colChanges    = "changes"
 	colSnapshots  = "snapshots"
 	colSyncedSeqs = "syncedseqs"
+	colTemplates  = "templates"
 )
 
 type collectionInfo struct {
@@ -97,6 +98,10 @@ var collectionInfos = []collectionInfo{
 					{Key: "removed_at", Value: bsonx.Null()},
 				},
 			).SetUnique(true),
+		}, {
+			Keys: bsonx.Doc{
+				{Key: "labels.$**", Value: bsonx.Int32(1)},
+			},
 		}},
 	}, {
 		name: colChanges,
@@ -131,6 +136,15 @@ var collectionInfos = []collectionInfo{
 				{Key: "actor_id", Value: bsonx.Int32(1)},
 			},
 		}},
+	}, {
+		name: colTemplates,
+		indexes: []mongo.IndexModel{{
+			Keys: bsonx.Doc{
+				{Key: "project_id", Value: bsonx.Int32(1)},
+				{Key: "collection", Value: bsonx.Int32(1)},
+			},
+			Options: options.Index().SetUnique(true),
+		}},
 	},
 }
 
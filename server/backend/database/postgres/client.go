@@ -0,0 +1,2046 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package postgres implements database interfaces using PostgreSQL.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	gotime "time"
+
+	"github.com/lib/pq"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/clock"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// Name is the name of the PostgreSQL database registered to database.Register.
+const Name = "postgres"
+
+func init() {
+	database.Register(Name, func(conf interface{}, clk clock.Clock) (database.Database, error) {
+		postgresConf, ok := conf.(*Config)
+		if !ok || postgresConf == nil {
+			return nil, fmt.Errorf("%s: %w", Name, database.ErrInvalidDatabaseConfig)
+		}
+
+		return DialWithClock(postgresConf, clk)
+	})
+}
+
+// queryer is the common interface of *sql.DB and *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Client is a client that connects to PostgreSQL and reads or saves Yorkie
+// data.
+type Client struct {
+	config *Config
+	db     *sql.DB
+	clock  clock.Clock
+}
+
+// Dial creates an instance of Client and dials the given PostgreSQL.
+func Dial(conf *Config) (*Client, error) {
+	return DialWithClock(conf, clock.New())
+}
+
+// DialWithClock creates an instance of Client that reads the time of updates
+// and deactivation from the given clock, and dials the given PostgreSQL.
+func DialWithClock(conf *Config, clk clock.Clock) (*Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), conf.ParseConnectionTimeout())
+	defer cancel()
+
+	dsn, err := conf.dataSourceName()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("connect to postgres: %w", err)
+	}
+
+	ctxPing, cancel := context.WithTimeout(ctx, conf.ParsePingTimeout())
+	defer cancel()
+
+	if err := db.PingContext(ctxPing); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("ping postgres: %w", err)
+	}
+
+	if err := ensureSchema(ctx, db, conf.YorkieSchema); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	logging.DefaultLogger().Infof("PostgreSQL connected, Schema: %s", conf.YorkieSchema)
+
+	return &Client{
+		config: conf,
+		db:     db,
+		clock:  clk,
+	}, nil
+}
+
+// Close all resources of this client.
+func (c *Client) Close() error {
+	if err := c.db.Close(); err != nil {
+		return fmt.Errorf("close postgres client: %w", err)
+	}
+
+	return nil
+}
+
+// EnsureDefaultUserAndProject creates the default user and project if they do not exist.
+func (c *Client) EnsureDefaultUserAndProject(
+	ctx context.Context,
+	username,
+	password string,
+	clientDeactivateThreshold string,
+) (*database.UserInfo, *database.ProjectInfo, error) {
+	userInfo, err := c.ensureDefaultUserInfo(ctx, username, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectInfo, err := c.ensureDefaultProjectInfo(ctx, userInfo.ID, clientDeactivateThreshold)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return userInfo, projectInfo, nil
+}
+
+// ensureDefaultUserInfo creates the default user info if it does not exist.
+func (c *Client) ensureDefaultUserInfo(
+	ctx context.Context,
+	username,
+	password string,
+) (*database.UserInfo, error) {
+	hashedPassword, err := database.HashedPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate := database.NewUserInfo(username, hashedPassword)
+	if _, err := c.db.ExecContext(ctx, `
+		INSERT INTO users (id, username, hashed_password, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (username) DO NOTHING`,
+		string(newID()), candidate.Username, candidate.HashedPassword, candidate.CreatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("upsert default user info: %w", err)
+	}
+
+	info, err := scanUserInfo(c.db.QueryRowContext(ctx,
+		`SELECT `+userColumns+` FROM users WHERE username = $1`,
+		candidate.Username,
+	))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("default: %w", database.ErrUserNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find user info: %w", err)
+	}
+
+	return info, nil
+}
+
+// ensureDefaultProjectInfo creates the default project info if it does not exist.
+func (c *Client) ensureDefaultProjectInfo(
+	ctx context.Context,
+	defaultUserID types.ID,
+	defaultClientDeactivateThreshold string,
+) (*database.ProjectInfo, error) {
+	candidate := database.NewProjectInfo(database.DefaultProjectName, defaultUserID, defaultClientDeactivateThreshold)
+	candidate.ID = database.DefaultProjectID
+
+	if _, err := c.db.ExecContext(ctx, `
+		INSERT INTO projects (
+			id, name, owner, client_deactivate_threshold, public_key, secret_key, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT DO NOTHING`,
+		candidate.ID.String(),
+		candidate.Name,
+		candidate.Owner.String(),
+		candidate.ClientDeactivateThreshold,
+		candidate.PublicKey,
+		candidate.SecretKey,
+		candidate.CreatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("create default project: %w", err)
+	}
+
+	info, err := scanProjectInfo(c.db.QueryRowContext(ctx,
+		`SELECT `+projectColumns+` FROM projects WHERE id = $1`,
+		candidate.ID.String(),
+	))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("default: %w", database.ErrProjectNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find project info: %w", err)
+	}
+
+	return info, nil
+}
+
+// CreateProjectInfo creates a new project.
+func (c *Client) CreateProjectInfo(
+	ctx context.Context,
+	name string,
+	owner types.ID,
+	clientDeactivateThreshold string,
+) (*database.ProjectInfo, error) {
+	if err := owner.Validate(); err != nil {
+		return nil, err
+	}
+
+	info := database.NewProjectInfo(name, owner, clientDeactivateThreshold)
+	info.ID = newID()
+	if _, err := c.db.ExecContext(ctx, `
+		INSERT INTO projects (
+			id, name, owner, client_deactivate_threshold, public_key, secret_key, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		info.ID.String(),
+		info.Name,
+		info.Owner.String(),
+		info.ClientDeactivateThreshold,
+		info.PublicKey,
+		info.SecretKey,
+		info.CreatedAt,
+	); err != nil {
+		if isUniqueViolation(err) {
+			return nil, database.ErrProjectAlreadyExists
+		}
+
+		return nil, fmt.Errorf("create project info: %w", err)
+	}
+
+	return info, nil
+}
+
+// listProjectInfos returns all project infos rotationally.
+func (c *Client) listProjectInfos(
+	ctx context.Context,
+	pageSize int,
+	housekeepingLastProjectID types.ID,
+) ([]*database.ProjectInfo, error) {
+	if err := housekeepingLastProjectID.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.queryProjectInfos(ctx,
+		`SELECT `+projectColumns+` FROM projects WHERE id > $1 ORDER BY id LIMIT $2`,
+		housekeepingLastProjectID.String(), pageSize,
+	)
+}
+
+// ListProjectInfos returns all project infos owned by owner.
+func (c *Client) ListProjectInfos(
+	ctx context.Context,
+	owner types.ID,
+) ([]*database.ProjectInfo, error) {
+	if err := owner.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.queryProjectInfos(ctx,
+		`SELECT `+projectColumns+` FROM projects WHERE owner = $1 ORDER BY id`,
+		owner.String(),
+	)
+}
+
+// FindProjectInfoByPublicKey returns a project by public key.
+func (c *Client) FindProjectInfoByPublicKey(ctx context.Context, publicKey string) (*database.ProjectInfo, error) {
+	info, err := scanProjectInfo(c.db.QueryRowContext(ctx,
+		`SELECT `+projectColumns+` FROM projects WHERE public_key = $1`,
+		publicKey,
+	))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s: %w", publicKey, database.ErrProjectNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find project info: %w", err)
+	}
+
+	return info, nil
+}
+
+// FindProjectInfoByName returns a project by name.
+func (c *Client) FindProjectInfoByName(
+	ctx context.Context,
+	owner types.ID,
+	name string,
+) (*database.ProjectInfo, error) {
+	if err := owner.Validate(); err != nil {
+		return nil, err
+	}
+
+	info, err := scanProjectInfo(c.db.QueryRowContext(ctx,
+		`SELECT `+projectColumns+` FROM projects WHERE owner = $1 AND name = $2`,
+		owner.String(), name,
+	))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s: %w", name, database.ErrProjectNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find project info: %w", err)
+	}
+
+	return info, nil
+}
+
+// FindProjectInfoByID returns a project by the given id.
+func (c *Client) FindProjectInfoByID(ctx context.Context, id types.ID) (*database.ProjectInfo, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	info, err := scanProjectInfo(c.db.QueryRowContext(ctx,
+		`SELECT `+projectColumns+` FROM projects WHERE id = $1`,
+		id.String(),
+	))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s: %w", id, database.ErrProjectNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find project info: %w", err)
+	}
+
+	return info, nil
+}
+
+// UpdateProjectInfo updates the project info.
+func (c *Client) UpdateProjectInfo(
+	ctx context.Context,
+	owner types.ID,
+	id types.ID,
+	fields *types.UpdatableProjectFields,
+) (*database.ProjectInfo, error) {
+	if err := validateIDs(owner, id); err != nil {
+		return nil, err
+	}
+
+	var info *database.ProjectInfo
+	if err := c.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		info, err = scanProjectInfo(tx.QueryRowContext(ctx,
+			`SELECT `+projectColumns+` FROM projects WHERE id = $1 AND owner = $2 FOR UPDATE`,
+			id.String(), owner.String(),
+		))
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("%s: %w", id, database.ErrProjectNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("find project info: %w", err)
+		}
+
+		info.UpdateFields(fields)
+		info.UpdatedAt = c.clock.Now()
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE projects SET
+				name = $2,
+				auth_webhook_url = $3,
+				auth_webhook_methods = $4,
+				auth_jwt_key = $5,
+				auth_jwks_url = $6,
+				sensitive_presence_keys = $7,
+				event_webhook_url = $8,
+				event_webhook_events = $9,
+				document_size_soft_limit = $10,
+				document_size_hard_limit = $11,
+				change_log_soft_limit = $12,
+				change_log_hard_limit = $13,
+				snapshot_threshold = $14,
+				snapshot_interval = $15,
+				client_deactivate_threshold = $16,
+				updated_at = $17
+			WHERE id = $1`,
+			info.ID.String(),
+			info.Name,
+			info.AuthWebhookURL,
+			pq.Array(info.AuthWebhookMethods),
+			info.AuthJWTKey,
+			info.AuthJWKSURL,
+			pq.Array(info.SensitivePresenceKeys),
+			info.EventWebhookURL,
+			pq.Array(info.EventWebhookEvents),
+			info.DocumentSizeSoftLimit,
+			info.DocumentSizeHardLimit,
+			info.ChangeLogSoftLimit,
+			info.ChangeLogHardLimit,
+			info.SnapshotThreshold,
+			info.SnapshotInterval,
+			info.ClientDeactivateThreshold,
+			info.UpdatedAt,
+		); err != nil {
+			if isUniqueViolation(err) {
+				return fmt.Errorf("%s: %w", info.Name, database.ErrProjectNameAlreadyExists)
+			}
+			return fmt.Errorf("update project info: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// CreateUserInfo creates a new user.
+func (c *Client) CreateUserInfo(
+	ctx context.Context,
+	username string,
+	hashedPassword string,
+) (*database.UserInfo, error) {
+	info := database.NewUserInfo(username, hashedPassword)
+	info.ID = newID()
+	info.CreatedAt = encodeTime(info.CreatedAt)
+	if _, err := c.db.ExecContext(ctx, `
+		INSERT INTO users (id, username, hashed_password, created_at)
+		VALUES ($1, $2, $3, $4)`,
+		info.ID.String(), info.Username, info.HashedPassword, info.CreatedAt,
+	); err != nil {
+		if isUniqueViolation(err) {
+			return nil, database.ErrUserAlreadyExists
+		}
+
+		return nil, fmt.Errorf("create user info: %w", err)
+	}
+
+	return info, nil
+}
+
+// FindUserInfo returns a user by username.
+func (c *Client) FindUserInfo(ctx context.Context, username string) (*database.UserInfo, error) {
+	info, err := scanUserInfo(c.db.QueryRowContext(ctx,
+		`SELECT `+userColumns+` FROM users WHERE username = $1`,
+		username,
+	))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s: %w", username, database.ErrUserNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find user info: %w", err)
+	}
+
+	return info, nil
+}
+
+// ListUserInfos returns all users.
+func (c *Client) ListUserInfos(
+	ctx context.Context,
+) ([]*database.UserInfo, error) {
+	rows, err := c.db.QueryContext(ctx, `SELECT `+userColumns+` FROM users ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("list user infos: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var infos []*database.UserInfo
+	for rows.Next() {
+		info, err := scanUserInfo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("fetch all user infos: %w", err)
+		}
+		infos = append(infos, info)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("fetch all user infos: %w", err)
+	}
+
+	return infos, nil
+}
+
+// ActivateClient activates the client of the given key with the given
+// connection.
+func (c *Client) ActivateClient(
+	ctx context.Context,
+	projectID types.ID,
+	key string,
+	connection types.ConnectionInfo,
+) (*database.ClientInfo, error) {
+	if err := projectID.Validate(); err != nil {
+		return nil, err
+	}
+
+	clientInfo, err := c.queryClientInfo(ctx, c.db, `
+		INSERT INTO clients (
+			id, project_id, key, status,
+			connection_ip, connection_user_agent, connection_source,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+		ON CONFLICT (project_id, key) DO UPDATE SET
+			status = EXCLUDED.status,
+			connection_ip = EXCLUDED.connection_ip,
+			connection_user_agent = EXCLUDED.connection_user_agent,
+			connection_source = EXCLUDED.connection_source,
+			updated_at = EXCLUDED.updated_at
+		RETURNING `+clientColumns,
+		string(newID()), projectID.String(), key, database.ClientActivated,
+		connection.IP, connection.UserAgent, connection.Source, c.clock.Now(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("upsert client: %w", err)
+	}
+
+	return clientInfo, nil
+}
+
+// DeactivateClient deactivates the client of the given ID.
+func (c *Client) DeactivateClient(ctx context.Context, projectID, clientID types.ID) (*database.ClientInfo, error) {
+	if err := validateIDs(projectID, clientID); err != nil {
+		return nil, err
+	}
+
+	clientInfo, err := c.queryClientInfo(ctx, c.db, `
+		UPDATE clients SET status = $3, updated_at = $4
+		WHERE id = $1 AND project_id = $2
+		RETURNING `+clientColumns,
+		clientID.String(), projectID.String(), database.ClientDeactivated, c.clock.Now(),
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s: %w", clientID, database.ErrClientNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("deactivate client: %w", err)
+	}
+
+	return clientInfo, nil
+}
+
+// FindClientInfoByID finds the client of the given ID.
+func (c *Client) FindClientInfoByID(ctx context.Context, projectID, clientID types.ID) (*database.ClientInfo, error) {
+	if err := validateIDs(projectID, clientID); err != nil {
+		return nil, err
+	}
+
+	clientInfo, err := c.queryClientInfo(ctx, c.db, `
+		UPDATE clients SET updated_at = $3
+		WHERE id = $1 AND project_id = $2
+		RETURNING `+clientColumns,
+		clientID.String(), projectID.String(), c.clock.Now(),
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s: %w", clientID, database.ErrClientNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find client info: %w", err)
+	}
+
+	return clientInfo, nil
+}
+
+// UpdateClientInfoAfterPushPull updates the client from the given clientInfo
+// after handling PushPull.
+func (c *Client) UpdateClientInfoAfterPushPull(
+	ctx context.Context,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+) error {
+	clientDocInfo, ok := clientInfo.Documents[docInfo.ID]
+	if !ok {
+		return fmt.Errorf("client doc info: %w", database.ErrDocumentNeverAttached)
+	}
+
+	attached, err := clientInfo.IsAttached(docInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The sequences only increase while the document is attached, and
+	// they are reset once it is detached.
+	upsert := `
+		INSERT INTO client_documents (client_id, doc_id, status, server_seq, client_seq, path_filter)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (client_id, doc_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			server_seq = GREATEST(client_documents.server_seq, EXCLUDED.server_seq),
+			client_seq = GREATEST(client_documents.client_seq, EXCLUDED.client_seq),
+			path_filter = EXCLUDED.path_filter`
+	serverSeq, clientSeq, pathFilter := clientDocInfo.ServerSeq, int64(clientDocInfo.ClientSeq), clientDocInfo.PathFilter
+	if !attached {
+		upsert = `
+			INSERT INTO client_documents (client_id, doc_id, status, server_seq, client_seq, path_filter)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (client_id, doc_id) DO UPDATE SET
+				status = EXCLUDED.status,
+				server_seq = EXCLUDED.server_seq,
+				client_seq = EXCLUDED.client_seq,
+				path_filter = EXCLUDED.path_filter`
+		serverSeq, clientSeq, pathFilter = 0, 0, ""
+	}
+
+	return c.withTx(ctx, func(tx *sql.Tx) error {
+		updated, err := updateOne(ctx, tx,
+			`UPDATE clients SET updated_at = $2 WHERE id = $1`,
+			clientInfo.ID.String(), clientInfo.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("update client info: %w", err)
+		}
+		if !updated {
+			return fmt.Errorf("%s: %w", clientInfo.Key, database.ErrClientNotFound)
+		}
+
+		if _, err := tx.ExecContext(ctx, upsert,
+			clientInfo.ID.String(),
+			docInfo.ID.String(),
+			clientDocInfo.Status,
+			serverSeq,
+			clientSeq,
+			pathFilter,
+		); err != nil {
+			return fmt.Errorf("update client info: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// FindClientInfosByPaging returns the clientInfos of the given paging.
+func (c *Client) FindClientInfosByPaging(
+	ctx context.Context,
+	projectID types.ID,
+	paging types.Paging[types.ID],
+) ([]*database.ClientInfo, error) {
+	if err := projectID.Validate(); err != nil {
+		return nil, err
+	}
+
+	conds := []string{"project_id = $1"}
+	args := []interface{}{projectID.String()}
+	if paging.Offset != "" {
+		if err := paging.Offset.Validate(); err != nil {
+			return nil, err
+		}
+		conds = append(conds, fmt.Sprintf("id %s $%d", pagingOperator(paging), len(args)+1))
+		args = append(args, paging.Offset.String())
+	}
+	args = append(args, paging.PageSize)
+
+	infos, err := c.queryClientInfos(ctx, c.db, fmt.Sprintf(
+		`SELECT `+clientColumns+` FROM clients WHERE %s ORDER BY id %s LIMIT $%d`,
+		strings.Join(conds, " AND "), pagingOrder(paging), len(args),
+	), args...)
+	if err != nil {
+		return nil, fmt.Errorf("find clients: %w", err)
+	}
+
+	return infos, nil
+}
+
+// findDeactivateCandidatesPerProject finds the clients that need housekeeping per project.
+func (c *Client) findDeactivateCandidatesPerProject(
+	ctx context.Context,
+	project *database.ProjectInfo,
+	candidatesLimit int,
+) ([]*database.ClientInfo, error) {
+	clientDeactivateThreshold, err := project.ClientDeactivateThresholdAsTimeDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := c.queryClientInfos(ctx, c.db, `
+		SELECT `+clientColumns+` FROM clients
+		WHERE project_id = $1 AND status = $2 AND updated_at <= $3
+		LIMIT $4`,
+		project.ID.String(),
+		database.ClientActivated,
+		c.clock.Now().Add(-clientDeactivateThreshold),
+		candidatesLimit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find deactivate candidates: %w", err)
+	}
+
+	return infos, nil
+}
+
+// FindDeactivateCandidates finds the clients that need housekeeping.
+func (c *Client) FindDeactivateCandidates(
+	ctx context.Context,
+	candidatesLimitPerProject int,
+	projectFetchSize int,
+	lastProjectID types.ID,
+) (types.ID, []*database.ClientInfo, error) {
+	projects, err := c.listProjectInfos(ctx, projectFetchSize, lastProjectID)
+	if err != nil {
+		return database.DefaultProjectID, nil, err
+	}
+
+	var candidates []*database.ClientInfo
+	for _, project := range projects {
+		clientInfos, err := c.findDeactivateCandidatesPerProject(ctx, project, candidatesLimitPerProject)
+		if err != nil {
+			return database.DefaultProjectID, nil, err
+		}
+
+		candidates = append(candidates, clientInfos...)
+	}
+
+	var topProjectID types.ID
+	if len(projects) < projectFetchSize {
+		topProjectID = database.DefaultProjectID
+	} else {
+		topProjectID = projects[len(projects)-1].ID
+	}
+	return topProjectID, candidates, nil
+}
+
+// FindCompactionCandidates finds the documents whose change logs are
+// compacted by housekeeping.
+func (c *Client) FindCompactionCandidates(
+	ctx context.Context,
+	candidatesLimit int,
+	lastDocID types.ID,
+) (types.ID, []*database.DocInfo, error) {
+	infos, err := c.queryDocInfos(ctx,
+		`SELECT `+docColumns+` FROM documents WHERE id > $1 ORDER BY id LIMIT $2`,
+		lastDocID.String(), candidatesLimit,
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf("find compaction candidates: %w", err)
+	}
+
+	if len(infos) < candidatesLimit {
+		return "", infos, nil
+	}
+	return infos[len(infos)-1].ID, infos, nil
+}
+
+// FindDocInfoByKeyAndOwner finds the document of the given key. If the
+// createDocIfNotExist condition is true, create the document if it does not
+// exist.
+func (c *Client) FindDocInfoByKeyAndOwner(
+	ctx context.Context,
+	projectID types.ID,
+	clientID types.ID,
+	docKey key.Key,
+	createDocIfNotExist bool,
+) (*database.DocInfo, error) {
+	if err := validateIDs(projectID, clientID); err != nil {
+		return nil, err
+	}
+
+	now := c.clock.Now()
+	if createDocIfNotExist {
+		info, err := scanDocInfo(c.db.QueryRowContext(ctx, `
+			INSERT INTO documents (id, project_id, key, server_seq, owner, created_at, accessed_at)
+			VALUES ($1, $2, $3, 0, $4, $5, $5)
+			ON CONFLICT (project_id, key) WHERE removed_at IS NULL DO UPDATE SET
+				accessed_at = EXCLUDED.accessed_at
+			RETURNING `+docColumns,
+			string(newID()), projectID.String(), docKey.String(), clientID.String(), now,
+		))
+		if err != nil {
+			return nil, fmt.Errorf("upsert document: %w", err)
+		}
+
+		return info, nil
+	}
+
+	info, err := scanDocInfo(c.db.QueryRowContext(ctx, `
+		UPDATE documents SET accessed_at = $3
+		WHERE project_id = $1 AND key = $2 AND removed_at IS NULL
+		RETURNING `+docColumns,
+		projectID.String(), docKey.String(), now,
+	))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s %s: %w", projectID, docKey, database.ErrDocumentNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find document: %w", err)
+	}
+
+	return info, nil
+}
+
+// FindDocInfoByKey finds the document of the given key.
+func (c *Client) FindDocInfoByKey(
+	ctx context.Context,
+	projectID types.ID,
+	docKey key.Key,
+) (*database.DocInfo, error) {
+	if err := projectID.Validate(); err != nil {
+		return nil, err
+	}
+
+	info, err := scanDocInfo(c.db.QueryRowContext(ctx, `
+		SELECT `+docColumns+` FROM documents
+		WHERE project_id = $1 AND key = $2 AND removed_at IS NULL`,
+		projectID.String(), docKey.String(),
+	))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s %s: %w", projectID, docKey, database.ErrDocumentNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find document: %w", err)
+	}
+
+	return info, nil
+}
+
+// FindDocInfoByID finds a docInfo of the given ID.
+func (c *Client) FindDocInfoByID(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+) (*database.DocInfo, error) {
+	if err := validateIDs(projectID, id); err != nil {
+		return nil, err
+	}
+
+	info, err := scanDocInfo(c.db.QueryRowContext(ctx,
+		`SELECT `+docColumns+` FROM documents WHERE id = $1 AND project_id = $2`,
+		id.String(), projectID.String(),
+	))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find document: %w", err)
+	}
+
+	return info, nil
+}
+
+// UpdateDocInfoStatusToRemoved updates the document status to removed.
+func (c *Client) UpdateDocInfoStatusToRemoved(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+) error {
+	if err := validateIDs(projectID, id); err != nil {
+		return err
+	}
+
+	updated, err := updateOne(ctx, c.db,
+		`UPDATE documents SET removed_at = $3 WHERE id = $1 AND project_id = $2`,
+		id.String(), projectID.String(), c.clock.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("update document info status to removed: %w", err)
+	}
+	if !updated {
+		return fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpdateDocInfoACL updates the access control list of the given document.
+func (c *Client) UpdateDocInfoACL(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	acl *types.DocumentACL,
+) error {
+	if err := validateIDs(projectID, id); err != nil {
+		return err
+	}
+
+	encodedACL, err := encodeJSON(acl, acl == nil)
+	if err != nil {
+		return err
+	}
+
+	updated, err := updateOne(ctx, c.db,
+		`UPDATE documents SET acl = $3 WHERE id = $1 AND project_id = $2`,
+		id.String(), projectID.String(), encodedACL,
+	)
+	if err != nil {
+		return fmt.Errorf("update document info acl: %w", err)
+	}
+	if !updated {
+		return fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpdateDocInfoReadOnlyReason updates the reason why the given document is
+// read-only.
+func (c *Client) UpdateDocInfoReadOnlyReason(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	reason string,
+) error {
+	if err := validateIDs(projectID, id); err != nil {
+		return err
+	}
+
+	updated, err := updateOne(ctx, c.db,
+		`UPDATE documents SET read_only_reason = $3 WHERE id = $1 AND project_id = $2`,
+		id.String(), projectID.String(), reason,
+	)
+	if err != nil {
+		return fmt.Errorf("update document info read only reason: %w", err)
+	}
+	if !updated {
+		return fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpdateDocInfoLabels updates the labels of the given document.
+func (c *Client) UpdateDocInfoLabels(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	labels map[string]string,
+) error {
+	if err := validateIDs(projectID, id); err != nil {
+		return err
+	}
+
+	encodedLabels, err := encodeJSON(labels, len(labels) == 0)
+	if err != nil {
+		return err
+	}
+
+	updated, err := updateOne(ctx, c.db,
+		`UPDATE documents SET labels = $3 WHERE id = $1 AND project_id = $2`,
+		id.String(), projectID.String(), encodedLabels,
+	)
+	if err != nil {
+		return fmt.Errorf("update document info labels: %w", err)
+	}
+	if !updated {
+		return fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpdateDocInfoSnapshotConfig updates the snapshot threshold and interval
+// that override the ones of the project for the given document.
+func (c *Client) UpdateDocInfoSnapshotConfig(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+	threshold int64,
+	interval int64,
+) error {
+	if err := validateIDs(projectID, id); err != nil {
+		return err
+	}
+
+	updated, err := updateOne(ctx, c.db,
+		`UPDATE documents SET snapshot_threshold = $3, snapshot_interval = $4
+		WHERE id = $1 AND project_id = $2`,
+		id.String(), projectID.String(), threshold, interval,
+	)
+	if err != nil {
+		return fmt.Errorf("update document info snapshot config: %w", err)
+	}
+	if !updated {
+		return fmt.Errorf("%s: %w", id, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpsertTemplateInfo creates or updates the template of the given collection.
+func (c *Client) UpsertTemplateInfo(
+	ctx context.Context,
+	projectID types.ID,
+	collection string,
+	root string,
+) (*database.TemplateInfo, error) {
+	if err := projectID.Validate(); err != nil {
+		return nil, err
+	}
+
+	info, err := scanTemplateInfo(c.db.QueryRowContext(ctx, `
+		INSERT INTO templates (id, project_id, collection, root, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (project_id, collection) DO UPDATE SET
+			root = EXCLUDED.root,
+			updated_at = EXCLUDED.updated_at
+		RETURNING `+templateColumns,
+		string(newID()), projectID.String(), collection, root, c.clock.Now(),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("upsert template info: %w", err)
+	}
+
+	return info, nil
+}
+
+// ListTemplateInfos returns all the templates of the given project.
+func (c *Client) ListTemplateInfos(
+	ctx context.Context,
+	projectID types.ID,
+) ([]*database.TemplateInfo, error) {
+	if err := projectID.Validate(); err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx,
+		`SELECT `+templateColumns+` FROM templates WHERE project_id = $1 ORDER BY collection`,
+		projectID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("fetch template infos: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var infos []*database.TemplateInfo
+	for rows.Next() {
+		info, err := scanTemplateInfo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("fetch template infos: %w", err)
+		}
+		infos = append(infos, info)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("fetch template infos: %w", err)
+	}
+
+	return infos, nil
+}
+
+// DeleteTemplateInfo deletes the template of the given collection.
+func (c *Client) DeleteTemplateInfo(
+	ctx context.Context,
+	projectID types.ID,
+	collection string,
+) error {
+	if err := projectID.Validate(); err != nil {
+		return err
+	}
+
+	deleted, err := updateOne(ctx, c.db,
+		`DELETE FROM templates WHERE project_id = $1 AND collection = $2`,
+		projectID.String(), collection,
+	)
+	if err != nil {
+		return fmt.Errorf("delete template info: %w", err)
+	}
+	if !deleted {
+		return fmt.Errorf("%s: %w", collection, database.ErrTemplateNotFound)
+	}
+
+	return nil
+}
+
+// CreateChangeInfos stores the given changes and doc info in a single
+// transaction.
+func (c *Client) CreateChangeInfos(
+	ctx context.Context,
+	projectID types.ID,
+	docInfo *database.DocInfo,
+	initialServerSeq int64,
+	changes []*change.Change,
+	isRemoved bool,
+) error {
+	return c.CreateChangeInfosOfDocs(ctx, projectID, []*database.DocChanges{{
+		DocInfo:          docInfo,
+		InitialServerSeq: initialServerSeq,
+		Changes:          changes,
+		IsRemoved:        isRemoved,
+	}})
+}
+
+// CreateChangeInfosOfDocs stores the changes of the given documents and their
+// doc infos in a single transaction.
+func (c *Client) CreateChangeInfosOfDocs(
+	ctx context.Context,
+	projectID types.ID,
+	docChanges []*database.DocChanges,
+) error {
+	now := c.clock.Now()
+	if err := c.withTx(ctx, func(tx *sql.Tx) error {
+		for _, dc := range docChanges {
+			if err := c.createChangeInfos(ctx, tx, dc, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, dc := range docChanges {
+		if dc.IsRemoved {
+			dc.DocInfo.RemovedAt = now
+		}
+	}
+
+	return nil
+}
+
+// createChangeInfos stores the changes and the doc info of the given document.
+func (c *Client) createChangeInfos(
+	ctx context.Context,
+	tx *sql.Tx,
+	dc *database.DocChanges,
+	now gotime.Time,
+) error {
+	if err := dc.DocInfo.ID.Validate(); err != nil {
+		return err
+	}
+
+	// 01. update the document first to detect the conflicts with the other
+	// pushes of the document before storing the changes.
+	update := `UPDATE documents SET server_seq = $3, updated_at = $4 WHERE id = $1 AND server_seq = $2`
+	if dc.IsRemoved {
+		update = `UPDATE documents SET server_seq = $3, updated_at = $4, removed_at = $4
+			WHERE id = $1 AND server_seq = $2`
+	}
+	updated, err := updateOne(ctx, tx, update,
+		dc.DocInfo.ID.String(), dc.InitialServerSeq, dc.DocInfo.ServerSeq, now,
+	)
+	if err != nil {
+		return fmt.Errorf("update document: %w", err)
+	}
+	if !updated {
+		return fmt.Errorf("%s: %w", dc.DocInfo.ID, database.ErrConflictOnUpdate)
+	}
+
+	if len(dc.Changes) == 0 {
+		return nil
+	}
+
+	// 02. store the changes.
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO changes (
+			id, doc_id, server_seq, client_seq, lamport, actor_id,
+			message, operations, presence_change
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (doc_id, server_seq) DO UPDATE SET
+			client_seq = EXCLUDED.client_seq,
+			lamport = EXCLUDED.lamport,
+			actor_id = EXCLUDED.actor_id,
+			message = EXCLUDED.message,
+			operations = EXCLUDED.operations,
+			presence_change = EXCLUDED.presence_change`)
+	if err != nil {
+		return fmt.Errorf("prepare changes: %w", err)
+	}
+	defer func() {
+		_ = stmt.Close()
+	}()
+
+	for _, cn := range dc.Changes {
+		encodedOperations, err := database.EncodeOperations(cn.Operations())
+		if err != nil {
+			return err
+		}
+		encodedPresence, err := database.EncodePresenceChange(cn.PresenceChange())
+		if err != nil {
+			return err
+		}
+
+		if _, err := stmt.ExecContext(ctx,
+			string(newID()),
+			dc.DocInfo.ID.String(),
+			cn.ServerSeq(),
+			int64(cn.ID().ClientSeq()),
+			cn.ID().Lamport(),
+			encodeActorID(cn.ID().ActorID()),
+			cn.Message(),
+			pq.Array(encodedOperations),
+			encodedPresence,
+		); err != nil {
+			return fmt.Errorf("insert changes: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// PurgeStaleChanges delete changes before the smallest in `syncedseqs` to
+// save storage.
+func (c *Client) PurgeStaleChanges(
+	ctx context.Context,
+	docID types.ID,
+) error {
+	if err := docID.Validate(); err != nil {
+		return err
+	}
+
+	// NOTE: Changes after the smallest server seq in `syncedseqs` are kept
+	// because offline clients can pull them when they become online. Nothing
+	// is deleted if there are no synced seqs.
+	if _, err := c.db.ExecContext(ctx, `
+		DELETE FROM changes
+		WHERE doc_id = $1 AND server_seq < (
+			SELECT MIN(server_seq) FROM syncedseqs WHERE doc_id = $1
+		)`,
+		docID.String(),
+	); err != nil {
+		return fmt.Errorf("delete changes: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeChangeInfos deletes the changes of the given document whose server
+// seqs are less than or equal to the given server seq, except the changes
+// after the checkpoints of the clients attached to the document.
+func (c *Client) PurgeChangeInfos(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	serverSeq int64,
+) (int64, error) {
+	if err := validateIDs(projectID, docID); err != nil {
+		return 0, err
+	}
+
+	// NOTE: LEAST ignores NULL, which is the smallest checkpoint if there are
+	// no clients attached to the document.
+	res, err := c.db.ExecContext(ctx, `
+		DELETE FROM changes
+		WHERE doc_id = $1 AND server_seq <= LEAST($2, (
+			SELECT MIN(cd.server_seq) FROM client_documents cd
+			JOIN clients c ON c.id = cd.client_id
+			WHERE c.project_id = $3 AND cd.doc_id = $1 AND cd.status = $4
+		))`,
+		docID.String(), serverSeq, projectID.String(), database.DocumentAttached,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("delete changes: %w", err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("delete changes: %w", err)
+	}
+	return deleted, nil
+}
+
+// FindChangesBetweenServerSeqs returns the changes between two server sequences.
+func (c *Client) FindChangesBetweenServerSeqs(
+	ctx context.Context,
+	docID types.ID,
+	from int64,
+	to int64,
+) ([]*change.Change, error) {
+	infos, err := c.FindChangeInfosBetweenServerSeqs(ctx, docID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*change.Change
+	for _, info := range infos {
+		c, err := info.ToChange()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+
+	return changes, nil
+}
+
+// FindChangeInfosBetweenServerSeqs returns the changeInfos between two server sequences.
+func (c *Client) FindChangeInfosBetweenServerSeqs(
+	ctx context.Context,
+	docID types.ID,
+	from int64,
+	to int64,
+) ([]*database.ChangeInfo, error) {
+	if err := docID.Validate(); err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, `
+		SELECT `+changeColumns+` FROM changes
+		WHERE doc_id = $1 AND server_seq BETWEEN $2 AND $3
+		ORDER BY server_seq`,
+		docID.String(), from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find changes: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var infos []*database.ChangeInfo
+	for rows.Next() {
+		info, err := scanChangeInfo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("fetch changes: %w", err)
+		}
+		infos = append(infos, info)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("fetch changes: %w", err)
+	}
+
+	return infos, nil
+}
+
+// CreateSnapshotInfo stores the given encoded snapshot of the given document.
+func (c *Client) CreateSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	snapshot []byte,
+) error {
+	if err := docID.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := c.db.ExecContext(ctx, `
+		INSERT INTO snapshots (id, doc_id, server_seq, lamport, snapshot, size, elements, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		string(newID()),
+		docID.String(),
+		doc.Checkpoint().ServerSeq,
+		doc.Lamport(),
+		snapshot,
+		int64(len(snapshot)),
+		int64(doc.Root().ElementMapLen()),
+		c.clock.Now(),
+	); err != nil {
+		return fmt.Errorf("insert snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// CreateOffloadedSnapshotInfo stores the metadata of the snapshot of the
+// given document whose data is stored in an object storage.
+func (c *Client) CreateOffloadedSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	size int64,
+	storageKey string,
+) error {
+	if err := docID.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := c.db.ExecContext(ctx, `
+		INSERT INTO snapshots (id, doc_id, server_seq, lamport, size, elements, storage_key, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		string(newID()),
+		docID.String(),
+		doc.Checkpoint().ServerSeq,
+		doc.Lamport(),
+		size,
+		int64(doc.Root().ElementMapLen()),
+		storageKey,
+		c.clock.Now(),
+	); err != nil {
+		return fmt.Errorf("insert snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// CreateDeltaSnapshotInfo stores the snapshot of the given document as the
+// given delta from the snapshot at the given base server seq.
+func (c *Client) CreateDeltaSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	baseServerSeq int64,
+	delta []byte,
+	size int64,
+) error {
+	if err := docID.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := c.db.ExecContext(ctx, `
+		INSERT INTO snapshots (
+			id, doc_id, server_seq, lamport, snapshot, size, elements, base_server_seq, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		string(newID()),
+		docID.String(),
+		doc.Checkpoint().ServerSeq,
+		doc.Lamport(),
+		delta,
+		size,
+		int64(doc.Root().ElementMapLen()),
+		baseServerSeq,
+		c.clock.Now(),
+	); err != nil {
+		return fmt.Errorf("insert snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// FindSnapshotInfoByID returns the snapshot by the given id.
+func (c *Client) FindSnapshotInfoByID(
+	ctx context.Context,
+	id types.ID,
+) (*database.SnapshotInfo, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	info, err := scanSnapshotInfo(c.db.QueryRowContext(ctx,
+		`SELECT `+snapshotColumns("snapshot")+` FROM snapshots WHERE id = $1`,
+		id.String(),
+	))
+	if err == sql.ErrNoRows {
+		return &database.SnapshotInfo{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find snapshot: %w", err)
+	}
+
+	return info, nil
+}
+
+// FindClosestSnapshotInfo finds the last snapshot of the given document.
+func (c *Client) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	serverSeq int64,
+	includeSnapshot bool,
+) (*database.SnapshotInfo, error) {
+	if err := docID.Validate(); err != nil {
+		return nil, err
+	}
+
+	snapshot := "NULL"
+	if includeSnapshot {
+		snapshot = "snapshot"
+	}
+
+	info, err := scanSnapshotInfo(c.db.QueryRowContext(ctx, `
+		SELECT `+snapshotColumns(snapshot)+` FROM snapshots
+		WHERE doc_id = $1 AND server_seq <= $2
+		ORDER BY server_seq DESC
+		LIMIT 1`,
+		docID.String(), serverSeq,
+	))
+	if err == sql.ErrNoRows {
+		return &database.SnapshotInfo{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find snapshot: %w", err)
+	}
+
+	return info, nil
+}
+
+// FindMinSyncedSeqInfo finds the minimum synced sequence info.
+func (c *Client) FindMinSyncedSeqInfo(
+	ctx context.Context,
+	docID types.ID,
+) (*database.SyncedSeqInfo, error) {
+	if err := docID.Validate(); err != nil {
+		return nil, err
+	}
+
+	info, err := scanSyncedSeqInfo(c.db.QueryRowContext(ctx, `
+		SELECT `+syncedSeqColumns+` FROM syncedseqs
+		WHERE doc_id = $1
+		ORDER BY server_seq
+		LIMIT 1`,
+		docID.String(),
+	))
+	if err == sql.ErrNoRows {
+		return &database.SyncedSeqInfo{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find synced seq: %w", err)
+	}
+
+	return info, nil
+}
+
+// UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
+// and returns the min synced ticket.
+func (c *Client) UpdateAndFindMinSyncedTicket(
+	ctx context.Context,
+	clientInfo *database.ClientInfo,
+	docID types.ID,
+	serverSeq int64,
+) (*time.Ticket, error) {
+	if err := c.UpdateSyncedSeq(ctx, clientInfo, docID, serverSeq); err != nil {
+		return nil, err
+	}
+
+	// 02. find min synced seq of the given document.
+	info, err := scanSyncedSeqInfo(c.db.QueryRowContext(ctx, `
+		SELECT `+syncedSeqColumns+` FROM syncedseqs
+		WHERE doc_id = $1
+		ORDER BY lamport, actor_id
+		LIMIT 1`,
+		docID.String(),
+	))
+	if err == sql.ErrNoRows {
+		return time.InitialTicket, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find smallest syncedseq: %w", err)
+	}
+
+	if info.ServerSeq == change.InitialServerSeq {
+		return time.InitialTicket, nil
+	}
+
+	actorID, err := time.ActorIDFromHex(info.ActorID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return time.NewTicket(
+		info.Lamport,
+		time.MaxDelimiter,
+		actorID,
+	), nil
+}
+
+// FindDocInfosByPaging returns the docInfos of the given paging.
+func (c *Client) FindDocInfosByPaging(
+	ctx context.Context,
+	projectID types.ID,
+	filter types.DocumentFilter,
+	paging types.Paging[types.ID],
+) ([]*database.DocInfo, error) {
+	if err := projectID.Validate(); err != nil {
+		return nil, err
+	}
+
+	conds := []string{"project_id = $1", "removed_at IS NULL"}
+	args := []interface{}{projectID.String()}
+	conds, args = labelSelectorConditions(filter.Labels, conds, args)
+	if filter.KeyPrefix != "" {
+		args = append(args, filter.KeyPrefix)
+		conds = append(conds, fmt.Sprintf("starts_with(key, $%d)", len(args)))
+	}
+	if !filter.UpdatedAfter.IsZero() {
+		args = append(args, filter.UpdatedAfter)
+		conds = append(conds, fmt.Sprintf("updated_at >= $%d", len(args)))
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		args = append(args, filter.UpdatedBefore)
+		conds = append(conds, fmt.Sprintf("updated_at < $%d", len(args)))
+	}
+	if paging.Offset != "" {
+		if err := paging.Offset.Validate(); err != nil {
+			return nil, err
+		}
+		conds = append(conds, fmt.Sprintf("id %s $%d", pagingOperator(paging), len(args)+1))
+		args = append(args, paging.Offset.String())
+	}
+	args = append(args, paging.PageSize)
+
+	infos, err := c.queryDocInfos(ctx, fmt.Sprintf(
+		`SELECT `+docColumns+` FROM documents WHERE %s ORDER BY id %s LIMIT $%d`,
+		strings.Join(conds, " AND "), pagingOrder(paging), len(args),
+	), args...)
+	if err != nil {
+		return nil, fmt.Errorf("find documents: %w", err)
+	}
+
+	return infos, nil
+}
+
+// labelSelectorConditions appends the conditions of the given label selector
+// and their arguments.
+func labelSelectorConditions(
+	selector types.LabelSelector,
+	conds []string,
+	args []interface{},
+) ([]string, []interface{}) {
+	for _, req := range selector {
+		args = append(args, req.Key)
+		keyArg := len(args)
+		switch req.Operator {
+		case types.LabelEquals:
+			args = append(args, req.Value)
+			conds = append(conds, fmt.Sprintf("labels ->> $%d = $%d", keyArg, len(args)))
+		case types.LabelNotEquals:
+			args = append(args, req.Value)
+			conds = append(conds, fmt.Sprintf("(labels ->> $%d) IS DISTINCT FROM $%d", keyArg, len(args)))
+		case types.LabelExists:
+			conds = append(conds, fmt.Sprintf("COALESCE(labels ? $%d, FALSE)", keyArg))
+		case types.LabelNotExists:
+			conds = append(conds, fmt.Sprintf("NOT COALESCE(labels ? $%d, FALSE)", keyArg))
+		}
+	}
+	return conds, args
+}
+
+// FindDocInfosBySample returns at most the given number of docInfos that are
+// sampled randomly from the documents of all projects.
+func (c *Client) FindDocInfosBySample(
+	ctx context.Context,
+	size int,
+) ([]*database.DocInfo, error) {
+	infos, err := c.queryDocInfos(ctx, `
+		SELECT `+docColumns+` FROM documents
+		WHERE removed_at IS NULL
+		ORDER BY random()
+		LIMIT $1`,
+		size,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sample documents: %w", err)
+	}
+
+	return infos, nil
+}
+
+// FindDocInfosByQuery returns the docInfos which match the given query.
+func (c *Client) FindDocInfosByQuery(
+	ctx context.Context,
+	projectID types.ID,
+	query string,
+	pageSize int,
+) (*types.SearchResult[*database.DocInfo], error) {
+	if err := projectID.Validate(); err != nil {
+		return nil, err
+	}
+
+	var totalCount int
+	if err := c.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM documents WHERE project_id = $1 AND starts_with(key, $2)`,
+		projectID.String(), query,
+	).Scan(&totalCount); err != nil {
+		return nil, fmt.Errorf("count document infos: %w", err)
+	}
+
+	infos, err := c.queryDocInfos(ctx, `
+		SELECT `+docColumns+` FROM documents
+		WHERE project_id = $1 AND starts_with(key, $2)
+		ORDER BY key
+		LIMIT $3`,
+		projectID.String(), query, pageSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find document infos: %w", err)
+	}
+
+	return &types.SearchResult[*database.DocInfo]{
+		TotalCount: totalCount,
+		Elements:   infos,
+	}, nil
+}
+
+// UpdateSyncedSeq updates the syncedSeq of the given client.
+func (c *Client) UpdateSyncedSeq(
+	ctx context.Context,
+	clientInfo *database.ClientInfo,
+	docID types.ID,
+	serverSeq int64,
+) error {
+	if err := validateIDs(docID, clientInfo.ID); err != nil {
+		return err
+	}
+
+	// 01. update synced seq of the given client.
+	isAttached, err := clientInfo.IsAttached(docID)
+	if err != nil {
+		return err
+	}
+
+	if !isAttached {
+		if _, err := c.db.ExecContext(ctx,
+			`DELETE FROM syncedseqs WHERE doc_id = $1 AND client_id = $2`,
+			docID.String(), clientInfo.ID.String(),
+		); err != nil {
+			return fmt.Errorf("delete synced seq: %w", err)
+		}
+		return nil
+	}
+
+	ticket, err := c.findTicketByServerSeq(ctx, docID, serverSeq)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: skip storing the initial ticket to prevent GC interruption.
+	//       Documents in this state do not need to be saved because they do not
+	//       have any tombstones to be referenced by other documents.
+	//
+	//       (The initial ticket is used as the creation time of the root
+	//       element that operations can not remove.)
+	if ticket.Compare(time.InitialTicket) == 0 {
+		return nil
+	}
+
+	if _, err := c.db.ExecContext(ctx, `
+		INSERT INTO syncedseqs (id, doc_id, client_id, lamport, actor_id, server_seq)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (doc_id, client_id) DO UPDATE SET
+			lamport = EXCLUDED.lamport,
+			actor_id = EXCLUDED.actor_id,
+			server_seq = EXCLUDED.server_seq`,
+		string(newID()),
+		docID.String(),
+		clientInfo.ID.String(),
+		ticket.Lamport(),
+		encodeActorID(ticket.ActorID()),
+		serverSeq,
+	); err != nil {
+		return fmt.Errorf("upsert synced seq: %w", err)
+	}
+
+	return nil
+}
+
+// IsDocumentAttached returns whether the given document is attached to clients.
+func (c *Client) IsDocumentAttached(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	excludeClientID types.ID,
+) (bool, error) {
+	if err := validateIDs(projectID, docID); err != nil {
+		return false, err
+	}
+	if excludeClientID != "" {
+		if err := excludeClientID.Validate(); err != nil {
+			return false, err
+		}
+	}
+
+	var attached bool
+	if err := c.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM client_documents cd
+			JOIN clients c ON c.id = cd.client_id
+			WHERE c.project_id = $1 AND cd.doc_id = $2 AND cd.status = $3 AND c.id <> $4
+		)`,
+		projectID.String(), docID.String(), database.DocumentAttached, excludeClientID.String(),
+	).Scan(&attached); err != nil {
+		return false, fmt.Errorf("find attached clients: %w", err)
+	}
+
+	return attached, nil
+}
+
+// FindAttachedClientInfosByDocID returns the clients that the given document
+// is attached to.
+func (c *Client) FindAttachedClientInfosByDocID(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) ([]*database.ClientInfo, error) {
+	if err := validateIDs(projectID, docID); err != nil {
+		return nil, err
+	}
+
+	infos, err := c.queryClientInfos(ctx, c.db, `
+		SELECT `+clientColumns+` FROM clients
+		WHERE project_id = $1 AND id IN (
+			SELECT client_id FROM client_documents WHERE doc_id = $2 AND status = $3
+		)
+		ORDER BY id`,
+		projectID.String(), docID.String(), database.DocumentAttached,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find attached clients: %w", err)
+	}
+
+	return infos, nil
+}
+
+func (c *Client) findTicketByServerSeq(
+	ctx context.Context,
+	docID types.ID,
+	serverSeq int64,
+) (*time.Ticket, error) {
+	if serverSeq == change.InitialServerSeq {
+		return time.InitialTicket, nil
+	}
+
+	var lamport int64
+	var actorID string
+	err := c.db.QueryRowContext(ctx,
+		`SELECT lamport, actor_id FROM changes WHERE doc_id = $1 AND server_seq = $2`,
+		docID.String(), serverSeq,
+	).Scan(&lamport, &actorID)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf(
+			"change docID=%s serverSeq=%d: %w",
+			docID.String(),
+			serverSeq,
+			database.ErrDocumentNotFound,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find change: %w", err)
+	}
+
+	decodedActorID, err := time.ActorIDFromHex(actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return time.NewTicket(
+		lamport,
+		time.MaxDelimiter,
+		decodedActorID,
+	), nil
+}
+
+// withTx runs the given function in a transaction. The transaction is rolled
+// back if the function returns an error.
+func (c *Client) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// queryProjectInfos returns the projects of the given query.
+func (c *Client) queryProjectInfos(
+	ctx context.Context,
+	query string,
+	args ...interface{},
+) ([]*database.ProjectInfo, error) {
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("find project infos: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var infos []*database.ProjectInfo
+	for rows.Next() {
+		info, err := scanProjectInfo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("fetch project infos: %w", err)
+		}
+		infos = append(infos, info)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("fetch project infos: %w", err)
+	}
+
+	return infos, nil
+}
+
+// queryClientInfo returns the client of the given query. It returns
+// sql.ErrNoRows if there is no such client.
+func (c *Client) queryClientInfo(
+	ctx context.Context,
+	q queryer,
+	query string,
+	args ...interface{},
+) (*database.ClientInfo, error) {
+	infos, err := c.queryClientInfos(ctx, q, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return infos[0], nil
+}
+
+// queryClientInfos returns the clients of the given query along with the
+// documents attached to them.
+func (c *Client) queryClientInfos(
+	ctx context.Context,
+	q queryer,
+	query string,
+	args ...interface{},
+) ([]*database.ClientInfo, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var infos []*database.ClientInfo
+	var ids []string
+	for rows.Next() {
+		info, err := scanClientInfo(rows)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+		ids = append(ids, info.ID.String())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(infos) == 0 {
+		return nil, nil
+	}
+
+	docRows, err := q.QueryContext(ctx, `
+		SELECT client_id, doc_id, status, server_seq, client_seq, path_filter
+		FROM client_documents WHERE client_id = ANY($1)`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = docRows.Close()
+	}()
+
+	infoByID := make(map[types.ID]*database.ClientInfo, len(infos))
+	for _, info := range infos {
+		infoByID[info.ID] = info
+	}
+	for docRows.Next() {
+		var clientID, docID string
+		var clientSeq int64
+		clientDocInfo := &database.ClientDocInfo{}
+		if err := docRows.Scan(
+			&clientID,
+			&docID,
+			&clientDocInfo.Status,
+			&clientDocInfo.ServerSeq,
+			&clientSeq,
+			&clientDocInfo.PathFilter,
+		); err != nil {
+			return nil, err
+		}
+		clientDocInfo.ClientSeq = uint32(clientSeq)
+
+		info := infoByID[types.ID(clientID)]
+		if info.Documents == nil {
+			info.Documents = make(map[types.ID]*database.ClientDocInfo)
+		}
+		info.Documents[types.ID(docID)] = clientDocInfo
+	}
+	if err := docRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return infos, nil
+}
+
+// queryDocInfos returns the documents of the given query.
+func (c *Client) queryDocInfos(
+	ctx context.Context,
+	query string,
+	args ...interface{},
+) ([]*database.DocInfo, error) {
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var infos []*database.DocInfo
+	for rows.Next() {
+		info, err := scanDocInfo(rows)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return infos, nil
+}
+
+// updateOne runs the given statement and returns whether a row is affected.
+func updateOne(ctx context.Context, q queryer, query string, args ...interface{}) (bool, error) {
+	res, err := q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// pagingOperator returns the operator comparing IDs with the offset of the
+// given paging.
+func pagingOperator(paging types.Paging[types.ID]) string {
+	if paging.IsForward {
+		return ">"
+	}
+	return "<"
+}
+
+// pagingOrder returns the order of IDs of the given paging.
+func pagingOrder(paging types.Paging[types.ID]) string {
+	if paging.IsForward {
+		return "ASC"
+	}
+	return "DESC"
+}
+
+// validateIDs returns ErrInvalidID if one of the given IDs is not ObjectID.
+func validateIDs(ids ...types.ID) error {
+	for _, id := range ids {
+		if err := id.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isUniqueViolation returns whether the given error is caused by a unique
+// constraint.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation"
+}
+
+// newID returns a new ID of ObjectID so that IDs are ordered by creation.
+func newID() types.ID {
+	return types.ID(primitive.NewObjectID().Hex())
+}
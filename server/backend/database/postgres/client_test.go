@@ -0,0 +1,149 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend/database/postgres"
+	"github.com/yorkie-team/yorkie/server/backend/database/testcases"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+const (
+	dummyProjectID = types.ID("000000000000000000000000")
+	projectOneID   = types.ID("000000000000000000000001")
+	projectTwoID   = types.ID("000000000000000000000002")
+	projectThrID   = types.ID("000000000000000000000003")
+)
+
+func setupTestWithDummyData(t *testing.T) *postgres.Client {
+	config := &postgres.Config{
+		ConnectionTimeout: "5s",
+		ConnectionURI:     "postgres://postgres@localhost:5432/postgres?sslmode=disable",
+		YorkieSchema:      helper.TestDBName(),
+		PingTimeout:       "5s",
+	}
+	assert.NoError(t, config.Validate())
+
+	// NOTE: The tests require PostgreSQL running by build/docker/docker-compose.yml.
+	cli, err := postgres.Dial(config)
+	if err != nil {
+		t.Fatalf("dial postgres: %v", err)
+	}
+
+	return cli
+}
+
+func TestClient(t *testing.T) {
+	cli := setupTestWithDummyData(t)
+
+	t.Run("RunFindDocInfo test", func(t *testing.T) {
+		testcases.RunFindDocInfoTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("RunFindDocInfosByQuery test", func(t *testing.T) {
+		testcases.RunFindDocInfosByQueryTest(t, cli, projectOneID)
+	})
+
+	t.Run("RunFindDocInfosBySample test", func(t *testing.T) {
+		testcases.RunFindDocInfosBySampleTest(t, cli, projectOneID)
+	})
+
+	t.Run("RunUpdateDocInfoACL test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoACLTest(t, cli, projectOneID)
+	})
+
+	t.Run("RunUpdateDocInfoReadOnlyReason test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoReadOnlyReasonTest(t, cli, projectOneID)
+	})
+
+	t.Run("RunFindChangesBetweenServerSeqs test", func(t *testing.T) {
+		testcases.RunFindChangesBetweenServerSeqsTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("RunFindClosestSnapshotInfo test", func(t *testing.T) {
+		testcases.RunFindClosestSnapshotInfoTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("ListUserInfos test", func(t *testing.T) {
+		testcases.RunListUserInfosTest(t, cli)
+	})
+
+	t.Run("FindProjectInfoByName test", func(t *testing.T) {
+		testcases.RunFindProjectInfoByNameTest(t, cli)
+	})
+
+	t.Run("ActivateClientDeactivateClient test", func(t *testing.T) {
+		testcases.RunActivateClientDeactivateClientTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("UpdateProjectInfo test", func(t *testing.T) {
+		testcases.RunUpdateProjectInfoTest(t, cli)
+	})
+
+	t.Run("FindDocInfosByPaging test", func(t *testing.T) {
+		testcases.RunFindDocInfosByPagingTest(t, cli, projectTwoID)
+	})
+
+	t.Run("UpdateDocInfoLabels test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoLabelsTest(t, cli, projectOneID)
+	})
+
+	t.Run("UpdateDocInfoSnapshotConfig test", func(t *testing.T) {
+		testcases.RunUpdateDocInfoSnapshotConfigTest(t, cli, projectOneID)
+	})
+
+	t.Run("FindClientInfosByPaging test", func(t *testing.T) {
+		testcases.RunFindClientInfosByPagingTest(t, cli, projectThrID)
+	})
+
+	t.Run("TemplateInfos test", func(t *testing.T) {
+		testcases.RunTemplateInfosTest(t, cli, projectTwoID)
+	})
+
+	t.Run("CreateChangeInfo test", func(t *testing.T) {
+		testcases.RunCreateChangeInfosTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("UpdateClientInfoAfterPushPull test", func(t *testing.T) {
+		testcases.RunUpdateClientInfoAfterPushPullTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("IsDocumentAttached test", func(t *testing.T) {
+		testcases.RunIsDocumentAttachedTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("FindCompactionCandidates test", func(t *testing.T) {
+		testcases.RunFindCompactionCandidatesTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("PurgeChangeInfos test", func(t *testing.T) {
+		testcases.RunPurgeChangeInfosTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("FindAttachedClientInfosByDocID test", func(t *testing.T) {
+		testcases.RunFindAttachedClientInfosByDocIDTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("FindDeactivateCandidates test", func(t *testing.T) {
+		testcases.RunFindDeactivateCandidates(t, cli)
+	})
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgres
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Config is the configuration for creating a Client instance.
+type Config struct {
+	ConnectionTimeout string `yaml:"ConnectionTimeout"`
+	ConnectionURI     string `yaml:"ConnectionURI"`
+	YorkieSchema      string `yaml:"YorkieSchema"`
+	PingTimeout       string `yaml:"PingTimeout"`
+}
+
+// Validate returns an error if the provided Config is invalidated.
+func (c *Config) Validate() error {
+	if _, err := time.ParseDuration(c.ConnectionTimeout); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--postgres-connection-timeout" flag: %w`,
+			c.ConnectionTimeout,
+			err,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.PingTimeout); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--postgres-ping-timeout" flag: %w`,
+			c.PingTimeout,
+			err,
+		)
+	}
+
+	if c.YorkieSchema == "" {
+		return fmt.Errorf(`invalid argument "" for "--postgres-yorkie-schema" flag: must not be empty`)
+	}
+
+	return nil
+}
+
+// ParseConnectionTimeout returns connection timeout duration.
+func (c *Config) ParseConnectionTimeout() time.Duration {
+	result, err := time.ParseDuration(c.ConnectionTimeout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse connection timeout: %w", err)
+		os.Exit(1)
+	}
+
+	return result
+}
+
+// ParsePingTimeout returns ping timeout duration.
+func (c *Config) ParsePingTimeout() time.Duration {
+	result, err := time.ParseDuration(c.PingTimeout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse ping timeout: %w", err)
+		os.Exit(1)
+	}
+
+	return result
+}
+
+// dataSourceName returns the connection URI whose search path is the schema
+// of Yorkie so that the tables are created and read in the schema. Both the
+// URL and the key/value forms of the connection URI are accepted.
+func (c *Config) dataSourceName() (string, error) {
+	if !strings.HasPrefix(c.ConnectionURI, "postgres://") &&
+		!strings.HasPrefix(c.ConnectionURI, "postgresql://") {
+		return fmt.Sprintf("%s search_path='%s'", c.ConnectionURI, pq.QuoteIdentifier(c.YorkieSchema)), nil
+	}
+
+	u, err := url.Parse(c.ConnectionURI)
+	if err != nil {
+		return "", fmt.Errorf("parse connection uri: %w", err)
+	}
+	query := u.Query()
+	query.Set("search_path", pq.QuoteIdentifier(c.YorkieSchema))
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
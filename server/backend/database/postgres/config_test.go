@@ -0,0 +1,51 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/backend/database/postgres"
+)
+
+func TestConfig(t *testing.T) {
+	t.Run("validate test", func(t *testing.T) {
+		// 1. success
+		config := &postgres.Config{
+			ConnectionTimeout: "5s",
+			YorkieSchema:      "yorkie",
+			PingTimeout:       "5s",
+		}
+		assert.NoError(t, config.Validate())
+
+		// 2. invalid connection timeout
+		config.ConnectionTimeout = "5"
+		assert.Error(t, config.Validate())
+
+		// 3. invalid ping timeout
+		config.ConnectionTimeout = "5s"
+		config.PingTimeout = "5"
+		assert.Error(t, config.Validate())
+
+		// 4. empty schema
+		config.PingTimeout = "5s"
+		config.YorkieSchema = ""
+		assert.Error(t, config.Validate())
+	})
+}
@@ -0,0 +1,281 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	gotime "time"
+
+	"github.com/lib/pq"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// Below are the columns of the tables in the order that the scan functions
+// read them.
+const (
+	projectColumns = `id, name, owner, public_key, secret_key, auth_webhook_url, auth_webhook_methods,
+		auth_jwt_key, auth_jwks_url, sensitive_presence_keys, event_webhook_url, event_webhook_events,
+		document_size_soft_limit, document_size_hard_limit, change_log_soft_limit, change_log_hard_limit,
+		snapshot_threshold, snapshot_interval, client_deactivate_threshold, created_at, updated_at`
+	userColumns   = `id, username, hashed_password, created_at`
+	clientColumns = `id, project_id, key, status, connection_ip, connection_user_agent, connection_source,
+		created_at, updated_at`
+	docColumns = `id, project_id, key, server_seq, owner, created_at, accessed_at, updated_at, removed_at,
+		acl, labels, read_only_reason, snapshot_threshold, snapshot_interval`
+	changeColumns = `id, doc_id, server_seq, client_seq, lamport, actor_id, message, operations,
+		presence_change`
+	syncedSeqColumns = `id, doc_id, client_id, lamport, actor_id, server_seq`
+	templateColumns  = `id, project_id, collection, root, created_at, updated_at`
+)
+
+// snapshotColumns returns the columns of snapshots. The given expression is
+// selected in place of the snapshot so that it can be omitted.
+func snapshotColumns(snapshot string) string {
+	return `id, doc_id, server_seq, lamport, ` + snapshot + `, size, elements, storage_key, base_server_seq, created_at`
+}
+
+// scanner is the common interface of *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// utcTime scans a TIMESTAMPTZ column into the given time in UTC. The driver
+// returns the time in the time zone of the session, which is Local by default.
+// NULL is scanned as the zero time.
+type utcTime struct {
+	t *gotime.Time
+}
+
+// Scan implements sql.Scanner.
+func (u utcTime) Scan(src interface{}) error {
+	if src == nil {
+		*u.t = gotime.Time{}
+		return nil
+	}
+
+	t, ok := src.(gotime.Time)
+	if !ok {
+		return fmt.Errorf("scan %T into time", src)
+	}
+	*u.t = t.UTC()
+	return nil
+}
+
+// encodeTime returns the given time in UTC truncated to microseconds, the
+// precision of TIMESTAMPTZ, so that it equals the time read back.
+func encodeTime(t gotime.Time) gotime.Time {
+	return t.UTC().Truncate(gotime.Microsecond)
+}
+
+func encodeActorID(id *time.ActorID) string {
+	return id.String()
+}
+
+// encodeJSON encodes the given value to JSON to be stored in JSONB columns.
+// It returns nil to store NULL if the given value is empty.
+func encodeJSON(v interface{}, isEmpty bool) (interface{}, error) {
+	if isEmpty {
+		return nil, nil
+	}
+
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encode json: %w", err)
+	}
+
+	// NOTE: JSONB parameters are sent as strings because byte slices are
+	// encoded as BYTEA.
+	return string(bytes), nil
+}
+
+func scanProjectInfo(s scanner) (*database.ProjectInfo, error) {
+	info := &database.ProjectInfo{}
+	if err := s.Scan(
+		&info.ID,
+		&info.Name,
+		&info.Owner,
+		&info.PublicKey,
+		&info.SecretKey,
+		&info.AuthWebhookURL,
+		pq.Array(&info.AuthWebhookMethods),
+		&info.AuthJWTKey,
+		&info.AuthJWKSURL,
+		pq.Array(&info.SensitivePresenceKeys),
+		&info.EventWebhookURL,
+		pq.Array(&info.EventWebhookEvents),
+		&info.DocumentSizeSoftLimit,
+		&info.DocumentSizeHardLimit,
+		&info.ChangeLogSoftLimit,
+		&info.ChangeLogHardLimit,
+		&info.SnapshotThreshold,
+		&info.SnapshotInterval,
+		&info.ClientDeactivateThreshold,
+		utcTime{&info.CreatedAt},
+		utcTime{&info.UpdatedAt},
+	); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func scanUserInfo(s scanner) (*database.UserInfo, error) {
+	info := &database.UserInfo{}
+	if err := s.Scan(
+		&info.ID,
+		&info.Username,
+		&info.HashedPassword,
+		utcTime{&info.CreatedAt},
+	); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func scanClientInfo(s scanner) (*database.ClientInfo, error) {
+	info := &database.ClientInfo{}
+	if err := s.Scan(
+		&info.ID,
+		&info.ProjectID,
+		&info.Key,
+		&info.Status,
+		&info.Connection.IP,
+		&info.Connection.UserAgent,
+		&info.Connection.Source,
+		utcTime{&info.CreatedAt},
+		utcTime{&info.UpdatedAt},
+	); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func scanDocInfo(s scanner) (*database.DocInfo, error) {
+	info := &database.DocInfo{}
+	var acl, labels []byte
+	if err := s.Scan(
+		&info.ID,
+		&info.ProjectID,
+		&info.Key,
+		&info.ServerSeq,
+		&info.Owner,
+		utcTime{&info.CreatedAt},
+		utcTime{&info.AccessedAt},
+		utcTime{&info.UpdatedAt},
+		utcTime{&info.RemovedAt},
+		&acl,
+		&labels,
+		&info.ReadOnlyReason,
+		&info.SnapshotThreshold,
+		&info.SnapshotInterval,
+	); err != nil {
+		return nil, err
+	}
+
+	if len(acl) > 0 {
+		info.ACL = &types.DocumentACL{}
+		if err := json.Unmarshal(acl, info.ACL); err != nil {
+			return nil, fmt.Errorf("decode acl: %w", err)
+		}
+	}
+	if len(labels) > 0 {
+		if err := json.Unmarshal(labels, &info.Labels); err != nil {
+			return nil, fmt.Errorf("decode labels: %w", err)
+		}
+	}
+
+	return info, nil
+}
+
+func scanChangeInfo(s scanner) (*database.ChangeInfo, error) {
+	info := &database.ChangeInfo{}
+	var clientSeq int64
+	if err := s.Scan(
+		&info.ID,
+		&info.DocID,
+		&info.ServerSeq,
+		&clientSeq,
+		&info.Lamport,
+		&info.ActorID,
+		&info.Message,
+		pq.Array(&info.Operations),
+		&info.PresenceChange,
+	); err != nil {
+		return nil, err
+	}
+	info.ClientSeq = uint32(clientSeq)
+
+	return info, nil
+}
+
+func scanSnapshotInfo(s scanner) (*database.SnapshotInfo, error) {
+	info := &database.SnapshotInfo{}
+	if err := s.Scan(
+		&info.ID,
+		&info.DocID,
+		&info.ServerSeq,
+		&info.Lamport,
+		&info.Snapshot,
+		&info.Size,
+		&info.Elements,
+		&info.StorageKey,
+		&info.BaseServerSeq,
+		utcTime{&info.CreatedAt},
+	); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func scanSyncedSeqInfo(s scanner) (*database.SyncedSeqInfo, error) {
+	info := &database.SyncedSeqInfo{}
+	if err := s.Scan(
+		&info.ID,
+		&info.DocID,
+		&info.ClientID,
+		&info.Lamport,
+		&info.ActorID,
+		&info.ServerSeq,
+	); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func scanTemplateInfo(s scanner) (*database.TemplateInfo, error) {
+	info := &database.TemplateInfo{}
+	if err := s.Scan(
+		&info.ID,
+		&info.ProjectID,
+		&info.Collection,
+		&info.Root,
+		utcTime{&info.CreatedAt},
+		utcTime{&info.UpdatedAt},
+	); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
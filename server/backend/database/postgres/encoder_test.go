@@ -0,0 +1,49 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgres
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoder(t *testing.T) {
+	t.Run("scan time in UTC test", func(t *testing.T) {
+		loc := gotime.FixedZone("KST", 9*60*60)
+		src := gotime.Date(2023, 1, 2, 3, 4, 5, 6000, loc)
+
+		var scanned gotime.Time
+		assert.NoError(t, utcTime{&scanned}.Scan(src))
+		assert.Equal(t, gotime.UTC, scanned.Location())
+		assert.True(t, src.Equal(scanned))
+
+		assert.NoError(t, utcTime{&scanned}.Scan(nil))
+		assert.True(t, scanned.IsZero())
+		assert.Error(t, utcTime{&scanned}.Scan("2023-01-02"))
+	})
+
+	t.Run("encode time test", func(t *testing.T) {
+		now := gotime.Now()
+		encoded := encodeTime(now)
+
+		var scanned gotime.Time
+		assert.NoError(t, utcTime{&scanned}.Scan(now.Truncate(gotime.Microsecond).In(gotime.Local)))
+		assert.Equal(t, encoded, scanned)
+	})
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// NOTE: IDs are the hex strings of ObjectIDs like the other databases. They
+// are compared in the "C" collation so that paging by IDs follows the order
+// of their creation regardless of the collation of the database.
+
+// Below are the statements that create the tables storing Yorkie data and
+// their indexes. They are idempotent so that they run whenever a client dials.
+var schemaStatements = []string{
+	`CREATE TABLE IF NOT EXISTS projects (
+		id                          TEXT COLLATE "C" PRIMARY KEY,
+		name                        TEXT NOT NULL,
+		owner                       TEXT COLLATE "C" NOT NULL,
+		public_key                  TEXT NOT NULL UNIQUE,
+		secret_key                  TEXT NOT NULL UNIQUE,
+		auth_webhook_url            TEXT NOT NULL DEFAULT '',
+		auth_webhook_methods        TEXT[],
+		auth_jwt_key                TEXT NOT NULL DEFAULT '',
+		auth_jwks_url               TEXT NOT NULL DEFAULT '',
+		sensitive_presence_keys     TEXT[],
+		event_webhook_url           TEXT NOT NULL DEFAULT '',
+		event_webhook_events        TEXT[],
+		document_size_soft_limit    BIGINT NOT NULL DEFAULT 0,
+		document_size_hard_limit    BIGINT NOT NULL DEFAULT 0,
+		change_log_soft_limit       BIGINT NOT NULL DEFAULT 0,
+		change_log_hard_limit       BIGINT NOT NULL DEFAULT 0,
+		snapshot_threshold          BIGINT NOT NULL DEFAULT 0,
+		snapshot_interval           BIGINT NOT NULL DEFAULT 0,
+		client_deactivate_threshold TEXT NOT NULL,
+		created_at                  TIMESTAMPTZ NOT NULL,
+		updated_at                  TIMESTAMPTZ,
+		UNIQUE (owner, name)
+	)`,
+	`CREATE TABLE IF NOT EXISTS users (
+		id              TEXT COLLATE "C" PRIMARY KEY,
+		username        TEXT NOT NULL UNIQUE,
+		hashed_password TEXT NOT NULL,
+		created_at      TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS clients (
+		id                    TEXT COLLATE "C" PRIMARY KEY,
+		project_id            TEXT COLLATE "C" NOT NULL,
+		key                   TEXT NOT NULL,
+		status                TEXT NOT NULL,
+		connection_ip         TEXT NOT NULL DEFAULT '',
+		connection_user_agent TEXT NOT NULL DEFAULT '',
+		connection_source     TEXT NOT NULL DEFAULT '',
+		created_at            TIMESTAMPTZ NOT NULL,
+		updated_at            TIMESTAMPTZ NOT NULL,
+		UNIQUE (project_id, key)
+	)`,
+	`CREATE INDEX IF NOT EXISTS clients_project_id_status_updated_at
+		ON clients (project_id, status, updated_at)`,
+	`CREATE TABLE IF NOT EXISTS client_documents (
+		client_id   TEXT COLLATE "C" NOT NULL,
+		doc_id      TEXT COLLATE "C" NOT NULL,
+		status      TEXT NOT NULL,
+		server_seq  BIGINT NOT NULL DEFAULT 0,
+		client_seq  BIGINT NOT NULL DEFAULT 0,
+		path_filter TEXT NOT NULL DEFAULT '',
+		PRIMARY KEY (client_id, doc_id)
+	)`,
+	`CREATE INDEX IF NOT EXISTS client_documents_doc_id_status
+		ON client_documents (doc_id, status)`,
+	`CREATE TABLE IF NOT EXISTS documents (
+		id                 TEXT COLLATE "C" PRIMARY KEY,
+		project_id         TEXT COLLATE "C" NOT NULL,
+		key                TEXT COLLATE "C" NOT NULL,
+		server_seq         BIGINT NOT NULL DEFAULT 0,
+		owner              TEXT COLLATE "C" NOT NULL,
+		created_at         TIMESTAMPTZ NOT NULL,
+		accessed_at        TIMESTAMPTZ NOT NULL,
+		updated_at         TIMESTAMPTZ,
+		removed_at         TIMESTAMPTZ,
+		acl                JSONB,
+		labels             JSONB,
+		read_only_reason   TEXT NOT NULL DEFAULT '',
+		snapshot_threshold BIGINT NOT NULL DEFAULT 0,
+		snapshot_interval  BIGINT NOT NULL DEFAULT 0
+	)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS documents_project_id_key
+		ON documents (project_id, key) WHERE removed_at IS NULL`,
+	`CREATE INDEX IF NOT EXISTS documents_labels
+		ON documents USING GIN (labels)`,
+	`CREATE TABLE IF NOT EXISTS changes (
+		id              TEXT COLLATE "C" PRIMARY KEY,
+		doc_id          TEXT COLLATE "C" NOT NULL,
+		server_seq      BIGINT NOT NULL,
+		client_seq      BIGINT NOT NULL,
+		lamport         BIGINT NOT NULL,
+		actor_id        TEXT COLLATE "C" NOT NULL,
+		message         TEXT NOT NULL DEFAULT '',
+		operations      BYTEA[],
+		presence_change TEXT NOT NULL DEFAULT '',
+		UNIQUE (doc_id, server_seq)
+	)`,
+	`CREATE TABLE IF NOT EXISTS snapshots (
+		id              TEXT COLLATE "C" PRIMARY KEY,
+		doc_id          TEXT COLLATE "C" NOT NULL,
+		server_seq      BIGINT NOT NULL,
+		lamport         BIGINT NOT NULL,
+		snapshot        BYTEA,
+		size            BIGINT NOT NULL DEFAULT 0,
+		elements        BIGINT NOT NULL DEFAULT 0,
+		storage_key     TEXT NOT NULL DEFAULT '',
+		base_server_seq BIGINT NOT NULL DEFAULT 0,
+		created_at      TIMESTAMPTZ NOT NULL,
+		UNIQUE (doc_id, server_seq)
+	)`,
+	`CREATE TABLE IF NOT EXISTS syncedseqs (
+		id         TEXT COLLATE "C" PRIMARY KEY,
+		doc_id     TEXT COLLATE "C" NOT NULL,
+		client_id  TEXT COLLATE "C" NOT NULL,
+		lamport    BIGINT NOT NULL,
+		actor_id   TEXT COLLATE "C" NOT NULL,
+		server_seq BIGINT NOT NULL,
+		UNIQUE (doc_id, client_id)
+	)`,
+	`CREATE INDEX IF NOT EXISTS syncedseqs_doc_id_lamport_actor_id
+		ON syncedseqs (doc_id, lamport, actor_id)`,
+	`CREATE TABLE IF NOT EXISTS templates (
+		id         TEXT COLLATE "C" PRIMARY KEY,
+		project_id TEXT COLLATE "C" NOT NULL,
+		collection TEXT COLLATE "C" NOT NULL,
+		root       TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL,
+		updated_at TIMESTAMPTZ NOT NULL,
+		UNIQUE (project_id, collection)
+	)`,
+}
+
+// ensureSchema creates the schema of Yorkie and its tables if they do not
+// exist.
+func ensureSchema(ctx context.Context, db *sql.DB, schema string) error {
+	if _, err := db.ExecContext(
+		ctx,
+		"CREATE SCHEMA IF NOT EXISTS "+pq.QuoteIdentifier(schema),
+	); err != nil {
+		return fmt.Errorf("create schema: %w", err)
+	}
+
+	for _, stmt := range schemaStatements {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("create tables: %w", err)
+		}
+	}
+
+	return nil
+}
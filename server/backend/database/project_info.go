@@ -57,6 +57,48 @@ type ProjectInfo struct {
 	// AuthWebhookMethods is the methods that run the authorization webhook.
 	AuthWebhookMethods []string `bson:"auth_webhook_methods"`
 
+	// AuthJWTKey is the static key that verifies the JWTs of clients.
+	AuthJWTKey string `bson:"auth_jwt_key"`
+
+	// AuthJWKSURL is the url of the JSON Web Key Set that verifies the JWTs
+	// of clients.
+	AuthJWKSURL string `bson:"auth_jwks_url"`
+
+	// SensitivePresenceKeys are the keys of presences whose values are
+	// encrypted before they are stored.
+	SensitivePresenceKeys []string `bson:"sensitive_presence_keys"`
+
+	// EventWebhookURL is the url of the event webhook.
+	EventWebhookURL string `bson:"event_webhook_url"`
+
+	// EventWebhookEvents is the types of the events that are sent to the
+	// event webhook.
+	EventWebhookEvents []string `bson:"event_webhook_events"`
+
+	// DocumentSizeSoftLimit is the snapshot size of a document that triggers
+	// a warning.
+	DocumentSizeSoftLimit int64 `bson:"document_size_soft_limit"`
+
+	// DocumentSizeHardLimit is the snapshot size of a document over which
+	// pushes are rejected.
+	DocumentSizeHardLimit int64 `bson:"document_size_hard_limit"`
+
+	// ChangeLogSoftLimit is the number of changes of a document that triggers
+	// a warning.
+	ChangeLogSoftLimit int64 `bson:"change_log_soft_limit"`
+
+	// ChangeLogHardLimit is the number of changes of a document over which
+	// pushes are rejected.
+	ChangeLogHardLimit int64 `bson:"change_log_hard_limit"`
+
+	// SnapshotThreshold overrides the snapshot threshold of the server for
+	// the documents of the project.
+	SnapshotThreshold int64 `bson:"snapshot_threshold"`
+
+	// SnapshotInterval overrides the snapshot interval of the server for the
+	// documents of the project.
+	SnapshotInterval int64 `bson:"snapshot_interval"`
+
 	// ClientDeactivateThreshold is the time after which clients in
 	// specific project are considered deactivate for housekeeping.
 	ClientDeactivateThreshold string `bson:"client_deactivate_threshold"`
@@ -95,6 +137,17 @@ func (i *ProjectInfo) DeepCopy() *ProjectInfo {
 		SecretKey:                 i.SecretKey,
 		AuthWebhookURL:            i.AuthWebhookURL,
 		AuthWebhookMethods:        i.AuthWebhookMethods,
+		AuthJWTKey:                i.AuthJWTKey,
+		AuthJWKSURL:               i.AuthJWKSURL,
+		SensitivePresenceKeys:     i.SensitivePresenceKeys,
+		EventWebhookURL:           i.EventWebhookURL,
+		EventWebhookEvents:        i.EventWebhookEvents,
+		DocumentSizeSoftLimit:     i.DocumentSizeSoftLimit,
+		DocumentSizeHardLimit:     i.DocumentSizeHardLimit,
+		ChangeLogSoftLimit:        i.ChangeLogSoftLimit,
+		ChangeLogHardLimit:        i.ChangeLogHardLimit,
+		SnapshotThreshold:         i.SnapshotThreshold,
+		SnapshotInterval:          i.SnapshotInterval,
 		ClientDeactivateThreshold: i.ClientDeactivateThreshold,
 		CreatedAt:                 i.CreatedAt,
 		UpdatedAt:                 i.UpdatedAt,
@@ -112,6 +165,39 @@ func (i *ProjectInfo) UpdateFields(fields *types.UpdatableProjectFields) {
 	if fields.AuthWebhookMethods != nil {
 		i.AuthWebhookMethods = *fields.AuthWebhookMethods
 	}
+	if fields.AuthJWTKey != nil {
+		i.AuthJWTKey = *fields.AuthJWTKey
+	}
+	if fields.AuthJWKSURL != nil {
+		i.AuthJWKSURL = *fields.AuthJWKSURL
+	}
+	if fields.SensitivePresenceKeys != nil {
+		i.SensitivePresenceKeys = *fields.SensitivePresenceKeys
+	}
+	if fields.EventWebhookURL != nil {
+		i.EventWebhookURL = *fields.EventWebhookURL
+	}
+	if fields.EventWebhookEvents != nil {
+		i.EventWebhookEvents = *fields.EventWebhookEvents
+	}
+	if fields.DocumentSizeSoftLimit != nil {
+		i.DocumentSizeSoftLimit = *fields.DocumentSizeSoftLimit
+	}
+	if fields.DocumentSizeHardLimit != nil {
+		i.DocumentSizeHardLimit = *fields.DocumentSizeHardLimit
+	}
+	if fields.ChangeLogSoftLimit != nil {
+		i.ChangeLogSoftLimit = *fields.ChangeLogSoftLimit
+	}
+	if fields.ChangeLogHardLimit != nil {
+		i.ChangeLogHardLimit = *fields.ChangeLogHardLimit
+	}
+	if fields.SnapshotThreshold != nil {
+		i.SnapshotThreshold = *fields.SnapshotThreshold
+	}
+	if fields.SnapshotInterval != nil {
+		i.SnapshotInterval = *fields.SnapshotInterval
+	}
 	if fields.ClientDeactivateThreshold != nil {
 		i.ClientDeactivateThreshold = *fields.ClientDeactivateThreshold
 	}
@@ -125,6 +211,17 @@ func (i *ProjectInfo) ToProject() *types.Project {
 		Owner:                     i.Owner,
 		AuthWebhookURL:            i.AuthWebhookURL,
 		AuthWebhookMethods:        i.AuthWebhookMethods,
+		AuthJWTKey:                i.AuthJWTKey,
+		AuthJWKSURL:               i.AuthJWKSURL,
+		SensitivePresenceKeys:     i.SensitivePresenceKeys,
+		EventWebhookURL:           i.EventWebhookURL,
+		EventWebhookEvents:        i.EventWebhookEvents,
+		DocumentSizeSoftLimit:     i.DocumentSizeSoftLimit,
+		DocumentSizeHardLimit:     i.DocumentSizeHardLimit,
+		ChangeLogSoftLimit:        i.ChangeLogSoftLimit,
+		ChangeLogHardLimit:        i.ChangeLogHardLimit,
+		SnapshotThreshold:         i.SnapshotThreshold,
+		SnapshotInterval:          i.SnapshotInterval,
 		ClientDeactivateThreshold: i.ClientDeactivateThreshold,
 		PublicKey:                 i.PublicKey,
 		SecretKey:                 i.SecretKey,
@@ -34,7 +34,12 @@ func TestProjectInfo(t *testing.T) {
 		testName := "testName"
 		testURL := "testUrl"
 		testMethods := []string{"testMethod"}
+		testJWTKey := "testJWTKey"
+		testJWKSURL := "testJWKSUrl"
 		testClientDeactivateThreshold := "2h"
+		testSensitivePresenceKeys := []string{"email"}
+		testEventWebhookURL := "testEventWebhookUrl"
+		testEventWebhookEvents := []string{"testEvent"}
 
 		project.UpdateFields(&types.UpdatableProjectFields{Name: &testName})
 		assert.Equal(t, testName, project.Name)
@@ -46,6 +51,40 @@ func TestProjectInfo(t *testing.T) {
 		assert.Equal(t, testMethods, project.AuthWebhookMethods)
 		assert.Equal(t, dummyOwnerID, project.Owner)
 
+		project.UpdateFields(&types.UpdatableProjectFields{AuthJWTKey: &testJWTKey, AuthJWKSURL: &testJWKSURL})
+		assert.Equal(t, testJWTKey, project.AuthJWTKey)
+		assert.Equal(t, testJWKSURL, project.AuthJWKSURL)
+
+		project.UpdateFields(&types.UpdatableProjectFields{SensitivePresenceKeys: &testSensitivePresenceKeys})
+		assert.Equal(t, testSensitivePresenceKeys, project.SensitivePresenceKeys)
+
+		project.UpdateFields(&types.UpdatableProjectFields{
+			EventWebhookURL:    &testEventWebhookURL,
+			EventWebhookEvents: &testEventWebhookEvents,
+		})
+		assert.Equal(t, testEventWebhookURL, project.EventWebhookURL)
+		assert.Equal(t, testEventWebhookEvents, project.EventWebhookEvents)
+
+		testSoftLimit, testHardLimit := int64(100), int64(200)
+		project.UpdateFields(&types.UpdatableProjectFields{
+			DocumentSizeSoftLimit: &testSoftLimit,
+			DocumentSizeHardLimit: &testHardLimit,
+			ChangeLogSoftLimit:    &testSoftLimit,
+			ChangeLogHardLimit:    &testHardLimit,
+		})
+		assert.Equal(t, testSoftLimit, project.DocumentSizeSoftLimit)
+		assert.Equal(t, testHardLimit, project.DocumentSizeHardLimit)
+		assert.Equal(t, testSoftLimit, project.ChangeLogSoftLimit)
+		assert.Equal(t, testHardLimit, project.ChangeLogHardLimit)
+
+		testThreshold, testInterval := int64(500), int64(1000)
+		project.UpdateFields(&types.UpdatableProjectFields{
+			SnapshotThreshold: &testThreshold,
+			SnapshotInterval:  &testInterval,
+		})
+		assert.Equal(t, testThreshold, project.SnapshotThreshold)
+		assert.Equal(t, testInterval, project.SnapshotInterval)
+
 		project.UpdateFields(&types.UpdatableProjectFields{
 			ClientDeactivateThreshold: &testClientDeactivateThreshold,
 		})
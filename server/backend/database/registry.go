@@ -0,0 +1,100 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/yorkie-team/yorkie/pkg/clock"
+)
+
+var (
+	// ErrUnknownDatabase is returned when no database is registered with the
+	// given name.
+	ErrUnknownDatabase = errors.New("unknown database")
+
+	// ErrInvalidDatabaseConfig is returned when the given config is not the
+	// config of the database.
+	ErrInvalidDatabaseConfig = errors.New("invalid database config")
+)
+
+// Factory creates a Database that reads the time from the given clock. conf
+// is the config of the database given to the server, e.g. *mongo.Config.
+// Implementations that are not configured by the server config should
+// capture their config when they are registered, and receive nil here.
+type Factory func(conf interface{}, clk clock.Clock) (Database, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = make(map[string]Factory)
+)
+
+// Register makes a database implementation available by the given name so
+// that the server can create it by the name in the config. It is intended to
+// be called from the init function of the implementation like the drivers of
+// database/sql. It panics if it is called twice with the same name or if the
+// factory is nil.
+func Register(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	if factory == nil {
+		panic("database: Register factory is nil")
+	}
+	if _, dup := factories[name]; dup {
+		panic("database: Register called twice for " + name)
+	}
+	factories[name] = factory
+}
+
+// IsRegistered returns whether a database is registered with the given name.
+func IsRegistered(name string) bool {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	_, ok := factories[name]
+	return ok
+}
+
+// Registered returns the sorted names of the registered databases.
+func Registered() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New creates a Database with the factory registered by the given name.
+func New(name string, conf interface{}, clk clock.Clock) (Database, error) {
+	factoriesMu.RLock()
+	factory, ok := factories[name]
+	factoriesMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", name, ErrUnknownDatabase)
+	}
+
+	return factory(conf, clk)
+}
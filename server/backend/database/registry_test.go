@@ -0,0 +1,60 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/clock"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/database/memory"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("create database by name test", func(t *testing.T) {
+		assert.True(t, database.IsRegistered(memory.Name))
+
+		db, err := database.New(memory.Name, nil, clock.New())
+		assert.NoError(t, err)
+		assert.IsType(t, &memory.DB{}, db)
+		assert.NoError(t, db.Close())
+	})
+
+	t.Run("unknown database test", func(t *testing.T) {
+		_, err := database.New("unknown", nil, clock.New())
+		assert.ErrorIs(t, err, database.ErrUnknownDatabase)
+	})
+
+	t.Run("register custom database test", func(t *testing.T) {
+		database.Register("custom", func(_ interface{}, clk clock.Clock) (database.Database, error) {
+			return memory.NewWithClock(clk)
+		})
+		assert.Contains(t, database.Registered(), "custom")
+
+		db, err := database.New("custom", nil, clock.New())
+		assert.NoError(t, err)
+		assert.NoError(t, db.Close())
+
+		assert.Panics(t, func() {
+			database.Register("custom", func(_ interface{}, _ clock.Clock) (database.Database, error) {
+				return nil, nil
+			})
+		})
+	})
+}
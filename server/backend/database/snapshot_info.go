@@ -39,6 +39,24 @@ type SnapshotInfo struct {
 	// Snapshot is the snapshot data.
 	Snapshot []byte `bson:"snapshot"`
 
+	// Size is the size of the snapshot data in bytes. It is kept even if the
+	// snapshot data is not fetched.
+	Size int64 `bson:"size"`
+
+	// Elements is the number of the elements of the document at the snapshot.
+	// Like Size, it is kept even if the snapshot data is not fetched.
+	Elements int64 `bson:"elements"`
+
+	// StorageKey is the key of the object that stores the snapshot data if
+	// the data is offloaded to an object storage. Snapshot is empty in that
+	// case.
+	StorageKey string `bson:"storage_key,omitempty"`
+
+	// BaseServerSeq is the server seq of the snapshot that the snapshot is
+	// based on if the snapshot is stored as a delta. Snapshot is the delta
+	// from the base snapshot in that case, and zero means a full snapshot.
+	BaseServerSeq int64 `bson:"base_server_seq,omitempty"`
+
 	// CreatedAt is the time when the snapshot is created.
 	CreatedAt time.Time `bson:"created_at"`
 }
@@ -50,11 +68,15 @@ func (i *SnapshotInfo) DeepCopy() *SnapshotInfo {
 	}
 
 	return &SnapshotInfo{
-		ID:        i.ID,
-		DocID:     i.DocID,
-		ServerSeq: i.ServerSeq,
-		Lamport:   i.Lamport,
-		Snapshot:  i.Snapshot,
-		CreatedAt: i.CreatedAt,
+		ID:            i.ID,
+		DocID:         i.DocID,
+		ServerSeq:     i.ServerSeq,
+		Lamport:       i.Lamport,
+		Snapshot:      i.Snapshot,
+		Size:          i.Size,
+		Elements:      i.Elements,
+		StorageKey:    i.StorageKey,
+		BaseServerSeq: i.BaseServerSeq,
+		CreatedAt:     i.CreatedAt,
 	}
 }
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"strings"
+	"time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// TemplateInfo is a structure representing information of a document
+// template. The template is applied to the documents whose keys start with
+// the collection of the template when they are attached for the first time.
+type TemplateInfo struct {
+	// ID is the unique ID of the template.
+	ID types.ID `bson:"_id"`
+
+	// ProjectID is the ID of the project that the template belongs to.
+	ProjectID types.ID `bson:"project_id"`
+
+	// Collection is the prefix of the document keys to apply the template.
+	Collection string `bson:"collection"`
+
+	// Root is the JSON representation of the root object of the template.
+	Root string `bson:"root"`
+
+	// CreatedAt is the time when the template is created.
+	CreatedAt time.Time `bson:"created_at"`
+
+	// UpdatedAt is the time when the template is updated.
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// Matches returns whether the template can be applied to the document of the
+// given key.
+func (i *TemplateInfo) Matches(k key.Key) bool {
+	return strings.HasPrefix(k.String(), i.Collection)
+}
+
+// DeepCopy returns a deep copy of the TemplateInfo.
+func (i *TemplateInfo) DeepCopy() *TemplateInfo {
+	if i == nil {
+		return nil
+	}
+
+	return &TemplateInfo{
+		ID:         i.ID,
+		ProjectID:  i.ProjectID,
+		Collection: i.Collection,
+		Root:       i.Root,
+		CreatedAt:  i.CreatedAt,
+		UpdatedAt:  i.UpdatedAt,
+	}
+}
+
+// ToDocumentTemplate converts the TemplateInfo to the DocumentTemplate.
+func (i *TemplateInfo) ToDocumentTemplate() *types.DocumentTemplate {
+	return &types.DocumentTemplate{
+		Collection: i.Collection,
+		Root:       i.Root,
+		CreatedAt:  i.CreatedAt,
+		UpdatedAt:  i.UpdatedAt,
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"testing"
@@ -30,6 +31,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	mongodb "go.mongodb.org/mongo-driver/mongo"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
@@ -56,7 +58,7 @@ func RunFindDocInfoTest(
 ) {
 	t.Run("find docInfo test", func(t *testing.T) {
 		ctx := context.Background()
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		_, err = db.FindDocInfoByID(context.Background(), projectID, dummyClientID)
@@ -130,7 +132,7 @@ func RunFindDocInfosByQueryTest(
 ) {
 	t.Run("search docInfos test", func(t *testing.T) {
 		ctx := context.Background()
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		docKeys := []string{
@@ -158,6 +160,295 @@ func RunFindDocInfosByQueryTest(
 	})
 }
 
+// RunFindDocInfosBySampleTest runs the FindDocInfosBySample test for the given db.
+func RunFindDocInfosBySampleTest(
+	t *testing.T,
+	db database.Database,
+	projectID types.ID,
+) {
+	t.Run("sample docInfos test", func(t *testing.T) {
+		ctx := context.Background()
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
+		assert.NoError(t, err)
+
+		var docInfos []*database.DocInfo
+		for i := 0; i < 3; i++ {
+			docKey := key.Key(fmt.Sprintf("%s%d", helper.TestDocKey(t), i))
+			docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+			assert.NoError(t, err)
+			docInfos = append(docInfos, docInfo)
+		}
+		assert.NoError(t, db.UpdateDocInfoStatusToRemoved(ctx, projectID, docInfos[2].ID))
+
+		res, err := db.FindDocInfosBySample(ctx, 2)
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+
+		res, err = db.FindDocInfosBySample(ctx, math.MaxInt32)
+		assert.NoError(t, err)
+		sampled := make(map[types.ID]bool)
+		for _, info := range res {
+			sampled[info.ID] = true
+		}
+		assert.True(t, sampled[docInfos[0].ID])
+		assert.True(t, sampled[docInfos[1].ID])
+		assert.False(t, sampled[docInfos[2].ID])
+	})
+}
+
+// RunUpdateDocInfoACLTest runs the UpdateDocInfoACL test for the given db.
+func RunUpdateDocInfoACLTest(
+	t *testing.T,
+	db database.Database,
+	projectID types.ID,
+) {
+	t.Run("update docInfo acl test", func(t *testing.T) {
+		ctx := context.Background()
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
+		assert.NoError(t, err)
+
+		docKey := helper.TestDocKey(t)
+		docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+		assert.NoError(t, err)
+		assert.True(t, docInfo.ACL.IsEmpty())
+
+		acl := &types.DocumentACL{
+			Readers: []string{types.AnySubject},
+			Writers: []string{"writer"},
+			Admins:  []string{"admin"},
+		}
+		assert.NoError(t, db.UpdateDocInfoACL(ctx, projectID, docInfo.ID, acl))
+
+		updated, err := db.FindDocInfoByKey(ctx, projectID, docKey)
+		assert.NoError(t, err)
+		assert.Equal(t, acl, updated.ACL)
+
+		assert.NoError(t, db.UpdateDocInfoACL(ctx, projectID, docInfo.ID, nil))
+		updated, err = db.FindDocInfoByKey(ctx, projectID, docKey)
+		assert.NoError(t, err)
+		assert.True(t, updated.ACL.IsEmpty())
+
+		err = db.UpdateDocInfoACL(ctx, projectID, dummyClientID, acl)
+		assert.ErrorIs(t, err, database.ErrDocumentNotFound)
+	})
+}
+
+// RunUpdateDocInfoReadOnlyReasonTest runs the UpdateDocInfoReadOnlyReason test
+// for the given db.
+func RunUpdateDocInfoReadOnlyReasonTest(
+	t *testing.T,
+	db database.Database,
+	projectID types.ID,
+) {
+	t.Run("update docInfo read only reason test", func(t *testing.T) {
+		ctx := context.Background()
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
+		assert.NoError(t, err)
+
+		docKey := helper.TestDocKey(t)
+		docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+		assert.NoError(t, err)
+		assert.False(t, docInfo.IsReadOnly())
+
+		reason := "11 changes exceed the change log limit 10"
+		assert.NoError(t, db.UpdateDocInfoReadOnlyReason(ctx, projectID, docInfo.ID, reason))
+
+		updated, err := db.FindDocInfoByKey(ctx, projectID, docKey)
+		assert.NoError(t, err)
+		assert.True(t, updated.IsReadOnly())
+		assert.Equal(t, reason, updated.ReadOnlyReason)
+
+		assert.NoError(t, db.UpdateDocInfoReadOnlyReason(ctx, projectID, docInfo.ID, ""))
+		updated, err = db.FindDocInfoByKey(ctx, projectID, docKey)
+		assert.NoError(t, err)
+		assert.False(t, updated.IsReadOnly())
+
+		err = db.UpdateDocInfoReadOnlyReason(ctx, projectID, dummyClientID, reason)
+		assert.ErrorIs(t, err, database.ErrDocumentNotFound)
+	})
+}
+
+// RunUpdateDocInfoLabelsTest runs the UpdateDocInfoLabels test for the given db.
+func RunUpdateDocInfoLabelsTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("update docInfo labels and find by selector test", func(t *testing.T) {
+		ctx := context.Background()
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
+		assert.NoError(t, err)
+
+		labels := []map[string]string{
+			{"env": "prod", "team": "web"},
+			{"env": "prod", "team": "api"},
+			{"env": "dev"},
+		}
+		var docInfos []*database.DocInfo
+		for i, l := range labels {
+			docKey := key.Key(fmt.Sprintf("%s%d", helper.TestDocKey(t), i))
+			docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+			assert.NoError(t, err)
+			assert.NoError(t, db.UpdateDocInfoLabels(ctx, projectID, docInfo.ID, l))
+			docInfos = append(docInfos, docInfo)
+		}
+
+		updated, err := db.FindDocInfoByID(ctx, projectID, docInfos[0].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, labels[0], updated.Labels)
+
+		selector, err := types.ParseLabelSelector("env=prod,team!=web")
+		assert.NoError(t, err)
+		infos, err := db.FindDocInfosByPaging(ctx, projectID, types.DocumentFilter{Labels: selector}, types.Paging[types.ID]{
+			PageSize:  10,
+			IsForward: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, docInfos[1].ID, infos[0].ID)
+
+		selector, err = types.ParseLabelSelector("env")
+		assert.NoError(t, err)
+		infos, err = db.FindDocInfosByPaging(ctx, projectID, types.DocumentFilter{Labels: selector}, types.Paging[types.ID]{
+			PageSize:  10,
+			IsForward: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 3)
+
+		assert.NoError(t, db.UpdateDocInfoLabels(ctx, projectID, docInfos[2].ID, nil))
+		infos, err = db.FindDocInfosByPaging(ctx, projectID, types.DocumentFilter{Labels: selector}, types.Paging[types.ID]{
+			PageSize:  10,
+			IsForward: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 2)
+
+		err = db.UpdateDocInfoLabels(ctx, projectID, dummyClientID, labels[0])
+		assert.ErrorIs(t, err, database.ErrDocumentNotFound)
+	})
+}
+
+// RunUpdateDocInfoSnapshotConfigTest runs the UpdateDocInfoSnapshotConfig test
+// for the given db.
+func RunUpdateDocInfoSnapshotConfigTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("update docInfo snapshot config test", func(t *testing.T) {
+		ctx := context.Background()
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
+		assert.NoError(t, err)
+
+		docKey := key.Key(helper.TestDocKey(t))
+		docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), docInfo.SnapshotThreshold)
+		assert.Equal(t, int64(0), docInfo.SnapshotInterval)
+
+		assert.NoError(t, db.UpdateDocInfoSnapshotConfig(ctx, projectID, docInfo.ID, 500, 1000))
+		updated, err := db.FindDocInfoByID(ctx, projectID, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(500), updated.SnapshotThreshold)
+		assert.Equal(t, int64(1000), updated.SnapshotInterval)
+
+		assert.NoError(t, db.UpdateDocInfoSnapshotConfig(ctx, projectID, docInfo.ID, 0, 0))
+		updated, err = db.FindDocInfoByKey(ctx, projectID, docKey)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), updated.SnapshotThreshold)
+		assert.Equal(t, int64(0), updated.SnapshotInterval)
+
+		err = db.UpdateDocInfoSnapshotConfig(ctx, projectID, dummyClientID, 500, 1000)
+		assert.ErrorIs(t, err, database.ErrDocumentNotFound)
+	})
+}
+
+// RunTemplateInfosTest runs the template related tests for the given db.
+func RunTemplateInfosTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("upsert, list and delete templateInfos test", func(t *testing.T) {
+		ctx := context.Background()
+
+		board, err := db.UpsertTemplateInfo(ctx, projectID, "board-", `{"cards":[]}`)
+		assert.NoError(t, err)
+		assert.Equal(t, "board-", board.Collection)
+		assert.Equal(t, `{"cards":[]}`, board.Root)
+
+		_, err = db.UpsertTemplateInfo(ctx, projectID, "note-", `{"title":""}`)
+		assert.NoError(t, err)
+
+		updated, err := db.UpsertTemplateInfo(ctx, projectID, "board-", `{"cards":[],"title":""}`)
+		assert.NoError(t, err)
+		assert.Equal(t, board.ID, updated.ID)
+		assert.Equal(t, `{"cards":[],"title":""}`, updated.Root)
+
+		infos, err := db.ListTemplateInfos(ctx, projectID)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 2)
+		assert.Equal(t, "board-", infos[0].Collection)
+		assert.Equal(t, "note-", infos[1].Collection)
+
+		assert.NoError(t, db.DeleteTemplateInfo(ctx, projectID, "note-"))
+		err = db.DeleteTemplateInfo(ctx, projectID, "note-")
+		assert.ErrorIs(t, err, database.ErrTemplateNotFound)
+
+		infos, err = db.ListTemplateInfos(ctx, projectID)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+	})
+}
+
+// RunFindClientInfosByPagingTest runs the FindClientInfosByPaging test for the given db.
+func RunFindClientInfosByPagingTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("find clientInfos by paging with connection test", func(t *testing.T) {
+		ctx := context.Background()
+
+		connection := types.ConnectionInfo{
+			IP:        "10.0.0.1",
+			UserAgent: "yorkie-js-sdk/0.4.5",
+			Source:    types.ConnectionSourceGRPCWeb,
+		}
+
+		var clientIDs []types.ID
+		for i := 0; i < 3; i++ {
+			clientInfo, err := db.ActivateClient(
+				ctx,
+				projectID,
+				fmt.Sprintf("%s-%d", t.Name(), i),
+				types.ConnectionInfo{},
+			)
+			assert.NoError(t, err)
+			clientIDs = append(clientIDs, clientInfo.ID)
+		}
+
+		// NOTE: The connection is updated when the client is activated again.
+		clientInfo, err := db.ActivateClient(ctx, projectID, fmt.Sprintf("%s-%d", t.Name(), 1), connection)
+		assert.NoError(t, err)
+		assert.Equal(t, clientIDs[1], clientInfo.ID)
+		assert.Equal(t, connection, clientInfo.Connection)
+
+		infos, err := db.FindClientInfosByPaging(ctx, projectID, types.Paging[types.ID]{
+			PageSize:  2,
+			IsForward: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 2)
+		assert.Equal(t, clientIDs[0], infos[0].ID)
+		assert.Equal(t, clientIDs[1], infos[1].ID)
+		assert.Equal(t, connection, infos[1].Connection)
+
+		infos, err = db.FindClientInfosByPaging(ctx, projectID, types.Paging[types.ID]{
+			Offset:    clientIDs[1],
+			PageSize:  2,
+			IsForward: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, clientIDs[2], infos[0].ID)
+
+		infos, err = db.FindClientInfosByPaging(ctx, projectID, types.Paging[types.ID]{
+			PageSize: 2,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 2)
+		assert.Equal(t, clientIDs[2], infos[0].ID)
+		assert.Equal(t, clientIDs[1], infos[1].ID)
+
+	})
+}
+
 // RunFindChangesBetweenServerSeqsTest runs the FindChangesBetweenServerSeqs test for the given db.
 func RunFindChangesBetweenServerSeqsTest(
 	t *testing.T,
@@ -169,7 +460,7 @@ func RunFindChangesBetweenServerSeqsTest(
 
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
 
-		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
 		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
 		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
@@ -215,7 +506,7 @@ func RunFindClosestSnapshotInfoTest(t *testing.T, db database.Database, projectI
 		ctx := context.Background()
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
 
-		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		bytesID, _ := clientInfo.ID.Bytes()
 		actorID, _ := time.ActorIDFromBytes(bytesID)
 		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
@@ -223,26 +514,32 @@ func RunFindClosestSnapshotInfoTest(t *testing.T, db database.Database, projectI
 		doc := document.New(key.Key(t.Name()))
 		doc.SetActor(actorID)
 
+		createSnapshot := func() error {
+			snapshot, err := converter.EncodeSnapshot(doc.RootObject(), doc.AllPresences(), converter.SnapshotCodecProtobuf)
+			assert.NoError(t, err)
+			return db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), snapshot)
+		}
+
 		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
 			root.SetNewArray("array")
 			return nil
 		}))
 
-		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument()))
+		assert.NoError(t, createSnapshot())
 		snapshot, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, change.MaxCheckpoint.ServerSeq, true)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(0), snapshot.ServerSeq)
 
 		pack := change.NewPack(doc.Key(), doc.Checkpoint().NextServerSeq(1), nil, nil)
 		assert.NoError(t, doc.ApplyChangePack(pack))
-		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument()))
+		assert.NoError(t, createSnapshot())
 		snapshot, err = db.FindClosestSnapshotInfo(ctx, docInfo.ID, change.MaxCheckpoint.ServerSeq, true)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), snapshot.ServerSeq)
 
 		pack = change.NewPack(doc.Key(), doc.Checkpoint().NextServerSeq(2), nil, nil)
 		assert.NoError(t, doc.ApplyChangePack(pack))
-		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument()))
+		assert.NoError(t, createSnapshot())
 		snapshot, err = db.FindClosestSnapshotInfo(ctx, docInfo.ID, change.MaxCheckpoint.ServerSeq, true)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), snapshot.ServerSeq)
@@ -250,6 +547,12 @@ func RunFindClosestSnapshotInfoTest(t *testing.T, db database.Database, projectI
 		snapshot, err = db.FindClosestSnapshotInfo(ctx, docInfo.ID, 1, true)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), snapshot.ServerSeq)
+		assert.Equal(t, int64(len(snapshot.Snapshot)), snapshot.Size)
+
+		metadata, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, 1, false)
+		assert.NoError(t, err)
+		assert.Equal(t, snapshot.Size, metadata.Size)
+		assert.Equal(t, int64(2), metadata.Elements)
 	})
 }
 
@@ -281,7 +584,7 @@ func RunActivateClientDeactivateClientTest(t *testing.T, db database.Database, p
 		_, err := db.FindClientInfoByID(ctx, projectID, dummyOwnerID)
 		assert.ErrorIs(t, err, database.ErrClientNotFound)
 
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		found, err := db.FindClientInfoByID(ctx, projectID, clientInfo.ID)
@@ -296,14 +599,14 @@ func RunActivateClientDeactivateClientTest(t *testing.T, db database.Database, p
 		_, err := db.DeactivateClient(ctx, projectID, dummyOwnerID)
 		assert.ErrorIs(t, err, database.ErrClientNotFound)
 
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		assert.Equal(t, t.Name(), clientInfo.Key)
 		assert.Equal(t, database.ClientActivated, clientInfo.Status)
 
 		// try to activate the client twice.
-		clientInfo, err = db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err = db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 		assert.Equal(t, t.Name(), clientInfo.Key)
 		assert.Equal(t, database.ClientActivated, clientInfo.Status)
@@ -436,19 +739,19 @@ func RunFindDocInfosByPagingTest(t *testing.T, db database.Database, projectID t
 
 		pageSize := 5
 		totalSize := 9
-		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		for i := 0; i < totalSize; i++ {
 			_, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, key.Key(fmt.Sprintf("%d", i)), true)
 			assert.NoError(t, err)
 		}
 
 		// initial page, offset is empty
-		infos, err := db.FindDocInfosByPaging(ctx, projectID, types.Paging[types.ID]{PageSize: pageSize})
+		infos, err := db.FindDocInfosByPaging(ctx, projectID, types.DocumentFilter{}, types.Paging[types.ID]{PageSize: pageSize})
 		assert.NoError(t, err)
 		assertKeys([]key.Key{"8", "7", "6", "5", "4"}, infos)
 
 		// backward
-		infos, err = db.FindDocInfosByPaging(ctx, projectID, types.Paging[types.ID]{
+		infos, err = db.FindDocInfosByPaging(ctx, projectID, types.DocumentFilter{}, types.Paging[types.ID]{
 			Offset:   infos[len(infos)-1].ID,
 			PageSize: pageSize,
 		})
@@ -456,7 +759,7 @@ func RunFindDocInfosByPagingTest(t *testing.T, db database.Database, projectID t
 		assertKeys([]key.Key{"3", "2", "1", "0"}, infos)
 
 		// backward again
-		emptyInfos, err := db.FindDocInfosByPaging(ctx, projectID, types.Paging[types.ID]{
+		emptyInfos, err := db.FindDocInfosByPaging(ctx, projectID, types.DocumentFilter{}, types.Paging[types.ID]{
 			Offset:   infos[len(infos)-1].ID,
 			PageSize: pageSize,
 		})
@@ -464,7 +767,7 @@ func RunFindDocInfosByPagingTest(t *testing.T, db database.Database, projectID t
 		assertKeys(nil, emptyInfos)
 
 		// forward
-		infos, err = db.FindDocInfosByPaging(ctx, projectID, types.Paging[types.ID]{
+		infos, err = db.FindDocInfosByPaging(ctx, projectID, types.DocumentFilter{}, types.Paging[types.ID]{
 			Offset:    infos[0].ID,
 			PageSize:  pageSize,
 			IsForward: true,
@@ -473,7 +776,7 @@ func RunFindDocInfosByPagingTest(t *testing.T, db database.Database, projectID t
 		assertKeys([]key.Key{"4", "5", "6", "7", "8"}, infos)
 
 		// forward again
-		emptyInfos, err = db.FindDocInfosByPaging(ctx, projectID, types.Paging[types.ID]{
+		emptyInfos, err = db.FindDocInfosByPaging(ctx, projectID, types.DocumentFilter{}, types.Paging[types.ID]{
 			Offset:    infos[len(infos)-1].ID,
 			PageSize:  pageSize,
 			IsForward: true,
@@ -573,7 +876,7 @@ func RunFindDocInfosByPagingTest(t *testing.T, db database.Database, projectID t
 					IsForward: c.isForward,
 				}
 
-				docInfos, err := db.FindDocInfosByPaging(ctx, testProjectInfo.ID, testPaging)
+				docInfos, err := db.FindDocInfosByPaging(ctx, testProjectInfo.ID, types.DocumentFilter{}, testPaging)
 				assert.NoError(t, err)
 
 				for idx, docInfo := range docInfos {
@@ -603,7 +906,7 @@ func RunFindDocInfosByPagingTest(t *testing.T, db database.Database, projectID t
 		}
 
 		// 02. List the documents.
-		result, err := db.FindDocInfosByPaging(ctx, projectInfo.ID, types.Paging[types.ID]{
+		result, err := db.FindDocInfosByPaging(ctx, projectInfo.ID, types.DocumentFilter{}, types.Paging[types.ID]{
 			PageSize:  10,
 			IsForward: false,
 		})
@@ -615,13 +918,51 @@ func RunFindDocInfosByPagingTest(t *testing.T, db database.Database, projectID t
 		assert.NoError(t, err)
 
 		// 04. List the documents again and check the filtered result.
-		result, err = db.FindDocInfosByPaging(ctx, projectInfo.ID, types.Paging[types.ID]{
+		result, err = db.FindDocInfosByPaging(ctx, projectInfo.ID, types.DocumentFilter{}, types.Paging[types.ID]{
 			PageSize:  10,
 			IsForward: false,
 		})
 		assert.NoError(t, err)
 		assert.Len(t, result, len(docInfos)-1)
 	})
+
+	t.Run("FindDocInfosByPaging with filter test", func(t *testing.T) {
+		ctx := context.Background()
+		projectInfo, err := db.CreateProjectInfo(ctx, t.Name(), dummyOwnerID, clientDeactivateThreshold)
+		assert.NoError(t, err)
+
+		// 01. Create documents and update only the first one.
+		var docInfos []*database.DocInfo
+		for _, k := range []string{"room-1", "room-2", "lobby-1"} {
+			docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectInfo.ID, dummyClientID, key.Key(k), true)
+			assert.NoError(t, err)
+			docInfos = append(docInfos, docInfo)
+		}
+		since := gotime.Now()
+		assert.NoError(t, db.CreateChangeInfos(ctx, projectInfo.ID, docInfos[0], 0, []*change.Change{}, false))
+
+		paging := types.Paging[types.ID]{PageSize: 10, IsForward: true}
+
+		// 02. Filter the documents by the prefix of their keys.
+		result, err := db.FindDocInfosByPaging(ctx, projectInfo.ID, types.DocumentFilter{KeyPrefix: "room-"}, paging)
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+
+		// 03. Filter the documents by the time when they are updated.
+		result, err = db.FindDocInfosByPaging(ctx, projectInfo.ID, types.DocumentFilter{
+			KeyPrefix:    "room-",
+			UpdatedAfter: since,
+		}, paging)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, docInfos[0].ID, result[0].ID)
+
+		result, err = db.FindDocInfosByPaging(ctx, projectInfo.ID, types.DocumentFilter{
+			UpdatedBefore: since,
+		}, paging)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
 }
 
 // RunFindDeactivateCandidates runs the FindDeactivateCandidates tests for the given db.
@@ -677,7 +1018,7 @@ func RunCreateChangeInfosTest(t *testing.T, db database.Database, projectID type
 		docKey := helper.TestDocKey(t)
 
 		// 01. Create a client and a document then attach the document to the client.
-		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
 		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
 		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
@@ -695,7 +1036,7 @@ func RunCreateChangeInfosTest(t *testing.T, db database.Database, projectID type
 		docKey := helper.TestDocKey(t)
 
 		// 01. Create a client and a document then attach the document to the client.
-		clientInfo1, _ := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo1, _ := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		docInfo1, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo1.ID, docKey, true)
 		assert.NoError(t, clientInfo1.AttachDocument(docInfo1.ID))
 		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo1, docInfo1))
@@ -717,7 +1058,7 @@ func RunCreateChangeInfosTest(t *testing.T, db database.Database, projectID type
 		ctx := context.Background()
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
 
-		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
 		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
 		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
@@ -740,6 +1081,59 @@ func RunCreateChangeInfosTest(t *testing.T, db database.Database, projectID type
 		assert.NoError(t, err)
 		assert.NotEqual(t, database.DocumentRemoved, clientInfo.Documents[docInfo.ID].Status)
 	})
+	t.Run("store changes of documents atomically test", func(t *testing.T) {
+		ctx := context.Background()
+
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+
+		var docChanges []*database.DocChanges
+		for i := 0; i < 2; i++ {
+			docKey := key.Key(fmt.Sprintf("tests$%s-%d", t.Name(), i))
+			docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+
+			doc := document.New(docKey)
+			doc.SetActor(actorID)
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k", i)
+				return nil
+			}))
+			pack := doc.CreateChangePack()
+			pack.Changes[0].SetServerSeq(docInfo.IncreaseServerSeq())
+
+			docChanges = append(docChanges, &database.DocChanges{
+				DocInfo:          docInfo,
+				InitialServerSeq: 0,
+				Changes:          pack.Changes,
+			})
+		}
+
+		// 01. Nothing is stored if one of the documents conflicts.
+		docChanges[1].InitialServerSeq = 1
+		err := db.CreateChangeInfosOfDocs(ctx, projectID, docChanges)
+		assert.ErrorIs(t, err, database.ErrConflictOnUpdate)
+		for _, dc := range docChanges {
+			docInfo, err := db.FindDocInfoByID(ctx, projectID, dc.DocInfo.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(0), docInfo.ServerSeq)
+			changes, err := db.FindChangesBetweenServerSeqs(ctx, dc.DocInfo.ID, 1, 1)
+			assert.NoError(t, err)
+			assert.Len(t, changes, 0)
+		}
+
+		// 02. The changes of all the documents are stored otherwise.
+		docChanges[1].InitialServerSeq = 0
+		assert.NoError(t, db.CreateChangeInfosOfDocs(ctx, projectID, docChanges))
+		for _, dc := range docChanges {
+			docInfo, err := db.FindDocInfoByID(ctx, projectID, dc.DocInfo.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), docInfo.ServerSeq)
+			changes, err := db.FindChangesBetweenServerSeqs(ctx, dc.DocInfo.ID, 1, 1)
+			assert.NoError(t, err)
+			assert.Len(t, changes, 1)
+		}
+	})
 }
 
 // RunUpdateClientInfoAfterPushPullTest runs the UpdateClientInfoAfterPushPull tests for the given db.
@@ -748,7 +1142,7 @@ func RunUpdateClientInfoAfterPushPullTest(t *testing.T, db database.Database, pr
 	ctx := context.Background()
 
 	t.Run("document is not attached in clientInfo test", func(t *testing.T) {
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
@@ -762,7 +1156,7 @@ func RunUpdateClientInfoAfterPushPullTest(t *testing.T, db database.Database, pr
 	})
 
 	t.Run("document attach test", func(t *testing.T) {
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
@@ -780,7 +1174,7 @@ func RunUpdateClientInfoAfterPushPullTest(t *testing.T, db database.Database, pr
 	})
 
 	t.Run("update server_seq and client_seq in clientInfo test", func(t *testing.T) {
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
@@ -822,7 +1216,7 @@ func RunUpdateClientInfoAfterPushPullTest(t *testing.T, db database.Database, pr
 	})
 
 	t.Run("detach document test", func(t *testing.T) {
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
@@ -851,7 +1245,7 @@ func RunUpdateClientInfoAfterPushPullTest(t *testing.T, db database.Database, pr
 	})
 
 	t.Run("remove document test", func(t *testing.T) {
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
@@ -880,7 +1274,7 @@ func RunUpdateClientInfoAfterPushPullTest(t *testing.T, db database.Database, pr
 	})
 
 	t.Run("invalid clientInfo test", func(t *testing.T) {
-		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
 		assert.NoError(t, err)
 
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
@@ -904,9 +1298,9 @@ func RunIsDocumentAttachedTest(t *testing.T, db database.Database, projectID typ
 		ctx := context.Background()
 
 		// 00. Create two clients and a document
-		c1, err := db.ActivateClient(ctx, projectID, t.Name()+"1")
+		c1, err := db.ActivateClient(ctx, projectID, t.Name()+"1", types.ConnectionInfo{})
 		assert.NoError(t, err)
-		c2, err := db.ActivateClient(ctx, projectID, t.Name()+"2")
+		c2, err := db.ActivateClient(ctx, projectID, t.Name()+"2", types.ConnectionInfo{})
 		assert.NoError(t, err)
 		d1, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, c1.ID, helper.TestDocKey(t), true)
 		assert.NoError(t, err)
@@ -958,7 +1352,7 @@ func RunIsDocumentAttachedTest(t *testing.T, db database.Database, projectID typ
 		ctx := context.Background()
 
 		// 00. Create a client and two documents
-		c1, err := db.ActivateClient(ctx, projectID, t.Name()+"1")
+		c1, err := db.ActivateClient(ctx, projectID, t.Name()+"1", types.ConnectionInfo{})
 		assert.NoError(t, err)
 		d1, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, c1.ID, helper.TestDocKey(t)+"1", true)
 		assert.NoError(t, err)
@@ -1000,9 +1394,9 @@ func RunIsDocumentAttachedTest(t *testing.T, db database.Database, projectID typ
 		ctx := context.Background()
 
 		// 00. Create two clients and a document
-		c1, err := db.ActivateClient(ctx, projectID, t.Name()+"1")
+		c1, err := db.ActivateClient(ctx, projectID, t.Name()+"1", types.ConnectionInfo{})
 		assert.NoError(t, err)
-		c2, err := db.ActivateClient(ctx, projectID, t.Name()+"2")
+		c2, err := db.ActivateClient(ctx, projectID, t.Name()+"2", types.ConnectionInfo{})
 		assert.NoError(t, err)
 		d1, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, c1.ID, helper.TestDocKey(t), true)
 		assert.NoError(t, err)
@@ -1074,3 +1468,138 @@ func RunIsDocumentAttachedTest(t *testing.T, db database.Database, projectID typ
 		assert.False(t, attached)
 	})
 }
+
+// RunFindCompactionCandidatesTest runs the FindCompactionCandidates test for the given db.
+func RunFindCompactionCandidatesTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("compaction candidates pagination test", func(t *testing.T) {
+		ctx := context.Background()
+
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
+		assert.NoError(t, err)
+
+		created := make(map[types.ID]bool)
+		for i := 0; i < 5; i++ {
+			docKey := key.Key(fmt.Sprintf("tests$%s-%d", t.Name(), i))
+			docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+			assert.NoError(t, err)
+			created[docInfo.ID] = true
+		}
+
+		// Visit all the documents in the order of their IDs until the
+		// pagination wraps around.
+		var visited []types.ID
+		lastDocID := types.ID("")
+		for {
+			nextDocID, candidates, err := db.FindCompactionCandidates(ctx, 2, lastDocID)
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, len(candidates), 2)
+			for _, candidate := range candidates {
+				visited = append(visited, candidate.ID)
+			}
+
+			if nextDocID == "" {
+				break
+			}
+			lastDocID = nextDocID
+		}
+
+		assert.True(t, sort.SliceIsSorted(visited, func(i, j int) bool {
+			return visited[i] < visited[j]
+		}))
+		for _, id := range visited {
+			delete(created, id)
+		}
+		assert.Empty(t, created)
+	})
+}
+
+// RunPurgeChangeInfosTest runs the PurgeChangeInfos test for the given db.
+func RunPurgeChangeInfosTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("purge changes before checkpoint of attached client test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		// 01. Store 10 changes of a document attached to a client whose
+		// checkpoint is 3.
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), types.ConnectionInfo{})
+		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+		assert.NoError(t, clientInfo.UpdateCheckpoint(docInfo.ID, change.NewCheckpoint(3, 0)))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New(key.Key(t.Name()))
+		doc.SetActor(actorID)
+		for idx := 0; idx < 10; idx++ {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k", idx)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for idx, c := range pack.Changes {
+			c.SetServerSeq(int64(idx + 1))
+		}
+		assert.NoError(t, db.CreateChangeInfos(ctx, projectID, docInfo, 0, pack.Changes, false))
+
+		// 02. Only the changes before the checkpoint of the client are purged.
+		deleted, err := db.PurgeChangeInfos(ctx, projectID, docInfo.ID, 6)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+
+		changes, err := db.FindChangesBetweenServerSeqs(ctx, docInfo.ID, 1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 7)
+		assert.Equal(t, int64(4), changes[0].ServerSeq())
+
+		// 03. All the changes before the given server seq are purged after the
+		// client detaches the document.
+		assert.NoError(t, clientInfo.DetachDocument(docInfo.ID))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		deleted, err = db.PurgeChangeInfos(ctx, projectID, docInfo.ID, 6)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+
+		changes, err = db.FindChangesBetweenServerSeqs(ctx, docInfo.ID, 1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 4)
+		assert.Equal(t, int64(7), changes[0].ServerSeq())
+	})
+}
+
+// RunFindAttachedClientInfosByDocIDTest runs the FindAttachedClientInfosByDocID
+// test for the given db.
+func RunFindAttachedClientInfosByDocIDTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("find attached clients test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		c1, err := db.ActivateClient(ctx, projectID, t.Name()+"1", types.ConnectionInfo{})
+		assert.NoError(t, err)
+		c2, err := db.ActivateClient(ctx, projectID, t.Name()+"2", types.ConnectionInfo{})
+		assert.NoError(t, err)
+		docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, c1.ID, docKey, true)
+		assert.NoError(t, err)
+
+		// 01. No clients are found before the document is attached.
+		infos, err := db.FindAttachedClientInfosByDocID(ctx, projectID, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 0)
+
+		// 02. Only the clients that the document is attached to are found.
+		assert.NoError(t, c1.AttachDocument(docInfo.ID))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, c1, docInfo))
+		assert.NoError(t, c2.AttachDocument(docInfo.ID))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, c2, docInfo))
+		assert.NoError(t, c2.DetachDocument(docInfo.ID))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, c2, docInfo))
+
+		infos, err = db.FindAttachedClientInfosByDocID(ctx, projectID, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, c1.ID, infos[0].ID)
+		assert.Equal(t, database.DocumentAttached, infos[0].Documents[docInfo.ID].Status)
+	})
+}
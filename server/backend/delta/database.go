@@ -0,0 +1,162 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package delta provides a database that stores snapshots as the deltas from
+// the previous snapshots to reduce the storage of snapshots.
+package delta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// Database is a database.Database that stores a snapshot as the delta from
+// the previous snapshot of the document, which only has the members of the
+// root modified since the previous snapshot. A full snapshot is stored after
+// maxDeltas deltas in a row so that the chain of deltas to apply when reading
+// a snapshot is bounded.
+type Database struct {
+	database.Database
+
+	maxDeltas int
+}
+
+// NewDatabase creates an instance of Database that wraps the given database.
+func NewDatabase(db database.Database, maxDeltas int) *Database {
+	return &Database{
+		Database:  db,
+		maxDeltas: maxDeltas,
+	}
+}
+
+// CreateSnapshotInfo stores the given encoded snapshot of the given document
+// as the delta from the previous snapshot. A full snapshot is stored instead
+// if there is no previous snapshot, the chain of deltas is full, or the delta
+// is not smaller than the full snapshot.
+func (d *Database) CreateSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	snapshot []byte,
+) error {
+	serverSeq := doc.Checkpoint().ServerSeq
+	prev, err := d.Database.FindClosestSnapshotInfo(ctx, docID, serverSeq, true)
+	if err != nil {
+		return err
+	}
+	// NOTE: The snapshot at the server seq zero cannot be the base of a delta
+	// since zero of the base server seq means a full snapshot.
+	if prev.ID == "" || prev.ServerSeq == 0 || prev.ServerSeq >= serverSeq {
+		return d.Database.CreateSnapshotInfo(ctx, docID, doc, snapshot)
+	}
+
+	deltas, err := d.resolve(ctx, prev)
+	if err != nil {
+		return err
+	}
+	if deltas >= d.maxDeltas {
+		return d.Database.CreateSnapshotInfo(ctx, docID, doc, snapshot)
+	}
+
+	delta, err := converter.SnapshotToDelta(prev.Snapshot, snapshot)
+	if err != nil {
+		return err
+	}
+	if len(delta) >= len(snapshot) {
+		return d.Database.CreateSnapshotInfo(ctx, docID, doc, snapshot)
+	}
+
+	return d.Database.CreateDeltaSnapshotInfo(ctx, docID, doc, prev.ServerSeq, delta, int64(len(snapshot)))
+}
+
+// FindSnapshotInfoByID returns the snapshot by the given id. The snapshot is
+// rebuilt from its base snapshots if it is stored as a delta.
+func (d *Database) FindSnapshotInfoByID(ctx context.Context, id types.ID) (*database.SnapshotInfo, error) {
+	info, err := d.Database.FindSnapshotInfoByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.resolve(ctx, info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// FindClosestSnapshotInfo finds the closest snapshot info in a given
+// serverSeq. The snapshot is rebuilt from its base snapshots if it is
+// included and stored as a delta.
+func (d *Database) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	serverSeq int64,
+	includeSnapshot bool,
+) (*database.SnapshotInfo, error) {
+	info, err := d.Database.FindClosestSnapshotInfo(ctx, docID, serverSeq, includeSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if includeSnapshot {
+		if _, err := d.resolve(ctx, info); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// resolve replaces the delta of the given snapshot with the full snapshot by
+// applying the chain of deltas to the full snapshot that the chain starts
+// from. It returns the number of the deltas applied.
+func (d *Database) resolve(ctx context.Context, info *database.SnapshotInfo) (int, error) {
+	if info.BaseServerSeq == 0 {
+		return 0, nil
+	}
+
+	base, err := d.Database.FindClosestSnapshotInfo(ctx, info.DocID, info.BaseServerSeq, true)
+	if err != nil {
+		return 0, err
+	}
+	if base.ServerSeq != info.BaseServerSeq {
+		return 0, fmt.Errorf(
+			"base %d of snapshot %d: %w",
+			info.BaseServerSeq,
+			info.ServerSeq,
+			database.ErrSnapshotNotFound,
+		)
+	}
+
+	deltas, err := d.resolve(ctx, base)
+	if err != nil {
+		return 0, err
+	}
+
+	snapshot, err := converter.DeltaToSnapshot(base.Snapshot, info.Snapshot)
+	if err != nil {
+		return 0, fmt.Errorf("snapshot %d: %w", info.ServerSeq, err)
+	}
+	info.Snapshot = snapshot
+	info.BaseServerSeq = 0
+
+	return deltas + 1, nil
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package delta_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	memdb "github.com/yorkie-team/yorkie/server/backend/database/memory"
+	"github.com/yorkie-team/yorkie/server/backend/database/testcases"
+	"github.com/yorkie-team/yorkie/server/backend/delta"
+)
+
+func TestDatabase(t *testing.T) {
+	setup := func(t *testing.T, maxDeltas int) (*delta.Database, database.Database) {
+		db, err := memdb.New()
+		assert.NoError(t, err)
+		return delta.NewDatabase(db, maxDeltas), db
+	}
+
+	t.Run("store and find snapshots test", func(t *testing.T) {
+		db, _ := setup(t, 10)
+		testcases.RunFindClosestSnapshotInfoTest(t, db, database.DefaultProjectID)
+	})
+
+	t.Run("delta chain test", func(t *testing.T) {
+		ctx := context.Background()
+		db, base := setup(t, 2)
+
+		clientInfo, err := db.ActivateClient(ctx, database.DefaultProjectID, t.Name(), types.ConnectionInfo{})
+		assert.NoError(t, err)
+		docInfo, err := db.FindDocInfoByKeyAndOwner(
+			ctx,
+			database.DefaultProjectID,
+			clientInfo.ID,
+			key.Key(t.Name()),
+			true,
+		)
+		assert.NoError(t, err)
+
+		doc := document.New(key.Key(t.Name()))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("Hello Yorkie ", 100))
+			root.SetInteger("k2", 0)
+			return nil
+		}))
+
+		// 01. store the snapshots, a full snapshot after two deltas in a row.
+		var expected []string
+		for i := 0; i < 5; i++ {
+			if i > 0 {
+				assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+					root.SetInteger("k2", i)
+					return nil
+				}))
+				pack := change.NewPack(doc.Key(), doc.Checkpoint().NextServerSeq(int64(i)), nil, nil)
+				assert.NoError(t, doc.ApplyChangePack(pack))
+			}
+			snapshot, err := converter.EncodeSnapshot(doc.RootObject(), doc.AllPresences(), converter.SnapshotCodecCBOR)
+			assert.NoError(t, err)
+			assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), snapshot))
+			expected = append(expected, doc.Marshal())
+		}
+
+		for i, baseServerSeq := range []int64{0, 0, 1, 2, 0} {
+			metadata, err := base.FindClosestSnapshotInfo(ctx, docInfo.ID, int64(i), false)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(i), metadata.ServerSeq)
+			assert.Equal(t, baseServerSeq, metadata.BaseServerSeq)
+		}
+
+		// 02. the snapshots are rebuilt from the chains of deltas.
+		for i := 0; i < 5; i++ {
+			info, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, int64(i), true)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(0), info.BaseServerSeq)
+
+			obj, _, err := converter.BytesToSnapshot(info.Snapshot)
+			assert.NoError(t, err)
+			assert.Equal(t, expected[i], obj.Marshal())
+
+			info, err = db.FindSnapshotInfoByID(ctx, info.ID)
+			assert.NoError(t, err)
+			obj, _, err = converter.BytesToSnapshot(info.Snapshot)
+			assert.NoError(t, err)
+			assert.Equal(t, expected[i], obj.Marshal())
+		}
+
+		// 03. the deltas are smaller than the full snapshots.
+		full, err := base.FindClosestSnapshotInfo(ctx, docInfo.ID, 1, true)
+		assert.NoError(t, err)
+		deltaInfo, err := base.FindClosestSnapshotInfo(ctx, docInfo.ID, 2, true)
+		assert.NoError(t, err)
+		assert.Less(t, len(deltaInfo.Snapshot), len(full.Snapshot)/2)
+	})
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package eventbus provides an in-process bus of the lifecycle events of the
+// server, such as the creation of documents and the activation of clients.
+// Embedders of the server can subscribe to it to hook into these events
+// without polling the database.
+package eventbus
+
+import (
+	"sync"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// subscriptionBufferSize is the number of events that can be queued for a
+// subscriber before the bus starts dropping events for it.
+const subscriptionBufferSize = 256
+
+// Type represents the type of the lifecycle event.
+type Type string
+
+const (
+	// DocumentCreated is emitted when a document is created.
+	DocumentCreated Type = "document-created"
+
+	// DocumentAttached is emitted when a document is attached to a client.
+	DocumentAttached Type = "document-attached"
+
+	// DocumentRemoved is emitted when a document is removed.
+	DocumentRemoved Type = "document-removed"
+
+	// ClientActivated is emitted when a client is activated.
+	ClientActivated Type = "client-activated"
+
+	// SnapshotCreated is emitted when a snapshot of a document is created.
+	SnapshotCreated Type = "snapshot-created"
+)
+
+// Event represents a lifecycle event of the server.
+type Event struct {
+	// Type is the type of the event.
+	Type Type
+
+	// ProjectID is the ID of the project that the event belongs to.
+	ProjectID types.ID
+
+	// ClientID is the ID of the client that caused the event. It is empty
+	// if the event is not caused by a client.
+	ClientID types.ID
+
+	// DocumentID is the ID of the document of the event. It is empty for
+	// client events.
+	DocumentID types.ID
+
+	// DocumentKey is the key of the document of the event. It is empty for
+	// client events.
+	DocumentKey key.Key
+
+	// ServerSeq is the server sequence of the snapshot for SnapshotCreated.
+	ServerSeq int64
+
+	// IssuedAt is the time when the event is issued.
+	IssuedAt gotime.Time
+}
+
+// Handler handles the events delivered by the bus.
+type Handler func(event Event)
+
+// subscription is a subscription of a handler to the bus.
+type subscription struct {
+	handler Handler
+	types   map[Type]bool
+	events  chan Event
+	done    chan struct{}
+}
+
+// accepts returns whether the subscription accepts the given type of event.
+func (s *subscription) accepts(t Type) bool {
+	return len(s.types) == 0 || s.types[t]
+}
+
+// run delivers the queued events to the handler in the order of publishing.
+func (s *subscription) run() {
+	defer close(s.done)
+	for event := range s.events {
+		s.handler(event)
+	}
+}
+
+// Bus is an in-process bus of the lifecycle events. Publishing never blocks:
+// each subscriber has its own queue and goroutine, and events are dropped for
+// subscribers that can not keep up.
+type Bus struct {
+	mu            sync.RWMutex
+	closed        bool
+	nextID        int
+	subscriptions map[int]*subscription
+}
+
+// New creates a new instance of Bus.
+func New() *Bus {
+	return &Bus{
+		subscriptions: make(map[int]*subscription),
+	}
+}
+
+// Subscribe registers the given handler for the given types of events. If no
+// types are given, the handler receives all events. The returned function
+// unsubscribes the handler and waits for the handler to finish.
+func (b *Bus) Subscribe(handler Handler, types ...Type) func() {
+	sub := &subscription{
+		handler: handler,
+		types:   make(map[Type]bool),
+		events:  make(chan Event, subscriptionBufferSize),
+		done:    make(chan struct{}),
+	}
+	for _, t := range types {
+		sub.types[t] = true
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		close(sub.done)
+		return func() {}
+	}
+	id := b.nextID
+	b.nextID++
+	b.subscriptions[id] = sub
+	b.mu.Unlock()
+
+	go sub.run()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			if _, ok := b.subscriptions[id]; ok {
+				delete(b.subscriptions, id)
+				close(sub.events)
+			}
+			b.mu.Unlock()
+			<-sub.done
+		})
+	}
+}
+
+// Publish delivers the given event to the subscribers of its type.
+func (b *Bus) Publish(event Event) {
+	if event.IssuedAt.IsZero() {
+		event.IssuedAt = gotime.Now()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subscriptions {
+		if !sub.accepts(event.Type) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			logging.DefaultLogger().Warnf(
+				"BUS: drop %s of '%s': subscriber is too slow",
+				event.Type,
+				event.DocumentKey,
+			)
+		}
+	}
+}
+
+// Len returns the number of the subscribers.
+func (b *Bus) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return len(b.subscriptions)
+}
+
+// Close unsubscribes all subscribers and waits for them to handle the events
+// that are already queued.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	subs := b.subscriptions
+	b.subscriptions = make(map[int]*subscription)
+	for _, sub := range subs {
+		close(sub.events)
+	}
+	b.mu.Unlock()
+
+	for _, sub := range subs {
+		<-sub.done
+	}
+}
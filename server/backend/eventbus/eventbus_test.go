@@ -0,0 +1,104 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package eventbus_test
+
+import (
+	gosync "sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/backend/eventbus"
+)
+
+func TestBus(t *testing.T) {
+	t.Run("publish and subscribe test", func(t *testing.T) {
+		bus := eventbus.New()
+		defer bus.Close()
+
+		var mu gosync.Mutex
+		var all, docs []eventbus.Type
+		unsubAll := bus.Subscribe(func(event eventbus.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			all = append(all, event.Type)
+		})
+		unsubDocs := bus.Subscribe(func(event eventbus.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			docs = append(docs, event.Type)
+		}, eventbus.DocumentCreated, eventbus.DocumentRemoved)
+		assert.Equal(t, 2, bus.Len())
+
+		bus.Publish(eventbus.Event{Type: eventbus.ClientActivated})
+		bus.Publish(eventbus.Event{Type: eventbus.DocumentCreated})
+		bus.Publish(eventbus.Event{Type: eventbus.DocumentAttached})
+		bus.Publish(eventbus.Event{Type: eventbus.DocumentRemoved})
+
+		// NOTE: Unsubscribing waits for the queued events to be
+		// handled, so the results are complete after it.
+		unsubAll()
+		unsubDocs()
+		assert.Equal(t, 0, bus.Len())
+
+		assert.Equal(t, []eventbus.Type{
+			eventbus.ClientActivated,
+			eventbus.DocumentCreated,
+			eventbus.DocumentAttached,
+			eventbus.DocumentRemoved,
+		}, all)
+		assert.Equal(t, []eventbus.Type{
+			eventbus.DocumentCreated,
+			eventbus.DocumentRemoved,
+		}, docs)
+
+		// publishing after unsubscribing should not panic.
+		bus.Publish(eventbus.Event{Type: eventbus.DocumentCreated})
+		unsubAll()
+	})
+
+	t.Run("slow subscriber test", func(t *testing.T) {
+		bus := eventbus.New()
+
+		release := make(chan struct{})
+		count := 0
+		bus.Subscribe(func(event eventbus.Event) {
+			<-release
+			count++
+		})
+
+		// NOTE: Publishing must not block even if the subscriber
+		// is stuck. The events that exceed the queue are dropped.
+		for i := 0; i < 1000; i++ {
+			bus.Publish(eventbus.Event{Type: eventbus.SnapshotCreated})
+		}
+		close(release)
+		bus.Close()
+
+		assert.Greater(t, count, 0)
+		assert.Less(t, count, 1000)
+	})
+
+	t.Run("subscribe after close test", func(t *testing.T) {
+		bus := eventbus.New()
+		bus.Close()
+
+		unsub := bus.Subscribe(func(event eventbus.Event) {})
+		assert.Equal(t, 0, bus.Len())
+		unsub()
+	})
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package faults
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+)
+
+// Coordinator is a sync.Coordinator that injects the faults of the injector
+// into the publishes of the underlying coordinator.
+type Coordinator struct {
+	sync.Coordinator
+	injector Injector
+}
+
+// NewCoordinator creates an instance of Coordinator that wraps the given
+// coordinator.
+func NewCoordinator(coordinator sync.Coordinator, injector Injector) *Coordinator {
+	return &Coordinator{
+		Coordinator: coordinator,
+		injector:    injector,
+	}
+}
+
+// Publish publishes the given event with the injected faults. If an error is
+// injected, the event is dropped unless the fault is partial.
+func (c *Coordinator) Publish(
+	ctx context.Context,
+	publisherID *time.ActorID,
+	event sync.DocEvent,
+) {
+	_ = inject(ctx, c.injector, Call{Target: TargetPubSub, Method: "Publish"}, func() error {
+		c.Coordinator.Publish(ctx, publisherID, event)
+		return nil
+	})
+}
+
+// PublishToLocal publishes the given event to the local subscribers with the
+// injected faults.
+func (c *Coordinator) PublishToLocal(
+	ctx context.Context,
+	publisherID *time.ActorID,
+	event sync.DocEvent,
+) {
+	_ = inject(ctx, c.injector, Call{Target: TargetPubSub, Method: "PublishToLocal"}, func() error {
+		c.Coordinator.PublishToLocal(ctx, publisherID, event)
+		return nil
+	})
+}
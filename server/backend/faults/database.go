@@ -0,0 +1,744 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package faults
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// Database is a database.Database that injects the faults of the injector
+// into the calls of the underlying database.
+type Database struct {
+	db       database.Database
+	injector Injector
+}
+
+// NewDatabase creates an instance of Database that wraps the given database.
+func NewDatabase(db database.Database, injector Injector) *Database {
+	return &Database{
+		db:       db,
+		injector: injector,
+	}
+}
+
+// inject runs the given call of the database with the injected faults.
+func (d *Database) inject(ctx context.Context, method string, call func() error) error {
+	return inject(ctx, d.injector, Call{Target: TargetDatabase, Method: method}, call)
+}
+
+// Close closes the underlying database. Faults are not injected into it.
+func (d *Database) Close() error {
+	return d.db.Close()
+}
+
+// FindProjectInfoByPublicKey calls the method of the database with the injected faults.
+func (d *Database) FindProjectInfoByPublicKey(
+	ctx context.Context,
+	publicKey string,
+) (*database.ProjectInfo, error) {
+	var v *database.ProjectInfo
+	if err := d.inject(ctx, "FindProjectInfoByPublicKey", func() (err error) {
+		v, err = d.db.FindProjectInfoByPublicKey(ctx, publicKey)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindProjectInfoByName calls the method of the database with the injected faults.
+func (d *Database) FindProjectInfoByName(
+	ctx context.Context,
+	owner types.ID,
+	name string,
+) (*database.ProjectInfo, error) {
+	var v *database.ProjectInfo
+	if err := d.inject(ctx, "FindProjectInfoByName", func() (err error) {
+		v, err = d.db.FindProjectInfoByName(ctx, owner, name)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindProjectInfoByID calls the method of the database with the injected faults.
+func (d *Database) FindProjectInfoByID(ctx context.Context, id types.ID) (*database.ProjectInfo, error) {
+	var v *database.ProjectInfo
+	if err := d.inject(ctx, "FindProjectInfoByID", func() (err error) {
+		v, err = d.db.FindProjectInfoByID(ctx, id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// EnsureDefaultUserAndProject calls the method of the database with the injected faults.
+func (d *Database) EnsureDefaultUserAndProject(
+	ctx context.Context,
+	username,
+	password string,
+	clientDeactivateThreshold string,
+) (*database.UserInfo, *database.ProjectInfo, error) {
+	var v0 *database.UserInfo
+	var v1 *database.ProjectInfo
+	if err := d.inject(ctx, "EnsureDefaultUserAndProject", func() (err error) {
+		v0, v1, err = d.db.EnsureDefaultUserAndProject(ctx, username, password, clientDeactivateThreshold)
+		return err
+	}); err != nil {
+		return nil, nil, err
+	}
+	return v0, v1, nil
+}
+
+// CreateProjectInfo calls the method of the database with the injected faults.
+func (d *Database) CreateProjectInfo(
+	ctx context.Context,
+	name string,
+	owner types.ID,
+	clientDeactivateThreshold string,
+) (*database.ProjectInfo, error) {
+	var v *database.ProjectInfo
+	if err := d.inject(ctx, "CreateProjectInfo", func() (err error) {
+		v, err = d.db.CreateProjectInfo(ctx, name, owner, clientDeactivateThreshold)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListProjectInfos calls the method of the database with the injected faults.
+func (d *Database) ListProjectInfos(ctx context.Context, owner types.ID) ([]*database.ProjectInfo, error) {
+	var v []*database.ProjectInfo
+	if err := d.inject(ctx, "ListProjectInfos", func() (err error) {
+		v, err = d.db.ListProjectInfos(ctx, owner)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// UpdateProjectInfo calls the method of the database with the injected faults.
+func (d *Database) UpdateProjectInfo(
+	ctx context.Context,
+	owner types.ID,
+	id types.ID,
+	fields *types.UpdatableProjectFields,
+) (*database.ProjectInfo, error) {
+	var v *database.ProjectInfo
+	if err := d.inject(ctx, "UpdateProjectInfo", func() (err error) {
+		v, err = d.db.UpdateProjectInfo(ctx, owner, id, fields)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// CreateUserInfo calls the method of the database with the injected faults.
+func (d *Database) CreateUserInfo(
+	ctx context.Context,
+	username string,
+	hashedPassword string,
+) (*database.UserInfo, error) {
+	var v *database.UserInfo
+	if err := d.inject(ctx, "CreateUserInfo", func() (err error) {
+		v, err = d.db.CreateUserInfo(ctx, username, hashedPassword)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindUserInfo calls the method of the database with the injected faults.
+func (d *Database) FindUserInfo(ctx context.Context, username string) (*database.UserInfo, error) {
+	var v *database.UserInfo
+	if err := d.inject(ctx, "FindUserInfo", func() (err error) {
+		v, err = d.db.FindUserInfo(ctx, username)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListUserInfos calls the method of the database with the injected faults.
+func (d *Database) ListUserInfos(ctx context.Context) ([]*database.UserInfo, error) {
+	var v []*database.UserInfo
+	if err := d.inject(ctx, "ListUserInfos", func() (err error) {
+		v, err = d.db.ListUserInfos(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ActivateClient calls the method of the database with the injected faults.
+func (d *Database) ActivateClient(
+	ctx context.Context,
+	projectID types.ID,
+	key string,
+	connection types.ConnectionInfo,
+) (*database.ClientInfo, error) {
+	var v *database.ClientInfo
+	if err := d.inject(ctx, "ActivateClient", func() (err error) {
+		v, err = d.db.ActivateClient(ctx, projectID, key, connection)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// DeactivateClient calls the method of the database with the injected faults.
+func (d *Database) DeactivateClient(
+	ctx context.Context,
+	projectID,
+	clientID types.ID,
+) (*database.ClientInfo, error) {
+	var v *database.ClientInfo
+	if err := d.inject(ctx, "DeactivateClient", func() (err error) {
+		v, err = d.db.DeactivateClient(ctx, projectID, clientID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindClientInfoByID calls the method of the database with the injected faults.
+func (d *Database) FindClientInfoByID(
+	ctx context.Context,
+	projectID,
+	clientID types.ID,
+) (*database.ClientInfo, error) {
+	var v *database.ClientInfo
+	if err := d.inject(ctx, "FindClientInfoByID", func() (err error) {
+		v, err = d.db.FindClientInfoByID(ctx, projectID, clientID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// UpdateClientInfoAfterPushPull calls the method of the database with the injected faults.
+func (d *Database) UpdateClientInfoAfterPushPull(
+	ctx context.Context,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+) error {
+	return d.inject(ctx, "UpdateClientInfoAfterPushPull", func() error {
+		return d.db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo)
+	})
+}
+
+// FindClientInfosByPaging calls the method of the database with the injected faults.
+func (d *Database) FindClientInfosByPaging(
+	ctx context.Context,
+	projectID types.ID,
+	paging types.Paging[types.ID],
+) ([]*database.ClientInfo, error) {
+	var v []*database.ClientInfo
+	if err := d.inject(ctx, "FindClientInfosByPaging", func() (err error) {
+		v, err = d.db.FindClientInfosByPaging(ctx, projectID, paging)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindDeactivateCandidates calls the method of the database with the injected faults.
+func (d *Database) FindDeactivateCandidates(
+	ctx context.Context,
+	candidatesLimitPerProject int,
+	projectFetchSize int,
+	lastProjectID types.ID,
+) (types.ID, []*database.ClientInfo, error) {
+	var v0 types.ID
+	var v1 []*database.ClientInfo
+	if err := d.inject(ctx, "FindDeactivateCandidates", func() (err error) {
+		v0, v1, err = d.db.FindDeactivateCandidates(ctx, candidatesLimitPerProject, projectFetchSize, lastProjectID)
+		return err
+	}); err != nil {
+		return "", nil, err
+	}
+	return v0, v1, nil
+}
+
+// FindDocInfoByKey calls the method of the database with the injected faults.
+func (d *Database) FindDocInfoByKey(
+	ctx context.Context,
+	projectID types.ID,
+	docKey key.Key,
+) (*database.DocInfo, error) {
+	var v *database.DocInfo
+	if err := d.inject(ctx, "FindDocInfoByKey", func() (err error) {
+		v, err = d.db.FindDocInfoByKey(ctx, projectID, docKey)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindDocInfoByKeyAndOwner calls the method of the database with the injected faults.
+func (d *Database) FindDocInfoByKeyAndOwner(
+	ctx context.Context,
+	projectID types.ID,
+	clientID types.ID,
+	docKey key.Key,
+	createDocIfNotExist bool,
+) (*database.DocInfo, error) {
+	var v *database.DocInfo
+	if err := d.inject(ctx, "FindDocInfoByKeyAndOwner", func() (err error) {
+		v, err = d.db.FindDocInfoByKeyAndOwner(ctx, projectID, clientID, docKey, createDocIfNotExist)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindDocInfoByID calls the method of the database with the injected faults.
+func (d *Database) FindDocInfoByID(
+	ctx context.Context,
+	projectID types.ID,
+	id types.ID,
+) (*database.DocInfo, error) {
+	var v *database.DocInfo
+	if err := d.inject(ctx, "FindDocInfoByID", func() (err error) {
+		v, err = d.db.FindDocInfoByID(ctx, projectID, id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// UpdateDocInfoStatusToRemoved calls the method of the database with the injected faults.
+func (d *Database) UpdateDocInfoStatusToRemoved(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) error {
+	return d.inject(ctx, "UpdateDocInfoStatusToRemoved", func() error {
+		return d.db.UpdateDocInfoStatusToRemoved(ctx, projectID, docID)
+	})
+}
+
+// UpdateDocInfoACL calls the method of the database with the injected faults.
+func (d *Database) UpdateDocInfoACL(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	acl *types.DocumentACL,
+) error {
+	return d.inject(ctx, "UpdateDocInfoACL", func() error {
+		return d.db.UpdateDocInfoACL(ctx, projectID, docID, acl)
+	})
+}
+
+// UpdateDocInfoReadOnlyReason calls the method of the database with the
+// injected faults.
+func (d *Database) UpdateDocInfoReadOnlyReason(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	reason string,
+) error {
+	return d.inject(ctx, "UpdateDocInfoReadOnlyReason", func() error {
+		return d.db.UpdateDocInfoReadOnlyReason(ctx, projectID, docID, reason)
+	})
+}
+
+// UpdateDocInfoLabels calls the method of the database with the injected faults.
+func (d *Database) UpdateDocInfoLabels(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	labels map[string]string,
+) error {
+	return d.inject(ctx, "UpdateDocInfoLabels", func() error {
+		return d.db.UpdateDocInfoLabels(ctx, projectID, docID, labels)
+	})
+}
+
+// UpdateDocInfoSnapshotConfig calls the method of the database with the injected faults.
+func (d *Database) UpdateDocInfoSnapshotConfig(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	threshold int64,
+	interval int64,
+) error {
+	return d.inject(ctx, "UpdateDocInfoSnapshotConfig", func() error {
+		return d.db.UpdateDocInfoSnapshotConfig(ctx, projectID, docID, threshold, interval)
+	})
+}
+
+// UpsertTemplateInfo calls the method of the database with the injected faults.
+func (d *Database) UpsertTemplateInfo(
+	ctx context.Context,
+	projectID types.ID,
+	collection string,
+	root string,
+) (*database.TemplateInfo, error) {
+	var v *database.TemplateInfo
+	if err := d.inject(ctx, "UpsertTemplateInfo", func() (err error) {
+		v, err = d.db.UpsertTemplateInfo(ctx, projectID, collection, root)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListTemplateInfos calls the method of the database with the injected faults.
+func (d *Database) ListTemplateInfos(
+	ctx context.Context,
+	projectID types.ID,
+) ([]*database.TemplateInfo, error) {
+	var v []*database.TemplateInfo
+	if err := d.inject(ctx, "ListTemplateInfos", func() (err error) {
+		v, err = d.db.ListTemplateInfos(ctx, projectID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// DeleteTemplateInfo calls the method of the database with the injected faults.
+func (d *Database) DeleteTemplateInfo(
+	ctx context.Context,
+	projectID types.ID,
+	collection string,
+) error {
+	return d.inject(ctx, "DeleteTemplateInfo", func() error {
+		return d.db.DeleteTemplateInfo(ctx, projectID, collection)
+	})
+}
+
+// CreateChangeInfos calls the method of the database with the injected faults.
+func (d *Database) CreateChangeInfos(
+	ctx context.Context,
+	projectID types.ID,
+	docInfo *database.DocInfo,
+	initialServerSeq int64,
+	changes []*change.Change,
+	isRemoved bool,
+) error {
+	return d.inject(ctx, "CreateChangeInfos", func() error {
+		return d.db.CreateChangeInfos(ctx, projectID, docInfo, initialServerSeq, changes, isRemoved)
+	})
+}
+
+// CreateChangeInfosOfDocs calls the method of the database with the injected
+// faults.
+func (d *Database) CreateChangeInfosOfDocs(
+	ctx context.Context,
+	projectID types.ID,
+	docChanges []*database.DocChanges,
+) error {
+	return d.inject(ctx, "CreateChangeInfosOfDocs", func() error {
+		return d.db.CreateChangeInfosOfDocs(ctx, projectID, docChanges)
+	})
+}
+
+// PurgeStaleChanges calls the method of the database with the injected faults.
+func (d *Database) PurgeStaleChanges(ctx context.Context, docID types.ID) error {
+	return d.inject(ctx, "PurgeStaleChanges", func() error {
+		return d.db.PurgeStaleChanges(ctx, docID)
+	})
+}
+
+// FindChangesBetweenServerSeqs calls the method of the database with the injected faults.
+func (d *Database) FindChangesBetweenServerSeqs(
+	ctx context.Context,
+	docID types.ID,
+	from int64,
+	to int64,
+) ([]*change.Change, error) {
+	var v []*change.Change
+	if err := d.inject(ctx, "FindChangesBetweenServerSeqs", func() (err error) {
+		v, err = d.db.FindChangesBetweenServerSeqs(ctx, docID, from, to)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindChangeInfosBetweenServerSeqs calls the method of the database with the injected faults.
+func (d *Database) FindChangeInfosBetweenServerSeqs(
+	ctx context.Context,
+	docID types.ID,
+	from int64,
+	to int64,
+) ([]*database.ChangeInfo, error) {
+	var v []*database.ChangeInfo
+	if err := d.inject(ctx, "FindChangeInfosBetweenServerSeqs", func() (err error) {
+		v, err = d.db.FindChangeInfosBetweenServerSeqs(ctx, docID, from, to)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// CreateSnapshotInfo calls the method of the database with the injected faults.
+func (d *Database) CreateSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	snapshot []byte,
+) error {
+	return d.inject(ctx, "CreateSnapshotInfo", func() error {
+		return d.db.CreateSnapshotInfo(ctx, docID, doc, snapshot)
+	})
+}
+
+// CreateOffloadedSnapshotInfo calls the method of the database with the injected faults.
+func (d *Database) CreateOffloadedSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	size int64,
+	storageKey string,
+) error {
+	return d.inject(ctx, "CreateOffloadedSnapshotInfo", func() error {
+		return d.db.CreateOffloadedSnapshotInfo(ctx, docID, doc, size, storageKey)
+	})
+}
+
+// CreateDeltaSnapshotInfo calls the method of the database with the injected faults.
+func (d *Database) CreateDeltaSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	baseServerSeq int64,
+	delta []byte,
+	size int64,
+) error {
+	return d.inject(ctx, "CreateDeltaSnapshotInfo", func() error {
+		return d.db.CreateDeltaSnapshotInfo(ctx, docID, doc, baseServerSeq, delta, size)
+	})
+}
+
+// FindCompactionCandidates calls the method of the database with the injected faults.
+func (d *Database) FindCompactionCandidates(
+	ctx context.Context,
+	candidatesLimit int,
+	lastDocID types.ID,
+) (types.ID, []*database.DocInfo, error) {
+	var id types.ID
+	var v []*database.DocInfo
+	if err := d.inject(ctx, "FindCompactionCandidates", func() (err error) {
+		id, v, err = d.db.FindCompactionCandidates(ctx, candidatesLimit, lastDocID)
+		return err
+	}); err != nil {
+		return "", nil, err
+	}
+	return id, v, nil
+}
+
+// PurgeChangeInfos calls the method of the database with the injected faults.
+func (d *Database) PurgeChangeInfos(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	serverSeq int64,
+) (int64, error) {
+	var v int64
+	if err := d.inject(ctx, "PurgeChangeInfos", func() (err error) {
+		v, err = d.db.PurgeChangeInfos(ctx, projectID, docID, serverSeq)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+// FindSnapshotInfoByID calls the method of the database with the injected faults.
+func (d *Database) FindSnapshotInfoByID(ctx context.Context, id types.ID) (*database.SnapshotInfo, error) {
+	var v *database.SnapshotInfo
+	if err := d.inject(ctx, "FindSnapshotInfoByID", func() (err error) {
+		v, err = d.db.FindSnapshotInfoByID(ctx, id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindClosestSnapshotInfo calls the method of the database with the injected faults.
+func (d *Database) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	serverSeq int64,
+	includeSnapshot bool,
+) (*database.SnapshotInfo, error) {
+	var v *database.SnapshotInfo
+	if err := d.inject(ctx, "FindClosestSnapshotInfo", func() (err error) {
+		v, err = d.db.FindClosestSnapshotInfo(ctx, docID, serverSeq, includeSnapshot)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindMinSyncedSeqInfo calls the method of the database with the injected faults.
+func (d *Database) FindMinSyncedSeqInfo(
+	ctx context.Context,
+	docID types.ID,
+) (*database.SyncedSeqInfo, error) {
+	var v *database.SyncedSeqInfo
+	if err := d.inject(ctx, "FindMinSyncedSeqInfo", func() (err error) {
+		v, err = d.db.FindMinSyncedSeqInfo(ctx, docID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// UpdateAndFindMinSyncedTicket calls the method of the database with the injected faults.
+func (d *Database) UpdateAndFindMinSyncedTicket(
+	ctx context.Context,
+	clientInfo *database.ClientInfo,
+	docID types.ID,
+	serverSeq int64,
+) (*time.Ticket, error) {
+	var v *time.Ticket
+	if err := d.inject(ctx, "UpdateAndFindMinSyncedTicket", func() (err error) {
+		v, err = d.db.UpdateAndFindMinSyncedTicket(ctx, clientInfo, docID, serverSeq)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// UpdateSyncedSeq calls the method of the database with the injected faults.
+func (d *Database) UpdateSyncedSeq(
+	ctx context.Context,
+	clientInfo *database.ClientInfo,
+	docID types.ID,
+	serverSeq int64,
+) error {
+	return d.inject(ctx, "UpdateSyncedSeq", func() error {
+		return d.db.UpdateSyncedSeq(ctx, clientInfo, docID, serverSeq)
+	})
+}
+
+// FindDocInfosByPaging calls the method of the database with the injected faults.
+func (d *Database) FindDocInfosByPaging(
+	ctx context.Context,
+	projectID types.ID,
+	filter types.DocumentFilter,
+	paging types.Paging[types.ID],
+) ([]*database.DocInfo, error) {
+	var v []*database.DocInfo
+	if err := d.inject(ctx, "FindDocInfosByPaging", func() (err error) {
+		v, err = d.db.FindDocInfosByPaging(ctx, projectID, filter, paging)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindDocInfosBySample calls the method of the database with the injected faults.
+func (d *Database) FindDocInfosBySample(
+	ctx context.Context,
+	size int,
+) ([]*database.DocInfo, error) {
+	var v []*database.DocInfo
+	if err := d.inject(ctx, "FindDocInfosBySample", func() (err error) {
+		v, err = d.db.FindDocInfosBySample(ctx, size)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// FindDocInfosByQuery calls the method of the database with the injected faults.
+func (d *Database) FindDocInfosByQuery(
+	ctx context.Context,
+	projectID types.ID,
+	query string,
+	pageSize int,
+) (*types.SearchResult[*database.DocInfo], error) {
+	var v *types.SearchResult[*database.DocInfo]
+	if err := d.inject(ctx, "FindDocInfosByQuery", func() (err error) {
+		v, err = d.db.FindDocInfosByQuery(ctx, projectID, query, pageSize)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IsDocumentAttached calls the method of the database with the injected faults.
+func (d *Database) IsDocumentAttached(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	excludeClientID types.ID,
+) (bool, error) {
+	var v bool
+	if err := d.inject(ctx, "IsDocumentAttached", func() (err error) {
+		v, err = d.db.IsDocumentAttached(ctx, projectID, docID, excludeClientID)
+		return err
+	}); err != nil {
+		return false, err
+	}
+	return v, nil
+}
+
+// FindAttachedClientInfosByDocID calls the method of the database with the
+// injected faults.
+func (d *Database) FindAttachedClientInfosByDocID(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) ([]*database.ClientInfo, error) {
+	var v []*database.ClientInfo
+	if err := d.inject(ctx, "FindAttachedClientInfosByDocID", func() (err error) {
+		v, err = d.db.FindAttachedClientInfosByDocID(ctx, projectID, docID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package faults provides the hooks to inject faults such as latency and
+// errors into the calls of the backend for chaos testing. It must not be used
+// in production.
+package faults
+
+import (
+	"context"
+	gotime "time"
+)
+
+// Target is the target of the calls that faults are injected into.
+type Target string
+
+// The values below are the targets of the calls.
+const (
+	TargetDatabase Target = "database"
+	TargetPubSub   Target = "pubsub"
+)
+
+// Call is a call of the backend that faults can be injected into.
+type Call struct {
+	Target Target
+	Method string
+}
+
+// Fault is a fault injected into a call.
+type Fault struct {
+	// Latency is the time to wait before the call.
+	Latency gotime.Duration
+
+	// Err is the error returned by the call instead of its result.
+	Err error
+
+	// Partial is whether the call is executed before Err is returned, so that
+	// its effects remain while the caller sees the error.
+	Partial bool
+}
+
+// Injector decides the faults injected into the calls of the backend.
+type Injector interface {
+	// Inject returns the fault to inject into the given call, or nil if the
+	// call runs as is.
+	Inject(ctx context.Context, call Call) *Fault
+}
+
+// InjectorFunc is an adapter to use an ordinary function as an Injector.
+type InjectorFunc func(ctx context.Context, call Call) *Fault
+
+// Inject calls f(ctx, call).
+func (f InjectorFunc) Inject(ctx context.Context, call Call) *Fault {
+	return f(ctx, call)
+}
+
+// inject runs the given function of the call with the fault of the injector.
+func inject(ctx context.Context, injector Injector, call Call, f func() error) error {
+	fault := injector.Inject(ctx, call)
+	if fault == nil {
+		return f()
+	}
+
+	if fault.Latency > 0 {
+		select {
+		case <-gotime.After(fault.Latency):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if fault.Err == nil {
+		return f()
+	}
+	if fault.Partial {
+		if err := f(); err != nil {
+			return err
+		}
+	}
+	return fault.Err
+}
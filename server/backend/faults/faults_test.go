@@ -0,0 +1,80 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package faults_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/database/memory"
+	"github.com/yorkie-team/yorkie/server/backend/faults"
+)
+
+var errInjected = errors.New("injected fault")
+
+func TestDatabase(t *testing.T) {
+	ctx := context.Background()
+	memdb, err := memory.New()
+	assert.NoError(t, err)
+
+	var fault *faults.Fault
+	db := faults.NewDatabase(memdb, faults.InjectorFunc(func(ctx context.Context, call faults.Call) *faults.Fault {
+		if call.Target != faults.TargetDatabase || call.Method != "CreateProjectInfo" {
+			return nil
+		}
+		return fault
+	}))
+	owner := types.ID("000000000000000000000000")
+
+	t.Run("inject error test", func(t *testing.T) {
+		fault = &faults.Fault{Err: errInjected}
+		_, err := db.CreateProjectInfo(ctx, "error-project", owner, "1h")
+		assert.ErrorIs(t, err, errInjected)
+
+		_, err = memdb.FindProjectInfoByName(ctx, owner, "error-project")
+		assert.ErrorIs(t, err, database.ErrProjectNotFound)
+	})
+
+	t.Run("inject partial failure test", func(t *testing.T) {
+		fault = &faults.Fault{Err: errInjected, Partial: true}
+		info, err := db.CreateProjectInfo(ctx, "partial-project", owner, "1h")
+		assert.ErrorIs(t, err, errInjected)
+		assert.Nil(t, info)
+
+		info, err = memdb.FindProjectInfoByName(ctx, owner, "partial-project")
+		assert.NoError(t, err)
+		assert.Equal(t, "partial-project", info.Name)
+	})
+
+	t.Run("inject latency test", func(t *testing.T) {
+		fault = &faults.Fault{Latency: gotime.Second}
+		cancelCtx, cancel := context.WithTimeout(ctx, 10*gotime.Millisecond)
+		defer cancel()
+		_, err := db.CreateProjectInfo(cancelCtx, "latency-project", owner, "1h")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		fault = nil
+		_, err = db.CreateProjectInfo(ctx, "latency-project", owner, "1h")
+		assert.NoError(t, err)
+	})
+}
@@ -31,6 +31,14 @@ type Config struct {
 
 	// ProjectFetchSize is the maximum number of projects to be returned to deactivate candidates.
 	ProjectFetchSize int `yaml:"HousekeepingProjectFetchSize"`
+
+	// CompactionCandidatesLimit is the maximum number of documents whose change
+	// logs are compacted in a single run. Zero disables the compaction.
+	CompactionCandidatesLimit int `yaml:"CompactionCandidatesLimit"`
+
+	// ChangeLogRetention is the number of changes to be kept before the latest
+	// snapshot of a document when its change logs are compacted.
+	ChangeLogRetention int64 `yaml:"ChangeLogRetention"`
 }
 
 // Validate validates the configuration.
@@ -57,5 +65,19 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if c.CompactionCandidatesLimit < 0 {
+		return fmt.Errorf(
+			`invalid argument %d for "--housekeeping-compaction-candidates-limit" flag`,
+			c.CompactionCandidatesLimit,
+		)
+	}
+
+	if c.ChangeLogRetention < 0 {
+		return fmt.Errorf(
+			`invalid argument %d for "--housekeeping-change-log-retention" flag`,
+			c.ChangeLogRetention,
+		)
+	}
+
 	return nil
 }
@@ -44,5 +44,13 @@ func TestConfig(t *testing.T) {
 		conf3 := validConf
 		conf3.ProjectFetchSize = -1
 		assert.Error(t, conf3.Validate())
+
+		conf4 := validConf
+		conf4.CompactionCandidatesLimit = -1
+		assert.Error(t, conf4.Validate())
+
+		conf5 := validConf
+		conf5.ChangeLogRetention = -1
+		assert.Error(t, conf5.Validate())
 	})
 }
@@ -16,7 +16,7 @@
 
 // Package housekeeping provides the housekeeping service. The housekeeping
 // service is responsible for deactivating clients that have not been used for
-// a long time.
+// a long time and compacting the change logs of documents.
 package housekeeping
 
 import (
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/clock"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/clients"
@@ -33,18 +34,22 @@ import (
 
 const (
 	deactivateCandidatesKey = "housekeeping/deactivateCandidates"
+	compactChangeLogsKey    = "housekeeping/compactChangeLogs"
 )
 
 // Housekeeping is the housekeeping service. It periodically runs housekeeping
 // tasks. It is responsible for deactivating clients that have not been active
-// for a long time.
+// for a long time and deleting the changes that are covered by snapshots.
 type Housekeeping struct {
 	database    database.Database
 	coordinator sync.Coordinator
+	clock       clock.Clock
 
 	interval                  time.Duration
 	candidatesLimitPerProject int
 	projectFetchSize          int
+	compactionCandidatesLimit int
+	changeLogRetention        int64
 
 	ctx        context.Context
 	cancelFunc context.CancelFunc
@@ -55,8 +60,9 @@ func Start(
 	conf *Config,
 	database database.Database,
 	coordinator sync.Coordinator,
+	clk clock.Clock,
 ) (*Housekeeping, error) {
-	h, err := New(conf, database, coordinator)
+	h, err := New(conf, database, coordinator, clk)
 	if err != nil {
 		return nil, err
 	}
@@ -67,11 +73,13 @@ func Start(
 	return h, nil
 }
 
-// New creates a new housekeeping instance.
+// New creates a new housekeeping instance. The interval between the runs of
+// the tasks is measured by the given clock.
 func New(
 	conf *Config,
 	database database.Database,
 	coordinator sync.Coordinator,
+	clk clock.Clock,
 ) (*Housekeeping, error) {
 	interval, err := time.ParseDuration(conf.Interval)
 	if err != nil {
@@ -83,10 +91,13 @@ func New(
 	return &Housekeeping{
 		database:    database,
 		coordinator: coordinator,
+		clock:       clk,
 
 		interval:                  interval,
 		candidatesLimitPerProject: conf.CandidatesLimitPerProject,
 		projectFetchSize:          conf.ProjectFetchSize,
+		compactionCandidatesLimit: conf.CompactionCandidatesLimit,
+		changeLogRetention:        conf.ChangeLogRetention,
 
 		ctx:        ctx,
 		cancelFunc: cancelFunc,
@@ -106,9 +117,39 @@ func (h *Housekeeping) Stop() error {
 	return nil
 }
 
+// Pause keeps all the servers of the cluster from running the housekeeping
+// tasks by holding their locks until the returned function is called, e.g.
+// so that the change logs are not compacted while documents are backed up.
+func (h *Housekeeping) Pause(ctx context.Context) (func(), error) {
+	var lockers []sync.Locker
+	resume := func() {
+		for i := len(lockers) - 1; i >= 0; i-- {
+			if err := lockers[i].Unlock(context.Background()); err != nil {
+				logging.From(ctx).Error(err)
+			}
+		}
+	}
+
+	for _, k := range []sync.Key{deactivateCandidatesKey, compactChangeLogsKey} {
+		locker, err := h.coordinator.NewLocker(ctx, k)
+		if err != nil {
+			resume()
+			return nil, err
+		}
+		if err := locker.Lock(ctx); err != nil {
+			resume()
+			return nil, err
+		}
+		lockers = append(lockers, locker)
+	}
+
+	return resume, nil
+}
+
 // run is the housekeeping loop.
 func (h *Housekeeping) run() {
 	housekeepingLastProjectID := database.DefaultProjectID
+	var compactionLastDocID types.ID
 
 	for {
 		ctx := context.Background()
@@ -119,8 +160,17 @@ func (h *Housekeeping) run() {
 		}
 		housekeepingLastProjectID = lastProjectID
 
+		if h.compactionCandidatesLimit > 0 {
+			lastDocID, err := h.compactChangeLogs(ctx, compactionLastDocID)
+			if err != nil {
+				logging.From(ctx).Error(err)
+			} else {
+				compactionLastDocID = lastDocID
+			}
+		}
+
 		select {
-		case <-time.After(h.interval):
+		case <-h.clock.After(h.interval):
 		case <-h.ctx.Done():
 			return
 		}
@@ -183,3 +233,89 @@ func (h *Housekeeping) deactivateCandidates(
 
 	return lastProjectID, nil
 }
+
+// compactChangeLogs deletes the changes of candidate documents that are older
+// than their latest snapshots minus the retention. Changes that are still
+// needed by the checkpoints of attached clients are kept by the database.
+func (h *Housekeeping) compactChangeLogs(
+	ctx context.Context,
+	compactionLastDocID types.ID,
+) (types.ID, error) {
+	start := time.Now()
+	locker, err := h.coordinator.NewLocker(ctx, compactChangeLogsKey)
+	if err != nil {
+		return compactionLastDocID, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return compactionLastDocID, err
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	lastDocID, candidates, err := h.database.FindCompactionCandidates(
+		ctx,
+		h.compactionCandidatesLimit,
+		compactionLastDocID,
+	)
+	if err != nil {
+		return compactionLastDocID, err
+	}
+
+	compactedCount := 0
+	var deletedCount int64
+	for _, docInfo := range candidates {
+		if docInfo.ServerSeq <= h.changeLogRetention {
+			continue
+		}
+
+		snapshotInfo, err := h.database.FindClosestSnapshotInfo(
+			ctx,
+			docInfo.ID,
+			docInfo.ServerSeq,
+			false,
+		)
+		if err != nil {
+			return compactionLastDocID, err
+		}
+
+		// NOTE: Changes before a snapshot are never pulled by clients because
+		// the snapshot is sent instead, so only the changes older than the
+		// retention window before the latest snapshot are deleted.
+		serverSeq := snapshotInfo.ServerSeq - h.changeLogRetention
+		if snapshotInfo.ID == "" || serverSeq <= 0 {
+			continue
+		}
+
+		deleted, err := h.database.PurgeChangeInfos(
+			ctx,
+			docInfo.ProjectID,
+			docInfo.ID,
+			serverSeq,
+		)
+		if err != nil {
+			return compactionLastDocID, err
+		}
+
+		if deleted > 0 {
+			compactedCount++
+			deletedCount += deleted
+		}
+	}
+
+	if deletedCount > 0 {
+		logging.From(ctx).Infof(
+			"HSKP: compaction candidates %d, compacted %d, deleted changes %d, %s",
+			len(candidates),
+			compactedCount,
+			deletedCount,
+			time.Since(start),
+		)
+	}
+
+	return lastDocID, nil
+}
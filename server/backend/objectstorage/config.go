@@ -0,0 +1,78 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectstorage
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmptyEndpoint is returned when the endpoint is empty.
+	ErrEmptyEndpoint = errors.New("endpoint must not be empty")
+
+	// ErrEmptyBucket is returned when the bucket is empty.
+	ErrEmptyBucket = errors.New("bucket must not be empty")
+)
+
+// Config is the configuration of the object storage.
+type Config struct {
+	// Endpoint is the endpoint of the storage, e.g. "s3.amazonaws.com",
+	// "storage.googleapis.com" or "localhost:9000" for MinIO.
+	Endpoint string `yaml:"Endpoint"`
+
+	// Region is the region of the bucket. It is detected from the storage if
+	// it is empty.
+	Region string `yaml:"Region"`
+
+	// Bucket is the name of the bucket that stores the snapshots.
+	Bucket string `yaml:"Bucket"`
+
+	// AccessKeyID is the access key ID of the storage. If it is empty, the
+	// credentials are read from the environment variables or the IAM role.
+	AccessKeyID string `yaml:"AccessKeyID"`
+
+	// SecretAccessKey is the secret access key of the storage.
+	SecretAccessKey string `yaml:"SecretAccessKey"`
+
+	// Insecure is whether to connect to the storage without TLS.
+	Insecure bool `yaml:"Insecure"`
+
+	// SnapshotThreshold is the size of snapshots in bytes above which the
+	// snapshots are offloaded to the storage.
+	SnapshotThreshold int64 `yaml:"SnapshotThreshold"`
+}
+
+// Validate returns an error if the provided Config is invalidated.
+func (c *Config) Validate() error {
+	if c.Endpoint == "" {
+		return fmt.Errorf(`invalid argument "" for "--object-storage-endpoint" flag: %w`, ErrEmptyEndpoint)
+	}
+
+	if c.Bucket == "" {
+		return fmt.Errorf(`invalid argument "" for "--object-storage-bucket" flag: %w`, ErrEmptyBucket)
+	}
+
+	if c.SnapshotThreshold < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--object-storage-snapshot-threshold" flag: must not be negative`,
+			c.SnapshotThreshold,
+		)
+	}
+
+	return nil
+}
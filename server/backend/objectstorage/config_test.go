@@ -0,0 +1,47 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectstorage_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/backend/objectstorage"
+)
+
+func TestConfig(t *testing.T) {
+	t.Run("validate test", func(t *testing.T) {
+		config := &objectstorage.Config{
+			Endpoint:          "localhost:9000",
+			Bucket:            "yorkie-snapshots",
+			SnapshotThreshold: 1024,
+		}
+		assert.NoError(t, config.Validate())
+
+		config.Endpoint = ""
+		assert.ErrorIs(t, config.Validate(), objectstorage.ErrEmptyEndpoint)
+
+		config.Endpoint = "localhost:9000"
+		config.Bucket = ""
+		assert.ErrorIs(t, config.Validate(), objectstorage.ErrEmptyBucket)
+
+		config.Bucket = "yorkie-snapshots"
+		config.SnapshotThreshold = -1
+		assert.Error(t, config.Validate())
+	})
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// Database is a database.Database that offloads the data of the snapshots
+// larger than the threshold to the object storage, keeping only their
+// metadata and the keys of their objects in the underlying database.
+type Database struct {
+	database.Database
+
+	storage   Storage
+	threshold int64
+}
+
+// NewDatabase creates an instance of Database that wraps the given database.
+func NewDatabase(db database.Database, storage Storage, threshold int64) *Database {
+	return &Database{
+		Database:  db,
+		storage:   storage,
+		threshold: threshold,
+	}
+}
+
+// SnapshotKey returns the key of the object that stores the snapshot of the
+// given document at the given server seq.
+func SnapshotKey(docID types.ID, serverSeq int64) string {
+	return fmt.Sprintf("snapshots/%s/%d", docID, serverSeq)
+}
+
+// CreateSnapshotInfo stores the given encoded snapshot of the given document.
+// The data of the snapshot is stored in the object storage if it is larger
+// than the threshold.
+func (d *Database) CreateSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	snapshot []byte,
+) error {
+	if int64(len(snapshot)) <= d.threshold {
+		return d.Database.CreateSnapshotInfo(ctx, docID, doc, snapshot)
+	}
+
+	key := SnapshotKey(docID, doc.Checkpoint().ServerSeq)
+	if err := d.storage.Put(ctx, key, snapshot); err != nil {
+		return fmt.Errorf("put snapshot %s: %w", key, err)
+	}
+
+	return d.Database.CreateOffloadedSnapshotInfo(ctx, docID, doc, int64(len(snapshot)), key)
+}
+
+// FindSnapshotInfoByID returns the snapshot by the given id along with its
+// data in the object storage.
+func (d *Database) FindSnapshotInfoByID(ctx context.Context, id types.ID) (*database.SnapshotInfo, error) {
+	info, err := d.Database.FindSnapshotInfoByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.loadSnapshot(ctx, info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// FindClosestSnapshotInfo finds the closest snapshot info in a given
+// serverSeq. The data of the snapshot is read from the object storage if it
+// is included.
+func (d *Database) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	serverSeq int64,
+	includeSnapshot bool,
+) (*database.SnapshotInfo, error) {
+	info, err := d.Database.FindClosestSnapshotInfo(ctx, docID, serverSeq, includeSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if includeSnapshot {
+		if err := d.loadSnapshot(ctx, info); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// loadSnapshot reads the data of the given snapshot from the object storage
+// if it is offloaded.
+func (d *Database) loadSnapshot(ctx context.Context, info *database.SnapshotInfo) error {
+	if info.StorageKey == "" {
+		return nil
+	}
+
+	snapshot, err := d.storage.Get(ctx, info.StorageKey)
+	if err != nil {
+		return fmt.Errorf("get snapshot %s: %w", info.StorageKey, err)
+	}
+	info.Snapshot = snapshot
+
+	return nil
+}
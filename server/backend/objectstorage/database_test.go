@@ -0,0 +1,110 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectstorage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	memdb "github.com/yorkie-team/yorkie/server/backend/database/memory"
+	"github.com/yorkie-team/yorkie/server/backend/database/testcases"
+	"github.com/yorkie-team/yorkie/server/backend/objectstorage"
+	"github.com/yorkie-team/yorkie/server/backend/objectstorage/memory"
+)
+
+func TestDatabase(t *testing.T) {
+	setup := func(t *testing.T, threshold int64) (*objectstorage.Database, *memory.Storage) {
+		db, err := memdb.New()
+		assert.NoError(t, err)
+		storage := memory.New()
+		return objectstorage.NewDatabase(db, storage, threshold), storage
+	}
+
+	createSnapshot := func(t *testing.T, db database.Database) (*database.DocInfo, []byte) {
+		ctx := context.Background()
+		clientInfo, err := db.ActivateClient(ctx, database.DefaultProjectID, t.Name(), types.ConnectionInfo{})
+		assert.NoError(t, err)
+		docInfo, err := db.FindDocInfoByKeyAndOwner(
+			ctx,
+			database.DefaultProjectID,
+			clientInfo.ID,
+			key.Key(t.Name()),
+			true,
+		)
+		assert.NoError(t, err)
+
+		doc := document.New(key.Key(t.Name()))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		snapshot, err := converter.EncodeSnapshot(doc.RootObject(), doc.AllPresences(), converter.SnapshotCodecProtobuf)
+		assert.NoError(t, err)
+		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), snapshot))
+		return docInfo, snapshot
+	}
+
+	t.Run("store and find snapshots test", func(t *testing.T) {
+		db, _ := setup(t, 0)
+		testcases.RunFindClosestSnapshotInfoTest(t, db, database.DefaultProjectID)
+	})
+
+	t.Run("offload large snapshot test", func(t *testing.T) {
+		ctx := context.Background()
+		db, storage := setup(t, 0)
+		docInfo, snapshot := createSnapshot(t, db)
+		assert.Equal(t, 1, storage.Len())
+
+		// 01. the metadata is kept in the database with the key of the object.
+		metadata, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, change.MaxCheckpoint.ServerSeq, false)
+		assert.NoError(t, err)
+		assert.Equal(t, objectstorage.SnapshotKey(docInfo.ID, 0), metadata.StorageKey)
+		assert.Equal(t, int64(len(snapshot)), metadata.Size)
+		assert.Empty(t, metadata.Snapshot)
+
+		// 02. the data is read from the object storage.
+		info, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, change.MaxCheckpoint.ServerSeq, true)
+		assert.NoError(t, err)
+		assert.Equal(t, snapshot, info.Snapshot)
+
+		info, err = db.FindSnapshotInfoByID(ctx, metadata.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, snapshot, info.Snapshot)
+	})
+
+	t.Run("keep small snapshot in database test", func(t *testing.T) {
+		ctx := context.Background()
+		db, storage := setup(t, 1024)
+		docInfo, snapshot := createSnapshot(t, db)
+		assert.Equal(t, 0, storage.Len())
+
+		info, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, change.MaxCheckpoint.ServerSeq, true)
+		assert.NoError(t, err)
+		assert.Empty(t, info.StorageKey)
+		assert.Equal(t, snapshot, info.Snapshot)
+	})
+}
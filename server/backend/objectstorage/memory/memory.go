@@ -0,0 +1,70 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package memory implements the object storage in memory. It is used for
+// testing.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/yorkie-team/yorkie/server/backend/objectstorage"
+)
+
+// Storage is an object storage that keeps the objects in memory.
+type Storage struct {
+	lock    sync.RWMutex
+	objects map[string][]byte
+}
+
+// New creates a new instance of Storage.
+func New() *Storage {
+	return &Storage{
+		objects: make(map[string][]byte),
+	}
+}
+
+// Put stores the given data as the object of the given key.
+func (s *Storage) Put(_ context.Context, key string, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.objects[key] = append([]byte(nil), data...)
+	return nil
+}
+
+// Get returns the data of the object of the given key.
+func (s *Storage) Get(_ context.Context, key string) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", key, objectstorage.ErrObjectNotFound)
+	}
+
+	return append([]byte(nil), data...), nil
+}
+
+// Len returns the number of the objects.
+func (s *Storage) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return len(s.objects)
+}
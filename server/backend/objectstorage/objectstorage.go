@@ -0,0 +1,37 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package objectstorage provides the object storage that the data of large
+// snapshots is offloaded to instead of the database.
+package objectstorage
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrObjectNotFound is returned when the object could not be found.
+var ErrObjectNotFound = errors.New("object not found")
+
+// Storage is a storage that stores objects by their keys such as Amazon S3,
+// Google Cloud Storage and MinIO.
+type Storage interface {
+	// Put stores the given data as the object of the given key.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get returns the data of the object of the given key.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
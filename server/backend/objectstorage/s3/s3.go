@@ -0,0 +1,119 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package s3 implements the object storage using the S3 API, which is served
+// by Amazon S3 and compatible storages such as MinIO and Google Cloud Storage.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/yorkie-team/yorkie/server/backend/objectstorage"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// Storage is an object storage that stores the objects in a bucket of the
+// storage serving the S3 API.
+type Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+// Dial creates an instance of Storage and checks that the bucket of the given
+// config exists.
+func Dial(conf *objectstorage.Config) (*Storage, error) {
+	creds := credentials.NewStaticV4(conf.AccessKeyID, conf.SecretAccessKey, "")
+	if conf.AccessKeyID == "" {
+		creds = credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.EnvMinio{},
+			&credentials.IAM{},
+		})
+	}
+
+	client, err := minio.New(conf.Endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: !conf.Insecure,
+		Region: conf.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("connect to object storage: %w", err)
+	}
+
+	exists, err := client.BucketExists(context.Background(), conf.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("check bucket %s: %w", conf.Bucket, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("bucket %s does not exist", conf.Bucket)
+	}
+
+	logging.DefaultLogger().Infof(
+		"object storage connected, endpoint: %s, bucket: %s",
+		conf.Endpoint,
+		conf.Bucket,
+	)
+
+	return &Storage{
+		client: client,
+		bucket: conf.Bucket,
+	}, nil
+}
+
+// Put stores the given data as the object of the given key.
+func (s *Storage) Put(ctx context.Context, key string, data []byte) error {
+	if _, err := s.client.PutObject(
+		ctx,
+		s.bucket,
+		key,
+		bytes.NewReader(data),
+		int64(len(data)),
+		minio.PutObjectOptions{ContentType: "application/octet-stream"},
+	); err != nil {
+		return fmt.Errorf("put object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Get returns the data of the object of the given key.
+func (s *Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get object %s: %w", key, err)
+	}
+	defer func() {
+		_ = object.Close()
+	}()
+
+	// NOTE: GetObject does not send the request until the object is read, so
+	// the missing object is reported while reading it.
+	data, err := io.ReadAll(object)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("%s: %w", key, objectstorage.ErrObjectNotFound)
+		}
+		return nil, fmt.Errorf("read object %s: %w", key, err)
+	}
+
+	return data, nil
+}
@@ -29,6 +29,7 @@ import (
 type ServerInfo struct {
 	ID        string      `json:"id"`
 	Hostname  string      `json:"hostname"`
+	RPCAddr   string      `json:"rpc_addr,omitempty"`
 	UpdatedAt gotime.Time `json:"updated_at"`
 }
 
@@ -41,7 +42,7 @@ type Coordinator interface {
 	Subscribe(
 		ctx context.Context,
 		subscriber *time.ActorID,
-		documentID types.ID,
+		ref DocumentRef,
 	) (*Subscription, []*time.ActorID, error)
 
 	// Unsubscribe unsubscribes from the given documents.
@@ -51,12 +52,30 @@ type Coordinator interface {
 		sub *Subscription,
 	) error
 
+	// Heartbeat records that the given subscriber of the document is alive
+	// at the given time.
+	Heartbeat(
+		ctx context.Context,
+		subscriber *time.ActorID,
+		documentID types.ID,
+		at gotime.Time,
+	) error
+
 	// Publish publishes the given event.
 	Publish(ctx context.Context, publisherID *time.ActorID, event DocEvent)
 
 	// PublishToLocal publishes the given event.
 	PublishToLocal(ctx context.Context, publisherID *time.ActorID, event DocEvent)
 
+	// DocumentMemories returns the approximate memory that this Coordinator
+	// holds for the subscriptions of each document of the given project. The
+	// documents of all the projects are returned if projectID is empty.
+	DocumentMemories(projectID types.ID) []*types.DocumentMemory
+
+	// LockCount returns the number of sessions holding or waiting for the
+	// locks of the given keys of this Coordinator.
+	LockCount(keys ...Key) int
+
 	// Members returns the members of this cluster.
 	Members() map[string]*ServerInfo
 
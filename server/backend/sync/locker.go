@@ -24,6 +24,10 @@ import (
 // ErrAlreadyLocked is returned when the lock is already locked.
 var ErrAlreadyLocked = errors.New("already locked")
 
+// LockBytes is the approximate bytes that the server holds for a session
+// holding or waiting for a lock.
+const LockBytes = 128
+
 // Key represents key of Locker.
 type Key string
 
@@ -19,6 +19,7 @@ package memory
 
 import (
 	"context"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
@@ -58,14 +59,14 @@ func (c *Coordinator) NewLocker(
 func (c *Coordinator) Subscribe(
 	ctx context.Context,
 	subscriber *time.ActorID,
-	documentID types.ID,
+	ref sync.DocumentRef,
 ) (*sync.Subscription, []*time.ActorID, error) {
-	sub, err := c.pubSub.Subscribe(ctx, subscriber, documentID)
+	sub, err := c.pubSub.Subscribe(ctx, subscriber, ref)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	ids := c.pubSub.ClientIDs(documentID)
+	ids := c.pubSub.ClientIDs(ref.ID)
 	return sub, ids, nil
 }
 
@@ -79,6 +80,16 @@ func (c *Coordinator) Unsubscribe(
 	return nil
 }
 
+// Heartbeat records that the given subscriber of the document is alive.
+func (c *Coordinator) Heartbeat(
+	ctx context.Context,
+	subscriber *time.ActorID,
+	documentID types.ID,
+	at gotime.Time,
+) error {
+	return c.pubSub.Heartbeat(subscriber, documentID, at)
+}
+
 // Publish publishes the given event.
 func (c *Coordinator) Publish(
 	ctx context.Context,
@@ -97,6 +108,32 @@ func (c *Coordinator) PublishToLocal(
 	c.pubSub.Publish(ctx, publisherID, event)
 }
 
+// DocumentMemories returns the approximate memory that this Coordinator holds
+// for the subscriptions of each document of the given project. The documents
+// of all the projects are returned if projectID is empty.
+func (c *Coordinator) DocumentMemories(projectID types.ID) []*types.DocumentMemory {
+	var memories []*types.DocumentMemory
+	for ref, count := range c.pubSub.SubscriptionCounts(projectID) {
+		memories = append(memories, &types.DocumentMemory{
+			ID:                ref.ID,
+			Key:               ref.Key,
+			Subscriptions:     count,
+			SubscriptionBytes: int64(count) * sync.SubscriptionBytes,
+		})
+	}
+	return memories
+}
+
+// LockCount returns the number of sessions holding or waiting for the locks of
+// the given keys.
+func (c *Coordinator) LockCount(keys ...sync.Key) int {
+	count := 0
+	for _, k := range keys {
+		count += c.locks.Count(k.String())
+	}
+	return count
+}
+
 // Members returns the members of this cluster.
 func (c *Coordinator) Members() map[string]*sync.ServerInfo {
 	members := make(map[string]*sync.ServerInfo)
@@ -106,5 +143,6 @@ func (c *Coordinator) Members() map[string]*sync.ServerInfo {
 
 // Close closes all resources of this Coordinator.
 func (c *Coordinator) Close() error {
+	c.pubSub.Close()
 	return nil
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/backend/sync/memory"
 )
 
@@ -37,9 +38,42 @@ func TestCoordinator(t *testing.T) {
 			id, err := time.ActorIDFromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, byte(i)})
 			assert.NoError(t, err)
 
-			_, clientIDs, err := coordinator.Subscribe(ctx, id, docID)
+			_, clientIDs, err := coordinator.Subscribe(ctx, id, sync.DocumentRef{ID: docID})
 			assert.NoError(t, err)
 			assert.Len(t, clientIDs, i+1)
 		}
 	})
+	t.Run("document memories test", func(t *testing.T) {
+		coordinator := memory.NewCoordinator(nil)
+		ctx := context.Background()
+		ref1 := sync.DocumentRef{ProjectID: "p1", ID: types.ID(t.Name() + "id1"), Key: "k1"}
+		ref2 := sync.DocumentRef{ProjectID: "p2", ID: types.ID(t.Name() + "id2"), Key: "k2"}
+
+		for i, ref := range []sync.DocumentRef{ref1, ref1, ref2} {
+			id, err := time.ActorIDFromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, byte(i)})
+			assert.NoError(t, err)
+			_, _, err = coordinator.Subscribe(ctx, id, ref)
+			assert.NoError(t, err)
+		}
+
+		memories := coordinator.DocumentMemories("p1")
+		assert.Len(t, memories, 1)
+		assert.Equal(t, ref1.ID, memories[0].ID)
+		assert.Equal(t, ref1.Key, memories[0].Key)
+		assert.Equal(t, 2, memories[0].Subscriptions)
+		assert.Len(t, coordinator.DocumentMemories(""), 2)
+	})
+
+	t.Run("lock count test", func(t *testing.T) {
+		coordinator := memory.NewCoordinator(nil)
+		ctx := context.Background()
+
+		locker, err := coordinator.NewLocker(ctx, sync.NewKey("k1"))
+		assert.NoError(t, err)
+		assert.NoError(t, locker.Lock(ctx))
+		assert.Equal(t, 1, coordinator.LockCount(sync.NewKey("k1"), sync.NewKey("k2")))
+
+		assert.NoError(t, locker.Unlock(ctx))
+		assert.Equal(t, 0, coordinator.LockCount(sync.NewKey("k1")))
+	})
 }
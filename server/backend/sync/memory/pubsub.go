@@ -18,24 +18,57 @@ package memory
 
 import (
 	"context"
+	"hash/fnv"
 	gosync "sync"
 	gotime "time"
 
 	"go.uber.org/zap"
 
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/logging"
 )
 
-// subscriptions is a map of subscriptions.
+// publishWorkers is the number of workers that deliver the published events.
+// The documents are sharded to the workers by their IDs, so that the events of
+// a document are delivered in order by the same worker.
+const publishWorkers = 32
+
+// publishQueueSize is the number of events that are queued for each document
+// before they are coalesced. Publish never blocks and never drops events, but
+// the events published while the queue is full are coalesced with the queued
+// ones if possible, so that slow subscribers do not make the queue grow.
+const publishQueueSize = 256
+
+// publication is an event to be delivered to the subscribers by a worker.
+type publication struct {
+	ctx         context.Context
+	publisherID *time.ActorID
+	event       sync.DocEvent
+}
+
+// subscriptions is a map of subscriptions to a document.
+//
+// Each document has its own queue of the published events, which is drained
+// in order by the worker of the document.
 type subscriptions struct {
+	ref         sync.DocumentRef
 	internalMap map[string]*sync.Subscription
+
+	// queueMu protects the fields below.
+	queueMu gosync.Mutex
+	queue   []publication
+
+	// scheduled tells whether the document is in the ready list of its worker.
+	scheduled bool
+	closed    bool
 }
 
-func newSubscriptions() *subscriptions {
+func newSubscriptions(ref sync.DocumentRef) *subscriptions {
 	return &subscriptions{
+		ref:         ref,
 		internalMap: make(map[string]*sync.Subscription),
 	}
 }
@@ -63,30 +96,173 @@ func (s *subscriptions) Len() int {
 	return len(s.internalMap)
 }
 
+// Close drops the queued publications of these subscriptions.
+func (s *subscriptions) Close() {
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+
+	s.closed = true
+	s.queue = nil
+}
+
+// Enqueue queues the given publication without blocking. If the queue is
+// full, the publication is coalesced with the queued one if possible. It
+// returns true if the document should be scheduled to its worker.
+func (s *subscriptions) Enqueue(p publication) bool {
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+
+	if s.closed {
+		return false
+	}
+
+	if len(s.queue) < publishQueueSize || !s.coalesce(p) {
+		s.queue = append(s.queue, p)
+	}
+
+	if s.scheduled {
+		return false
+	}
+	s.scheduled = true
+	return true
+}
+
+// coalesce coalesces the given publication into the last queued one of the
+// same publisher in place, if both of them are DocumentChangedEvent or
+// PeersChangedEvent. The other events, e.g. DocumentWatchedEvent, are never
+// coalesced, and neither are the events of different types, to keep the order
+// of the events of the publisher.
+func (s *subscriptions) coalesce(p publication) bool {
+	for i := len(s.queue) - 1; i >= 0; i-- {
+		queued := &s.queue[i]
+		if queued.publisherID.Compare(p.publisherID) != 0 {
+			continue
+		}
+		if queued.event.Type != p.event.Type {
+			return false
+		}
+
+		switch p.event.Type {
+		case types.DocumentChangedEvent:
+			return true
+		case types.PeersChangedEvent:
+			presence := make(innerpresence.Presence, len(queued.event.Presence)+len(p.event.Presence))
+			for k, v := range queued.event.Presence {
+				presence[k] = v
+			}
+			for k, v := range p.event.Presence {
+				presence[k] = v
+			}
+			queued.event.Presence = presence
+			return true
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+// Dequeue returns the first queued publication. If there is none, it returns
+// false and the document is unscheduled from its worker.
+func (s *subscriptions) Dequeue() (publication, bool) {
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+
+	if s.closed || len(s.queue) == 0 {
+		s.scheduled = false
+		return publication{}, false
+	}
+
+	p := s.queue[0]
+	s.queue[0] = publication{}
+	s.queue = s.queue[1:]
+	return p, true
+}
+
+// publishWorker delivers the events of the documents sharded to it. The
+// documents with queued events take turns in its ready list, and one event is
+// delivered per turn, so that a document with slow subscribers does not delay
+// the other documents of the worker much.
+type publishWorker struct {
+	mu     gosync.Mutex
+	ready  []*subscriptions
+	notify chan struct{}
+}
+
+func newPublishWorker() *publishWorker {
+	return &publishWorker{
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// schedule appends the given subscriptions to the ready list.
+func (w *publishWorker) schedule(subs *subscriptions) {
+	w.mu.Lock()
+	w.ready = append(w.ready, subs)
+	w.mu.Unlock()
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+// next pops the first subscriptions of the ready list. It returns nil if the
+// list is empty.
+func (w *publishWorker) next() *subscriptions {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.ready) == 0 {
+		return nil
+	}
+
+	subs := w.ready[0]
+	w.ready[0] = nil
+	w.ready = w.ready[1:]
+	return subs
+}
+
 // PubSub is the memory implementation of PubSub, used for single server.
 type PubSub struct {
 	subscriptionsMapMu      *gosync.RWMutex
 	subscriptionsMapByDocID map[types.ID]*subscriptions
+
+	publishWorkers []*publishWorker
+	closing        chan struct{}
+	closeOnce      gosync.Once
+	workers        gosync.WaitGroup
 }
 
 // NewPubSub creates an instance of PubSub.
 func NewPubSub() *PubSub {
-	return &PubSub{
+	m := &PubSub{
 		subscriptionsMapMu:      &gosync.RWMutex{},
 		subscriptionsMapByDocID: make(map[types.ID]*subscriptions),
+		closing:                 make(chan struct{}),
+	}
+
+	for i := 0; i < publishWorkers; i++ {
+		w := newPublishWorker()
+		m.publishWorkers = append(m.publishWorkers, w)
+		m.workers.Add(1)
+		go m.runWorker(w)
 	}
+
+	return m
 }
 
 // Subscribe subscribes to the given document keys.
 func (m *PubSub) Subscribe(
 	ctx context.Context,
 	subscriber *time.ActorID,
-	documentID types.ID,
+	ref sync.DocumentRef,
 ) (*sync.Subscription, error) {
-	if logging.Enabled(zap.DebugLevel) {
-		logging.From(ctx).Debugf(
+	if logging.ModuleEnabled("sync", zap.DebugLevel) {
+		logging.FromModule(ctx, "sync").Debugf(
 			`Subscribe(%s,%s) Start`,
-			documentID.String(),
+			ref.ID.String(),
 			subscriber.String(),
 		)
 	}
@@ -95,15 +271,15 @@ func (m *PubSub) Subscribe(
 	defer m.subscriptionsMapMu.Unlock()
 
 	sub := sync.NewSubscription(subscriber)
-	if _, ok := m.subscriptionsMapByDocID[documentID]; !ok {
-		m.subscriptionsMapByDocID[documentID] = newSubscriptions()
+	if _, ok := m.subscriptionsMapByDocID[ref.ID]; !ok {
+		m.subscriptionsMapByDocID[ref.ID] = newSubscriptions(ref)
 	}
-	m.subscriptionsMapByDocID[documentID].Add(sub)
+	m.subscriptionsMapByDocID[ref.ID].Add(sub)
 
-	if logging.Enabled(zap.DebugLevel) {
-		logging.From(ctx).Debugf(
+	if logging.ModuleEnabled("sync", zap.DebugLevel) {
+		logging.FromModule(ctx, "sync").Debugf(
 			`Subscribe(%s,%s) End`,
-			documentID.String(),
+			ref.ID.String(),
 			subscriber.String(),
 		)
 	}
@@ -116,11 +292,16 @@ func (m *PubSub) Unsubscribe(
 	documentID types.ID,
 	sub *sync.Subscription,
 ) {
+	// NOTE: Stop the subscription before taking the lock, so that
+	// the workers delivering events to it do not hold the lock for the timeout
+	// while many subscribers leave at once.
+	sub.Stop()
+
 	m.subscriptionsMapMu.Lock()
 	defer m.subscriptionsMapMu.Unlock()
 
-	if logging.Enabled(zap.DebugLevel) {
-		logging.From(ctx).Debugf(
+	if logging.ModuleEnabled("sync", zap.DebugLevel) {
+		logging.FromModule(ctx, "sync").Debugf(
 			`Unsubscribe(%s,%s) Start`,
 			documentID,
 			sub.Subscriber().String(),
@@ -134,11 +315,12 @@ func (m *PubSub) Unsubscribe(
 
 		if subs.Len() == 0 {
 			delete(m.subscriptionsMapByDocID, documentID)
+			subs.Close()
 		}
 	}
 
-	if logging.Enabled(zap.DebugLevel) {
-		logging.From(ctx).Debugf(
+	if logging.ModuleEnabled("sync", zap.DebugLevel) {
+		logging.FromModule(ctx, "sync").Debugf(
 			`Unsubscribe(%s,%s) End`,
 			documentID,
 			sub.Subscriber().String(),
@@ -146,7 +328,35 @@ func (m *PubSub) Unsubscribe(
 	}
 }
 
-// Publish publishes the given event.
+// Heartbeat records that the given subscriber of the document is alive at the
+// given time. It returns sync.ErrSubscriptionNotFound if the subscriber does not
+// watch the document, e.g. because it has been evicted.
+func (m *PubSub) Heartbeat(
+	subscriber *time.ActorID,
+	documentID types.ID,
+	at gotime.Time,
+) error {
+	m.subscriptionsMapMu.RLock()
+	defer m.subscriptionsMapMu.RUnlock()
+
+	found := false
+	if subs, ok := m.subscriptionsMapByDocID[documentID]; ok {
+		for _, sub := range subs.Map() {
+			if sub.Subscriber().Compare(subscriber) == 0 {
+				sub.Heartbeat(at)
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		return sync.ErrSubscriptionNotFound
+	}
+	return nil
+}
+
+// Publish publishes the given event. The event is delivered to the
+// subscribers asynchronously after this call returns.
 func (m *PubSub) Publish(
 	ctx context.Context,
 	publisherID *time.ActorID,
@@ -155,43 +365,105 @@ func (m *PubSub) Publish(
 	m.subscriptionsMapMu.RLock()
 	defer m.subscriptionsMapMu.RUnlock()
 
-	documentID := event.DocumentID
-	if logging.Enabled(zap.DebugLevel) {
-		logging.From(ctx).Debugf(`Publish(%s,%s) Start`, documentID.String(), publisherID.String())
+	subs, ok := m.subscriptionsMapByDocID[event.DocumentID]
+	if !ok {
+		return
 	}
 
-	if subs, ok := m.subscriptionsMapByDocID[documentID]; ok {
-		for _, sub := range subs.Map() {
-			if sub.Subscriber().Compare(publisherID) == 0 {
-				continue
-			}
+	if subs.Enqueue(publication{ctx: ctx, publisherID: publisherID, event: event}) {
+		m.workerOf(event.DocumentID).schedule(subs)
+	}
+}
 
-			if logging.Enabled(zap.DebugLevel) {
-				logging.From(ctx).Debugf(
-					`Publish %s(%s,%s) to %s`,
-					event.Type,
-					documentID.String(),
-					publisherID.String(),
-					sub.Subscriber().String(),
-				)
-			}
+// Close stops the workers of this PubSub. The events that are not delivered
+// yet are dropped.
+func (m *PubSub) Close() {
+	m.closeOnce.Do(func() {
+		close(m.closing)
+	})
+	m.workers.Wait()
+}
+
+// workerOf returns the worker that delivers the events of the given document.
+func (m *PubSub) workerOf(documentID types.ID) *publishWorker {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(documentID))
+	return m.publishWorkers[h.Sum32()%uint32(len(m.publishWorkers))]
+}
+
+// runWorker delivers the events of the documents scheduled to the given
+// worker until this PubSub is closed.
+func (m *PubSub) runWorker(w *publishWorker) {
+	defer m.workers.Done()
 
-			// NOTE: When a subscription is being closed by a subscriber,
-			// the subscriber may not receive messages.
+	for {
+		select {
+		case <-m.closing:
+			return
+		default:
+		}
+
+		subs := w.next()
+		if subs == nil {
 			select {
-			case sub.Events() <- event:
-			case <-gotime.After(100 * gotime.Millisecond):
-				logging.From(ctx).Warnf(
-					`Publish(%s,%s) to %s timeout`,
-					documentID.String(),
-					publisherID.String(),
-					sub.Subscriber().String(),
-				)
+			case <-w.notify:
+			case <-m.closing:
+				return
 			}
+			continue
+		}
+
+		p, ok := subs.Dequeue()
+		if !ok {
+			continue
 		}
+		m.deliver(subs, p)
+		w.schedule(subs)
 	}
-	if logging.Enabled(zap.DebugLevel) {
-		logging.From(ctx).Debugf(`Publish(%s,%s) End`, documentID.String(), publisherID.String())
+}
+
+// deliver delivers the given publication to the given subscriptions.
+func (m *PubSub) deliver(subs *subscriptions, p publication) {
+	m.subscriptionsMapMu.RLock()
+	defer m.subscriptionsMapMu.RUnlock()
+
+	ctx, publisherID, event := p.ctx, p.publisherID, p.event
+	documentID := event.DocumentID
+	if logging.ModuleEnabled("sync", zap.DebugLevel) {
+		logging.FromModule(ctx, "sync").Debugf(`Publish(%s,%s) Start`, documentID.String(), publisherID.String())
+	}
+
+	for _, sub := range subs.Map() {
+		if sub.Subscriber().Compare(publisherID) == 0 {
+			continue
+		}
+
+		if logging.ModuleEnabled("sync", zap.DebugLevel) {
+			logging.FromModule(ctx, "sync").Debugf(
+				`Publish %s(%s,%s) to %s`,
+				event.Type,
+				documentID.String(),
+				publisherID.String(),
+				sub.Subscriber().String(),
+			)
+		}
+
+		// NOTE: When a subscription is being closed by a subscriber,
+		// the subscriber may not receive messages.
+		select {
+		case sub.Events() <- event:
+		case <-sub.Stopped():
+		case <-gotime.After(100 * gotime.Millisecond):
+			logging.FromModule(ctx, "sync").Warnf(
+				`Publish(%s,%s) to %s timeout`,
+				documentID.String(),
+				publisherID.String(),
+				sub.Subscriber().String(),
+			)
+		}
+	}
+	if logging.ModuleEnabled("sync", zap.DebugLevel) {
+		logging.FromModule(ctx, "sync").Debugf(`Publish(%s,%s) End`, documentID.String(), publisherID.String())
 	}
 }
 
@@ -206,3 +478,20 @@ func (m *PubSub) ClientIDs(documentID types.ID) []*time.ActorID {
 	}
 	return ids
 }
+
+// SubscriptionCounts returns the number of subscriptions of each document of
+// the given project. The documents of all the projects are counted if
+// projectID is empty.
+func (m *PubSub) SubscriptionCounts(projectID types.ID) map[sync.DocumentRef]int {
+	m.subscriptionsMapMu.RLock()
+	defer m.subscriptionsMapMu.RUnlock()
+
+	counts := make(map[sync.DocumentRef]int)
+	for _, subs := range m.subscriptionsMapByDocID {
+		if projectID != "" && subs.ref.ProjectID != projectID {
+			continue
+		}
+		counts[subs.ref] = subs.Len()
+	}
+	return counts
+}
@@ -0,0 +1,67 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/backend/sync/memory"
+)
+
+func BenchmarkPubSub(b *testing.B) {
+	ctx := context.Background()
+	publisherID, err := time.ActorIDFromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	assert.NoError(b, err)
+
+	for _, size := range []int{10, 1000} {
+		b.Run(fmt.Sprintf("publish to %d subscribers test", size), func(b *testing.B) {
+			pubSub := memory.NewPubSub()
+			defer pubSub.Close()
+			id := types.ID(b.Name() + "id")
+
+			for i := 0; i < size; i++ {
+				subscriberID, err := time.ActorIDFromHex(fmt.Sprintf("%024x", i+1))
+				assert.NoError(b, err)
+				sub, err := pubSub.Subscribe(ctx, subscriberID, sync.DocumentRef{ID: id})
+				assert.NoError(b, err)
+				defer pubSub.Unsubscribe(ctx, id, sub)
+				go func() {
+					for range sub.Events() {
+					}
+				}()
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				pubSub.Publish(ctx, publisherID, sync.DocEvent{
+					Type:       types.DocumentChangedEvent,
+					Publisher:  publisherID,
+					DocumentID: id,
+				})
+			}
+		})
+	}
+}
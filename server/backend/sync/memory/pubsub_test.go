@@ -18,15 +18,19 @@ package memory_test
 
 import (
 	"context"
+	"fmt"
 	gosync "sync"
 	"testing"
+	gotime "time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/backend/sync/memory"
+	"github.com/yorkie-team/yorkie/server/logging"
 )
 
 func TestPubSub(t *testing.T) {
@@ -34,9 +38,12 @@ func TestPubSub(t *testing.T) {
 	assert.NoError(t, err)
 	idB, err := time.ActorIDFromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
 	assert.NoError(t, err)
+	idC, err := time.ActorIDFromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2})
+	assert.NoError(t, err)
 
 	t.Run("publish subscribe test", func(t *testing.T) {
 		pubSub := memory.NewPubSub()
+		defer pubSub.Close()
 		id := types.ID(t.Name() + "id")
 		docEvent := sync.DocEvent{
 			Type:       types.DocumentWatchedEvent,
@@ -46,7 +53,7 @@ func TestPubSub(t *testing.T) {
 
 		ctx := context.Background()
 		// subscribe the documents by actorA
-		subA, err := pubSub.Subscribe(ctx, idA, id)
+		subA, err := pubSub.Subscribe(ctx, idA, sync.DocumentRef{ID: id})
 		assert.NoError(t, err)
 		defer func() {
 			pubSub.Unsubscribe(ctx, id, subA)
@@ -64,4 +71,209 @@ func TestPubSub(t *testing.T) {
 		pubSub.Publish(ctx, idB, docEvent)
 		wg.Wait()
 	})
+	t.Run("publish in order test", func(t *testing.T) {
+		pubSub := memory.NewPubSub()
+		defer pubSub.Close()
+		id := types.ID(t.Name() + "id")
+		eventTypes := []types.DocEventType{
+			types.DocumentWatchedEvent,
+			types.DocumentChangedEvent,
+			types.DocumentChangedEvent,
+			types.DocumentUnwatchedEvent,
+		}
+
+		ctx := context.Background()
+		subA, err := pubSub.Subscribe(ctx, idA, sync.DocumentRef{ID: id})
+		assert.NoError(t, err)
+		defer func() {
+			pubSub.Unsubscribe(ctx, id, subA)
+		}()
+
+		var wg gosync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, eventType := range eventTypes {
+				e := <-subA.Events()
+				assert.Equal(t, eventType, e.Type)
+			}
+		}()
+
+		for _, eventType := range eventTypes {
+			pubSub.Publish(ctx, idB, sync.DocEvent{
+				Type:       eventType,
+				Publisher:  idB,
+				DocumentID: id,
+			})
+		}
+		wg.Wait()
+	})
+	t.Run("publish without blocking test", func(t *testing.T) {
+		pubSub := memory.NewPubSub()
+		defer pubSub.Close()
+		id := types.ID(t.Name() + "id")
+		otherID := types.ID(t.Name() + "other-id")
+
+		ctx := logging.With(context.Background(), logging.New("pubsub"))
+		subA, err := pubSub.Subscribe(ctx, idA, sync.DocumentRef{ID: id})
+		assert.NoError(t, err)
+		defer func() {
+			pubSub.Unsubscribe(ctx, id, subA)
+		}()
+		subOther, err := pubSub.Subscribe(ctx, idA, sync.DocumentRef{ID: otherID})
+		assert.NoError(t, err)
+		defer func() {
+			pubSub.Unsubscribe(ctx, otherID, subOther)
+		}()
+
+		// 01. the publisher is not blocked by the subscriber that does not
+		// receive the events.
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 1000; i++ {
+				pubSub.Publish(ctx, idB, sync.DocEvent{
+					Type:       types.DocumentChangedEvent,
+					Publisher:  idB,
+					DocumentID: id,
+				})
+			}
+		}()
+		select {
+		case <-done:
+		case <-gotime.After(gotime.Second):
+			assert.Fail(t, "publish is blocked")
+		}
+
+		// 02. the events of the other documents are delivered in the meantime.
+		pubSub.Publish(ctx, idB, sync.DocEvent{
+			Type:       types.DocumentWatchedEvent,
+			Publisher:  idB,
+			DocumentID: otherID,
+		})
+		select {
+		case e := <-subOther.Events():
+			assert.Equal(t, types.DocumentWatchedEvent, e.Type)
+		case <-gotime.After(gotime.Second):
+			assert.Fail(t, "event of the other document is not delivered")
+		}
+
+		// 03. the subscriber receives the changed event eventually.
+		select {
+		case e := <-subA.Events():
+			assert.Equal(t, types.DocumentChangedEvent, e.Type)
+		case <-gotime.After(gotime.Second):
+			assert.Fail(t, "changed event is not delivered")
+		}
+	})
+	t.Run("coalesce events in order test", func(t *testing.T) {
+		pubSub := memory.NewPubSub()
+		defer pubSub.Close()
+		id := types.ID(t.Name() + "id")
+
+		ctx := logging.With(context.Background(), logging.New("pubsub"))
+		subA, err := pubSub.Subscribe(ctx, idA, sync.DocumentRef{ID: id})
+		assert.NoError(t, err)
+		defer func() {
+			pubSub.Unsubscribe(ctx, id, subA)
+		}()
+
+		// 01. publish more events than the queue can hold before the
+		// subscriber receives them.
+		publish := func(publisher *time.ActorID, eventType types.DocEventType, p innerpresence.Presence) {
+			pubSub.Publish(ctx, publisher, sync.DocEvent{
+				Type:       eventType,
+				Publisher:  publisher,
+				DocumentID: id,
+				Presence:   p,
+			})
+		}
+		publish(idC, types.DocumentWatchedEvent, nil)
+		for i := 0; i < 1000; i++ {
+			publish(idB, types.DocumentChangedEvent, nil)
+		}
+		publish(idB, types.PeersChangedEvent, innerpresence.Presence{"k1": "1"})
+		publish(idB, types.PeersChangedEvent, innerpresence.Presence{"k1": "2", "k2": "1"})
+		publish(idC, types.DocumentUnwatchedEvent, nil)
+
+		// 02. the events are received in order, and only the changed events
+		// and the presence changes of the same publisher are coalesced.
+		var events []sync.DocEvent
+		for len(events) == 0 || events[len(events)-1].Type != types.DocumentUnwatchedEvent {
+			select {
+			case e := <-subA.Events():
+				events = append(events, e)
+			case <-gotime.After(gotime.Second):
+				assert.FailNow(t, "events are not delivered")
+			}
+		}
+
+		assert.Equal(t, types.DocumentWatchedEvent, events[0].Type)
+		changed := events[1 : len(events)-2]
+		assert.Less(t, len(changed), 1000)
+		for _, e := range changed {
+			assert.Equal(t, types.DocumentChangedEvent, e.Type)
+		}
+		peersChanged := events[len(events)-2]
+		assert.Equal(t, types.PeersChangedEvent, peersChanged.Type)
+		assert.Equal(t, innerpresence.Presence{"k1": "2", "k2": "1"}, peersChanged.Presence)
+	})
+	t.Run("deliver events of many documents test", func(t *testing.T) {
+		pubSub := memory.NewPubSub()
+		defer pubSub.Close()
+
+		// NOTE: The documents are more than the workers, so that some of them
+		// share the same worker.
+		ctx := context.Background()
+		var subs []*sync.Subscription
+		var ids []types.ID
+		for i := 0; i < 100; i++ {
+			id := types.ID(fmt.Sprintf("%sid-%d", t.Name(), i))
+			sub, err := pubSub.Subscribe(ctx, idA, sync.DocumentRef{ID: id})
+			assert.NoError(t, err)
+			defer pubSub.Unsubscribe(ctx, id, sub)
+			subs = append(subs, sub)
+			ids = append(ids, id)
+		}
+
+		for _, id := range ids {
+			pubSub.Publish(ctx, idB, sync.DocEvent{
+				Type:       types.DocumentWatchedEvent,
+				Publisher:  idB,
+				DocumentID: id,
+			})
+		}
+		for i, sub := range subs {
+			select {
+			case e := <-sub.Events():
+				assert.Equal(t, ids[i], e.DocumentID)
+			case <-gotime.After(gotime.Second):
+				assert.Fail(t, "event is not delivered")
+			}
+		}
+	})
+	t.Run("heartbeat test", func(t *testing.T) {
+		pubSub := memory.NewPubSub()
+		defer pubSub.Close()
+		id := types.ID(t.Name() + "id")
+		timeout := 10 * gotime.Second
+		now := gotime.Now()
+
+		ctx := context.Background()
+		subA, err := pubSub.Subscribe(ctx, idA, sync.DocumentRef{ID: id})
+		assert.NoError(t, err)
+
+		// subscribers that have never sent a heartbeat are not stale.
+		assert.False(t, subA.IsStale(now.Add(gotime.Hour), timeout))
+
+		assert.NoError(t, pubSub.Heartbeat(idA, id, now))
+		assert.False(t, subA.IsStale(now.Add(timeout), timeout))
+		assert.True(t, subA.IsStale(now.Add(timeout+gotime.Second), timeout))
+
+		// subscribers that do not watch the document cannot send heartbeats.
+		assert.ErrorIs(t, pubSub.Heartbeat(idB, id, now), sync.ErrSubscriptionNotFound)
+
+		pubSub.Unsubscribe(ctx, id, subA)
+		assert.ErrorIs(t, pubSub.Heartbeat(idA, id, now), sync.ErrSubscriptionNotFound)
+	})
 }
@@ -17,18 +17,59 @@
 package sync
 
 import (
+	"errors"
+	gosync "sync"
+	"sync/atomic"
+	gotime "time"
+
 	"github.com/rs/xid"
 
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+const (
+	// SubscriptionBytes is the approximate bytes that the server holds for a
+	// subscription, including the goroutine and the buffers of the stream
+	// that watches the document.
+	SubscriptionBytes = 16 << 10
+)
+
+var (
+	// ErrSubscriptionNotFound is returned when the subscription of the
+	// subscriber to the document does not exist.
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+
+	// ErrHeartbeatTimeout is returned when the subscription is evicted because
+	// the subscriber has stopped sending heartbeats.
+	ErrHeartbeatTimeout = errors.New("heartbeat timeout")
+)
+
+// DocumentRef is the reference of the document that subscriptions are for.
+// The project and the key of the document are kept along with its ID, so that
+// the memory held for the document can be reported without looking it up.
+type DocumentRef struct {
+	ProjectID types.ID
+	ID        types.ID
+	Key       key.Key
+}
+
 // Subscription represents a subscription of a subscriber to documents.
 type Subscription struct {
 	id         string
 	subscriber *time.ActorID
 	closed     bool
 	events     chan DocEvent
+
+	// stopped is closed when the subscriber stops receiving events.
+	stopped  chan struct{}
+	stopOnce gosync.Once
+
+	// lastHeartbeat is the time in unix nanoseconds of the last heartbeat of
+	// the subscriber. It is zero until the subscriber sends a heartbeat.
+	lastHeartbeat atomic.Int64
 }
 
 // NewSubscription creates a new instance of Subscription.
@@ -37,6 +78,7 @@ func NewSubscription(subscriber *time.ActorID) *Subscription {
 		id:         xid.New().String(),
 		subscriber: subscriber,
 		events:     make(chan DocEvent, 1),
+		stopped:    make(chan struct{}),
 	}
 }
 
@@ -50,6 +92,14 @@ type DocEvent struct {
 	Type       types.DocEventType
 	Publisher  *time.ActorID
 	DocumentID types.ID
+
+	// Presence is the changed keys of the presence of the publisher. It is
+	// set only for PeersChangedEvent.
+	Presence innerpresence.Presence
+
+	// Reason is the reason why the document becomes read-only or is evicted.
+	// It is set only for DocumentReadOnlyEvent and DocumentEvictedEvent.
+	Reason string
 }
 
 // Events returns the DocEvent channel of this subscription.
@@ -62,6 +112,38 @@ func (s *Subscription) Subscriber() *time.ActorID {
 	return s.subscriber
 }
 
+// Heartbeat records that the subscriber is alive at the given time.
+func (s *Subscription) Heartbeat(at gotime.Time) {
+	s.lastHeartbeat.Store(at.UnixNano())
+}
+
+// IsStale returns whether the subscriber has stopped sending heartbeats for
+// longer than the given timeout. Subscribers that have never sent a heartbeat,
+// such as older SDKs, are never stale.
+func (s *Subscription) IsStale(now gotime.Time, timeout gotime.Duration) bool {
+	last := s.lastHeartbeat.Load()
+	if last == 0 {
+		return false
+	}
+
+	return now.Sub(gotime.Unix(0, last)) > timeout
+}
+
+// Stop marks that the subscriber stops receiving events, so that publishers
+// do not wait for it until it is unsubscribed. It can be called many times
+// and without holding the lock of the subscriptions.
+func (s *Subscription) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopped)
+	})
+}
+
+// Stopped returns a channel that is closed when the subscriber stops
+// receiving events.
+func (s *Subscription) Stopped() <-chan struct{} {
+	return s.stopped
+}
+
 // Close closes all resources of this Subscription.
 func (s *Subscription) Close() {
 	if s.closed {
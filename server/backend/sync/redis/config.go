@@ -0,0 +1,87 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+var (
+	// ErrEmptyAddress is returned when the address is empty.
+	ErrEmptyAddress = errors.New("address must not be empty")
+
+	// ErrEmptyChannel is returned when the channel is empty.
+	ErrEmptyChannel = errors.New("channel must not be empty")
+)
+
+// Config is the configuration for creating a Coordinator instance.
+type Config struct {
+	// Address is the address of the Redis server, e.g. "localhost:6379".
+	Address string `yaml:"Address"`
+
+	// Password is the password of the Redis server.
+	Password string `yaml:"Password"`
+
+	// DB is the number of the database that keeps the members of the cluster.
+	DB int `yaml:"DB"`
+
+	// Channel is the name of the channel through which the servers broadcast
+	// the events of documents. Servers sharing a channel form a cluster.
+	Channel string `yaml:"Channel"`
+
+	// PingTimeout is the timeout of the ping to the Redis server.
+	PingTimeout string `yaml:"PingTimeout"`
+}
+
+// Validate returns an error if the provided Config is invalidated.
+func (c *Config) Validate() error {
+	if c.Address == "" {
+		return fmt.Errorf(`invalid argument "" for "--redis-address" flag: %w`, ErrEmptyAddress)
+	}
+
+	if c.DB < 0 {
+		return fmt.Errorf(`invalid argument "%d" for "--redis-db" flag: must not be negative`, c.DB)
+	}
+
+	if c.Channel == "" {
+		return fmt.Errorf(`invalid argument "" for "--redis-channel" flag: %w`, ErrEmptyChannel)
+	}
+
+	if _, err := time.ParseDuration(c.PingTimeout); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--redis-ping-timeout" flag: %w`,
+			c.PingTimeout,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// ParsePingTimeout returns ping timeout duration.
+func (c *Config) ParsePingTimeout() time.Duration {
+	result, err := time.ParseDuration(c.PingTimeout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse ping timeout: %w", err)
+		os.Exit(1)
+	}
+
+	return result
+}
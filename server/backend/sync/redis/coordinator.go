@@ -0,0 +1,257 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package redis provides the Redis implementation of the sync package. It
+// broadcasts the events of documents through Redis Pub/Sub so that watchers
+// connected to different servers receive the events of each other.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gosync "sync"
+	gotime "time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/backend/sync/memory"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+const (
+	// memberHeartbeatInterval is the interval at which a server refreshes its
+	// information in the members of the cluster.
+	memberHeartbeatInterval = 5 * gotime.Second
+
+	// memberTTL is the time after the last heartbeat of a server when it is no
+	// longer regarded as a member of the cluster.
+	memberTTL = 3 * memberHeartbeatInterval
+)
+
+// message is the message broadcast through the channel of the cluster.
+type message struct {
+	ServerID    string        `json:"server_id"`
+	PublisherID *time.ActorID `json:"publisher_id"`
+	Event       sync.DocEvent `json:"event"`
+}
+
+// Coordinator is a Redis-based implementation of sync.Coordinator. The
+// subscriptions and the locks are kept in the memory of each server, and the
+// published events are broadcast to the other servers of the cluster.
+//
+// NOTE: The locks are not shared between servers, so requests that change a
+// document should still be routed to the same server by the shard key. Also,
+// Subscribe returns only the subscribers connected to this server.
+type Coordinator struct {
+	*memory.Coordinator
+
+	serverInfo *sync.ServerInfo
+	channel    string
+	membersKey string
+
+	client *redis.Client
+	pubSub *redis.PubSub
+
+	closing   chan struct{}
+	closeOnce gosync.Once
+	workers   gosync.WaitGroup
+}
+
+// Dial creates an instance of Coordinator and connects it to the Redis
+// server of the given config.
+func Dial(conf *Config, serverInfo *sync.ServerInfo) (*Coordinator, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     conf.Address,
+		Password: conf.Password,
+		DB:       conf.DB,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), conf.ParsePingTimeout())
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("ping redis: %w", err)
+	}
+
+	pubSub := client.Subscribe(ctx, conf.Channel)
+	if _, err := pubSub.Receive(ctx); err != nil {
+		_ = pubSub.Close()
+		_ = client.Close()
+		return nil, fmt.Errorf("subscribe %s: %w", conf.Channel, err)
+	}
+
+	c := &Coordinator{
+		Coordinator: memory.NewCoordinator(serverInfo),
+		serverInfo:  serverInfo,
+		channel:     conf.Channel,
+		membersKey:  conf.Channel + ":members",
+		client:      client,
+		pubSub:      pubSub,
+		closing:     make(chan struct{}),
+	}
+
+	if err := c.heartbeat(ctx); err != nil {
+		_ = pubSub.Close()
+		_ = client.Close()
+		return nil, err
+	}
+
+	c.workers.Add(2)
+	go c.receive()
+	go c.keepAlive()
+
+	logging.DefaultLogger().Infof("redis coordinator connected, channel: %s", conf.Channel)
+
+	return c, nil
+}
+
+// Publish publishes the given event to the subscribers of this server and
+// broadcasts it to the other servers of the cluster.
+func (c *Coordinator) Publish(
+	ctx context.Context,
+	publisherID *time.ActorID,
+	event sync.DocEvent,
+) {
+	c.Coordinator.PublishToLocal(ctx, publisherID, event)
+
+	data, err := json.Marshal(&message{
+		ServerID:    c.serverInfo.ID,
+		PublisherID: publisherID,
+		Event:       event,
+	})
+	if err != nil {
+		logging.From(ctx).Error(fmt.Errorf("marshal message: %w", err))
+		return
+	}
+
+	if err := c.client.Publish(ctx, c.channel, data).Err(); err != nil {
+		logging.From(ctx).Error(fmt.Errorf("publish to %s: %w", c.channel, err))
+	}
+}
+
+// Members returns the members of this cluster. The servers that have not
+// sent heartbeats within the TTL are excluded.
+func (c *Coordinator) Members() map[string]*sync.ServerInfo {
+	members := make(map[string]*sync.ServerInfo)
+	members[c.serverInfo.ID] = c.serverInfo
+
+	values, err := c.client.HGetAll(context.Background(), c.membersKey).Result()
+	if err != nil {
+		logging.DefaultLogger().Error(fmt.Errorf("get members: %w", err))
+		return members
+	}
+
+	now := gotime.Now()
+	for id, value := range values {
+		info := &sync.ServerInfo{}
+		if err := json.Unmarshal([]byte(value), info); err != nil {
+			logging.DefaultLogger().Warnf("unmarshal member %s: %s", id, err)
+			continue
+		}
+		if now.Sub(info.UpdatedAt) > memberTTL {
+			continue
+		}
+		members[id] = info
+	}
+
+	return members
+}
+
+// Close leaves the cluster and closes all resources of this Coordinator.
+func (c *Coordinator) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closing)
+	})
+
+	if err := c.client.HDel(context.Background(), c.membersKey, c.serverInfo.ID).Err(); err != nil {
+		logging.DefaultLogger().Error(fmt.Errorf("leave members: %w", err))
+	}
+
+	if err := c.pubSub.Close(); err != nil {
+		logging.DefaultLogger().Error(fmt.Errorf("close pubsub: %w", err))
+	}
+	c.workers.Wait()
+
+	if err := c.client.Close(); err != nil {
+		return fmt.Errorf("close redis: %w", err)
+	}
+
+	return c.Coordinator.Close()
+}
+
+// receive delivers the events broadcast by the other servers to the
+// subscribers of this server until the Pub/Sub is closed.
+func (c *Coordinator) receive() {
+	defer c.workers.Done()
+
+	for msg := range c.pubSub.Channel() {
+		m := &message{}
+		if err := json.Unmarshal([]byte(msg.Payload), m); err != nil {
+			logging.DefaultLogger().Warnf("unmarshal message: %s", err)
+			continue
+		}
+
+		// NOTE: Redis delivers the messages to the publisher as well, and the
+		// events of this server are already published to its subscribers.
+		if m.ServerID == c.serverInfo.ID {
+			continue
+		}
+
+		c.Coordinator.PublishToLocal(context.Background(), m.PublisherID, m.Event)
+	}
+}
+
+// keepAlive refreshes the information of this server in the members of the
+// cluster until the Coordinator is closed.
+func (c *Coordinator) keepAlive() {
+	defer c.workers.Done()
+
+	ticker := gotime.NewTicker(memberHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.heartbeat(context.Background()); err != nil {
+				logging.DefaultLogger().Error(err)
+			}
+		case <-c.closing:
+			return
+		}
+	}
+}
+
+// heartbeat records the information of this server in the members of the
+// cluster with the current time.
+func (c *Coordinator) heartbeat(ctx context.Context) error {
+	info := *c.serverInfo
+	info.UpdatedAt = gotime.Now()
+
+	data, err := json.Marshal(&info)
+	if err != nil {
+		return fmt.Errorf("marshal server info: %w", err)
+	}
+
+	if err := c.client.HSet(ctx, c.membersKey, c.serverInfo.ID, data).Err(); err != nil {
+		return fmt.Errorf("register member: %w", err)
+	}
+
+	return nil
+}
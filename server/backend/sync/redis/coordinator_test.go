@@ -0,0 +1,116 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+	gotime "time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/backend/sync/redis"
+)
+
+func dialCoordinator(t *testing.T, server *miniredis.Miniredis, id string) *redis.Coordinator {
+	coordinator, err := redis.Dial(&redis.Config{
+		Address:     server.Addr(),
+		Channel:     "yorkie",
+		PingTimeout: "1s",
+	}, &sync.ServerInfo{ID: id, Hostname: id})
+	assert.NoError(t, err)
+	return coordinator
+}
+
+func TestCoordinator(t *testing.T) {
+	t.Run("broadcast test", func(t *testing.T) {
+		server := miniredis.RunT(t)
+		c1 := dialCoordinator(t, server, "server1")
+		c2 := dialCoordinator(t, server, "server2")
+		defer func() {
+			assert.NoError(t, c1.Close())
+			assert.NoError(t, c2.Close())
+		}()
+
+		ctx := context.Background()
+		docID := types.ID(t.Name() + "id")
+		publisher, err := time.ActorIDFromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+		assert.NoError(t, err)
+		subscriber, err := time.ActorIDFromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2})
+		assert.NoError(t, err)
+
+		sub, _, err := c2.Subscribe(ctx, subscriber, sync.DocumentRef{ID: docID})
+		assert.NoError(t, err)
+
+		c1.Publish(ctx, publisher, sync.DocEvent{
+			Type:       types.DocumentChangedEvent,
+			Publisher:  publisher,
+			DocumentID: docID,
+		})
+
+		select {
+		case event := <-sub.Events():
+			assert.Equal(t, types.DocumentChangedEvent, event.Type)
+			assert.Equal(t, docID, event.DocumentID)
+			assert.Equal(t, publisher.String(), event.Publisher.String())
+		case <-gotime.After(5 * gotime.Second):
+			assert.Fail(t, "the event is not broadcast")
+		}
+	})
+
+	t.Run("members test", func(t *testing.T) {
+		server := miniredis.RunT(t)
+		c1 := dialCoordinator(t, server, "server1")
+		c2 := dialCoordinator(t, server, "server2")
+		defer func() {
+			assert.NoError(t, c1.Close())
+		}()
+
+		assert.Len(t, c1.Members(), 2)
+		assert.Contains(t, c1.Members(), "server2")
+
+		assert.NoError(t, c2.Close())
+		assert.Len(t, c1.Members(), 1)
+		assert.NotContains(t, c1.Members(), "server2")
+	})
+}
+
+func TestConfig(t *testing.T) {
+	t.Run("validate test", func(t *testing.T) {
+		conf := &redis.Config{
+			Address:     "localhost:6379",
+			Channel:     "yorkie",
+			PingTimeout: "5s",
+		}
+		assert.NoError(t, conf.Validate())
+
+		conf.Address = ""
+		assert.ErrorIs(t, conf.Validate(), redis.ErrEmptyAddress)
+		conf.Address = "localhost:6379"
+
+		conf.Channel = ""
+		assert.ErrorIs(t, conf.Validate(), redis.ErrEmptyChannel)
+		conf.Channel = "yorkie"
+
+		conf.PingTimeout = "5"
+		assert.Error(t, conf.Validate())
+	})
+}
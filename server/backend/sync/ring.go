@@ -0,0 +1,84 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync
+
+import (
+	"hash/crc32"
+	"sort"
+	"strconv"
+)
+
+// ringReplicas is the number of the points of each server on the ring. The
+// more points the servers have, the more evenly the keys are distributed.
+const ringReplicas = 128
+
+// HashRing assigns keys to the servers of the cluster by consistent hashing.
+// When a server joins or leaves the cluster, only the keys of the server are
+// reassigned and the others stay with their servers.
+type HashRing struct {
+	points  []uint32
+	servers map[uint32]*ServerInfo
+}
+
+// NewHashRing creates an instance of HashRing with the given members.
+func NewHashRing(members map[string]*ServerInfo) *HashRing {
+	ring := &HashRing{
+		servers: make(map[uint32]*ServerInfo),
+	}
+
+	// NOTE: The IDs are sorted so that a point shared by two servers belongs
+	// to the same server on every server of the cluster.
+	ids := make([]string, 0, len(members))
+	for id := range members {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		for i := 0; i < ringReplicas; i++ {
+			point := crc32.ChecksumIEEE([]byte(id + "#" + strconv.Itoa(i)))
+			if _, ok := ring.servers[point]; ok {
+				continue
+			}
+			ring.servers[point] = members[id]
+			ring.points = append(ring.points, point)
+		}
+	}
+	sort.Slice(ring.points, func(i, j int) bool {
+		return ring.points[i] < ring.points[j]
+	})
+
+	return ring
+}
+
+// Owner returns the server that owns the given key. It returns nil if the
+// ring has no servers.
+func (r *HashRing) Owner(key string) *ServerInfo {
+	if len(r.points) == 0 {
+		return nil
+	}
+
+	hash := crc32.ChecksumIEEE([]byte(key))
+	idx := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i] >= hash
+	})
+	if idx == len(r.points) {
+		idx = 0
+	}
+
+	return r.servers[r.points[idx]]
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+)
+
+func TestHashRing(t *testing.T) {
+	members := func(ids ...string) map[string]*sync.ServerInfo {
+		result := make(map[string]*sync.ServerInfo)
+		for _, id := range ids {
+			result[id] = &sync.ServerInfo{ID: id}
+		}
+		return result
+	}
+
+	t.Run("empty ring test", func(t *testing.T) {
+		assert.Nil(t, sync.NewHashRing(nil).Owner("key"))
+	})
+
+	t.Run("distribution test", func(t *testing.T) {
+		ring := sync.NewHashRing(members("s1", "s2", "s3"))
+
+		counts := make(map[string]int)
+		for i := 0; i < 3000; i++ {
+			counts[ring.Owner("doc-"+strconv.Itoa(i)).ID]++
+		}
+		assert.Len(t, counts, 3)
+		for _, count := range counts {
+			assert.Greater(t, count, 500)
+		}
+	})
+
+	t.Run("rebalance test", func(t *testing.T) {
+		before := sync.NewHashRing(members("s1", "s2", "s3"))
+		after := sync.NewHashRing(members("s1", "s2", "s3", "s4"))
+
+		for i := 0; i < 1000; i++ {
+			key := "doc-" + strconv.Itoa(i)
+			owner := after.Owner(key)
+
+			// NOTE: A key is moved only to the new server.
+			if owner.ID != "s4" {
+				assert.Equal(t, before.Owner(key).ID, owner.ID)
+			}
+		}
+
+		// The owners do not depend on the order of the members.
+		assert.Equal(t, after.Owner("doc"), sync.NewHashRing(members("s4", "s3", "s2", "s1")).Owner("doc"))
+	})
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package backups provides the backup of the documents of a project. A backup
+// is a tar archive that has the export of each document, followed by the
+// manifest that lists the documents.
+package backups
+
+import (
+	"archive/tar"
+	"context"
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/documents"
+	"github.com/yorkie-team/yorkie/server/packs"
+)
+
+// Backup writes the documents of the given project to the given writer as a
+// tar archive, and returns the manifest of the archive.
+//
+// The server seqs of the documents are taken as their watermarks when the
+// backup starts, and each document is exported at its watermark, so the
+// changes made during the backup are not included. The housekeeping of the
+// cluster is paused until the backup ends so that the changes up to the
+// watermarks are not compacted in the middle.
+func Backup(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	w io.Writer,
+	includeChanges bool,
+) (*types.BackupManifest, error) {
+	resume, err := be.Housekeeping.Pause(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	manifest := &types.BackupManifest{
+		Project:   project.Name,
+		CreatedAt: be.Clock.Now(),
+	}
+	infos, err := documents.FindDocInfosByLabels(ctx, be, project, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tw := tar.NewWriter(w)
+	for _, info := range infos {
+		export, err := packs.ExportDocument(ctx, be, info, info.ServerSeq, includeChanges)
+		// NOTE: The changes compacted before the backup cannot be restored, so
+		// only the snapshot of such documents is backed up.
+		if errors.Is(err, packs.ErrChangeLogIncomplete) {
+			export, err = packs.ExportDocument(ctx, be, info, info.ServerSeq, false)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		pbChanges, err := converter.ToChanges(export.Changes)
+		if err != nil {
+			return nil, err
+		}
+		data, err := (&api.DocumentExport{
+			DocumentKey: info.Key.String(),
+			ServerSeq:   export.ServerSeq,
+			Snapshot:    export.Snapshot,
+			Changes:     pbChanges,
+		}).Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("marshal export of %s: %w", info.Key, err)
+		}
+		if err := writeEntry(tw, types.BackupDocumentPath(info.Key), data, manifest.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		manifest.Documents = append(manifest.Documents, &types.BackupDocument{
+			Key:        info.Key,
+			ServerSeq:  export.ServerSeq,
+			HasChanges: len(export.Changes) > 0,
+		})
+	}
+
+	data, err := gojson.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("marshal backup manifest: %w", err)
+	}
+	if err := writeEntry(tw, types.BackupManifestName, data, manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("close backup archive: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// writeEntry writes the given data as a file of the given name to the archive.
+func writeEntry(tw *tar.Writer, name string, data []byte, modTime gotime.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0600,
+		Size:     int64(len(data)),
+		ModTime:  modTime,
+	}); err != nil {
+		return fmt.Errorf("write header of %s: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+
+	return nil
+}
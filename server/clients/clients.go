@@ -24,8 +24,12 @@ import (
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
 )
 
+// pageSizeLimit is the limit of the pagination size of clients.
+const pageSizeLimit = 101
+
 var (
 	// ErrInvalidClientKey is returned when the given Key is not valid ClientKey.
 	ErrInvalidClientKey = errors.New("invalid client key")
@@ -34,14 +38,31 @@ var (
 	ErrInvalidClientID = errors.New("invalid client id")
 )
 
-// Activate activates the given client.
+// Activate activates the given client and records the connection that the
+// client is activated with.
 func Activate(
 	ctx context.Context,
 	db database.Database,
 	project *types.Project,
 	clientKey string,
+	connection types.ConnectionInfo,
 ) (*database.ClientInfo, error) {
-	return db.ActivateClient(ctx, project.ID, clientKey)
+	clientInfo, err := db.ActivateClient(ctx, project.ID, clientKey, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.From(ctx).Infof(
+		"ACTV: '%s'(%s) is activated in '%s' from ip: %s, user agent: %s, source: %s",
+		clientInfo.Key,
+		clientInfo.ID,
+		project.Name,
+		connection.IP,
+		connection.UserAgent,
+		connection.Source,
+	)
+
+	return clientInfo, nil
 }
 
 // Deactivate deactivates the given client.
@@ -88,7 +109,21 @@ func Deactivate(
 		}
 	}
 
-	return db.DeactivateClient(ctx, projectID, clientID)
+	clientInfo, err = db.DeactivateClient(ctx, projectID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.From(ctx).Infof(
+		"DACT: '%s'(%s) is deactivated, activated from ip: %s, user agent: %s, source: %s",
+		clientInfo.Key,
+		clientInfo.ID,
+		clientInfo.Connection.IP,
+		clientInfo.Connection.UserAgent,
+		clientInfo.Connection.Source,
+	)
+
+	return clientInfo, nil
 }
 
 // FindClientInfo finds the client with the given id.
@@ -104,3 +139,34 @@ func FindClientInfo(
 		types.IDFromActorID(clientID),
 	)
 }
+
+// ListClientSummaries returns a list of client summaries.
+func ListClientSummaries(
+	ctx context.Context,
+	db database.Database,
+	project *types.Project,
+	paging types.Paging[types.ID],
+) ([]*types.ClientSummary, error) {
+	if paging.PageSize > pageSizeLimit {
+		paging.PageSize = pageSizeLimit
+	}
+
+	infos, err := db.FindClientInfosByPaging(ctx, project.ID, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	var summaries []*types.ClientSummary
+	for _, info := range infos {
+		summaries = append(summaries, &types.ClientSummary{
+			ID:         info.ID,
+			Key:        info.Key,
+			Status:     info.Status,
+			Connection: info.Connection,
+			CreatedAt:  info.CreatedAt,
+			UpdatedAt:  info.UpdatedAt,
+		})
+	}
+
+	return summaries, nil
+}
@@ -24,31 +24,62 @@ import (
 
 	"gopkg.in/yaml.v2"
 
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/internal/compression"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database/mongo"
+	"github.com/yorkie-team/yorkie/server/backend/database/postgres"
 	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/server/backend/objectstorage"
+	"github.com/yorkie-team/yorkie/server/backend/sync/redis"
+	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/server/profiling"
 	"github.com/yorkie-team/yorkie/server/rpc"
+	"github.com/yorkie-team/yorkie/server/verification"
 )
 
 // Below are the values of the default values of Yorkie config.
 const (
 	DefaultRPCPort                  = 11101
-	DefaultRPCMaxRequestsBytes      = 4 * 1024 * 1024 // 4MiB
+	DefaultRPCMaxRequestsBytes      = 4 * 1024 * 1024  // 4MiB
+	DefaultRPCMaxStreamedPackBytes  = 64 * 1024 * 1024 // 64MiB
 	DefaultRPCMaxConnectionAge      = 0 * time.Second
 	DefaultRPCMaxConnectionAgeGrace = 0 * time.Second
+	DefaultRPCCompressionMinSize    = compression.DefaultMinSize
+
+	DefaultAdminPort = 11103
 
 	DefaultProfilingPort = 11102
 
 	DefaultHousekeepingInterval                  = 30 * time.Second
 	DefaultHousekeepingCandidatesLimitPerProject = 500
 	DefaultHousekeepingProjectFetchSize          = 100
+	DefaultHousekeepingCompactionCandidatesLimit = 0
+	DefaultHousekeepingChangeLogRetention        = 1000
+
+	DefaultVerificationInterval   = 10 * time.Minute
+	DefaultVerificationSampleSize = 10
+
+	DefaultLogEncoding       = logging.ConsoleEncoding
+	DefaultLogFileMaxSize    = 100 // 100MB
+	DefaultLogFileMaxBackups = 3
 
 	DefaultMongoConnectionURI     = "mongodb://localhost:27017"
 	DefaultMongoConnectionTimeout = 5 * time.Second
 	DefaultMongoPingTimeout       = 5 * time.Second
 	DefaultMongoYorkieDatabase    = "yorkie-meta"
 
+	DefaultPostgresConnectionURI     = "postgres://localhost:5432/postgres?sslmode=disable"
+	DefaultPostgresConnectionTimeout = 5 * time.Second
+	DefaultPostgresPingTimeout       = 5 * time.Second
+	DefaultPostgresYorkieSchema      = "yorkie"
+
+	DefaultObjectStorageSnapshotThreshold = 1024 * 1024 // 1MB
+
+	DefaultRedisAddress     = "localhost:6379"
+	DefaultRedisChannel     = "yorkie"
+	DefaultRedisPingTimeout = 5 * time.Second
+
 	DefaultAdminUser                  = "admin"
 	DefaultAdminPassword              = "admin"
 	DefaultSecretKey                  = "yorkie-secret"
@@ -58,25 +89,47 @@ const (
 	DefaultSnapshotThreshold          = 500
 	DefaultSnapshotInterval           = 1000
 	DefaultSnapshotWithPurgingChanges = false
-
-	DefaultAuthWebhookMaxRetries      = 10
-	DefaultAuthWebhookMaxWaitInterval = 3000 * time.Millisecond
-	DefaultAuthWebhookCacheSize       = 5000
-	DefaultAuthWebhookCacheAuthTTL    = 10 * time.Second
-	DefaultAuthWebhookCacheUnauthTTL  = 10 * time.Second
-	DefaultProjectInfoCacheSize       = 256
-	DefaultProjectInfoCacheTTL        = 10 * time.Minute
+	DefaultSnapshotBuildBatchSize     = 100
+	DefaultSnapshotMode               = backend.SnapshotModeFull
+	DefaultSnapshotMaxDeltas          = 10
+	DefaultSnapshotCodec              = converter.SnapshotCodecProtobuf
+
+	DefaultMaxOperationsPerChange = 100000
+	DefaultMaxChangeDepth         = 128
+	DefaultMaxStringLength        = 4 * 1024 * 1024
+
+	DefaultAuthWebhookMaxRetries       = 10
+	DefaultAuthWebhookMaxWaitInterval  = 3000 * time.Millisecond
+	DefaultAuthWebhookCacheSize        = 5000
+	DefaultAuthWebhookCacheAuthTTL     = 10 * time.Second
+	DefaultAuthWebhookCacheUnauthTTL   = 10 * time.Second
+	DefaultAuthJWKSCacheTTL            = 10 * time.Minute
+	DefaultEventWebhookMaxRetries      = 5
+	DefaultEventWebhookMaxWaitInterval = 3000 * time.Millisecond
+	DefaultProjectInfoCacheSize        = 256
+	DefaultProjectInfoCacheTTL         = 10 * time.Minute
+	DefaultSnapshotCacheSize           = 128
+	DefaultSnapshotCacheTTL            = time.Minute
+	DefaultPushPullCacheSize           = 1024
+	DefaultPushPullCacheTTL            = time.Minute
+	DefaultWatchHeartbeatTimeout       = 30 * time.Second
 
 	DefaultHostname = ""
 )
 
 // Config is the configuration for creating a Yorkie instance.
 type Config struct {
-	RPC          *rpc.Config          `yaml:"RPC"`
-	Profiling    *profiling.Config    `yaml:"Profiling"`
-	Housekeeping *housekeeping.Config `yaml:"Housekeeping"`
-	Backend      *backend.Config      `yaml:"Backend"`
-	Mongo        *mongo.Config        `yaml:"Mongo"`
+	RPC           *rpc.Config           `yaml:"RPC"`
+	Admin         *rpc.AdminConfig      `yaml:"Admin"`
+	Profiling     *profiling.Config     `yaml:"Profiling"`
+	Housekeeping  *housekeeping.Config  `yaml:"Housekeeping"`
+	Verification  *verification.Config  `yaml:"Verification"`
+	Backend       *backend.Config       `yaml:"Backend"`
+	Mongo         *mongo.Config         `yaml:"Mongo"`
+	Postgres      *postgres.Config      `yaml:"Postgres"`
+	ObjectStorage *objectstorage.Config `yaml:"ObjectStorage"`
+	Redis         *redis.Config         `yaml:"Redis"`
+	Logging       *logging.Config       `yaml:"Logging"`
 }
 
 // NewConfig returns a Config struct that contains reasonable defaults
@@ -106,12 +159,27 @@ func (c *Config) RPCAddr() string {
 	return fmt.Sprintf("localhost:%d", c.RPC.Port)
 }
 
+// AdminAddr returns the address of the admin service. It is the RPC address
+// unless the admin server is configured separately.
+func (c *Config) AdminAddr() string {
+	if c.Admin == nil {
+		return c.RPCAddr()
+	}
+	return fmt.Sprintf("localhost:%d", c.Admin.Port)
+}
+
 // Validate returns an error if the provided Config is invalidated.
 func (c *Config) Validate() error {
 	if err := c.RPC.Validate(); err != nil {
 		return err
 	}
 
+	if c.Admin != nil {
+		if err := c.Admin.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if err := c.Profiling.Validate(); err != nil {
 		return err
 	}
@@ -120,6 +188,12 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if c.Verification != nil {
+		if err := c.Verification.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if err := c.Backend.Validate(); err != nil {
 		return err
 	}
@@ -130,6 +204,41 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.Postgres != nil {
+		if c.Mongo != nil {
+			return fmt.Errorf("only one of Mongo and Postgres can be configured")
+		}
+
+		if err := c.Postgres.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.ObjectStorage != nil {
+		if err := c.ObjectStorage.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Redis != nil {
+		if err := c.Redis.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Backend.ClusterRPCAddr != "" && c.Redis == nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-cluster-rpc-addr" flag: Redis must be configured`,
+			c.Backend.ClusterRPCAddr,
+		)
+	}
+
+	if c.Logging != nil {
+		if err := c.Logging.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -144,6 +253,10 @@ func (c *Config) ensureDefaultValue() {
 		c.RPC.MaxRequestBytes = DefaultRPCMaxRequestsBytes
 	}
 
+	if c.RPC.MaxStreamedPackBytes == 0 {
+		c.RPC.MaxStreamedPackBytes = DefaultRPCMaxStreamedPackBytes
+	}
+
 	if c.RPC.MaxConnectionAge == "" {
 		c.RPC.MaxConnectionAge = DefaultRPCMaxConnectionAge.String()
 	}
@@ -152,6 +265,14 @@ func (c *Config) ensureDefaultValue() {
 		c.RPC.MaxConnectionAgeGrace = DefaultRPCMaxConnectionAgeGrace.String()
 	}
 
+	if c.RPC.CompressionMinSize == 0 {
+		c.RPC.CompressionMinSize = DefaultRPCCompressionMinSize
+	}
+
+	if c.Admin != nil && c.Admin.Port == 0 {
+		c.Admin.Port = DefaultAdminPort
+	}
+
 	if c.Profiling.Port == 0 {
 		c.Profiling.Port = DefaultProfilingPort
 	}
@@ -184,6 +305,34 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.SnapshotInterval = DefaultSnapshotInterval
 	}
 
+	if c.Backend.SnapshotBuildBatchSize == 0 {
+		c.Backend.SnapshotBuildBatchSize = DefaultSnapshotBuildBatchSize
+	}
+
+	if c.Backend.SnapshotMode == "" {
+		c.Backend.SnapshotMode = DefaultSnapshotMode
+	}
+
+	if c.Backend.SnapshotMaxDeltas == 0 {
+		c.Backend.SnapshotMaxDeltas = DefaultSnapshotMaxDeltas
+	}
+
+	if c.Backend.SnapshotCodec == "" {
+		c.Backend.SnapshotCodec = DefaultSnapshotCodec
+	}
+
+	if c.Backend.MaxOperationsPerChange == 0 {
+		c.Backend.MaxOperationsPerChange = DefaultMaxOperationsPerChange
+	}
+
+	if c.Backend.MaxChangeDepth == 0 {
+		c.Backend.MaxChangeDepth = DefaultMaxChangeDepth
+	}
+
+	if c.Backend.MaxStringLength == 0 {
+		c.Backend.MaxStringLength = DefaultMaxStringLength
+	}
+
 	if c.Backend.AuthWebhookCacheSize == 0 {
 		c.Backend.AuthWebhookCacheSize = DefaultAuthWebhookCacheSize
 	}
@@ -204,6 +353,18 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.AuthWebhookCacheUnauthTTL = DefaultAuthWebhookCacheUnauthTTL.String()
 	}
 
+	if c.Backend.AuthJWKSCacheTTL == "" {
+		c.Backend.AuthJWKSCacheTTL = DefaultAuthJWKSCacheTTL.String()
+	}
+
+	if c.Backend.EventWebhookMaxRetries == 0 {
+		c.Backend.EventWebhookMaxRetries = DefaultEventWebhookMaxRetries
+	}
+
+	if c.Backend.EventWebhookMaxWaitInterval == "" {
+		c.Backend.EventWebhookMaxWaitInterval = DefaultEventWebhookMaxWaitInterval.String()
+	}
+
 	if c.Backend.ProjectInfoCacheSize == 0 {
 		c.Backend.ProjectInfoCacheSize = DefaultProjectInfoCacheSize
 	}
@@ -212,6 +373,26 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.ProjectInfoCacheTTL = DefaultProjectInfoCacheTTL.String()
 	}
 
+	if c.Backend.SnapshotCacheSize == 0 {
+		c.Backend.SnapshotCacheSize = DefaultSnapshotCacheSize
+	}
+
+	if c.Backend.SnapshotCacheTTL == "" {
+		c.Backend.SnapshotCacheTTL = DefaultSnapshotCacheTTL.String()
+	}
+
+	if c.Backend.PushPullCacheSize == 0 {
+		c.Backend.PushPullCacheSize = DefaultPushPullCacheSize
+	}
+
+	if c.Backend.PushPullCacheTTL == "" {
+		c.Backend.PushPullCacheTTL = DefaultPushPullCacheTTL.String()
+	}
+
+	if c.Backend.WatchHeartbeatTimeout == "" {
+		c.Backend.WatchHeartbeatTimeout = DefaultWatchHeartbeatTimeout.String()
+	}
+
 	if c.Mongo != nil {
 		if c.Mongo.ConnectionURI == "" {
 			c.Mongo.ConnectionURI = DefaultMongoConnectionURI
@@ -229,6 +410,56 @@ func (c *Config) ensureDefaultValue() {
 			c.Mongo.PingTimeout = DefaultMongoPingTimeout.String()
 		}
 	}
+
+	if c.Postgres != nil {
+		if c.Postgres.ConnectionURI == "" {
+			c.Postgres.ConnectionURI = DefaultPostgresConnectionURI
+		}
+
+		if c.Postgres.ConnectionTimeout == "" {
+			c.Postgres.ConnectionTimeout = DefaultPostgresConnectionTimeout.String()
+		}
+
+		if c.Postgres.YorkieSchema == "" {
+			c.Postgres.YorkieSchema = DefaultPostgresYorkieSchema
+		}
+
+		if c.Postgres.PingTimeout == "" {
+			c.Postgres.PingTimeout = DefaultPostgresPingTimeout.String()
+		}
+	}
+
+	if c.ObjectStorage != nil && c.ObjectStorage.SnapshotThreshold == 0 {
+		c.ObjectStorage.SnapshotThreshold = DefaultObjectStorageSnapshotThreshold
+	}
+
+	if c.Redis != nil {
+		if c.Redis.Address == "" {
+			c.Redis.Address = DefaultRedisAddress
+		}
+
+		if c.Redis.Channel == "" {
+			c.Redis.Channel = DefaultRedisChannel
+		}
+
+		if c.Redis.PingTimeout == "" {
+			c.Redis.PingTimeout = DefaultRedisPingTimeout.String()
+		}
+	}
+
+	if c.Logging != nil {
+		if c.Logging.Encoding == "" {
+			c.Logging.Encoding = DefaultLogEncoding
+		}
+
+		if c.Logging.FileMaxSize == 0 {
+			c.Logging.FileMaxSize = DefaultLogFileMaxSize
+		}
+
+		if c.Logging.FileMaxBackups == 0 {
+			c.Logging.FileMaxBackups = DefaultLogFileMaxBackups
+		}
+	}
 }
 
 func newConfig(port int, profilingPort int) *Config {
@@ -243,12 +474,30 @@ func newConfig(port int, profilingPort int) *Config {
 			Interval:                  DefaultHousekeepingInterval.String(),
 			CandidatesLimitPerProject: DefaultHousekeepingCandidatesLimitPerProject,
 			ProjectFetchSize:          DefaultHousekeepingProjectFetchSize,
+			CompactionCandidatesLimit: DefaultHousekeepingCompactionCandidatesLimit,
+			ChangeLogRetention:        DefaultHousekeepingChangeLogRetention,
+		},
+		Verification: &verification.Config{
+			Interval:   DefaultVerificationInterval.String(),
+			SampleSize: DefaultVerificationSampleSize,
 		},
 		Backend: &backend.Config{
 			ClientDeactivateThreshold:  DefaultClientDeactivateThreshold,
 			SnapshotThreshold:          DefaultSnapshotThreshold,
 			SnapshotInterval:           DefaultSnapshotInterval,
 			SnapshotWithPurgingChanges: DefaultSnapshotWithPurgingChanges,
+			SnapshotBuildBatchSize:     DefaultSnapshotBuildBatchSize,
+			SnapshotMode:               DefaultSnapshotMode,
+			SnapshotMaxDeltas:          DefaultSnapshotMaxDeltas,
+			SnapshotCodec:              DefaultSnapshotCodec,
+			MaxOperationsPerChange:     DefaultMaxOperationsPerChange,
+			MaxChangeDepth:             DefaultMaxChangeDepth,
+			MaxStringLength:            DefaultMaxStringLength,
+		},
+		Logging: &logging.Config{
+			Encoding:       DefaultLogEncoding,
+			FileMaxSize:    DefaultLogFileMaxSize,
+			FileMaxBackups: DefaultLogFileMaxBackups,
 		},
 	}
 }
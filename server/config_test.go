@@ -17,6 +17,8 @@
 package server_test
 
 import (
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -38,6 +40,7 @@ func TestNewConfigFromFile(t *testing.T) {
 
 		assert.Equal(t, conf.Backend.SnapshotThreshold, int64(server.DefaultSnapshotThreshold))
 		assert.Equal(t, conf.Backend.SnapshotInterval, int64(server.DefaultSnapshotInterval))
+		assert.Equal(t, conf.Backend.SnapshotBuildBatchSize, int64(server.DefaultSnapshotBuildBatchSize))
 	})
 
 	t.Run("read config file test", func(t *testing.T) {
@@ -48,6 +51,10 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.Equal(t, conf.RPC.Port, server.DefaultRPCPort)
 		assert.Equal(t, conf.RPC.CertFile, "")
 		assert.Equal(t, conf.RPC.KeyFile, "")
+		assert.Equal(t, conf.RPC.CompressionMinSize, server.DefaultRPCCompressionMinSize)
+		assert.Equal(t, conf.RPC.MaxStreamedPackBytes, uint64(server.DefaultRPCMaxStreamedPackBytes))
+		assert.Nil(t, conf.Admin)
+		assert.Equal(t, conf.AdminAddr(), conf.RPCAddr())
 
 		connTimeout, err := time.ParseDuration(conf.Mongo.ConnectionTimeout)
 		assert.NoError(t, err)
@@ -60,7 +67,20 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.Equal(t, pingTimeout, server.DefaultMongoPingTimeout)
 		assert.Equal(t, conf.Backend.SnapshotThreshold, int64(server.DefaultSnapshotThreshold))
 		assert.Equal(t, conf.Backend.SnapshotInterval, int64(server.DefaultSnapshotInterval))
+		assert.Equal(t, conf.Backend.SnapshotBuildBatchSize, int64(server.DefaultSnapshotBuildBatchSize))
 		assert.Equal(t, conf.Backend.AuthWebhookMaxRetries, uint64(server.DefaultAuthWebhookMaxRetries))
+		assert.Equal(t, conf.Backend.MaxOperationsPerChange, server.DefaultMaxOperationsPerChange)
+		assert.Equal(t, conf.Backend.MaxChangeDepth, server.DefaultMaxChangeDepth)
+		assert.Equal(t, conf.Backend.MaxStringLength, server.DefaultMaxStringLength)
+
+		verificationInterval, err := time.ParseDuration(conf.Verification.Interval)
+		assert.NoError(t, err)
+		assert.Equal(t, verificationInterval, server.DefaultVerificationInterval)
+		assert.Equal(t, conf.Verification.SampleSize, server.DefaultVerificationSampleSize)
+
+		assert.Equal(t, conf.Logging.Encoding, server.DefaultLogEncoding)
+		assert.Equal(t, conf.Logging.FileMaxSize, server.DefaultLogFileMaxSize)
+		assert.Equal(t, conf.Logging.FileMaxBackups, server.DefaultLogFileMaxBackups)
 
 		ClientDeactivateThreshold := conf.Backend.ClientDeactivateThreshold
 		assert.NoError(t, err)
@@ -78,8 +98,48 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, authWebhookCacheUnauthTTL, server.DefaultAuthWebhookCacheUnauthTTL)
 
+		authJWKSCacheTTL, err := time.ParseDuration(conf.Backend.AuthJWKSCacheTTL)
+		assert.NoError(t, err)
+		assert.Equal(t, authJWKSCacheTTL, server.DefaultAuthJWKSCacheTTL)
+
+		eventWebhookMaxWaitInterval, err := time.ParseDuration(conf.Backend.EventWebhookMaxWaitInterval)
+		assert.NoError(t, err)
+		assert.Equal(t, eventWebhookMaxWaitInterval, server.DefaultEventWebhookMaxWaitInterval)
+
 		projectInfoCacheTTL, err := time.ParseDuration(conf.Backend.ProjectInfoCacheTTL)
 		assert.NoError(t, err)
 		assert.Equal(t, projectInfoCacheTTL, server.DefaultProjectInfoCacheTTL)
+
+		assert.Equal(t, conf.Backend.SnapshotCacheSize, server.DefaultSnapshotCacheSize)
+		snapshotCacheTTL, err := time.ParseDuration(conf.Backend.SnapshotCacheTTL)
+		assert.NoError(t, err)
+		assert.Equal(t, snapshotCacheTTL, server.DefaultSnapshotCacheTTL)
+
+		assert.Equal(t, conf.Backend.SnapshotMode, server.DefaultSnapshotMode)
+		assert.Equal(t, conf.Backend.SnapshotMaxDeltas, server.DefaultSnapshotMaxDeltas)
+		assert.Equal(t, conf.Backend.SnapshotCodec, server.DefaultSnapshotCodec)
+
+		assert.Equal(t, conf.Backend.PushPullCacheSize, server.DefaultPushPullCacheSize)
+		pushPullCacheTTL, err := time.ParseDuration(conf.Backend.PushPullCacheTTL)
+		assert.NoError(t, err)
+		assert.Equal(t, pushPullCacheTTL, server.DefaultPushPullCacheTTL)
+
+		watchHeartbeatTimeout, err := time.ParseDuration(conf.Backend.WatchHeartbeatTimeout)
+		assert.NoError(t, err)
+		assert.Equal(t, watchHeartbeatTimeout, server.DefaultWatchHeartbeatTimeout)
+	})
+
+	t.Run("read config file with admin server test", func(t *testing.T) {
+		sample, err := os.ReadFile("config.sample.yml")
+		assert.NoError(t, err)
+		filePath := filepath.Join(t.TempDir(), "config.yml")
+		sample = append(sample, []byte("\nAdmin:\n  SecretKey: admin-secret\n")...)
+		assert.NoError(t, os.WriteFile(filePath, sample, 0600))
+
+		conf, err := server.NewConfigFromFile(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, conf.Admin.Port, server.DefaultAdminPort)
+		assert.Equal(t, conf.Admin.SecretKey, "admin-secret")
+		assert.Equal(t, conf.AdminAddr(), "localhost:"+strconv.Itoa(server.DefaultAdminPort))
 	})
 }
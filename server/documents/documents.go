@@ -19,6 +19,8 @@ package documents
 
 import (
 	"context"
+	gojson "encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/yorkie-team/yorkie/api/types"
@@ -27,7 +29,9 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/eventbus"
 	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/webhook"
 )
 
 // SnapshotMaxLen is the maximum length of the document snapshot in the
@@ -41,13 +45,19 @@ var (
 	// ErrDocumentAttached is returned when the document is attached when
 	// deleting the document.
 	ErrDocumentAttached = fmt.Errorf("document is attached")
+
+	// ErrInvalidSnapshotConfig is returned when the snapshot threshold or
+	// interval of a document is negative.
+	ErrInvalidSnapshotConfig = fmt.Errorf("invalid snapshot config")
 )
 
-// ListDocumentSummaries returns a list of document summaries.
+// ListDocumentSummaries returns a list of document summaries that match the
+// given filter.
 func ListDocumentSummaries(
 	ctx context.Context,
 	be *backend.Backend,
 	project *types.Project,
+	filter types.DocumentFilter,
 	paging types.Paging[types.ID],
 	includeSnapshot bool,
 ) ([]*types.DocumentSummary, error) {
@@ -55,7 +65,7 @@ func ListDocumentSummaries(
 		paging.PageSize = pageSizeLimit
 	}
 
-	docInfo, err := be.DB.FindDocInfosByPaging(ctx, project.ID, paging)
+	docInfo, err := be.DB.FindDocInfosByPaging(ctx, project.ID, filter, paging)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +78,7 @@ func ListDocumentSummaries(
 			CreatedAt:  docInfo.CreatedAt,
 			AccessedAt: docInfo.AccessedAt,
 			UpdatedAt:  docInfo.UpdatedAt,
+			Labels:     docInfo.Labels,
 		}
 
 		if includeSnapshot {
@@ -121,6 +132,7 @@ func GetDocumentSummary(
 		AccessedAt: docInfo.AccessedAt,
 		UpdatedAt:  docInfo.UpdatedAt,
 		Snapshot:   doc.Marshal(),
+		Labels:     docInfo.Labels,
 	}, nil
 }
 
@@ -151,6 +163,59 @@ func GetDocumentByServerSeq(
 	return doc, nil
 }
 
+// EvaluateQuery returns the JSON encoding of the value at the given path of
+// the given document at its latest server sequence. The value is "null" if
+// the path does not exist in the document. The document is built once for all
+// the watchers evaluating their queries at the same server sequence.
+func EvaluateQuery(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	path string,
+) (string, error) {
+	doc, err := packs.BuildDocumentForPull(ctx, be, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := doc.View().Lookup(path)
+	if errors.Is(err, document.ErrPathNotFound) {
+		return "null", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	encoded, err := gojson.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("marshal query result: %w", err)
+	}
+
+	return string(encoded), nil
+}
+
+// VerifyDocument rebuilds the document of the given key from its change log
+// and compares it against the latest stored snapshot.
+func VerifyDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+) (*types.DocumentVerification, error) {
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
+		ctx,
+		project.ID,
+		types.IDFromActorID(time.InitialActorID),
+		k,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return packs.VerifyDocument(ctx, be, docInfo)
+}
+
 // SearchDocumentSummaries returns document summaries that match the query parameters.
 func SearchDocumentSummaries(
 	ctx context.Context,
@@ -172,6 +237,7 @@ func SearchDocumentSummaries(
 			CreatedAt:  docInfo.CreatedAt,
 			AccessedAt: docInfo.AccessedAt,
 			UpdatedAt:  docInfo.UpdatedAt,
+			Labels:     docInfo.Labels,
 		})
 	}
 
@@ -195,6 +261,30 @@ func FindDocInfoByKey(
 	)
 }
 
+// FindDocInfosByLabels returns all the documents that match the given label
+// selector.
+func FindDocInfosByLabels(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	selector types.LabelSelector,
+) ([]*database.DocInfo, error) {
+	var infos []*database.DocInfo
+	paging := types.Paging[types.ID]{PageSize: pageSizeLimit, IsForward: true}
+	for {
+		page, err := be.DB.FindDocInfosByPaging(ctx, project.ID, types.DocumentFilter{Labels: selector}, paging)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, page...)
+
+		if len(page) < paging.PageSize {
+			return infos, nil
+		}
+		paging.Offset = page[len(page)-1].ID
+	}
+}
+
 // FindDocInfo returns a document for the given document ID.
 func FindDocInfo(
 	ctx context.Context,
@@ -215,13 +305,45 @@ func FindDocInfoByKeyAndOwner(
 	docKey key.Key,
 	createDocIfNotExist bool,
 ) (*database.DocInfo, error) {
-	return be.DB.FindDocInfoByKeyAndOwner(
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
+		ctx,
+		project.ID,
+		clientInfo.ID,
+		docKey,
+		false,
+	)
+	if !createDocIfNotExist {
+		return docInfo, err
+	}
+	if err == nil && !docInfo.IsRemoved() {
+		return docInfo, nil
+	}
+	if err != nil && !errors.Is(err, database.ErrDocumentNotFound) {
+		return nil, err
+	}
+
+	// NOTE: The callers hold the lock of the document while
+	// creating it, so the document is created by only one of them.
+	docInfo, err = be.DB.FindDocInfoByKeyAndOwner(
 		ctx,
 		project.ID,
 		clientInfo.ID,
 		docKey,
-		createDocIfNotExist,
+		true,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	be.EventBus.Publish(eventbus.Event{
+		Type:        eventbus.DocumentCreated,
+		ProjectID:   project.ID,
+		ClientID:    clientInfo.ID,
+		DocumentID:  docInfo.ID,
+		DocumentKey: docInfo.Key,
+	})
+	webhook.SendDocumentEvent(be, project, types.DocumentCreatedEvent, clientInfo, docInfo)
+	return docInfo, nil
 }
 
 // RemoveDocument removes the given document. If force is false, it only removes
@@ -233,19 +355,124 @@ func RemoveDocument(
 	docID types.ID,
 	force bool,
 ) error {
-	if force {
-		return be.DB.UpdateDocInfoStatusToRemoved(ctx, project.ID, docID)
+	if !force {
+		isAttached, err := be.DB.IsDocumentAttached(ctx, project.ID, docID, "")
+		if err != nil {
+			return err
+		}
+		if isAttached {
+			return ErrDocumentAttached
+		}
+	}
+
+	if err := be.DB.UpdateDocInfoStatusToRemoved(ctx, project.ID, docID); err != nil {
+		return err
+	}
+
+	be.EventBus.Publish(eventbus.Event{
+		Type:       eventbus.DocumentRemoved,
+		ProjectID:  project.ID,
+		DocumentID: docID,
+	})
+	return nil
+}
+
+// UpdateDocumentACL updates the access control list of the given document.
+// An empty ACL without public read access removes the ACL of the document.
+func UpdateDocumentACL(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docKey key.Key,
+	acl *types.DocumentACL,
+) (*types.DocumentACL, error) {
+	docInfo, err := be.DB.FindDocInfoByKey(ctx, project.ID, docKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if acl.IsEmpty() && !acl.AllowsPublicRead() {
+		acl = nil
+	}
+	if err := be.DB.UpdateDocInfoACL(ctx, project.ID, docInfo.ID, acl); err != nil {
+		return nil, err
+	}
+
+	return acl, nil
+}
+
+// UpdateDocumentLabels replaces the labels of the given document. Empty
+// labels remove all the labels of the document.
+func UpdateDocumentLabels(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docKey key.Key,
+	labels map[string]string,
+) (map[string]string, error) {
+	if err := types.ValidateLabels(labels); err != nil {
+		return nil, err
+	}
+
+	docInfo, err := be.DB.FindDocInfoByKey(ctx, project.ID, docKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := be.DB.UpdateDocInfoLabels(ctx, project.ID, docInfo.ID, labels); err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// UpdateDocumentSnapshotConfig updates the snapshot threshold and interval
+// that override the ones of the project for the given document. Zero values
+// remove the overrides.
+func UpdateDocumentSnapshotConfig(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docKey key.Key,
+	threshold int64,
+	interval int64,
+) error {
+	if threshold < 0 || interval < 0 {
+		return fmt.Errorf("threshold %d, interval %d: %w", threshold, interval, ErrInvalidSnapshotConfig)
 	}
 
-	isAttached, err := be.DB.IsDocumentAttached(ctx, project.ID, docID, "")
+	docInfo, err := be.DB.FindDocInfoByKey(ctx, project.ID, docKey)
 	if err != nil {
 		return err
 	}
-	if isAttached {
-		return ErrDocumentAttached
+
+	return be.DB.UpdateDocInfoSnapshotConfig(ctx, project.ID, docInfo.ID, threshold, interval)
+}
+
+// InitializeDocumentLabels sets the given labels to the given document if it
+// is newly created, i.e. it has neither changes nor labels yet. It is used to
+// label documents at creation when they are attached for the first time.
+func InitializeDocumentLabels(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	labels map[string]string,
+) error {
+	if len(labels) == 0 || docInfo.ServerSeq > 0 || len(docInfo.Labels) > 0 {
+		return nil
+	}
+
+	if err := types.ValidateLabels(labels); err != nil {
+		return err
+	}
+
+	if err := be.DB.UpdateDocInfoLabels(ctx, project.ID, docInfo.ID, labels); err != nil {
+		return err
 	}
+	docInfo.Labels = labels
 
-	return be.DB.UpdateDocInfoStatusToRemoved(ctx, project.ID, docID)
+	return nil
 }
 
 // IsDocumentAttached returns true if the given document is attached to any client.
@@ -258,3 +485,31 @@ func IsDocumentAttached(
 ) (bool, error) {
 	return be.DB.IsDocumentAttached(ctx, project.ID, docID, excludeClientID)
 }
+
+// EvictDocument detaches the given document from all the clients attaching it
+// and returns the clients. It should be called under the pushpull lock.
+func EvictDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) ([]*database.ClientInfo, error) {
+	clientInfos, err := be.DB.FindAttachedClientInfosByDocID(ctx, project.ID, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, clientInfo := range clientInfos {
+		if err := clientInfo.DetachDocument(docInfo.ID); err != nil {
+			return nil, err
+		}
+		if err := be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
+			return nil, err
+		}
+		if err := be.DB.UpdateSyncedSeq(ctx, clientInfo, docInfo.ID, docInfo.ServerSeq); err != nil {
+			return nil, err
+		}
+	}
+
+	return clientInfos, nil
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package documents
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/packs"
+)
+
+// memoryLimit is the limit of the number of documents in the memory report.
+const memoryLimit = 100
+
+// WatchDocumentKey creates a new sync.Key of WatchDocument for the given
+// client and document.
+func WatchDocumentKey(clientID *time.ActorID, docID types.ID) sync.Key {
+	return sync.NewKey(fmt.Sprintf("watchdoc-%s-%s", clientID.String(), docID))
+}
+
+// TopDocumentMemories returns the documents of the given project that the
+// server holds the most memory for, in descending order of the bytes.
+func TopDocumentMemories(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	limit int,
+) ([]*types.DocumentMemory, error) {
+	if limit <= 0 || limit > memoryLimit {
+		limit = memoryLimit
+	}
+
+	memories := be.DocumentMemories(project.ID)
+	for _, memory := range memories {
+		// NOTE: Each subscription holds the lock of WatchDocumentKey while the
+		// client watches the document, so the locks are counted from the
+		// subscriptions, and the other locks are looked up by their keys.
+		memory.Locks = memory.Subscriptions + be.Coordinator.LockCount(
+			packs.PushPullKey(project.ID, memory.Key),
+			packs.SnapshotKey(project.ID, memory.Key),
+		)
+		memory.LockBytes = int64(memory.Locks) * sync.LockBytes
+	}
+
+	return types.TopDocumentMemories(memories, limit), nil
+}
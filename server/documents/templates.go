@@ -0,0 +1,279 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package documents
+
+import (
+	"bytes"
+	"context"
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+var (
+	// ErrInvalidTemplateRoot is returned when the root of the template is not
+	// a JSON object.
+	ErrInvalidTemplateRoot = errors.New("invalid template root, root must be a JSON object")
+)
+
+// RegisterDocumentTemplate registers the template of the given collection. If
+// the collection already has a template, it is replaced with the given one.
+func RegisterDocumentTemplate(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	collection string,
+	root string,
+) (*types.DocumentTemplate, error) {
+	if err := types.ValidateTemplateCollection(collection); err != nil {
+		return nil, err
+	}
+
+	// NOTE: Build a document from the root in advance to reject
+	// the template that cannot be applied to documents.
+	if _, err := newDocumentFromTemplate(&database.TemplateInfo{
+		Collection: collection,
+		Root:       root,
+	}); err != nil {
+		return nil, err
+	}
+
+	info, err := be.DB.UpsertTemplateInfo(ctx, project.ID, collection, root)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.ToDocumentTemplate(), nil
+}
+
+// ListDocumentTemplates returns the templates of the given project.
+func ListDocumentTemplates(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+) ([]*types.DocumentTemplate, error) {
+	infos, err := be.DB.ListTemplateInfos(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []*types.DocumentTemplate
+	for _, info := range infos {
+		templates = append(templates, info.ToDocumentTemplate())
+	}
+
+	return templates, nil
+}
+
+// RemoveDocumentTemplate removes the template of the given collection.
+func RemoveDocumentTemplate(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	collection string,
+) error {
+	return be.DB.DeleteTemplateInfo(ctx, project.ID, collection)
+}
+
+// InitializeDocumentFromTemplate initializes the given document from the
+// template of the collection that the document belongs to. It only applies to
+// the document that has no changes yet, so it should be called under the
+// pushpull lock of the document.
+func InitializeDocumentFromTemplate(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) error {
+	if docInfo.ServerSeq > 0 {
+		return nil
+	}
+
+	infos, err := be.DB.ListTemplateInfos(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: If several collections match the key of the document,
+	// the template of the longest collection is applied.
+	var template *database.TemplateInfo
+	for _, info := range infos {
+		if !info.Matches(docInfo.Key) {
+			continue
+		}
+		if template == nil || len(template.Collection) < len(info.Collection) {
+			template = info
+		}
+	}
+	if template == nil {
+		return nil
+	}
+
+	doc, err := newDocumentFromTemplate(template)
+	if err != nil {
+		return err
+	}
+
+	pack := doc.CreateChangePack()
+	if !pack.HasChanges() {
+		return nil
+	}
+
+	initialServerSeq := docInfo.ServerSeq
+	for _, cn := range pack.Changes {
+		cn.SetServerSeq(docInfo.IncreaseServerSeq())
+	}
+	if err := be.DB.CreateChangeInfos(
+		ctx,
+		project.ID,
+		docInfo,
+		initialServerSeq,
+		pack.Changes,
+		false,
+	); err != nil {
+		return err
+	}
+
+	logging.From(ctx).Infof(
+		"TMPL: '%s' initialized from template '%s'",
+		docInfo.Key,
+		template.Collection,
+	)
+
+	return nil
+}
+
+// newDocumentFromTemplate creates a new document whose root is built from the
+// given template.
+func newDocumentFromTemplate(template *database.TemplateInfo) (*document.Document, error) {
+	decoder := gojson.NewDecoder(bytes.NewBufferString(template.Root))
+	decoder.UseNumber()
+
+	var root map[string]any
+	if err := decoder.Decode(&root); err != nil || root == nil {
+		return nil, ErrInvalidTemplateRoot
+	}
+	if decoder.More() {
+		return nil, ErrInvalidTemplateRoot
+	}
+
+	doc := document.New(key.Key(template.Collection))
+	if err := doc.Update(func(r *json.Object, p *presence.Presence) error {
+		return setObject(r, root)
+	}, fmt.Sprintf("initialize from template '%s'", template.Collection)); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// setObject sets the given values to the given object in the order of keys.
+func setObject(obj *json.Object, values map[string]any) error {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		switch v := values[k].(type) {
+		case nil:
+			obj.SetNull(k)
+		case bool:
+			obj.SetBool(k, v)
+		case string:
+			obj.SetString(k, v)
+		case gojson.Number:
+			if i, err := v.Int64(); err == nil {
+				if i >= math.MinInt32 && i <= math.MaxInt32 {
+					obj.SetInteger(k, int(i))
+				} else {
+					obj.SetLong(k, i)
+				}
+				continue
+			}
+			f, err := v.Float64()
+			if err != nil {
+				return ErrInvalidTemplateRoot
+			}
+			obj.SetDouble(k, f)
+		case map[string]any:
+			if err := setObject(obj.SetNewObject(k), v); err != nil {
+				return err
+			}
+		case []any:
+			if err := addElements(obj.SetNewArray(k), v); err != nil {
+				return err
+			}
+		default:
+			return ErrInvalidTemplateRoot
+		}
+	}
+
+	return nil
+}
+
+// addElements adds the given values to the end of the given array.
+func addElements(arr *json.Array, values []any) error {
+	for _, value := range values {
+		switch v := value.(type) {
+		case nil:
+			arr.AddNull()
+		case bool:
+			arr.AddBool(v)
+		case string:
+			arr.AddString(v)
+		case gojson.Number:
+			if i, err := v.Int64(); err == nil {
+				if i >= math.MinInt32 && i <= math.MaxInt32 {
+					arr.AddInteger(int(i))
+				} else {
+					arr.AddLong(i)
+				}
+				continue
+			}
+			f, err := v.Float64()
+			if err != nil {
+				return ErrInvalidTemplateRoot
+			}
+			arr.AddDouble(f)
+		case map[string]any:
+			if err := setObject(arr.AddNewObject(), v); err != nil {
+				return err
+			}
+		case []any:
+			if err := addElements(arr.AddNewArray(), v); err != nil {
+				return err
+			}
+		default:
+			return ErrInvalidTemplateRoot
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package listener provides the listeners of the servers. A server can listen
+// on several addresses at once, such as an IPv4 and an IPv6 address, and on
+// Unix domain sockets given as "unix:<path>".
+package listener
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// unixPrefix is the prefix of the addresses of Unix domain sockets.
+const unixPrefix = "unix:"
+
+// ErrInvalidAddress is returned when the given address is invalid.
+var ErrInvalidAddress = errors.New("invalid listen address")
+
+// Validate validates the given address. It is either "host:port" or
+// "unix:<path>".
+func Validate(address string) error {
+	if path, ok := unixPath(address); ok {
+		if path == "" {
+			return fmt.Errorf("%s: empty socket path: %w", address, ErrInvalidAddress)
+		}
+		return nil
+	}
+
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%s: %s: %w", address, err.Error(), ErrInvalidAddress)
+	}
+	if p, err := strconv.Atoi(port); err != nil || p < 0 || 65535 < p {
+		return fmt.Errorf("%s: invalid port: %w", address, ErrInvalidAddress)
+	}
+
+	return nil
+}
+
+// Listen listens on the given address. The socket file left by a previous
+// process is removed before listening on a Unix domain socket.
+func Listen(address string) (net.Listener, error) {
+	path, ok := unixPath(address)
+	if !ok {
+		lis, err := net.Listen("tcp", address)
+		if err != nil {
+			return nil, fmt.Errorf("listen %s: %w", address, err)
+		}
+		return lis, nil
+	}
+
+	if info, err := os.Stat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("remove stale socket %s: %w", path, err)
+		}
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("listen %s: %w", address, err)
+	}
+	return lis, nil
+}
+
+// ListenAll listens on all the given addresses. If one of them fails, the
+// listeners opened so far are closed.
+func ListenAll(addresses []string) ([]net.Listener, error) {
+	var listeners []net.Listener
+	for _, address := range addresses {
+		lis, err := Listen(address)
+		if err != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+			return nil, err
+		}
+		listeners = append(listeners, lis)
+	}
+
+	return listeners, nil
+}
+
+// Addresses returns the given addresses, or the address of all interfaces
+// with the given port if none is given.
+func Addresses(addresses []string, port int) []string {
+	if len(addresses) > 0 {
+		return addresses
+	}
+
+	return []string{fmt.Sprintf(":%d", port)}
+}
+
+// unixPath returns the path of the socket if the given address is of a Unix
+// domain socket. Both "unix:<path>" and "unix://<path>" are accepted as gRPC
+// does.
+func unixPath(address string) (string, bool) {
+	if !strings.HasPrefix(address, unixPrefix) {
+		return "", false
+	}
+
+	path := strings.TrimPrefix(address, unixPrefix)
+	return strings.TrimPrefix(path, "//"), true
+}
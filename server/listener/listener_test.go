@@ -0,0 +1,91 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package listener_test
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/listener"
+)
+
+func TestValidate(t *testing.T) {
+	scenarios := []*struct {
+		address  string
+		expected error
+	}{
+		{address: ":11101", expected: nil},
+		{address: "0.0.0.0:11101", expected: nil},
+		{address: "[::1]:11101", expected: nil},
+		{address: "unix:/tmp/yorkie.sock", expected: nil},
+		{address: "unix:///tmp/yorkie.sock", expected: nil},
+		{address: "unix:", expected: listener.ErrInvalidAddress},
+		{address: "127.0.0.1", expected: listener.ErrInvalidAddress},
+		{address: "127.0.0.1:port", expected: listener.ErrInvalidAddress},
+		{address: "127.0.0.1:65536", expected: listener.ErrInvalidAddress},
+	}
+	for _, scenario := range scenarios {
+		assert.ErrorIs(t, listener.Validate(scenario.address), scenario.expected, "provided address: %s", scenario.address)
+	}
+}
+
+func TestListen(t *testing.T) {
+	t.Run("listen on multiple addresses test", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "yorkie.sock")
+		listeners, err := listener.ListenAll([]string{"127.0.0.1:0", "unix:" + path})
+		assert.NoError(t, err)
+		assert.Len(t, listeners, 2)
+		assert.Equal(t, "tcp", listeners[0].Addr().Network())
+		assert.Equal(t, "unix", listeners[1].Addr().Network())
+
+		conn, err := net.Dial("unix", path)
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Close())
+
+		for _, lis := range listeners {
+			assert.NoError(t, lis.Close())
+		}
+	})
+
+	t.Run("remove stale socket test", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "yorkie.sock")
+		lis, err := listener.Listen("unix:" + path)
+		assert.NoError(t, err)
+
+		// NOTE: keep the socket file as a crashed process would.
+		lis.(*net.UnixListener).SetUnlinkOnClose(false)
+		assert.NoError(t, lis.Close())
+
+		lis, err = listener.Listen("unix:" + path)
+		assert.NoError(t, err)
+		assert.NoError(t, lis.Close())
+	})
+
+	t.Run("close opened listeners on failure test", func(t *testing.T) {
+		listeners, err := listener.ListenAll([]string{"127.0.0.1:0", "256.0.0.1:0"})
+		assert.Error(t, err)
+		assert.Nil(t, listeners)
+	})
+
+	t.Run("default addresses test", func(t *testing.T) {
+		assert.Equal(t, []string{":11101"}, listener.Addresses(nil, 11101))
+		assert.Equal(t, []string{"unix:/a"}, listener.Addresses([]string{"unix:/a"}, 11101))
+	})
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Below are the encodings of the logs.
+const (
+	ConsoleEncoding = "console"
+	JSONEncoding    = "json"
+)
+
+// ErrInvalidLogEncoding is returned when the given log encoding is invalid.
+var ErrInvalidLogEncoding = errors.New("invalid log encoding")
+
+// Config is the configuration of the output of the loggers.
+type Config struct {
+	// Encoding is the encoding of the logs, "console" or "json".
+	Encoding string `yaml:"Encoding"`
+
+	// SamplingInitial is the number of debug and info messages with the same
+	// prefix, e.g. "PUSH", logged in each second before sampling starts. If it
+	// is 0, the messages are not sampled.
+	SamplingInitial int `yaml:"SamplingInitial"`
+
+	// SamplingThereafter is the interval of the messages logged after
+	// SamplingInitial messages in each second. If it is 0, the messages after
+	// SamplingInitial are dropped.
+	SamplingThereafter int `yaml:"SamplingThereafter"`
+
+	// File is the path of the file to write the logs to. If it is empty, the
+	// logs are written to the standard output.
+	File string `yaml:"File"`
+
+	// FileMaxSize is the maximum size of the log file in megabytes before it
+	// is rotated. If it is 0, the file is not rotated.
+	FileMaxSize int `yaml:"FileMaxSize"`
+
+	// FileMaxBackups is the maximum number of rotated log files to keep.
+	FileMaxBackups int `yaml:"FileMaxBackups"`
+}
+
+// Validate validates the configuration.
+func (c *Config) Validate() error {
+	if c.Encoding != ConsoleEncoding && c.Encoding != JSONEncoding {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--log-encoding" flag: %w`,
+			c.Encoding,
+			ErrInvalidLogEncoding,
+		)
+	}
+
+	if c.SamplingInitial < 0 {
+		return fmt.Errorf(
+			`invalid argument %d for "--log-sampling-initial" flag`,
+			c.SamplingInitial,
+		)
+	}
+
+	if c.SamplingThereafter < 0 {
+		return fmt.Errorf(
+			`invalid argument %d for "--log-sampling-thereafter" flag`,
+			c.SamplingThereafter,
+		)
+	}
+
+	if c.FileMaxSize < 0 {
+		return fmt.Errorf(
+			`invalid argument %d for "--log-file-max-size" flag`,
+			c.FileMaxSize,
+		)
+	}
+
+	if c.FileMaxBackups < 0 {
+		return fmt.Errorf(
+			`invalid argument %d for "--log-file-max-backups" flag`,
+			c.FileMaxBackups,
+		)
+	}
+
+	return nil
+}
@@ -20,9 +20,15 @@ import (
 	"context"
 )
 
+// RequestIDField is the name of the field of the request ID in the logs.
+const RequestIDField = "request_id"
+
 // loggerKey is the type used for the logger key in context.
 type loggerKey struct{}
 
+// requestIDKey is the type used for the request ID key in context.
+type requestIDKey struct{}
+
 // With returns a new context with the provided logger.
 func With(ctx context.Context, logger Logger) context.Context {
 	return context.WithValue(ctx, loggerKey{}, logger)
@@ -41,3 +47,28 @@ func From(ctx context.Context) Logger {
 
 	return logger
 }
+
+// FromModule returns the logger stored in the provided context whose level
+// is the level of the given module, e.g. "packs" or "rpc".
+func FromModule(ctx context.Context, module string) Logger {
+	return WithModule(From(ctx), module)
+}
+
+// WithRequestID returns a new context with the given request ID. The logger
+// stored in the context is replaced with the one that writes the ID in every
+// entry, so that the logs of the request can be correlated with each other.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+	return With(ctx, From(ctx).With(RequestIDField, requestID))
+}
+
+// RequestID returns the request ID stored in the provided context. It returns
+// an empty string if the context has no request ID.
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
@@ -18,6 +18,7 @@
 package logging
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -30,32 +31,146 @@ import (
 // Logger is a wrapper of zap.Logger.
 type Logger = *zap.SugaredLogger
 
+// ErrInvalidLogLevel is returned when the given log level is invalid.
+var ErrInvalidLogLevel = errors.New("invalid log level")
+
 var defaultLogger Logger
-var logLevel = zapcore.InfoLevel
+var logLevel = zap.NewAtomicLevelAt(zapcore.InfoLevel)
 var loggerOnce sync.Once
 
+var moduleLevelsMu sync.RWMutex
+var moduleLevels = make(map[string]zapcore.Level)
+
+var outputMu sync.RWMutex
+var output = zapcore.NewCore(
+	zapcore.NewConsoleEncoder(humanEncoderConfig()),
+	zapcore.AddSync(os.Stdout),
+	zapcore.DebugLevel,
+)
+
+// Configure configures the encoding, the sampling and the file of the logs
+// with the given config. Configure must be called before calling
+// DefaultLogger() or New().
+func Configure(conf *Config) error {
+	if err := conf.Validate(); err != nil {
+		return err
+	}
+
+	var ws zapcore.WriteSyncer = zapcore.AddSync(os.Stdout)
+	encoderCfg := humanEncoderConfig()
+	if conf.File != "" {
+		file, err := openRotatingFile(conf.File, int64(conf.FileMaxSize)*1024*1024, conf.FileMaxBackups)
+		if err != nil {
+			return err
+		}
+		ws = file
+
+		// NOTE: Colors are only readable in terminals.
+		encoderCfg.EncodeLevel = zapcore.CapitalLevelEncoder
+	}
+
+	encoder := zapcore.NewConsoleEncoder(encoderCfg)
+	if conf.Encoding == JSONEncoding {
+		encoder = zapcore.NewJSONEncoder(encoderConfig())
+	}
+
+	core := zapcore.NewCore(encoder, ws, zapcore.DebugLevel)
+	if conf.SamplingInitial > 0 {
+		core = &samplingCore{
+			Core:    core,
+			sampler: newSampler(conf.SamplingInitial, conf.SamplingThereafter),
+		}
+	}
+
+	outputMu.Lock()
+	defer outputMu.Unlock()
+	output = core
+
+	return nil
+}
+
 // SetLogLevel sets the level of global logger with ["debug", "info", "warn", "error", "panic", "fatal"].
-// SetLoglevel must be sets before calling DefaultLogger() or New().
+// The level can be changed at runtime, and it applies to the modules that
+// do not have their own levels.
 func SetLogLevel(level string) error {
-	switch strings.ToLower(level) {
-	case "debug":
-		logLevel = zapcore.DebugLevel
-	case "info":
-		logLevel = zapcore.InfoLevel
-	case "warn":
-		logLevel = zapcore.WarnLevel
-	case "error":
-		logLevel = zapcore.ErrorLevel
-	case "panic":
-		logLevel = zapcore.PanicLevel
-	case "fatal":
-		logLevel = zapcore.FatalLevel
-	default:
-		return fmt.Errorf("invalid log level: %s", level)
+	l, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	logLevel.SetLevel(l)
+	return nil
+}
+
+// SetModuleLevels sets the levels of the given modules, e.g. {"packs":
+// "debug"}. A module with an empty level follows the global level again.
+// None of the levels is set if any of them is invalid.
+func SetModuleLevels(levels map[string]string) error {
+	parsed := make(map[string]*zapcore.Level, len(levels))
+	for module, level := range levels {
+		if level == "" {
+			parsed[module] = nil
+			continue
+		}
+
+		l, err := parseLevel(level)
+		if err != nil {
+			return err
+		}
+		parsed[module] = &l
 	}
+
+	moduleLevelsMu.Lock()
+	defer moduleLevelsMu.Unlock()
+	for module, level := range parsed {
+		if level == nil {
+			delete(moduleLevels, module)
+			continue
+		}
+		moduleLevels[module] = *level
+	}
+
 	return nil
 }
 
+// ModuleLevels returns the levels of the modules that have their own levels.
+func ModuleLevels() map[string]string {
+	moduleLevelsMu.RLock()
+	defer moduleLevelsMu.RUnlock()
+
+	levels := make(map[string]string, len(moduleLevels))
+	for module, level := range moduleLevels {
+		levels[module] = level.String()
+	}
+	return levels
+}
+
+// ParseModuleLevels parses the given comma-separated module levels, e.g.
+// "packs=debug,rpc=info".
+func ParseModuleLevels(spec string) (map[string]string, error) {
+	levels := make(map[string]string)
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		module, level, ok := strings.Cut(pair, "=")
+		module, level = strings.TrimSpace(module), strings.TrimSpace(level)
+		if !ok || module == "" {
+			return nil, fmt.Errorf("%s: %w", pair, ErrInvalidLogLevel)
+		}
+		if level != "" {
+			if _, err := parseLevel(level); err != nil {
+				return nil, err
+			}
+		}
+		levels[module] = level
+	}
+
+	return levels, nil
+}
+
 // New creates a new logger with the given configuration.
 func New(name string) Logger {
 	return newLogger(name)
@@ -71,18 +186,97 @@ func DefaultLogger() Logger {
 
 // Enabled returns true if the given level is enabled.
 func Enabled(level zapcore.Level) bool {
-	return level >= logLevel
+	return logLevel.Enabled(level)
+}
+
+// ModuleEnabled returns true if the given level is enabled in the given
+// module.
+func ModuleEnabled(module string, level zapcore.Level) bool {
+	return levelOf(module).Enabled(level)
+}
+
+// WithModule returns a logger derived from the given logger whose level is
+// the level of the given module.
+func WithModule(logger Logger, module string) Logger {
+	return logger.Desugar().WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		if mc, ok := core.(*moduleCore); ok {
+			return &moduleCore{Core: mc.Core, module: module}
+		}
+		return &moduleCore{Core: core, module: module}
+	})).Sugar()
 }
 
 // newLogger returns a new raw logger.
 func newLogger(name string) Logger {
-	return zap.New(zapcore.NewTee(
-		zapcore.NewCore(
-			zapcore.NewConsoleEncoder(humanEncoderConfig()),
-			zapcore.AddSync(os.Stdout),
-			logLevel,
-		),
-	), zap.AddStacktrace(zap.ErrorLevel)).Named(name).Sugar()
+	outputMu.RLock()
+	defer outputMu.RUnlock()
+
+	return zap.New(
+		&moduleCore{Core: output},
+		zap.AddStacktrace(zap.ErrorLevel),
+	).Named(name).Sugar()
+}
+
+// parseLevel parses the given level with ["debug", "info", "warn", "error",
+// "panic", "fatal"].
+func parseLevel(level string) (zapcore.Level, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return zapcore.DebugLevel, nil
+	case "info":
+		return zapcore.InfoLevel, nil
+	case "warn":
+		return zapcore.WarnLevel, nil
+	case "error":
+		return zapcore.ErrorLevel, nil
+	case "panic":
+		return zapcore.PanicLevel, nil
+	case "fatal":
+		return zapcore.FatalLevel, nil
+	default:
+		return zapcore.InfoLevel, fmt.Errorf("%s: %w", level, ErrInvalidLogLevel)
+	}
+}
+
+// levelOf returns the level of the given module. The global level is
+// returned if the module does not have its own level.
+func levelOf(module string) zapcore.LevelEnabler {
+	if module != "" {
+		moduleLevelsMu.RLock()
+		level, ok := moduleLevels[module]
+		moduleLevelsMu.RUnlock()
+		if ok {
+			return level
+		}
+	}
+
+	return logLevel
+}
+
+// moduleCore is a zapcore.Core that filters the entries by the level of its
+// module, so that the level can be changed for each module at runtime.
+type moduleCore struct {
+	zapcore.Core
+	module string
+}
+
+// Enabled returns whether the given level is enabled in the module.
+func (c *moduleCore) Enabled(level zapcore.Level) bool {
+	return levelOf(c.module).Enabled(level)
+}
+
+// With adds the given fields to the core.
+func (c *moduleCore) With(fields []zapcore.Field) zapcore.Core {
+	return &moduleCore{Core: c.Core.With(fields), module: c.module}
+}
+
+// Check passes the entry to the underlying core if the level of the entry is
+// enabled in the module.
+func (c *moduleCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return c.Core.Check(entry, checked)
+	}
+	return checked
 }
 
 func encoderConfig() zapcore.EncoderConfig {
@@ -0,0 +1,191 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+func TestModuleLevels(t *testing.T) {
+	t.Run("parse module levels test", func(t *testing.T) {
+		levels, err := logging.ParseModuleLevels("packs=debug, rpc=info,")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"packs": "debug", "rpc": "info"}, levels)
+
+		_, err = logging.ParseModuleLevels("packs")
+		assert.ErrorIs(t, err, logging.ErrInvalidLogLevel)
+
+		_, err = logging.ParseModuleLevels("packs=verbose")
+		assert.ErrorIs(t, err, logging.ErrInvalidLogLevel)
+	})
+
+	t.Run("set module levels test", func(t *testing.T) {
+		assert.NoError(t, logging.SetLogLevel("info"))
+		assert.False(t, logging.ModuleEnabled("packs", zap.DebugLevel))
+
+		assert.NoError(t, logging.SetModuleLevels(map[string]string{"packs": "debug", "rpc": "error"}))
+		assert.True(t, logging.ModuleEnabled("packs", zap.DebugLevel))
+		assert.False(t, logging.ModuleEnabled("rpc", zap.InfoLevel))
+		assert.True(t, logging.ModuleEnabled("sync", zap.InfoLevel))
+		assert.False(t, logging.Enabled(zap.DebugLevel))
+		assert.Equal(t, map[string]string{"packs": "debug", "rpc": "error"}, logging.ModuleLevels())
+
+		// invalid levels are not applied at all.
+		assert.Error(t, logging.SetModuleLevels(map[string]string{"packs": "info", "rpc": "verbose"}))
+		assert.True(t, logging.ModuleEnabled("packs", zap.DebugLevel))
+
+		// an empty level resets the module to the global level.
+		assert.NoError(t, logging.SetModuleLevels(map[string]string{"packs": "", "rpc": ""}))
+		assert.False(t, logging.ModuleEnabled("packs", zap.DebugLevel))
+		assert.Empty(t, logging.ModuleLevels())
+	})
+
+	t.Run("module logger test", func(t *testing.T) {
+		logger := logging.WithModule(logging.New("test"), "packs")
+		assert.False(t, logger.Desugar().Core().Enabled(zap.DebugLevel))
+
+		assert.NoError(t, logging.SetModuleLevels(map[string]string{"packs": "debug"}))
+		defer func() {
+			assert.NoError(t, logging.SetModuleLevels(map[string]string{"packs": ""}))
+		}()
+		assert.True(t, logger.Desugar().Core().Enabled(zap.DebugLevel))
+		assert.False(t, logging.New("test").Desugar().Core().Enabled(zap.DebugLevel))
+	})
+}
+
+func TestConfigure(t *testing.T) {
+	defer func() {
+		assert.NoError(t, logging.Configure(&logging.Config{Encoding: logging.ConsoleEncoding}))
+	}()
+
+	readLines := func(t *testing.T, path string) []string {
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		return strings.Split(strings.TrimSpace(string(data)), "\n")
+	}
+
+	t.Run("invalid config test", func(t *testing.T) {
+		assert.ErrorIs(t, logging.Configure(&logging.Config{Encoding: "xml"}), logging.ErrInvalidLogEncoding)
+		assert.Error(t, logging.Configure(&logging.Config{
+			Encoding:        logging.ConsoleEncoding,
+			SamplingInitial: -1,
+		}))
+	})
+
+	t.Run("json encoding test", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "yorkie.log")
+		assert.NoError(t, logging.Configure(&logging.Config{
+			Encoding: logging.JSONEncoding,
+			File:     path,
+		}))
+
+		logger := logging.New("json")
+		logger.Infof("PUSH: %d changes", 3)
+		assert.NoError(t, logger.Sync())
+
+		lines := readLines(t, path)
+		assert.Len(t, lines, 1)
+
+		var entry map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+		assert.Equal(t, "PUSH: 3 changes", entry["M"])
+		assert.Equal(t, "json", entry["N"])
+	})
+
+	t.Run("request id test", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "yorkie.log")
+		assert.NoError(t, logging.Configure(&logging.Config{
+			Encoding: logging.JSONEncoding,
+			File:     path,
+		}))
+
+		ctx := logging.With(context.Background(), logging.New("r1"))
+		assert.Empty(t, logging.RequestID(ctx))
+
+		ctx = logging.WithRequestID(ctx, "req-1")
+		assert.Equal(t, "req-1", logging.RequestID(ctx))
+		logging.From(ctx).Info("PULL: 1 change")
+		assert.NoError(t, logging.From(ctx).Sync())
+
+		lines := readLines(t, path)
+		assert.Len(t, lines, 1)
+
+		var entry map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+		assert.Equal(t, "r1", entry["N"])
+		assert.Equal(t, "req-1", entry[logging.RequestIDField])
+	})
+
+	t.Run("sampling test", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "yorkie.log")
+		assert.NoError(t, logging.Configure(&logging.Config{
+			Encoding:           logging.ConsoleEncoding,
+			SamplingInitial:    2,
+			SamplingThereafter: 3,
+			File:               path,
+		}))
+
+		// NOTE: Messages of different loggers with the same prefix
+		// are sampled together.
+		for i := 0; i < 10; i++ {
+			logging.New("sampling").Infof("PUSH: change %d", i)
+		}
+		logging.New("sampling").Warnf("PUSH: warning")
+
+		lines := readLines(t, path)
+		assert.Len(t, lines, 5)
+		assert.Contains(t, lines[0], "PUSH: change 0")
+		assert.Contains(t, lines[1], "PUSH: change 1")
+		assert.Contains(t, lines[2], "PUSH: change 4")
+		assert.Contains(t, lines[3], "PUSH: change 7")
+		assert.Contains(t, lines[4], "PUSH: warning")
+	})
+
+	t.Run("rotation test", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "yorkie.log")
+		assert.NoError(t, logging.Configure(&logging.Config{
+			Encoding:       logging.ConsoleEncoding,
+			File:           path,
+			FileMaxSize:    1,
+			FileMaxBackups: 2,
+		}))
+
+		logger := logging.New("rotation")
+		message := strings.Repeat("x", 1024)
+		for i := 0; i < 4*1024; i++ {
+			logger.Info(message)
+		}
+
+		for _, p := range []string{path, path + ".1", path + ".2"} {
+			info, err := os.Stat(p)
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, info.Size(), int64(1024*1024))
+		}
+		_, err := os.Stat(path + ".3")
+		assert.True(t, os.IsNotExist(err))
+	})
+}
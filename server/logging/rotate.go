@@ -0,0 +1,129 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// rotatingFile is a zapcore.WriteSyncer that writes the logs to a file and
+// rotates the file when its size exceeds maxSize. The rotated files are named
+// "<path>.1", "<path>.2" and so on, where "<path>.1" is the newest one.
+type rotatingFile struct {
+	mu sync.Mutex
+
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// openRotatingFile opens the file of the given path to append the logs.
+func openRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	f := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Write writes the given bytes to the file. The file is rotated before the
+// write if the write would exceed the maximum size.
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	if err != nil {
+		return n, fmt.Errorf("write log file: %w", err)
+	}
+
+	return n, nil
+}
+
+// Sync flushes the file.
+func (f *rotatingFile) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.file.Sync(); err != nil {
+		return fmt.Errorf("sync log file: %w", err)
+	}
+
+	return nil
+}
+
+// open opens the file to append the logs.
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open log file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("stat log file: %w", err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// rotate shifts the rotated files, drops the oldest one and reopens the file.
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("close log file: %w", err)
+	}
+
+	if f.maxBackups > 0 {
+		for i := f.maxBackups - 1; i > 0; i-- {
+			if err := os.Rename(f.backupPath(i), f.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("rotate log file: %w", err)
+			}
+		}
+		if err := os.Rename(f.path, f.backupPath(1)); err != nil {
+			return fmt.Errorf("rotate log file: %w", err)
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return fmt.Errorf("rotate log file: %w", err)
+	}
+
+	return f.open()
+}
+
+// backupPath returns the path of the i-th rotated file.
+func (f *rotatingFile) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", f.path, i)
+}
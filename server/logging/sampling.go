@@ -0,0 +1,105 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// samplingTick is the period in which the messages are counted.
+const samplingTick = time.Second
+
+// maxSamplingPrefixLength is the maximum length of the prefix of messages
+// such as "PUSH" that is used as the key of sampling.
+const maxSamplingPrefixLength = 8
+
+// sampler counts the messages by their keys in each tick. It is shared by all
+// the loggers so that the messages of different requests are sampled together.
+type sampler struct {
+	initial    uint64
+	thereafter uint64
+
+	mu      sync.Mutex
+	resetAt time.Time
+	counts  map[string]uint64
+}
+
+// newSampler creates a new instance of sampler.
+func newSampler(initial, thereafter int) *sampler {
+	return &sampler{
+		initial:    uint64(initial),
+		thereafter: uint64(thereafter),
+		counts:     make(map[string]uint64),
+	}
+}
+
+// allow returns whether the given entry should be logged.
+func (s *sampler) allow(entry zapcore.Entry) bool {
+	key := entry.Level.String() + ":" + samplingKey(entry.Message)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry.Time.After(s.resetAt) {
+		s.counts = make(map[string]uint64)
+		s.resetAt = entry.Time.Add(samplingTick)
+	}
+
+	s.counts[key]++
+	n := s.counts[key]
+	if n <= s.initial {
+		return true
+	}
+	if s.thereafter == 0 {
+		return false
+	}
+	return (n-s.initial)%s.thereafter == 0
+}
+
+// samplingKey returns the key of the given message. Messages with a short
+// prefix like "PUSH: ..." are counted together regardless of their arguments.
+func samplingKey(message string) string {
+	if i := strings.Index(message, ":"); i > 0 && i <= maxSamplingPrefixLength {
+		return strings.TrimSpace(message[:i])
+	}
+	return message
+}
+
+// samplingCore is a zapcore.Core that drops debug and info messages which
+// are logged more than the sampler allows. Warnings and errors are never
+// dropped.
+type samplingCore struct {
+	zapcore.Core
+	sampler *sampler
+}
+
+// With adds the given fields to the core.
+func (c *samplingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &samplingCore{Core: c.Core.With(fields), sampler: c.sampler}
+}
+
+// Check adds the core to the checked entry if the sampler allows the entry.
+func (c *samplingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if entry.Level < zapcore.WarnLevel && !c.sampler.allow(entry) {
+		return checked
+	}
+	return c.Core.Check(entry, checked)
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"github.com/yorkie-team/yorkie/server/rpc/auth"
+)
+
+// Option configures Options.
+type Option func(*Options)
+
+// Options configures the components of the server that are plugged in
+// in-process rather than set in the config file.
+type Options struct {
+	// AuthProvider is the provider that authenticates the users of projects.
+	// If it is nil, the users are authenticated by the authorization webhook
+	// or the JWT keys of their projects.
+	AuthProvider auth.Provider
+}
+
+// WithAuthProvider configures the provider that authenticates the users of
+// projects. The provider replaces the authentication by the settings of the
+// projects, so custom schemes such as API gateways can be used.
+func WithAuthProvider(provider auth.Provider) Option {
+	return func(o *Options) { o.AuthProvider = provider }
+}
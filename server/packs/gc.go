@@ -0,0 +1,78 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// GarbageCollect purges the removed elements of the given document that all
+// the clients attaching it have synchronized, and stores a snapshot of the
+// document without them regardless of the snapshot interval. It returns the
+// number of the purged elements and should be called under the snapshot lock.
+//
+// NOTE: The snapshot is stored at the current server seq of the document, so
+// nothing is purged if the document already has a snapshot there. The garbage
+// is then purged by the snapshot after the next change.
+func GarbageCollect(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) (int, error) {
+	minSyncedTicket, err := findMinSyncedTicket(ctx, be, docInfo)
+	if err != nil {
+		return 0, err
+	}
+
+	return storeSnapshot(ctx, be, project, docInfo, minSyncedTicket, true)
+}
+
+// findMinSyncedTicket returns the min synced ticket of the given document
+// without updating the synced seq of any client.
+func findMinSyncedTicket(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+) (*time.Ticket, error) {
+	info, err := be.DB.FindMinSyncedSeqInfo(ctx, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: Without clients attaching the document, no replica refers to the
+	// removed elements, so all of them can be purged.
+	if info == nil || info.ID == "" {
+		return time.MaxTicket, nil
+	}
+	if info.ServerSeq == change.InitialServerSeq {
+		return time.InitialTicket, nil
+	}
+
+	actorID, err := time.ActorIDFromHex(info.ActorID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return time.NewTicket(info.Lamport, time.MaxDelimiter, actorID), nil
+}
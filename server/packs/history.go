@@ -19,6 +19,7 @@ package packs
 import (
 	"context"
 
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
@@ -28,6 +29,7 @@ import (
 func FindChanges(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	docInfo *database.DocInfo,
 	from int64,
 	to int64,
@@ -40,7 +42,7 @@ func FindChanges(
 
 		snapshotInfo, err := be.DB.FindClosestSnapshotInfo(
 			ctx, docInfo.ID,
-			minSyncedSeqInfo.ServerSeq+be.Config.SnapshotInterval,
+			minSyncedSeqInfo.ServerSeq+snapshotInterval(be, project, docInfo),
 			false,
 		)
 		if err != nil {
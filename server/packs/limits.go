@@ -0,0 +1,360 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/server/webhook"
+)
+
+// checkHardLimits returns an error if storing the given pushed changes makes
+// the document exceed the hard limits of the project. The server seq of the
+// given docInfo should already include the pushed changes.
+func checkHardLimits(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	initialServerSeq int64,
+	changes []*change.Change,
+) error {
+	if docInfo.ServerSeq == initialServerSeq {
+		return nil
+	}
+
+	if limit := project.ChangeLogHardLimit; limit > 0 && docInfo.ServerSeq > limit {
+		be.Metrics.AddDocumentLimit(project, types.ChangeLogLimit, prometheus.LimitHard)
+		return &types.ThrottleError{
+			Subject:     "document:" + docInfo.Key.String(),
+			Description: fmt.Sprintf("%d changes exceed the change log limit %d", docInfo.ServerSeq, limit),
+		}
+	}
+
+	if limit := project.DocumentSizeHardLimit; limit > 0 {
+		usage, err := projectUsage(ctx, be, docInfo, initialServerSeq, changes)
+		if err != nil {
+			return err
+		}
+		if size := usage.size; size > limit {
+			be.Metrics.AddDocumentLimit(project, types.DocumentSizeLimit, prometheus.LimitHard)
+			return &types.ThrottleError{
+				Subject:     "document:" + docInfo.Key.String(),
+				Description: fmt.Sprintf("document of %d bytes exceeds the document size limit %d", size, limit),
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkServerLimits returns an error if the given pushed changes exceed the
+// limits of the server, or storing them would make the document exceed them.
+// Unlike the hard limits of the project, the document is not degraded to
+// read-only, since the changes are rejected before they are stored.
+func checkServerLimits(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	initialServerSeq int64,
+	changes []*change.Change,
+) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if limit := be.Config.MaxChangePackSize; limit > 0 {
+		size, err := changesSize(changes)
+		if err != nil {
+			return err
+		}
+		if size > limit {
+			return &types.ThrottleError{
+				Subject:     "document:" + docInfo.Key.String(),
+				Description: fmt.Sprintf("changes of %d bytes exceed the change pack size limit %d", size, limit),
+			}
+		}
+	}
+
+	sizeLimit, elementsLimit := be.Config.MaxDocumentSize, be.Config.MaxDocumentElements
+	if sizeLimit <= 0 && elementsLimit <= 0 {
+		return nil
+	}
+
+	usage, err := projectUsage(ctx, be, docInfo, initialServerSeq, changes)
+	if err != nil {
+		return err
+	}
+
+	if elements := usage.elements; elementsLimit > 0 && elements > elementsLimit {
+		return &types.ThrottleError{
+			Subject:     "document:" + docInfo.Key.String(),
+			Description: fmt.Sprintf("%d elements exceed the document elements limit %d", elements, elementsLimit),
+		}
+	}
+
+	if size := usage.size; sizeLimit > 0 && size > sizeLimit {
+		return &types.ThrottleError{
+			Subject:     "document:" + docInfo.Key.String(),
+			Description: fmt.Sprintf("document of %d bytes exceeds the document size limit %d", size, sizeLimit),
+		}
+	}
+
+	return nil
+}
+
+// documentUsage is the usage of a document projected after storing the
+// pushed changes.
+type documentUsage struct {
+	size     int64
+	elements int64
+}
+
+// projectUsage returns the usage of the given document after storing the
+// given pushed changes. It is projected by adding the changes stored after
+// the closest snapshot and the pushed changes to the usage of the snapshot,
+// so that the limits are checked without building the document on every
+// push.
+//
+// NOTE: The projected usage may overestimate the actual usage if the changes
+// remove elements or overwrite values.
+func projectUsage(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	initialServerSeq int64,
+	changes []*change.Change,
+) (*documentUsage, error) {
+	info, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, initialServerSeq, false)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &documentUsage{size: info.Size, elements: info.Elements}
+	if info.ID == "" {
+		// NOTE: The document without snapshots has only the root.
+		usage.elements = 1
+	}
+
+	if info.ServerSeq < initialServerSeq {
+		stored, err := be.DB.FindChangesBetweenServerSeqs(ctx, docInfo.ID, info.ServerSeq+1, initialServerSeq)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(stored, changes...)
+	}
+
+	size, err := changesSize(changes)
+	if err != nil {
+		return nil, err
+	}
+	usage.size += size
+	usage.elements += createdElements(changes)
+
+	return usage, nil
+}
+
+// createdElements returns the number of the elements that the given changes
+// create at most, including the descendants of the containers they set.
+func createdElements(changes []*change.Change) int64 {
+	var elements int64
+	for _, c := range changes {
+		for _, op := range c.Operations() {
+			var value crdt.Element
+			switch op := op.(type) {
+			case *operations.Set:
+				value = op.Value()
+			case *operations.Add:
+				value = op.Value()
+			default:
+				continue
+			}
+
+			elements++
+			if container, ok := value.(crdt.Container); ok {
+				container.Descendants(func(crdt.Element, crdt.Container) bool {
+					elements++
+					return false
+				})
+			}
+		}
+	}
+
+	return elements
+}
+
+// changesSize returns the size in bytes of the given changes encoded in
+// protobuf.
+func changesSize(changes []*change.Change) (int64, error) {
+	pbChanges, err := converter.ToChanges(changes)
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, pbChange := range pbChanges {
+		size += int64(pbChange.Size())
+	}
+	return size, nil
+}
+
+// warnSoftLimit warns that the document has reached the given soft limit of
+// the project if the usage has crossed the limit from the previous usage.
+func warnSoftLimit(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	limitName string,
+	limit int64,
+	prevUsage int64,
+	usage int64,
+) {
+	if limit <= 0 || prevUsage >= limit || usage < limit {
+		return
+	}
+
+	logging.FromModule(ctx, "packs").Warnf(
+		"LIMT: '%s' reached the %s limit: %d >= %d",
+		docInfo.Key,
+		limitName,
+		usage,
+		limit,
+	)
+	be.Metrics.AddDocumentLimit(project, limitName, prometheus.LimitSoft)
+	webhook.SendDocumentLimitEvent(be, project, docInfo, &types.DocumentLimitUsage{
+		Limit:     limitName,
+		Usage:     usage,
+		Threshold: limit,
+	})
+}
+
+// degradeToReadOnly makes the given document read-only for the reason of the
+// given error if it is a ThrottleError, and notifies the clients watching the
+// document. The clients can still read the document.
+func degradeToReadOnly(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	err error,
+) error {
+	var throttleErr *types.ThrottleError
+	if !errors.As(err, &throttleErr) || docInfo.IsReadOnly() {
+		return nil
+	}
+
+	reason := throttleErr.Description
+	if err := be.DB.UpdateDocInfoReadOnlyReason(ctx, project.ID, docInfo.ID, reason); err != nil {
+		return err
+	}
+	docInfo.ReadOnlyReason = reason
+
+	logging.FromModule(ctx, "packs").Warnf("LIMT: '%s' becomes read-only: %s", docInfo.Key, reason)
+	publishQuotaEvent(ctx, be, docInfo, types.DocumentReadOnlyEvent, reason)
+	return nil
+}
+
+// restoreWriteAccess restores the write access of the given read-only document
+// if its usage has dropped below the thresholds of the project. The soft
+// limits are used as the thresholds if they are set, so that the document
+// does not flap between read-only and writable near the hard limits.
+func restoreWriteAccess(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) error {
+	if !docInfo.IsReadOnly() {
+		return nil
+	}
+
+	if threshold := restoreThreshold(
+		project.ChangeLogSoftLimit,
+		project.ChangeLogHardLimit,
+	); threshold > 0 && docInfo.ServerSeq >= threshold {
+		return nil
+	}
+
+	if threshold := restoreThreshold(
+		project.DocumentSizeSoftLimit,
+		project.DocumentSizeHardLimit,
+	); threshold > 0 {
+		info, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq, false)
+		if err != nil {
+			return err
+		}
+		if info.Size >= threshold {
+			return nil
+		}
+	}
+
+	if err := be.DB.UpdateDocInfoReadOnlyReason(ctx, project.ID, docInfo.ID, ""); err != nil {
+		return err
+	}
+	docInfo.ReadOnlyReason = ""
+
+	logging.FromModule(ctx, "packs").Infof("LIMT: '%s' becomes writable", docInfo.Key)
+	publishQuotaEvent(ctx, be, docInfo, types.DocumentWritableEvent, "")
+	return nil
+}
+
+// restoreThreshold returns the usage below which the write access of the
+// read-only document is restored. It returns 0 if the usage is not limited.
+func restoreThreshold(softLimit, hardLimit int64) int64 {
+	if softLimit > 0 {
+		return softLimit
+	}
+	return hardLimit
+}
+
+// publishQuotaEvent publishes the given event about the quota of the document
+// to all the clients watching the document, including the one that caused it.
+func publishQuotaEvent(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	eventType types.DocEventType,
+	reason string,
+) {
+	docID := docInfo.ID
+	be.Background.AttachGoroutine(func(ctx context.Context) {
+		be.Coordinator.Publish(
+			ctx,
+			time.InitialActorID,
+			sync.DocEvent{
+				Type:       eventType,
+				Publisher:  time.InitialActorID,
+				DocumentID: docID,
+				Reason:     reason,
+			},
+		)
+	})
+}
@@ -20,6 +20,7 @@ package packs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	gotime "time"
 
@@ -31,10 +32,20 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/eventbus"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/webhook"
 )
 
+// ErrDuplicateDocument is returned when the packs of PushPullMulti include the
+// same document more than once.
+var ErrDuplicateDocument = errors.New("duplicate document")
+
+// maxStoreAttempts is the maximum number of attempts to store the pushed
+// changes when they conflict with the pushes of the other servers.
+const maxStoreAttempts = 3
+
 // PushPullKey creates a new sync.Key of PushPull for the given document.
 func PushPullKey(projectID types.ID, docKey key.Key) sync.Key {
 	return sync.NewKey(fmt.Sprintf("pushpull-%s-%s", projectID, docKey))
@@ -64,44 +75,346 @@ func PushPull(
 		be.Metrics.ObservePushPullResponseSeconds(gotime.Since(start).Seconds())
 	}()
 
+	pushStart := gotime.Now()
+	observeReceived(be, reqPack)
+	var pushed *pushResult
+	var respPack *ServerPack
+	var pullErr error
+	for attempt := 1; ; attempt++ {
+		// 00. ~ 01. push changes: filter out the changes that are already
+		// saved in the database.
+		var err error
+		pushed, err = push(ctx, be, project, clientInfo, docInfo, reqPack)
+		if err != nil {
+			return nil, err
+		}
+
+		// 02. pull pack and store pushed changes.
+		// NOTE: Pulling reads only the changes up to initialServerSeq,
+		// so it does not depend on the pushed changes. To reduce the latency of
+		// large packs, we pull the pack while storing the pushed changes, and
+		// respond only after both are done. The pull reads a copy of docInfo
+		// because storing the changes updates docInfo.
+		pulled := make(chan struct{})
+		pullDocInfo := docInfo.DeepCopy()
+		go func() {
+			defer close(pulled)
+			respPack, pullErr = pullPack(
+				ctx,
+				be,
+				project,
+				clientInfo,
+				pullDocInfo,
+				reqPack,
+				pushed.cpAfterPush,
+				pushed.initialServerSeq,
+				mode,
+			)
+		}()
+
+		storeErr := storeChanges(ctx, be, project, docInfo, reqPack, pushed.changes, pushed.initialServerSeq)
+		<-pulled
+		if retry, err := retryOnConflict(ctx, be, storeErr, attempt, docInfo); err != nil {
+			return nil, err
+		} else if retry {
+			continue
+		}
+		break
+	}
+	be.Metrics.ObservePushPullPushSeconds(gotime.Since(pushStart).Seconds())
+	observeDuplicates(be, reqPack, pushed)
+	afterStore(ctx, be, project, clientInfo, docInfo, reqPack, pushed)
+	if pullErr != nil {
+		if err := syncClientSeq(ctx, be, clientInfo, docInfo, pushed); err != nil {
+			return nil, err
+		}
+		return nil, pullErr
+	}
+
+	// 03. ~ 05. store checkpoint and publish the event.
+	if err := complete(ctx, be, project, clientInfo, docInfo, reqPack, pushed, respPack); err != nil {
+		return nil, err
+	}
+
+	return respPack, nil
+}
+
+// PushPullMulti stores the changes of the given documents and returns the
+// accumulated changes of each document. Unlike PushPull, the changes of all the
+// documents are stored in a single transaction, so either all of them are
+// stored or none of them.
+//
+// The given docInfos and reqPacks are matched by index, and the documents
+// should be locked by the caller in a consistent order to avoid deadlocks.
+func PushPullMulti(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfos []*database.DocInfo,
+	reqPacks []*change.Pack,
+) ([]*ServerPack, error) {
+	start := gotime.Now()
+	defer func() {
+		be.Metrics.ObservePushPullResponseSeconds(gotime.Since(start).Seconds())
+	}()
+
+	pushStart := gotime.Now()
+	for _, reqPack := range reqPacks {
+		observeReceived(be, reqPack)
+	}
+	pushedList := make([]*pushResult, len(docInfos))
+	for attempt := 1; ; attempt++ {
+		// 01. push the changes of all the documents before storing any of
+		// them, so that the violation of a limit of one document rejects all
+		// of them.
+		var docChanges []*database.DocChanges
+		for i, docInfo := range docInfos {
+			pushed, err := push(ctx, be, project, clientInfo, docInfo, reqPacks[i])
+			if err != nil {
+				return nil, err
+			}
+			pushedList[i] = pushed
+
+			if len(pushed.changes) == 0 && !reqPacks[i].IsRemoved {
+				continue
+			}
+			changes, err := encryptChanges(be, project, pushed.changes)
+			if err != nil {
+				return nil, err
+			}
+			docChanges = append(docChanges, &database.DocChanges{
+				DocInfo:          docInfo,
+				InitialServerSeq: pushed.initialServerSeq,
+				Changes:          changes,
+				IsRemoved:        reqPacks[i].IsRemoved,
+			})
+		}
+
+		// 02. store the pushed changes atomically then pull the pack of each
+		// document.
+		if len(docChanges) == 0 {
+			break
+		}
+		storeErr := be.DB.CreateChangeInfosOfDocs(ctx, project.ID, docChanges)
+		if retry, err := retryOnConflict(ctx, be, storeErr, attempt, docInfos...); err != nil {
+			return nil, err
+		} else if retry {
+			continue
+		}
+		break
+	}
+	be.Metrics.ObservePushPullPushSeconds(gotime.Since(pushStart).Seconds())
+
+	respPacks := make([]*ServerPack, len(docInfos))
+	for i, docInfo := range docInfos {
+		reqPack, pushed := reqPacks[i], pushedList[i]
+		observeDuplicates(be, reqPack, pushed)
+		afterStore(ctx, be, project, clientInfo, docInfo, reqPack, pushed)
+
+		respPack, err := pullPack(
+			ctx,
+			be,
+			project,
+			clientInfo,
+			docInfo,
+			reqPack,
+			pushed.cpAfterPush,
+			pushed.initialServerSeq,
+			types.SyncModePushPull,
+		)
+		if err != nil {
+			if err := syncClientSeq(ctx, be, clientInfo, docInfo, pushed); err != nil {
+				return nil, err
+			}
+			return nil, err
+		}
+
+		// 03. ~ 05. store checkpoint and publish the event.
+		if err := complete(ctx, be, project, clientInfo, docInfo, reqPack, pushed, respPack); err != nil {
+			return nil, err
+		}
+		respPacks[i] = respPack
+	}
+
+	return respPacks, nil
+}
+
+// pushResult is the result of pushing the changes of a pack to a document.
+type pushResult struct {
+	initialServerSeq int64
+	cpAfterPush      change.Checkpoint
+	changes          []*change.Change
+}
+
+// push filters out the changes of the given pack that are already saved in
+// the database and assigns server seqs to the others. It rejects the pack if
+// the document is read-only, the changes exceed the limits of the server or
+// the document exceeds the hard limits of the project.
+func push(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+) (*pushResult, error) {
 	// TODO: Changes may be reordered or missing during communication on the network.
 	// We should check the change.pack with checkpoint to make sure the changes are in the correct order.
 	initialServerSeq := docInfo.ServerSeq
 
+	// 00. reject the changes with operations if the document is read-only
+	// because it exceeds the quota of the project. Pulling is still allowed.
+	if err := restoreWriteAccess(ctx, be, project, docInfo); err != nil {
+		return nil, err
+	}
+	if docInfo.IsReadOnly() && reqPack.OperationsLen() > 0 {
+		return nil, &types.ThrottleError{
+			Subject:     "document:" + docInfo.Key.String(),
+			Description: docInfo.ReadOnlyReason,
+		}
+	}
+
 	// 01. push changes: filter out the changes that are already saved in the database.
 	cpAfterPush, pushedChanges := pushChanges(ctx, clientInfo, docInfo, reqPack, initialServerSeq)
+	if err := checkServerLimits(ctx, be, docInfo, initialServerSeq, pushedChanges); err != nil {
+		return nil, err
+	}
+	if err := checkHardLimits(ctx, be, project, docInfo, initialServerSeq, pushedChanges); err != nil {
+		if err := degradeToReadOnly(ctx, be, project, docInfo, err); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+
+	return &pushResult{
+		initialServerSeq: initialServerSeq,
+		cpAfterPush:      cpAfterPush,
+		changes:          pushedChanges,
+	}, nil
+}
+
+// observeReceived records the changes and operations of the given pack
+// received from the client. It is called once per pack, not per attempt of
+// pushing it.
+func observeReceived(be *backend.Backend, reqPack *change.Pack) {
 	be.Metrics.AddPushPullReceivedChanges(reqPack.ChangesLen())
 	be.Metrics.AddPushPullReceivedOperations(reqPack.OperationsLen())
+}
 
-	// 02. pull pack: pull changes or a snapshot from the database and create a response pack.
-	respPack, err := pullPack(ctx, be, clientInfo, docInfo, reqPack, cpAfterPush, initialServerSeq, mode)
-	if err != nil {
-		return nil, err
+// observeDuplicates records the changes of the given pack that were already
+// saved in the database, by the result of the last attempt of pushing it.
+func observeDuplicates(be *backend.Backend, reqPack *change.Pack, pushed *pushResult) {
+	if duplicates := reqPack.ChangesLen() - len(pushed.changes); duplicates > 0 {
+		be.Metrics.AddPushPullDuplicateChanges(duplicates)
 	}
-	be.Metrics.AddPushPullSentChanges(respPack.ChangesLen())
-	be.Metrics.AddPushPullSentOperations(respPack.OperationsLen())
-	be.Metrics.AddPushPullSnapshotBytes(respPack.SnapshotLen())
+}
 
-	if err := clientInfo.UpdateCheckpoint(docInfo.ID, respPack.Checkpoint); err != nil {
-		return nil, err
+// retryOnConflict returns whether to push the changes again after storing
+// them fails with the given error. If the server seq of a document is changed
+// by a push of another server after the document is read, the documents are
+// reloaded to allocate the server seqs again, up to maxStoreAttempts times.
+func retryOnConflict(
+	ctx context.Context,
+	be *backend.Backend,
+	storeErr error,
+	attempt int,
+	docInfos ...*database.DocInfo,
+) (bool, error) {
+	if storeErr == nil {
+		return false, nil
+	}
+	if !errors.Is(storeErr, database.ErrConflictOnUpdate) || attempt >= maxStoreAttempts {
+		return false, storeErr
 	}
 
-	// 03. store pushed changes, docInfo and checkpoint of the client to DB.
-	if len(pushedChanges) > 0 || reqPack.IsRemoved {
-		if err := be.DB.CreateChangeInfos(
-			ctx,
-			project.ID,
-			docInfo,
-			initialServerSeq,
-			pushedChanges,
-			reqPack.IsRemoved,
-		); err != nil {
-			return nil, err
+	for _, docInfo := range docInfos {
+		latest, err := be.DB.FindDocInfoByID(ctx, docInfo.ProjectID, docInfo.ID)
+		if err != nil {
+			return false, err
 		}
+		*docInfo = *latest
+	}
+
+	logging.FromModule(ctx, "packs").Warnf("PUSH: retry %d after conflict: %s", attempt, storeErr)
+	return true, nil
+}
+
+// afterStore warns the soft limit of the change log and publishes the removal
+// of the document after the pushed changes are stored.
+func afterStore(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+	pushed *pushResult,
+) {
+	warnSoftLimit(
+		ctx,
+		be,
+		project,
+		docInfo,
+		types.ChangeLogLimit,
+		project.ChangeLogSoftLimit,
+		pushed.initialServerSeq,
+		docInfo.ServerSeq,
+	)
+	if reqPack.IsRemoved {
+		be.EventBus.Publish(eventbus.Event{
+			Type:        eventbus.DocumentRemoved,
+			ProjectID:   project.ID,
+			ClientID:    clientInfo.ID,
+			DocumentID:  docInfo.ID,
+			DocumentKey: docInfo.Key,
+		})
+		webhook.SendDocumentEvent(be, project, types.DocumentRemovedEvent, clientInfo, docInfo)
 	}
+}
+
+// syncClientSeq stores the client seq after pushing when pulling fails.
+//
+// NOTE: The pushed changes are already stored, so we store the
+// client seq after pushing to prevent the client from pushing them again when
+// it retries.
+func syncClientSeq(
+	ctx context.Context,
+	be *backend.Backend,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	pushed *pushResult,
+) error {
+	cp := clientInfo.Checkpoint(docInfo.ID)
+	if err := clientInfo.UpdateCheckpoint(docInfo.ID, cp.SyncClientSeq(pushed.cpAfterPush.ClientSeq)); err != nil {
+		return err
+	}
+	return be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo)
+}
+
+// complete stores the checkpoint of the client, updates the min synced ticket
+// of the document, then publishes the change event and stores the snapshot
+// asynchronously.
+func complete(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+	pushed *pushResult,
+	respPack *ServerPack,
+) error {
+	be.Metrics.AddPushPullSentChanges(respPack.ChangesLen())
+	be.Metrics.AddPushPullSentOperations(respPack.OperationsLen())
+	be.Metrics.AddPushPullSnapshotBytes(respPack.SnapshotLen())
 
+	// 03. store checkpoint of the client to DB.
+	if err := clientInfo.UpdateCheckpoint(docInfo.ID, respPack.Checkpoint); err != nil {
+		return err
+	}
 	if err := be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
-		return nil, err
+		return err
 	}
 
 	// 04. update and find min synced ticket for garbage collection.
@@ -114,17 +427,17 @@ func PushPull(
 		reqPack.Checkpoint.ServerSeq,
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	respPack.MinSyncedTicket = minSyncedTicket
 	respPack.ApplyDocInfo(docInfo)
 
 	// 05. publish document change event then store snapshot asynchronously.
-	if len(pushedChanges) > 0 || reqPack.IsRemoved {
+	if len(pushed.changes) > 0 || reqPack.IsRemoved {
 		be.Background.AttachGoroutine(func(ctx context.Context) {
 			publisherID, err := clientInfo.ID.ToActorID()
 			if err != nil {
-				logging.From(ctx).Error(err)
+				logging.FromModule(ctx, "packs").Error(err)
 				return
 			}
 
@@ -140,7 +453,7 @@ func PushPull(
 
 			locker, err := be.Coordinator.NewLocker(ctx, SnapshotKey(project.ID, reqPack.DocumentKey))
 			if err != nil {
-				logging.From(ctx).Error(err)
+				logging.FromModule(ctx, "packs").Error(err)
 				return
 			}
 
@@ -151,19 +464,21 @@ func PushPull(
 			}
 			defer func() {
 				if err := locker.Unlock(ctx); err != nil {
-					logging.From(ctx).Error(err)
+					logging.FromModule(ctx, "packs").Error(err)
 					return
 				}
 			}()
 
 			start := gotime.Now()
-			if err := storeSnapshot(
+			if _, err := storeSnapshot(
 				ctx,
 				be,
+				project,
 				docInfo,
 				minSyncedTicket,
+				false,
 			); err != nil {
-				logging.From(ctx).Error(err)
+				logging.FromModule(ctx, "packs").Error(err)
 			}
 			be.Metrics.ObservePushPullSnapshotDurationSeconds(
 				gotime.Since(start).Seconds(),
@@ -171,16 +486,51 @@ func PushPull(
 		})
 	}
 
-	return respPack, nil
+	return nil
+}
+
+// storeChanges stores the pushed changes and docInfo to DB.
+func storeChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+	pushedChanges []*change.Change,
+	initialServerSeq int64,
+) error {
+	if len(pushedChanges) == 0 && !reqPack.IsRemoved {
+		return nil
+	}
+
+	changes, err := encryptChanges(be, project, pushedChanges)
+	if err != nil {
+		return err
+	}
+
+	return be.DB.CreateChangeInfos(
+		ctx,
+		project.ID,
+		docInfo,
+		initialServerSeq,
+		changes,
+		reqPack.IsRemoved,
+	)
 }
 
 // BuildDocumentForServerSeq returns a new document for the given serverSeq.
+// If the serverSeq is greater than the server seq of the document, the latest
+// state of the document is returned.
 func BuildDocumentForServerSeq(
 	ctx context.Context,
 	be *backend.Backend,
 	docInfo *database.DocInfo,
 	serverSeq int64,
 ) (*document.InternalDocument, error) {
+	if serverSeq > docInfo.ServerSeq {
+		serverSeq = docInfo.ServerSeq
+	}
+
 	snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, serverSeq, true)
 	if err != nil {
 		return nil, err
@@ -196,32 +546,15 @@ func BuildDocumentForServerSeq(
 		return nil, err
 	}
 
-	// TODO(hackerwins): If the Snapshot is missing, we may have a very large
-	// number of changes to read at once here. We need to split changes by a
-	// certain size (e.g. 100) and read and gradually reflect it into the document.
-	changes, err := be.DB.FindChangesBetweenServerSeqs(
-		ctx,
-		docInfo.ID,
-		snapshotInfo.ServerSeq+1,
-		serverSeq,
-	)
+	applied, err := applyChangesInBatches(ctx, be, doc, docInfo, snapshotInfo.ServerSeq+1, serverSeq)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := doc.ApplyChangePack(change.NewPack(
-		docInfo.Key,
-		change.InitialCheckpoint.NextServerSeq(serverSeq),
-		changes,
-		nil,
-	)); err != nil {
-		return nil, err
-	}
-
-	if logging.Enabled(zap.DebugLevel) {
-		logging.From(ctx).Debugf(
+	if logging.ModuleEnabled("packs", zap.DebugLevel) {
+		logging.FromModule(ctx, "packs").Debugf(
 			"after apply %d changes: elements: %d removeds: %d, %s",
-			len(changes),
+			applied,
 			doc.Root().ElementMapLen(),
 			doc.Root().RemovedElementLen(),
 			doc.RootObject().Marshal(),
@@ -230,3 +563,58 @@ func BuildDocumentForServerSeq(
 
 	return doc, nil
 }
+
+// applyChangesInBatches reads the changes between the given server seqs from
+// the database and applies them to the given document batch by batch, so that
+// the memory stays bounded even if the snapshot is missing and the document
+// has a large number of changes. It returns ErrChangeLogIncomplete if some of
+// the changes are missing, and the number of applied changes otherwise.
+func applyChangesInBatches(
+	ctx context.Context,
+	be *backend.Backend,
+	doc *document.InternalDocument,
+	docInfo *database.DocInfo,
+	from int64,
+	to int64,
+) (int, error) {
+	batchSize := be.Config.SnapshotBuildBatchSize
+	if batchSize <= 0 {
+		batchSize = to - from + 1
+	}
+
+	applied := 0
+	for start := from; start <= to; start += batchSize {
+		end := start + batchSize - 1
+		if end > to {
+			end = to
+		}
+
+		changes, err := be.DB.FindChangesBetweenServerSeqs(ctx, docInfo.ID, start, end)
+		if err != nil {
+			return applied, err
+		}
+		if int64(len(changes)) != end-start+1 {
+			return applied, fmt.Errorf(
+				"%d of %d changes(%d~%d) in '%s': %w",
+				len(changes),
+				end-start+1,
+				start,
+				end,
+				docInfo.Key,
+				ErrChangeLogIncomplete,
+			)
+		}
+
+		if err := doc.ApplyChangePack(change.NewPack(
+			docInfo.Key,
+			change.InitialCheckpoint.NextServerSeq(end),
+			changes,
+			nil,
+		)); err != nil {
+			return applied, err
+		}
+		applied += len(changes)
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,258 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+)
+
+var (
+	docSizes     = []int{1000, 10000}
+	lagDistances = []int{10, 100, 1000}
+)
+
+func newBackend(b *testing.B) *backend.Backend {
+	assert.NoError(b, logging.SetLogLevel("error"))
+
+	met, err := prometheus.NewMetrics()
+	assert.NoError(b, err)
+
+	be, err := backend.New(&backend.Config{
+		AdminUser:                 "admin",
+		AdminPassword:             "admin",
+		ClientDeactivateThreshold: "24h",
+		SnapshotInterval:          1,
+		AuthWebhookCacheSize:      100,
+		ProjectInfoCacheSize:      256,
+		ProjectInfoCacheTTL:       "5s",
+		AdminTokenDuration:        "10s",
+	}, nil, nil, nil, &housekeeping.Config{
+		Interval:                  "10s",
+		CandidatesLimitPerProject: 10,
+		ProjectFetchSize:          10,
+	}, met)
+	assert.NoError(b, err)
+	b.Cleanup(func() {
+		assert.NoError(b, be.Shutdown())
+	})
+
+	return be
+}
+
+// fixture is a document that has the changes of the writer stored in the
+// database, and the reader that has synced the document up to a server seq.
+type fixture struct {
+	writer  *database.ClientInfo
+	reader  *database.ClientInfo
+	docInfo *database.DocInfo
+}
+
+// newFixture creates a document that has the given number of changes. If
+// snapshotSeq is not zero, a snapshot of the document at the seq is stored.
+func newFixture(
+	ctx context.Context,
+	b *testing.B,
+	be *backend.Backend,
+	size int,
+	snapshotSeq int,
+) *fixture {
+	docKey := key.Key(fmt.Sprintf("bench-%d-%d-%d", size, snapshotSeq, gotime.Now().UnixNano()))
+	writer, err := be.DB.ActivateClient(ctx, database.DefaultProjectID, "writer", types.ConnectionInfo{})
+	assert.NoError(b, err)
+	reader, err := be.DB.ActivateClient(ctx, database.DefaultProjectID, "reader", types.ConnectionInfo{})
+	assert.NoError(b, err)
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(ctx, database.DefaultProjectID, writer.ID, docKey, true)
+	assert.NoError(b, err)
+
+	changes := newTextChanges(b, docKey, writer, size)
+	if len(changes) == 0 {
+		return &fixture{writer: writer, reader: reader, docInfo: docInfo}
+	}
+	for _, batch := range splitChanges(changes, snapshotSeq) {
+		initialServerSeq := docInfo.ServerSeq
+		_, pushed := pushChanges(ctx, writer, docInfo, change.NewPack(
+			docKey,
+			change.InitialCheckpoint,
+			batch,
+			nil,
+		), initialServerSeq)
+		assert.NoError(b, be.DB.CreateChangeInfos(
+			ctx,
+			database.DefaultProjectID,
+			docInfo,
+			initialServerSeq,
+			pushed,
+			false,
+		))
+
+		if docInfo.ServerSeq == int64(snapshotSeq) {
+			assert.NoError(b, storeSnapshot(ctx, be, project, docInfo, time.InitialTicket))
+		}
+	}
+
+	return &fixture{writer: writer, reader: reader, docInfo: docInfo}
+}
+
+// newTextChanges returns the given number of changes of the given client,
+// each of which inserts a character at the end of a text.
+func newTextChanges(b *testing.B, docKey key.Key, clientInfo *database.ClientInfo, size int) []*change.Change {
+	if size == 0 {
+		return nil
+	}
+
+	actorID, err := clientInfo.ID.ToActorID()
+	assert.NoError(b, err)
+
+	doc := document.New(docKey)
+	doc.SetActor(actorID)
+	assert.NoError(b, doc.Update(func(root *json.Object, p *presence.Presence) error {
+		root.SetNewText("text")
+		return nil
+	}))
+	for i := 1; i < size; i++ {
+		assert.NoError(b, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(i-1, i-1, "a")
+			return nil
+		}))
+	}
+
+	return doc.CreateChangePack().Changes
+}
+
+// splitChanges splits the given changes at the given seq.
+func splitChanges(changes []*change.Change, seq int) [][]*change.Change {
+	if seq <= 0 || seq >= len(changes) {
+		return [][]*change.Change{changes}
+	}
+	return [][]*change.Change{changes[:seq], changes[seq:]}
+}
+
+func BenchmarkPushChanges(b *testing.B) {
+	ctx := context.Background()
+	be := newBackend(b)
+
+	for _, size := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("push %d changes test", size), func(b *testing.B) {
+			f := newFixture(ctx, b, be, 0, 0)
+			reqPack := change.NewPack(
+				f.docInfo.Key,
+				change.InitialCheckpoint,
+				newTextChanges(b, f.docInfo.Key, f.writer, size),
+				nil,
+			)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				f.docInfo.ServerSeq = 0
+				_, pushed := pushChanges(ctx, f.writer, f.docInfo, reqPack, 0)
+				assert.Len(b, pushed, size)
+			}
+		})
+	}
+}
+
+func BenchmarkPushPull(b *testing.B) {
+	ctx := context.Background()
+	be := newBackend(b)
+	projectInfo, err := be.DB.FindProjectInfoByID(ctx, database.DefaultProjectID)
+	assert.NoError(b, err)
+	project := projectInfo.ToProject()
+
+	for _, size := range []int{100, 1000} {
+		b.Run(fmt.Sprintf("pushpull %d changes test", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				f := newFixture(ctx, b, be, 0, 0)
+				assert.NoError(b, f.writer.AttachDocument(f.docInfo.ID))
+				reqPack := change.NewPack(
+					f.docInfo.Key,
+					change.InitialCheckpoint,
+					newTextChanges(b, f.docInfo.Key, f.writer, size),
+					nil,
+				)
+				b.StartTimer()
+
+				_, err := PushPull(ctx, be, project, f.writer, f.docInfo, reqPack, types.SyncModePushPull)
+				assert.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkPullChangeInfos(b *testing.B) {
+	ctx := context.Background()
+	be := newBackend(b)
+
+	for _, size := range docSizes {
+		f := newFixture(ctx, b, be, size, 0)
+		for _, lag := range lagDistances {
+			b.Run(fmt.Sprintf("pull %d of %d changes test", lag, size), func(b *testing.B) {
+				cp := change.Checkpoint{ServerSeq: int64(size - lag)}
+				reqPack := change.NewPack(f.docInfo.Key, cp, nil, nil)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					_, pulled, err := pullChangeInfos(ctx, be, f.reader, f.docInfo, reqPack, cp, f.docInfo.ServerSeq)
+					assert.NoError(b, err)
+					assert.Len(b, pulled, lag)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkPullSnapshot(b *testing.B) {
+	ctx := context.Background()
+	be := newBackend(b)
+
+	for _, size := range docSizes {
+		for _, lag := range lagDistances {
+			f := newFixture(ctx, b, be, size, size-lag)
+			b.Run(fmt.Sprintf("pull snapshot %d behind of %d changes test", lag, size), func(b *testing.B) {
+				cp := change.Checkpoint{ServerSeq: int64(size - lag)}
+				reqPack := change.NewPack(f.docInfo.Key, cp, nil, nil)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					pack, err := pullSnapshot(ctx, be, f.reader, f.docInfo, reqPack, cp, f.docInfo.ServerSeq)
+					assert.NoError(b, err)
+					assert.NotZero(b, pack.SnapshotLen())
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,236 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	gosync "sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+)
+
+func newTestBackend(t *testing.T) *backend.Backend {
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+
+	be, err := backend.New(&backend.Config{
+		AdminUser:                 "admin",
+		AdminPassword:             "admin",
+		ClientDeactivateThreshold: "24h",
+		SnapshotInterval:          10,
+		AuthWebhookCacheSize:      100,
+		ProjectInfoCacheSize:      256,
+		ProjectInfoCacheTTL:       "5s",
+		AdminTokenDuration:        "10s",
+	}, nil, nil, nil, &housekeeping.Config{
+		Interval:                  "10s",
+		CandidatesLimitPerProject: 10,
+		ProjectFetchSize:          10,
+	}, met)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, be.Shutdown())
+	})
+
+	return be
+}
+
+// newChangePack returns a pack with a change of the given client.
+func newChangePack(t *testing.T, docKey key.Key, clientInfo *database.ClientInfo) *change.Pack {
+	actorID, err := clientInfo.ID.ToActorID()
+	assert.NoError(t, err)
+
+	doc := document.New(docKey)
+	doc.SetActor(actorID)
+	assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+		root.SetString("k", clientInfo.Key)
+		return nil
+	}))
+
+	return change.NewPack(docKey, change.InitialCheckpoint, doc.CreateChangePack().Changes, nil)
+}
+
+// metricValue returns the value of the counter or the sample count of the
+// histogram of the given name and labels in the metrics of the given backend.
+func metricValue(t *testing.T, be *backend.Backend, name string, labels map[string]string) float64 {
+	families, err := be.Metrics.Registry().Gather()
+	assert.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			matched := 0
+			for _, label := range metric.GetLabel() {
+				if value, ok := labels[label.GetName()]; ok && value == label.GetValue() {
+					matched++
+				}
+			}
+			if matched != len(labels) {
+				continue
+			}
+
+			if histogram := metric.GetHistogram(); histogram != nil {
+				return float64(histogram.GetSampleCount())
+			}
+			return metric.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+func TestPushPull(t *testing.T) {
+	t.Run("retry on conflict test", func(t *testing.T) {
+		ctx := context.Background()
+		be := newTestBackend(t)
+		projectInfo, err := be.DB.FindProjectInfoByID(ctx, database.DefaultProjectID)
+		assert.NoError(t, err)
+		project := projectInfo.ToProject()
+
+		docKey := key.Key(t.Name())
+		c1, err := be.DB.ActivateClient(ctx, project.ID, "c1", types.ConnectionInfo{})
+		assert.NoError(t, err)
+		c2, err := be.DB.ActivateClient(ctx, project.ID, "c2", types.ConnectionInfo{})
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKeyAndOwner(ctx, project.ID, c1.ID, docKey, true)
+		assert.NoError(t, err)
+		assert.NoError(t, c1.AttachDocument(docInfo.ID))
+		assert.NoError(t, c2.AttachDocument(docInfo.ID))
+
+		// NOTE: The doc info read by another server before c1 pushes its
+		// change, whose server seq is stale after the push.
+		staleDocInfo := docInfo.DeepCopy()
+
+		_, err = PushPull(ctx, be, project, c1, docInfo, newChangePack(t, docKey, c1), types.SyncModePushPull)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), docInfo.ServerSeq)
+
+		_, err = PushPull(ctx, be, project, c2, staleDocInfo, newChangePack(t, docKey, c2), types.SyncModePushPull)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), staleDocInfo.ServerSeq)
+
+		changes, err := be.DB.FindChangeInfosBetweenServerSeqs(ctx, docInfo.ID, 1, 2)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 2)
+		assert.Equal(t, types.ID(c1.ID.String()), changes[0].ActorID)
+		assert.Equal(t, types.ID(c2.ID.String()), changes[1].ActorID)
+
+		// NOTE: The retried push of c2 is counted only once.
+		assert.Equal(t, float64(2), metricValue(t, be, "yorkie_pushpull_received_changes_total", nil))
+		assert.Equal(t, float64(2), metricValue(t, be, "yorkie_pushpull_received_operations_total", nil))
+		assert.Equal(t, float64(0), metricValue(t, be, "yorkie_pushpull_duplicate_changes_total", nil))
+	})
+
+	t.Run("metrics test", func(t *testing.T) {
+		ctx := context.Background()
+		be := newTestBackend(t)
+		projectInfo, err := be.DB.FindProjectInfoByID(ctx, database.DefaultProjectID)
+		assert.NoError(t, err)
+		project := projectInfo.ToProject()
+
+		docKey := key.Key(t.Name())
+		c1, err := be.DB.ActivateClient(ctx, project.ID, "c1", types.ConnectionInfo{})
+		assert.NoError(t, err)
+		c2, err := be.DB.ActivateClient(ctx, project.ID, "c2", types.ConnectionInfo{})
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKeyAndOwner(ctx, project.ID, c1.ID, docKey, true)
+		assert.NoError(t, err)
+		assert.NoError(t, c1.AttachDocument(docInfo.ID))
+		assert.NoError(t, c2.AttachDocument(docInfo.ID))
+		docInfo.SnapshotThreshold = 10
+
+		changesPull := map[string]string{"pull_type": prometheus.PullTypeChanges}
+		snapshotPull := map[string]string{"pull_type": prometheus.PullTypeSnapshot}
+
+		// 01. a pack with a new change is pushed, and the changes are pulled.
+		pack := newChangePack(t, docKey, c1)
+		_, err = PushPull(ctx, be, project, c1, docInfo, pack, types.SyncModePushPull)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(1), metricValue(t, be, "yorkie_pushpull_push_seconds", nil))
+		assert.Equal(t, float64(1), metricValue(t, be, "yorkie_pushpull_pull_seconds", nil))
+		assert.Equal(t, float64(1), metricValue(t, be, "yorkie_pushpull_pulls_total", changesPull))
+		assert.Equal(t, float64(1), metricValue(t, be, "yorkie_pushpull_pulled_changes", nil))
+		assert.Equal(t, float64(0), metricValue(t, be, "yorkie_pushpull_pulls_total", snapshotPull))
+		assert.Equal(t, float64(0), metricValue(t, be, "yorkie_pushpull_duplicate_changes_total", nil))
+
+		// 02. the same pack is pushed again, and its change is a duplicate.
+		_, err = PushPull(ctx, be, project, c1, docInfo, pack, types.SyncModePushPull)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(2), metricValue(t, be, "yorkie_pushpull_push_seconds", nil))
+		assert.Equal(t, float64(2), metricValue(t, be, "yorkie_pushpull_pulls_total", changesPull))
+		assert.Equal(t, float64(1), metricValue(t, be, "yorkie_pushpull_duplicate_changes_total", nil))
+
+		// 03. a snapshot is pulled if the changes to pull exceed the threshold.
+		docInfo.SnapshotThreshold = 1
+		_, err = PushPull(ctx, be, project, c2, docInfo, newChangePack(t, docKey, c2), types.SyncModePushPull)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(3), metricValue(t, be, "yorkie_pushpull_pull_seconds", nil))
+		assert.Equal(t, float64(1), metricValue(t, be, "yorkie_pushpull_pulls_total", snapshotPull))
+		assert.Equal(t, float64(2), metricValue(t, be, "yorkie_pushpull_pulled_changes", nil))
+	})
+}
+
+func TestBuildDocumentForPull(t *testing.T) {
+	t.Run("build document concurrently test", func(t *testing.T) {
+		ctx := context.Background()
+		be := newTestBackend(t)
+		projectInfo, err := be.DB.FindProjectInfoByID(ctx, database.DefaultProjectID)
+		assert.NoError(t, err)
+		project := projectInfo.ToProject()
+
+		docKey := key.Key(t.Name())
+		c1, err := be.DB.ActivateClient(ctx, project.ID, "c1", types.ConnectionInfo{})
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKeyAndOwner(ctx, project.ID, c1.ID, docKey, true)
+		assert.NoError(t, err)
+		assert.NoError(t, c1.AttachDocument(docInfo.ID))
+		_, err = PushPull(ctx, be, project, c1, docInfo, newChangePack(t, docKey, c1), types.SyncModePushPull)
+		assert.NoError(t, err)
+
+		// NOTE: Each caller owns its document even if the build is shared.
+		docs := make([]*document.InternalDocument, 10)
+		var wg gosync.WaitGroup
+		for i := range docs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				doc, err := BuildDocumentForPull(ctx, be, docInfo, docInfo.ServerSeq)
+				assert.NoError(t, err)
+				docs[i] = doc
+			}(i)
+		}
+		wg.Wait()
+
+		for _, doc := range docs[1:] {
+			assert.NotSame(t, docs[0], doc)
+			assert.Equal(t, `{"k":"c1"}`, doc.Marshal())
+		}
+	})
+}
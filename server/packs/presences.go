@@ -0,0 +1,337 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// encryptedPrefix is the prefix of the encrypted values of presences.
+const encryptedPrefix = "yorkie:enc:v1:"
+
+var (
+	// errInvalidCiphertext is returned when an encrypted presence value can
+	// not be decrypted.
+	errInvalidCiphertext = errors.New("invalid ciphertext")
+)
+
+// presenceDecryptionKey is the key for the context.Context.
+type presenceDecryptionKey struct{}
+
+// WithPresenceDecryption creates a new context that tells whether the user of
+// the request is permitted to read the values of the sensitive presence keys
+// in plaintext.
+func WithPresenceDecryption(ctx context.Context, permitted bool) context.Context {
+	return context.WithValue(ctx, presenceDecryptionKey{}, permitted)
+}
+
+// canDecryptPresence returns whether the user of the request is permitted to
+// read the values of the sensitive presence keys in plaintext. The values are
+// not decrypted unless the permission is given explicitly.
+func canDecryptPresence(ctx context.Context) bool {
+	permitted, ok := ctx.Value(presenceDecryptionKey{}).(bool)
+	return ok && permitted
+}
+
+// presenceCipher encrypts and decrypts the values of the sensitive presence
+// keys of a project.
+type presenceCipher struct {
+	aead cipher.AEAD
+	keys map[string]bool
+}
+
+// newPresenceCipher creates a new presenceCipher for the given project. It
+// returns nil if the server has no encryption key.
+func newPresenceCipher(be *backend.Backend, project *types.Project) (*presenceCipher, error) {
+	if be.Config.PresenceEncryptionKey == "" {
+		return nil, nil
+	}
+
+	key, err := hex.DecodeString(be.Config.PresenceEncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode presence encryption key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create presence cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create presence cipher: %w", err)
+	}
+
+	keys := make(map[string]bool)
+	for _, k := range project.SensitivePresenceKeys {
+		keys[k] = true
+	}
+
+	return &presenceCipher{aead: aead, keys: keys}, nil
+}
+
+// encrypt returns a copy of the given presence whose sensitive values are
+// encrypted. The encrypted value is a JSON string, because the values of
+// presences are JSON encoded by clients.
+func (c *presenceCipher) encrypt(p innerpresence.Presence) (innerpresence.Presence, error) {
+	encrypted := p.DeepCopy()
+	for k, v := range encrypted {
+		if !c.keys[k] || isEncrypted(v) {
+			continue
+		}
+
+		nonce := make([]byte, c.aead.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, fmt.Errorf("generate nonce: %w", err)
+		}
+		sealed := c.aead.Seal(nonce, nonce, []byte(v), []byte(k))
+
+		value, err := json.Marshal(encryptedPrefix + base64.StdEncoding.EncodeToString(sealed))
+		if err != nil {
+			return nil, fmt.Errorf("marshal encrypted value: %w", err)
+		}
+		encrypted[k] = string(value)
+	}
+
+	return encrypted, nil
+}
+
+// decrypt returns a copy of the given presence whose encrypted values are
+// decrypted.
+func (c *presenceCipher) decrypt(p innerpresence.Presence) (innerpresence.Presence, error) {
+	decrypted := p.DeepCopy()
+	for k, v := range decrypted {
+		if !isEncrypted(v) {
+			continue
+		}
+
+		var value string
+		if err := json.Unmarshal([]byte(v), &value); err != nil {
+			return nil, fmt.Errorf("unmarshal encrypted value of %s: %w", k, err)
+		}
+		sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("decode encrypted value of %s: %w", k, err)
+		}
+		if len(sealed) < c.aead.NonceSize() {
+			return nil, fmt.Errorf("encrypted value of %s: %w", k, errInvalidCiphertext)
+		}
+
+		nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+		plaintext, err := c.aead.Open(nil, nonce, ciphertext, []byte(k))
+		if err != nil {
+			return nil, fmt.Errorf("encrypted value of %s: %w", k, errInvalidCiphertext)
+		}
+		decrypted[k] = string(plaintext)
+	}
+
+	return decrypted, nil
+}
+
+// SealPresence returns a copy of the given presence whose values of the
+// sensitive keys are encrypted, so that it can be delivered to the watchers of
+// the document. The values are removed instead if the server has no
+// encryption key, since they can not be sealed.
+func SealPresence(
+	be *backend.Backend,
+	project *types.Project,
+	p innerpresence.Presence,
+) (innerpresence.Presence, error) {
+	if len(project.SensitivePresenceKeys) == 0 {
+		return p, nil
+	}
+
+	c, err := newPresenceCipher(be, project)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		return c.encrypt(p)
+	}
+
+	stripped := p.DeepCopy()
+	for _, k := range project.SensitivePresenceKeys {
+		delete(stripped, k)
+	}
+	return stripped, nil
+}
+
+// OpenPresence returns the given presence whose values are decrypted if the
+// user of the request is permitted to decrypt them. The presence is returned
+// as it is if it can not be decrypted.
+func OpenPresence(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	p innerpresence.Presence,
+) (innerpresence.Presence, error) {
+	if len(p) == 0 || !canDecryptPresence(ctx) {
+		return p, nil
+	}
+
+	c, err := newPresenceCipher(be, project)
+	if err != nil || c == nil {
+		return p, err
+	}
+
+	decrypted, err := c.decrypt(p)
+	if err != nil {
+		logging.FromModule(ctx, "packs").Warnf("decrypt presence: %v", err)
+		return p, nil
+	}
+	return decrypted, nil
+}
+
+// isEncrypted returns whether the given presence value is encrypted.
+func isEncrypted(value string) bool {
+	return strings.HasPrefix(value, `"`+encryptedPrefix)
+}
+
+// encryptChanges returns the given changes whose presences are encrypted. It
+// creates new changes instead of updating the given ones, since the given ones
+// are also applied to the document while pulling.
+func encryptChanges(
+	be *backend.Backend,
+	project *types.Project,
+	changes []*change.Change,
+) ([]*change.Change, error) {
+	if len(project.SensitivePresenceKeys) == 0 {
+		return changes, nil
+	}
+
+	c, err := newPresenceCipher(be, project)
+	if err != nil || c == nil {
+		return changes, err
+	}
+
+	encrypted := make([]*change.Change, 0, len(changes))
+	for _, cn := range changes {
+		p := cn.PresenceChange()
+		if p == nil || p.Presence == nil {
+			encrypted = append(encrypted, cn)
+			continue
+		}
+
+		presence, err := c.encrypt(p.Presence)
+		if err != nil {
+			return nil, err
+		}
+		encrypted = append(encrypted, change.New(cn.ID(), cn.Message(), cn.Operations(), &innerpresence.PresenceChange{
+			ChangeType: p.ChangeType,
+			Presence:   presence,
+		}))
+	}
+
+	return encrypted, nil
+}
+
+// decryptChangeInfos returns the given change infos whose presences are
+// decrypted. The values that can not be decrypted are left encrypted. Values
+// are decrypted even if their keys are no longer sensitive, since they were
+// encrypted when they were stored. The change infos are returned as they are
+// if the user of the request is not permitted to decrypt them.
+func decryptChangeInfos(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	infos []*database.ChangeInfo,
+) ([]*database.ChangeInfo, error) {
+	if !canDecryptPresence(ctx) {
+		return infos, nil
+	}
+
+	c, err := newPresenceCipher(be, project)
+	if err != nil || c == nil {
+		return infos, err
+	}
+
+	decrypted := make([]*database.ChangeInfo, 0, len(infos))
+	for _, info := range infos {
+		if !strings.Contains(info.PresenceChange, encryptedPrefix) {
+			decrypted = append(decrypted, info)
+			continue
+		}
+
+		p, err := innerpresence.NewChangeFromJSON(info.PresenceChange)
+		if err != nil {
+			return nil, err
+		}
+		presence, err := c.decrypt(p.Presence)
+		if err != nil {
+			logging.FromModule(ctx, "packs").Warnf("decrypt presence of change %d: %v", info.ServerSeq, err)
+			decrypted = append(decrypted, info)
+			continue
+		}
+		p.Presence = presence
+
+		encoded, err := database.EncodePresenceChange(p)
+		if err != nil {
+			return nil, err
+		}
+		clone := info.DeepCopy()
+		clone.PresenceChange = encoded
+		decrypted = append(decrypted, clone)
+	}
+
+	return decrypted, nil
+}
+
+// decryptPresences returns the given presences whose values are decrypted.
+// The presences that can not be decrypted are left encrypted, and all of them
+// are if the user of the request is not permitted to decrypt them.
+func decryptPresences(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	presences map[string]innerpresence.Presence,
+) (map[string]innerpresence.Presence, error) {
+	if !canDecryptPresence(ctx) {
+		return presences, nil
+	}
+
+	c, err := newPresenceCipher(be, project)
+	if err != nil || c == nil {
+		return presences, err
+	}
+
+	decrypted := make(map[string]innerpresence.Presence, len(presences))
+	for clientID, p := range presences {
+		presence, err := c.decrypt(p)
+		if err != nil {
+			logging.FromModule(ctx, "packs").Warnf("decrypt presence of %s: %v", clientID, err)
+			presence = p
+		}
+		decrypted[clientID] = presence
+	}
+
+	return decrypted, nil
+}
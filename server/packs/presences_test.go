@@ -0,0 +1,122 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/server/backend"
+)
+
+func TestPresenceCipher(t *testing.T) {
+	be := &backend.Backend{Config: &backend.Config{
+		PresenceEncryptionKey: "00112233445566778899aabbccddeeff",
+	}}
+	project := &types.Project{SensitivePresenceKeys: []string{"email"}}
+
+	t.Run("encrypt and decrypt sensitive values test", func(t *testing.T) {
+		c, err := newPresenceCipher(be, project)
+		assert.NoError(t, err)
+
+		p := innerpresence.Presence{"name": `"jane"`, "email": `"jane@example.com"`}
+		encrypted, err := c.encrypt(p)
+		assert.NoError(t, err)
+		assert.Equal(t, `"jane"`, encrypted["name"])
+		assert.True(t, isEncrypted(encrypted["email"]))
+		assert.Equal(t, `"jane@example.com"`, p["email"])
+
+		reencrypted, err := c.encrypt(encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, encrypted, reencrypted)
+
+		decrypted, err := c.decrypt(encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, p, decrypted)
+	})
+
+	t.Run("decrypt with another key test", func(t *testing.T) {
+		c, err := newPresenceCipher(be, project)
+		assert.NoError(t, err)
+		encrypted, err := c.encrypt(innerpresence.Presence{"email": `"jane@example.com"`})
+		assert.NoError(t, err)
+
+		other := &backend.Backend{Config: &backend.Config{
+			PresenceEncryptionKey: "ffeeddccbbaa99887766554433221100",
+		}}
+		c, err = newPresenceCipher(other, project)
+		assert.NoError(t, err)
+		_, err = c.decrypt(encrypted)
+		assert.ErrorIs(t, err, errInvalidCiphertext)
+	})
+
+	t.Run("decrypt presences with permission test", func(t *testing.T) {
+		c, err := newPresenceCipher(be, project)
+		assert.NoError(t, err)
+		p := innerpresence.Presence{"name": `"jane"`, "email": `"jane@example.com"`}
+		encrypted, err := c.encrypt(p)
+		assert.NoError(t, err)
+		presences := map[string]innerpresence.Presence{"c1": encrypted}
+
+		ctx := context.Background()
+		decrypted, err := decryptPresences(ctx, be, project, presences)
+		assert.NoError(t, err)
+		assert.Equal(t, encrypted, decrypted["c1"])
+
+		ctx = WithPresenceDecryption(ctx, false)
+		decrypted, err = decryptPresences(ctx, be, project, presences)
+		assert.NoError(t, err)
+		assert.Equal(t, encrypted, decrypted["c1"])
+
+		ctx = WithPresenceDecryption(ctx, true)
+		decrypted, err = decryptPresences(ctx, be, project, presences)
+		assert.NoError(t, err)
+		assert.Equal(t, p, decrypted["c1"])
+	})
+
+	t.Run("seal and open presence test", func(t *testing.T) {
+		p := innerpresence.Presence{"name": `"jane"`, "email": `"jane@example.com"`}
+		sealed, err := SealPresence(be, project, p)
+		assert.NoError(t, err)
+		assert.Equal(t, `"jane"`, sealed["name"])
+		assert.True(t, isEncrypted(sealed["email"]))
+
+		ctx := context.Background()
+		opened, err := OpenPresence(ctx, be, project, sealed)
+		assert.NoError(t, err)
+		assert.Equal(t, sealed, opened)
+
+		opened, err = OpenPresence(WithPresenceDecryption(ctx, true), be, project, sealed)
+		assert.NoError(t, err)
+		assert.Equal(t, p, opened)
+
+		stripped, err := SealPresence(&backend.Backend{Config: &backend.Config{}}, project, p)
+		assert.NoError(t, err)
+		assert.Equal(t, innerpresence.Presence{"name": `"jane"`}, stripped)
+		assert.Equal(t, `"jane@example.com"`, p["email"])
+	})
+
+	t.Run("without encryption key test", func(t *testing.T) {
+		c, err := newPresenceCipher(&backend.Backend{Config: &backend.Config{}}, project)
+		assert.NoError(t, err)
+		assert.Nil(t, c)
+	})
+}
@@ -20,13 +20,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
 )
 
 var (
@@ -53,7 +56,7 @@ func pushChanges(
 			cn.SetServerSeq(serverSeq)
 			pushedChanges = append(pushedChanges, cn)
 		} else {
-			logging.From(ctx).Warnf(
+			logging.FromModule(ctx, "packs").Warnf(
 				"change already pushed, clientSeq: %d, cp: %d",
 				cn.ID().ClientSeq(),
 				cp.ClientSeq,
@@ -64,7 +67,7 @@ func pushChanges(
 	}
 
 	if len(reqPack.Changes) > 0 {
-		logging.From(ctx).Infof(
+		logging.FromModule(ctx, "packs").Infof(
 			"PUSH: '%s' pushes %d changes into '%s', rejected %d changes, serverSeq: %d -> %d, cp: %s",
 			clientInfo.Key,
 			len(pushedChanges),
@@ -82,6 +85,7 @@ func pushChanges(
 func pullPack(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	clientInfo *database.ClientInfo,
 	docInfo *database.DocInfo,
 	reqPack *change.Pack,
@@ -107,11 +111,17 @@ func pullPack(
 		)
 	}
 
+	start := gotime.Now()
+	defer func() {
+		be.Metrics.ObservePushPullPullSeconds(gotime.Since(start).Seconds())
+	}()
+
 	// Pull changes from DB if the size of changes for the response is less than the snapshot threshold.
-	if initialServerSeq-reqPack.Checkpoint.ServerSeq < be.Config.SnapshotThreshold {
+	if initialServerSeq-reqPack.Checkpoint.ServerSeq < snapshotThreshold(be, project, docInfo) {
 		cpAfterPull, pulledChanges, err := pullChangeInfos(
 			ctx,
 			be,
+			project,
 			clientInfo,
 			docInfo,
 			reqPack,
@@ -122,16 +132,19 @@ func pullPack(
 			return nil, err
 		}
 
+		be.Metrics.AddPushPullPull(prometheus.PullTypeChanges, len(pulledChanges))
 		return NewServerPack(docInfo.Key, cpAfterPull, pulledChanges, nil), nil
 	}
 
-	return pullSnapshot(ctx, be, clientInfo, docInfo, reqPack, cpAfterPush, initialServerSeq)
+	be.Metrics.AddPushPullPull(prometheus.PullTypeSnapshot, 0)
+	return pullSnapshot(ctx, be, project, clientInfo, docInfo, reqPack, cpAfterPush, initialServerSeq)
 }
 
 // pullSnapshot pulls the snapshot from DB.
 func pullSnapshot(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	clientInfo *database.ClientInfo,
 	docInfo *database.DocInfo,
 	reqPack *change.Pack,
@@ -139,7 +152,7 @@ func pullSnapshot(
 	initialServerSeq int64,
 ) (*ServerPack, error) {
 	// Build document from DB if the size of changes for the response is greater than the snapshot threshold.
-	doc, err := BuildDocumentForServerSeq(ctx, be, docInfo, initialServerSeq)
+	doc, err := BuildDocumentForPull(ctx, be, docInfo, initialServerSeq)
 	if err != nil {
 		return nil, err
 	}
@@ -157,12 +170,22 @@ func pullSnapshot(
 	}
 	cpAfterPull := cpAfterPush.NextServerSeq(docInfo.ServerSeq)
 
-	snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+	// Remove the elements out of the subtree that the client subscribes to.
+	if err := document.PruneByPathFilter(doc.Root(), clientInfo.PathFilter(docInfo.ID)); err != nil {
+		return nil, err
+	}
+
+	presences, err := decryptPresences(ctx, be, project, doc.AllPresences())
 	if err != nil {
 		return nil, err
 	}
 
-	logging.From(ctx).Infof(
+	snapshot, err := converter.SnapshotToBytes(doc.RootObject(), presences)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromModule(ctx, "packs").Infof(
 		"PULL: '%s' build snapshot with changes(%d~%d) from '%s', cp: %s",
 		clientInfo.Key,
 		reqPack.Checkpoint.ServerSeq+1,
@@ -174,9 +197,92 @@ func pullSnapshot(
 	return NewServerPack(docInfo.Key, cpAfterPull, nil, snapshot), err
 }
 
+// BuildDocumentForPull returns a new document for the given serverSeq. The
+// document is restored from the snapshot cache if it has been built before,
+// and its snapshot is cached otherwise, so that the document is not rebuilt
+// from the stored snapshot and changes on every pull. The concurrent builds of
+// the same document at the same serverSeq, e.g. by the watchers evaluating
+// their queries on the same change, are done only once.
+func BuildDocumentForPull(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+) (*document.InternalDocument, error) {
+	cacheKey := backend.SnapshotCacheKey{
+		ProjectID: docInfo.ProjectID,
+		DocID:     docInfo.ID,
+		DocKey:    docInfo.Key,
+		ServerSeq: serverSeq,
+	}
+	if be.SnapshotCache != nil {
+		if info, ok := be.SnapshotCache.Get(cacheKey); ok {
+			be.Metrics.AddSnapshotCacheLookup(prometheus.SnapshotCacheHit)
+			return document.NewInternalDocumentFromSnapshot(
+				docInfo.Key,
+				info.ServerSeq,
+				info.Lamport,
+				info.Snapshot,
+			)
+		}
+		be.Metrics.AddSnapshotCacheLookup(prometheus.SnapshotCacheMiss)
+	}
+
+	// NOTE: The built document is shared as a snapshot, since each caller
+	// owns its document and may apply changes to it.
+	built, err, _ := be.SnapshotBuilds.Do(fmt.Sprintf("%s-%d", docInfo.ID, serverSeq), func() (interface{}, error) {
+		doc, err := BuildDocumentForServerSeq(ctx, be, docInfo, serverSeq)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+		if err != nil {
+			return nil, err
+		}
+		info := &database.SnapshotInfo{
+			DocID:     docInfo.ID,
+			ServerSeq: serverSeq,
+			Lamport:   doc.Lamport(),
+			Snapshot:  snapshot,
+			Size:      int64(len(snapshot)),
+			Elements:  int64(doc.Root().ElementMapLen()),
+		}
+		if be.SnapshotCache != nil {
+			be.SnapshotCache.Add(cacheKey, info, be.Config.ParseSnapshotCacheTTL())
+		}
+
+		return info, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info := built.(*database.SnapshotInfo)
+	return document.NewInternalDocumentFromSnapshot(
+		docInfo.Key,
+		info.ServerSeq,
+		info.Lamport,
+		info.Snapshot,
+	)
+}
+
+// invalidateSnapshotCache removes the cached snapshots of the given document,
+// which are superseded by the snapshot newly stored in the database.
+func invalidateSnapshotCache(be *backend.Backend, docID types.ID) {
+	if be.SnapshotCache == nil {
+		return
+	}
+
+	be.SnapshotCache.RemoveFunc(func(key backend.SnapshotCacheKey) bool {
+		return key.DocID == docID
+	})
+}
+
 func pullChangeInfos(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	clientInfo *database.ClientInfo,
 	docInfo *database.DocInfo,
 	reqPack *change.Pack,
@@ -200,18 +306,35 @@ func pullChangeInfos(
 	//
 	// See the following test case for more details:
 	//   "sync option with mixed mode test" in integration/client_test.go
+	//
+	// Changes that do not affect the subtree the client subscribes to are
+	// also removed. Presence-only changes are always delivered.
+	pathFilter := clientInfo.PathFilter(docInfo.ID)
+	affectedPaths, err := findAffectedPaths(ctx, be, docInfo, reqPack.Checkpoint.ServerSeq, pathFilter, pulledChanges)
+	if err != nil {
+		return change.InitialCheckpoint, nil, err
+	}
+
 	var filteredChanges []*database.ChangeInfo
-	for _, pulledChange := range pulledChanges {
+	for i, pulledChange := range pulledChanges {
 		if clientInfo.ID == pulledChange.ActorID && cpAfterPush.ClientSeq >= pulledChange.ClientSeq {
 			continue
 		}
+		if len(pulledChange.Operations) > 0 && !document.MatchPathFilter(pathFilter, affectedPaths[i]) {
+			continue
+		}
 		filteredChanges = append(filteredChanges, pulledChange)
 	}
 
+	filteredChanges, err = decryptChangeInfos(ctx, be, project, filteredChanges)
+	if err != nil {
+		return change.InitialCheckpoint, nil, err
+	}
+
 	cpAfterPull := cpAfterPush.NextServerSeq(docInfo.ServerSeq)
 
 	if len(pulledChanges) > 0 {
-		logging.From(ctx).Infof(
+		logging.FromModule(ctx, "packs").Infof(
 			"PULL: '%s' pulls %d changes(%d~%d) from '%s', cp: %s, filtered changes: %d",
 			clientInfo.Key,
 			len(pulledChanges),
@@ -225,3 +348,40 @@ func pullChangeInfos(
 
 	return cpAfterPull, filteredChanges, nil
 }
+
+// findAffectedPaths returns the paths of the elements affected by each of the
+// given changes pulled after the given server seq. The paths are computed by
+// replaying the changes on the document built at the server seq instead of
+// trusting the ones reported by the clients. It returns nil if the document is
+// not filtered by the given path filter.
+func findAffectedPaths(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+	pathFilter string,
+	changeInfos []*database.ChangeInfo,
+) ([][]string, error) {
+	if pathFilter == "" || pathFilter == "$" || len(changeInfos) == 0 {
+		return make([][]string, len(changeInfos)), nil
+	}
+
+	doc, err := BuildDocumentForPull(ctx, be, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	affectedPaths := make([][]string, len(changeInfos))
+	for i, changeInfo := range changeInfos {
+		c, err := changeInfo.ToChange()
+		if err != nil {
+			return nil, err
+		}
+
+		if affectedPaths[i], err = doc.ApplyChangeWithPaths(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return affectedPaths, nil
+}
@@ -0,0 +1,310 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+var (
+	// ErrUnrestorableElement is returned when the historical state of a
+	// document has an element that cannot be recreated by a change.
+	ErrUnrestorableElement = errors.New("unrestorable element")
+)
+
+// RestoreDocument reverts the given document to its state at the given server
+// seq. Instead of deleting the changes after the server seq, it stores a new
+// change that turns the current state into the historical one, so that the
+// history of the document is preserved. It returns the server seq of the
+// document after the restore and should be called under the pushpull lock.
+func RestoreDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+) (int64, error) {
+	if serverSeq < 0 || serverSeq > docInfo.ServerSeq {
+		return 0, fmt.Errorf(
+			"restore '%s' to %d of %d: %w",
+			docInfo.Key,
+			serverSeq,
+			docInfo.ServerSeq,
+			ErrInvalidServerSeq,
+		)
+	}
+
+	target, err := BuildDocumentForServerSeq(ctx, be, docInfo, serverSeq)
+	if err != nil {
+		return 0, err
+	}
+	current, err := BuildDocumentForServerSeq(ctx, be, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return 0, err
+	}
+	if target.Marshal() == current.Marshal() {
+		return docInfo.ServerSeq, nil
+	}
+
+	// NOTE: The lamport of the document built from a snapshot pack follows
+	// the server seq, so the lamport of the current state is given to make
+	// the restoring change win over the elements it replaces.
+	snapshot, err := converter.SnapshotToBytes(current.RootObject(), current.AllPresences())
+	if err != nil {
+		return 0, err
+	}
+	lamport := current.Lamport()
+	doc := document.New(docInfo.Key, document.WithLamportSource(
+		change.LamportSourceFunc(func(int64) int64 { return lamport + 1 }),
+	))
+	if err := doc.ApplyChangePack(change.NewPack(
+		docInfo.Key,
+		change.InitialCheckpoint.NextServerSeq(docInfo.ServerSeq),
+		nil,
+		snapshot,
+	)); err != nil {
+		return 0, err
+	}
+
+	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+		return restoreObject(root, target.RootObject())
+	}, fmt.Sprintf("restore to %d", serverSeq)); err != nil {
+		return 0, err
+	}
+
+	pack := doc.CreateChangePack()
+	initialServerSeq := docInfo.ServerSeq
+	for _, cn := range pack.Changes {
+		cn.SetServerSeq(docInfo.IncreaseServerSeq())
+	}
+	if err := be.DB.CreateChangeInfos(
+		ctx,
+		project.ID,
+		docInfo,
+		initialServerSeq,
+		pack.Changes,
+		false,
+	); err != nil {
+		return 0, err
+	}
+
+	return docInfo.ServerSeq, nil
+}
+
+// restoreObject makes the members of the given object the same as the members
+// of the given historical object. Members that have not been changed are kept
+// so that the concurrent edits on them are not lost.
+func restoreObject(obj *json.Object, from *crdt.Object) error {
+	members := from.Members()
+	for _, k := range sortedKeys(obj.Members()) {
+		if _, ok := members[k]; !ok {
+			obj.Delete(k)
+		}
+	}
+
+	for _, k := range sortedKeys(members) {
+		elem := members[k]
+		if curr := obj.Get(k); curr != nil && isSameElement(curr, elem) {
+			continue
+		}
+
+		switch elem := elem.(type) {
+		case *crdt.Primitive:
+			if err := setPrimitive(obj, k, elem); err != nil {
+				return err
+			}
+		case *crdt.Object:
+			if err := restoreObject(obj.SetNewObject(k, elem.Policy()), elem); err != nil {
+				return err
+			}
+		case *crdt.Array:
+			if err := restoreArray(obj.SetNewArray(k), elem); err != nil {
+				return err
+			}
+		case *crdt.Text:
+			restoreText(obj.SetNewText(k), elem)
+		case *crdt.Counter:
+			obj.SetNewCounter(k, elem.ValueType(), elem.Value())
+		case *crdt.Tree:
+			root := toTreeNode(elem.Root())
+			obj.SetNewTree(k, &root)
+		case *crdt.Set:
+			set := obj.SetNewSet(k)
+			for _, value := range elem.Values() {
+				set.Add(value.Value())
+			}
+		default:
+			return fmt.Errorf("%T of '%s': %w", elem, k, ErrUnrestorableElement)
+		}
+	}
+
+	return nil
+}
+
+// restoreArray adds the elements of the given historical array to the given
+// new array.
+func restoreArray(arr *json.Array, from *crdt.Array) error {
+	for _, elem := range from.Elements() {
+		switch elem := elem.(type) {
+		case *crdt.Primitive:
+			if err := addPrimitive(arr, elem); err != nil {
+				return err
+			}
+		case *crdt.Object:
+			if err := restoreObject(arr.AddNewObject(), elem); err != nil {
+				return err
+			}
+		case *crdt.Array:
+			if err := restoreArray(arr.AddNewArray(), elem); err != nil {
+				return err
+			}
+		default:
+			// NOTE: Texts, counters, trees and sets cannot be added to arrays by
+			// the JSON API yet, so they cannot be restored in arrays.
+			return fmt.Errorf("%T in array: %w", elem, ErrUnrestorableElement)
+		}
+	}
+
+	return nil
+}
+
+// restoreText inserts the live nodes of the given historical text into the
+// given new text with their attributes.
+func restoreText(text *json.Text, from *crdt.Text) {
+	pos := 0
+	for _, node := range from.Nodes() {
+		value := node.Value()
+		if node.RemovedAt() != nil || value.Len() == 0 {
+			continue
+		}
+
+		var attrs map[string]string
+		if value.Attrs() != nil {
+			attrs = value.Attrs().Elements()
+		}
+		text.Edit(pos, pos, value.Value(), attrs)
+		pos += value.Len()
+	}
+}
+
+// toTreeNode converts the given node of a historical tree into the node to
+// create a new tree with.
+func toTreeNode(node *crdt.TreeNode) json.TreeNode {
+	if node.IsText() {
+		return json.TreeNode{
+			Type:  node.Type(),
+			Value: node.Value,
+		}
+	}
+
+	treeNode := json.TreeNode{Type: node.Type()}
+	if node.Attrs != nil {
+		if attrs := node.Attrs.Elements(); len(attrs) > 0 {
+			treeNode.Attributes = attrs
+		}
+	}
+	for _, child := range node.IndexTreeNode.Children() {
+		if child.Value.IsText() && child.Value.Value == "" {
+			continue
+		}
+		treeNode.Children = append(treeNode.Children, toTreeNode(child.Value))
+	}
+
+	return treeNode
+}
+
+// setPrimitive sets the value of the given primitive to the given key.
+func setPrimitive(obj *json.Object, k string, p *crdt.Primitive) error {
+	switch p.ValueType() {
+	case crdt.Null:
+		obj.SetNull(k)
+	case crdt.Boolean:
+		obj.SetBool(k, p.Value().(bool))
+	case crdt.Integer:
+		obj.SetInteger(k, int(p.Value().(int32)))
+	case crdt.Long:
+		obj.SetLong(k, p.Value().(int64))
+	case crdt.Double:
+		obj.SetDouble(k, p.Value().(float64))
+	case crdt.String:
+		obj.SetString(k, p.Value().(string))
+	case crdt.Bytes:
+		obj.SetBytes(k, p.Value().([]byte))
+	case crdt.Date:
+		obj.SetDate(k, p.Value().(gotime.Time))
+	default:
+		return fmt.Errorf("primitive of '%s': %w", k, ErrUnrestorableElement)
+	}
+
+	return nil
+}
+
+// addPrimitive adds the value of the given primitive to the given array.
+func addPrimitive(arr *json.Array, p *crdt.Primitive) error {
+	switch p.ValueType() {
+	case crdt.Null:
+		arr.AddNull()
+	case crdt.Boolean:
+		arr.AddBool(p.Value().(bool))
+	case crdt.Integer:
+		arr.AddInteger(int(p.Value().(int32)))
+	case crdt.Long:
+		arr.AddLong(p.Value().(int64))
+	case crdt.Double:
+		arr.AddDouble(p.Value().(float64))
+	case crdt.String:
+		arr.AddString(p.Value().(string))
+	case crdt.Bytes:
+		arr.AddBytes(p.Value().([]byte))
+	case crdt.Date:
+		arr.AddDate(p.Value().(gotime.Time))
+	default:
+		return fmt.Errorf("primitive in array: %w", ErrUnrestorableElement)
+	}
+
+	return nil
+}
+
+// isSameElement returns whether the given elements have the same type and
+// the same content.
+func isSameElement(a, b crdt.Element) bool {
+	return fmt.Sprintf("%T", a) == fmt.Sprintf("%T", b) && a.Marshal() == b.Marshal()
+}
+
+// sortedKeys returns the keys of the given members in order.
+func sortedKeys(members map[string]crdt.Element) []string {
+	keys := make([]string, 0, len(members))
+	for k := range members {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -19,30 +19,41 @@ package packs
 import (
 	"context"
 
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/eventbus"
 	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/server/webhook"
 )
 
+// storeSnapshot stores the snapshot of the given document after purging the
+// garbage removed before the given min synced ticket, and returns the number of
+// the purged elements. Unless forced, the snapshot is stored only if the
+// changes since the last snapshot reach the snapshot interval.
 func storeSnapshot(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	docInfo *database.DocInfo,
 	minSyncedTicket *time.Ticket,
-) error {
+	force bool,
+) (int, error) {
 	// 01. get the closest snapshot's metadata of this docInfo
 	snapshotMetadata, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq, false)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if snapshotMetadata.ServerSeq == docInfo.ServerSeq {
-		return nil
+		return 0, nil
 	}
-	if docInfo.ServerSeq-snapshotMetadata.ServerSeq < be.Config.SnapshotInterval {
-		return nil
+	if !force && docInfo.ServerSeq-snapshotMetadata.ServerSeq < snapshotInterval(be, project, docInfo) {
+		return 0, nil
 	}
 
 	// 02. retrieve the changes between last snapshot and current docInfo
@@ -53,7 +64,7 @@ func storeSnapshot(
 		docInfo.ServerSeq,
 	)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	// 03. create document instance of the docInfo
@@ -61,7 +72,7 @@ func storeSnapshot(
 	if snapshotMetadata.ID != "" {
 		snapshotInfo, err = be.DB.FindSnapshotInfoByID(ctx, snapshotInfo.ID)
 		if err != nil {
-			return err
+			return 0, err
 		}
 	}
 
@@ -72,7 +83,7 @@ func storeSnapshot(
 		snapshotInfo.Snapshot,
 	)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	pack := change.NewPack(
@@ -81,16 +92,57 @@ func storeSnapshot(
 		changes,
 		nil,
 	)
-	pack.MinSyncedTicket = minSyncedTicket
-
 	if err := doc.ApplyChangePack(pack); err != nil {
-		return err
+		return 0, err
+	}
+
+	// NOTE: The garbage is collected apart from applying the pack to count
+	// the purged elements.
+	removed, err := doc.GarbageCollect(minSyncedTicket)
+	if err != nil {
+		return 0, err
+	}
+	trigger := prometheus.GCTriggerSnapshot
+	if force {
+		trigger = prometheus.GCTriggerManual
 	}
+	be.Metrics.AddGCRemovedElements(trigger, removed)
 
 	// 04. save the snapshot of the docInfo
-	if err := be.DB.CreateSnapshotInfo(ctx, docInfo.ID, doc); err != nil {
-		return err
+	snapshot, err := converter.EncodeSnapshot(doc.RootObject(), doc.AllPresences(), be.Config.SnapshotCodec)
+	if err != nil {
+		return 0, err
 	}
+	if err := be.DB.CreateSnapshotInfo(ctx, docInfo.ID, doc, snapshot); err != nil {
+		return 0, err
+	}
+	invalidateSnapshotCache(be, docInfo.ID)
+
+	if project.DocumentSizeSoftLimit > 0 {
+		created, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq, false)
+		if err != nil {
+			return 0, err
+		}
+		warnSoftLimit(
+			ctx,
+			be,
+			project,
+			docInfo,
+			types.DocumentSizeLimit,
+			project.DocumentSizeSoftLimit,
+			snapshotMetadata.Size,
+			created.Size,
+		)
+	}
+
+	be.EventBus.Publish(eventbus.Event{
+		Type:        eventbus.SnapshotCreated,
+		ProjectID:   docInfo.ProjectID,
+		DocumentID:  docInfo.ID,
+		DocumentKey: docInfo.Key,
+		ServerSeq:   doc.Checkpoint().ServerSeq,
+	})
+	webhook.SendSnapshotEvent(be, project, docInfo, doc.Checkpoint().ServerSeq)
 
 	// 05. delete changes before the smallest in `syncedseqs` to save storage.
 	if be.Config.SnapshotWithPurgingChanges {
@@ -98,14 +150,42 @@ func storeSnapshot(
 			ctx,
 			docInfo.ID,
 		); err != nil {
-			logging.From(ctx).Error(err)
+			logging.FromModule(ctx, "packs").Error(err)
 		}
 	}
 
-	logging.From(ctx).Infof(
-		"SNAP: '%s', serverSeq: %d",
+	logging.FromModule(ctx, "packs").Infof(
+		"SNAP: '%s', serverSeq: %d, removed: %d",
 		docInfo.Key,
 		doc.Checkpoint().ServerSeq,
+		removed,
 	)
-	return nil
+	return removed, nil
+}
+
+// snapshotThreshold returns the number of changes to pull over which the
+// snapshot of the given document is sent instead. The threshold of the
+// document overrides the one of its project, which overrides the one of the
+// server.
+func snapshotThreshold(be *backend.Backend, project *types.Project, docInfo *database.DocInfo) int64 {
+	if docInfo.SnapshotThreshold > 0 {
+		return docInfo.SnapshotThreshold
+	}
+	if project.SnapshotThreshold > 0 {
+		return project.SnapshotThreshold
+	}
+	return be.Config.SnapshotThreshold
+}
+
+// snapshotInterval returns the number of changes between the snapshots of the
+// given document. The interval of the document overrides the one of its
+// project, which overrides the one of the server.
+func snapshotInterval(be *backend.Backend, project *types.Project, docInfo *database.DocInfo) int64 {
+	if docInfo.SnapshotInterval > 0 {
+		return docInfo.SnapshotInterval
+	}
+	if project.SnapshotInterval > 0 {
+		return project.SnapshotInterval
+	}
+	return be.Config.SnapshotInterval
 }
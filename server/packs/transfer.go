@@ -0,0 +1,232 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+var (
+	// ErrDocumentAlreadyExists is returned when a document is imported to the
+	// key of a document that already has contents.
+	ErrDocumentAlreadyExists = errors.New("document already exists")
+
+	// ErrInvalidExport is returned when the changes of an export do not build
+	// the snapshot of the export.
+	ErrInvalidExport = errors.New("invalid export")
+)
+
+// DocumentExport is the dump of a document to load it into another cluster.
+type DocumentExport struct {
+	// ServerSeq is the server seq of the document when it is exported.
+	ServerSeq int64
+
+	// Snapshot is the encoded snapshot of the document at the server seq.
+	Snapshot []byte
+
+	// Changes are the changes of the document from the first one to the
+	// server seq. It is empty if the history is not exported.
+	Changes []*change.Change
+}
+
+// ExportDocument dumps the state of the given document at the given server
+// seq. If includeChanges is true, the change log of the document up to the
+// server seq is dumped together, which fails with ErrChangeLogIncomplete if
+// the changes are purged.
+func ExportDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+	includeChanges bool,
+) (*DocumentExport, error) {
+	if serverSeq < 0 || serverSeq > docInfo.ServerSeq {
+		return nil, fmt.Errorf(
+			"export '%s' at %d of %d: %w",
+			docInfo.Key,
+			serverSeq,
+			docInfo.ServerSeq,
+			ErrInvalidServerSeq,
+		)
+	}
+
+	doc, err := BuildDocumentForServerSeq(ctx, be, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The snapshot is encoded in the default codec rather than the codec
+	// of the server, so that it can be loaded by clusters with other codecs.
+	snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+	if err != nil {
+		return nil, err
+	}
+
+	export := &DocumentExport{
+		ServerSeq: serverSeq,
+		Snapshot:  snapshot,
+	}
+	if !includeChanges || serverSeq == 0 {
+		return export, nil
+	}
+
+	changes, err := be.DB.FindChangesBetweenServerSeqs(ctx, docInfo.ID, 1, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(changes)) != serverSeq {
+		return nil, fmt.Errorf(
+			"export '%s' with %d of %d changes: %w",
+			docInfo.Key,
+			len(changes),
+			serverSeq,
+			ErrChangeLogIncomplete,
+		)
+	}
+	export.Changes = changes
+
+	return export, nil
+}
+
+// ImportDocument creates the document of the given key from the given export
+// and returns the info of the imported document. If the export has the
+// changes, they are stored as they are so that the history is kept.
+// Otherwise, the snapshot is stored as a single change. It should be called
+// under the pushpull lock.
+func ImportDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docKey key.Key,
+	export *DocumentExport,
+) (*database.DocInfo, error) {
+	snapshotDoc, err := document.NewInternalDocumentFromSnapshot(docKey, 0, 0, export.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := export.Changes
+	if len(changes) > 0 {
+		if int64(len(changes)) != export.ServerSeq {
+			return nil, fmt.Errorf(
+				"import '%s' with %d of %d changes: %w",
+				docKey,
+				len(changes),
+				export.ServerSeq,
+				ErrInvalidExport,
+			)
+		}
+		if err := verifyExport(docKey, snapshotDoc, changes); err != nil {
+			return nil, err
+		}
+	} else if changes, err = changesFromSnapshot(docKey, snapshotDoc); err != nil {
+		return nil, err
+	}
+
+	// NOTE: Imported documents are not created by any client, so the initial
+	// actor is recorded as their owner.
+	ownerID := types.ID(time.InitialActorID.String())
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(ctx, project.ID, ownerID, docKey, false)
+	if err != nil && !errors.Is(err, database.ErrDocumentNotFound) {
+		return nil, err
+	}
+	if err == nil && !docInfo.IsRemoved() && docInfo.ServerSeq > 0 {
+		return nil, fmt.Errorf("import '%s': %w", docKey, ErrDocumentAlreadyExists)
+	}
+	if docInfo, err = be.DB.FindDocInfoByKeyAndOwner(ctx, project.ID, ownerID, docKey, true); err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return docInfo, nil
+	}
+
+	initialServerSeq := docInfo.ServerSeq
+	for _, cn := range changes {
+		cn.SetServerSeq(docInfo.IncreaseServerSeq())
+	}
+	if err := be.DB.CreateChangeInfos(
+		ctx,
+		project.ID,
+		docInfo,
+		initialServerSeq,
+		changes,
+		false,
+	); err != nil {
+		return nil, err
+	}
+
+	// NOTE: The snapshot is stored right away so that attaching the imported
+	// document does not replay the whole history.
+	if _, err := storeSnapshot(ctx, be, project, docInfo, time.InitialTicket, true); err != nil {
+		return nil, err
+	}
+
+	return docInfo, nil
+}
+
+// verifyExport checks that the given changes build the same document as the
+// given snapshot.
+func verifyExport(
+	docKey key.Key,
+	snapshotDoc *document.InternalDocument,
+	changes []*change.Change,
+) error {
+	doc := document.NewInternalDocument(docKey)
+	if err := doc.ApplyChangePack(change.NewPack(
+		docKey,
+		change.InitialCheckpoint.NextServerSeq(int64(len(changes))),
+		changes,
+		nil,
+	)); err != nil {
+		return err
+	}
+
+	if doc.Marshal() != snapshotDoc.Marshal() {
+		return fmt.Errorf("import '%s': changes mismatch snapshot: %w", docKey, ErrInvalidExport)
+	}
+
+	return nil
+}
+
+// changesFromSnapshot returns the changes that create the contents of the
+// given document built from a snapshot.
+func changesFromSnapshot(
+	docKey key.Key,
+	snapshotDoc *document.InternalDocument,
+) ([]*change.Change, error) {
+	doc := document.New(docKey)
+	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+		return restoreObject(root, snapshotDoc.RootObject())
+	}, "import"); err != nil {
+		return nil, err
+	}
+
+	return doc.CreateChangePack().Changes, nil
+}
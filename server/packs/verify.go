@@ -0,0 +1,93 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+var (
+	// ErrChangeLogIncomplete is returned when the change log of a document
+	// does not contain all changes, e.g. when changes are purged.
+	ErrChangeLogIncomplete = errors.New("change log incomplete")
+)
+
+// BuildDocumentFromChangeLog returns a new document that is rebuilt purely from
+// the change log of the given docInfo up to the given serverSeq, without using
+// any snapshots.
+func BuildDocumentFromChangeLog(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+) (*document.InternalDocument, error) {
+	doc := document.NewInternalDocument(docInfo.Key)
+	if _, err := applyChangesInBatches(ctx, be, doc, docInfo, 1, serverSeq); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// VerifyDocument rebuilds the given document from its change log and compares
+// the result against the latest stored snapshot by content hash.
+func VerifyDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+) (*types.DocumentVerification, error) {
+	snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq, true)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotDoc, err := document.NewInternalDocumentFromSnapshot(
+		docInfo.Key,
+		snapshotInfo.ServerSeq,
+		snapshotInfo.Lamport,
+		snapshotInfo.Snapshot,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rebuiltDoc, err := BuildDocumentFromChangeLog(ctx, be, docInfo, snapshotInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentVerification{
+		SnapshotServerSeq: snapshotInfo.ServerSeq,
+		SnapshotHash:      contentHash(snapshotDoc.RootObject()),
+		RebuiltHash:       contentHash(rebuiltDoc.RootObject()),
+	}, nil
+}
+
+// contentHash returns the hash of the JSON encoding of the given root.
+func contentHash(root *crdt.Object) string {
+	sum := sha256.Sum256([]byte(root.Marshal()))
+	return hex.EncodeToString(sum[:])
+}
@@ -20,6 +20,8 @@ package profiling
 import (
 	"errors"
 	"fmt"
+
+	"github.com/yorkie-team/yorkie/server/listener"
 )
 
 var (
@@ -31,6 +33,10 @@ var (
 type Config struct {
 	Port        int  `yaml:"Port"`
 	EnablePprof bool `yaml:"EnablePprof"`
+
+	// Addresses are the addresses that the profiling server listens on
+	// instead of Port, such as "127.0.0.1:11102" or "unix:/tmp/profiling.sock".
+	Addresses []string `yaml:"Addresses"`
 }
 
 // Validate validates the port number.
@@ -39,5 +45,11 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("must be between 1 and 65535, given %d: %w", c.Port, ErrInvalidProfilingPort)
 	}
 
+	for _, address := range c.Addresses {
+		if err := listener.Validate(address); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
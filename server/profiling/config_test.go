@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/server/listener"
 	"github.com/yorkie-team/yorkie/server/profiling"
 )
 
@@ -32,6 +33,14 @@ func TestConfig(t *testing.T) {
 		{config: &profiling.Config{Port: -1}, expected: profiling.ErrInvalidProfilingPort},
 		{config: &profiling.Config{Port: 0}, expected: profiling.ErrInvalidProfilingPort},
 		{config: &profiling.Config{Port: 11102}, expected: nil},
+		{
+			config:   &profiling.Config{Port: 11102, Addresses: []string{"127.0.0.1"}},
+			expected: listener.ErrInvalidAddress,
+		},
+		{
+			config:   &profiling.Config{Port: 11102, Addresses: []string{"[::1]:11102", "unix:/tmp/profiling.sock"}},
+			expected: nil,
+		},
 	}
 	for _, scenario := range scenarios {
 		assert.ErrorIs(t, scenario.config.Validate(), scenario.expected, "provided config: %#v", scenario.config)
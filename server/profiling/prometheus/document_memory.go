@@ -0,0 +1,70 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package prometheus
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+const documentIDLabel = "document_id"
+
+// documentMemoryCollector collects the approximate memory of the documents
+// that the server holds the most memory for when the metrics are scraped.
+type documentMemoryCollector struct {
+	desc     *prometheus.Desc
+	memories func() []*types.DocumentMemory
+}
+
+// Describe sends the descriptor of the document memory to the given channel.
+func (c *documentMemoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect sends the document memory of each document to the given channel.
+func (c *documentMemoryCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, memory := range c.memories() {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(memory.Bytes()),
+			memory.ID.String(),
+		)
+	}
+}
+
+// RegisterDocumentMemories registers the given function that returns the
+// documents that the server holds the most memory for. The bytes of them are
+// collected as gauges.
+func (m *Metrics) RegisterDocumentMemories(memories func() []*types.DocumentMemory) error {
+	if err := m.registry.Register(&documentMemoryCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "document", "memory_bytes"),
+			"The approximate bytes that the server holds for the document.",
+			[]string{documentIDLabel},
+			nil,
+		),
+		memories: memories,
+	}); err != nil {
+		return fmt.Errorf("register document memory collector: %w", err)
+	}
+
+	return nil
+}
@@ -38,6 +38,51 @@ const (
 	projectIDLabel   = "project_id"
 	projectNameLabel = "project_name"
 	hostnameLabel    = "hostname"
+	resultLabel      = "result"
+	limitLabel       = "limit"
+	levelLabel       = "level"
+	compressorLabel  = "compressor"
+	triggerLabel     = "trigger"
+	pullTypeLabel    = "pull_type"
+)
+
+// The values below are the levels of the limits of documents.
+const (
+	LimitSoft = "soft"
+	LimitHard = "hard"
+)
+
+// The values below are the results of verifying a document by the
+// verification service.
+const (
+	VerificationPassed   = "passed"
+	VerificationDiverged = "diverged"
+	VerificationSkipped  = "skipped"
+)
+
+// The values below are the results of checking the versions of SDKs against
+// the minimum and the recommended versions.
+const (
+	SDKVersionRejected   = "rejected"
+	SDKVersionDeprecated = "deprecated"
+)
+
+// The values below are the types of the packs pulled by PushPull.
+const (
+	PullTypeChanges  = "changes"
+	PullTypeSnapshot = "snapshot"
+)
+
+// The values below are the results of looking up the snapshot cache.
+const (
+	SnapshotCacheHit  = "hit"
+	SnapshotCacheMiss = "miss"
+)
+
+// The values below are the triggers of the garbage collection of documents.
+const (
+	GCTriggerSnapshot = "snapshot"
+	GCTriggerManual   = "manual"
 )
 
 var (
@@ -62,8 +107,27 @@ type Metrics struct {
 	pushPullSentOperationsTotal     prometheus.Counter
 	pushPullSnapshotDurationSeconds prometheus.Histogram
 	pushPullSnapshotBytesTotal      prometheus.Counter
+	pushPullPushSeconds             prometheus.Histogram
+	pushPullPullSeconds             prometheus.Histogram
+	pushPullPulledChanges           prometheus.Histogram
+	pushPullPullsTotal              *prometheus.CounterVec
+	pushPullDuplicateChangesTotal   prometheus.Counter
+
+	snapshotCacheLookupsTotal *prometheus.CounterVec
+
+	userAgentTotal        *prometheus.CounterVec
+	sdkVersionChecksTotal *prometheus.CounterVec
+
+	verificationDocumentsTotal *prometheus.CounterVec
 
-	userAgentTotal *prometheus.CounterVec
+	documentLimitsTotal *prometheus.CounterVec
+
+	gcRunsTotal            *prometheus.CounterVec
+	gcRemovedElementsTotal *prometheus.CounterVec
+
+	rpcCompressionRatio       *prometheus.HistogramVec
+	rpcUncompressedBytesTotal *prometheus.CounterVec
+	rpcCompressedBytesTotal   *prometheus.CounterVec
 }
 
 // NewMetrics creates a new instance of Metrics.
@@ -135,6 +199,43 @@ func NewMetrics() (*Metrics, error) {
 			Name:      "snapshot_bytes_total",
 			Help:      "The total bytes of snapshots for response packs in PushPull.",
 		}),
+		pushPullPushSeconds: promauto.With(reg).NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "push_seconds",
+			Help:      "The time to push and store the changes of request packs in PushPull.",
+		}),
+		pushPullPullSeconds: promauto.With(reg).NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "pull_seconds",
+			Help:      "The time to pull the changes or the snapshot of response packs in PushPull.",
+		}),
+		pushPullPulledChanges: promauto.With(reg).NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "pulled_changes",
+			Help:      "The count of changes pulled without a snapshot in PushPull.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+		}),
+		pushPullPullsTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "pulls_total",
+			Help:      "The total count of pulls in PushPull by whether they send changes or a snapshot.",
+		}, []string{pullTypeLabel}),
+		pushPullDuplicateChangesTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "duplicate_changes_total",
+			Help:      "The total count of changes in request packs rejected as already stored in PushPull.",
+		}),
+		snapshotCacheLookupsTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "snapshot_cache",
+			Name:      "lookups_total",
+			Help:      "The total count of lookups of the snapshot cache by their results.",
+		}, []string{resultLabel}),
 		userAgentTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: "user_agent",
@@ -148,6 +249,64 @@ func NewMetrics() (*Metrics, error) {
 			projectNameLabel,
 			hostnameLabel,
 		}),
+		sdkVersionChecksTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "sdk_version",
+			Name:      "checks_total",
+			Help:      "The total count of requests from SDKs below the minimum or the recommended version.",
+		}, []string{
+			sdkTypeLabel,
+			sdkVersionLabel,
+			resultLabel,
+		}),
+		verificationDocumentsTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "verification",
+			Name:      "documents_total",
+			Help:      "The total count of documents verified against their change logs.",
+		}, []string{resultLabel}),
+		documentLimitsTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "document",
+			Name:      "limits_total",
+			Help:      "The total count of documents that reached the limits of their projects.",
+		}, []string{
+			projectIDLabel,
+			projectNameLabel,
+			limitLabel,
+			levelLabel,
+		}),
+		gcRunsTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gc",
+			Name:      "runs_total",
+			Help:      "The total count of garbage collections of documents by their triggers.",
+		}, []string{triggerLabel}),
+		gcRemovedElementsTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gc",
+			Name:      "removed_elements_total",
+			Help:      "The total count of removed elements purged by garbage collections.",
+		}, []string{triggerLabel}),
+		rpcCompressionRatio: promauto.With(reg).NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "rpc",
+			Name:      "compression_ratio",
+			Help:      "The ratio of the compressed size to the uncompressed size of messages.",
+			Buckets:   prometheus.LinearBuckets(0.1, 0.1, 10),
+		}, []string{compressorLabel}),
+		rpcUncompressedBytesTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rpc",
+			Name:      "uncompressed_bytes_total",
+			Help:      "The total bytes of messages before they are compressed.",
+		}, []string{compressorLabel}),
+		rpcCompressedBytesTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rpc",
+			Name:      "compressed_bytes_total",
+			Help:      "The total bytes of messages after they are compressed.",
+		}, []string{compressorLabel}),
 	}
 
 	metrics.serverVersion.With(prometheus.Labels{
@@ -198,6 +357,41 @@ func (m *Metrics) AddPushPullSnapshotBytes(bytes int) {
 	m.pushPullSnapshotBytesTotal.Add(float64(bytes))
 }
 
+// ObservePushPullPushSeconds adds an observation for the time to push and
+// store the changes of the request pack.
+func (m *Metrics) ObservePushPullPushSeconds(seconds float64) {
+	m.pushPullPushSeconds.Observe(seconds)
+}
+
+// ObservePushPullPullSeconds adds an observation for the time to pull the
+// response pack.
+func (m *Metrics) ObservePushPullPullSeconds(seconds float64) {
+	m.pushPullPullSeconds.Observe(seconds)
+}
+
+// AddPushPullPull adds a pull of the given type. The changes are observed only
+// for the pulls of changes since a snapshot replaces them.
+func (m *Metrics) AddPushPullPull(pullType string, changes int) {
+	m.pushPullPullsTotal.With(prometheus.Labels{pullTypeLabel: pullType}).Inc()
+	if pullType == PullTypeChanges {
+		m.pushPullPulledChanges.Observe(float64(changes))
+	}
+}
+
+// AddPushPullDuplicateChanges adds the number of changes in the request pack
+// that are rejected since they are already stored.
+func (m *Metrics) AddPushPullDuplicateChanges(count int) {
+	m.pushPullDuplicateChangesTotal.Add(float64(count))
+}
+
+// AddSnapshotCacheLookup adds the count of lookups of the snapshot cache with
+// the given result.
+func (m *Metrics) AddSnapshotCacheLookup(result string) {
+	m.snapshotCacheLookupsTotal.With(prometheus.Labels{
+		resultLabel: result,
+	}).Inc()
+}
+
 // AddUserAgent adds the number of user agent.
 func (m *Metrics) AddUserAgent(
 	hostname string,
@@ -220,6 +414,54 @@ func (m *Metrics) AddUserAgentWithEmptyProject(hostname string, sdkType, sdkVers
 	m.AddUserAgent(hostname, emptyProject, sdkType, sdkVersion, methodName)
 }
 
+// AddSDKVersionCheck adds a request from the SDK of the given type and version
+// that is rejected or deprecated by the version check.
+func (m *Metrics) AddSDKVersionCheck(sdkType, sdkVersion, result string) {
+	m.sdkVersionChecksTotal.With(prometheus.Labels{
+		sdkTypeLabel:    sdkType,
+		sdkVersionLabel: sdkVersion,
+		resultLabel:     result,
+	}).Inc()
+}
+
+// AddVerifiedDocument adds the count of documents verified with the given
+// result.
+func (m *Metrics) AddVerifiedDocument(result string) {
+	m.verificationDocumentsTotal.With(prometheus.Labels{
+		resultLabel: result,
+	}).Inc()
+}
+
+// AddDocumentLimit adds the count of documents that reached the given limit of
+// the project at the given level.
+func (m *Metrics) AddDocumentLimit(project *types.Project, limit, level string) {
+	m.documentLimitsTotal.With(prometheus.Labels{
+		projectIDLabel:   project.ID.String(),
+		projectNameLabel: project.Name,
+		limitLabel:       limit,
+		levelLabel:       level,
+	}).Inc()
+}
+
+// AddGCRemovedElements adds a garbage collection by the given trigger and the
+// count of removed elements purged by it.
+func (m *Metrics) AddGCRemovedElements(trigger string, count int) {
+	labels := prometheus.Labels{triggerLabel: trigger}
+	m.gcRunsTotal.With(labels).Inc()
+	m.gcRemovedElementsTotal.With(labels).Add(float64(count))
+}
+
+// ObserveCompression adds an observation of a message compressed by the given
+// compressor.
+func (m *Metrics) ObserveCompression(compressor string, uncompressed, compressed int) {
+	labels := prometheus.Labels{compressorLabel: compressor}
+	if uncompressed > 0 {
+		m.rpcCompressionRatio.With(labels).Observe(float64(compressed) / float64(uncompressed))
+	}
+	m.rpcUncompressedBytesTotal.With(labels).Add(float64(uncompressed))
+	m.rpcCompressedBytesTotal.With(labels).Add(float64(compressed))
+}
+
 // RegisterGRPCServer registers the given gRPC server.
 func (m *Metrics) RegisterGRPCServer(server *grpc.Server) {
 	m.serverMetrics.InitializeMetrics(server)
@@ -18,12 +18,13 @@ package profiling
 
 import (
 	"context"
-	"fmt"
+	"net"
 	"net/http"
 	"net/http/pprof"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	"github.com/yorkie-team/yorkie/server/listener"
 	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
 )
@@ -61,7 +62,7 @@ func NewServer(conf *Config, metrics *prometheus.Metrics) *Server {
 	return &Server{
 		conf:       conf,
 		serveMux:   serveMux,
-		httpServer: &http.Server{Addr: fmt.Sprintf(":%d", conf.Port)},
+		httpServer: &http.Server{Handler: serveMux},
 	}
 }
 
@@ -90,12 +91,19 @@ func (s *Server) Shutdown(graceful bool) {
 }
 
 func (s *Server) listenAndServe() error {
-	go func() {
-		logging.DefaultLogger().Infof(fmt.Sprintf("serving profiling on %d", s.conf.Port))
-		s.httpServer.Handler = s.serveMux
-		if err := s.httpServer.ListenAndServe(); err != http.ErrServerClosed {
-			logging.DefaultLogger().Errorf("HTTP server ListenAndServe: %v", err)
-		}
-	}()
+	addresses := listener.Addresses(s.conf.Addresses, s.conf.Port)
+	listeners, err := listener.ListenAll(addresses)
+	if err != nil {
+		return err
+	}
+
+	for i, lis := range listeners {
+		go func(address string, lis net.Listener) {
+			logging.DefaultLogger().Infof("serving profiling on %s", address)
+			if err := s.httpServer.Serve(lis); err != http.ErrServerClosed {
+				logging.DefaultLogger().Errorf("HTTP server Serve: %v", err)
+			}
+		}(addresses[i], lis)
+	}
 	return nil
 }
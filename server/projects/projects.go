@@ -19,12 +19,19 @@ package projects
 
 import (
 	"context"
+	"errors"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 )
 
+var (
+	// ErrPresenceEncryptionKeyRequired is returned when sensitive presence
+	// keys are set but the server has no key to encrypt their values.
+	ErrPresenceEncryptionKeyRequired = errors.New("presence encryption key required")
+)
+
 // CreateProject creates a project.
 func CreateProject(
 	ctx context.Context,
@@ -82,6 +89,12 @@ func UpdateProject(
 	id types.ID,
 	fields *types.UpdatableProjectFields,
 ) (*types.Project, error) {
+	if fields.SensitivePresenceKeys != nil &&
+		len(*fields.SensitivePresenceKeys) > 0 &&
+		be.Config.PresenceEncryptionKey == "" {
+		return nil, ErrPresenceEncryptionKeyRequired
+	}
+
 	info, err := be.DB.UpdateProjectInfo(ctx, owner, id, fields)
 	if err != nil {
 		return nil, err
@@ -17,7 +17,9 @@
 package rpc
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/yorkie-team/yorkie/api/converter"
@@ -26,15 +28,22 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/backups"
+	"github.com/yorkie-team/yorkie/server/clients"
 	"github.com/yorkie-team/yorkie/server/documents"
 	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/server/packs"
 	"github.com/yorkie-team/yorkie/server/projects"
 	"github.com/yorkie-team/yorkie/server/rpc/auth"
 	"github.com/yorkie-team/yorkie/server/users"
+	"github.com/yorkie-team/yorkie/server/webhook"
 )
 
+// backupChunkSize is the size of the chunks that a backup is streamed in.
+const backupChunkSize = 64 * 1024
+
 type adminServer struct {
 	backend      *backend.Backend
 	tokenManager *auth.TokenManager
@@ -276,10 +285,29 @@ func (s *adminServer) ListDocuments(
 		return nil, err
 	}
 
+	selector, err := types.ParseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	updatedAfter, err := converter.FromOptionalTimestamp(req.UpdatedAfter)
+	if err != nil {
+		return nil, err
+	}
+	updatedBefore, err := converter.FromOptionalTimestamp(req.UpdatedBefore)
+	if err != nil {
+		return nil, err
+	}
+
 	docs, err := documents.ListDocumentSummaries(
 		ctx,
 		s.backend,
 		project,
+		types.DocumentFilter{
+			Labels:        selector,
+			KeyPrefix:     req.KeyPrefix,
+			UpdatedAfter:  updatedAfter,
+			UpdatedBefore: updatedBefore,
+		},
 		types.Paging[types.ID]{
 			Offset:    types.ID(req.PreviousId),
 			PageSize:  int(req.PageSize),
@@ -301,6 +329,41 @@ func (s *adminServer) ListDocuments(
 	}, nil
 }
 
+// ListClients lists clients.
+func (s *adminServer) ListClients(
+	ctx context.Context,
+	req *api.ListClientsRequest,
+) (*api.ListClientsResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := clients.ListClientSummaries(
+		ctx,
+		s.backend.DB,
+		project,
+		types.Paging[types.ID]{
+			Offset:    types.ID(req.PreviousId),
+			PageSize:  int(req.PageSize),
+			IsForward: req.IsForward,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbClients, err := converter.ToClientSummaries(summaries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListClientsResponse{
+		Clients: pbClients,
+	}, nil
+}
+
 // SearchDocuments searches documents for a specified string.
 func (s *adminServer) SearchDocuments(
 	ctx context.Context,
@@ -350,14 +413,72 @@ func (s *adminServer) RemoveDocumentByAdmin(
 		return nil, err
 	}
 
+	if err := s.removeDocument(ctx, project, docInfo, req.Force); err != nil {
+		return nil, err
+	}
+
+	return &api.RemoveDocumentByAdminResponse{}, nil
+}
+
+// RemoveDocumentsByAdmin removes the documents that match the given label
+// selector. Documents attached to clients are skipped unless force is set.
+func (s *adminServer) RemoveDocumentsByAdmin(
+	ctx context.Context,
+	req *api.RemoveDocumentsByAdminRequest,
+) (*api.RemoveDocumentsByAdminResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := types.ParseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	// NOTE: An empty selector matches every document, so it is rejected to
+	// prevent removing all the documents of the project by mistake.
+	if selector.IsEmpty() {
+		return nil, fmt.Errorf("selector is empty: %w", types.ErrInvalidLabelSelector)
+	}
+
+	docInfos, err := documents.FindDocInfosByLabels(ctx, s.backend, project, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var removedKeys []string
+	for _, docInfo := range docInfos {
+		if err := s.removeDocument(ctx, project, docInfo, req.Force); err != nil {
+			if errors.Is(err, documents.ErrDocumentAttached) {
+				continue
+			}
+			return nil, err
+		}
+		removedKeys = append(removedKeys, docInfo.Key.String())
+	}
+
+	return &api.RemoveDocumentsByAdminResponse{
+		DocumentKeys: removedKeys,
+	}, nil
+}
+
+// removeDocument removes the given document under the lock of the document
+// and notifies the watchers of the document.
+func (s *adminServer) removeDocument(
+	ctx context.Context,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	force bool,
+) error {
 	// TODO(hackerwins): Rename PushPullKey to something else like DocWriteLockKey?.
 	locker, err := s.backend.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, docInfo.Key))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if err := locker.Lock(ctx); err != nil {
-		return nil, err
+		return err
 	}
 	defer func() {
 		if err := locker.Unlock(ctx); err != nil {
@@ -365,9 +486,10 @@ func (s *adminServer) RemoveDocumentByAdmin(
 		}
 	}()
 
-	if err := documents.RemoveDocument(ctx, s.backend, project, docInfo.ID, req.Force); err != nil {
-		return nil, err
+	if err := documents.RemoveDocument(ctx, s.backend, project, docInfo.ID, force); err != nil {
+		return err
 	}
+	webhook.SendDocumentEvent(s.backend, project, types.DocumentRemovedEvent, nil, docInfo)
 
 	// TODO(emplam27): Change the publisherID to the actual user ID. This is a temporary solution.
 	publisherID := time.InitialActorID
@@ -382,10 +504,90 @@ func (s *adminServer) RemoveDocumentByAdmin(
 	)
 
 	logging.DefaultLogger().Info(
-		fmt.Sprintf("document remove success(projectID: %s, docKey: %s)", project.ID, req.DocumentKey),
+		fmt.Sprintf("document remove success(projectID: %s, docKey: %s)", project.ID, docInfo.Key),
 	)
 
-	return &api.RemoveDocumentByAdminResponse{}, nil
+	return nil
+}
+
+// UpdateDocumentACL updates the access control list of the given document.
+func (s *adminServer) UpdateDocumentACL(
+	ctx context.Context,
+	req *api.UpdateDocumentACLRequest,
+) (*api.UpdateDocumentACLResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	acl, err := documents.UpdateDocumentACL(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		converter.FromDocumentACL(req.Acl),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateDocumentACLResponse{
+		Acl: converter.ToDocumentACL(acl),
+	}, nil
+}
+
+// UpdateDocumentLabels updates the labels of the given document.
+func (s *adminServer) UpdateDocumentLabels(
+	ctx context.Context,
+	req *api.UpdateDocumentLabelsRequest,
+) (*api.UpdateDocumentLabelsResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := documents.UpdateDocumentLabels(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		req.Labels,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateDocumentLabelsResponse{
+		Labels: labels,
+	}, nil
+}
+
+// UpdateDocumentSnapshotConfig updates the snapshot threshold and interval of
+// the given document.
+func (s *adminServer) UpdateDocumentSnapshotConfig(
+	ctx context.Context,
+	req *api.UpdateDocumentSnapshotConfigRequest,
+) (*api.UpdateDocumentSnapshotConfigResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.UpdateDocumentSnapshotConfig(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		req.SnapshotThreshold,
+		req.SnapshotInterval,
+	); err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateDocumentSnapshotConfigResponse{}, nil
 }
 
 // ListChanges lists of changes for the given document.
@@ -419,6 +621,7 @@ func (s *adminServer) ListChanges(
 	changes, err := packs.FindChanges(
 		ctx,
 		s.backend,
+		project,
 		docInfo,
 		from,
 		to,
@@ -436,3 +639,512 @@ func (s *adminServer) ListChanges(
 		Changes: pbChanges,
 	}, nil
 }
+
+// VerifyDocument rebuilds the document from its change log and compares it
+// against the latest stored snapshot.
+func (s *adminServer) VerifyDocument(
+	ctx context.Context,
+	req *api.VerifyDocumentRequest,
+) (*api.VerifyDocumentResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := documents.VerifyDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.VerifyDocumentResponse{
+		SnapshotServerSeq: verification.SnapshotServerSeq,
+		SnapshotHash:      verification.SnapshotHash,
+		RebuiltHash:       verification.RebuiltHash,
+	}, nil
+}
+
+// RestoreDocument reverts the given document to its state at the given server
+// seq by a new change, and notifies the watchers of the document.
+func (s *adminServer) RestoreDocument(
+	ctx context.Context,
+	req *api.RestoreDocumentRequest,
+) (*api.RestoreDocumentResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	locker, err := s.backend.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, key.Key(req.DocumentKey)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}()
+
+	docInfo, err := documents.FindDocInfoByKey(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+	initialServerSeq := docInfo.ServerSeq
+
+	serverSeq, err := packs.RestoreDocument(ctx, s.backend, project, docInfo, req.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	if serverSeq != initialServerSeq {
+		publisherID := time.InitialActorID
+		s.backend.Coordinator.Publish(
+			ctx,
+			publisherID,
+			sync.DocEvent{
+				Type:       types.DocumentChangedEvent,
+				Publisher:  publisherID,
+				DocumentID: docInfo.ID,
+			},
+		)
+
+		logging.DefaultLogger().Info(fmt.Sprintf(
+			"document restore success(projectID: %s, docKey: %s, serverSeq: %d)",
+			project.ID,
+			docInfo.Key,
+			req.ServerSeq,
+		))
+	}
+
+	return &api.RestoreDocumentResponse{
+		ServerSeq: serverSeq,
+	}, nil
+}
+
+// EvictDocument detaches the document from all the clients attaching it and
+// closes the watch streams of the document, e.g. for maintenance.
+func (s *adminServer) EvictDocument(
+	ctx context.Context,
+	req *api.EvictDocumentRequest,
+) (*api.EvictDocumentResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	locker, err := s.backend.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, key.Key(req.DocumentKey)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}()
+
+	docInfo, err := documents.FindDocInfoByKey(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	clientInfos, err := documents.EvictDocument(ctx, s.backend, project, docInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	publisherID := time.InitialActorID
+	s.backend.Coordinator.Publish(
+		ctx,
+		publisherID,
+		sync.DocEvent{
+			Type:       types.DocumentEvictedEvent,
+			Publisher:  publisherID,
+			DocumentID: docInfo.ID,
+			Reason:     req.Reason,
+		},
+	)
+
+	var clientIDs []string
+	for _, clientInfo := range clientInfos {
+		clientIDs = append(clientIDs, clientInfo.ID.String())
+	}
+
+	logging.DefaultLogger().Info(fmt.Sprintf(
+		"document evict success(projectID: %s, docKey: %s, clients: %d, reason: %s)",
+		project.ID,
+		docInfo.Key,
+		len(clientIDs),
+		req.Reason,
+	))
+
+	return &api.EvictDocumentResponse{
+		ClientIds: clientIDs,
+	}, nil
+}
+
+// GCDocument purges the garbage of the document that all the clients attaching
+// it have synchronized, instead of waiting for the next snapshot.
+func (s *adminServer) GCDocument(
+	ctx context.Context,
+	req *api.GCDocumentRequest,
+) (*api.GCDocumentResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	locker, err := s.backend.Coordinator.NewLocker(ctx, packs.SnapshotKey(project.ID, key.Key(req.DocumentKey)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}()
+
+	docInfo, err := documents.FindDocInfoByKey(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := packs.GarbageCollect(ctx, s.backend, project, docInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.DefaultLogger().Info(fmt.Sprintf(
+		"document gc success(projectID: %s, docKey: %s, serverSeq: %d, removed: %d)",
+		project.ID,
+		docInfo.Key,
+		docInfo.ServerSeq,
+		removed,
+	))
+
+	return &api.GCDocumentResponse{
+		RemovedElements: int32(removed),
+		ServerSeq:       docInfo.ServerSeq,
+	}, nil
+}
+
+// ExportDocument dumps the latest state of the given document, optionally
+// with its full change history, to load it into another cluster.
+func (s *adminServer) ExportDocument(
+	ctx context.Context,
+	req *api.ExportDocumentRequest,
+) (*api.ExportDocumentResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	docInfo, err := documents.FindDocInfoByKey(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := packs.ExportDocument(ctx, s.backend, docInfo, docInfo.ServerSeq, req.IncludeChanges)
+	if err != nil {
+		return nil, err
+	}
+
+	pbChanges, err := converter.ToChanges(export.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ExportDocumentResponse{
+		Export: &api.DocumentExport{
+			DocumentKey: docInfo.Key.String(),
+			ServerSeq:   export.ServerSeq,
+			Snapshot:    export.Snapshot,
+			Changes:     pbChanges,
+		},
+	}, nil
+}
+
+// ImportDocument creates the given document from an export of another
+// cluster, and notifies the watchers of the document.
+func (s *adminServer) ImportDocument(
+	ctx context.Context,
+	req *api.ImportDocumentRequest,
+) (*api.ImportDocumentResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	docKey := key.Key(req.DocumentKey)
+	if err := docKey.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Export == nil {
+		return nil, fmt.Errorf("import '%s' without export: %w", docKey, packs.ErrInvalidExport)
+	}
+
+	changes, err := converter.FromChanges(req.Export.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	locker, err := s.backend.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, docKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}()
+
+	docInfo, err := packs.ImportDocument(ctx, s.backend, project, docKey, &packs.DocumentExport{
+		ServerSeq: req.Export.ServerSeq,
+		Snapshot:  req.Export.Snapshot,
+		Changes:   changes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publisherID := time.InitialActorID
+	s.backend.Coordinator.Publish(
+		ctx,
+		publisherID,
+		sync.DocEvent{
+			Type:       types.DocumentChangedEvent,
+			Publisher:  publisherID,
+			DocumentID: docInfo.ID,
+		},
+	)
+
+	logging.DefaultLogger().Info(fmt.Sprintf(
+		"document import success(projectID: %s, docKey: %s, serverSeq: %d)",
+		project.ID,
+		docInfo.Key,
+		docInfo.ServerSeq,
+	))
+
+	return &api.ImportDocumentResponse{
+		ServerSeq: docInfo.ServerSeq,
+	}, nil
+}
+
+// BackupProject streams the tar archive of the documents of the given project
+// in chunks.
+func (s *adminServer) BackupProject(
+	req *api.BackupProjectRequest,
+	stream api.AdminService_BackupProjectServer,
+) error {
+	ctx := stream.Context()
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&backupStreamWriter{stream: stream}, backupChunkSize)
+	manifest, err := backups.Backup(ctx, s.backend, project, w, req.IncludeChanges)
+	if err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	logging.DefaultLogger().Info(fmt.Sprintf(
+		"project backup success(projectID: %s, documents: %d, includeChanges: %t)",
+		project.ID,
+		len(manifest.Documents),
+		req.IncludeChanges,
+	))
+
+	return nil
+}
+
+// ListDocumentMemories lists the documents of the project that the server
+// holds the most memory for.
+func (s *adminServer) ListDocumentMemories(
+	ctx context.Context,
+	req *api.ListDocumentMemoriesRequest,
+) (*api.ListDocumentMemoriesResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	memories, err := documents.TopDocumentMemories(ctx, s.backend, project, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListDocumentMemoriesResponse{
+		Documents: converter.ToDocumentMemories(memories),
+	}, nil
+}
+
+// RegisterDocumentTemplate registers the template of the given collection.
+func (s *adminServer) RegisterDocumentTemplate(
+	ctx context.Context,
+	req *api.RegisterDocumentTemplateRequest,
+) (*api.RegisterDocumentTemplateResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := documents.RegisterDocumentTemplate(
+		ctx,
+		s.backend,
+		project,
+		req.Collection,
+		req.Root,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTemplate, err := converter.ToDocumentTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.RegisterDocumentTemplateResponse{
+		Template: pbTemplate,
+	}, nil
+}
+
+// ListDocumentTemplates lists the templates of the given project.
+func (s *adminServer) ListDocumentTemplates(
+	ctx context.Context,
+	req *api.ListDocumentTemplatesRequest,
+) (*api.ListDocumentTemplatesResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := documents.ListDocumentTemplates(ctx, s.backend, project)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTemplates, err := converter.ToDocumentTemplates(templates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListDocumentTemplatesResponse{
+		Templates: pbTemplates,
+	}, nil
+}
+
+// RemoveDocumentTemplate removes the template of the given collection.
+func (s *adminServer) RemoveDocumentTemplate(
+	ctx context.Context,
+	req *api.RemoveDocumentTemplateRequest,
+) (*api.RemoveDocumentTemplateResponse, error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.RemoveDocumentTemplate(ctx, s.backend, project, req.Collection); err != nil {
+		return nil, err
+	}
+
+	return &api.RemoveDocumentTemplateResponse{}, nil
+}
+
+// UpdateLogLevels updates the log levels of the given modules.
+func (s *adminServer) UpdateLogLevels(
+	ctx context.Context,
+	req *api.UpdateLogLevelsRequest,
+) (*api.UpdateLogLevelsResponse, error) {
+	if err := logging.SetModuleLevels(req.Levels); err != nil {
+		return nil, err
+	}
+
+	user := users.From(ctx)
+	logging.DefaultLogger().Infof(
+		"LOGL: %s updated log levels %v",
+		user.Username,
+		req.Levels,
+	)
+
+	return &api.UpdateLogLevelsResponse{
+		Levels: logging.ModuleLevels(),
+	}, nil
+}
+
+// backupStreamWriter sends the data written to it as the chunks of a backup.
+type backupStreamWriter struct {
+	stream api.AdminService_BackupProjectServer
+}
+
+// Write sends the given data as chunks of the backup. The data is split so
+// that no chunk exceeds the message size limit of the clients.
+func (w *backupStreamWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		end := written + backupChunkSize
+		if end > len(p) {
+			end = len(p)
+		}
+		if err := w.stream.Send(&api.BackupProjectResponse{Data: p[written:end]}); err != nil {
+			return written, err
+		}
+		written = end
+	}
+
+	return written, nil
+}
@@ -19,16 +19,17 @@ package auth
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
-	"github.com/yorkie-team/yorkie/server/backend"
-	"github.com/yorkie-team/yorkie/server/projects"
+	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/rpc/metadata"
 )
 
-// AccessAttributes returns an array of AccessAttribute from the given pack.
-func AccessAttributes(pack *change.Pack) []types.AccessAttribute {
+// AccessAttributes returns an array of AccessAttribute of the given method
+// from the given pack.
+func AccessAttributes(method types.Method, pack *change.Pack) []types.AccessAttribute {
 	verb := types.Read
 	if pack.HasChanges() {
 		verb = types.ReadWrite
@@ -37,25 +38,93 @@ func AccessAttributes(pack *change.Pack) []types.AccessAttribute {
 	// NOTE(hackerwins): In the future, methods such as bulk PushPull can be
 	// added, so we declare it as an array.
 	return []types.AccessAttribute{{
-		Key:  pack.DocumentKey.String(),
-		Verb: verb,
+		Key:    pack.DocumentKey.String(),
+		Verb:   verb,
+		Action: types.ActionOf(method, verb),
 	}}
 }
 
-// VerifyAccess verifies the given access.
-func VerifyAccess(ctx context.Context, be *backend.Backend, accessInfo *types.AccessInfo) error {
-	md := metadata.From(ctx)
-	project := projects.From(ctx)
-
-	if !project.RequireAuth(accessInfo.Method) {
-		return nil
-	}
+// VerifyAccess verifies the given access with the given provider.
+func VerifyAccess(ctx context.Context, provider Provider, accessInfo *types.AccessInfo) error {
+	_, err := authenticate(ctx, provider, accessInfo)
+	return err
+}
 
-	return verifyAccess(
+// Authenticate verifies the given access with the given provider and returns
+// the identity of the user.
+func Authenticate(ctx context.Context, provider Provider, accessInfo *types.AccessInfo) (*Identity, error) {
+	return provider.Authenticate(
 		ctx,
-		be,
-		project.AuthWebhookURL,
-		md.Authorization,
+		metadata.From(ctx).Authorization,
 		accessInfo,
 	)
 }
+
+// authenticate verifies the given access with the given provider and returns
+// the subject of the user. The subject is empty if the user is anonymous.
+func authenticate(ctx context.Context, provider Provider, accessInfo *types.AccessInfo) (string, error) {
+	identity, err := Authenticate(ctx, provider, accessInfo)
+	if err != nil {
+		return "", err
+	}
+
+	return identity.Subject, nil
+}
+
+// RoleOf returns the role that the given pack requires in the access control
+// list of the document. Presence changes do not modify the document, so only
+// the changes with operations require the writer role.
+func RoleOf(pack *change.Pack) types.ACLRole {
+	for _, c := range pack.Changes {
+		if len(c.Operations()) > 0 {
+			return types.WriterRole
+		}
+	}
+
+	return types.ReaderRole
+}
+
+// VerifyDocumentAccess verifies the user of the given access has the given
+// role in the access control list of the given document. The subject of the
+// user is taken from the identity authenticated by the given provider, and a
+// user without a subject is only permitted by the entries of AnySubject.
+func VerifyDocumentAccess(
+	ctx context.Context,
+	provider Provider,
+	accessInfo *types.AccessInfo,
+	docInfo *database.DocInfo,
+	role types.ACLRole,
+) error {
+	if docInfo.ACL.IsEmpty() {
+		return nil
+	}
+
+	subject, err := authenticate(ctx, provider, accessInfo)
+	if err != nil {
+		return err
+	}
+
+	if !docInfo.ACL.Allows(subject, role) {
+		return fmt.Errorf("%q on %s: %w", subject, docInfo.Key, ErrPermissionDenied)
+	}
+
+	return nil
+}
+
+// CanReadPublicly returns whether the request of the given context can fall
+// back to the public read access of documents when its authentication fails.
+// Only the requests without a token that require the reader role can.
+func CanReadPublicly(ctx context.Context, role types.ACLRole) bool {
+	return role == types.ReaderRole && metadata.From(ctx).Authorization == ""
+}
+
+// VerifyPublicRead verifies the given document allows anyone to read it. The
+// given cause is the error of the failed authentication, and it is returned
+// if the document does not allow public read access.
+func VerifyPublicRead(docInfo *database.DocInfo, cause error) error {
+	if !docInfo.ACL.AllowsPublicRead() {
+		return cause
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/golang-jwt/jwt"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/jwks"
+	"github.com/yorkie-team/yorkie/server/backend"
+)
+
+// ClientClaims is a JWT claims struct for a user of a project. The subject of
+// the claims is the identity of the user.
+type ClientClaims struct {
+	jwt.StandardClaims
+
+	// Project is the name of the project that the token is issued for. If it
+	// is empty, the token is valid for any project that trusts the key.
+	Project string `json:"project,omitempty"`
+
+	// DecryptPresence permits the user to read the values of the sensitive
+	// presence keys of the project in plaintext.
+	DecryptPresence bool `json:"decrypt_presence,omitempty"`
+}
+
+// verifyJWT verifies the given token with the key of the given project and
+// returns the claims of the token.
+func verifyJWT(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	token string,
+) (*ClientClaims, error) {
+	claims := &ClientClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if project.AuthJWKSURL != "" {
+			return jwksKey(ctx, be, project.AuthJWKSURL, t)
+		}
+		return staticKey(t.Method, project.AuthJWTKey)
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrNotAllowed)
+	}
+
+	if claims.Project != "" && claims.Project != project.Name {
+		return nil, fmt.Errorf("token for project %s: %w", claims.Project, ErrNotAllowed)
+	}
+
+	return claims, nil
+}
+
+// jwksKey returns the key of the given token from the JSON Web Key Set of the
+// given URL.
+func jwksKey(ctx context.Context, be *backend.Backend, url string, token *jwt.Token) (interface{}, error) {
+	set, ok := be.AuthJWKSCache.Get(url)
+	if !ok {
+		fetched, err := jwks.Fetch(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		be.AuthJWKSCache.Add(url, fetched, be.Config.ParseAuthJWKSCacheTTL())
+		set = fetched
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, err := set.Key(kid)
+	if err != nil {
+		return nil, err
+	}
+
+	return verifiableKey(token.Method, key)
+}
+
+// staticKey returns the given static key for the given signing method. The
+// key is used as the secret of HMAC only if it is not a public key, so that
+// a public key can not be used to sign tokens.
+func staticKey(method jwt.SigningMethod, key string) (interface{}, error) {
+	if rsaKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(key)); err == nil {
+		return verifiableKey(method, rsaKey)
+	}
+	if ecKey, err := jwt.ParseECPublicKeyFromPEM([]byte(key)); err == nil {
+		return verifiableKey(method, ecKey)
+	}
+
+	if _, ok := method.(*jwt.SigningMethodHMAC); !ok {
+		return nil, fmt.Errorf("%s: %w", method.Alg(), ErrUnexpectedSigningMethod)
+	}
+
+	return []byte(key), nil
+}
+
+// verifiableKey returns the given public key if it can verify the signatures
+// of the given signing method.
+func verifiableKey(method jwt.SigningMethod, key crypto.PublicKey) (interface{}, error) {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		switch method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+			return key, nil
+		}
+	case *ecdsa.PublicKey:
+		if _, ok := method.(*jwt.SigningMethodECDSA); ok {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: %w", method.Alg(), ErrUnexpectedSigningMethod)
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/projects"
+)
+
+// ClaimDecryptPresence is the claim that permits the user to read the values
+// of the sensitive presence keys of the project in plaintext.
+const ClaimDecryptPresence = "decrypt_presence"
+
+// Identity is the identity of a user authenticated by a Provider.
+type Identity struct {
+	// Subject is the identity of the user that is matched against the access
+	// control lists of documents. It is empty if the user is anonymous.
+	Subject string
+
+	// Claims are the additional claims of the user verified by the provider.
+	Claims map[string]string
+}
+
+// CanDecryptPresence returns whether the user is permitted to read the values
+// of the sensitive presence keys in plaintext.
+func (i *Identity) CanDecryptPresence() bool {
+	return i != nil && i.Claims[ClaimDecryptPresence] == "true"
+}
+
+// Provider authenticates the users of projects. The project of the request
+// can be taken from the context with projects.From.
+type Provider interface {
+	// Authenticate verifies the given token for the method, the documents and
+	// the client in the given access info, and returns the identity of the
+	// user.
+	Authenticate(
+		ctx context.Context,
+		token string,
+		accessInfo *types.AccessInfo,
+	) (*Identity, error)
+}
+
+// webhookProvider is a Provider that asks the authorization webhook of the
+// project.
+type webhookProvider struct {
+	be *backend.Backend
+}
+
+// NewWebhookProvider creates a Provider that asks the authorization webhook
+// of the project.
+func NewWebhookProvider(be *backend.Backend) Provider {
+	return &webhookProvider{be: be}
+}
+
+// Authenticate asks the authorization webhook of the project.
+func (p *webhookProvider) Authenticate(
+	ctx context.Context,
+	token string,
+	accessInfo *types.AccessInfo,
+) (*Identity, error) {
+	resp, err := verifyAccess(
+		ctx,
+		p.be,
+		projects.From(ctx).AuthWebhookURL,
+		token,
+		accessInfo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Subject: resp.Subject,
+		Claims:  make(map[string]string),
+	}
+	if resp.DecryptPresence {
+		identity.Claims[ClaimDecryptPresence] = "true"
+	}
+
+	return identity, nil
+}
+
+// jwtProvider is a Provider that verifies JWTs with the keys of the project.
+type jwtProvider struct {
+	be *backend.Backend
+}
+
+// NewJWTProvider creates a Provider that verifies JWTs with the static key or
+// the JSON Web Key Set of the project.
+func NewJWTProvider(be *backend.Backend) Provider {
+	return &jwtProvider{be: be}
+}
+
+// Authenticate verifies the given token as a JWT.
+func (p *jwtProvider) Authenticate(
+	ctx context.Context,
+	token string,
+	_ *types.AccessInfo,
+) (*Identity, error) {
+	claims, err := verifyJWT(ctx, p.be, projects.From(ctx), token)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Subject: claims.Subject,
+		Claims:  make(map[string]string),
+	}
+	if claims.Issuer != "" {
+		identity.Claims["iss"] = claims.Issuer
+	}
+	if claims.Audience != "" {
+		identity.Claims["aud"] = claims.Audience
+	}
+	if claims.Project != "" {
+		identity.Claims["project"] = claims.Project
+	}
+	if claims.DecryptPresence {
+		identity.Claims[ClaimDecryptPresence] = "true"
+	}
+
+	return identity, nil
+}
+
+// defaultProvider is a Provider that authenticates the users by the settings
+// of the project.
+type defaultProvider struct {
+	webhook Provider
+	jwt     Provider
+}
+
+// NewDefaultProvider creates a Provider that authenticates the users by the
+// settings of the project. Methods that the project does not require to
+// authorize are allowed anonymously. If the project verifies JWTs by itself,
+// the authorization webhook is not called.
+func NewDefaultProvider(be *backend.Backend) Provider {
+	return &defaultProvider{
+		webhook: NewWebhookProvider(be),
+		jwt:     NewJWTProvider(be),
+	}
+}
+
+// Authenticate authenticates the user by the settings of the project.
+func (p *defaultProvider) Authenticate(
+	ctx context.Context,
+	token string,
+	accessInfo *types.AccessInfo,
+) (*Identity, error) {
+	project := projects.From(ctx)
+	if !project.RequireAuth(accessInfo.Method) {
+		return &Identity{}, nil
+	}
+
+	if project.UseJWTAuth() {
+		return p.jwt.Authenticate(ctx, token, accessInfo)
+	}
+
+	return p.webhook.Authenticate(ctx, token, accessInfo)
+}
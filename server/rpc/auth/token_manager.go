@@ -27,6 +27,10 @@ import (
 var (
 	// ErrUnexpectedSigningMethod is returned when the signing method is unexpected.
 	ErrUnexpectedSigningMethod = fmt.Errorf("unexpected signing method")
+
+	// ErrInvalidAuthorization is returned when the authorization of the admin
+	// is invalid or the admin does not exist.
+	ErrInvalidAuthorization = fmt.Errorf("authorization is invalid")
 )
 
 // UserClaims is a JWT claims struct for a user.
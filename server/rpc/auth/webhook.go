@@ -22,145 +22,105 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"net/http"
-	"syscall"
-	"time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/webhook"
 )
 
 var (
+	// ErrPermissionDenied is returned when the given user is not permitted by
+	// the access control list of the document.
+	ErrPermissionDenied = errors.New("permission denied by the document acl")
+
 	// ErrNotAllowed is returned when the given user is not allowed for the access.
 	ErrNotAllowed = errors.New("method is not allowed for this user")
 
 	// ErrUnexpectedStatusCode is returned when the response code is not 200 from the webhook.
-	ErrUnexpectedStatusCode = errors.New("unexpected status code from webhook")
+	ErrUnexpectedStatusCode = webhook.ErrUnexpectedStatusCode
 
 	// ErrWebhookTimeout is returned when the webhook does not respond in time.
-	ErrWebhookTimeout = errors.New("webhook timeout")
+	ErrWebhookTimeout = webhook.ErrWebhookTimeout
 )
 
-// verifyAccess verifies the given user is allowed to access the given method.
+// verifyAccess verifies the given user is allowed to access the given method
+// and returns the response of the webhook.
 func verifyAccess(
 	ctx context.Context,
 	be *backend.Backend,
 	authWebhookURL string,
 	token string,
 	accessInfo *types.AccessInfo,
-) error {
+) (*types.AuthWebhookResponse, error) {
 	reqBody, err := json.Marshal(types.AuthWebhookRequest{
 		Token:      token,
 		Method:     accessInfo.Method,
 		Attributes: accessInfo.Attributes,
+		Client:     accessInfo.Client,
 	})
 	if err != nil {
-		return fmt.Errorf("marshal auth webhook request: %w", err)
+		return nil, fmt.Errorf("marshal auth webhook request: %w", err)
 	}
 
 	cacheKey := string(reqBody)
 	if entry, ok := be.AuthWebhookCache.Get(cacheKey); ok {
 		resp := entry
 		if !resp.Allowed {
-			return fmt.Errorf("%s: %w", resp.Reason, ErrNotAllowed)
+			return nil, fmt.Errorf("%s: %w", resp.Reason, ErrNotAllowed)
 		}
-		return nil
+		return resp, nil
 	}
 
 	var authResp *types.AuthWebhookResponse
-	if err := withExponentialBackoff(ctx, be.Config, func() (int, error) {
-		resp, err := http.Post(
-			authWebhookURL,
-			"application/json",
-			bytes.NewBuffer(reqBody),
-		)
-		if err != nil {
-			return 0, fmt.Errorf("post to webhook: %w", err)
-		}
-
-		defer func() {
-			if err := resp.Body.Close(); err != nil {
-				logging.From(ctx).Error(err)
+	if err := webhook.WithExponentialBackoff(
+		ctx,
+		be.Config.AuthWebhookMaxRetries,
+		be.Config.ParseAuthWebhookMaxWaitInterval(),
+		func() (int, error) {
+			resp, err := http.Post(
+				authWebhookURL,
+				"application/json",
+				bytes.NewBuffer(reqBody),
+			)
+			if err != nil {
+				return 0, fmt.Errorf("post to webhook: %w", err)
 			}
-		}()
 
-		if http.StatusOK != resp.StatusCode {
-			return resp.StatusCode, ErrUnexpectedStatusCode
-		}
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					logging.From(ctx).Error(err)
+				}
+			}()
 
-		authResp, err = types.NewAuthWebhookResponse(resp.Body)
-		if err != nil {
-			return resp.StatusCode, err
-		}
+			if resp.StatusCode == http.StatusTooManyRequests {
+				return resp.StatusCode, webhook.NewThrottleError("webhook:auth", resp)
+			}
+			if http.StatusOK != resp.StatusCode {
+				return resp.StatusCode, ErrUnexpectedStatusCode
+			}
 
-		if !authResp.Allowed {
-			return resp.StatusCode, fmt.Errorf("%s: %w", authResp.Reason, ErrNotAllowed)
-		}
+			authResp, err = types.NewAuthWebhookResponse(resp.Body)
+			if err != nil {
+				return resp.StatusCode, err
+			}
+
+			if !authResp.Allowed {
+				return resp.StatusCode, fmt.Errorf("%s: %w", authResp.Reason, ErrNotAllowed)
+			}
 
-		return resp.StatusCode, nil
-	}); err != nil {
+			return resp.StatusCode, nil
+		},
+	); err != nil {
 		if errors.Is(err, ErrNotAllowed) {
 			be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheUnauthTTL())
 		}
 
-		return err
+		return nil, err
 	}
 
 	be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheAuthTTL())
 
-	return nil
-}
-
-func withExponentialBackoff(ctx context.Context, cfg *backend.Config, webhookFn func() (int, error)) error {
-	var retries uint64
-	var statusCode int
-	for retries <= cfg.AuthWebhookMaxRetries {
-		statusCode, err := webhookFn()
-		if !shouldRetry(statusCode, err) {
-			if err == ErrUnexpectedStatusCode {
-				return fmt.Errorf("unexpected status code from webhook: %d", statusCode)
-			}
-
-			return err
-		}
-
-		waitBeforeRetry := waitInterval(retries, cfg.ParseAuthWebhookMaxWaitInterval())
-
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(waitBeforeRetry):
-		}
-
-		retries++
-	}
-
-	return fmt.Errorf("unexpected status code from webhook %d: %w", statusCode, ErrWebhookTimeout)
-}
-
-// waitInterval returns the interval of given retries. (2^retries * 100) milliseconds.
-func waitInterval(retries uint64, maxWaitInterval time.Duration) time.Duration {
-	interval := time.Duration(math.Pow(2, float64(retries))) * 100 * time.Millisecond
-	if maxWaitInterval < interval {
-		return maxWaitInterval
-	}
-
-	return interval
-}
-
-// shouldRetry returns true if the given error should be retried.
-// Refer to https://github.com/kubernetes/kubernetes/search?q=DefaultShouldRetry
-func shouldRetry(statusCode int, err error) bool {
-	// If the connection is reset, we should retry.
-	var errno syscall.Errno
-	if errors.As(err, &errno) {
-		return errno == syscall.ECONNRESET
-	}
-
-	return statusCode == http.StatusInternalServerError ||
-		statusCode == http.StatusServiceUnavailable ||
-		statusCode == http.StatusGatewayTimeout ||
-		statusCode == http.StatusTooManyRequests
+	return authResp, nil
 }
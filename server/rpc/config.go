@@ -21,6 +21,9 @@ import (
 	"fmt"
 	"os"
 	"time"
+
+	"github.com/yorkie-team/yorkie/server/listener"
+	"github.com/yorkie-team/yorkie/server/rpc/grpchelper"
 )
 
 var (
@@ -34,6 +37,13 @@ var (
 	ErrInvalidMaxConnectionAge = errors.New("invalid max connection age for RPC server")
 	// ErrInvalidMaxConnectionAgeGrace occurs when the max connection age grace is invalid.
 	ErrInvalidMaxConnectionAgeGrace = errors.New("invalid max connection age grace for RPC server")
+	// ErrInvalidCompressionMinSize occurs when the compression min size is invalid.
+	ErrInvalidCompressionMinSize = errors.New("invalid compression min size for RPC server")
+	// ErrInvalidAdminPort occurs when the port in the admin config is invalid.
+	ErrInvalidAdminPort = errors.New("invalid port number for admin server")
+	// ErrInvalidAdminTokenDuration occurs when the token duration in the admin
+	// config is invalid.
+	ErrInvalidAdminTokenDuration = errors.New("invalid token duration for admin server")
 )
 
 // Config is the configuration for creating a Server instance.
@@ -41,6 +51,12 @@ type Config struct {
 	// Port is the port number for the RPC server.
 	Port int `yaml:"Port"`
 
+	// Addresses are the addresses that the RPC server listens on instead of
+	// Port, such as "127.0.0.1:11101", "[::1]:11101" or "unix:/tmp/yorkie.sock".
+	// The admin service is served on the same addresses unless AdminConfig is
+	// given.
+	Addresses []string `yaml:"Addresses"`
+
 	// CertFile is the path to the certificate file.
 	CertFile string `yaml:"CertFile"`
 
@@ -50,6 +66,10 @@ type Config struct {
 	// MaxRequestBytes is the maximum client request size in bytes the server will accept.
 	MaxRequestBytes uint64 `yaml:"MaxRequestBytes"`
 
+	// MaxStreamedPackBytes is the maximum size in bytes of the change pack that
+	// the server assembles from the chunks of a streamed PushPull request.
+	MaxStreamedPackBytes uint64 `yaml:"MaxStreamedPackBytes"`
+
 	// MaxConnectionAge is a duration for the maximum amount of time a connection may exist
 	// before it will be closed by sending a GoAway.
 	MaxConnectionAge string `yaml:"MaxConnectionAge"`
@@ -57,6 +77,24 @@ type Config struct {
 	// MaxConnectionAgeGrace is a duration for the amount of time after receiving a GoAway
 	// for pending RPCs to complete before forcibly closing connections.
 	MaxConnectionAgeGrace string `yaml:"MaxConnectionAgeGrace"`
+
+	// CompressionMinSize is the size in bytes of the messages below which the
+	// gzip and zstd compressors negotiated with clients use the cheapest level.
+	CompressionMinSize int `yaml:"CompressionMinSize"`
+
+	// EnableWebProtocols is whether to serve the services over gRPC-Web and
+	// Connect along with gRPC on the same addresses, so that browsers can call
+	// them without a proxy. The streams are also served over WebSocket for the
+	// environments where gRPC streaming is blocked, and the documents are read
+	// as JSON from GET /documents/{key}. The servers are served over HTTP in
+	// this mode, and MaxConnectionAge and MaxConnectionAgeGrace are not
+	// applied.
+	EnableWebProtocols bool `yaml:"EnableWebProtocols"`
+
+	// TrustedProxies are the IP addresses or CIDRs of the proxies in front of
+	// the server, such as load balancers. The X-Forwarded-For headers of the
+	// requests are honored only if they are sent by these proxies.
+	TrustedProxies []string `yaml:"TrustedProxies"`
 }
 
 // Validate validates the port number and the files for certification.
@@ -65,6 +103,16 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("must be between 1 and 65535, given %d: %w", c.Port, ErrInvalidRPCPort)
 	}
 
+	for _, address := range c.Addresses {
+		if err := listener.Validate(address); err != nil {
+			return err
+		}
+	}
+
+	if _, err := grpchelper.ParseTrustedProxies(c.TrustedProxies); err != nil {
+		return err
+	}
+
 	// when specific cert or key file are configured
 	if c.CertFile != "" {
 		if _, err := os.Stat(c.CertFile); err != nil {
@@ -94,5 +142,77 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if c.CompressionMinSize < 0 {
+		return fmt.Errorf(
+			"%d: %w",
+			c.CompressionMinSize,
+			ErrInvalidCompressionMinSize,
+		)
+	}
+
+	return nil
+}
+
+// AdminConfig is the configuration of the admin server. If it is given, the
+// admin service is served by its own listeners apart from the RPC server, so
+// that the administration can be firewalled away from the SDK traffic.
+type AdminConfig struct {
+	// Port is the port number for the admin server.
+	Port int `yaml:"Port"`
+
+	// Addresses are the addresses that the admin server listens on instead of
+	// Port, such as "127.0.0.1:11103" or "unix:/tmp/yorkie-admin.sock".
+	Addresses []string `yaml:"Addresses"`
+
+	// CertFile is the path to the certificate file of the admin server.
+	CertFile string `yaml:"CertFile"`
+
+	// KeyFile is the path to the key file of the admin server.
+	KeyFile string `yaml:"KeyFile"`
+
+	// SecretKey is the secret key for signing the tokens of the admin server.
+	// If it is empty, the secret key of the backend is used.
+	SecretKey string `yaml:"SecretKey"`
+
+	// TokenDuration is the duration of the tokens of the admin server. If it
+	// is empty, the admin token duration of the backend is used.
+	TokenDuration string `yaml:"TokenDuration"`
+}
+
+// Validate validates the port number, the addresses and the files for
+// certification of the admin server.
+func (c *AdminConfig) Validate() error {
+	if c.Port < 1 || 65535 < c.Port {
+		return fmt.Errorf("must be between 1 and 65535, given %d: %w", c.Port, ErrInvalidAdminPort)
+	}
+
+	for _, address := range c.Addresses {
+		if err := listener.Validate(address); err != nil {
+			return err
+		}
+	}
+
+	if c.CertFile != "" {
+		if _, err := os.Stat(c.CertFile); err != nil {
+			return fmt.Errorf("%s: %w", c.CertFile, ErrInvalidCertFile)
+		}
+	}
+
+	if c.KeyFile != "" {
+		if _, err := os.Stat(c.KeyFile); err != nil {
+			return fmt.Errorf("%s: %w", c.KeyFile, ErrInvalidKeyFile)
+		}
+	}
+
+	if c.TokenDuration != "" {
+		if _, err := time.ParseDuration(c.TokenDuration); err != nil {
+			return fmt.Errorf(
+				"%s: %w",
+				c.TokenDuration,
+				ErrInvalidAdminTokenDuration,
+			)
+		}
+	}
+
 	return nil
 }
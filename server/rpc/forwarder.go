@@ -0,0 +1,208 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	gosync "sync"
+	gotime "time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcmetadata "google.golang.org/grpc/metadata"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/clock"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// forwardedSignatureTTL is the duration in which the signature of a forwarded
+// request is valid, which allows the clock skew between the servers.
+const forwardedSignatureTTL = gotime.Minute
+
+// forwarder forwards the requests of the documents owned by the other servers
+// of the cluster to their owners.
+type forwarder struct {
+	serverID string
+	secret   []byte
+	clock    clock.Clock
+	creds    credentials.TransportCredentials
+
+	mu    gosync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+// newForwarder creates an instance of forwarder. The forwarded requests are
+// signed with the given secret of the cluster. If the given certFile is not
+// empty, the requests are forwarded over TLS with the certificate, assuming
+// that the servers of the cluster share it.
+func newForwarder(serverID, secret, certFile string, clk clock.Clock) (*forwarder, error) {
+	creds := insecure.NewCredentials()
+	if certFile != "" {
+		tlsCreds, err := credentials.NewClientTLSFromFile(certFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("load TLS cert: %w", err)
+		}
+		creds = tlsCreds
+	}
+
+	return &forwarder{
+		serverID: serverID,
+		secret:   []byte(secret),
+		clock:    clk,
+		creds:    creds,
+		conns:    make(map[string]*grpc.ClientConn),
+	}, nil
+}
+
+// sign returns the signature of a request forwarded by the given server at
+// the given time in unix seconds.
+func (f *forwarder) sign(serverID, forwardedAt string) string {
+	mac := hmac.New(sha256.New, f.secret)
+	mac.Write([]byte(serverID + ":" + forwardedAt))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// isForwarded returns whether the request of the given context is forwarded
+// by another server of the cluster. The headers set by clients are not
+// trusted unless they are signed with the secret of the cluster recently.
+func (f *forwarder) isForwarded(ctx context.Context) bool {
+	data, ok := grpcmetadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	forwardedBy := data.Get(types.ForwardedByKey)
+	forwardedAt := data.Get(types.ForwardedAtKey)
+	signature := data.Get(types.ForwardedSignatureKey)
+	if len(forwardedBy) != 1 || len(forwardedAt) != 1 || len(signature) != 1 {
+		return false
+	}
+
+	at, err := strconv.ParseInt(forwardedAt[0], 10, 64)
+	if err != nil {
+		return false
+	}
+	elapsed := f.clock.Now().Sub(gotime.Unix(at, 0))
+	if elapsed > forwardedSignatureTTL || elapsed < -forwardedSignatureTTL {
+		return false
+	}
+
+	expected := f.sign(forwardedBy[0], forwardedAt[0])
+	return hmac.Equal([]byte(expected), []byte(signature[0]))
+}
+
+// forward forwards the given request of the client of the given IP to the
+// given owner of the document with the given method of the service and returns
+// the response of the owner.
+func forward[Req any, Resp any](
+	ctx context.Context,
+	f *forwarder,
+	owner *sync.ServerInfo,
+	clientIP string,
+	req Req,
+	method func(api.YorkieServiceClient, context.Context, Req, ...grpc.CallOption) (Resp, error),
+) (Resp, error) {
+	conn, err := f.conn(owner.RPCAddr)
+	if err != nil {
+		var resp Resp
+		return resp, err
+	}
+
+	logging.From(ctx).Debugf("FRWD: forward %T to %s(%s)", req, owner.ID, owner.RPCAddr)
+
+	return method(
+		api.NewYorkieServiceClient(conn),
+		f.outgoingContext(ctx, clientIP),
+		req,
+		grpc.MaxCallRecvMsgSize(math.MaxInt32),
+	)
+}
+
+// outgoingContext returns the context of the forwarded request with the
+// headers of the original request, such as the API key and the token, so
+// that the owner authorizes the request in the same way. The X-Forwarded-For
+// header of the original request is replaced with the given IP of the client
+// resolved by this server, so that the owner can trust it.
+func (f *forwarder) outgoingContext(ctx context.Context, clientIP string) context.Context {
+	data := grpcmetadata.MD{}
+	if incoming, ok := grpcmetadata.FromIncomingContext(ctx); ok {
+		for k, v := range incoming {
+			// NOTE: The pseudo and reserved headers are set by the transport.
+			if strings.HasPrefix(k, ":") || strings.HasPrefix(k, "grpc-") ||
+				k == "content-type" || k == "user-agent" || k == "te" || k == types.ForwardedForKey {
+				continue
+			}
+			data[k] = v
+		}
+	}
+
+	if clientIP != "" {
+		data.Set(types.ForwardedForKey, clientIP)
+	}
+	forwardedAt := strconv.FormatInt(f.clock.Now().Unix(), 10)
+	data.Set(types.ForwardedByKey, f.serverID)
+	data.Set(types.ForwardedAtKey, forwardedAt)
+	data.Set(types.ForwardedSignatureKey, f.sign(f.serverID, forwardedAt))
+	if requestID := logging.RequestID(ctx); requestID != "" {
+		data.Set(types.RequestIDKey, requestID)
+	}
+
+	return grpcmetadata.NewOutgoingContext(ctx, data)
+}
+
+// conn returns the connection to the given address. The connections are
+// reused for the requests to the same server.
+func (f *forwarder) conn(addr string) (*grpc.ClientConn, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if conn, ok := f.conns[addr]; ok {
+		return conn, nil
+	}
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(f.creds))
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", addr, err)
+	}
+	f.conns[addr] = conn
+
+	return conn, nil
+}
+
+// Close closes the connections to the other servers.
+func (f *forwarder) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for addr, conn := range f.conns {
+		if err := conn.Close(); err != nil {
+			logging.DefaultLogger().Error(fmt.Errorf("close connection to %s: %w", addr, err))
+		}
+	}
+	f.conns = make(map[string]*grpc.ClientConn)
+}
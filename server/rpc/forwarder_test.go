@@ -0,0 +1,137 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	grpcmetadata "google.golang.org/grpc/metadata"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/clock"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// ownerServer is a YorkieService of the owner of documents that records
+// whether the requests are forwarded.
+type ownerServer struct {
+	api.UnimplementedYorkieServiceServer
+	forwarder *forwarder
+	forwarded chan bool
+}
+
+func (s *ownerServer) AttachDocument(
+	ctx context.Context,
+	_ *api.AttachDocumentRequest,
+) (*api.AttachDocumentResponse, error) {
+	s.forwarded <- s.forwarder.isForwarded(ctx)
+	return &api.AttachDocumentResponse{DocumentId: "owned"}, nil
+}
+
+// incomingContext returns the context that the owner receives for the request
+// of the given outgoing context.
+func incomingContext(ctx context.Context) context.Context {
+	data, _ := grpcmetadata.FromOutgoingContext(ctx)
+	return grpcmetadata.NewIncomingContext(context.Background(), data)
+}
+
+func TestForwarder(t *testing.T) {
+	clk := clock.NewFake(gotime.Now())
+	f, err := newForwarder("server1", "secret", "", clk)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	t.Run("forwarded request test", func(t *testing.T) {
+		ctx := incomingContext(f.outgoingContext(context.Background(), ""))
+		assert.True(t, f.isForwarded(ctx))
+
+		other, err := newForwarder("server2", "secret", "", clk)
+		assert.NoError(t, err)
+		assert.True(t, other.isForwarded(ctx))
+	})
+
+	t.Run("forwarded for header test", func(t *testing.T) {
+		ctx := grpcmetadata.NewIncomingContext(context.Background(), grpcmetadata.Pairs(
+			types.ForwardedForKey, "1.1.1.1",
+		))
+		ctx = incomingContext(f.outgoingContext(ctx, "2.2.2.2"))
+		data, _ := grpcmetadata.FromIncomingContext(ctx)
+		assert.Equal(t, []string{"2.2.2.2"}, data.Get(types.ForwardedForKey))
+	})
+
+	t.Run("request with forwarded header by client test", func(t *testing.T) {
+		assert.False(t, f.isForwarded(context.Background()))
+
+		ctx := grpcmetadata.NewIncomingContext(context.Background(), grpcmetadata.Pairs(
+			types.ForwardedByKey, "server1",
+		))
+		assert.False(t, f.isForwarded(ctx))
+
+		ctx = grpcmetadata.NewIncomingContext(context.Background(), grpcmetadata.Pairs(
+			types.ForwardedByKey, "server1",
+			types.ForwardedAtKey, strconv.FormatInt(clk.Now().Unix(), 10),
+			types.ForwardedSignatureKey, "invalid",
+		))
+		assert.False(t, f.isForwarded(ctx))
+	})
+
+	t.Run("request signed with another secret test", func(t *testing.T) {
+		other, err := newForwarder("server2", "other", "", clk)
+		assert.NoError(t, err)
+
+		ctx := incomingContext(other.outgoingContext(context.Background(), ""))
+		assert.False(t, f.isForwarded(ctx))
+	})
+
+	t.Run("replayed request test", func(t *testing.T) {
+		ctx := incomingContext(f.outgoingContext(context.Background(), ""))
+		clk.Advance(forwardedSignatureTTL + gotime.Second)
+		assert.False(t, f.isForwarded(ctx))
+	})
+
+	t.Run("forward request to owner test", func(t *testing.T) {
+		lis, err := net.Listen("tcp", "localhost:0")
+		assert.NoError(t, err)
+		owner := &ownerServer{forwarder: f, forwarded: make(chan bool, 1)}
+		grpcServer := grpc.NewServer()
+		api.RegisterYorkieServiceServer(grpcServer, owner)
+		go func() {
+			assert.NoError(t, grpcServer.Serve(lis))
+		}()
+		defer grpcServer.Stop()
+
+		resp, err := forward(
+			logging.With(context.Background(), logging.New("forward")),
+			f,
+			&sync.ServerInfo{ID: "owner", RPCAddr: lis.Addr().String()},
+			"",
+			&api.AttachDocumentRequest{},
+			api.YorkieServiceClient.AttachDocument,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, "owned", resp.DocumentId)
+		assert.True(t, <-owner.forwarded)
+	})
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpchelper
+
+import (
+	"context"
+
+	grpcmetadata "google.golang.org/grpc/metadata"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/internal/compression"
+)
+
+// PackCompressor returns the compressor of the change packs that the client
+// of the request in the given context accepts. It returns the empty string if
+// the client does not set the header or sets an unsupported compressor, so
+// that the change packs of the responses are not compressed.
+func PackCompressor(ctx context.Context) string {
+	data, ok := grpcmetadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := data[types.PackCompressionKey]
+	if len(values) == 0 || compression.Validate(values[0]) != nil {
+		return ""
+	}
+
+	return values[0]
+}
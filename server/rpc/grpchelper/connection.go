@@ -0,0 +1,140 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpchelper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	grpcmetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+// ErrInvalidTrustedProxy is returned when the address of a trusted proxy is
+// neither an IP address nor a CIDR.
+var ErrInvalidTrustedProxy = errors.New("invalid trusted proxy")
+
+// TrustedProxies is the networks of the proxies in front of the server whose
+// X-Forwarded-For headers are trusted.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses the given IP addresses or CIDRs of the trusted
+// proxies.
+func ParseTrustedProxies(proxies []string) (TrustedProxies, error) {
+	var networks TrustedProxies
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("%s: %w", proxy, ErrInvalidTrustedProxy)
+			}
+
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", proxy, ErrInvalidTrustedProxy)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+// Contains returns whether the given IP is of one of the trusted proxies.
+func (p TrustedProxies) Contains(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+
+	for _, network := range p {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConnectionInfo returns the information of the connection of the request in
+// the given context. The X-Forwarded-For header is honored only if the peer of
+// the request is trusted by the given isTrusted, and the IP is taken from the
+// right-most address of the header that is not trusted, as the addresses on
+// its left can be set by the client.
+func ConnectionInfo(ctx context.Context, isTrusted func(ip string) bool) types.ConnectionInfo {
+	info := types.ConnectionInfo{
+		Source: types.ConnectionSourceGRPC,
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		info.IP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(info.IP); err == nil {
+			info.IP = host
+		}
+	}
+
+	data, ok := grpcmetadata.FromIncomingContext(ctx)
+	if !ok {
+		return info
+	}
+
+	if isTrusted(info.IP) {
+		info.IP = forwardedFor(data.Get(types.ForwardedForKey), info.IP, isTrusted)
+	}
+	if userAgent := data[types.UserAgentKey]; len(userAgent) > 0 {
+		info.UserAgent = userAgent[0]
+	}
+	if len(data[types.GRPCWebKey]) > 0 {
+		info.Source = types.ConnectionSourceGRPCWeb
+	}
+
+	return info
+}
+
+// forwardedFor returns the address of the client in the given X-Forwarded-For
+// headers sent by the trusted proxy of the given IP.
+func forwardedFor(headers []string, ip string, isTrusted func(ip string) bool) string {
+	var addrs []string
+	for _, header := range headers {
+		for _, addr := range strings.Split(header, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+
+	for i := len(addrs) - 1; i >= 0; i-- {
+		ip = addrs[i]
+		if !isTrusted(ip) {
+			break
+		}
+	}
+
+	return ip
+}
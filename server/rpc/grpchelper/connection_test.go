@@ -0,0 +1,81 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpchelper_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	grpcmetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/rpc/grpchelper"
+)
+
+// requestContext returns the context of a request from the given peer with
+// the given X-Forwarded-For header.
+func requestContext(peerIP string, forwardedFor ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 50000},
+	})
+
+	data := grpcmetadata.MD{}
+	for _, addr := range forwardedFor {
+		data.Append(types.ForwardedForKey, addr)
+	}
+	return grpcmetadata.NewIncomingContext(ctx, data)
+}
+
+func TestConnectionInfo(t *testing.T) {
+	proxies, err := grpchelper.ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.0.1"})
+	assert.NoError(t, err)
+
+	t.Run("parse trusted proxies test", func(t *testing.T) {
+		assert.True(t, proxies.Contains("10.1.2.3"))
+		assert.True(t, proxies.Contains("192.168.0.1"))
+		assert.False(t, proxies.Contains("192.168.0.2"))
+		assert.False(t, proxies.Contains("invalid"))
+
+		_, err := grpchelper.ParseTrustedProxies([]string{"10.0.0.0/33"})
+		assert.ErrorIs(t, err, grpchelper.ErrInvalidTrustedProxy)
+		_, err = grpchelper.ParseTrustedProxies([]string{"proxy"})
+		assert.ErrorIs(t, err, grpchelper.ErrInvalidTrustedProxy)
+	})
+
+	t.Run("forwarded for header from untrusted peer test", func(t *testing.T) {
+		info := grpchelper.ConnectionInfo(requestContext("1.1.1.1", "2.2.2.2"), proxies.Contains)
+		assert.Equal(t, "1.1.1.1", info.IP)
+	})
+
+	t.Run("forwarded for header from trusted proxy test", func(t *testing.T) {
+		info := grpchelper.ConnectionInfo(requestContext("10.0.0.1", "2.2.2.2"), proxies.Contains)
+		assert.Equal(t, "2.2.2.2", info.IP)
+
+		// NOTE: The addresses on the left of the client can be set by the client.
+		info = grpchelper.ConnectionInfo(
+			requestContext("10.0.0.1", "3.3.3.3, 2.2.2.2, 10.0.0.2"),
+			proxies.Contains,
+		)
+		assert.Equal(t, "2.2.2.2", info.IP)
+
+		info = grpchelper.ConnectionInfo(requestContext("10.0.0.1"), proxies.Contains)
+		assert.Equal(t, "10.0.0.1", info.IP)
+	})
+}
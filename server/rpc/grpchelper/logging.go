@@ -19,15 +19,22 @@ package grpchelper
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"strconv"
 	"sync/atomic"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
+	grpcmetadata "google.golang.org/grpc/metadata"
 
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server/logging"
 )
 
+// maxRequestIDLen is the maximum length of the request ID given by clients.
+const maxRequestIDLen = 128
+
 type reqID int32
 
 func (c *reqID) next() string {
@@ -53,8 +60,13 @@ func (i *LoggingInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (resp interface{}, err error) {
-		reqLogger := logging.New(i.reqID.next())
-		return handler(logging.With(ctx, reqLogger), req)
+		requestID := requestIDOf(ctx)
+		ctx = logging.WithRequestID(logging.With(ctx, logging.New(i.reqID.next())), requestID)
+		if err := grpc.SetHeader(ctx, grpcmetadata.Pairs(types.RequestIDKey, requestID)); err != nil {
+			logging.From(ctx).Warn(err)
+		}
+
+		return handler(ctx, req)
 	}
 }
 
@@ -66,9 +78,54 @@ func (i *LoggingInterceptor) Stream() grpc.StreamServerInterceptor {
 		info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler,
 	) error {
-		reqLogger := logging.New(i.reqID.next())
+		requestID := requestIDOf(ss.Context())
+		ctx := logging.WithRequestID(logging.With(ss.Context(), logging.New(i.reqID.next())), requestID)
+		if err := ss.SetHeader(grpcmetadata.Pairs(types.RequestIDKey, requestID)); err != nil {
+			logging.From(ctx).Warn(err)
+		}
+
 		wrapped := grpcmiddleware.WrapServerStream(ss)
-		wrapped.WrappedContext = logging.With(ss.Context(), reqLogger)
+		wrapped.WrappedContext = ctx
 		return handler(srv, wrapped)
 	}
 }
+
+// requestIDOf returns the request ID given in the header of the request. If
+// the header is missing or invalid, it returns a newly generated ID.
+func requestIDOf(ctx context.Context) string {
+	if data, ok := grpcmetadata.FromIncomingContext(ctx); ok {
+		if values := data.Get(types.RequestIDKey); len(values) > 0 && isValidRequestID(values[0]) {
+			return values[0]
+		}
+	}
+
+	return newRequestID()
+}
+
+// isValidRequestID returns whether the given request ID can be written in the
+// logs as it is. Only printable ASCII characters without spaces are allowed.
+func isValidRequestID(id string) bool {
+	if len(id) == 0 || len(id) > maxRequestIDLen {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newRequestID generates a random request ID.
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// NOTE: crypto/rand does not fail on supported platforms, but we keep
+		// the request going with an empty ID rather than failing it.
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpchelper_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	grpcmetadata "google.golang.org/grpc/metadata"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/rpc/grpchelper"
+)
+
+func TestLoggingInterceptor(t *testing.T) {
+	interceptor := grpchelper.NewLoggingInterceptor().Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: "/yorkie.v1.YorkieService/PushPullChanges"}
+	requestIDOf := func(ctx context.Context) string {
+		var requestID string
+		_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			requestID = logging.RequestID(ctx)
+			return nil, nil
+		})
+		assert.NoError(t, err)
+		return requestID
+	}
+
+	t.Run("given request id test", func(t *testing.T) {
+		ctx := grpcmetadata.NewIncomingContext(
+			context.Background(),
+			grpcmetadata.Pairs(types.RequestIDKey, "req-1"),
+		)
+		assert.Equal(t, "req-1", requestIDOf(ctx))
+	})
+
+	t.Run("generated request id test", func(t *testing.T) {
+		first := requestIDOf(context.Background())
+		second := requestIDOf(context.Background())
+		assert.Len(t, first, 32)
+		assert.NotEqual(t, first, second)
+
+		for _, invalid := range []string{"req 1", strings.Repeat("a", 129)} {
+			ctx := grpcmetadata.NewIncomingContext(
+				context.Background(),
+				grpcmetadata.Pairs(types.RequestIDKey, invalid),
+			)
+			assert.NotEqual(t, invalid, requestIDOf(ctx))
+		}
+	})
+}
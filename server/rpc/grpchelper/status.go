@@ -18,62 +18,106 @@ package grpchelper
 
 import (
 	"errors"
-	"fmt"
+	"strings"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/internal/validation"
+	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/clients"
 	"github.com/yorkie-team/yorkie/server/documents"
+	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/projects"
 	"github.com/yorkie-team/yorkie/server/rpc/auth"
+	"github.com/yorkie-team/yorkie/server/rpc/metadata"
 )
 
-// errorToCode maps an error to gRPC status code.
+// ErrorDomain is the domain of ErrorInfo in the details of statuses.
+const ErrorDomain = "yorkie.dev"
+
+// maxReasonLength is the maximum length of the reason of ErrorInfo.
+const maxReasonLength = 63
+
+var (
+	// ErrInvalidFields is the reason of errors of invalid fields.
+	ErrInvalidFields = errors.New("invalid fields")
+
+	// ErrInternal is the reason of errors that are not mapped to any code.
+	ErrInternal = errors.New("internal error")
+)
+
+// errorToCode maps an error to gRPC status code. This is the only place where
+// the errors of the server are mapped to the codes.
 var errorToCode = map[error]codes.Code{
 	// InvalidArgument means the request is malformed.
-	converter.ErrPackRequired:       codes.InvalidArgument,
-	converter.ErrCheckpointRequired: codes.InvalidArgument,
-	time.ErrInvalidHexString:        codes.InvalidArgument,
-	time.ErrInvalidActorID:          codes.InvalidArgument,
-	types.ErrInvalidID:              codes.InvalidArgument,
-	clients.ErrInvalidClientID:      codes.InvalidArgument,
-	clients.ErrInvalidClientKey:     codes.InvalidArgument,
-	key.ErrInvalidKey:               codes.InvalidArgument,
-	types.ErrEmptyProjectFields:     codes.InvalidArgument,
+	converter.ErrPackRequired:          codes.InvalidArgument,
+	converter.ErrCheckpointRequired:    codes.InvalidArgument,
+	converter.ErrInvalidActorIndex:     codes.InvalidArgument,
+	converter.ErrTimeTicketRequired:    codes.InvalidArgument,
+	converter.ErrTooManyOperations:     codes.InvalidArgument,
+	converter.ErrTooDeep:               codes.InvalidArgument,
+	converter.ErrStringTooLong:         codes.InvalidArgument,
+	converter.ErrInvalidCompressedPack: codes.InvalidArgument,
+	time.ErrInvalidHexString:           codes.InvalidArgument,
+	time.ErrInvalidActorID:             codes.InvalidArgument,
+	types.ErrInvalidID:                 codes.InvalidArgument,
+	clients.ErrInvalidClientID:         codes.InvalidArgument,
+	clients.ErrInvalidClientKey:        codes.InvalidArgument,
+	key.ErrInvalidKey:                  codes.InvalidArgument,
+	types.ErrEmptyProjectFields:        codes.InvalidArgument,
+	types.ErrInvalidLabel:              codes.InvalidArgument,
+	types.ErrInvalidLabelSelector:      codes.InvalidArgument,
+	types.ErrInvalidTemplateCollection: codes.InvalidArgument,
+	documents.ErrInvalidTemplateRoot:   codes.InvalidArgument,
+	documents.ErrInvalidSnapshotConfig: codes.InvalidArgument,
+	logging.ErrInvalidLogLevel:         codes.InvalidArgument,
+	document.ErrInvalidPath:            codes.InvalidArgument,
+	packs.ErrDuplicateDocument:         codes.InvalidArgument,
+	packs.ErrInvalidExport:             codes.InvalidArgument,
 
 	// NotFound means the requested resource does not exist.
 	database.ErrProjectNotFound:  codes.NotFound,
 	database.ErrClientNotFound:   codes.NotFound,
 	database.ErrDocumentNotFound: codes.NotFound,
 	database.ErrUserNotFound:     codes.NotFound,
+	database.ErrTemplateNotFound: codes.NotFound,
+	sync.ErrSubscriptionNotFound: codes.NotFound,
 
 	// AlreadyExists means the requested resource already exists.
 	database.ErrProjectAlreadyExists:     codes.AlreadyExists,
 	database.ErrProjectNameAlreadyExists: codes.AlreadyExists,
+	packs.ErrDocumentAlreadyExists:       codes.AlreadyExists,
 
 	// FailedPrecondition means the request is rejected because the state of the
 	// system is not the desired state.
-	database.ErrClientNotActivated:      codes.FailedPrecondition,
-	database.ErrDocumentNotAttached:     codes.FailedPrecondition,
-	database.ErrDocumentAlreadyAttached: codes.FailedPrecondition,
-	documents.ErrDocumentAttached:       codes.FailedPrecondition,
-	packs.ErrInvalidServerSeq:           codes.FailedPrecondition,
-	database.ErrConflictOnUpdate:        codes.FailedPrecondition,
+	database.ErrClientNotActivated:            codes.FailedPrecondition,
+	database.ErrDocumentNotAttached:           codes.FailedPrecondition,
+	database.ErrDocumentAlreadyAttached:       codes.FailedPrecondition,
+	documents.ErrDocumentAttached:             codes.FailedPrecondition,
+	packs.ErrInvalidServerSeq:                 codes.FailedPrecondition,
+	packs.ErrChangeLogIncomplete:              codes.FailedPrecondition,
+	projects.ErrPresenceEncryptionKeyRequired: codes.FailedPrecondition,
+	database.ErrConflictOnUpdate:              codes.FailedPrecondition,
+	database.ErrTransactionNotSupported:       codes.FailedPrecondition,
+	ErrUnsupportedSDKVersion:                  codes.FailedPrecondition,
 
 	// Unimplemented means the server does not implement the functionality.
 	converter.ErrUnsupportedOperation:   codes.Unimplemented,
 	converter.ErrUnsupportedElement:     codes.Unimplemented,
 	converter.ErrUnsupportedEventType:   codes.Unimplemented,
 	converter.ErrUnsupportedValueType:   codes.Unimplemented,
+	packs.ErrUnrestorableElement:        codes.Unimplemented,
 	converter.ErrUnsupportedCounterType: codes.Unimplemented,
 
 	// Unauthenticated means the request does not have valid authentication
@@ -81,14 +125,28 @@ var errorToCode = map[error]codes.Code{
 	auth.ErrUnexpectedStatusCode:   codes.Unauthenticated,
 	auth.ErrWebhookTimeout:         codes.Unauthenticated,
 	database.ErrMismatchedPassword: codes.Unauthenticated,
-}
+	auth.ErrInvalidAuthorization:   codes.Unauthenticated,
 
-func detailsFromError(err error) (protoiface.MessageV1, bool) {
-	invalidFieldsError, ok := err.(*validation.StructError)
-	if !ok {
-		return nil, false
-	}
+	metadata.ErrMetadataNotProvided:      codes.Unauthenticated,
+	metadata.ErrAPIKeyNotProvided:        codes.Unauthenticated,
+	metadata.ErrAuthorizationNotProvided: codes.Unauthenticated,
+
+	// PermissionDenied means the caller does not have permission to execute
+	// the specified operation.
+	auth.ErrPermissionDenied: codes.PermissionDenied,
+
+	// ResourceExhausted means the request is rejected by a rate limit or a
+	// quota.
+	types.ErrResourceExhausted: codes.ResourceExhausted,
+	converter.ErrPackTooLarge:  codes.ResourceExhausted,
 
+	// Unavailable means the service is unavailable for the caller for now,
+	// and the caller can retry it.
+	sync.ErrHeartbeatTimeout: codes.Unavailable,
+}
+
+// detailsFromError returns BadRequest with the violations of the given error.
+func detailsFromError(invalidFieldsError *validation.StructError) *errdetails.BadRequest {
 	violations := invalidFieldsError.Violations
 	br := &errdetails.BadRequest{}
 	for _, violation := range violations {
@@ -98,35 +156,127 @@ func detailsFromError(err error) (protoiface.MessageV1, bool) {
 		}
 		br.FieldViolations = append(br.FieldViolations, v)
 	}
-	return br, true
+	return br
+}
+
+// throttleDetails returns the details of the given throttle error. RetryInfo
+// tells the client how long to back off and QuotaFailure tells which quota
+// was exceeded. RetryInfo is omitted for quotas that retrying does not help.
+func throttleDetails(throttleErr *types.ThrottleError) []protoiface.MessageV1 {
+	var details []protoiface.MessageV1
+	if throttleErr.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(throttleErr.RetryAfter),
+		})
+	}
+
+	return append(details, &errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     throttleErr.Subject,
+			Description: throttleErr.Description,
+		}},
+	})
 }
 
 // ToStatusError returns a status.Error from the given logic error. If an error
 // occurs while executing logic in API handler, gRPC status.error should be
 // returned so that the client can know more about the status of the request.
+// Every status has ErrorInfo with the reason of the error so that SDKs can
+// handle errors without parsing the messages.
 func ToStatusError(err error) error {
+	// NOTE: The errors of the requests forwarded to the other servers of the
+	// cluster are already converted to status errors by the servers.
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	// NOTE: ThrottleError has details of the backoff so that SDKs
+	// can retry after the given duration instead of retrying immediately.
+	var throttleErr *types.ThrottleError
+	if errors.As(err, &throttleErr) {
+		return withDetails(
+			status.New(codes.ResourceExhausted, err.Error()),
+			append(
+				[]protoiface.MessageV1{errorInfo(types.ErrResourceExhausted, map[string]string{
+					"subject":     throttleErr.Subject,
+					"retry_after": throttleErr.RetryAfter.String(),
+				})},
+				throttleDetails(throttleErr)...,
+			)...,
+		)
+	}
+
 	cause := err
 	for errors.Unwrap(cause) != nil {
 		cause = errors.Unwrap(cause)
 	}
 	if code, ok := errorToCode[cause]; ok {
-		return status.Error(code, err.Error())
+		return withDetails(status.New(code, err.Error()), errorInfo(cause, nil))
 	}
 
 	// NOTE(hackerwins): InvalidFieldsError has details of invalid fields in
 	// the error message.
 	var invalidFieldsError *validation.StructError
 	if errors.As(err, &invalidFieldsError) {
-		st := status.New(codes.InvalidArgument, err.Error())
-		if details, ok := detailsFromError(err); ok {
-			st, _ = st.WithDetails(details)
-		}
+		return withDetails(
+			status.New(codes.InvalidArgument, err.Error()),
+			errorInfo(ErrInvalidFields, nil),
+			detailsFromError(invalidFieldsError),
+		)
+	}
+
+	return withDetails(status.New(codes.Internal, err.Error()), errorInfo(ErrInternal, nil))
+}
+
+// withDetails returns the error of the given status with the given details.
+// The status is returned without details if they cannot be attached.
+func withDetails(st *status.Status, details ...protoiface.MessageV1) error {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
 		return st.Err()
 	}
 
-	if err := status.Error(codes.Internal, err.Error()); err != nil {
-		return fmt.Errorf("create status error: %w", err)
+	return detailed.Err()
+}
+
+// errorInfo returns ErrorInfo of the given error with the given metadata.
+func errorInfo(err error, metadata map[string]string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason:   ReasonOf(err),
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	}
+}
+
+// ReasonOfStatus returns the reason of ErrorInfo in the details of the given
+// status. It returns an empty string if the status does not have ErrorInfo.
+func ReasonOfStatus(st *status.Status) string {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
 	}
 
-	return nil
+	return ""
+}
+
+// ReasonOf returns the reason of the given error in ErrorInfo. The reason is
+// the message of the error in UPPER_SNAKE_CASE, e.g. "invalid server seq"
+// becomes "INVALID_SERVER_SEQ".
+func ReasonOf(err error) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(err.Error()) {
+		switch {
+		case (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'):
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "_"):
+			b.WriteRune('_')
+		}
+	}
+
+	reason := b.String()
+	if len(reason) > maxReasonLength {
+		reason = reason[:maxReasonLength]
+	}
+	return strings.TrimSuffix(reason, "_")
 }
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpchelper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/rpc/grpchelper"
+)
+
+func errorInfoOf(t *testing.T, err error) *errdetails.ErrorInfo {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+
+	t.Fatalf("no ErrorInfo in %v", err)
+	return nil
+}
+
+func TestToStatusError(t *testing.T) {
+	t.Run("mapped error test", func(t *testing.T) {
+		err := grpchelper.ToStatusError(fmt.Errorf("push pack: %w", packs.ErrInvalidServerSeq))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		info := errorInfoOf(t, err)
+		assert.Equal(t, "INVALID_SERVER_SEQ", info.Reason)
+		assert.Equal(t, grpchelper.ErrorDomain, info.Domain)
+
+		err = grpchelper.ToStatusError(database.ErrDocumentNotFound)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, "DOCUMENT_NOT_FOUND", errorInfoOf(t, err).Reason)
+	})
+
+	t.Run("validation error test", func(t *testing.T) {
+		name := "in valid"
+		err := grpchelper.ToStatusError((&types.UpdatableProjectFields{Name: &name}).Validate())
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "INVALID_FIELDS", errorInfoOf(t, err).Reason)
+
+		var found bool
+		for _, detail := range status.Convert(err).Details() {
+			if _, ok := detail.(*errdetails.BadRequest); ok {
+				found = true
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("unknown error test", func(t *testing.T) {
+		err := grpchelper.ToStatusError(fmt.Errorf("unknown"))
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, "INTERNAL_ERROR", errorInfoOf(t, err).Reason)
+	})
+
+	t.Run("throttle error test", func(t *testing.T) {
+		err := grpchelper.ToStatusError(&types.ThrottleError{Subject: "webhook:auth"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		info := errorInfoOf(t, err)
+		assert.Equal(t, "RESOURCE_EXHAUSTED", info.Reason)
+		assert.Equal(t, "webhook:auth", info.Metadata["subject"])
+	})
+}
+
+func TestReasonOf(t *testing.T) {
+	assert.Equal(t, "DOCUMENT_NOT_ATTACHED", grpchelper.ReasonOf(database.ErrDocumentNotAttached))
+	assert.Equal(t, "INVALID_ARGUMENT", grpchelper.ReasonOf(fmt.Errorf("  invalid (argument) ")))
+	assert.LessOrEqual(t, len(grpchelper.ReasonOf(fmt.Errorf("%0100d", 0))), 63)
+}
@@ -17,6 +17,7 @@
 package grpchelper
 
 import (
+	"errors"
 	"strings"
 
 	grpcmetadata "google.golang.org/grpc/metadata"
@@ -24,6 +25,10 @@ import (
 	"github.com/yorkie-team/yorkie/api/types"
 )
 
+// ErrUnsupportedSDKVersion is returned when the version of the SDK is below the
+// minimum version that the server accepts.
+var ErrUnsupportedSDKVersion = errors.New("unsupported sdk version")
+
 // SDKTypeAndVersion returns the type and version of the SDK from the given
 // metadata.
 func SDKTypeAndVersion(data grpcmetadata.MD) (string, string) {
@@ -33,6 +38,9 @@ func SDKTypeAndVersion(data grpcmetadata.MD) (string, string) {
 	}
 
 	yorkieUserAgent := yorkieUserAgentSlice[0]
-	agent := strings.Split(yorkieUserAgent, "/")
+	agent := strings.SplitN(yorkieUserAgent, "/", 2)
+	if len(agent) < 2 {
+		return agent[0], ""
+	}
 	return agent[0], agent[1]
 }
@@ -22,14 +22,13 @@ import (
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	grpcmetadata "google.golang.org/grpc/metadata"
-	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/rpc/auth"
 	"github.com/yorkie-team/yorkie/server/rpc/grpchelper"
+	"github.com/yorkie-team/yorkie/server/rpc/metadata"
 	"github.com/yorkie-team/yorkie/server/users"
 )
 
@@ -143,22 +142,22 @@ func (i *AdminAuthInterceptor) authenticate(
 ) (*types.User, error) {
 	data, ok := grpcmetadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, grpcstatus.Errorf(codes.Unauthenticated, "metadata is not provided")
+		return nil, grpchelper.ToStatusError(metadata.ErrMetadataNotProvided)
 	}
 
 	authorization := data[types.AuthorizationKey]
 	if len(authorization) == 0 {
-		return nil, grpcstatus.Errorf(codes.Unauthenticated, "authorization is not provided")
+		return nil, grpchelper.ToStatusError(metadata.ErrAuthorizationNotProvided)
 	}
 
 	claims, err := i.tokenManager.Verify(authorization[0])
 	if err != nil {
-		return nil, grpcstatus.Errorf(codes.Unauthenticated, "authorization is invalid")
+		return nil, grpchelper.ToStatusError(auth.ErrInvalidAuthorization)
 	}
 
 	user, err := users.GetUser(ctx, i.backend, claims.Username)
 	if err != nil {
-		return nil, grpcstatus.Errorf(codes.Unauthenticated, "authorization is invalid")
+		return nil, grpchelper.ToStatusError(auth.ErrInvalidAuthorization)
 	}
 
 	return user, nil
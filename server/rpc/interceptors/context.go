@@ -19,18 +19,19 @@ package interceptors
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	grpcmetadata "google.golang.org/grpc/metadata"
-	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/internal/version"
 	"github.com/yorkie-team/yorkie/pkg/cache"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
 	"github.com/yorkie-team/yorkie/server/projects"
 	"github.com/yorkie-team/yorkie/server/rpc/grpchelper"
 	"github.com/yorkie-team/yorkie/server/rpc/metadata"
@@ -44,7 +45,10 @@ type ContextInterceptor struct {
 
 // NewContextInterceptor creates a new instance of ContextInterceptor.
 func NewContextInterceptor(be *backend.Backend) *ContextInterceptor {
-	projectInfoCache, err := cache.NewLRUExpireCache[string, *types.Project](be.Config.ProjectInfoCacheSize)
+	projectInfoCache, err := cache.NewLRUExpireCacheWithClock[string, *types.Project](
+		be.Config.ProjectInfoCacheSize,
+		be.Clock,
+	)
 	if err != nil {
 		logging.DefaultLogger().Fatal("Failed to create project info cache: %v", err)
 	}
@@ -71,6 +75,16 @@ func (i *ContextInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return nil, err
 		}
 
+		deprecation, err := i.verifySDKVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if deprecation != "" {
+			if err := grpc.SetHeader(ctx, grpcmetadata.Pairs(types.DeprecationKey, deprecation)); err != nil {
+				logging.From(ctx).Warn(err)
+			}
+		}
+
 		resp, err = handler(ctx, req)
 
 		data, ok := grpcmetadata.FromIncomingContext(ctx)
@@ -106,6 +120,16 @@ func (i *ContextInterceptor) Stream() grpc.StreamServerInterceptor {
 			return err
 		}
 
+		deprecation, err := i.verifySDKVersion(ctx)
+		if err != nil {
+			return err
+		}
+		if deprecation != "" {
+			if err := ss.SetHeader(grpcmetadata.Pairs(types.DeprecationKey, deprecation)); err != nil {
+				logging.From(ctx).Warn(err)
+			}
+		}
+
 		wrapped := grpcmiddleware.WrapServerStream(ss)
 		wrapped.WrappedContext = ctx
 
@@ -138,12 +162,12 @@ func (i *ContextInterceptor) buildContext(ctx context.Context) (context.Context,
 	md := metadata.Metadata{}
 	data, ok := grpcmetadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, grpcstatus.Errorf(codes.Unauthenticated, "metadata is not provided")
+		return nil, grpchelper.ToStatusError(metadata.ErrMetadataNotProvided)
 	}
 
 	apiKey := data[types.APIKeyKey]
 	if len(apiKey) == 0 && !i.backend.Config.UseDefaultProject {
-		return nil, grpcstatus.Errorf(codes.Unauthenticated, "api key is not provided")
+		return nil, grpchelper.ToStatusError(metadata.ErrAPIKeyNotProvided)
 	}
 	if len(apiKey) > 0 {
 		md.APIKey = apiKey[0]
@@ -170,3 +194,50 @@ func (i *ContextInterceptor) buildContext(ctx context.Context) (context.Context,
 
 	return ctx, nil
 }
+
+// verifySDKVersion verifies the version of the SDK that sent the request. It
+// rejects the request if the SDK is below the minimum version, and returns a
+// deprecation message if the SDK is below the recommended version.
+func (i *ContextInterceptor) verifySDKVersion(ctx context.Context) (string, error) {
+	data, ok := grpcmetadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	sdkType, sdkVersion := grpchelper.SDKTypeAndVersion(data)
+	if sdkVersion == "" || version.Validate(sdkVersion) != nil {
+		// NOTE: SDKs that do not send a parsable version, such as
+		// custom builds, are served as is to avoid breaking them.
+		return "", nil
+	}
+
+	if minVersion := i.backend.Config.MinClientVersion; minVersion != "" {
+		if cmp, err := version.Compare(sdkVersion, minVersion); err == nil && cmp < 0 {
+			i.backend.Metrics.AddSDKVersionCheck(sdkType, sdkVersion, prometheus.SDKVersionRejected)
+			logging.From(ctx).Warnf(
+				"SDK : reject %s/%s, minimum version is %s",
+				sdkType, sdkVersion, minVersion,
+			)
+			return "", grpchelper.ToStatusError(fmt.Errorf(
+				"%s/%s is below %s: %w",
+				sdkType, sdkVersion, minVersion, grpchelper.ErrUnsupportedSDKVersion,
+			))
+		}
+	}
+
+	if recommended := i.backend.Config.RecommendedClientVersion; recommended != "" {
+		if cmp, err := version.Compare(sdkVersion, recommended); err == nil && cmp < 0 {
+			i.backend.Metrics.AddSDKVersionCheck(sdkType, sdkVersion, prometheus.SDKVersionDeprecated)
+			logging.From(ctx).Debugf(
+				"SDK : deprecated %s/%s, recommended version is %s",
+				sdkType, sdkVersion, recommended,
+			)
+			return fmt.Sprintf(
+				"%s/%s is deprecated, upgrade to %s or later",
+				sdkType, sdkVersion, recommended,
+			), nil
+		}
+	}
+
+	return "", nil
+}
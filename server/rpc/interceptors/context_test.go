@@ -0,0 +1,109 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	grpcmetadata "google.golang.org/grpc/metadata"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+)
+
+// sdkVersionChecks returns the count of the version checks of the SDK of the
+// given version with the given result in the metrics of the given backend.
+func sdkVersionChecks(t *testing.T, be *backend.Backend, sdkVersion, result string) float64 {
+	families, err := be.Metrics.Registry().Gather()
+	assert.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "yorkie_sdk_version_checks_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["sdk_type"] == "yorkie-go-sdk" &&
+				labels["sdk_version"] == sdkVersion &&
+				labels["result"] == result {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func TestContextInterceptor(t *testing.T) {
+	t.Run("sdk version metrics test", func(t *testing.T) {
+		met, err := prometheus.NewMetrics()
+		assert.NoError(t, err)
+		be, err := backend.New(&backend.Config{
+			AdminUser:                 "admin",
+			AdminPassword:             "admin",
+			ClientDeactivateThreshold: "24h",
+			SnapshotInterval:          10,
+			AuthWebhookCacheSize:      100,
+			ProjectInfoCacheSize:      256,
+			ProjectInfoCacheTTL:       "5s",
+			AdminTokenDuration:        "10s",
+			MinClientVersion:          "0.4.0",
+			RecommendedClientVersion:  "0.5.0",
+		}, nil, nil, nil, &housekeeping.Config{
+			Interval:                  "10s",
+			CandidatesLimitPerProject: 10,
+			ProjectFetchSize:          10,
+		}, met)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, be.Shutdown()) }()
+
+		interceptor := NewContextInterceptor(be)
+		verify := func(sdkVersion string) (string, error) {
+			ctx := logging.With(context.Background(), logging.New("test"))
+			ctx = grpcmetadata.NewIncomingContext(ctx, grpcmetadata.Pairs(
+				types.UserAgentKey, "yorkie-go-sdk/"+sdkVersion,
+			))
+			return interceptor.verifySDKVersion(ctx)
+		}
+
+		// 01. the SDKs below the minimum version are rejected.
+		_, err = verify("0.3.0")
+		assert.Error(t, err)
+		assert.Equal(t, float64(1), sdkVersionChecks(t, be, "0.3.0", prometheus.SDKVersionRejected))
+
+		// 02. the SDKs below the recommended version are deprecated.
+		deprecation, err := verify("0.4.5")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, deprecation)
+		_, err = verify("0.4.5")
+		assert.NoError(t, err)
+		assert.Equal(t, float64(2), sdkVersionChecks(t, be, "0.4.5", prometheus.SDKVersionDeprecated))
+
+		// 03. the SDKs of the recommended version are not counted.
+		deprecation, err = verify("0.5.0")
+		assert.NoError(t, err)
+		assert.Empty(t, deprecation)
+		assert.Equal(t, float64(0), sdkVersionChecks(t, be, "0.5.0", prometheus.SDKVersionDeprecated))
+	})
+}
@@ -44,7 +44,7 @@ func (i *DefaultInterceptor) Unary() grpc.UnaryServerInterceptor {
 	) (interface{}, error) {
 		start := gotime.Now()
 		resp, err := handler(ctx, req)
-		reqLogger := logging.From(ctx)
+		reqLogger := logging.FromModule(ctx, "rpc")
 		if err != nil {
 			reqLogger.Warnf("RPC : %q %s: %q => %q", info.FullMethod, gotime.Since(start), req, err)
 			return nil, grpchelper.ToStatusError(err)
@@ -65,7 +65,7 @@ func (i *DefaultInterceptor) Stream() grpc.StreamServerInterceptor {
 		info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler,
 	) error {
-		reqLogger := logging.From(ss.Context())
+		reqLogger := logging.FromModule(ss.Context(), "rpc")
 
 		start := gotime.Now()
 		err := handler(srv, ss)
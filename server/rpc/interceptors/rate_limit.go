@@ -0,0 +1,158 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors
+
+import (
+	"context"
+
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/ratelimit"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/projects"
+	"github.com/yorkie-team/yorkie/server/rpc/grpchelper"
+)
+
+// rateLimitMaxKeys is the maximum number of clients and projects whose
+// buckets are tracked by each limiter.
+const rateLimitMaxKeys = 100000
+
+// rateLimitedMethods are the methods that are limited by RateLimitInterceptor.
+var rateLimitedMethods = map[string]bool{
+	"/yorkie.v1.YorkieService/PushPullChanges":       true,
+	"/yorkie.v1.YorkieService/PushPullChangesStream": true,
+	"/yorkie.v1.YorkieService/PushPullChangesMulti":  true,
+	"/yorkie.v1.YorkieService/BatchPushPullChanges":  true,
+	"/yorkie.v1.YorkieService/WatchDocument":         true,
+	"/yorkie.v1.YorkieService/ReadDocument":          true,
+}
+
+// RateLimitInterceptor is an interceptor for limiting the rate of the calls
+// of each client and each project.
+type RateLimitInterceptor struct {
+	clientLimiter  *ratelimit.Limiter
+	projectLimiter *ratelimit.Limiter
+}
+
+// NewRateLimitInterceptor creates a new instance of RateLimitInterceptor.
+func NewRateLimitInterceptor(be *backend.Backend) *RateLimitInterceptor {
+	i := &RateLimitInterceptor{}
+
+	var err error
+	if rate := be.Config.ClientRateLimit; rate > 0 {
+		i.clientLimiter, err = ratelimit.New(be.Clock, rate, be.Config.ClientRateLimitBurst, rateLimitMaxKeys)
+		if err != nil {
+			logging.DefaultLogger().Fatal("Failed to create client rate limiter: %v", err)
+		}
+	}
+	if rate := be.Config.ProjectRateLimit; rate > 0 {
+		i.projectLimiter, err = ratelimit.New(be.Clock, rate, be.Config.ProjectRateLimitBurst, rateLimitMaxKeys)
+		if err != nil {
+			logging.DefaultLogger().Fatal("Failed to create project rate limiter: %v", err)
+		}
+	}
+
+	return i
+}
+
+// Unary creates a unary server interceptor for limiting the rate of calls.
+func (i *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !rateLimitedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		if err := i.allow(ctx, req); err != nil {
+			return nil, grpchelper.ToStatusError(err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream creates a stream server interceptor for limiting the rate of calls.
+// Each message received from the stream is counted as a call.
+func (i *RateLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !rateLimitedMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+
+		return handler(srv, &rateLimitedStream{
+			WrappedServerStream: grpcmiddleware.WrapServerStream(ss),
+			interceptor:         i,
+		})
+	}
+}
+
+// allow checks the limits of the client of the given request and its project.
+// It returns ThrottleError if one of them is exceeded.
+func (i *RateLimitInterceptor) allow(ctx context.Context, req interface{}) error {
+	if i.clientLimiter != nil {
+		if r, ok := req.(interface{ GetClientId() string }); ok {
+			if allowed, wait := i.clientLimiter.Allow(r.GetClientId()); !allowed {
+				return &types.ThrottleError{
+					Subject:     "client:" + r.GetClientId(),
+					Description: "too many requests",
+					RetryAfter:  wait,
+				}
+			}
+		}
+	}
+
+	if i.projectLimiter != nil {
+		projectID := projects.From(ctx).ID.String()
+		if allowed, wait := i.projectLimiter.Allow(projectID); !allowed {
+			return &types.ThrottleError{
+				Subject:     "project:" + projectID,
+				Description: "too many requests",
+				RetryAfter:  wait,
+			}
+		}
+	}
+
+	return nil
+}
+
+// rateLimitedStream is a server stream that checks the limits whenever a
+// message is received.
+type rateLimitedStream struct {
+	*grpcmiddleware.WrappedServerStream
+	interceptor *RateLimitInterceptor
+}
+
+// RecvMsg receives a message from the stream and checks the limits of it.
+func (s *rateLimitedStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.interceptor.allow(s.Context(), m)
+}
@@ -19,6 +19,20 @@ package metadata
 
 import (
 	"context"
+	"errors"
+)
+
+var (
+	// ErrMetadataNotProvided is returned when the request has no metadata.
+	ErrMetadataNotProvided = errors.New("metadata is not provided")
+
+	// ErrAPIKeyNotProvided is returned when the request has no API key and
+	// the default project is not used.
+	ErrAPIKeyNotProvided = errors.New("api key is not provided")
+
+	// ErrAuthorizationNotProvided is returned when the request has no
+	// authorization.
+	ErrAuthorizationNotProvided = errors.New("authorization is not provided")
 )
 
 // metadataKey is the key for the context.Context.
@@ -26,11 +26,14 @@ import (
 	"time"
 
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/internal/compression"
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/listener"
 	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/server/rpc/auth"
 	"github.com/yorkie-team/yorkie/server/rpc/grpchelper"
 	"github.com/yorkie-team/yorkie/server/rpc/interceptors"
+	"github.com/yorkie-team/yorkie/server/rpc/web"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
@@ -46,43 +49,38 @@ type Server struct {
 	grpcServer          *grpc.Server
 	yorkieServiceCancel context.CancelFunc
 	tokenManager        *auth.TokenManager
-}
+	forwarder           *forwarder
 
-// NewServer creates a new instance of Server.
-func NewServer(conf *Config, be *backend.Backend) (*Server, error) {
-	tokenManager := auth.NewTokenManager(
-		be.Config.SecretKey,
-		be.Config.ParseAdminTokenDuration(),
-	)
+	adminConf   *AdminConfig
+	adminServer *grpc.Server
 
-	loggingInterceptor := grpchelper.NewLoggingInterceptor()
-	adminAuthInterceptor := interceptors.NewAdminAuthInterceptor(be, tokenManager)
-	contextInterceptor := interceptors.NewContextInterceptor(be)
-	defaultInterceptor := interceptors.NewDefaultInterceptor()
+	// webServer and adminWebServer serve the gRPC servers over HTTP when the
+	// web protocols are enabled.
+	webServer      *web.Server
+	adminWebServer *web.Server
+}
 
-	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
-			loggingInterceptor.Unary(),
-			be.Metrics.ServerMetrics().UnaryServerInterceptor(),
-			adminAuthInterceptor.Unary(),
-			contextInterceptor.Unary(),
-			defaultInterceptor.Unary(),
-		)),
-		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
-			loggingInterceptor.Stream(),
-			be.Metrics.ServerMetrics().StreamServerInterceptor(),
-			adminAuthInterceptor.Stream(),
-			contextInterceptor.Stream(),
-			defaultInterceptor.Stream(),
-		)),
+// NewServer creates a new instance of Server. If the given authProvider is
+// nil, the users are authenticated by the settings of their projects. If the
+// given adminConf is nil, the admin service is served along with the RPC.
+func NewServer(
+	conf *Config,
+	adminConf *AdminConfig,
+	be *backend.Backend,
+	authProvider auth.Provider,
+) (*Server, error) {
+	if authProvider == nil {
+		authProvider = auth.NewDefaultProvider(be)
 	}
 
-	if conf.CertFile != "" && conf.KeyFile != "" {
-		creds, err := credentials.NewServerTLSFromFile(conf.CertFile, conf.KeyFile)
-		if err != nil {
-			return nil, fmt.Errorf("load TLS cert: %w", err)
-		}
-		opts = append(opts, grpc.Creds(creds))
+	tokenManager, err := newTokenManager(adminConf, be)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := newServerOptions(be, tokenManager, conf.CertFile, conf.KeyFile)
+	if err != nil {
+		return nil, err
 	}
 
 	maxConnectionAge, err := time.ParseDuration(conf.MaxConnectionAge)
@@ -103,52 +101,260 @@ func NewServer(conf *Config, be *backend.Backend) (*Server, error) {
 		MaxConnectionAgeGrace: maxConnectionAgeGrace,
 	}))
 
+	// NOTE: The compressors are registered to gRPC globally, and the server
+	// replies with the compressor that the client used for the request.
+	compression.SetMinSize(conf.CompressionMinSize)
+	compression.SetObserver(be.Metrics.ObserveCompression)
+
+	var adminServer *grpc.Server
+	if adminConf != nil {
+		adminOpts, err := newServerOptions(be, tokenManager, adminConf.CertFile, adminConf.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		adminServer = grpc.NewServer(adminOpts...)
+		healthpb.RegisterHealthServer(adminServer, health.NewServer())
+		api.RegisterAdminServiceServer(adminServer, newAdminServer(be, tokenManager))
+		be.Metrics.RegisterGRPCServer(adminServer)
+	}
+
+	var fwd *forwarder
+	if be.Cluster != nil {
+		fwd, err = newForwarder(be.Cluster.Self().ID, be.Config.ClusterSecret, conf.CertFile, be.Clock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	trustedProxies, err := grpchelper.ParseTrustedProxies(conf.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
 	yorkieServiceCtx, yorkieServiceCancel := context.WithCancel(context.Background())
 
+	service, err := newYorkieServer(
+		yorkieServiceCtx,
+		be,
+		authProvider,
+		conf.MaxStreamedPackBytes,
+		fwd,
+		trustedProxies,
+	)
+	if err != nil {
+		yorkieServiceCancel()
+		return nil, err
+	}
+
 	grpcServer := grpc.NewServer(opts...)
 	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
-	api.RegisterYorkieServiceServer(grpcServer, newYorkieServer(yorkieServiceCtx, be))
-	api.RegisterAdminServiceServer(grpcServer, newAdminServer(be, tokenManager))
+	api.RegisterYorkieServiceServer(grpcServer, service)
+	if adminConf == nil {
+		api.RegisterAdminServiceServer(grpcServer, newAdminServer(be, tokenManager))
+	}
 	be.Metrics.RegisterGRPCServer(grpcServer)
 
 	return &Server{
 		conf:                conf,
 		grpcServer:          grpcServer,
 		yorkieServiceCancel: yorkieServiceCancel,
+		tokenManager:        tokenManager,
+		forwarder:           fwd,
+		adminConf:           adminConf,
+		adminServer:         adminServer,
 	}, nil
 }
 
+// newTokenManager creates the token manager of the admin service. The secret
+// key and the token duration of the given adminConf take precedence over the
+// ones of the backend.
+func newTokenManager(adminConf *AdminConfig, be *backend.Backend) (*auth.TokenManager, error) {
+	secretKey := be.Config.SecretKey
+	tokenDuration := be.Config.ParseAdminTokenDuration()
+	if adminConf == nil {
+		return auth.NewTokenManager(secretKey, tokenDuration), nil
+	}
+
+	if adminConf.SecretKey != "" {
+		secretKey = adminConf.SecretKey
+	}
+	if adminConf.TokenDuration != "" {
+		duration, err := time.ParseDuration(adminConf.TokenDuration)
+		if err != nil {
+			return nil, fmt.Errorf("parse admin token duration: %w", err)
+		}
+		tokenDuration = duration
+	}
+
+	return auth.NewTokenManager(secretKey, tokenDuration), nil
+}
+
+// newServerOptions returns the options of the gRPC servers with the
+// interceptors and the credentials of the given files.
+func newServerOptions(
+	be *backend.Backend,
+	tokenManager *auth.TokenManager,
+	certFile, keyFile string,
+) ([]grpc.ServerOption, error) {
+	loggingInterceptor := grpchelper.NewLoggingInterceptor()
+	adminAuthInterceptor := interceptors.NewAdminAuthInterceptor(be, tokenManager)
+	contextInterceptor := interceptors.NewContextInterceptor(be)
+	rateLimitInterceptor := interceptors.NewRateLimitInterceptor(be)
+	defaultInterceptor := interceptors.NewDefaultInterceptor()
+
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
+			loggingInterceptor.Unary(),
+			be.Metrics.ServerMetrics().UnaryServerInterceptor(),
+			adminAuthInterceptor.Unary(),
+			contextInterceptor.Unary(),
+			rateLimitInterceptor.Unary(),
+			defaultInterceptor.Unary(),
+		)),
+		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
+			loggingInterceptor.Stream(),
+			be.Metrics.ServerMetrics().StreamServerInterceptor(),
+			adminAuthInterceptor.Stream(),
+			contextInterceptor.Stream(),
+			rateLimitInterceptor.Stream(),
+			defaultInterceptor.Stream(),
+		)),
+	}
+
+	if certFile != "" && keyFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load TLS cert: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	return opts, nil
+}
+
 // Start starts this server by opening the rpc port.
 func (s *Server) Start() error {
-	return s.listenAndServeGRPC()
+	addresses := listener.Addresses(s.conf.Addresses, s.conf.Port)
+	if s.conf.EnableWebProtocols {
+		webServer, err := serveWeb(s.grpcServer, "RPC", addresses, s.conf.CertFile, s.conf.KeyFile)
+		if err != nil {
+			return err
+		}
+		s.webServer = webServer
+	} else if err := serveGRPC(s.grpcServer, "RPC", addresses); err != nil {
+		return err
+	}
+
+	if s.adminServer != nil {
+		addresses := listener.Addresses(s.adminConf.Addresses, s.adminConf.Port)
+		var err error
+		if s.conf.EnableWebProtocols {
+			s.adminWebServer, err = serveWeb(
+				s.adminServer,
+				"admin",
+				addresses,
+				s.adminConf.CertFile,
+				s.adminConf.KeyFile,
+			)
+		} else {
+			err = serveGRPC(s.adminServer, "admin", addresses)
+		}
+		if err != nil {
+			stop(s.grpcServer, s.webServer, false)
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Shutdown shuts down this server.
 func (s *Server) Shutdown(graceful bool) {
 	s.yorkieServiceCancel()
 
-	if graceful {
-		s.grpcServer.GracefulStop()
-	} else {
-		s.grpcServer.Stop()
+	stop(s.grpcServer, s.webServer, graceful)
+	if s.adminServer != nil {
+		stop(s.adminServer, s.adminWebServer, graceful)
+	}
+
+	if s.forwarder != nil {
+		s.forwarder.Close()
+	}
+}
+
+// serveGRPC serves the given gRPC server on the given addresses.
+func serveGRPC(grpcServer *grpc.Server, name string, addresses []string) error {
+	listeners, err := listener.ListenAll(addresses)
+	if err != nil {
+		return err
+	}
+
+	for i, lis := range listeners {
+		go func(address string, lis net.Listener) {
+			logging.DefaultLogger().Infof("serving %s on %s", name, address)
+
+			if err := grpcServer.Serve(lis); err != nil {
+				if err != grpc.ErrServerStopped {
+					logging.DefaultLogger().Error(err)
+				}
+			}
+		}(addresses[i], lis)
 	}
+
+	return nil
 }
 
-func (s *Server) listenAndServeGRPC() error {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.conf.Port))
+// serveWeb serves the given gRPC server over HTTP on the given addresses with
+// gRPC-Web and Connect along with gRPC.
+func serveWeb(
+	grpcServer *grpc.Server,
+	name string,
+	addresses []string,
+	certFile string,
+	keyFile string,
+) (*web.Server, error) {
+	webServer, err := web.NewServer(grpcServer, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	listeners, err := listener.ListenAll(addresses)
 	if err != nil {
-		return fmt.Errorf("listen port %d: %w", s.conf.Port, err)
+		return nil, err
 	}
 
-	go func() {
-		logging.DefaultLogger().Infof("serving RPC on %d", s.conf.Port)
+	for i, lis := range listeners {
+		go func(address string, lis net.Listener) {
+			logging.DefaultLogger().Infof("serving %s with web protocols on %s", name, address)
 
-		if err := s.grpcServer.Serve(lis); err != nil {
-			if err != grpc.ErrServerStopped {
+			if err := webServer.Serve(lis); err != nil {
 				logging.DefaultLogger().Error(err)
 			}
+		}(addresses[i], lis)
+	}
+
+	return webServer, nil
+}
+
+// stop stops the given gRPC server and the web server serving it if any.
+//
+// NOTE: The web server is stopped before the gRPC server, since the gRPC
+// server cannot drain the calls served by its ServeHTTP gracefully.
+func stop(grpcServer *grpc.Server, webServer *web.Server, graceful bool) {
+	if webServer != nil {
+		if graceful {
+			webServer.GracefulStop()
+		} else {
+			webServer.Stop()
 		}
-	}()
+		grpcServer.Stop()
+		return
+	}
 
-	return nil
+	if graceful {
+		grpcServer.GracefulStop()
+	} else {
+		grpcServer.Stop()
+	}
 }
@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	grpcmetadata "google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/admin"
@@ -39,6 +40,7 @@ import (
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/backend/database/mongo"
 	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/server/listener"
 	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
 	"github.com/yorkie-team/yorkie/server/rpc"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -84,7 +86,7 @@ func TestMain(m *testing.M) {
 		YorkieDatabase:    helper.TestDBName(),
 		ConnectionTimeout: helper.MongoConnectionTimeout,
 		PingTimeout:       helper.MongoPingTimeout,
-	}, &housekeeping.Config{
+	}, nil, nil, &housekeeping.Config{
 		Interval:                  helper.HousekeepingInterval.String(),
 		CandidatesLimitPerProject: helper.HousekeepingCandidatesLimitPerProject,
 		ProjectFetchSize:          helper.HousekeepingProjectFetchSize,
@@ -106,7 +108,7 @@ func TestMain(m *testing.M) {
 		MaxRequestBytes:       helper.RPCMaxRequestBytes,
 		MaxConnectionAge:      helper.RPCMaxConnectionAge.String(),
 		MaxConnectionAgeGrace: helper.RPCMaxConnectionAgeGrace.String(),
-	}, be)
+	}, nil, be, nil)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -917,6 +919,54 @@ func TestAdminRPCServerBackend(t *testing.T) {
 		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
 	})
 
+	t.Run("admin list clients test", func(t *testing.T) {
+		resp, err := testAdminClient.LogIn(
+			context.Background(),
+			&api.LogInRequest{
+				Username: helper.AdminUser,
+				Password: helper.AdminPassword,
+			},
+		)
+		assert.NoError(t, err)
+
+		testAdminAuthInterceptor.SetToken(resp.Token)
+
+		ctx := grpcmetadata.AppendToOutgoingContext(
+			context.Background(),
+			"x-forwarded-for", "203.0.113.1, 10.0.0.1",
+			"x-yorkie-user-agent", "yorkie-js-sdk/0.4.5",
+			"x-grpc-web", "1",
+		)
+		activateResp, err := testClient.ActivateClient(
+			ctx,
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		listResp, err := testAdminClient.ListClients(
+			context.Background(),
+			&api.ListClientsRequest{
+				ProjectName: defaultProjectName,
+				PageSize:    1,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Len(t, listResp.Clients, 1)
+		assert.Equal(t, activateResp.ClientId, listResp.Clients[0].Id)
+		assert.Equal(t, "203.0.113.1", listResp.Clients[0].Connection.Ip)
+		assert.Equal(t, "yorkie-js-sdk/0.4.5", listResp.Clients[0].Connection.UserAgent)
+		assert.Equal(t, "grpc-web", listResp.Clients[0].Connection.Source)
+
+		// try to list clients with non-existing project name
+		_, err = testAdminClient.ListClients(
+			context.Background(),
+			&api.ListClientsRequest{
+				ProjectName: invalidSlugName,
+			},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
 	t.Run("admin get document test", func(t *testing.T) {
 		testDocumentKey := helper.TestDocKey(t).String()
 
@@ -1052,6 +1102,43 @@ func TestConfig_Validate(t *testing.T) {
 			MaxConnectionAgeGrace: "10s",
 		},
 			expected: nil},
+		{config: &rpc.Config{
+			Port:                  11101,
+			MaxConnectionAge:      "50s",
+			MaxConnectionAgeGrace: "10s",
+			CompressionMinSize:    -1,
+		},
+			expected: rpc.ErrInvalidCompressionMinSize},
+		{config: &rpc.Config{
+			Port:                  11101,
+			MaxConnectionAge:      "50s",
+			MaxConnectionAgeGrace: "10s",
+			Addresses:             []string{"unix:"},
+		},
+			expected: listener.ErrInvalidAddress},
+	}
+	for _, scenario := range scenarios {
+		assert.ErrorIs(t, scenario.config.Validate(), scenario.expected, "provided config: %#v", scenario.config)
+	}
+}
+
+func TestAdminConfig_Validate(t *testing.T) {
+	scenarios := []*struct {
+		config   *rpc.AdminConfig
+		expected error
+	}{
+		{config: &rpc.AdminConfig{Port: 0}, expected: rpc.ErrInvalidAdminPort},
+		{config: &rpc.AdminConfig{Port: 11103, CertFile: "noSuchCertFile"}, expected: rpc.ErrInvalidCertFile},
+		{config: &rpc.AdminConfig{Port: 11103, KeyFile: "noSuchKeyFile"}, expected: rpc.ErrInvalidKeyFile},
+		{config: &rpc.AdminConfig{Port: 11103, TokenDuration: "1 day"}, expected: rpc.ErrInvalidAdminTokenDuration},
+		{config: &rpc.AdminConfig{Port: 11103, Addresses: []string{"localhost"}}, expected: listener.ErrInvalidAddress},
+		{config: &rpc.AdminConfig{
+			Port:          11103,
+			Addresses:     []string{"127.0.0.1:11103"},
+			SecretKey:     "admin-secret",
+			TokenDuration: "1h",
+		},
+			expected: nil},
 	}
 	for _, scenario := range scenarios {
 		assert.ErrorIs(t, scenario.config.Validate(), scenario.expected, "provided config: %#v", scenario.config)
@@ -0,0 +1,346 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	gojson "encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxGRPCTimeoutDigits is the maximum number of the digits of the timeout of
+// gRPC.
+const maxGRPCTimeoutDigits = 8
+
+// connectCodes is the names of the codes of Connect.
+var connectCodes = map[codes.Code]string{
+	codes.Canceled:           "canceled",
+	codes.Unknown:            "unknown",
+	codes.InvalidArgument:    "invalid_argument",
+	codes.DeadlineExceeded:   "deadline_exceeded",
+	codes.NotFound:           "not_found",
+	codes.AlreadyExists:      "already_exists",
+	codes.PermissionDenied:   "permission_denied",
+	codes.ResourceExhausted:  "resource_exhausted",
+	codes.FailedPrecondition: "failed_precondition",
+	codes.Aborted:            "aborted",
+	codes.OutOfRange:         "out_of_range",
+	codes.Unimplemented:      "unimplemented",
+	codes.Internal:           "internal",
+	codes.Unavailable:        "unavailable",
+	codes.DataLoss:           "data_loss",
+	codes.Unauthenticated:    "unauthenticated",
+}
+
+// connectStatusCodes is the HTTP status codes of the errors of Connect unary
+// calls.
+var connectStatusCodes = map[codes.Code]int{
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// connectError is the JSON representation of the errors of Connect.
+type connectError struct {
+	Code    string               `json:"code"`
+	Message string               `json:"message,omitempty"`
+	Details []connectErrorDetail `json:"details,omitempty"`
+}
+
+// connectErrorDetail is the JSON representation of the details of the errors
+// of Connect.
+type connectErrorDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// connectEndStream is the JSON representation of the end of the responses of
+// Connect streaming calls.
+type connectEndStream struct {
+	Error    *connectError       `json:"error,omitempty"`
+	Metadata map[string][]string `json:"metadata,omitempty"`
+}
+
+// serveConnectUnary serves the given Connect unary request. The message of the
+// request is framed for the gRPC server, and the response of the server is
+// buffered to be written without the framing, or as a JSON error.
+func (h *Handler) serveConnectUnary(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeConnectError(w, codes.InvalidArgument, &connectError{
+			Code:    connectCodes[codes.InvalidArgument],
+			Message: "read request: " + err.Error(),
+		})
+		return
+	}
+
+	var flags byte
+	if encoding := r.Header.Get("Content-Encoding"); encoding != "" && encoding != "identity" {
+		flags = envelopeFlagCompressed
+		r.Header.Set(grpcEncodingHeader, encoding)
+	}
+	if encoding := r.Header.Get("Accept-Encoding"); encoding != "" {
+		r.Header.Set(grpcAcceptEncodingHeader, encoding)
+	}
+	req := newGRPCRequest(r, bytes.NewReader(appendEnvelope(nil, flags, body)))
+	req.Header.Del("Content-Encoding")
+	req.Header.Del("Accept-Encoding")
+
+	rw := &bufferedResponseWriter{header: make(http.Header)}
+	h.grpcServer.ServeHTTP(rw, req)
+
+	headers, trailers := headersOf(rw.header), trailersOf(rw.header)
+	code, connErr := statusOf(trailers)
+	for k, vv := range headers {
+		if isGRPCHeader(k) {
+			continue
+		}
+		w.Header()[k] = vv
+	}
+	for k, vv := range trailers {
+		if isGRPCHeader(k) {
+			continue
+		}
+		w.Header()[connectTrailerPrefix+k] = vv
+	}
+	if connErr != nil {
+		writeConnectError(w, code, connErr)
+		return
+	}
+
+	flags, payload, ok := readEnvelope(rw.body.Bytes())
+	if !ok {
+		writeConnectError(w, codes.Internal, &connectError{
+			Code:    connectCodes[codes.Internal],
+			Message: "invalid response message",
+		})
+		return
+	}
+	w.Header().Set("Content-Type", connectUnaryContentType)
+	if flags&envelopeFlagCompressed != 0 {
+		w.Header().Set("Content-Encoding", headers.Get(grpcEncodingHeader))
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(payload)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(payload)
+}
+
+// serveConnectStream serves the given Connect streaming request. The messages
+// are framed in the same way as gRPC, and the trailers of the response are
+// written at the end of the body as a JSON message with the end stream flag.
+func (h *Handler) serveConnectStream(w http.ResponseWriter, r *http.Request) {
+	if encoding := r.Header.Get(connectContentEncoding); encoding != "" {
+		r.Header.Set(grpcEncodingHeader, encoding)
+	}
+	if encoding := r.Header.Get(connectAcceptEncoding); encoding != "" {
+		r.Header.Set(grpcAcceptEncodingHeader, encoding)
+	}
+	req := newGRPCRequest(r, nil)
+	req.Header.Del(connectContentEncoding)
+	req.Header.Del(connectAcceptEncoding)
+
+	rw := &responseWriter{
+		w:      w,
+		header: make(http.Header),
+		writeHeader: func(header http.Header) {
+			headers := headersOf(header)
+			for k, vv := range headers {
+				if isGRPCHeader(k) {
+					continue
+				}
+				w.Header()[k] = vv
+			}
+			w.Header().Set("Content-Type", connectStreamContentType)
+			if encoding := headers.Get(grpcEncodingHeader); encoding != "" {
+				w.Header().Set(connectContentEncoding, encoding)
+			}
+		},
+	}
+	h.grpcServer.ServeHTTP(rw, req)
+	rw.WriteHeader(http.StatusOK)
+
+	trailers := trailersOf(rw.header)
+	_, connErr := statusOf(trailers)
+	end := connectEndStream{Error: connErr}
+	for k, vv := range trailers {
+		if isGRPCHeader(k) {
+			continue
+		}
+		if end.Metadata == nil {
+			end.Metadata = make(map[string][]string)
+		}
+		end.Metadata[k] = vv
+	}
+	payload, err := gojson.Marshal(&end)
+	if err != nil {
+		return
+	}
+	if _, err := w.Write(appendEnvelope(nil, connectFlagEndStream, payload)); err != nil {
+		return
+	}
+	rw.Flush()
+}
+
+// writeConnectError writes the given error as the response of a Connect unary
+// call.
+func writeConnectError(w http.ResponseWriter, code codes.Code, connErr *connectError) {
+	statusCode, ok := connectStatusCodes[code]
+	if !ok {
+		statusCode = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", jsonContentType)
+	w.Header().Del("Content-Length")
+	w.WriteHeader(statusCode)
+	_ = gojson.NewEncoder(w).Encode(connErr)
+}
+
+// statusOf returns the code of the given trailers of gRPC, and its error of
+// Connect. The error is nil if the call succeeded.
+func statusOf(trailers http.Header) (codes.Code, *connectError) {
+	value := trailers.Get(grpcStatusHeader)
+	if value == "0" {
+		return codes.OK, nil
+	}
+
+	code := codes.Unknown
+	if c, err := strconv.ParseUint(value, 10, 32); err == nil {
+		code = codes.Code(c)
+	}
+	connErr := &connectError{
+		Code:    connectCodes[code],
+		Message: decodeGRPCMessage(trailers.Get(grpcMessageHeader)),
+	}
+	if connErr.Code == "" {
+		connErr.Code = connectCodes[codes.Unknown]
+	}
+
+	// NOTE: The details of the status are marshaled as google.rpc.Status, and
+	// the ones of Connect are the Anys in it without the prefix of the URLs.
+	details, err := decodeBinHeader(trailers.Get(grpcStatusDetailsHeader))
+	if err != nil || len(details) == 0 {
+		return code, connErr
+	}
+	st := &status.Status{}
+	if err := proto.Unmarshal(details, st); err != nil {
+		return code, connErr
+	}
+	for _, detail := range st.Details {
+		typeURL := detail.GetTypeUrl()
+		connErr.Details = append(connErr.Details, connectErrorDetail{
+			Type:  typeURL[strings.LastIndex(typeURL, "/")+1:],
+			Value: base64.RawStdEncoding.EncodeToString(detail.GetValue()),
+		})
+	}
+
+	return code, connErr
+}
+
+// isGRPCHeader returns whether the given header is only for gRPC, which should
+// not be passed to the clients of Connect.
+func isGRPCHeader(k string) bool {
+	return k == "Content-Type" || strings.HasPrefix(k, "Grpc-")
+}
+
+// decodeGRPCMessage decodes the given percent-encoded message of gRPC.
+func decodeGRPCMessage(msg string) string {
+	decoded, err := url.PathUnescape(msg)
+	if err != nil {
+		return msg
+	}
+	return decoded
+}
+
+// decodeBinHeader decodes the given value of a binary header of gRPC, which
+// is base64-encoded with or without padding.
+func decodeBinHeader(v string) ([]byte, error) {
+	return base64.RawStdEncoding.DecodeString(strings.TrimRight(v, "="))
+}
+
+// readEnvelope returns the flags and the payload of the given envelope. It
+// returns false if the given data is not a single envelope.
+func readEnvelope(data []byte) (byte, []byte, bool) {
+	if len(data) < envelopeHeaderSize {
+		return 0, nil, false
+	}
+	size := binary.BigEndian.Uint32(data[1:envelopeHeaderSize])
+	if uint64(len(data)-envelopeHeaderSize) != uint64(size) {
+		return 0, nil, false
+	}
+	return data[0], data[envelopeHeaderSize:], true
+}
+
+// grpcTimeout returns the timeout of gRPC of the given timeout of Connect in
+// milliseconds.
+func grpcTimeout(timeoutMs string) string {
+	if len(timeoutMs) <= maxGRPCTimeoutDigits {
+		return timeoutMs + "m"
+	}
+
+	ms, err := strconv.ParseUint(timeoutMs, 10, 64)
+	if err != nil {
+		return timeoutMs + "m"
+	}
+	return strconv.FormatUint(ms/1000, 10) + "S"
+}
+
+// bufferedResponseWriter is the http.ResponseWriter given to the gRPC server
+// to buffer the whole response.
+type bufferedResponseWriter struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+// Header returns the headers of the response.
+func (rw *bufferedResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+// WriteHeader does nothing since the status code of gRPC responses is always
+// 200.
+func (rw *bufferedResponseWriter) WriteHeader(int) {}
+
+// Write buffers the given body of the response.
+func (rw *bufferedResponseWriter) Write(b []byte) (int, error) {
+	return rw.body.Write(b)
+}
+
+// Flush does nothing since the response is written after the call.
+func (rw *bufferedResponseWriter) Flush() {}
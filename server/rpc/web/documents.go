@@ -0,0 +1,92 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+)
+
+const (
+	// documentsPath is the path of the REST endpoint of the documents, which
+	// is followed by the key of the document.
+	documentsPath = "/documents/"
+
+	// readDocumentMethod is the method that the REST endpoint of the documents
+	// is translated to.
+	readDocumentMethod = "/yorkie.v1.YorkieService/ReadDocument"
+
+	// serverSeqHeader is the header of the server sequence of the document
+	// read by the REST endpoint.
+	serverSeqHeader = "X-Yorkie-Server-Seq"
+)
+
+// isReadDocument returns whether the given request is a read of a document
+// from the REST endpoint.
+func isReadDocument(r *http.Request) bool {
+	return r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, documentsPath)
+}
+
+// serveReadDocument serves the given request of GET /documents/{key} with the
+// root of the document as JSON, so that the consumers without the SDK, such
+// as server-side rendering, can read documents. The errors are written in the
+// same JSON as the ones of Connect.
+func (h *Handler) serveReadDocument(w http.ResponseWriter, r *http.Request) {
+	body, err := (&api.ReadDocumentRequest{
+		DocumentKey: strings.TrimPrefix(r.URL.Path, documentsPath),
+	}).Marshal()
+	if err != nil {
+		writeConnectError(w, codes.Internal, &connectError{
+			Code:    connectCodes[codes.Internal],
+			Message: "marshal request: " + err.Error(),
+		})
+		return
+	}
+
+	req := newGRPCRequest(r, bytes.NewReader(appendEnvelope(nil, 0, body)))
+	req.Method = http.MethodPost
+	req.URL.Path, req.URL.RawPath = readDocumentMethod, ""
+
+	rw := &bufferedResponseWriter{header: make(http.Header)}
+	h.grpcServer.ServeHTTP(rw, req)
+	if code, connErr := statusOf(trailersOf(rw.header)); connErr != nil {
+		writeConnectError(w, code, connErr)
+		return
+	}
+
+	resp := &api.ReadDocumentResponse{}
+	_, payload, ok := readEnvelope(rw.body.Bytes())
+	if !ok || resp.Unmarshal(payload) != nil {
+		writeConnectError(w, codes.Internal, &connectError{
+			Code:    connectCodes[codes.Internal],
+			Message: "invalid response message",
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", jsonContentType)
+	w.Header().Set(serverSeqHeader, strconv.FormatInt(resp.ServerSeq, 10))
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.Root)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(resp.Root))
+}
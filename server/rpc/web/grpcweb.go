@@ -0,0 +1,55 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// serveGRPCWeb serves the given gRPC-Web request. The body of the request is
+// passed to the gRPC server as it is since the messages are framed in the same
+// way, and the trailers of the response are written at the end of the body as
+// a frame since browsers cannot read the trailers of HTTP.
+func (h *Handler) serveGRPCWeb(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	rw := &responseWriter{
+		w:      w,
+		header: make(http.Header),
+		writeHeader: func(header http.Header) {
+			for k, vv := range headersOf(header) {
+				w.Header()[k] = vv
+			}
+			w.Header().Set("Content-Type", contentType)
+		},
+	}
+	h.grpcServer.ServeHTTP(rw, newGRPCRequest(r, nil))
+	rw.WriteHeader(http.StatusOK)
+
+	var trailer bytes.Buffer
+	for k, vv := range trailersOf(rw.header) {
+		for _, v := range vv {
+			_, _ = fmt.Fprintf(&trailer, "%s: %s\r\n", strings.ToLower(k), v)
+		}
+	}
+	if _, err := w.Write(appendEnvelope(nil, grpcWebFlagTrailer, trailer.Bytes())); err != nil {
+		return
+	}
+	rw.Flush()
+}
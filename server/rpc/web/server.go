@@ -0,0 +1,152 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// readHeaderTimeout is the amount of time allowed to read the headers of
+// requests.
+const readHeaderTimeout = 10 * time.Second
+
+// Server serves a gRPC server over HTTP with the web protocols. HTTP/2 is
+// served without TLS by h2c if the files for certification are not given.
+type Server struct {
+	handler    *Handler
+	httpServer *http.Server
+	certFile   string
+	keyFile    string
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+// NewServer creates an instance of Server with the given gRPC server and the
+// files for certification.
+func NewServer(grpcServer http.Handler, certFile, keyFile string) (*Server, error) {
+	handler := NewHandler(grpcServer)
+	h2s := &http2.Server{}
+	httpServer := &http.Server{
+		Handler:           h2c.NewHandler(handler, h2s),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	// NOTE: HTTP/2 is configured to the server explicitly so that the
+	// connections taken over by h2c are sent GOAWAY on Shutdown as well.
+	if err := http2.ConfigureServer(httpServer, h2s); err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		handler:    handler,
+		httpServer: httpServer,
+		certFile:   certFile,
+		keyFile:    keyFile,
+		conns:      make(map[net.Conn]struct{}),
+	}, nil
+}
+
+// Serve accepts the connections of the given listener and serves them. It
+// returns nil when the server is stopped.
+func (s *Server) Serve(lis net.Listener) error {
+	lis = &trackingListener{Listener: lis, server: s}
+
+	var err error
+	if s.certFile != "" && s.keyFile != "" {
+		err = s.httpServer.ServeTLS(lis, s.certFile, s.keyFile)
+	} else {
+		err = s.httpServer.Serve(lis)
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// GracefulStop stops the server from accepting new connections and calls, and
+// waits for the calls in progress to end.
+func (s *Server) GracefulStop() {
+	// NOTE: Shutdown does not wait for the connections taken over by h2c, so
+	// the calls in progress are waited for by the handler.
+	_ = s.httpServer.Shutdown(context.Background())
+	s.handler.Shutdown()
+	s.closeConns()
+}
+
+// Stop stops the server immediately by closing all the connections.
+func (s *Server) Stop() {
+	_ = s.httpServer.Close()
+	s.closeConns()
+}
+
+// closeConns closes all the connections accepted by the server.
+func (s *Server) closeConns() {
+	s.mu.Lock()
+	conns := make([]net.Conn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		_ = conn.Close()
+	}
+}
+
+// trackingListener is a net.Listener that keeps the accepted connections in
+// the server until they are closed.
+type trackingListener struct {
+	net.Listener
+	server *Server
+}
+
+// Accept accepts a connection and keeps it in the server.
+func (l *trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &trackedConn{Conn: conn, server: l.server}
+	l.server.mu.Lock()
+	l.server.conns[c] = struct{}{}
+	l.server.mu.Unlock()
+	return c, nil
+}
+
+// trackedConn is a connection kept in the server until it is closed.
+type trackedConn struct {
+	net.Conn
+	server *Server
+}
+
+// Close closes the connection and removes it from the server.
+func (c *trackedConn) Close() error {
+	c.server.mu.Lock()
+	delete(c.server.conns, c)
+	c.server.mu.Unlock()
+	return c.Conn.Close()
+}
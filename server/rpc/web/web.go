@@ -0,0 +1,300 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package web serves the gRPC services of the server to the clients that
+// cannot speak gRPC over HTTP/2 directly, such as browsers, over the gRPC-Web
+// and the Connect protocols, and serves the streams over WebSocket for the
+// environments where gRPC streaming is blocked. It also serves a read-only
+// REST endpoint of the documents for the consumers without the SDK. The requests of the protocols are translated to
+// gRPC and handled by the gRPC server in the process, so that the services are
+// served on the same port without a proxy like Envoy.
+package web
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// The content types of the protocols. Only the binary format of protocol
+// buffers is supported.
+const (
+	grpcContentType           = "application/grpc"
+	grpcWebContentType        = "application/grpc-web"
+	grpcWebProtoContentType   = "application/grpc-web+proto"
+	connectUnaryContentType   = "application/proto"
+	connectStreamContentType  = "application/connect+proto"
+	jsonContentType           = "application/json"
+	grpcProtoContentType      = "application/grpc+proto"
+	envelopeHeaderSize        = 5
+	envelopeFlagCompressed    = 0x01
+	connectFlagEndStream      = 0x02
+	grpcWebFlagTrailer        = 0x80
+	connectTimeoutHeader      = "Connect-Timeout-Ms"
+	connectProtocolHeader     = "Connect-Protocol-Version"
+	connectContentEncoding    = "Connect-Content-Encoding"
+	connectAcceptEncoding     = "Connect-Accept-Encoding"
+	connectTrailerPrefix      = "Trailer-"
+	grpcEncodingHeader        = "Grpc-Encoding"
+	grpcAcceptEncodingHeader  = "Grpc-Accept-Encoding"
+	grpcStatusHeader          = "Grpc-Status"
+	grpcMessageHeader         = "Grpc-Message"
+	grpcStatusDetailsHeader   = "Grpc-Status-Details-Bin"
+	grpcTimeoutHeader         = "Grpc-Timeout"
+	corsMaxAge                = "1728"
+	corsAllowedMethods        = "POST, GET, OPTIONS"
+	corsDefaultExposedHeaders = "Grpc-Status, Grpc-Message, Grpc-Status-Details-Bin, X-Yorkie-Server-Seq"
+)
+
+// Handler is an http.Handler that serves gRPC, gRPC-Web and Connect requests
+// with the given gRPC server.
+type Handler struct {
+	grpcServer http.Handler
+
+	mu       sync.Mutex
+	shutdown bool
+	calls    sync.WaitGroup
+}
+
+// NewHandler creates an instance of Handler with the given gRPC server, which
+// serves the requests with its ServeHTTP.
+func NewHandler(grpcServer http.Handler) *Handler {
+	return &Handler{grpcServer: grpcServer}
+}
+
+// ServeHTTP serves the given request by the protocol of its content type.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.begin() {
+		http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	defer h.calls.Done()
+
+	contentType := r.Header.Get("Content-Type")
+	if isGRPC(contentType) {
+		h.grpcServer.ServeHTTP(w, r)
+		return
+	}
+
+	// NOTE: Like the CORS policy of the proxies in the charts, the requests
+	// from any origin are allowed since the clients are authenticated by the
+	// keys and the tokens in the headers, not by the cookies.
+	setCORSHeaders(w, r)
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if isWebSocket(r) {
+		h.serveWebSocket(w, r)
+		return
+	}
+	if isReadDocument(r) {
+		h.serveReadDocument(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch {
+	case contentType == grpcWebContentType || contentType == grpcWebProtoContentType:
+		h.serveGRPCWeb(w, r)
+	case contentType == connectUnaryContentType:
+		h.serveConnectUnary(w, r)
+	case contentType == connectStreamContentType:
+		h.serveConnectStream(w, r)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported content type %q", contentType), http.StatusUnsupportedMediaType)
+	}
+}
+
+// Shutdown stops the handler from serving new calls, and waits for the calls
+// in progress to end.
+func (h *Handler) Shutdown() {
+	h.mu.Lock()
+	h.shutdown = true
+	h.mu.Unlock()
+
+	h.calls.Wait()
+}
+
+// begin registers a call to serve. It returns false if the handler is shut
+// down.
+func (h *Handler) begin() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.shutdown {
+		return false
+	}
+	h.calls.Add(1)
+	return true
+}
+
+// isGRPC returns whether the given content type is of gRPC, not of gRPC-Web.
+func isGRPC(contentType string) bool {
+	if contentType == grpcContentType {
+		return true
+	}
+	return strings.HasPrefix(contentType, grpcContentType+"+") || strings.HasPrefix(contentType, grpcContentType+";")
+}
+
+// setCORSHeaders sets the headers that allow the browsers of the origin of
+// the given request to call the services.
+func setCORSHeaders(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return
+	}
+
+	h := w.Header()
+	h.Set("Access-Control-Allow-Origin", origin)
+	h.Set("Access-Control-Allow-Credentials", "true")
+	h.Add("Vary", "Origin")
+	if r.Method == http.MethodOptions {
+		h.Set("Access-Control-Allow-Methods", corsAllowedMethods)
+		h.Set("Access-Control-Allow-Headers", r.Header.Get("Access-Control-Request-Headers"))
+		h.Set("Access-Control-Max-Age", corsMaxAge)
+		return
+	}
+	h.Set("Access-Control-Expose-Headers", corsDefaultExposedHeaders)
+}
+
+// newGRPCRequest returns the gRPC request translated from the given request
+// with the given body.
+func newGRPCRequest(r *http.Request, body *bytes.Reader) *http.Request {
+	req := r.Clone(r.Context())
+	req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2.0", 2, 0
+	req.Header.Set("Content-Type", grpcProtoContentType)
+	req.Header.Set("Te", "trailers")
+	req.Header.Del("Content-Length")
+	req.Header.Del(connectProtocolHeader)
+	if body != nil {
+		req.Body = noopCloser{body}
+		req.ContentLength = int64(body.Len())
+	}
+
+	if timeout := r.Header.Get(connectTimeoutHeader); timeout != "" {
+		req.Header.Del(connectTimeoutHeader)
+		req.Header.Set(grpcTimeoutHeader, grpcTimeout(timeout))
+	}
+
+	return req
+}
+
+// noopCloser is a reader of the body of a request with a no-op Close.
+type noopCloser struct {
+	*bytes.Reader
+}
+
+// Close does nothing.
+func (noopCloser) Close() error {
+	return nil
+}
+
+// appendEnvelope appends the given payload as an envelope with the given flags,
+// which is the message framing of gRPC, gRPC-Web and Connect streaming.
+func appendEnvelope(b []byte, flags byte, payload []byte) []byte {
+	b = append(b, flags)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(payload)))
+	return append(b, payload...)
+}
+
+// headersOf returns the headers of the given response of gRPC without its
+// trailers.
+func headersOf(h http.Header) http.Header {
+	trailers := make(map[string]bool)
+	for _, names := range h.Values("Trailer") {
+		for _, name := range strings.Split(names, ",") {
+			trailers[http.CanonicalHeaderKey(strings.TrimSpace(name))] = true
+		}
+	}
+
+	headers := make(http.Header)
+	for k, vv := range h {
+		if k == "Trailer" || trailers[k] || strings.HasPrefix(k, http.TrailerPrefix) {
+			continue
+		}
+		headers[k] = vv
+	}
+	return headers
+}
+
+// trailersOf returns the trailers of the given response of gRPC, which are
+// the headers declared in "Trailer" or the ones prefixed with TrailerPrefix.
+func trailersOf(h http.Header) http.Header {
+	trailers := make(http.Header)
+	for _, names := range h.Values("Trailer") {
+		for _, name := range strings.Split(names, ",") {
+			name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+			if vv, ok := h[name]; ok {
+				trailers[name] = vv
+			}
+		}
+	}
+	for k, vv := range h {
+		if strings.HasPrefix(k, http.TrailerPrefix) {
+			trailers[http.CanonicalHeaderKey(strings.TrimPrefix(k, http.TrailerPrefix))] = vv
+		}
+	}
+	return trailers
+}
+
+// responseWriter is the http.ResponseWriter given to the gRPC server. It keeps
+// the headers of gRPC apart from the response, and calls writeHeader with
+// them once before the body is written.
+type responseWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	wroteHeader bool
+	writeHeader func(header http.Header)
+}
+
+// Header returns the headers of gRPC.
+func (rw *responseWriter) Header() http.Header {
+	return rw.header
+}
+
+// WriteHeader writes the headers of the response.
+//
+// NOTE: The status code of gRPC responses is always 200 since the status of
+// the calls is in the trailers.
+func (rw *responseWriter) WriteHeader(int) {
+	if rw.wroteHeader {
+		return
+	}
+	rw.wroteHeader = true
+	rw.writeHeader(rw.header)
+	rw.w.WriteHeader(http.StatusOK)
+}
+
+// Write writes the given body of the response.
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	rw.WriteHeader(http.StatusOK)
+	return rw.w.Write(b)
+}
+
+// Flush flushes the response written so far to the client.
+func (rw *responseWriter) Flush() {
+	rw.WriteHeader(http.StatusOK)
+	if f, ok := rw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,307 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	gojson "encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"golang.org/x/net/websocket"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/server/rpc/web"
+)
+
+const (
+	checkPath = "/grpc.health.v1.Health/Check"
+	watchPath = "/grpc.health.v1.Health/Watch"
+)
+
+// readingYorkieServer is a server that reads the document of the key "doc".
+type readingYorkieServer struct {
+	api.UnimplementedYorkieServiceServer
+}
+
+func (s *readingYorkieServer) ReadDocument(
+	_ context.Context,
+	req *api.ReadDocumentRequest,
+) (*api.ReadDocumentResponse, error) {
+	if req.DocumentKey != "doc" {
+		return nil, status.Error(codes.NotFound, "document not found")
+	}
+	return &api.ReadDocumentResponse{Root: `{"k1":"v1"}`, ServerSeq: 3}, nil
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	grpcServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+	api.RegisterYorkieServiceServer(grpcServer, &readingYorkieServer{})
+	server := httptest.NewServer(h2c.NewHandler(web.NewHandler(grpcServer), &http2.Server{}))
+	t.Cleanup(func() {
+		server.Close()
+		grpcServer.Stop()
+	})
+	return server
+}
+
+func envelope(flags byte, payload []byte) []byte {
+	b := []byte{flags}
+	b = binary.BigEndian.AppendUint32(b, uint32(len(payload)))
+	return append(b, payload...)
+}
+
+// readEnvelopes splits the given body into the flags and the payloads.
+func readEnvelopes(t *testing.T, body []byte) ([]byte, [][]byte) {
+	var flags []byte
+	var payloads [][]byte
+	for len(body) > 0 {
+		assert.GreaterOrEqual(t, len(body), 5)
+		size := binary.BigEndian.Uint32(body[1:5])
+		flags = append(flags, body[0])
+		payloads = append(payloads, body[5:5+size])
+		body = body[5+size:]
+	}
+	return flags, payloads
+}
+
+func marshal(t *testing.T, msg proto.Message) []byte {
+	data, err := proto.Marshal(msg)
+	assert.NoError(t, err)
+	return data
+}
+
+func post(t *testing.T, url, contentType string, header http.Header, body []byte) (*http.Response, []byte) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	assert.NoError(t, err)
+	for k, vv := range header {
+		req.Header[k] = vv
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, resp.Body.Close())
+	}()
+	data, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return resp, data
+}
+
+func TestWeb(t *testing.T) {
+	server := newTestServer(t)
+
+	t.Run("grpc test", func(t *testing.T) {
+		conn, err := grpc.Dial(
+			strings.TrimPrefix(server.URL, "http://"),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+
+		resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	})
+
+	t.Run("grpc-web test", func(t *testing.T) {
+		resp, body := post(
+			t,
+			server.URL+checkPath,
+			"application/grpc-web+proto",
+			nil,
+			envelope(0, marshal(t, &healthpb.HealthCheckRequest{})),
+		)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/grpc-web+proto", resp.Header.Get("Content-Type"))
+
+		flags, payloads := readEnvelopes(t, body)
+		assert.Equal(t, []byte{0x00, 0x80}, flags)
+		checkResp := &healthpb.HealthCheckResponse{}
+		assert.NoError(t, proto.Unmarshal(payloads[0], checkResp))
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkResp.Status)
+		assert.Contains(t, string(payloads[1]), "grpc-status: 0\r\n")
+	})
+
+	t.Run("grpc-web error test", func(t *testing.T) {
+		resp, body := post(
+			t,
+			server.URL+checkPath,
+			"application/grpc-web",
+			nil,
+			envelope(0, marshal(t, &healthpb.HealthCheckRequest{Service: "unknown"})),
+		)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		flags, payloads := readEnvelopes(t, body)
+		assert.Equal(t, []byte{0x80}, flags)
+		assert.Contains(t, string(payloads[0]), "grpc-status: 5\r\n")
+	})
+
+	t.Run("connect unary test", func(t *testing.T) {
+		resp, body := post(
+			t,
+			server.URL+checkPath,
+			"application/proto",
+			http.Header{"Connect-Protocol-Version": {"1"}, "Connect-Timeout-Ms": {"5000"}},
+			marshal(t, &healthpb.HealthCheckRequest{}),
+		)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/proto", resp.Header.Get("Content-Type"))
+
+		checkResp := &healthpb.HealthCheckResponse{}
+		assert.NoError(t, proto.Unmarshal(body, checkResp))
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkResp.Status)
+	})
+
+	t.Run("connect unary error test", func(t *testing.T) {
+		resp, body := post(
+			t,
+			server.URL+checkPath,
+			"application/proto",
+			nil,
+			marshal(t, &healthpb.HealthCheckRequest{Service: "unknown"}),
+		)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		connErr := struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		}{}
+		assert.NoError(t, gojson.Unmarshal(body, &connErr))
+		assert.Equal(t, "not_found", connErr.Code)
+		assert.Equal(t, "unknown service", connErr.Message)
+	})
+
+	t.Run("connect streaming test", func(t *testing.T) {
+		resp, body := post(
+			t,
+			server.URL+watchPath,
+			"application/connect+proto",
+			http.Header{"Connect-Timeout-Ms": {"100"}},
+			envelope(0, marshal(t, &healthpb.HealthCheckRequest{})),
+		)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/connect+proto", resp.Header.Get("Content-Type"))
+
+		flags, payloads := readEnvelopes(t, body)
+		assert.Equal(t, []byte{0x00, 0x02}, flags)
+		watchResp := &healthpb.HealthCheckResponse{}
+		assert.NoError(t, proto.Unmarshal(payloads[0], watchResp))
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, watchResp.Status)
+
+		end := struct {
+			Error struct {
+				Code string `json:"code"`
+			} `json:"error"`
+		}{}
+		// NOTE: The health server ends Watch with Canceled when the deadline
+		// of the call is exceeded.
+		assert.NoError(t, gojson.Unmarshal(payloads[1], &end))
+		assert.Equal(t, "canceled", end.Error.Code)
+	})
+
+	t.Run("websocket test", func(t *testing.T) {
+		url := "ws" + strings.TrimPrefix(server.URL, "http")
+		conn, err := websocket.Dial(url+watchPath, "", server.URL)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+
+		// the responses of the stream are sent as messages.
+		assert.NoError(t, websocket.Message.Send(conn, marshal(t, &healthpb.HealthCheckRequest{})))
+		var msg []byte
+		assert.NoError(t, websocket.Message.Receive(conn, &msg))
+		assert.Equal(t, byte(0x00), msg[0])
+		watchResp := &healthpb.HealthCheckResponse{}
+		assert.NoError(t, proto.Unmarshal(msg[1:], watchResp))
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, watchResp.Status)
+
+		// the status of the call is sent at the end.
+		unaryConn, err := websocket.Dial(url+checkPath, "", server.URL)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, unaryConn.Close())
+		}()
+		assert.NoError(t, websocket.Message.Send(unaryConn, marshal(t, &healthpb.HealthCheckRequest{Service: "unknown"})))
+		assert.NoError(t, websocket.Message.Receive(unaryConn, &msg))
+		assert.Equal(t, byte(0x02), msg[0])
+		st := &spb.Status{}
+		assert.NoError(t, proto.Unmarshal(msg[1:], st))
+		assert.Equal(t, int32(5), st.Code)
+		assert.Equal(t, "unknown service", st.Message)
+	})
+
+	t.Run("read document test", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/documents/doc")
+		assert.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "3", resp.Header.Get("X-Yorkie-Server-Seq"))
+		assert.Equal(t, `{"k1":"v1"}`, string(body))
+
+		resp, err = http.Get(server.URL + "/documents/missing")
+		assert.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("cors test", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodOptions, server.URL+checkPath, nil)
+		assert.NoError(t, err)
+		req.Header.Set("Origin", "http://localhost:3000")
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "content-type,x-api-key")
+
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, "http://localhost:3000", resp.Header.Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "content-type,x-api-key", resp.Header.Get("Access-Control-Allow-Headers"))
+	})
+
+	t.Run("unsupported content type test", func(t *testing.T) {
+		resp, _ := post(t, server.URL+checkPath, "application/json", nil, []byte("{}"))
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	})
+}
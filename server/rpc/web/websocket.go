@@ -0,0 +1,172 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/websocket"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// The flags of the messages of the WebSocket bridge. Each binary message is a
+// flag followed by a response of the call, or by the final status of the call
+// as google.rpc.Status.
+const (
+	webSocketFlagMessage = 0x00
+	webSocketFlagStatus  = 0x02
+)
+
+// isWebSocket returns whether the given request is a WebSocket handshake.
+func isWebSocket(r *http.Request) bool {
+	return r.Method == http.MethodGet && strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// serveWebSocket serves the given WebSocket handshake as a call of the method
+// of its path, for the environments where gRPC streaming is blocked, e.g. by
+// proxies. The first message from the client is the request of the call, and
+// the responses are sent to the client as messages until the call ends. The
+// call is canceled when the client closes the connection.
+func (h *Handler) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	websocket.Server{
+		// NOTE: Like the CORS policy, the handshakes from any origin are
+		// accepted since the clients are authenticated by the headers.
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(conn *websocket.Conn) {
+			defer func() {
+				_ = conn.Close()
+			}()
+			h.bridgeWebSocket(conn, r)
+		},
+	}.ServeHTTP(w, r)
+}
+
+// bridgeWebSocket bridges the call of the given WebSocket connection to the
+// gRPC server.
+func (h *Handler) bridgeWebSocket(conn *websocket.Conn, r *http.Request) {
+	var body []byte
+	if err := websocket.Message.Receive(conn, &body); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() {
+		var discard []byte
+		for websocket.Message.Receive(conn, &discard) == nil {
+		}
+		cancel()
+	}()
+
+	req := newGRPCRequest(r.WithContext(ctx), bytes.NewReader(appendEnvelope(nil, 0, body)))
+	req.Method = http.MethodPost
+	for _, k := range []string{
+		"Upgrade",
+		"Connection",
+		"Sec-Websocket-Key",
+		"Sec-Websocket-Version",
+		"Sec-Websocket-Extensions",
+		"Sec-Websocket-Protocol",
+	} {
+		req.Header.Del(k)
+	}
+
+	rw := &webSocketResponseWriter{conn: conn, header: make(http.Header)}
+	h.grpcServer.ServeHTTP(rw, req)
+	if rw.err != nil {
+		return
+	}
+
+	st, err := proto.Marshal(statusProtoOf(trailersOf(rw.header)))
+	if err != nil {
+		return
+	}
+	_ = websocket.Message.Send(conn, append([]byte{webSocketFlagStatus}, st...))
+}
+
+// statusProtoOf returns the status of the given trailers of gRPC as
+// google.rpc.Status with its details.
+func statusProtoOf(trailers http.Header) *status.Status {
+	details, err := decodeBinHeader(trailers.Get(grpcStatusDetailsHeader))
+	if err == nil && len(details) > 0 {
+		st := &status.Status{}
+		if err := proto.Unmarshal(details, st); err == nil {
+			return st
+		}
+	}
+
+	code := codes.Unknown
+	if c, err := strconv.ParseUint(trailers.Get(grpcStatusHeader), 10, 32); err == nil {
+		code = codes.Code(c)
+	}
+	return &status.Status{
+		Code:    int32(code),
+		Message: decodeGRPCMessage(trailers.Get(grpcMessageHeader)),
+	}
+}
+
+// webSocketResponseWriter is the http.ResponseWriter given to the gRPC server
+// to send the responses of the call to the WebSocket connection.
+type webSocketResponseWriter struct {
+	conn   *websocket.Conn
+	header http.Header
+	buf    []byte
+	err    error
+}
+
+// Header returns the headers of the response.
+func (rw *webSocketResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+// WriteHeader does nothing since the status code of gRPC responses is always
+// 200.
+func (rw *webSocketResponseWriter) WriteHeader(int) {}
+
+// Write sends the complete responses in the given body to the connection.
+func (rw *webSocketResponseWriter) Write(b []byte) (int, error) {
+	if rw.err != nil {
+		return 0, rw.err
+	}
+
+	rw.buf = append(rw.buf, b...)
+	for len(rw.buf) >= envelopeHeaderSize {
+		size := int(binary.BigEndian.Uint32(rw.buf[1:envelopeHeaderSize]))
+		if len(rw.buf) < envelopeHeaderSize+size {
+			break
+		}
+
+		msg := append([]byte{webSocketFlagMessage}, rw.buf[envelopeHeaderSize:envelopeHeaderSize+size]...)
+		if err := websocket.Message.Send(rw.conn, msg); err != nil {
+			rw.err = err
+			return 0, err
+		}
+		rw.buf = rw.buf[envelopeHeaderSize+size:]
+	}
+
+	return len(b), nil
+}
+
+// Flush does nothing since the responses are sent when they are written.
+func (rw *webSocketResponseWriter) Flush() {}
@@ -18,14 +18,25 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"sort"
+	gotime "time"
+
+	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/cache"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/eventbus"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/clients"
 	"github.com/yorkie-team/yorkie/server/documents"
@@ -33,19 +44,71 @@ import (
 	"github.com/yorkie-team/yorkie/server/packs"
 	"github.com/yorkie-team/yorkie/server/projects"
 	"github.com/yorkie-team/yorkie/server/rpc/auth"
+	"github.com/yorkie-team/yorkie/server/rpc/grpchelper"
+	"github.com/yorkie-team/yorkie/server/webhook"
 )
 
 type yorkieServer struct {
-	backend    *backend.Backend
-	serviceCtx context.Context
+	backend      *backend.Backend
+	authProvider auth.Provider
+	serviceCtx   context.Context
+
+	// maxStreamedPackBytes is the maximum size in bytes of the change pack
+	// assembled from the chunks of a streamed PushPull request.
+	maxStreamedPackBytes uint64
+
+	// forwarder forwards the requests of the documents owned by the other
+	// servers of the cluster. It is nil if the server is not in cluster mode.
+	forwarder *forwarder
+
+	// trustedProxies are the proxies whose X-Forwarded-For headers are
+	// trusted.
+	trustedProxies grpchelper.TrustedProxies
+
+	// pushPullCache is the cache of the responses of PushPull by the
+	// idempotency keys of the pushed packs. It is nil if the responses are
+	// not cached.
+	pushPullCache *cache.LRUExpireCache[pushPullCacheKey, *packs.ServerPack]
+}
+
+// pushPullCacheKey is the key of the PushPull cache, which identifies the push
+// of a client to a document.
+type pushPullCacheKey struct {
+	clientID       types.ID
+	docID          types.ID
+	idempotencyKey string
 }
 
 // newYorkieServer creates a new instance of yorkieServer
-func newYorkieServer(serviceCtx context.Context, be *backend.Backend) *yorkieServer {
-	return &yorkieServer{
-		backend:    be,
-		serviceCtx: serviceCtx,
+func newYorkieServer(
+	serviceCtx context.Context,
+	be *backend.Backend,
+	authProvider auth.Provider,
+	maxStreamedPackBytes uint64,
+	forwarder *forwarder,
+	trustedProxies grpchelper.TrustedProxies,
+) (*yorkieServer, error) {
+	var pushPullCache *cache.LRUExpireCache[pushPullCacheKey, *packs.ServerPack]
+	if be.Config.PushPullCacheSize > 0 {
+		var err error
+		pushPullCache, err = cache.NewLRUExpireCacheWithClock[pushPullCacheKey, *packs.ServerPack](
+			be.Config.PushPullCacheSize,
+			be.Clock,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
+
+	return &yorkieServer{
+		backend:              be,
+		authProvider:         authProvider,
+		serviceCtx:           serviceCtx,
+		maxStreamedPackBytes: maxStreamedPackBytes,
+		forwarder:            forwarder,
+		trustedProxies:       trustedProxies,
+		pushPullCache:        pushPullCache,
+	}, nil
 }
 
 // ActivateClient activates the given client.
@@ -57,20 +120,34 @@ func (s *yorkieServer) ActivateClient(
 		return nil, clients.ErrInvalidClientKey
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	if err := auth.VerifyAccess(ctx, s.authProvider, &types.AccessInfo{
 		Method: types.ActivateClient,
+		Client: s.accessClient(ctx, "", req.ClientKey),
 	}); err != nil {
 		return nil, err
 	}
 
 	project := projects.From(ctx)
-	cli, err := clients.Activate(ctx, s.backend.DB, project, req.ClientKey)
+	cli, err := clients.Activate(
+		ctx,
+		s.backend.DB,
+		project,
+		req.ClientKey,
+		s.connectionInfo(ctx),
+	)
 	if err != nil {
 		return nil, err
 	}
+	webhook.SendClientEvent(s.backend, project, types.ClientActivatedEvent, cli)
+	s.backend.EventBus.Publish(eventbus.Event{
+		Type:      eventbus.ClientActivated,
+		ProjectID: project.ID,
+		ClientID:  cli.ID,
+	})
 
 	return &api.ActivateClientResponse{
-		ClientId: cli.ID.String(),
+		ClientId:        cli.ID.String(),
+		CompactEncoding: true,
 	}, nil
 }
 
@@ -84,17 +161,19 @@ func (s *yorkieServer) DeactivateClient(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	if err := auth.VerifyAccess(ctx, s.authProvider, &types.AccessInfo{
 		Method: types.DeactivateClient,
+		Client: s.accessClient(ctx, req.ClientId, ""),
 	}); err != nil {
 		return nil, err
 	}
 
 	project := projects.From(ctx)
-	_, err = clients.Deactivate(ctx, s.backend.DB, project.ID, types.IDFromActorID(actorID))
+	clientInfo, err := clients.Deactivate(ctx, s.backend.DB, project.ID, types.IDFromActorID(actorID))
 	if err != nil {
 		return nil, err
 	}
+	webhook.SendClientEvent(s.backend, project, types.ClientDeactivatedEvent, clientInfo)
 
 	return &api.DeactivateClientResponse{}, nil
 }
@@ -109,20 +188,34 @@ func (s *yorkieServer) AttachDocument(
 		return nil, err
 	}
 
-	pack, err := converter.FromChangePack(req.ChangePack)
+	isCompact := req.ChangePack.GetIsCompact()
+	pack, err := converter.FromChangePackWithLimits(req.ChangePack, s.changeLimits())
 	if err != nil {
 		return nil, err
 	}
 	if err := pack.DocumentKey.Validate(); err != nil {
 		return nil, err
 	}
+	if owner := s.ownerOf(ctx, pack.DocumentKey); owner != nil {
+		return forward(ctx, s.forwarder, owner, s.connectionInfo(ctx).IP, req, api.YorkieServiceClient.AttachDocument)
+	}
+	var pathFilter string
+	if req.PathFilter != "" {
+		if pathFilter, err = document.NormalizePathFilter(req.PathFilter); err != nil {
+			return nil, err
+		}
+	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	accessInfo := &types.AccessInfo{
 		Method:     types.AttachDocument,
-		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
-		return nil, err
+		Attributes: auth.AccessAttributes(types.AttachDocument, pack),
+		Client:     s.accessClient(ctx, req.ClientId, ""),
 	}
+	identity, authErr := auth.Authenticate(ctx, s.authProvider, accessInfo)
+	if authErr != nil && (pack.IsRemoved || !auth.CanReadPublicly(ctx, auth.RoleOf(pack))) {
+		return nil, authErr
+	}
+	ctx = packs.WithPresenceDecryption(ctx, identity.CanDecryptPresence())
 
 	project := projects.From(ctx)
 	locker, err := s.backend.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, pack.DocumentKey))
@@ -143,14 +236,49 @@ func (s *yorkieServer) AttachDocument(
 	if err != nil {
 		return nil, err
 	}
-	docInfo, err := documents.FindDocInfoByKeyAndOwner(ctx, s.backend, project, clientInfo, pack.DocumentKey, true)
+	// NOTE: Anonymous readers can attach only the existing public documents,
+	// so the document is not created for them.
+	docInfo, err := documents.FindDocInfoByKeyAndOwner(
+		ctx,
+		s.backend,
+		project,
+		clientInfo,
+		pack.DocumentKey,
+		authErr == nil,
+	)
+	if authErr != nil && (errors.Is(err, database.ErrDocumentNotFound) || err == nil && docInfo.IsRemoved()) {
+		return nil, authErr
+	}
 	if err != nil {
 		return nil, err
 	}
+	if err := s.verifyDocumentAccess(ctx, accessInfo, docInfo, auth.RoleOf(pack), authErr); err != nil {
+		return nil, err
+	}
+	if err := documents.InitializeDocumentLabels(ctx, s.backend, project, docInfo, req.Labels); err != nil {
+		return nil, err
+	}
+	if err := documents.InitializeDocumentFromTemplate(ctx, s.backend, project, docInfo); err != nil {
+		return nil, err
+	}
+
+	// NOTE: Checking other clients costs a query, so it is only done when the
+	// project receives the event.
+	isFirstAttach := false
+	if project.RequireEventWebhook(types.DocumentFirstAttachedEvent) {
+		isAttached, err := documents.IsDocumentAttached(ctx, s.backend, project, docInfo.ID, clientInfo.ID)
+		if err != nil {
+			return nil, err
+		}
+		isFirstAttach = !isAttached
+	}
 
 	if err := clientInfo.AttachDocument(docInfo.ID); err != nil {
 		return nil, err
 	}
+	if err := clientInfo.SetPathFilter(docInfo.ID, pathFilter); err != nil {
+		return nil, err
+	}
 
 	pulled, err := packs.PushPull(ctx, s.backend, project, clientInfo, docInfo, pack, types.SyncModePushPull)
 	if err != nil {
@@ -161,6 +289,23 @@ func (s *yorkieServer) AttachDocument(
 	if err != nil {
 		return nil, err
 	}
+	if isCompact {
+		converter.CompactChangePack(pbChangePack)
+	}
+	if err := converter.CompressChangePack(pbChangePack, grpchelper.PackCompressor(ctx)); err != nil {
+		return nil, err
+	}
+	webhook.SendDocumentEvent(s.backend, project, types.DocumentAttachedEvent, clientInfo, docInfo)
+	if isFirstAttach {
+		webhook.SendDocumentEvent(s.backend, project, types.DocumentFirstAttachedEvent, clientInfo, docInfo)
+	}
+	s.backend.EventBus.Publish(eventbus.Event{
+		Type:        eventbus.DocumentAttached,
+		ProjectID:   project.ID,
+		ClientID:    clientInfo.ID,
+		DocumentID:  docInfo.ID,
+		DocumentKey: docInfo.Key,
+	})
 
 	return &api.AttachDocumentResponse{
 		ChangePack: pbChangePack,
@@ -178,7 +323,8 @@ func (s *yorkieServer) DetachDocument(
 		return nil, err
 	}
 
-	pack, err := converter.FromChangePack(req.ChangePack)
+	isCompact := req.ChangePack.GetIsCompact()
+	pack, err := converter.FromChangePackWithLimits(req.ChangePack, s.changeLimits())
 	if err != nil {
 		return nil, err
 	}
@@ -186,13 +332,21 @@ func (s *yorkieServer) DetachDocument(
 	if err := docID.Validate(); err != nil {
 		return nil, err
 	}
+	if owner := s.ownerOf(ctx, pack.DocumentKey); owner != nil {
+		return forward(ctx, s.forwarder, owner, s.connectionInfo(ctx).IP, req, api.YorkieServiceClient.DetachDocument)
+	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	accessInfo := &types.AccessInfo{
 		Method:     types.DetachDocument,
-		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
-		return nil, err
+		Attributes: auth.AccessAttributes(types.DetachDocument, pack),
+		Client:     s.accessClient(ctx, req.ClientId, ""),
+	}
+	// NOTE: Anonymous readers can not remove the document while detaching it.
+	identity, authErr := auth.Authenticate(ctx, s.authProvider, accessInfo)
+	if authErr != nil && (pack.IsRemoved || req.RemoveIfNotAttached || !auth.CanReadPublicly(ctx, auth.RoleOf(pack))) {
+		return nil, authErr
 	}
+	ctx = packs.WithPresenceDecryption(ctx, identity.CanDecryptPresence())
 
 	project := projects.From(ctx)
 	locker, err := s.backend.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, pack.DocumentKey))
@@ -217,6 +371,9 @@ func (s *yorkieServer) DetachDocument(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.verifyDocumentAccess(ctx, accessInfo, docInfo, auth.RoleOf(pack), authErr); err != nil {
+		return nil, err
+	}
 
 	isAttached, err := documents.IsDocumentAttached(ctx, s.backend, project, docInfo.ID, clientInfo.ID)
 	if err != nil {
@@ -243,6 +400,16 @@ func (s *yorkieServer) DetachDocument(
 	if err != nil {
 		return nil, err
 	}
+	if isCompact {
+		converter.CompactChangePack(pbChangePack)
+	}
+	if err := converter.CompressChangePack(pbChangePack, grpchelper.PackCompressor(ctx)); err != nil {
+		return nil, err
+	}
+	webhook.SendDocumentEvent(s.backend, project, types.DocumentDetachedEvent, clientInfo, docInfo)
+	if !isAttached {
+		webhook.SendDocumentEvent(s.backend, project, types.DocumentLastDetachedEvent, clientInfo, docInfo)
+	}
 
 	return &api.DetachDocumentResponse{
 		ChangePack: pbChangePack,
@@ -255,12 +422,264 @@ func (s *yorkieServer) PushPullChanges(
 	ctx context.Context,
 	req *api.PushPullChangesRequest,
 ) (*api.PushPullChangesResponse, error) {
+	return s.pushPullChanges(ctx, req)
+}
+
+// PushPullChangesStream is the streaming variant of PushPullChanges for large
+// change packs. The client sends the change pack in chunks, and the server
+// assembles them into a change pack before storing its changes.
+func (s *yorkieServer) PushPullChangesStream(
+	stream api.YorkieService_PushPullChangesStreamServer,
+) error {
+	req, err := receivePushPullChunks(stream, s.maxStreamedPackBytes)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.pushPullChanges(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(res)
+}
+
+// PushPullChangesMulti stores the changes of several documents sent by the
+// client all or nothing, and delivers the changes accumulated in the server for
+// each of them.
+//
+// NOTE: In cluster mode, the request is not forwarded since its documents can
+// be owned by different servers. It is handled by the server receiving it.
+func (s *yorkieServer) PushPullChangesMulti(
+	ctx context.Context,
+	req *api.PushPullChangesMultiRequest,
+) (*api.PushPullChangesMultiResponse, error) {
 	actorID, err := time.ActorIDFromHex(req.ClientId)
 	if err != nil {
 		return nil, err
 	}
+	if len(req.ChangePacks) == 0 {
+		return nil, converter.ErrPackRequired
+	}
 
-	pack, err := converter.FromChangePack(req.ChangePack)
+	var docIDs []types.ID
+	var reqPacks []*change.Pack
+	var isCompacts []bool
+	var attributes []types.AccessAttribute
+	docKeys := make(map[key.Key]bool)
+	for _, pbPack := range req.ChangePacks {
+		isCompacts = append(isCompacts, pbPack.ChangePack.GetIsCompact())
+		pack, err := converter.FromChangePackWithLimits(pbPack.ChangePack, s.changeLimits())
+		if err != nil {
+			return nil, err
+		}
+		docID := types.ID(pbPack.DocumentId)
+		if err := docID.Validate(); err != nil {
+			return nil, err
+		}
+		if docKeys[pack.DocumentKey] {
+			return nil, fmt.Errorf("%s: %w", pack.DocumentKey, packs.ErrDuplicateDocument)
+		}
+		docKeys[pack.DocumentKey] = true
+
+		docIDs = append(docIDs, docID)
+		reqPacks = append(reqPacks, pack)
+		attributes = append(attributes, auth.AccessAttributes(types.PushPull, pack)...)
+	}
+
+	identity, err := auth.Authenticate(ctx, s.authProvider, &types.AccessInfo{
+		Method:     types.PushPull,
+		Attributes: attributes,
+		Client:     s.accessClient(ctx, req.ClientId, ""),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx = packs.WithPresenceDecryption(ctx, identity.CanDecryptPresence())
+
+	// NOTE: The documents are locked in the order of their keys to avoid
+	// deadlocks with other requests locking the same documents.
+	project := projects.From(ctx)
+	sortedKeys := make([]key.Key, 0, len(docKeys))
+	for _, pack := range reqPacks {
+		if pack.HasChanges() {
+			sortedKeys = append(sortedKeys, pack.DocumentKey)
+		}
+	}
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		return sortedKeys[i] < sortedKeys[j]
+	})
+	for _, docKey := range sortedKeys {
+		locker, err := s.backend.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, docKey))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := locker.Lock(ctx); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := locker.Unlock(ctx); err != nil {
+				logging.DefaultLogger().Error(err)
+			}
+		}()
+	}
+
+	clientInfo, err := clients.FindClientInfo(ctx, s.backend.DB, project, actorID)
+	if err != nil {
+		return nil, err
+	}
+	accessInfo := &types.AccessInfo{
+		Method: types.PushPull,
+		Client: s.accessClient(ctx, req.ClientId, ""),
+	}
+	var docInfos []*database.DocInfo
+	for i, docID := range docIDs {
+		docInfo, err := documents.FindDocInfo(ctx, s.backend, project, docID)
+		if err != nil {
+			return nil, err
+		}
+		accessInfo.Attributes = auth.AccessAttributes(types.PushPull, reqPacks[i])
+		if err := auth.VerifyDocumentAccess(
+			ctx,
+			s.authProvider,
+			accessInfo,
+			docInfo,
+			auth.RoleOf(reqPacks[i]),
+		); err != nil {
+			return nil, err
+		}
+		if err := clientInfo.EnsureDocumentAttached(docInfo.ID); err != nil {
+			return nil, err
+		}
+		docInfos = append(docInfos, docInfo)
+	}
+
+	pulledPacks, err := packs.PushPullMulti(ctx, s.backend, project, clientInfo, docInfos, reqPacks)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.PushPullChangesMultiResponse{}
+	for i, pulled := range pulledPacks {
+		pbChangePack, err := pulled.ToPBChangePack()
+		if err != nil {
+			return nil, err
+		}
+		if isCompacts[i] {
+			converter.CompactChangePack(pbChangePack)
+		}
+		if err := converter.CompressChangePack(pbChangePack, grpchelper.PackCompressor(ctx)); err != nil {
+			return nil, err
+		}
+		res.ChangePacks = append(res.ChangePacks, pbChangePack)
+	}
+
+	return res, nil
+}
+
+// BatchPushPullChanges synchronizes several documents of the client in a
+// single request. Unlike PushPullChangesMulti, the documents are synchronized
+// independently, so the failure of a document is returned in its result
+// without affecting the others.
+func (s *yorkieServer) BatchPushPullChanges(
+	ctx context.Context,
+	req *api.BatchPushPullChangesRequest,
+) (*api.BatchPushPullChangesResponse, error) {
+	if _, err := time.ActorIDFromHex(req.ClientId); err != nil {
+		return nil, err
+	}
+	if len(req.Requests) == 0 {
+		return nil, converter.ErrPackRequired
+	}
+
+	docIDs := make(map[string]bool)
+	for _, docReq := range req.Requests {
+		if docIDs[docReq.DocumentId] {
+			return nil, fmt.Errorf("%s: %w", docReq.DocumentId, packs.ErrDuplicateDocument)
+		}
+		docIDs[docReq.DocumentId] = true
+	}
+
+	res := &api.BatchPushPullChangesResponse{}
+	for _, docReq := range req.Requests {
+		docReq.ClientId = req.ClientId
+		docRes, err := s.pushPullChanges(ctx, docReq)
+		if err != nil {
+			// NOTE: The error is converted in the same way as the errors of
+			// the other RPCs so that SDKs can handle it by its reason.
+			st := status.Convert(grpchelper.ToStatusError(err))
+			res.Results = append(res.Results, &api.BatchPushPullResult{
+				Error: &api.BatchPushPullError{
+					Code:    uint32(st.Code()),
+					Message: st.Message(),
+					Reason:  grpchelper.ReasonOfStatus(st),
+				},
+			})
+			continue
+		}
+
+		res.Results = append(res.Results, &api.BatchPushPullResult{
+			ChangePack: docRes.ChangePack,
+		})
+	}
+
+	return res, nil
+}
+
+// receivePushPullChunks receives the chunks of a PushPull request from the
+// given stream until the client closes it. The first chunk carries the fields
+// of the request and the metadata of the change pack, and the following chunks
+// carry only the changes that are appended to the change pack.
+func receivePushPullChunks(
+	stream api.YorkieService_PushPullChangesStreamServer,
+	maxBytes uint64,
+) (*api.PushPullChangesRequest, error) {
+	var req *api.PushPullChangesRequest
+	var size uint64
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		size += uint64(chunk.Size())
+		if maxBytes > 0 && size > maxBytes {
+			return nil, fmt.Errorf("%d bytes exceed %d bytes: %w", size, maxBytes, converter.ErrPackTooLarge)
+		}
+
+		if req == nil {
+			req = chunk
+			continue
+		}
+		if req.ChangePack == nil || chunk.ChangePack == nil {
+			return nil, converter.ErrPackRequired
+		}
+		req.ChangePack.Changes = append(req.ChangePack.Changes, chunk.ChangePack.Changes...)
+	}
+
+	if req == nil {
+		return nil, converter.ErrPackRequired
+	}
+	return req, nil
+}
+
+// pushPullChanges stores the changes of the given request and returns the
+// changes accumulated in the server.
+func (s *yorkieServer) pushPullChanges(
+	ctx context.Context,
+	req *api.PushPullChangesRequest,
+) (*api.PushPullChangesResponse, error) {
+	actorID, err := time.ActorIDFromHex(req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	isCompact := req.ChangePack.GetIsCompact()
+	pack, err := converter.FromChangePackWithLimits(req.ChangePack, s.changeLimits())
 	if err != nil {
 		return nil, err
 	}
@@ -269,12 +688,22 @@ func (s *yorkieServer) PushPullChanges(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	// NOTE: The request is forwarded before it is authorized, since the owner
+	// of the document authorizes it again.
+	if owner := s.ownerOf(ctx, pack.DocumentKey); owner != nil {
+		return forward(ctx, s.forwarder, owner, s.connectionInfo(ctx).IP, req, api.YorkieServiceClient.PushPullChanges)
+	}
+
+	accessInfo := &types.AccessInfo{
 		Method:     types.PushPull,
-		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
-		return nil, err
+		Attributes: auth.AccessAttributes(types.PushPull, pack),
+		Client:     s.accessClient(ctx, req.ClientId, ""),
+	}
+	identity, authErr := auth.Authenticate(ctx, s.authProvider, accessInfo)
+	if authErr != nil && (pack.IsRemoved || !auth.CanReadPublicly(ctx, auth.RoleOf(pack))) {
+		return nil, authErr
 	}
+	ctx = packs.WithPresenceDecryption(ctx, identity.CanDecryptPresence())
 
 	project := projects.From(ctx)
 	if pack.HasChanges() {
@@ -309,26 +738,67 @@ func (s *yorkieServer) PushPullChanges(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.verifyDocumentAccess(ctx, accessInfo, docInfo, auth.RoleOf(pack), authErr); err != nil {
+		return nil, err
+	}
 
 	if err := clientInfo.EnsureDocumentAttached(docInfo.ID); err != nil {
 		return nil, err
 	}
 
-	pulled, err := packs.PushPull(ctx, s.backend, project, clientInfo, docInfo, pack, syncMode)
-	if err != nil {
-		return nil, err
+	// NOTE: A client pushes the same changes with the same idempotency key
+	// again if the response of the previous push is lost, e.g. by a timeout.
+	// The response of the previous push is returned so that the checkpoint of
+	// the client is not advanced twice.
+	cacheKey := pushPullCacheKey{
+		clientID:       clientInfo.ID,
+		docID:          docInfo.ID,
+		idempotencyKey: pack.IdempotencyKey,
+	}
+	pulled, ok := s.findPushPullResponse(cacheKey)
+	if !ok {
+		pulled, err = packs.PushPull(ctx, s.backend, project, clientInfo, docInfo, pack, syncMode)
+		if err != nil {
+			return nil, err
+		}
+		s.cachePushPullResponse(cacheKey, pulled)
 	}
 
 	pbChangePack, err := pulled.ToPBChangePack()
 	if err != nil {
 		return nil, err
 	}
+	if isCompact {
+		converter.CompactChangePack(pbChangePack)
+	}
+	if err := converter.CompressChangePack(pbChangePack, grpchelper.PackCompressor(ctx)); err != nil {
+		return nil, err
+	}
 
 	return &api.PushPullChangesResponse{
 		ChangePack: pbChangePack,
 	}, nil
 }
 
+// findPushPullResponse returns the cached response of the push of the given
+// key. Pushes without idempotency keys are never cached.
+func (s *yorkieServer) findPushPullResponse(cacheKey pushPullCacheKey) (*packs.ServerPack, bool) {
+	if s.pushPullCache == nil || cacheKey.idempotencyKey == "" {
+		return nil, false
+	}
+
+	return s.pushPullCache.Get(cacheKey)
+}
+
+// cachePushPullResponse caches the response of the push of the given key.
+func (s *yorkieServer) cachePushPullResponse(cacheKey pushPullCacheKey, pulled *packs.ServerPack) {
+	if s.pushPullCache == nil || cacheKey.idempotencyKey == "" {
+		return
+	}
+
+	s.pushPullCache.Add(cacheKey, pulled, s.backend.Config.ParsePushPullCacheTTL())
+}
+
 // WatchDocument connects the stream to deliver events from the given documents
 // to the requesting client.
 func (s *yorkieServer) WatchDocument(
@@ -343,6 +813,11 @@ func (s *yorkieServer) WatchDocument(
 	if err != nil {
 		return err
 	}
+	if req.Query != "" {
+		if err := document.ValidatePath(req.Query); err != nil {
+			return err
+		}
+	}
 
 	docInfo, err := documents.FindDocInfo(
 		stream.Context(),
@@ -354,10 +829,23 @@ func (s *yorkieServer) WatchDocument(
 		return nil
 	}
 
-	if err := auth.VerifyAccess(stream.Context(), s.backend, &types.AccessInfo{
+	accessInfo := &types.AccessInfo{
 		Method:     types.WatchDocuments,
-		Attributes: types.NewAccessAttributes([]key.Key{docInfo.Key}, types.Read),
-	}); err != nil {
+		Attributes: types.NewAccessAttributes([]key.Key{docInfo.Key}, types.WatchDocuments, types.Read),
+		Client:     s.accessClient(stream.Context(), req.ClientId, ""),
+	}
+	identity, authErr := auth.Authenticate(stream.Context(), s.authProvider, accessInfo)
+	if authErr != nil && !auth.CanReadPublicly(stream.Context(), types.ReaderRole) {
+		return authErr
+	}
+	ctx := packs.WithPresenceDecryption(stream.Context(), identity.CanDecryptPresence())
+	if err := s.verifyDocumentAccess(
+		stream.Context(),
+		accessInfo,
+		docInfo,
+		types.ReaderRole,
+		authErr,
+	); err != nil {
 		return err
 	}
 
@@ -368,7 +856,7 @@ func (s *yorkieServer) WatchDocument(
 
 	locker, err := s.backend.Coordinator.NewLocker(
 		stream.Context(),
-		sync.NewKey(fmt.Sprintf("watchdoc-%s-%s", clientID.String(), docID)),
+		documents.WatchDocumentKey(clientID, docID),
 	)
 	if err != nil {
 		return err
@@ -382,7 +870,11 @@ func (s *yorkieServer) WatchDocument(
 		}
 	}()
 
-	subscription, clientIDs, err := s.watchDoc(stream.Context(), clientID, docID)
+	subscription, clientIDs, err := s.watchDoc(stream.Context(), clientID, sync.DocumentRef{
+		ProjectID: docInfo.ProjectID,
+		ID:        docInfo.ID,
+		Key:       docInfo.Key,
+	})
 	if err != nil {
 		logging.From(stream.Context()).Error(err)
 		return err
@@ -405,32 +897,174 @@ func (s *yorkieServer) WatchDocument(
 		return err
 	}
 
+	// NOTE: A watcher with a query receives the value of the query instead of
+	// the document changed events, and only when the value has changed.
+	queryResult := &api.WatchDocumentResponse_QueryResult{}
+	sendQueryResult := func() error {
+		docInfo, err := documents.FindDocInfo(stream.Context(), s.backend, project, docID)
+		if err != nil {
+			return err
+		}
+		if queryResult.Value != "" && docInfo.ServerSeq == queryResult.ServerSeq {
+			return nil
+		}
+
+		value, err := documents.EvaluateQuery(stream.Context(), s.backend, docInfo, req.Query)
+		if err != nil {
+			return err
+		}
+		changed := value != queryResult.Value
+		queryResult = &api.WatchDocumentResponse_QueryResult{
+			Value:     value,
+			ServerSeq: docInfo.ServerSeq,
+		}
+		if !changed {
+			return nil
+		}
+
+		return stream.Send(&api.WatchDocumentResponse{
+			Body: &api.WatchDocumentResponse_QueryResult_{QueryResult: queryResult},
+		})
+	}
+	if req.Query != "" {
+		if err := sendQueryResult(); err != nil {
+			return err
+		}
+	}
+
+	// NOTE: The stream of a crashed client may stay open until the TCP
+	// connection times out. Close it when the client stops sending heartbeats,
+	// so that its peers do not see the client online.
+	var staleCheck <-chan gotime.Time
+	heartbeatTimeout := s.backend.Config.ParseWatchHeartbeatTimeout()
+	if heartbeatTimeout > 0 {
+		ticker := gotime.NewTicker(heartbeatTimeout / 2)
+		defer ticker.Stop()
+		staleCheck = ticker.C
+	}
+
 	for {
 		select {
 		case <-s.serviceCtx.Done():
 			return nil
 		case <-stream.Context().Done():
 			return nil
+		case <-staleCheck:
+			if subscription.IsStale(s.backend.Clock.Now(), heartbeatTimeout) {
+				logging.From(stream.Context()).Infof(
+					"WATC: evict %s from %s, no heartbeat for %s",
+					clientID,
+					docID,
+					heartbeatTimeout,
+				)
+				return sync.ErrHeartbeatTimeout
+			}
 		case event := <-subscription.Events():
+			if req.Query != "" && event.Type == types.DocumentChangedEvent {
+				if err := sendQueryResult(); err != nil {
+					return err
+				}
+				continue
+			}
+
 			eventType, err := converter.ToDocEventType(event.Type)
 			if err != nil {
 				return err
 			}
+			presence, err := packs.OpenPresence(ctx, s.backend, project, event.Presence)
+			if err != nil {
+				return err
+			}
 
 			if err := stream.Send(&api.WatchDocumentResponse{
 				Body: &api.WatchDocumentResponse_Event{
 					Event: &api.DocEvent{
 						Type:      eventType,
 						Publisher: event.Publisher.String(),
+						Presence:  presence,
+						Reason:    event.Reason,
 					},
 				},
 			}); err != nil {
 				return err
 			}
+
+			// NOTE: The document has been detached from the client by the
+			// admin, so the stream is closed after the client is notified.
+			if event.Type == types.DocumentEvictedEvent {
+				return nil
+			}
 		}
 	}
 }
 
+// Heartbeat records that the client watching the given document is alive.
+// It refreshes the subscription of the client in memory.
+func (s *yorkieServer) Heartbeat(
+	ctx context.Context,
+	req *api.HeartbeatRequest,
+) (*api.HeartbeatResponse, error) {
+	clientID, err := time.ActorIDFromHex(req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	docID, err := converter.FromDocumentID(req.DocumentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyWatcher(ctx, req.ClientId, clientID, docID); err != nil {
+		return nil, err
+	}
+
+	if err := s.backend.Coordinator.Heartbeat(ctx, clientID, docID, s.backend.Clock.Now()); err != nil {
+		return nil, err
+	}
+
+	return &api.HeartbeatResponse{}, nil
+}
+
+// UpdatePresence delivers the changed keys of the presence of the client to
+// the other clients watching the given document. The presence is not stored
+// in the document, so the client should be watching the document. The values
+// of the sensitive keys are sealed before they are delivered.
+func (s *yorkieServer) UpdatePresence(
+	ctx context.Context,
+	req *api.UpdatePresenceRequest,
+) (*api.UpdatePresenceResponse, error) {
+	clientID, err := time.ActorIDFromHex(req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	docID, err := converter.FromDocumentID(req.DocumentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.changeLimits().CheckPresence(req.Presence); err != nil {
+		return nil, err
+	}
+	if err := s.verifyWatcher(ctx, req.ClientId, clientID, docID); err != nil {
+		return nil, err
+	}
+	presence, err := packs.SealPresence(s.backend, projects.From(ctx), req.Presence)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: Updating the presence also tells that the client is alive.
+	if err := s.backend.Coordinator.Heartbeat(ctx, clientID, docID, s.backend.Clock.Now()); err != nil {
+		return nil, err
+	}
+
+	s.backend.Coordinator.Publish(ctx, clientID, sync.DocEvent{
+		Type:       types.PeersChangedEvent,
+		Publisher:  clientID,
+		DocumentID: docID,
+		Presence:   presence,
+	})
+
+	return &api.UpdatePresenceResponse{}, nil
+}
+
 // RemoveDocument removes the given document.
 func (s *yorkieServer) RemoveDocument(
 	ctx context.Context,
@@ -441,7 +1075,8 @@ func (s *yorkieServer) RemoveDocument(
 		return nil, err
 	}
 
-	pack, err := converter.FromChangePack(req.ChangePack)
+	isCompact := req.ChangePack.GetIsCompact()
+	pack, err := converter.FromChangePackWithLimits(req.ChangePack, s.changeLimits())
 	if err != nil {
 		return nil, err
 	}
@@ -449,13 +1084,20 @@ func (s *yorkieServer) RemoveDocument(
 	if err := docID.Validate(); err != nil {
 		return nil, err
 	}
+	if owner := s.ownerOf(ctx, pack.DocumentKey); owner != nil {
+		return forward(ctx, s.forwarder, owner, s.connectionInfo(ctx).IP, req, api.YorkieServiceClient.RemoveDocument)
+	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	accessInfo := &types.AccessInfo{
 		Method:     types.RemoveDocument,
-		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
+		Attributes: auth.AccessAttributes(types.RemoveDocument, pack),
+		Client:     s.accessClient(ctx, req.ClientId, ""),
+	}
+	identity, err := auth.Authenticate(ctx, s.authProvider, accessInfo)
+	if err != nil {
 		return nil, err
 	}
+	ctx = packs.WithPresenceDecryption(ctx, identity.CanDecryptPresence())
 
 	project := projects.From(ctx)
 	if pack.HasChanges() {
@@ -482,6 +1124,9 @@ func (s *yorkieServer) RemoveDocument(
 	if err != nil {
 		return nil, err
 	}
+	if err := auth.VerifyDocumentAccess(ctx, s.authProvider, accessInfo, docInfo, types.AdminRole); err != nil {
+		return nil, err
+	}
 
 	if err := clientInfo.RemoveDocument(docInfo.ID); err != nil {
 		return nil, err
@@ -496,18 +1141,122 @@ func (s *yorkieServer) RemoveDocument(
 	if err != nil {
 		return nil, err
 	}
+	if isCompact {
+		converter.CompactChangePack(pbChangePack)
+	}
+	if err := converter.CompressChangePack(pbChangePack, grpchelper.PackCompressor(ctx)); err != nil {
+		return nil, err
+	}
 
 	return &api.RemoveDocumentResponse{
 		ChangePack: pbChangePack,
 	}, nil
 }
 
+// ReadDocument returns the root of the given document at its latest server
+// sequence as JSON. The document is read without attaching it, so that the
+// consumers without CRDTs can read documents.
+func (s *yorkieServer) ReadDocument(
+	ctx context.Context,
+	req *api.ReadDocumentRequest,
+) (*api.ReadDocumentResponse, error) {
+	docKey := key.Key(req.DocumentKey)
+	if err := docKey.Validate(); err != nil {
+		return nil, err
+	}
+
+	accessInfo := &types.AccessInfo{
+		Method:     types.ReadDocument,
+		Attributes: types.NewAccessAttributes([]key.Key{docKey}, types.ReadDocument, types.Read),
+		Client:     s.accessClient(ctx, "", ""),
+	}
+	authErr := auth.VerifyAccess(ctx, s.authProvider, accessInfo)
+	if authErr != nil && !auth.CanReadPublicly(ctx, types.ReaderRole) {
+		return nil, authErr
+	}
+
+	docInfo, err := documents.FindDocInfoByKey(ctx, s.backend, projects.From(ctx), docKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyDocumentAccess(ctx, accessInfo, docInfo, types.ReaderRole, authErr); err != nil {
+		return nil, err
+	}
+
+	doc, err := packs.BuildDocumentForServerSeq(ctx, s.backend, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ReadDocumentResponse{
+		Root:      doc.Marshal(),
+		ServerSeq: docInfo.ServerSeq,
+	}, nil
+}
+
+// verifyDocumentAccess verifies the access to the given document with the
+// given role. If the authentication of the request has failed with authErr,
+// the access is permitted only by the public read access of the document.
+func (s *yorkieServer) verifyDocumentAccess(
+	ctx context.Context,
+	accessInfo *types.AccessInfo,
+	docInfo *database.DocInfo,
+	role types.ACLRole,
+	authErr error,
+) error {
+	if authErr != nil {
+		return auth.VerifyPublicRead(docInfo, authErr)
+	}
+
+	return auth.VerifyDocumentAccess(ctx, s.authProvider, accessInfo, docInfo, role)
+}
+
+// verifyWatcher verifies that the given client can watch the given document as
+// WatchDocument does, and that the client is activated in the project of the
+// request and attached to the document, so that the request is bound to the
+// client's own watch of the document.
+func (s *yorkieServer) verifyWatcher(
+	ctx context.Context,
+	reqClientID string,
+	clientID *time.ActorID,
+	docID types.ID,
+) error {
+	project := projects.From(ctx)
+	docInfo, err := documents.FindDocInfo(ctx, s.backend, project, docID)
+	if err != nil {
+		return err
+	}
+
+	accessInfo := &types.AccessInfo{
+		Method:     types.WatchDocuments,
+		Attributes: types.NewAccessAttributes([]key.Key{docInfo.Key}, types.WatchDocuments, types.Read),
+		Client:     s.accessClient(ctx, reqClientID, ""),
+	}
+	authErr := auth.VerifyAccess(ctx, s.authProvider, accessInfo)
+	if authErr != nil && !auth.CanReadPublicly(ctx, types.ReaderRole) {
+		return authErr
+	}
+	if err := s.verifyDocumentAccess(ctx, accessInfo, docInfo, types.ReaderRole, authErr); err != nil {
+		return err
+	}
+
+	clientInfo, err := clients.FindClientInfo(ctx, s.backend.DB, project, clientID)
+	if err != nil {
+		return err
+	}
+	if err := clientInfo.EnsureDocumentAttached(docInfo.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *yorkieServer) watchDoc(
 	ctx context.Context,
 	clientID *time.ActorID,
-	documentID types.ID,
+	ref sync.DocumentRef,
 ) (*sync.Subscription, []*time.ActorID, error) {
-	subscription, clientIDs, err := s.backend.Coordinator.Subscribe(ctx, clientID, documentID)
+	subscription, clientIDs, err := s.backend.Coordinator.Subscribe(ctx, clientID, ref)
 	if err != nil {
 		logging.From(ctx).Error(err)
 		return nil, nil, err
@@ -519,7 +1268,7 @@ func (s *yorkieServer) watchDoc(
 		sync.DocEvent{
 			Type:       types.DocumentWatchedEvent,
 			Publisher:  subscription.Subscriber(),
-			DocumentID: documentID,
+			DocumentID: ref.ID,
 		},
 	)
 
@@ -542,3 +1291,43 @@ func (s *yorkieServer) unwatchDoc(
 		},
 	)
 }
+
+// ownerOf returns the server of the cluster that owns the given document if
+// the request should be forwarded to it. It returns nil if this server handles
+// the request.
+func (s *yorkieServer) ownerOf(ctx context.Context, docKey key.Key) *sync.ServerInfo {
+	if s.forwarder == nil || s.forwarder.isForwarded(ctx) {
+		return nil
+	}
+
+	return s.backend.Cluster.Owner(projects.From(ctx).ID, docKey)
+}
+
+// changeLimits returns the limits of the changes decoded from the requests.
+// The payloads of compressed change packs are limited to the max size of the
+// streamed change packs.
+func (s *yorkieServer) changeLimits() converter.Limits {
+	limits := s.backend.Config.ChangeLimits()
+	limits.MaxDecompressedBytes = s.maxStreamedPackBytes
+	return limits
+}
+
+// accessClient returns the client of the given request to be passed to the
+// auth provider.
+func (s *yorkieServer) accessClient(ctx context.Context, clientID, clientKey string) *types.AccessClient {
+	return &types.AccessClient{
+		ID:         clientID,
+		Key:        clientKey,
+		Connection: s.connectionInfo(ctx),
+	}
+}
+
+// connectionInfo returns the information of the connection of the given
+// request. The X-Forwarded-For headers are trusted only from the trusted
+// proxies and the other servers of the cluster.
+func (s *yorkieServer) connectionInfo(ctx context.Context) types.ConnectionInfo {
+	forwarded := s.forwarder != nil && s.forwarder.isForwarded(ctx)
+	return grpchelper.ConnectionInfo(ctx, func(ip string) bool {
+		return forwarded || s.trustedProxies.Contains(ip)
+	})
+}
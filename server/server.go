@@ -23,9 +23,11 @@ import (
 	gosync "sync"
 
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/eventbus"
 	"github.com/yorkie-team/yorkie/server/profiling"
 	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
 	"github.com/yorkie-team/yorkie/server/rpc"
+	"github.com/yorkie-team/yorkie/server/verification"
 )
 
 // Yorkie is a server of Yorkie.
@@ -38,25 +40,42 @@ type Yorkie struct {
 	backend         *backend.Backend
 	rpcServer       *rpc.Server
 	profilingServer *profiling.Server
+	verification    *verification.Verification
 
 	shutdown   bool
 	shutdownCh chan struct{}
 }
 
 // New creates a new instance of Yorkie.
-func New(conf *Config) (*Yorkie, error) {
+func New(conf *Config, opts ...Option) (*Yorkie, error) {
 	if err := conf.Validate(); err != nil {
 		return nil, err
 	}
 
+	var options Options
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	metrics, err := prometheus.NewMetrics()
 	if err != nil {
 		return nil, err
 	}
 
+	// NOTE: A nil *mongo.Config should not be passed as a non-nil
+	// interface, otherwise the backend would try to dial MongoDB.
+	var dbConf interface{}
+	if conf.Mongo != nil {
+		dbConf = conf.Mongo
+	} else if conf.Postgres != nil {
+		dbConf = conf.Postgres
+	}
+
 	be, err := backend.New(
 		conf.Backend,
-		conf.Mongo,
+		dbConf,
+		conf.ObjectStorage,
+		conf.Redis,
 		conf.Housekeeping,
 		metrics,
 	)
@@ -64,7 +83,7 @@ func New(conf *Config) (*Yorkie, error) {
 		return nil, err
 	}
 
-	rpcServer, err := rpc.NewServer(conf.RPC, be)
+	rpcServer, err := rpc.NewServer(conf.RPC, conf.Admin, be, options.AuthProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -74,11 +93,20 @@ func New(conf *Config) (*Yorkie, error) {
 		profilingServer = profiling.NewServer(conf.Profiling, metrics)
 	}
 
+	var verifier *verification.Verification
+	if conf.Verification != nil {
+		verifier, err = verification.New(conf.Verification, be)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Yorkie{
 		conf:            conf,
 		backend:         be,
 		rpcServer:       rpcServer,
 		profilingServer: profilingServer,
+		verification:    verifier,
 		shutdownCh:      make(chan struct{}),
 	}, nil
 }
@@ -94,6 +122,13 @@ func (r *Yorkie) Start() error {
 			return err
 		}
 	}
+
+	if r.verification != nil {
+		if err := r.verification.Start(); err != nil {
+			return err
+		}
+	}
+
 	return r.rpcServer.Start()
 }
 
@@ -110,6 +145,12 @@ func (r *Yorkie) Shutdown(graceful bool) error {
 		r.profilingServer.Shutdown(graceful)
 	}
 
+	if r.verification != nil {
+		if err := r.verification.Stop(); err != nil {
+			return err
+		}
+	}
+
 	if err := r.backend.Shutdown(); err != nil {
 		return err
 	}
@@ -124,7 +165,21 @@ func (r *Yorkie) ShutdownCh() <-chan struct{} {
 	return r.shutdownCh
 }
 
+// Subscribe registers the given handler for the given types of lifecycle
+// events of this server. If no types are given, the handler receives all
+// events. Handlers run in their own goroutine, so slow handlers do not block
+// the requests, but events are dropped if they can not keep up. The returned
+// function unsubscribes the handler.
+func (r *Yorkie) Subscribe(handler eventbus.Handler, types ...eventbus.Type) func() {
+	return r.backend.EventBus.Subscribe(handler, types...)
+}
+
 // RPCAddr returns the address of the RPC.
 func (r *Yorkie) RPCAddr() string {
 	return r.conf.RPCAddr()
 }
+
+// AdminAddr returns the address of the admin service.
+func (r *Yorkie) AdminAddr() string {
+	return r.conf.AdminAddr()
+}
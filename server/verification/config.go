@@ -0,0 +1,51 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package verification
+
+import (
+	"fmt"
+	"time"
+)
+
+// Config is the configuration for the verification service.
+type Config struct {
+	// Interval is the time between verification runs.
+	Interval string `yaml:"Interval"`
+
+	// SampleSize is the number of documents to be verified in each run.
+	SampleSize int `yaml:"SampleSize"`
+}
+
+// Validate validates the configuration.
+func (c *Config) Validate() error {
+	if _, err := time.ParseDuration(c.Interval); err != nil {
+		return fmt.Errorf(
+			`invalid argument %s for "--verification-interval" flag: %w`,
+			c.Interval,
+			err,
+		)
+	}
+
+	if c.SampleSize <= 0 {
+		return fmt.Errorf(
+			`invalid argument %d for "--verification-sample-size" flag`,
+			c.SampleSize,
+		)
+	}
+
+	return nil
+}
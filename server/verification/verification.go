@@ -0,0 +1,155 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package verification provides the verification service. The verification
+// service periodically samples documents, rebuilds them from their change
+// logs and compares them against their latest snapshots to detect corrupted
+// documents.
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+)
+
+// Verification is the verification service. It verifies sampled documents
+// one by one so that it does not compete with the requests of clients.
+type Verification struct {
+	backend *backend.Backend
+
+	interval   time.Duration
+	sampleSize int
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	done       chan struct{}
+}
+
+// New creates a new verification instance.
+func New(conf *Config, be *backend.Backend) (*Verification, error) {
+	interval, err := time.ParseDuration(conf.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("parse interval %s: %w", conf.Interval, err)
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	return &Verification{
+		backend:    be,
+		interval:   interval,
+		sampleSize: conf.SampleSize,
+		ctx:        ctx,
+		cancelFunc: cancelFunc,
+		done:       make(chan struct{}),
+	}, nil
+}
+
+// Start starts the verification service.
+func (v *Verification) Start() error {
+	go v.run()
+	return nil
+}
+
+// Stop stops the verification service and waits for the running verification
+// to finish.
+func (v *Verification) Stop() error {
+	v.cancelFunc()
+	<-v.done
+
+	return nil
+}
+
+// run is the verification loop.
+func (v *Verification) run() {
+	defer close(v.done)
+
+	ctx := logging.With(v.ctx, logging.New("vrfy"))
+	for {
+		select {
+		case <-v.backend.Clock.After(v.interval):
+		case <-v.ctx.Done():
+			return
+		}
+
+		if _, err := v.VerifySamples(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logging.From(ctx).Error(err)
+		}
+	}
+}
+
+// VerifySamples verifies the documents sampled from the database and returns
+// the documents that diverged from their change logs.
+func (v *Verification) VerifySamples(ctx context.Context) ([]*database.DocInfo, error) {
+	start := time.Now()
+	docInfos, err := v.backend.DB.FindDocInfosBySample(ctx, v.sampleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var diverged []*database.DocInfo
+	verifiedCount := 0
+	for _, docInfo := range docInfos {
+		if err := ctx.Err(); err != nil {
+			return diverged, err
+		}
+
+		verification, err := packs.VerifyDocument(ctx, v.backend, docInfo)
+		if errors.Is(err, packs.ErrChangeLogIncomplete) {
+			v.backend.Metrics.AddVerifiedDocument(prometheus.VerificationSkipped)
+			continue
+		}
+		if err != nil {
+			return diverged, err
+		}
+
+		verifiedCount++
+		if !verification.IsDiverged() {
+			v.backend.Metrics.AddVerifiedDocument(prometheus.VerificationPassed)
+			continue
+		}
+
+		v.backend.Metrics.AddVerifiedDocument(prometheus.VerificationDiverged)
+		diverged = append(diverged, docInfo)
+		logging.From(ctx).Errorf(
+			"VRFY: '%s' of project '%s' diverged from its change log at serverSeq %d: snapshot %s, rebuilt %s",
+			docInfo.Key,
+			docInfo.ProjectID,
+			verification.SnapshotServerSeq,
+			verification.SnapshotHash,
+			verification.RebuiltHash,
+		)
+	}
+
+	if len(docInfos) > 0 {
+		logging.From(ctx).Infof(
+			"VRFY: samples %d, verified %d, diverged %d, %s",
+			len(docInfos),
+			verifiedCount,
+			len(diverged),
+			time.Since(start),
+		)
+	}
+
+	return diverged, nil
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package verification_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/server/verification"
+)
+
+func newTestBackend(t *testing.T) *backend.Backend {
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+
+	be, err := backend.New(&backend.Config{
+		AdminUser:                 "admin",
+		AdminPassword:             "admin",
+		ClientDeactivateThreshold: "24h",
+		SnapshotInterval:          1,
+		SnapshotThreshold:         1,
+		AuthWebhookCacheSize:      100,
+		ProjectInfoCacheSize:      256,
+		ProjectInfoCacheTTL:       "5s",
+		AdminTokenDuration:        "10s",
+	}, nil, nil, nil, &housekeeping.Config{
+		Interval:                  "10s",
+		CandidatesLimitPerProject: 10,
+		ProjectFetchSize:          10,
+	}, met)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, be.Shutdown())
+	})
+
+	return be
+}
+
+// pushDocument creates a document of the given key with a change of a new
+// client, and returns the info of the document.
+func pushDocument(
+	t *testing.T,
+	be *backend.Backend,
+	project *types.Project,
+	docKey key.Key,
+) *database.DocInfo {
+	ctx := context.Background()
+	clientInfo, err := be.DB.ActivateClient(ctx, project.ID, docKey.String(), types.ConnectionInfo{})
+	assert.NoError(t, err)
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(ctx, project.ID, clientInfo.ID, docKey, true)
+	assert.NoError(t, err)
+	assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+	actorID, err := clientInfo.ID.ToActorID()
+	assert.NoError(t, err)
+	doc := document.New(docKey)
+	doc.SetActor(actorID)
+	assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+		root.SetString("k", "v")
+		return nil
+	}))
+
+	pack := change.NewPack(docKey, change.InitialCheckpoint, doc.CreateChangePack().Changes, nil)
+	_, err = packs.PushPull(ctx, be, project, clientInfo, docInfo, pack, types.SyncModePushPull)
+	assert.NoError(t, err)
+
+	return docInfo
+}
+
+// verifiedDocuments returns the count of documents verified with the given
+// result in the metrics of the given backend.
+func verifiedDocuments(t *testing.T, be *backend.Backend, result string) float64 {
+	families, err := be.Metrics.Registry().Gather()
+	assert.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "yorkie_verification_documents_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "result" && label.GetValue() == result {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestVerification(t *testing.T) {
+	t.Run("verify samples test", func(t *testing.T) {
+		ctx := logging.With(context.Background(), logging.New("vrfy"))
+		be := newTestBackend(t)
+		projectInfo, err := be.DB.FindProjectInfoByID(ctx, database.DefaultProjectID)
+		assert.NoError(t, err)
+		project := projectInfo.ToProject()
+
+		v, err := verification.New(&verification.Config{Interval: "1h", SampleSize: 10}, be)
+		assert.NoError(t, err)
+
+		// 01. the documents that match their change logs pass.
+		passed := pushDocument(t, be, project, key.Key(t.Name()+"-passed"))
+		diverged, err := v.VerifySamples(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, diverged)
+		assert.Equal(t, float64(1), verifiedDocuments(t, be, prometheus.VerificationPassed))
+
+		// 02. the documents whose snapshots do not match their change logs
+		// diverge.
+		divergedInfo := pushDocument(t, be, project, key.Key(t.Name()+"-diverged"))
+		corrupted := document.New(divergedInfo.Key)
+		assert.NoError(t, corrupted.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k", "corrupted")
+			return nil
+		}))
+		snapshot, err := converter.EncodeSnapshot(corrupted.RootObject(), corrupted.AllPresences(), "")
+		assert.NoError(t, err)
+		doc, err := packs.BuildDocumentForPull(ctx, be, divergedInfo, divergedInfo.ServerSeq)
+		assert.NoError(t, err)
+		assert.NoError(t, be.DB.CreateSnapshotInfo(ctx, divergedInfo.ID, doc, snapshot))
+
+		diverged, err = v.VerifySamples(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, diverged, 1)
+		assert.Equal(t, divergedInfo.ID, diverged[0].ID)
+		assert.Equal(t, float64(2), verifiedDocuments(t, be, prometheus.VerificationPassed))
+		assert.Equal(t, float64(1), verifiedDocuments(t, be, prometheus.VerificationDiverged))
+
+		// 03. the documents whose change logs are purged are skipped.
+		purged, err := be.DB.PurgeChangeInfos(ctx, project.ID, passed.ID, passed.ServerSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, passed.ServerSeq, purged)
+
+		diverged, err = v.VerifySamples(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, diverged, 1)
+		assert.Equal(t, float64(2), verifiedDocuments(t, be, prometheus.VerificationPassed))
+		assert.Equal(t, float64(2), verifiedDocuments(t, be, prometheus.VerificationDiverged))
+		assert.Equal(t, float64(1), verifiedDocuments(t, be, prometheus.VerificationSkipped))
+	})
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// SendClientEvent sends the lifecycle event of the given client to the event
+// webhook of the project.
+func SendClientEvent(
+	be *backend.Backend,
+	project *types.Project,
+	eventType types.EventWebhookType,
+	clientInfo *database.ClientInfo,
+) {
+	sendEvent(be, project, &types.EventWebhookRequest{
+		Type:        eventType,
+		ProjectName: project.Name,
+		ClientID:    clientInfo.ID.String(),
+		ClientKey:   clientInfo.Key,
+		Connection:  clientInfo.Connection,
+		IssuedAt:    time.Now(),
+	})
+}
+
+// SendDocumentEvent sends the lifecycle event of the given document of the
+// client to the event webhook of the project. The client can be nil if the
+// event is not caused by a client, e.g. the removal by the admin.
+func SendDocumentEvent(
+	be *backend.Backend,
+	project *types.Project,
+	eventType types.EventWebhookType,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+) {
+	event := &types.EventWebhookRequest{
+		Type:           eventType,
+		ProjectName:    project.Name,
+		DocumentID:     docInfo.ID.String(),
+		DocumentKey:    docInfo.Key.String(),
+		DocumentLabels: docInfo.Labels,
+		IssuedAt:       time.Now(),
+	}
+	if clientInfo != nil {
+		event.ClientID = clientInfo.ID.String()
+		event.ClientKey = clientInfo.Key
+		event.Connection = clientInfo.Connection
+	}
+
+	sendEvent(be, project, event)
+}
+
+// SendSnapshotEvent sends the event that a snapshot of the given document is
+// stored at the given server seq to the event webhook of the project.
+func SendSnapshotEvent(
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+) {
+	sendEvent(be, project, &types.EventWebhookRequest{
+		Type:           types.SnapshotStoredEvent,
+		ProjectName:    project.Name,
+		DocumentID:     docInfo.ID.String(),
+		DocumentKey:    docInfo.Key.String(),
+		DocumentLabels: docInfo.Labels,
+		ServerSeq:      serverSeq,
+		IssuedAt:       time.Now(),
+	})
+}
+
+// SendDocumentLimitEvent sends the warning that the given document has reached
+// a soft limit of the project to the event webhook of the project.
+func SendDocumentLimitEvent(
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	usage *types.DocumentLimitUsage,
+) {
+	sendEvent(be, project, &types.EventWebhookRequest{
+		Type:           types.DocumentLimitWarningEvent,
+		ProjectName:    project.Name,
+		DocumentID:     docInfo.ID.String(),
+		DocumentKey:    docInfo.Key.String(),
+		DocumentLabels: docInfo.Labels,
+		LimitUsage:     usage,
+		IssuedAt:       time.Now(),
+	})
+}
+
+// sendEvent posts the given event to the event webhook of the project in the
+// background, so that the slow webhook does not block the request. The body is
+// signed with the secret key of the project so that the receiver can verify
+// the sender.
+func sendEvent(
+	be *backend.Backend,
+	project *types.Project,
+	event *types.EventWebhookRequest,
+) {
+	if !project.RequireEventWebhook(event.Type) {
+		return
+	}
+
+	url := project.EventWebhookURL
+	secretKey := project.SecretKey
+	be.Background.AttachGoroutine(func(ctx context.Context) {
+		reqBody, err := json.Marshal(event)
+		if err != nil {
+			logging.From(ctx).Error(fmt.Errorf("marshal event webhook request: %w", err))
+			return
+		}
+		signature := types.SignEventWebhookRequest(secretKey, reqBody)
+
+		if err := WithExponentialBackoff(
+			ctx,
+			be.Config.EventWebhookMaxRetries,
+			be.Config.ParseEventWebhookMaxWaitInterval(),
+			func() (int, error) {
+				req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(reqBody))
+				if err != nil {
+					return 0, fmt.Errorf("create webhook request: %w", err)
+				}
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set(types.EventWebhookSignatureHeader, signature)
+
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					return 0, fmt.Errorf("post to webhook: %w", err)
+				}
+				defer func() {
+					if err := resp.Body.Close(); err != nil {
+						logging.From(ctx).Error(err)
+					}
+				}()
+
+				if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+					return resp.StatusCode, ErrUnexpectedStatusCode
+				}
+
+				return resp.StatusCode, nil
+			},
+		); err != nil {
+			logging.From(ctx).Errorf("EVNT: %s of '%s' to %s: %v", event.Type, event.ClientKey, url, err)
+		}
+	})
+}
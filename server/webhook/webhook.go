@@ -0,0 +1,123 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package webhook provides the common logic to call the webhooks of projects.
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+var (
+	// ErrUnexpectedStatusCode is returned when the response code is not 200 from the webhook.
+	ErrUnexpectedStatusCode = errors.New("unexpected status code from webhook")
+
+	// ErrWebhookTimeout is returned when the webhook does not respond in time.
+	ErrWebhookTimeout = errors.New("webhook timeout")
+)
+
+// WithExponentialBackoff calls the given webhookFn and retries it with
+// exponential backoff until it succeeds or the retries are exhausted. If the
+// webhook keeps throttling the requests, the last types.ThrottleError returned
+// by webhookFn is returned so that the caller can back off as well.
+func WithExponentialBackoff(
+	ctx context.Context,
+	maxRetries uint64,
+	maxWaitInterval time.Duration,
+	webhookFn func() (int, error),
+) error {
+	var retries uint64
+	var statusCode int
+	var throttleErr *types.ThrottleError
+	for retries <= maxRetries {
+		statusCode, err := webhookFn()
+		if !shouldRetry(statusCode, err) {
+			if err == ErrUnexpectedStatusCode {
+				return fmt.Errorf("unexpected status code from webhook: %d", statusCode)
+			}
+
+			return err
+		}
+		if !errors.As(err, &throttleErr) {
+			throttleErr = nil
+		}
+
+		waitBeforeRetry := waitInterval(retries, maxWaitInterval)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitBeforeRetry):
+		}
+
+		retries++
+	}
+
+	if throttleErr != nil {
+		return throttleErr
+	}
+
+	return fmt.Errorf("unexpected status code from webhook %d: %w", statusCode, ErrWebhookTimeout)
+}
+
+// waitInterval returns the interval of given retries. (2^retries * 100) milliseconds.
+func waitInterval(retries uint64, maxWaitInterval time.Duration) time.Duration {
+	interval := time.Duration(math.Pow(2, float64(retries))) * 100 * time.Millisecond
+	if maxWaitInterval < interval {
+		return maxWaitInterval
+	}
+
+	return interval
+}
+
+// shouldRetry returns true if the given error should be retried.
+// Refer to https://github.com/kubernetes/kubernetes/search?q=DefaultShouldRetry
+func shouldRetry(statusCode int, err error) bool {
+	// If the connection is reset, we should retry.
+	var errno syscall.Errno
+	if errors.As(err, &errno) {
+		return errno == syscall.ECONNRESET
+	}
+
+	return statusCode == http.StatusInternalServerError ||
+		statusCode == http.StatusServiceUnavailable ||
+		statusCode == http.StatusGatewayTimeout ||
+		statusCode == http.StatusTooManyRequests
+}
+
+// NewThrottleError creates a types.ThrottleError from the given response of
+// the webhook whose status code is 429 Too Many Requests. The Retry-After
+// header is respected only in the delay-seconds form.
+func NewThrottleError(subject string, resp *http.Response) *types.ThrottleError {
+	var retryAfter time.Duration
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+
+	return &types.ThrottleError{
+		Subject:     subject,
+		Description: "too many requests to webhook",
+		RetryAfter:  retryAfter,
+	}
+}
@@ -0,0 +1,119 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// newTextDoc returns a document whose text has the given number of nodes
+// created by separate changes.
+func newTextDoc(b *testing.B, size int) *document.Document {
+	doc := document.New("d1")
+	assert.NoError(b, doc.Update(func(root *json.Object, p *presence.Presence) error {
+		root.SetNewText("text")
+		return nil
+	}))
+	for i := 0; i < size; i++ {
+		assert.NoError(b, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(i, i, "a")
+			return nil
+		}))
+	}
+	return doc
+}
+
+// newLargeRoot returns a root object that has the given number of members,
+// each of which is an object with the given number of strings.
+func newLargeRoot(members, size int) *crdt.Object {
+	lamport := int64(0)
+	issueTicket := func() *time.Ticket {
+		lamport++
+		return time.NewTicket(lamport, 0, time.InitialActorID)
+	}
+
+	root := crdt.NewObject(crdt.NewElementRHT(), issueTicket())
+	for i := 0; i < members; i++ {
+		obj := crdt.NewObject(crdt.NewElementRHT(), issueTicket())
+		for j := 0; j < size; j++ {
+			obj.Set(fmt.Sprintf("k%d", j), crdt.NewPrimitive("hello world", issueTicket()))
+		}
+		root.Set(fmt.Sprintf("k%d", i), obj)
+	}
+	return root
+}
+
+func BenchmarkConverter(b *testing.B) {
+	for _, members := range []int{1, 8} {
+		root := newLargeRoot(members, 160000/members)
+		b.Run(fmt.Sprintf("encode snapshot of %d members test", members), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				snapshot, err := converter.SnapshotToBytes(root, nil)
+				assert.NoError(b, err)
+				b.SetBytes(int64(len(snapshot)))
+			}
+		})
+	}
+
+	for _, size := range []int{100, 1000, 10000} {
+		doc := newTextDoc(b, size)
+
+		packBytes, err := converter.ChangePackToBytes(doc.CreateChangePack())
+		assert.NoError(b, err)
+		b.Run(fmt.Sprintf("decode change pack %d test", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, err := converter.BytesToChangePack(packBytes)
+				assert.NoError(b, err)
+			}
+		})
+
+		root := doc.InternalDocument().RootObject()
+		snapshot, err := converter.SnapshotToBytes(root, doc.InternalDocument().AllPresences())
+		assert.NoError(b, err)
+		b.Run(fmt.Sprintf("decode snapshot %d test", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _, err := converter.BytesToSnapshot(snapshot)
+				assert.NoError(b, err)
+			}
+		})
+
+		b.Run(fmt.Sprintf("apply changes %d test", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				pack, err := converter.BytesToChangePack(packBytes)
+				assert.NoError(b, err)
+
+				target := document.NewInternalDocument("d1")
+				assert.NoError(b, target.ApplyChangePack(pack))
+			}
+		})
+	}
+}
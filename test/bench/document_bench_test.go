@@ -20,11 +20,13 @@ package bench
 
 import (
 	"fmt"
+	"math/rand"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
@@ -447,6 +449,10 @@ func BenchmarkDocument(b *testing.B) {
 		benchmarkArray(10000, b)
 	})
 
+	b.Run("array random get with tombstones 10000", func(b *testing.B) {
+		benchmarkArrayRandomGet(10000, b)
+	})
+
 	b.Run("array gc 100", func(b *testing.B) {
 		benchmarkArrayGC(100, b)
 	})
@@ -503,6 +509,64 @@ func BenchmarkDocument(b *testing.B) {
 		benchmarkTreeSplitGC(1000, b)
 	})
 
+	b.Run("marshal 1000", func(b *testing.B) {
+		benchmarkMarshal(1000, b)
+	})
+
+	b.Run("marshal 10000", func(b *testing.B) {
+		benchmarkMarshal(10000, b)
+	})
+
+	b.Run("apply change pack 1000", func(b *testing.B) {
+		benchmarkApplyChangePack(1000, b)
+	})
+
+	b.Run("apply change pack 10000", func(b *testing.B) {
+		benchmarkApplyChangePack(10000, b)
+	})
+}
+
+func benchmarkApplyChangePack(cnt int, b *testing.B) {
+	packBytes, err := converter.ChangePackToBytes(newTextDoc(b, cnt).CreateChangePack())
+	assert.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		pack, err := converter.BytesToChangePack(packBytes)
+		assert.NoError(b, err)
+		doc := document.New("d1")
+		b.StartTimer()
+
+		assert.NoError(b, doc.ApplyChangePack(pack))
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(0, 0, "b")
+			return nil
+		})
+		assert.NoError(b, err)
+	}
+}
+
+func benchmarkMarshal(cnt int, b *testing.B) {
+	doc := document.New("d1")
+	err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+		text := root.SetNewText("text")
+		obj := root.SetNewObject("obj")
+		for c := 0; c < cnt; c++ {
+			text.Edit(c, c, "a\n")
+			obj.SetString(fmt.Sprintf("k%d", c), "\"v\"")
+		}
+		return nil
+	})
+	assert.NoError(b, err)
+
+	root := doc.RootObject()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		root.Marshal()
+	}
 }
 
 func benchmarkTree(cnt int, b *testing.B) {
@@ -737,6 +801,28 @@ func benchmarkArray(cnt int, b *testing.B) {
 	}
 }
 
+func benchmarkArrayRandomGet(cnt int, b *testing.B) {
+	doc := document.New("d1")
+	err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+		array := root.SetNewArray("k1")
+		for c := 0; c < cnt; c++ {
+			array.AddInteger(c)
+		}
+		for c := 0; c < cnt/2; c++ {
+			array.Delete(cnt / 4)
+		}
+		return nil
+	})
+	assert.NoError(b, err)
+
+	array := doc.Root().GetArray("k1")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := array.Array.Get(rand.Intn(array.Len()))
+		assert.NoError(b, err)
+	}
+}
+
 func benchmarkArrayGC(cnt int, b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		doc := document.New("d1")
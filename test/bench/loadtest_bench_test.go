@@ -0,0 +1,68 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/test/loadtest"
+)
+
+func BenchmarkLoadTest(b *testing.B) {
+	err := logging.SetLogLevel("error")
+	assert.NoError(b, err)
+
+	svr := helper.TestServer()
+	assert.NoError(b, svr.Start())
+	defer func() {
+		assert.NoError(b, svr.Shutdown(true))
+	}()
+
+	scenarios := []loadtest.Scenario{
+		loadtest.TextScenario(1, 2, 0),
+		loadtest.TextScenario(5, 5, 5),
+		loadtest.ObjectScenario(5, 5, 5),
+		loadtest.TextScenario(5, 5, 5).WithChurnRate(0.1),
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		b.Run(scenario.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				result, err := loadtest.Run(context.Background(), svr.RPCAddr(), scenario.WithSeed(int64(i)))
+				assert.NoError(b, err)
+				assert.True(b, result.Converged)
+				assert.Zero(b, result.Errors)
+
+				b.ReportMetric(result.Throughput(), "syncs/s")
+				b.ReportMetric(float64(result.Latency(99).Microseconds()), "p99-us")
+
+				sb := strings.Builder{}
+				assert.NoError(b, result.Report(&sb))
+				b.Log(sb.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/test/loadtest"
+)
+
+func BenchmarkWatchStreams(b *testing.B) {
+	assert.NoError(b, logging.SetLogLevel("error"))
+
+	svr := helper.TestServer(
+		helper.WithBackend(helper.BackendMemory),
+		helper.WithoutMaxConnectionAge(),
+	)
+	assert.NoError(b, svr.Start())
+	defer func() {
+		assert.NoError(b, svr.Shutdown(true))
+	}()
+
+	scenarios := []loadtest.WatchScenario{
+		loadtest.NewWatchScenario(10, 100),
+		loadtest.NewWatchScenario(100, 100),
+		loadtest.NewWatchScenario(200, 100),
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		b.Run(scenario.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				result, err := loadtest.RunWatch(context.Background(), svr.RPCAddr(), scenario)
+				assert.NoError(b, err)
+				assert.Zero(b, result.Missed)
+				assert.Zero(b, result.Dropped)
+				assert.Zero(b, result.LeakedGoroutines)
+
+				b.ReportMetric(float64(result.Latency(50).Microseconds()), "p50-us")
+				b.ReportMetric(float64(result.Latency(99).Microseconds()), "p99-us")
+				b.ReportMetric(float64(result.HeapPerStream), "B/stream")
+				b.ReportMetric(result.GoroutinesPerStream, "goroutines/stream")
+			}
+		})
+	}
+}
@@ -20,7 +20,6 @@ package helper
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"testing"
 	gotime "time"
@@ -51,6 +50,7 @@ var testStartedAt int64
 var (
 	RPCPort                  = 21101
 	RPCMaxRequestBytes       = uint64(4 * 1024 * 1024)
+	RPCMaxStreamedPackBytes  = uint64(64 * 1024 * 1024)
 	RPCMaxConnectionAge      = 8 * gotime.Second
 	RPCMaxConnectionAgeGrace = 2 * gotime.Second
 
@@ -62,16 +62,24 @@ var (
 	HousekeepingCandidatesLimitPerProject = 10
 	HousekeepingProjectFetchSize          = 10
 
-	AdminTokenDuration         = "10s"
-	ClientDeactivateThreshold  = "10s"
-	SnapshotThreshold          = int64(10)
-	SnapshotWithPurgingChanges = false
-	AuthWebhookMaxWaitInterval = 3 * gotime.Millisecond
-	AuthWebhookSize            = 100
-	AuthWebhookCacheAuthTTL    = 10 * gotime.Second
-	AuthWebhookCacheUnauthTTL  = 10 * gotime.Second
-	ProjectInfoCacheSize       = 256
-	ProjectInfoCacheTTL        = 5 * gotime.Second
+	AdminTokenDuration          = "10s"
+	ClientDeactivateThreshold   = "10s"
+	SnapshotThreshold           = int64(10)
+	SnapshotWithPurgingChanges  = false
+	AuthWebhookMaxWaitInterval  = 3 * gotime.Millisecond
+	AuthWebhookSize             = 100
+	AuthWebhookCacheAuthTTL     = 10 * gotime.Second
+	AuthWebhookCacheUnauthTTL   = 10 * gotime.Second
+	AuthJWKSCacheTTL            = 10 * gotime.Second
+	EventWebhookMaxRetries      = uint64(3)
+	EventWebhookMaxWaitInterval = 3 * gotime.Millisecond
+	ProjectInfoCacheSize        = 256
+	ProjectInfoCacheTTL         = 5 * gotime.Second
+	SnapshotCacheSize           = 128
+	SnapshotCacheTTL            = 5 * gotime.Second
+	PushPullCacheSize           = 1024
+	PushPullCacheTTL            = 5 * gotime.Second
+	PresenceEncryptionKey       = "00112233445566778899aabbccddeeff"
 
 	MongoConnectionURI     = "mongodb://localhost:27017"
 	MongoConnectionTimeout = "5s"
@@ -193,20 +201,20 @@ func BuildTreeNode(node *json.TreeNode) *crdt.TreeNode {
 	return doc.Root().GetTree("test").Root()
 }
 
-var portOffset = 0
-
-// TestConfig returns config for creating Yorkie instance.
+// TestConfig returns config for creating Yorkie instance. The ports of the
+// config are allocated from the free ports, so that servers created from the
+// config can run in parallel.
 func TestConfig() *server.Config {
-	portOffset += 100
 	return &server.Config{
 		RPC: &rpc.Config{
-			Port:                  RPCPort + portOffset,
+			Port:                  freePort(),
 			MaxRequestBytes:       RPCMaxRequestBytes,
+			MaxStreamedPackBytes:  RPCMaxStreamedPackBytes,
 			MaxConnectionAge:      RPCMaxConnectionAge.String(),
 			MaxConnectionAgeGrace: RPCMaxConnectionAgeGrace.String(),
 		},
 		Profiling: &profiling.Config{
-			Port: ProfilingPort + portOffset,
+			Port: freePort(),
 		},
 		Housekeeping: &housekeeping.Config{
 			Interval:                  HousekeepingInterval.String(),
@@ -214,21 +222,29 @@ func TestConfig() *server.Config {
 			ProjectFetchSize:          HousekeepingProjectFetchSize,
 		},
 		Backend: &backend.Config{
-			AdminUser:                  server.DefaultAdminUser,
-			AdminPassword:              server.DefaultAdminPassword,
-			SecretKey:                  server.DefaultSecretKey,
-			AdminTokenDuration:         server.DefaultAdminTokenDuration.String(),
-			UseDefaultProject:          true,
-			ClientDeactivateThreshold:  server.DefaultClientDeactivateThreshold,
-			SnapshotInterval:           10,
-			SnapshotThreshold:          SnapshotThreshold,
-			SnapshotWithPurgingChanges: SnapshotWithPurgingChanges,
-			AuthWebhookMaxWaitInterval: AuthWebhookMaxWaitInterval.String(),
-			AuthWebhookCacheSize:       AuthWebhookSize,
-			AuthWebhookCacheAuthTTL:    AuthWebhookCacheAuthTTL.String(),
-			AuthWebhookCacheUnauthTTL:  AuthWebhookCacheUnauthTTL.String(),
-			ProjectInfoCacheSize:       ProjectInfoCacheSize,
-			ProjectInfoCacheTTL:        ProjectInfoCacheTTL.String(),
+			AdminUser:                   server.DefaultAdminUser,
+			AdminPassword:               server.DefaultAdminPassword,
+			SecretKey:                   server.DefaultSecretKey,
+			AdminTokenDuration:          server.DefaultAdminTokenDuration.String(),
+			UseDefaultProject:           true,
+			ClientDeactivateThreshold:   server.DefaultClientDeactivateThreshold,
+			SnapshotInterval:            10,
+			SnapshotThreshold:           SnapshotThreshold,
+			SnapshotWithPurgingChanges:  SnapshotWithPurgingChanges,
+			AuthWebhookMaxWaitInterval:  AuthWebhookMaxWaitInterval.String(),
+			AuthWebhookCacheSize:        AuthWebhookSize,
+			AuthWebhookCacheAuthTTL:     AuthWebhookCacheAuthTTL.String(),
+			AuthWebhookCacheUnauthTTL:   AuthWebhookCacheUnauthTTL.String(),
+			AuthJWKSCacheTTL:            AuthJWKSCacheTTL.String(),
+			EventWebhookMaxRetries:      EventWebhookMaxRetries,
+			EventWebhookMaxWaitInterval: EventWebhookMaxWaitInterval.String(),
+			ProjectInfoCacheSize:        ProjectInfoCacheSize,
+			ProjectInfoCacheTTL:         ProjectInfoCacheTTL.String(),
+			SnapshotCacheSize:           SnapshotCacheSize,
+			SnapshotCacheTTL:            SnapshotCacheTTL.String(),
+			PushPullCacheSize:           PushPullCacheSize,
+			PushPullCacheTTL:            PushPullCacheTTL.String(),
+			PresenceEncryptionKey:       PresenceEncryptionKey,
 		},
 		Mongo: &mongo.Config{
 			ConnectionURI:     MongoConnectionURI,
@@ -239,15 +255,6 @@ func TestConfig() *server.Config {
 	}
 }
 
-// TestServer returns a new instance of Yorkie for testing.
-func TestServer() *server.Yorkie {
-	y, err := server.New(TestConfig())
-	if err != nil {
-		log.Fatal(err)
-	}
-	return y
-}
-
 // TestDocKey returns a new instance of document key for testing.
 func TestDocKey(t testing.TB) key.Key {
 	name := t.Name()
@@ -0,0 +1,362 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	gotime "time"
+
+	adminClient "github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/faults"
+	"github.com/yorkie-team/yorkie/server/rpc"
+)
+
+// Backend is the type of the database that the test server uses.
+type Backend string
+
+// The values below are types of Backend.
+const (
+	BackendMongo  Backend = "mongo"
+	BackendMemory Backend = "memory"
+)
+
+// ServerOption configures the server created by TestServer.
+type ServerOption func(*serverOptions)
+
+type serverOptions struct {
+	backend           Backend
+	rpcPort           int
+	profilingPort     int
+	snapshotThreshold int64
+	snapshotInterval  int64
+	authWebhook       http.Handler
+	tls               bool
+	faultInjector     faults.Injector
+	keepConnections   bool
+	unixSocket        string
+	adminServer       bool
+	webProtocols      bool
+}
+
+// WithBackend configures the database of the server.
+func WithBackend(backend Backend) ServerOption {
+	return func(o *serverOptions) { o.backend = backend }
+}
+
+// WithPorts configures the RPC and profiling ports of the server instead of
+// the free ports.
+func WithPorts(rpcPort, profilingPort int) ServerOption {
+	return func(o *serverOptions) {
+		o.rpcPort = rpcPort
+		o.profilingPort = profilingPort
+	}
+}
+
+// WithSnapshotThreshold configures the threshold and the interval of
+// snapshots of the server.
+func WithSnapshotThreshold(threshold, interval int64) ServerOption {
+	return func(o *serverOptions) {
+		o.snapshotThreshold = threshold
+		o.snapshotInterval = interval
+	}
+}
+
+// WithAuthWebhook configures the server to run a stub of the authorization
+// webhook with the given handler and to register it to the default project
+// when the server starts. It cannot be used with WithTLS.
+func WithAuthWebhook(handler http.Handler) ServerOption {
+	return func(o *serverOptions) { o.authWebhook = handler }
+}
+
+// WithTLS configures the server to serve RPC over TLS with a self-signed
+// certificate for localhost. Clients can trust it with Server.CertFile.
+func WithTLS() ServerOption {
+	return func(o *serverOptions) { o.tls = true }
+}
+
+// WithFaultInjector configures the server to inject the faults of the given
+// injector into the calls of the database and the pubsub.
+func WithFaultInjector(injector faults.Injector) ServerOption {
+	return func(o *serverOptions) { o.faultInjector = injector }
+}
+
+// WithoutMaxConnectionAge configures the server not to close connections by
+// their age, so that long-running streams are not closed by the server.
+func WithoutMaxConnectionAge() ServerOption {
+	return func(o *serverOptions) { o.keepConnections = true }
+}
+
+// WithUnixSocket configures the server to listen on the Unix domain socket of
+// the given path as well as on the RPC port.
+func WithUnixSocket(path string) ServerOption {
+	return func(o *serverOptions) { o.unixSocket = path }
+}
+
+// WithAdminServer configures the server to serve the admin service on its own
+// port with its own secret key. Admin clients can dial it with
+// Server.AdminAddr.
+func WithAdminServer() ServerOption {
+	return func(o *serverOptions) { o.adminServer = true }
+}
+
+// WithWebProtocols configures the server to serve gRPC-Web and Connect along
+// with gRPC on the RPC port.
+func WithWebProtocols() ServerOption {
+	return func(o *serverOptions) { o.webProtocols = true }
+}
+
+// TokenAuthWebhook returns a handler of the authorization webhook that allows
+// only the requests with the given token.
+func TokenAuthWebhook(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewAuthWebhookRequest(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var res types.AuthWebhookResponse
+		if req.Token == token {
+			res.Allowed = true
+		} else {
+			res.Reason = "invalid token"
+		}
+		_, _ = res.Write(w)
+	})
+}
+
+// Server is a Yorkie server for testing. It runs the stubs that the server
+// depends on along with the server.
+type Server struct {
+	*server.Yorkie
+
+	authWebhook       http.Handler
+	authWebhookServer *httptest.Server
+	certDir           string
+}
+
+// TestServer returns a new instance of Yorkie for testing. Without options,
+// it uses the config of TestConfig.
+func TestServer(opts ...ServerOption) *Server {
+	options := &serverOptions{backend: BackendMongo}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.authWebhook != nil && options.tls {
+		log.Fatal("auth webhook stub cannot be used with TLS")
+	}
+
+	conf := TestConfig()
+	if options.backend == BackendMemory {
+		conf.Mongo = nil
+	}
+	if options.rpcPort != 0 {
+		conf.RPC.Port = options.rpcPort
+	}
+	if options.profilingPort != 0 {
+		conf.Profiling.Port = options.profilingPort
+	}
+	if options.snapshotThreshold != 0 {
+		conf.Backend.SnapshotThreshold = options.snapshotThreshold
+	}
+	if options.snapshotInterval != 0 {
+		conf.Backend.SnapshotInterval = options.snapshotInterval
+	}
+	if options.faultInjector != nil {
+		conf.Backend.FaultInjector = options.faultInjector
+	}
+	if options.keepConnections {
+		conf.RPC.MaxConnectionAge = "0s"
+		conf.RPC.MaxConnectionAgeGrace = "0s"
+	}
+	if options.adminServer {
+		conf.Admin = &rpc.AdminConfig{
+			Port:      freePort(),
+			SecretKey: "admin-secret",
+		}
+	}
+	if options.webProtocols {
+		conf.RPC.EnableWebProtocols = true
+	}
+	if options.unixSocket != "" {
+		conf.RPC.Addresses = []string{
+			fmt.Sprintf(":%d", conf.RPC.Port),
+			"unix:" + options.unixSocket,
+		}
+	}
+
+	svr := &Server{authWebhook: options.authWebhook}
+	if options.tls {
+		certDir, err := os.MkdirTemp("", "yorkie-test-cert")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := writeSelfSignedCert(certDir); err != nil {
+			log.Fatal(err)
+		}
+		svr.certDir = certDir
+		conf.RPC.CertFile = svr.CertFile()
+		conf.RPC.KeyFile = filepath.Join(certDir, "key.pem")
+	}
+
+	y, err := server.New(conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	svr.Yorkie = y
+	return svr
+}
+
+// Start starts the server and the stubs of the server.
+func (s *Server) Start() error {
+	if err := s.Yorkie.Start(); err != nil {
+		return err
+	}
+	if s.authWebhook == nil {
+		return nil
+	}
+
+	s.authWebhookServer = httptest.NewServer(s.authWebhook)
+	return s.registerAuthWebhook(s.authWebhookServer.URL)
+}
+
+// Shutdown shuts down the server and the stubs of the server.
+func (s *Server) Shutdown(graceful bool) error {
+	if err := s.Yorkie.Shutdown(graceful); err != nil {
+		return err
+	}
+
+	if s.authWebhookServer != nil {
+		s.authWebhookServer.Close()
+		s.authWebhookServer = nil
+	}
+	if s.certDir != "" {
+		return os.RemoveAll(s.certDir)
+	}
+	return nil
+}
+
+// CertFile returns the path of the certificate of the server if the server
+// serves RPC over TLS.
+func (s *Server) CertFile() string {
+	if s.certDir == "" {
+		return ""
+	}
+	return filepath.Join(s.certDir, "cert.pem")
+}
+
+// AuthWebhookURL returns the URL of the stub of the authorization webhook if
+// the server is started with it.
+func (s *Server) AuthWebhookURL() string {
+	if s.authWebhookServer == nil {
+		return ""
+	}
+	return s.authWebhookServer.URL
+}
+
+// registerAuthWebhook registers the given URL of the authorization webhook to
+// the default project.
+func (s *Server) registerAuthWebhook(url string) error {
+	ctx := context.Background()
+	cli, err := adminClient.Dial(s.AdminAddr(), adminClient.WithInsecure(true))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cli.Close()
+	}()
+
+	if _, err := cli.LogIn(ctx, AdminUser, AdminPassword); err != nil {
+		return err
+	}
+	if _, err := cli.UpdateProject(ctx, database.DefaultProjectID.String(), &types.UpdatableProjectFields{
+		AuthWebhookURL: &url,
+	}); err != nil {
+		return fmt.Errorf("register auth webhook: %w", err)
+	}
+	return nil
+}
+
+// freePort returns a free port of localhost.
+func freePort() int {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() {
+		_ = l.Close()
+	}()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// writeSelfSignedCert writes a self-signed certificate for localhost and its
+// key to cert.pem and key.pem of the given directory.
+func writeSelfSignedCert(dir string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    gotime.Now().Add(-gotime.Hour),
+		NotAfter:     gotime.Now().Add(24 * gotime.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(
+		filepath.Join(dir, "cert.pem"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}),
+		0600,
+	); err != nil {
+		return err
+	}
+	return os.WriteFile(
+		filepath.Join(dir, "key.pem"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}),
+		0600,
+	)
+}
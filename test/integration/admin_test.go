@@ -19,19 +19,28 @@
 package integration
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"math"
 	"sync"
 	"testing"
+	gotime "time"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
@@ -66,6 +75,7 @@ func TestAdmin(t *testing.T) {
 		// 01. admin tries to remove document that does not exist.
 		err = adminCli.RemoveDocument(ctx, "default", d1.Key().String(), true)
 		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+		assert.Equal(t, "DOCUMENT_NOT_FOUND", client.ErrorReason(err))
 
 		// 02. client creates a document then admin removes the document.
 		assert.NoError(t, cli.Attach(ctx, d1))
@@ -149,4 +159,653 @@ func TestAdmin(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, document.StatusDetached, doc.Status())
 	})
+
+	t.Run("document labels test", func(t *testing.T) {
+		ctx := context.Background()
+		group := helper.TestDocKey(t).String()
+
+		// 01. c1 creates documents with labels.
+		d1 := document.New(key.Key(group + "-1"))
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithLabels(map[string]string{"group": group, "env": "prod"})))
+		d2 := document.New(key.Key(group + "-2"))
+		assert.NoError(t, c1.Attach(ctx, d2, client.WithLabels(map[string]string{"group": group, "env": "dev"})))
+
+		selector, err := types.ParseLabelSelector("group=" + group + ",env=prod")
+		assert.NoError(t, err)
+		filter := types.DocumentFilter{Labels: selector}
+		docs, err := adminCli.ListDocuments(ctx, "default", filter, "", 10, true, false)
+		assert.NoError(t, err)
+		assert.Len(t, docs, 1)
+		assert.Equal(t, d1.Key(), docs[0].Key)
+		assert.Equal(t, map[string]string{"group": group, "env": "prod"}, docs[0].Labels)
+
+		// 02. admin updates the labels of d2.
+		labels, err := adminCli.UpdateDocumentLabels(ctx, "default", d2.Key(), map[string]string{
+			"group": group,
+			"env":   "prod",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "prod", labels["env"])
+
+		docs, err = adminCli.ListDocuments(ctx, "default", filter, "", 10, true, false)
+		assert.NoError(t, err)
+		assert.Len(t, docs, 2)
+
+		_, err = adminCli.UpdateDocumentLabels(ctx, "default", d2.Key(), map[string]string{"in.valid": ""})
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// 03. admin removes the documents of the group in bulk. Attached
+		// documents are skipped without force.
+		keys, err := adminCli.RemoveDocuments(ctx, "default", "group="+group, false)
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+
+		assert.NoError(t, c1.Detach(ctx, d1))
+		assert.NoError(t, c1.Detach(ctx, d2))
+		keys, err = adminCli.RemoveDocuments(ctx, "default", "group="+group, false)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{d1.Key().String(), d2.Key().String()}, keys)
+
+		_, err = adminCli.RemoveDocuments(ctx, "default", "", true)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+
+	t.Run("document list filter test", func(t *testing.T) {
+		ctx := context.Background()
+		prefix := helper.TestDocKey(t).String()
+
+		// 01. c1 creates two documents and then updates only d1.
+		d1 := document.New(key.Key(prefix + "-1"))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(prefix + "-2"))
+		assert.NoError(t, c1.Attach(ctx, d2))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+			assert.NoError(t, c1.Detach(ctx, d2))
+		}()
+
+		since := gotime.Now()
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx, client.WithDocKey(d1.Key())))
+
+		// 02. list the documents by the prefix of their keys.
+		docs, err := adminCli.ListDocuments(ctx, "default", types.DocumentFilter{
+			KeyPrefix: prefix,
+		}, "", 10, true, false)
+		assert.NoError(t, err)
+		assert.Len(t, docs, 2)
+
+		// 03. list the documents by the time when they are updated.
+		docs, err = adminCli.ListDocuments(ctx, "default", types.DocumentFilter{
+			KeyPrefix:    prefix,
+			UpdatedAfter: since,
+		}, "", 10, true, false)
+		assert.NoError(t, err)
+		assert.Len(t, docs, 1)
+		assert.Equal(t, d1.Key(), docs[0].Key)
+
+		docs, err = adminCli.ListDocuments(ctx, "default", types.DocumentFilter{
+			KeyPrefix:     prefix,
+			UpdatedBefore: since,
+		}, "", 10, true, false)
+		assert.NoError(t, err)
+		assert.Len(t, docs, 1)
+		assert.Equal(t, d2.Key(), docs[0].Key)
+	})
+
+	t.Run("document template test", func(t *testing.T) {
+		ctx := context.Background()
+		collection := helper.TestDocKey(t).String()
+
+		// 01. admin registers the template of the collection.
+		_, err := adminCli.RegisterDocumentTemplate(ctx, "default", collection, `[]`)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		_, err = adminCli.RegisterDocumentTemplate(ctx, "default", "in/valid", `{}`)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		template, err := adminCli.RegisterDocumentTemplate(
+			ctx,
+			"default",
+			collection,
+			`{"title":"untitled","cards":[{"done":false}],"meta":{"count":0}}`,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, collection, template.Collection)
+
+		templates, err := adminCli.ListDocumentTemplates(ctx, "default")
+		assert.NoError(t, err)
+		assert.Contains(t, templates, template)
+
+		// 02. the first attachment initializes the document from the template.
+		d1 := document.New(key.Key(collection + "-1"))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+		}()
+		assert.Equal(t, `{"cards":[{"done":false}],"meta":{"count":0},"title":"untitled"}`, d1.Marshal())
+
+		// 03. the following attachments do not initialize the document again.
+		cli, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() {
+			assert.NoError(t, cli.Deactivate(ctx))
+			assert.NoError(t, cli.Close())
+		}()
+
+		d2 := document.New(key.Key(collection + "-1"))
+		assert.NoError(t, cli.Attach(ctx, d2))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// 04. documents are not initialized after the template is removed.
+		assert.NoError(t, adminCli.RemoveDocumentTemplate(ctx, "default", collection))
+		err = adminCli.RemoveDocumentTemplate(ctx, "default", collection)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		d3 := document.New(key.Key(collection + "-2"))
+		assert.NoError(t, cli.Attach(ctx, d3))
+		assert.Equal(t, `{}`, d3.Marshal())
+	})
+
+	t.Run("snapshot diff test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+		}()
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v2")
+			root.SetString("k2", "v3")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 01. diff the state after the first update against the live state.
+		// NOTE: The first change is pushed by the attachment.
+		from, err := adminCli.GetSnapshot(ctx, "default", d1.Key(), 2)
+		assert.NoError(t, err)
+		to, err := adminCli.GetSnapshot(ctx, "default", d1.Key(), math.MaxInt64)
+		assert.NoError(t, err)
+
+		diffs, err := document.DiffSnapshots(from, to)
+		assert.NoError(t, err)
+		assert.Equal(t, []document.Diff{
+			{Type: document.DiffChanged, Path: "$.k1", From: `"v1"`, To: `"v2"`},
+			{Type: document.DiffAdded, Path: "$.k2", To: `"v3"`},
+		}, diffs)
+	})
+
+	t.Run("document verification test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+		}()
+
+		// 01. verify the document without snapshots.
+		verification, err := adminCli.VerifyDocument(ctx, "default", d1.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), verification.SnapshotServerSeq)
+		assert.False(t, verification.IsDiverged())
+
+		// 02. verify the document after the snapshot is created.
+		for i := 0; i < int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+
+		verification, err = adminCli.VerifyDocument(ctx, "default", d1.Key())
+		assert.NoError(t, err)
+		assert.NotEqual(t, int64(0), verification.SnapshotServerSeq)
+		assert.False(t, verification.IsDiverged())
+	})
+
+	t.Run("document rebuild test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+		}()
+
+		// 01. rebuild the document from more changes than the snapshot
+		// threshold.
+		for i := 0; i < int(helper.SnapshotThreshold)+1; i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger(fmt.Sprintf("k%d", i%3), i)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+
+		doc, serverSeq, err := adminCli.RebuildDocument(ctx, "default", d1.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Checkpoint().ServerSeq, serverSeq)
+		assert.Equal(t, d1.Marshal(), doc.Marshal())
+
+		// 02. the rebuilt document is the same as the one built by the server.
+		snapshot, err := adminCli.GetSnapshot(ctx, "default", d1.Key(), serverSeq)
+		assert.NoError(t, err)
+		root, _, err := converter.BytesToSnapshot(snapshot)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), root.Marshal())
+	})
+
+	t.Run("document restore test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+		}()
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			root.SetNewText("k2").Edit(0, 0, "hello", map[string]string{"b": "1"})
+			root.SetNewArray("k3").AddInteger(1, 2)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		restored := d1.Marshal()
+		restoredSeq := d1.Checkpoint().ServerSeq
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v2")
+			root.GetText("k2").Edit(0, 5, "world")
+			root.Delete("k3")
+			root.SetNewCounter("k4", crdt.IntegerCnt, 10)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 01. restore the document to the state before the second update.
+		serverSeq, err := adminCli.RestoreDocument(ctx, "default", d1.Key(), restoredSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, restoredSeq+2, serverSeq)
+
+		// 02. the restore is synced to the clients as a new change.
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, restored, d1.Marshal())
+		assert.Equal(t, serverSeq, d1.Checkpoint().ServerSeq)
+
+		changes, err := adminCli.ListChangeSummaries(ctx, "default", d1.Key(), 0, 0, true)
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("restore to %d", restoredSeq), changes[0].Message)
+
+		// 03. restoring to the current state does not make a new change.
+		serverSeq2, err := adminCli.RestoreDocument(ctx, "default", d1.Key(), serverSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, serverSeq, serverSeq2)
+
+		// 04. the server seq after the current one is rejected.
+		_, err = adminCli.RestoreDocument(ctx, "default", d1.Key(), serverSeq+1)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("document export and import test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+		}()
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			root.SetNewText("k2").Edit(0, 0, "hello", map[string]string{"b": "1"})
+			root.SetNewArray("k3").AddInteger(1, 2)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k2").Edit(0, 5, "world")
+			root.SetNewCounter("k4", crdt.IntegerCnt, 10)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 01. import the export with the change history to keep the history.
+		data, err := adminCli.ExportDocument(ctx, "default", d1.Key(), true)
+		assert.NoError(t, err)
+		historyKey := key.Key(d1.Key().String() + "-history")
+		serverSeq, err := adminCli.ImportDocument(ctx, "default", historyKey, data)
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Checkpoint().ServerSeq, serverSeq)
+		changes, err := adminCli.ListChangeSummaries(ctx, "default", historyKey, 0, 0, true)
+		assert.NoError(t, err)
+		assert.Len(t, changes, int(serverSeq))
+
+		d2 := document.New(historyKey)
+		assert.NoError(t, c1.Attach(ctx, d2))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.NoError(t, c1.Detach(ctx, d2))
+
+		// 02. import the export without the change history as a single change.
+		data, err = adminCli.ExportDocument(ctx, "default", d1.Key(), false)
+		assert.NoError(t, err)
+		snapshotKey := key.Key(d1.Key().String() + "-snapshot")
+		serverSeq, err = adminCli.ImportDocument(ctx, "default", snapshotKey, data)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), serverSeq)
+
+		d3 := document.New(snapshotKey)
+		assert.NoError(t, c1.Attach(ctx, d3))
+		assert.Equal(t, d1.Marshal(), d3.Marshal())
+
+		// 03. the imported document can be edited like the others.
+		assert.NoError(t, d3.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k2").Edit(5, 5, "!")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c1.Detach(ctx, d3))
+
+		// 04. the key of a document with contents is rejected.
+		_, err = adminCli.ImportDocument(ctx, "default", d1.Key(), data)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("project backup and restore test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+		}()
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			root.SetNewText("k2").Edit(0, 0, "hello")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		backedUp := d1.Marshal()
+		watermark := d1.Checkpoint().ServerSeq
+
+		// 01. back up the project with the change logs of the documents.
+		var archive bytes.Buffer
+		assert.NoError(t, adminCli.BackupProject(ctx, "default", true, &archive))
+
+		// 02. the changes after the backup are not restored.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v2")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		_, err := adminCli.CreateProject(ctx, "backup-restore")
+		assert.NoError(t, err)
+		manifest, err := adminCli.RestoreProject(ctx, "backup-restore", bytes.NewReader(archive.Bytes()))
+		assert.NoError(t, err)
+		assert.Equal(t, "default", manifest.Project)
+
+		var entry *types.BackupDocument
+		for _, doc := range manifest.Documents {
+			if doc.Key == d1.Key() {
+				entry = doc
+			}
+		}
+		assert.NotNil(t, entry)
+		assert.Equal(t, watermark, entry.ServerSeq)
+		assert.True(t, entry.HasChanges)
+
+		changes, err := adminCli.ListChangeSummaries(ctx, "backup-restore", d1.Key(), 0, 0, true)
+		assert.NoError(t, err)
+		assert.Len(t, changes, int(watermark))
+		assert.Equal(t, backedUp, changes[0].Snapshot)
+
+		// 03. the documents that already exist are not overwritten.
+		_, err = adminCli.RestoreProject(ctx, "backup-restore", bytes.NewReader(archive.Bytes()))
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		// 04. the archive without the manifest is rejected.
+		var truncated bytes.Buffer
+		tw := tar.NewWriter(&truncated)
+		assert.NoError(t, tw.Close())
+		_, err = adminCli.RestoreProject(ctx, "backup-restore", &truncated)
+		assert.ErrorIs(t, err, admin.ErrIncompleteBackup)
+	})
+
+	t.Run("document eviction test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := c1.Watch(watchCtx, d1)
+		assert.NoError(t, err)
+
+		// 01. admin evicts the document from the clients attaching it.
+		clientIDs, err := adminCli.EvictDocument(ctx, "default", d1.Key(), "maintenance")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{c1.ID().String()}, clientIDs)
+
+		// 02. the watcher is notified with the reason and the stream is closed.
+		resp := <-rch
+		assert.Equal(t, client.DocumentEvicted, resp.Type)
+		assert.Equal(t, "maintenance", resp.Reason)
+		_, ok := <-rch
+		assert.False(t, ok)
+
+		// 03. the client can no longer sync the document, but can detach it.
+		err = c1.Sync(ctx, client.WithDocKey(d1.Key()))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.NoError(t, c1.Detach(ctx, d1))
+		assert.Equal(t, document.StatusDetached, d1.Status())
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 04. evicting the document without clients attaching it does nothing.
+		clientIDs, err = adminCli.EvictDocument(ctx, "default", d1.Key(), "")
+		assert.NoError(t, err)
+		assert.Len(t, clientIDs, 0)
+	})
+
+	t.Run("batch sync with an evicted document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(key.Key(helper.TestDocKey(t) + "-evicted"))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(helper.TestDocKey(t) + "-synced"))
+		assert.NoError(t, c1.Attach(ctx, d2))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+			assert.NoError(t, c1.Detach(ctx, d2))
+		}()
+
+		for _, doc := range []*document.Document{d1, d2} {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString("k1", "v1")
+				return nil
+			}))
+		}
+
+		// 01. the documents are synced in a single request, and the failure
+		// of the evicted document does not prevent the other from syncing.
+		_, err := adminCli.EvictDocument(ctx, "default", d1.Key(), "")
+		assert.NoError(t, err)
+		err = c1.Sync(ctx)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Equal(t, "DOCUMENT_NOT_ATTACHED", client.ErrorReason(err))
+		assert.True(t, d1.HasLocalChanges())
+		assert.False(t, d2.HasLocalChanges())
+
+		// 02. the changes of the synced document are delivered to others.
+		c2, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer func() {
+			assert.NoError(t, c2.Deactivate(ctx))
+			assert.NoError(t, c2.Close())
+		}()
+		d3 := document.New(d2.Key())
+		assert.NoError(t, c2.Attach(ctx, d3))
+		assert.Equal(t, `{"k1":"v1"}`, d3.Marshal())
+		assert.NoError(t, c2.Detach(ctx, d3))
+	})
+
+	t.Run("document gc test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("k1").SetString("k1.1", "v1")
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("k1")
+			return nil
+		}))
+		assert.NoError(t, c1.Detach(ctx, d1))
+
+		// 01. the garbage is purged since no client attaches the document.
+		removed, serverSeq, err := adminCli.GCDocument(ctx, "default", d1.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, removed)
+		assert.Equal(t, int64(4), serverSeq)
+
+		// 02. nothing is purged again at the same server seq.
+		removed, _, err = adminCli.GCDocument(ctx, "default", d1.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, removed)
+
+		// 03. the document is not changed by the garbage collection.
+		d2 := document.New(d1.Key())
+		assert.NoError(t, c1.Attach(ctx, d2))
+		assert.Equal(t, `{"k2":"v2"}`, d2.Marshal())
+		assert.NoError(t, c1.Detach(ctx, d2))
+	})
+
+	t.Run("document snapshot config test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+		}()
+
+		// 01. negative values are rejected.
+		err := adminCli.UpdateDocumentSnapshotConfig(ctx, "default", d1.Key(), -1, 0)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// 02. a snapshot is stored at the interval of the document.
+		assert.NoError(t, adminCli.UpdateDocumentSnapshotConfig(ctx, "default", d1.Key(), 2, 2))
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+
+		verification, err := adminCli.VerifyDocument(ctx, "default", d1.Key())
+		assert.NoError(t, err)
+		assert.NotEqual(t, int64(0), verification.SnapshotServerSeq)
+		assert.False(t, verification.IsDiverged())
+	})
+
+	t.Run("document memory test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d1))
+		}()
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		_, err := c1.Watch(watchCtx, d1)
+		assert.NoError(t, err)
+
+		memories, err := adminCli.ListDocumentMemories(ctx, "default", 0)
+		assert.NoError(t, err)
+
+		var found bool
+		for _, memory := range memories {
+			if memory.Key == d1.Key() {
+				found = true
+				assert.Equal(t, 1, memory.Subscriptions)
+				assert.Greater(t, memory.Bytes(), int64(0))
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("document memory with snapshots test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. push changes more than the snapshot threshold.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		for i := 0; i < int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+		}
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c1.Detach(ctx, d1))
+
+		// 02. attach the document again to pull the snapshot, which is cached.
+		d2 := document.New(d1.Key())
+		assert.NoError(t, c1.Attach(ctx, d2))
+		defer func() {
+			assert.NoError(t, c1.Detach(ctx, d2))
+		}()
+
+		memories, err := adminCli.ListDocumentMemories(ctx, "default", 0)
+		assert.NoError(t, err)
+
+		var found bool
+		for _, memory := range memories {
+			if memory.Key == d1.Key() {
+				found = true
+				assert.Equal(t, 0, memory.Subscriptions)
+				assert.Equal(t, 1, memory.Snapshots)
+				assert.Greater(t, memory.SnapshotBytes, int64(0))
+				assert.Equal(t, memory.LockBytes+memory.SnapshotBytes, memory.Bytes())
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("update log levels test", func(t *testing.T) {
+		ctx := context.Background()
+
+		_, err := adminCli.UpdateLogLevels(ctx, map[string]string{"packs": "verbose"})
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		levels, err := adminCli.UpdateLogLevels(ctx, map[string]string{"packs": "debug"})
+		assert.NoError(t, err)
+		assert.Equal(t, "debug", levels["packs"])
+
+		levels, err = adminCli.UpdateLogLevels(ctx, map[string]string{"packs": ""})
+		assert.NoError(t, err)
+		assert.NotContains(t, levels, "packs")
+	})
 }
@@ -19,8 +19,12 @@
 package integration
 
 import (
+	"bytes"
 	"context"
 	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"testing"
 
@@ -28,8 +32,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yorkie-team/yorkie/admin"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -71,4 +79,201 @@ func TestServer(t *testing.T) {
 
 		wg.Wait()
 	})
+	t.Run("test server with options test", func(t *testing.T) {
+		t.Run("memory backend with TLS test", func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			svr := helper.TestServer(
+				helper.WithBackend(helper.BackendMemory),
+				helper.WithSnapshotThreshold(3, 1),
+				helper.WithTLS(),
+			)
+			assert.NoError(t, svr.Start())
+			defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+			cli, err := client.Dial(svr.RPCAddr(), client.WithCertFile(svr.CertFile()))
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, cli.Close()) }()
+			assert.NoError(t, cli.Activate(ctx))
+			defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+			doc := document.New(helper.TestDocKey(t))
+			assert.NoError(t, cli.Attach(ctx, doc))
+
+			insecureCli, err := client.Dial(svr.RPCAddr())
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, insecureCli.Close()) }()
+			assert.Error(t, insecureCli.Activate(ctx))
+		})
+
+		t.Run("memory backend with auth webhook test", func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			svr := helper.TestServer(
+				helper.WithBackend(helper.BackendMemory),
+				helper.WithAuthWebhook(helper.TokenAuthWebhook("token")),
+			)
+			assert.NoError(t, svr.Start())
+			defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+			assert.NotEmpty(t, svr.AuthWebhookURL())
+
+			cli, err := client.Dial(svr.RPCAddr(), client.WithToken("token"))
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, cli.Close()) }()
+			assert.NoError(t, cli.Activate(ctx))
+			defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+			unauthorizedCli, err := client.Dial(svr.RPCAddr(), client.WithToken("invalid"))
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, unauthorizedCli.Close()) }()
+			err = unauthorizedCli.Activate(ctx)
+			assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+		})
+
+		t.Run("memory backend with unix socket test", func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			path := filepath.Join(t.TempDir(), "yorkie.sock")
+			svr := helper.TestServer(
+				helper.WithBackend(helper.BackendMemory),
+				helper.WithUnixSocket(path),
+			)
+			assert.NoError(t, svr.Start())
+			defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+			for _, addr := range []string{"unix://" + path, svr.RPCAddr()} {
+				cli, err := client.Dial(addr)
+				assert.NoError(t, err)
+				assert.NoError(t, cli.Activate(ctx))
+				assert.NoError(t, cli.Deactivate(ctx))
+				assert.NoError(t, cli.Close())
+			}
+		})
+
+		t.Run("memory backend with separate admin server test", func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			svr := helper.TestServer(
+				helper.WithBackend(helper.BackendMemory),
+				helper.WithAdminServer(),
+			)
+			assert.NoError(t, svr.Start())
+			defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+			assert.NotEqual(t, svr.RPCAddr(), svr.AdminAddr())
+
+			adminCli, err := admin.Dial(svr.AdminAddr(), admin.WithInsecure(true))
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, adminCli.Close()) }()
+			_, err = adminCli.LogIn(ctx, helper.AdminUser, helper.AdminPassword)
+			assert.NoError(t, err)
+			_, err = adminCli.ListProjects(ctx)
+			assert.NoError(t, err)
+
+			// the admin service is not served along with RPC.
+			rpcAdminCli, err := admin.Dial(svr.RPCAddr(), admin.WithInsecure(true))
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, rpcAdminCli.Close()) }()
+			_, err = rpcAdminCli.LogIn(ctx, helper.AdminUser, helper.AdminPassword)
+			assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+			// the SDK-facing service is not served on the admin server.
+			adminAddrCli, err := client.Dial(svr.AdminAddr())
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, adminAddrCli.Close()) }()
+			assert.Equal(t, codes.Unimplemented, status.Code(adminAddrCli.Activate(ctx)))
+
+			cli, err := client.Dial(svr.RPCAddr())
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, cli.Close()) }()
+			assert.NoError(t, cli.Activate(ctx))
+			assert.NoError(t, cli.Deactivate(ctx))
+		})
+		t.Run("memory backend with web protocols test", func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			svr := helper.TestServer(
+				helper.WithBackend(helper.BackendMemory),
+				helper.WithWebProtocols(),
+			)
+			assert.NoError(t, svr.Start())
+			defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+			// gRPC clients are served on the same port over h2c.
+			cli, err := client.Dial(svr.RPCAddr())
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, cli.Close()) }()
+			assert.NoError(t, cli.Activate(ctx))
+			defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+			doc := document.New(helper.TestDocKey(t))
+			assert.NoError(t, cli.Attach(ctx, doc))
+			_, err = cli.Watch(ctx, doc)
+			assert.NoError(t, err)
+
+			// Connect clients are served without a proxy.
+			body, err := (&api.ActivateClientRequest{ClientKey: t.Name()}).Marshal()
+			assert.NoError(t, err)
+			resp, err := http.Post(
+				"http://"+svr.RPCAddr()+"/yorkie.v1.YorkieService/ActivateClient",
+				"application/proto",
+				bytes.NewReader(body),
+			)
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, resp.Body.Close()) }()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			data, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			activateResp := &api.ActivateClientResponse{}
+			assert.NoError(t, activateResp.Unmarshal(data))
+			assert.NotEmpty(t, activateResp.ClientId)
+
+			// documents are watched over WebSocket with the watch transport.
+			wsCli, err := client.Dial(svr.RPCAddr(), client.WithWatchTransport(client.WatchTransportWebSocket))
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, wsCli.Close()) }()
+			assert.NoError(t, wsCli.Activate(ctx))
+
+			wsDoc := document.New(helper.TestDocKey(t))
+			assert.NoError(t, wsCli.Attach(ctx, wsDoc))
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			rch, err := wsCli.Watch(watchCtx, wsDoc)
+			assert.NoError(t, err)
+
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString("k1", "v1")
+				return nil
+			}))
+			assert.NoError(t, cli.Sync(ctx))
+
+			for resp := range rch {
+				assert.NoError(t, resp.Err)
+				if resp.Type == client.DocumentChanged {
+					break
+				}
+			}
+			assert.NoError(t, wsCli.Sync(ctx))
+			assert.Equal(t, doc.Marshal(), wsDoc.Marshal())
+
+			// documents are read as JSON from the REST endpoint.
+			docResp, err := http.Get("http://" + svr.RPCAddr() + "/documents/" + doc.Key().String())
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, docResp.Body.Close()) }()
+			assert.Equal(t, http.StatusOK, docResp.StatusCode)
+			root, err := io.ReadAll(docResp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, doc.Marshal(), string(root))
+			assert.Equal(
+				t,
+				strconv.FormatInt(wsDoc.Checkpoint().ServerSeq, 10),
+				docResp.Header.Get("X-Yorkie-Server-Seq"),
+			)
+
+			missingResp, err := http.Get("http://" + svr.RPCAddr() + "/documents/missing")
+			assert.NoError(t, err)
+			assert.NoError(t, missingResp.Body.Close())
+			assert.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+		})
+	})
 }
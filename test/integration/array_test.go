@@ -232,6 +232,56 @@ func TestArray(t *testing.T) {
 			return nil
 		}))
 
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+	})
+	t.Run("concurrent array move after test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			arr := root.SetNewArray("k1")
+			arr.AddInteger(0, 1)
+			arr.AddNewObject().SetString("k", "v")
+			assert.Equal(t, `{"k1":[0,1,{"k":"v"}]}`, root.Marshal())
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			prev := root.GetArray("k1").Get(0)
+			elem := root.GetArray("k1").Get(2)
+			root.GetArray("k1").MoveAfter(prev.CreatedAt(), elem.CreatedAt())
+			assert.Equal(t, `{"k1":[0,{"k":"v"},1]}`, root.Marshal())
+			return nil
+		}))
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			elem := root.GetArray("k1").Get(2)
+			root.GetArray("k1").MoveFront(elem.CreatedAt())
+			assert.Equal(t, `{"k1":[{"k":"v"},0,1]}`, root.Marshal())
+			return nil
+		}))
+
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `{"k1":[{"k":"v"},0,1]}`, d1.Marshal())
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			elem := root.GetArray("k1").Get(0)
+			root.GetArray("k1").MoveLast(elem.CreatedAt())
+			assert.Equal(t, `{"k1":[0,1,{"k":"v"}]}`, root.Marshal())
+			return nil
+		}))
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			next := root.GetArray("k1").Get(2)
+			elem := root.GetArray("k1").Get(1)
+			root.GetArray("k1").MoveBefore(next.CreatedAt(), elem.CreatedAt())
+			assert.Equal(t, `{"k1":[{"k":"v"},0,1]}`, root.Marshal())
+			return nil
+		}))
+
 		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
 	})
 }
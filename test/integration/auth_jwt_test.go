@@ -0,0 +1,174 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/rpc/auth"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func newClientToken(t *testing.T, method jwt.SigningMethod, key interface{}, kid, subject, project string) string {
+	token := jwt.NewWithClaims(method, auth.ClientClaims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   subject,
+			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		},
+		Project: project,
+	})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return signed
+}
+
+func TestJWTAuth(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	docKey := helper.TestDocKey(t)
+	activate := func(ctx context.Context, project *types.Project, token string) error {
+		cli, err := client.Dial(
+			svr.RPCAddr(),
+			client.WithAPIKey(project.PublicKey),
+			client.WithToken(token),
+		)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		if err := cli.Activate(ctx); err != nil {
+			return err
+		}
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		return cli.Attach(ctx, document.New(docKey))
+	}
+
+	t.Run("static key test", func(t *testing.T) {
+		ctx := context.Background()
+		project, err := adminCli.CreateProject(ctx, "jwt-static-key-test")
+		assert.NoError(t, err)
+
+		secret := "jwt-secret"
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			AuthJWTKey: &secret,
+		})
+		assert.NoError(t, err)
+
+		token := newClientToken(t, jwt.SigningMethodHS256, []byte(secret), "", "alice", project.Name)
+		assert.NoError(t, activate(ctx, project, token))
+
+		// token signed with another key
+		token = newClientToken(t, jwt.SigningMethodHS256, []byte("another"), "", "alice", project.Name)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(activate(ctx, project, token)).Code())
+
+		// token issued for another project
+		token = newClientToken(t, jwt.SigningMethodHS256, []byte(secret), "", "alice", "another")
+		assert.Equal(t, codes.Unauthenticated, status.Convert(activate(ctx, project, token)).Code())
+
+		// no token
+		assert.Equal(t, codes.Unauthenticated, status.Convert(activate(ctx, project, "")).Code())
+	})
+
+	t.Run("json web key set test", func(t *testing.T) {
+		ctx := context.Background()
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.NoError(t, err)
+
+		jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := fmt.Fprintf(
+				w,
+				`{"keys": [{"kty": "RSA", "kid": "k1", "use": "sig", "n": "%s", "e": "%s"}]}`,
+				base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			)
+			assert.NoError(t, err)
+		}))
+		defer jwksServer.Close()
+
+		project, err := adminCli.CreateProject(ctx, "jwt-jwks-test")
+		assert.NoError(t, err)
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			AuthJWKSURL: &jwksServer.URL,
+		})
+		assert.NoError(t, err)
+
+		token := newClientToken(t, jwt.SigningMethodRS256, key, "k1", "alice", "")
+		assert.NoError(t, activate(ctx, project, token))
+
+		// token signed with an unknown key
+		token = newClientToken(t, jwt.SigningMethodRS256, key, "k2", "alice", "")
+		assert.Equal(t, codes.Unauthenticated, status.Convert(activate(ctx, project, token)).Code())
+
+		// token signed with HMAC
+		token = newClientToken(t, jwt.SigningMethodHS256, []byte("secret"), "k1", "alice", "")
+		assert.Equal(t, codes.Unauthenticated, status.Convert(activate(ctx, project, token)).Code())
+	})
+
+	t.Run("document acl with jwt subject test", func(t *testing.T) {
+		ctx := context.Background()
+		project, err := adminCli.CreateProject(ctx, "jwt-acl-test")
+		assert.NoError(t, err)
+
+		secret := "jwt-secret"
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			AuthJWTKey: &secret,
+		})
+		assert.NoError(t, err)
+
+		alice := newClientToken(t, jwt.SigningMethodHS256, []byte(secret), "", "alice", "")
+		assert.NoError(t, activate(ctx, project, alice))
+
+		_, err = adminCli.UpdateDocumentACL(ctx, project.Name, docKey, &types.DocumentACL{
+			Writers: []string{"alice"},
+		})
+		assert.NoError(t, err)
+
+		bob := newClientToken(t, jwt.SigningMethodHS256, []byte(secret), "", "bob", "")
+		assert.Equal(t, codes.PermissionDenied, status.Convert(activate(ctx, project, bob)).Code())
+		assert.NoError(t, activate(ctx, project, alice))
+	})
+}
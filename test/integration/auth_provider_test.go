@@ -0,0 +1,92 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	gosync "sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/rpc/auth"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+// staticTokenProvider is an auth.Provider that accepts only the given token
+// and records the document keys that it authenticated.
+type staticTokenProvider struct {
+	token string
+
+	mu      gosync.Mutex
+	docKeys []string
+}
+
+func (p *staticTokenProvider) Authenticate(
+	_ context.Context,
+	token string,
+	accessInfo *types.AccessInfo,
+) (*auth.Identity, error) {
+	if token != p.token {
+		return nil, fmt.Errorf("%s: %w", accessInfo.Method, auth.ErrNotAllowed)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, attr := range accessInfo.Attributes {
+		p.docKeys = append(p.docKeys, attr.Key)
+	}
+
+	return &auth.Identity{Subject: "gateway-user"}, nil
+}
+
+func TestAuthProvider(t *testing.T) {
+	provider := &staticTokenProvider{token: "gateway-token"}
+	svr, err := server.New(helper.TestConfig(), server.WithAuthProvider(provider))
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	t.Run("custom auth provider test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := helper.TestDocKey(t)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithToken("gateway-token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		assert.NoError(t, cli.Activate(ctx))
+		assert.NoError(t, cli.Attach(ctx, document.New(docKey)))
+		assert.Contains(t, provider.docKeys, docKey.String())
+
+		invalidCli, err := client.Dial(svr.RPCAddr(), client.WithToken("invalid"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, invalidCli.Close()) }()
+
+		err = invalidCli.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+}
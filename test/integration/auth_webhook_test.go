@@ -22,6 +22,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	gosync "sync"
 	"testing"
 	"time"
 
@@ -267,6 +268,52 @@ func TestAuthWebhook(t *testing.T) {
 		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
 	})
 
+	t.Run("authorization webhook that throttles requests test", func(t *testing.T) {
+		ctx := context.Background()
+		authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "3")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+
+		conf := helper.TestConfig()
+		conf.Backend.AuthWebhookMaxRetries = 1
+		conf.Backend.AuthWebhookMaxWaitInterval = "10ms"
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+		defer func() { assert.NoError(t, adminCli.Close()) }()
+		project, err := adminCli.CreateProject(context.Background(), "throttle-webhook")
+		assert.NoError(t, err)
+		project.AuthWebhookURL = authServer.URL
+		_, err = adminCli.UpdateProject(
+			ctx,
+			project.ID.String(),
+			&types.UpdatableProjectFields{
+				AuthWebhookURL: &project.AuthWebhookURL,
+			},
+		)
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(
+			svr.RPCAddr(),
+			client.WithToken("token"),
+			client.WithAPIKey(project.PublicKey),
+		)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		err = cli.Activate(ctx)
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+		var throttledErr *client.ThrottledError
+		assert.ErrorAs(t, err, &throttledErr)
+		assert.Equal(t, 3*time.Second, throttledErr.RetryAfter())
+		assert.Equal(t, "webhook:auth", throttledErr.Violations()[0].Subject)
+	})
+
 	t.Run("authorized request cache test", func(t *testing.T) {
 		ctx := context.Background()
 		reqCnt := 0
@@ -407,3 +454,316 @@ func TestAuthWebhook(t *testing.T) {
 		assert.Equal(t, 2, reqCnt)
 	})
 }
+
+func TestDocumentACL(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	ctx := context.Background()
+	project, err := adminCli.CreateProject(ctx, "document-acl-test")
+	assert.NoError(t, err)
+
+	// NOTE: The webhook uses the token as the subject of the user.
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewAuthWebhookRequest(r.Body)
+		assert.NoError(t, err)
+
+		res := types.AuthWebhookResponse{Allowed: true, Subject: req.Token}
+		_, err = res.Write(w)
+		assert.NoError(t, err)
+	}))
+	defer authServer.Close()
+
+	project.AuthWebhookURL = authServer.URL
+	_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+		AuthWebhookURL: &project.AuthWebhookURL,
+	})
+	assert.NoError(t, err)
+
+	newClient := func(subject string) *client.Client {
+		cli, err := client.Dial(
+			svr.RPCAddr(),
+			client.WithAPIKey(project.PublicKey),
+			client.WithToken(subject),
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+		return cli
+	}
+
+	writer := newClient("writer")
+	defer func() { assert.NoError(t, writer.Close()) }()
+	reader := newClient("reader")
+	defer func() { assert.NoError(t, reader.Close()) }()
+	stranger := newClient("stranger")
+	defer func() { assert.NoError(t, stranger.Close()) }()
+
+	docKey := helper.TestDocKey(t)
+	d1 := document.New(docKey)
+	assert.NoError(t, writer.Attach(ctx, d1))
+
+	acl, err := adminCli.UpdateDocumentACL(ctx, project.Name, docKey, &types.DocumentACL{
+		Readers: []string{"reader"},
+		Writers: []string{"writer"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"reader"}, acl.Readers)
+
+	t.Run("writer can push changes test", func(t *testing.T) {
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, writer.Sync(ctx))
+	})
+
+	t.Run("reader cannot push changes test", func(t *testing.T) {
+		d2 := document.New(docKey)
+		assert.NoError(t, reader.Attach(ctx, d2))
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		err := reader.Sync(ctx)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("stranger cannot attach test", func(t *testing.T) {
+		d3 := document.New(docKey)
+		err := stranger.Attach(ctx, d3)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("writer cannot remove test", func(t *testing.T) {
+		err := writer.Remove(ctx, d1)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("empty acl removes the restriction test", func(t *testing.T) {
+		acl, err := adminCli.UpdateDocumentACL(ctx, project.Name, docKey, &types.DocumentACL{})
+		assert.NoError(t, err)
+		assert.True(t, acl.IsEmpty())
+
+		d3 := document.New(docKey)
+		assert.NoError(t, stranger.Attach(ctx, d3))
+	})
+}
+
+func TestDocumentAuthWebhook(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	ctx := context.Background()
+	project, err := adminCli.CreateProject(ctx, "document-auth-webhook-test")
+	assert.NoError(t, err)
+
+	// NOTE: The webhook denies the actions in the denied set and records the
+	// requests so that the payloads can be checked.
+	var mu gosync.Mutex
+	denied := make(map[string]bool)
+	var requests []*types.AuthWebhookRequest
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewAuthWebhookRequest(r.Body)
+		assert.NoError(t, err)
+
+		mu.Lock()
+		requests = append(requests, req)
+		res := types.AuthWebhookResponse{Allowed: true}
+		for _, attr := range req.Attributes {
+			if denied[attr.Key+"/"+string(attr.Action)] {
+				res = types.AuthWebhookResponse{Reason: "denied by document"}
+			}
+		}
+		mu.Unlock()
+
+		_, err = res.Write(w)
+		assert.NoError(t, err)
+	}))
+	defer authServer.Close()
+
+	project.AuthWebhookURL = authServer.URL
+	_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+		AuthWebhookURL: &project.AuthWebhookURL,
+	})
+	assert.NoError(t, err)
+
+	cli, err := client.Dial(
+		svr.RPCAddr(),
+		client.WithAPIKey(project.PublicKey),
+		client.WithToken("token"),
+	)
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, cli.Close()) }()
+	assert.NoError(t, cli.Activate(ctx))
+
+	t.Run("payload with action and client test", func(t *testing.T) {
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, cli.Detach(ctx, d1))
+
+		mu.Lock()
+		defer mu.Unlock()
+		var actions []types.AccessAction
+		for _, req := range requests {
+			if len(req.Attributes) == 0 || req.Attributes[0].Key != d1.Key().String() {
+				continue
+			}
+			actions = append(actions, req.Attributes[0].Action)
+			assert.Equal(t, cli.ID().String(), req.Client.ID)
+			assert.NotEmpty(t, req.Client.Connection.IP)
+		}
+		assert.Equal(t, []types.AccessAction{
+			types.AttachAction, types.WriteAction, types.DetachAction,
+		}, actions)
+	})
+
+	t.Run("deny write on document test", func(t *testing.T) {
+		d1 := document.New(helper.TestDocKey(t))
+		mu.Lock()
+		denied[d1.Key().String()+"/"+string(types.WriteAction)] = true
+		mu.Unlock()
+
+		assert.NoError(t, cli.Attach(ctx, d1))
+		assert.NoError(t, cli.Sync(ctx))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		err := cli.Sync(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+
+	t.Run("deny read on document test", func(t *testing.T) {
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, d1))
+
+		mu.Lock()
+		denied[d1.Key().String()+"/"+string(types.ReadAction)] = true
+		mu.Unlock()
+
+		_, err := cli.Watch(ctx, d1)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+}
+
+func TestPublicReadAccess(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	ctx := context.Background()
+	project, err := adminCli.CreateProject(ctx, "public-read-access-test")
+	assert.NoError(t, err)
+
+	// NOTE: The webhook allows only the requests with a token, and the clients
+	// are activated without the authorization so that anonymous readers can
+	// be activated.
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewAuthWebhookRequest(r.Body)
+		assert.NoError(t, err)
+
+		res := types.AuthWebhookResponse{Allowed: req.Token != "", Subject: req.Token}
+		if !res.Allowed {
+			res.Reason = "token is required"
+		}
+		_, err = res.Write(w)
+		assert.NoError(t, err)
+	}))
+	defer authServer.Close()
+
+	project.AuthWebhookURL = authServer.URL
+	project.AuthWebhookMethods = []string{
+		string(types.AttachDocument),
+		string(types.DetachDocument),
+		string(types.PushPull),
+		string(types.WatchDocuments),
+	}
+	_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+		AuthWebhookURL:     &project.AuthWebhookURL,
+		AuthWebhookMethods: &project.AuthWebhookMethods,
+	})
+	assert.NoError(t, err)
+
+	writer, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey), client.WithToken("writer"))
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, writer.Close()) }()
+	assert.NoError(t, writer.Activate(ctx))
+
+	anonymous, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, anonymous.Close()) }()
+	assert.NoError(t, anonymous.Activate(ctx))
+
+	docKey := helper.TestDocKey(t)
+	d1 := document.New(docKey)
+	assert.NoError(t, writer.Attach(ctx, d1))
+	assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+		root.SetString("k1", "v1")
+		return nil
+	}))
+	assert.NoError(t, writer.Sync(ctx))
+
+	t.Run("anonymous cannot attach private document test", func(t *testing.T) {
+		err := anonymous.Attach(ctx, document.New(docKey))
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+
+	acl, err := adminCli.UpdateDocumentACL(ctx, project.Name, docKey, &types.DocumentACL{PublicRead: true})
+	assert.NoError(t, err)
+	assert.True(t, acl.AllowsPublicRead())
+
+	t.Run("anonymous can attach and watch public document test", func(t *testing.T) {
+		d2 := document.New(docKey)
+		assert.NoError(t, anonymous.Attach(ctx, d2))
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := anonymous.Watch(watchCtx, d2)
+		assert.NoError(t, err)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, writer.Sync(ctx))
+		waitWatchResponse(t, rch, client.DocumentChanged)
+
+		assert.NoError(t, anonymous.Sync(ctx, client.WithDocKey(docKey)))
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, d2.Marshal())
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k3", "v3")
+			return nil
+		}))
+		err = anonymous.Sync(ctx, client.WithDocKey(docKey))
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+
+	t.Run("anonymous cannot create document test", func(t *testing.T) {
+		err := anonymous.Attach(ctx, document.New(helper.TestDocKey(t)))
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+}
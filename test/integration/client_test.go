@@ -20,16 +20,26 @@ package integration
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/internal/compression"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -172,4 +182,550 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, doc.Checkpoint(), change.Checkpoint{ClientSeq: 4, ServerSeq: 4})
 		assert.Equal(t, "2", doc.Root().GetCounter("counter").Marshal())
 	})
+
+	t.Run("sdk version negotiation test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// NOTE: SDKs built without ldflags have version "0.0.0".
+		conf := helper.TestConfig()
+		conf.Backend.MinClientVersion = "0.0.1"
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		cli, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		err = cli.Activate(ctx)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		conf = helper.TestConfig()
+		conf.Backend.RecommendedClientVersion = "0.0.1"
+		svr2, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr2.Start())
+		defer func() { assert.NoError(t, svr2.Shutdown(true)) }()
+
+		cli2, err := client.Dial(svr2.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli2.Close()) }()
+		assert.NoError(t, cli2.Activate(ctx))
+	})
+
+	t.Run("compact encoding test", func(t *testing.T) {
+		ctx := context.Background()
+
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithCompactEncoding())
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c1, c2})
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. changes pushed by a compact client are pulled by a plain client.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// 02. changes pushed by a plain client are pulled by a compact client.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Edit(5, 5, " world")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("pack compression test", func(t *testing.T) {
+		ctx := context.Background()
+
+		c1, err := client.Dial(
+			defaultServer.RPCAddr(),
+			client.WithPackCompression(compression.Zstd),
+			client.WithCompactEncoding(),
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c1, c2})
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. changes pushed by a compressing client are pulled by a plain client.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("Hello ", 500))
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// 02. changes pushed by a plain client are pulled by a compressing client.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Edit(0, 0, strings.Repeat("World ", 500))
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		_, err = client.New(client.WithPackCompression("br"))
+		assert.ErrorIs(t, err, compression.ErrUnsupportedCompressor)
+	})
+
+	t.Run("detach documents on deactivation test", func(t *testing.T) {
+		clients := activeClients(t, 2)
+		defer deactivateAndCloseClients(t, clients)
+		c1, c2 := clients[0], clients[1]
+
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. c1 edits the document without syncing, then is deactivated.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Deactivate(ctx))
+		assert.Equal(t, document.StatusDetached, d1.Status())
+
+		// 02. the document is not attached again by the re-activation.
+		assert.NoError(t, c1.Activate(ctx))
+		assert.ErrorIs(t, c1.Sync(ctx, client.WithDocKey(d1.Key())), client.ErrDocumentNotAttached)
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{}`, d2.Marshal())
+	})
+
+	t.Run("replay local changes after server-side deactivation test", func(t *testing.T) {
+		clients := activeClients(t, 2)
+		defer deactivateAndCloseClients(t, clients)
+		c1, c2 := clients[0], clients[1]
+
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+
+		// 01. the activation of c1 expires on the server.
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		_, err = api.NewYorkieServiceClient(conn).DeactivateClient(ctx, &api.DeactivateClientRequest{
+			ClientId: c1.ID().String(),
+		})
+		assert.NoError(t, err)
+
+		// 02. c1 finds that it is deactivated when syncing.
+		err = c1.Sync(ctx)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+		assert.False(t, c1.IsActive())
+
+		// 03. the local changes are replayed after re-activation.
+		assert.NoError(t, c1.Activate(ctx))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+	})
+
+	t.Run("persist local changes across restarts test", func(t *testing.T) {
+		ctx := context.Background()
+		store, err := client.NewFileStore(t.TempDir())
+		assert.NoError(t, err)
+
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithStore(store))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c2})
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. c1 fails to push the local changes since its activation expires,
+		// then the process of c1 stops.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("obj").SetInteger("k1", 1)
+			return nil
+		}))
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		_, err = api.NewYorkieServiceClient(conn).DeactivateClient(ctx, &api.DeactivateClientRequest{
+			ClientId: c1.ID().String(),
+		})
+		assert.NoError(t, err)
+		assert.Error(t, c1.Sync(ctx))
+		assert.NoError(t, c1.Close())
+
+		keys, err := store.Keys()
+		assert.NoError(t, err)
+		assert.Equal(t, []key.Key{d1.Key()}, keys)
+
+		// 02. a new client with the same store loads the local changes.
+		c3, err := client.Dial(defaultServer.RPCAddr(), client.WithStore(store))
+		assert.NoError(t, err)
+		assert.NoError(t, c3.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c3})
+
+		d3 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c3.Attach(ctx, d3))
+		assert.Equal(t, `{"obj":{"k1":1}}`, d3.Marshal())
+		assert.True(t, d3.HasLocalChanges())
+
+		// 03. the local changes are replayed on the next sync, and the
+		// elements created by them can be edited.
+		assert.NoError(t, c3.Sync(ctx))
+		assert.NoError(t, d3.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("obj").SetInteger("k2", 2)
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c3, d3}, {c2, d2}})
+		assert.Equal(t, `{"obj":{"k1":1,"k2":2}}`, d2.Marshal())
+
+		keys, err = store.Keys()
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("sync mode and interval test", func(t *testing.T) {
+		clients := activeClients(t, 2)
+		defer deactivateAndCloseClients(t, clients)
+		c1, c2 := clients[0], clients[1]
+
+		ctx := context.Background()
+		manualKey := key.Key(helper.TestDocKey(t) + "-manual")
+		pullKey := key.Key(helper.TestDocKey(t) + "-pull")
+		intervalKey := key.Key(helper.TestDocKey(t) + "-interval")
+
+		d1 := document.New(manualKey)
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithSyncMode(client.SyncModeManual)))
+		d2 := document.New(pullKey)
+		assert.NoError(t, c1.Attach(ctx, d2, client.WithSyncMode(client.SyncModePullOnly)))
+		d3 := document.New(intervalKey)
+		assert.NoError(t, c1.Attach(ctx, d3, client.WithSyncInterval(time.Hour)))
+
+		var docs []*document.Document
+		for _, k := range []key.Key{manualKey, pullKey, intervalKey} {
+			doc := document.New(k)
+			assert.NoError(t, c2.Attach(ctx, doc))
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString("k1", "v1")
+				return nil
+			}))
+			docs = append(docs, doc)
+		}
+		assert.NoError(t, c2.Sync(ctx))
+
+		// 01. the document in the manual mode is not synced by Sync without
+		// document keys.
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, `{}`, d1.Marshal())
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+		assert.Equal(t, `{"k1":"v1"}`, d3.Marshal())
+		assert.NoError(t, c1.Sync(ctx, client.WithDocKey(manualKey)))
+		assert.Equal(t, `{"k1":"v1"}`, d1.Marshal())
+
+		// 02. the local changes of the document in the pull-only mode are kept
+		// until they are pushed explicitly.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1"}`, docs[1].Marshal())
+		assert.True(t, d2.HasLocalChanges())
+
+		assert.NoError(t, c1.Sync(ctx, client.WithDocKey(pullKey).WithPushOnly()))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, docs[1].Marshal())
+
+		// 03. the document is not synced again within its sync interval.
+		assert.NoError(t, docs[2].Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1"}`, d3.Marshal())
+		assert.NoError(t, c1.Sync(ctx, client.WithDocKey(intervalKey)))
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, d3.Marshal())
+	})
+
+	t.Run("sync status test", func(t *testing.T) {
+		ctx := context.Background()
+
+		var events []client.SyncStatusEvent
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithSyncStatusHandler(func(event client.SyncStatusEvent) {
+			events = append(events, event)
+		}))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c1.Close()) }()
+		assert.NoError(t, c1.Activate(ctx))
+
+		d1 := document.New(helper.TestDocKey(t))
+		_, err = c1.SyncStatus(d1)
+		assert.ErrorIs(t, err, client.ErrDocumentNotAttached)
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		// 01. local changes are pending until they are pushed.
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k", i)
+				return nil
+			}))
+		}
+		st, err := c1.SyncStatus(d1)
+		assert.NoError(t, err)
+		assert.Equal(t, client.Synced, st.State)
+		assert.Equal(t, 2, st.PendingChanges)
+		attachedAt := st.LastSyncedAt
+
+		assert.NoError(t, c1.Sync(ctx))
+		st, err = c1.SyncStatus(d1)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, st.PendingChanges)
+		assert.False(t, st.LastSyncedAt.Before(attachedAt))
+		assert.False(t, st.SnapshotPulling)
+		assert.Empty(t, events)
+
+		// 02. the document gets out of sync if the push-pull fails.
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		_, err = api.NewYorkieServiceClient(conn).DeactivateClient(ctx, &api.DeactivateClientRequest{
+			ClientId: c1.ID().String(),
+		})
+		assert.NoError(t, err)
+		assert.Error(t, c1.Sync(ctx))
+
+		st, err = c1.SyncStatus(d1)
+		assert.NoError(t, err)
+		assert.Equal(t, client.OutOfSync, st.State)
+		assert.Error(t, st.LastError)
+
+		// 03. the document gets back in sync after re-activation.
+		assert.NoError(t, c1.Activate(ctx))
+		st, err = c1.SyncStatus(d1)
+		assert.NoError(t, err)
+		assert.Equal(t, client.Synced, st.State)
+		assert.NoError(t, st.LastError)
+
+		assert.Len(t, events, 2)
+		assert.Equal(t, client.OutOfSync, events[0].State)
+		assert.Equal(t, d1.Key(), events[0].DocumentKey)
+		assert.Error(t, events[0].Err)
+		assert.Equal(t, client.Synced, events[1].State)
+	})
+
+	t.Run("stats handler test", func(t *testing.T) {
+		ctx := context.Background()
+
+		var events []client.StatsEvent
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithStatsHandler(func(event client.StatsEvent) {
+			events = append(events, event)
+		}))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c1.Close()) }()
+		assert.NoError(t, c1.Activate(ctx))
+		c2 := activeClients(t, 1)[0]
+		defer deactivateAndCloseClients(t, []*client.Client{c2})
+
+		d1 := document.New(helper.TestDocKey(t))
+		_, err = c1.Stats(d1)
+		assert.ErrorIs(t, err, client.ErrDocumentNotAttached)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. the stats of local updates are recorded since the document is attached.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("k", 1)
+			return nil
+		}))
+		stats, err := c1.Stats(d1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), stats.Updates)
+		assert.Equal(t, int64(0), stats.RemoteChanges)
+
+		// 02. the handler is called with the stats after each sync. The
+		// changes of c2 are the initialization of its presence and the update.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("k2", 2)
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NotEmpty(t, events)
+		last := events[len(events)-1]
+		assert.Equal(t, d1.Key(), last.DocumentKey)
+		assert.Equal(t, int64(2), last.Stats.Updates)
+		assert.Equal(t, int64(2), last.Stats.RemoteChanges)
+	})
+
+	t.Run("schema validator test", func(t *testing.T) {
+		ctx := context.Background()
+		errNoTitle := errors.New("no title")
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithSchemaValidator(func(root *crdt.Object) error {
+			if root.Has("body") && !root.Has("title") {
+				return errNoTitle
+			}
+			return nil
+		}))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c1, c2})
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. the local update that violates the schema is rejected.
+		err = d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("body", "b")
+			return nil
+		})
+		assert.ErrorIs(t, err, errNoTitle)
+		assert.False(t, d1.HasLocalChanges())
+
+		// 02. the remote changes that violate the schema are flagged.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("body", "b")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, `{"body":"b"}`, d1.Marshal())
+		st, err := c1.SyncStatus(d1)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, st.ValidationError, errNoTitle)
+
+		// 03. the flag is cleared once the document becomes valid again.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "t")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		st, err = c1.SyncStatus(d1)
+		assert.NoError(t, err)
+		assert.NoError(t, st.ValidationError)
+	})
+}
+
+func TestPushPullChunks(t *testing.T) {
+	conf := helper.TestConfig()
+	conf.RPC.MaxRequestBytes = 64 * 1024
+	conf.RPC.MaxStreamedPackBytes = 256 * 1024
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	// updateLargeValues updates the given document with values of the given
+	// total size in KiB.
+	updateLargeValues := func(doc *document.Document, kib int) error {
+		value := strings.Repeat("a", 1024)
+		for i := 0; i < kib; i++ {
+			if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString(fmt.Sprintf("k%d", i), value)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t.Run("push changes in chunks test", func(t *testing.T) {
+		ctx := context.Background()
+
+		c1, err := client.Dial(
+			svr.RPCAddr(),
+			client.WithPushChunkSize(16*1024),
+			client.WithCompactEncoding(),
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c1, c2})
+
+		// 01. changes over the max request size are pushed in chunks.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, updateLargeValues(d1, 100))
+		assert.NoError(t, c1.Sync(ctx))
+
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// 02. small change packs are pushed in one message.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k0", "b")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("push changes over the max size test", func(t *testing.T) {
+		ctx := context.Background()
+
+		c1, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(svr.RPCAddr(), client.WithPushChunkSize(16*1024))
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c1, c2})
+
+		// 01. changes over the max request size are rejected without chunks.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, updateLargeValues(d1, 100))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(c1.Sync(ctx)))
+
+		// 02. chunks over the max streamed pack size are rejected.
+		d2 := document.New(helper.TestDocKey(t) + "-2")
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.NoError(t, updateLargeValues(d2, 300))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(c2.Sync(ctx)))
+	})
 }
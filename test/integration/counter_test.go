@@ -107,4 +107,35 @@ func TestCounter(t *testing.T) {
 
 		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
 	})
+
+	t.Run("concurrent counter decrease test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewCounter("int", crdt.IntegerCnt, math.MinInt32)
+			root.SetNewCounter("long", crdt.LongCnt, 10)
+			root.SetNewCounter("double", crdt.DoubleCnt, 1.5)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("int").Decrease(1)
+			root.GetCounter("long").Decrease(3)
+			root.GetCounter("double").Decrease(0.25)
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("long").Increase(5).Decrease(2)
+			root.GetCounter("double").Increase(1)
+			return nil
+		}))
+
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `{"double":2.250000,"int":2147483647,"long":10}`, d1.Marshal())
+	})
 }
@@ -0,0 +1,359 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestDocumentLimits(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	update := func(doc *document.Document, i int) error {
+		return doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("k", i)
+			return nil
+		})
+	}
+
+	t.Run("change log limits test", func(t *testing.T) {
+		ctx := context.Background()
+		eventServer, events := newEventServer(t, 0)
+		defer eventServer.Close()
+
+		project, err := adminCli.CreateProject(ctx, "change-log-limits")
+		assert.NoError(t, err)
+		softLimit, hardLimit := int64(3), int64(5)
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			EventWebhookURL:    &eventServer.URL,
+			EventWebhookEvents: &[]string{string(types.DocumentLimitWarningEvent)},
+			ChangeLogSoftLimit: &softLimit,
+			ChangeLogHardLimit: &hardLimit,
+		})
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		// 01. the initial presence of the attachment is the first change.
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 02. reaching the soft limit sends a warning.
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, update(doc, i))
+			assert.NoError(t, cli.Sync(ctx))
+		}
+		received := receiveEvents(t, events, 1)
+		warning := received[types.DocumentLimitWarningEvent]
+		assert.Equal(t, doc.Key().String(), warning.DocumentKey)
+		assert.Equal(t, &types.DocumentLimitUsage{
+			Limit:     types.ChangeLogLimit,
+			Usage:     3,
+			Threshold: softLimit,
+		}, warning.LimitUsage)
+
+		// 03. pushes over the hard limit are rejected with a quota error.
+		assert.NoError(t, update(doc, 2))
+		assert.NoError(t, update(doc, 3))
+		assert.NoError(t, cli.Sync(ctx))
+
+		assert.NoError(t, update(doc, 4))
+		err = cli.Sync(ctx)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		var throttled *client.ThrottledError
+		assert.ErrorAs(t, err, &throttled)
+		assert.Equal(t, "document:"+doc.Key().String(), throttled.Violations()[0].Subject)
+	})
+
+	t.Run("document size limits test", func(t *testing.T) {
+		ctx := context.Background()
+
+		project, err := adminCli.CreateProject(ctx, "document-size-limits")
+		assert.NoError(t, err)
+		hardLimit := int64(1024)
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			DocumentSizeHardLimit: &hardLimit,
+		})
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 01. pushes are accepted while the document is under the limit.
+		assert.NoError(t, update(doc, 0))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 02. a push that would make the document exceed the limit is rejected
+		// before it is stored.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("large", strings.Repeat("a", int(hardLimit)))
+			return nil
+		}))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(cli.Sync(ctx)))
+
+		stored, _, err := adminCli.RebuildDocument(ctx, "document-size-limits", doc.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k":0}`, stored.Marshal())
+	})
+}
+
+func TestReadOnlyDegradation(t *testing.T) {
+	conf := helper.TestConfig()
+	conf.Backend.ProjectInfoCacheTTL = "1ms"
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	update := func(doc *document.Document, i int) error {
+		return doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("k", i)
+			return nil
+		})
+	}
+
+	waitFor := func(rch <-chan client.WatchResponse, t client.WatchResponseType) (client.WatchResponse, error) {
+		for {
+			select {
+			case resp := <-rch:
+				if resp.Err != nil {
+					return resp, resp.Err
+				}
+				if resp.Type == t {
+					return resp, nil
+				}
+			case <-time.After(5 * time.Second):
+				return client.WatchResponse{}, context.DeadlineExceeded
+			}
+		}
+	}
+
+	t.Run("read-only degradation and restoration test", func(t *testing.T) {
+		ctx := context.Background()
+
+		project, err := adminCli.CreateProject(ctx, "read-only-degradation")
+		assert.NoError(t, err)
+		hardLimit := int64(3)
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			ChangeLogHardLimit: &hardLimit,
+		})
+		assert.NoError(t, err)
+
+		c1, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c1.Close()) }()
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c2.Close()) }()
+		assert.NoError(t, c2.Activate(ctx))
+
+		// 01. the initial presences of the attachments are the first changes.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := c2.Watch(watchCtx, d2)
+		assert.NoError(t, err)
+
+		// 02. the push over the hard limit makes the document read-only and
+		// notifies the watching clients with the reason.
+		assert.NoError(t, update(d1, 0))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, update(d1, 1))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(c1.Sync(ctx)))
+
+		resp, err := waitFor(rch, client.DocumentReadOnly)
+		assert.NoError(t, err)
+		assert.Equal(t, "4 changes exceed the change log limit 3", resp.Reason)
+
+		// 03. the read-only document can still be pulled, but not be updated.
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"k":0}`, d2.Marshal())
+
+		assert.NoError(t, update(d2, 2))
+		err = c2.Sync(ctx)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		var throttled *client.ThrottledError
+		assert.ErrorAs(t, err, &throttled)
+		assert.Equal(t, resp.Reason, throttled.Violations()[0].Description)
+
+		// 04. the write access is restored once the usage drops below the limit.
+		hardLimit = 10
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			ChangeLogHardLimit: &hardLimit,
+		})
+		assert.NoError(t, err)
+		time.Sleep(10 * time.Millisecond)
+
+		assert.NoError(t, c2.Sync(ctx))
+		_, err = waitFor(rch, client.DocumentWritable)
+		assert.NoError(t, err)
+
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+}
+
+func TestServerLimits(t *testing.T) {
+	newServer := func(t *testing.T, setLimits func(conf *server.Config)) *server.Yorkie {
+		conf := helper.TestConfig()
+		setLimits(conf)
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		return svr
+	}
+
+	assertRejected := func(t *testing.T, err error, doc *document.Document) {
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		var throttled *client.ThrottledError
+		assert.ErrorAs(t, err, &throttled)
+		assert.Equal(t, "document:"+doc.Key().String(), throttled.Violations()[0].Subject)
+	}
+
+	t.Run("change pack size limit test", func(t *testing.T) {
+		ctx := context.Background()
+		svr := newServer(t, func(conf *server.Config) {
+			conf.Backend.MaxChangePackSize = 1024
+		})
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		cli, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 01. small packs are accepted.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k", "small")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 02. packs over the limit are rejected.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k", strings.Repeat("a", 2048))
+			return nil
+		}))
+		assertRejected(t, cli.Sync(ctx), doc)
+	})
+
+	t.Run("document size limit test", func(t *testing.T) {
+		ctx := context.Background()
+		svr := newServer(t, func(conf *server.Config) {
+			conf.Backend.MaxDocumentSize = 1024
+		})
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		cli, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 01. pushes that keep the snapshot under the limit are accepted.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", strings.Repeat("a", 512))
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 02. pushes that would make the snapshot exceed the limit are
+		// rejected, even though each of them is small.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", strings.Repeat("b", 512))
+			return nil
+		}))
+		assertRejected(t, cli.Sync(ctx), doc)
+	})
+
+	t.Run("document elements limit test", func(t *testing.T) {
+		ctx := context.Background()
+		svr := newServer(t, func(conf *server.Config) {
+			conf.Backend.MaxDocumentElements = 5
+		})
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		cli, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 01. the root and the array with its three elements are accepted.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("list").AddInteger(1, 2, 3)
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 02. pushes that would add more elements than the limit are rejected.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("list").AddInteger(4, 5)
+			return nil
+		}))
+		assertRejected(t, cli.Sync(ctx), doc)
+	})
+}
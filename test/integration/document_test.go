@@ -20,12 +20,15 @@ package integration
 
 import (
 	"context"
+	"fmt"
+	"errors"
 	"io"
 	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
@@ -418,6 +421,106 @@ func TestDocument(t *testing.T) {
 		_, err = c1.Watch(watchCtx, d1)
 		assert.ErrorIs(t, err, client.ErrDocumentNotAttached)
 	})
+
+	t.Run("partial sync with path filter test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. c1 creates d1 with rows and a title.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("rows").SetNewObject("r1").SetNewArray("cells").AddString("a")
+			root.SetString("title", "t1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 02. c2 subscribes to the cells of the rows only.
+		d2 := document.New(helper.TestDocKey(t))
+		assert.Error(t, c2.Attach(ctx, d2, client.WithPathFilter("rows")))
+		assert.NoError(t, c2.Attach(ctx, d2, client.WithPathFilter("$.rows[*].cells")))
+		assert.Equal(t, `{"rows":{"r1":{"cells":["a"]}},"title":"t1"}`, d2.Marshal())
+
+		// 03. c2 receives the changes of the cells but not the ones of the title.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "t2")
+			return nil
+		}))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("rows").GetObject("r1").GetArray("cells").AddString("b")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"rows":{"r1":{"cells":["a","b"]}},"title":"t1"}`, d2.Marshal())
+
+		// 04. the changes of c2 within the subtree are delivered to c1.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("rows").GetObject("r1").GetArray("cells").AddString("c")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, `{"rows":{"r1":{"cells":["a","b","c"]}},"title":"t2"}`, d1.Marshal())
+
+		// 05. the snapshot pulled by c2 carries only the subtree of the filter.
+		for i := 0; i < int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString("title", fmt.Sprintf("t%d", i+3))
+				return nil
+			}))
+		}
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"rows":{"r1":{"cells":["a","b","c"]}}}`, d2.Marshal())
+	})
+
+	t.Run("update multiple documents test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. c1 attaches two accounts and c2 attaches the same ones.
+		from1 := document.New(helper.TestDocKey(t) + "-from")
+		to1 := document.New(helper.TestDocKey(t) + "-to")
+		assert.NoError(t, c1.Attach(ctx, from1))
+		assert.NoError(t, c1.Attach(ctx, to1))
+		assert.NoError(t, from1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("balance", 10)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		from2 := document.New(helper.TestDocKey(t) + "-from")
+		to2 := document.New(helper.TestDocKey(t) + "-to")
+		assert.NoError(t, c2.Attach(ctx, from2))
+		assert.NoError(t, c2.Attach(ctx, to2))
+
+		// 02. c1 transfers the balance between the two documents at once.
+		transfer := func(roots []*json.Object) error {
+			roots[0].SetInteger("balance", 0)
+			roots[1].SetInteger("balance", 10)
+			return nil
+		}
+		assert.NoError(t, c1.UpdateMulti(ctx, []*document.Document{from1, to1}, transfer))
+		assert.False(t, from1.HasLocalChanges())
+		assert.False(t, to1.HasLocalChanges())
+
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"balance":0}`, from2.Marshal())
+		assert.Equal(t, `{"balance":10}`, to2.Marshal())
+
+		// 03. none of the documents is changed if the updater fails.
+		errFailed := errors.New("failed")
+		assert.ErrorIs(t, c1.UpdateMulti(ctx, []*document.Document{from1, to1}, func(roots []*json.Object) error {
+			roots[0].SetInteger("balance", 5)
+			return errFailed
+		}), errFailed)
+		assert.False(t, from1.HasLocalChanges())
+		assert.Equal(t, `{"balance":0}`, from1.Marshal())
+
+		// 04. the documents should be attached.
+		other := document.New(helper.TestDocKey(t) + "-other")
+		assert.ErrorIs(t, c1.UpdateMulti(ctx, []*document.Document{from1, other}, transfer), client.ErrDocumentNotAttached)
+	})
 }
 
 func TestDocumentWithProjects(t *testing.T) {
@@ -557,11 +660,11 @@ func TestDocumentWithProjects(t *testing.T) {
 
 		assert.NoError(t, cli.Sync(ctx))
 
-		docs, err := adminCli.ListDocuments(ctx, "default", "000000000000000000000000", 0, true, false)
+		docs, err := adminCli.ListDocuments(ctx, "default", types.DocumentFilter{}, "000000000000000000000000", 0, true, false)
 		assert.NoError(t, err)
 		assert.Equal(t, "", docs[0].Snapshot)
 
-		docs, err = adminCli.ListDocuments(ctx, "default", "000000000000000000000000", 0, true, true)
+		docs, err = adminCli.ListDocuments(ctx, "default", types.DocumentFilter{}, "000000000000000000000000", 0, true, true)
 		assert.NoError(t, err)
 		assert.NotEqual(t, 0, len(docs[0].Snapshot))
 	})
@@ -0,0 +1,242 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package integration
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func newEventServer(t *testing.T, unavailableCnt int) (*httptest.Server, chan *types.EventWebhookRequest) {
+	events := make(chan *types.EventWebhookRequest, 10)
+	var failures int
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewEventWebhookRequest(r.Body)
+		assert.NoError(t, err)
+
+		if failures < unavailableCnt {
+			failures++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		events <- req
+		w.WriteHeader(http.StatusNoContent)
+	})), events
+}
+
+func receiveEvents(t *testing.T, events chan *types.EventWebhookRequest, n int) map[types.EventWebhookType]*types.EventWebhookRequest {
+	received := make(map[types.EventWebhookType]*types.EventWebhookRequest)
+	for i := 0; i < n; i++ {
+		select {
+		case event := <-events:
+			received[event.Type] = event
+		case <-time.After(5 * time.Second):
+			assert.FailNow(t, "timeout while waiting for events")
+		}
+	}
+	return received
+}
+
+func TestProjectEventWebhook(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	t.Run("lifecycle event webhook test", func(t *testing.T) {
+		ctx := context.Background()
+		eventServer, events := newEventServer(t, 1)
+		defer eventServer.Close()
+
+		project, err := adminCli.CreateProject(ctx, "event-webhook-test")
+		assert.NoError(t, err)
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			EventWebhookURL: &eventServer.URL,
+		})
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		assert.NoError(t, cli.Activate(ctx))
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc, client.WithLabels(map[string]string{"env": "prod"})))
+		assert.NoError(t, cli.Detach(ctx, doc))
+		assert.NoError(t, cli.Deactivate(ctx))
+
+		// NOTE: Events are sent in the background, so they may
+		// arrive in any order.
+		received := receiveEvents(t, events, 7)
+		for _, eventType := range []types.EventWebhookType{
+			types.ClientActivatedEvent,
+			types.ClientDeactivatedEvent,
+			types.DocumentCreatedEvent,
+			types.DocumentAttachedEvent,
+			types.DocumentFirstAttachedEvent,
+			types.DocumentDetachedEvent,
+			types.DocumentLastDetachedEvent,
+		} {
+			event, ok := received[eventType]
+			assert.True(t, ok)
+			assert.Equal(t, project.Name, event.ProjectName)
+			assert.Equal(t, cli.ID().String(), event.ClientID)
+			assert.Equal(t, cli.Key(), event.ClientKey)
+		}
+
+		attached := received[types.DocumentAttachedEvent]
+		assert.Equal(t, doc.Key().String(), attached.DocumentKey)
+		assert.Equal(t, map[string]string{"env": "prod"}, attached.DocumentLabels)
+		assert.Empty(t, received[types.ClientActivatedEvent].DocumentKey)
+	})
+
+	t.Run("selected event webhook test", func(t *testing.T) {
+		ctx := context.Background()
+		eventServer, events := newEventServer(t, 0)
+		defer eventServer.Close()
+
+		project, err := adminCli.CreateProject(ctx, "event-webhook-test2")
+		assert.NoError(t, err)
+		eventTypes := []string{string(types.DocumentAttachedEvent)}
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			EventWebhookURL:    &eventServer.URL,
+			EventWebhookEvents: &eventTypes,
+		})
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		assert.NoError(t, cli.Activate(ctx))
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, cli.Detach(ctx, doc))
+		assert.NoError(t, cli.Deactivate(ctx))
+
+		received := receiveEvents(t, events, 1)
+		assert.Contains(t, received, types.DocumentAttachedEvent)
+
+		select {
+		case event := <-events:
+			assert.Fail(t, "unexpected event", event.Type)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+
+	t.Run("document lifecycle event webhook test", func(t *testing.T) {
+		ctx := context.Background()
+		var secretKey string
+		events := make(chan *types.EventWebhookRequest, 10)
+		eventServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			signature := r.Header.Get(types.EventWebhookSignatureHeader)
+			if !types.VerifyEventWebhookSignature(secretKey, body, signature) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			req, err := types.NewEventWebhookRequest(bytes.NewReader(body))
+			assert.NoError(t, err)
+			events <- req
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer eventServer.Close()
+
+		project, err := adminCli.CreateProject(ctx, "event-webhook-test3")
+		assert.NoError(t, err)
+		secretKey = project.SecretKey
+		eventTypes := []string{
+			string(types.DocumentCreatedEvent),
+			string(types.DocumentFirstAttachedEvent),
+			string(types.DocumentLastDetachedEvent),
+			string(types.SnapshotStoredEvent),
+			string(types.DocumentRemovedEvent),
+		}
+		snapshotInterval := int64(3)
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			EventWebhookURL:    &eventServer.URL,
+			EventWebhookEvents: &eventTypes,
+			SnapshotInterval:   &snapshotInterval,
+		})
+		assert.NoError(t, err)
+
+		c1, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c1.Close()) }()
+		c2, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c2.Close()) }()
+		assert.NoError(t, c1.Activate(ctx))
+		assert.NoError(t, c2.Activate(ctx))
+
+		// 01. only the first attach and the last detach are sent. The snapshot
+		// is stored once after the two attaches and the update.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(d1.Key())
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Detach(ctx, d2))
+		assert.NoError(t, c1.Detach(ctx, d1))
+
+		// 02. the removal by the admin is sent without the client.
+		assert.NoError(t, adminCli.RemoveDocument(ctx, project.Name, d1.Key().String(), false))
+
+		received := receiveEvents(t, events, 5)
+		for _, eventType := range eventTypes {
+			event, ok := received[types.EventWebhookType(eventType)]
+			assert.True(t, ok, eventType)
+			assert.Equal(t, d1.Key().String(), event.DocumentKey)
+		}
+		assert.Equal(t, c1.ID().String(), received[types.DocumentFirstAttachedEvent].ClientID)
+		assert.Equal(t, c1.ID().String(), received[types.DocumentLastDetachedEvent].ClientID)
+		assert.Equal(t, snapshotInterval, received[types.SnapshotStoredEvent].ServerSeq)
+		assert.Empty(t, received[types.DocumentRemovedEvent].ClientID)
+
+		select {
+		case event := <-events:
+			assert.Fail(t, "unexpected event", event.Type)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+}
@@ -0,0 +1,114 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/backend/eventbus"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestEventBus(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	t.Run("lifecycle events test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := helper.TestDocKey(t)
+
+		var mu gosync.Mutex
+		var events []eventbus.Event
+		unsubscribe := svr.Subscribe(func(event eventbus.Event) {
+			if event.Type != eventbus.ClientActivated && event.DocumentKey != docKey {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		})
+		defer unsubscribe()
+
+		cli, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		for i := 0; i < int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k", i)
+				return nil
+			}))
+			assert.NoError(t, cli.Sync(ctx))
+		}
+		assert.NoError(t, cli.Remove(ctx, doc))
+
+		typesOf := func() []eventbus.Type {
+			mu.Lock()
+			defer mu.Unlock()
+			var types []eventbus.Type
+			for _, event := range events {
+				types = append(types, event.Type)
+			}
+			return types
+		}
+
+		// NOTE: Snapshots are created in the background, so we
+		// wait for the snapshot event.
+		assert.Eventually(t, func() bool {
+			for _, typ := range typesOf() {
+				if typ == eventbus.SnapshotCreated {
+					return true
+				}
+			}
+			return false
+		}, 5*time.Second, 10*time.Millisecond)
+
+		types := typesOf()
+		assert.Subset(t, types, []eventbus.Type{
+			eventbus.ClientActivated,
+			eventbus.DocumentCreated,
+			eventbus.DocumentAttached,
+			eventbus.DocumentRemoved,
+		})
+		assert.Equal(t, eventbus.ClientActivated, types[0])
+		assert.Equal(t, eventbus.DocumentCreated, types[1])
+		assert.Equal(t, eventbus.DocumentAttached, types[2])
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, event := range events {
+			assert.Equal(t, events[0].ProjectID, event.ProjectID)
+		}
+	})
+}
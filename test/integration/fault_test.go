@@ -0,0 +1,206 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server/backend/faults"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+var errInjected = errors.New("injected fault")
+
+// faultQueue is an injector that injects the queued faults of a method into
+// its next calls, one fault per call.
+type faultQueue struct {
+	mu     sync.Mutex
+	faults map[faults.Call][]*faults.Fault
+}
+
+func newFaultQueue() *faultQueue {
+	return &faultQueue{faults: make(map[faults.Call][]*faults.Fault)}
+}
+
+// push queues the given fault into the next call of the given method.
+func (q *faultQueue) push(target faults.Target, method string, fault *faults.Fault) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	call := faults.Call{Target: target, Method: method}
+	q.faults[call] = append(q.faults[call], fault)
+}
+
+// Inject returns the first queued fault of the given call.
+func (q *faultQueue) Inject(_ context.Context, call faults.Call) *faults.Fault {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	queued := q.faults[call]
+	if len(queued) == 0 {
+		return nil
+	}
+	q.faults[call] = queued[1:]
+	return queued[0]
+}
+
+func TestFaultInjection(t *testing.T) {
+	queue := newFaultQueue()
+	svr := helper.TestServer(
+		helper.WithBackend(helper.BackendMemory),
+		helper.WithFaultInjector(queue),
+	)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	ctx := context.Background()
+	attach := func(t *testing.T) []clientAndDocPair {
+		var pairs []clientAndDocPair
+		for i := 0; i < 2; i++ {
+			cli, err := client.Dial(svr.RPCAddr())
+			assert.NoError(t, err)
+			t.Cleanup(func() { assert.NoError(t, cli.Close()) })
+			assert.NoError(t, cli.Activate(ctx))
+
+			doc := document.New(helper.TestDocKey(t))
+			assert.NoError(t, cli.Attach(ctx, doc))
+			pairs = append(pairs, clientAndDocPair{cli: cli, doc: doc})
+		}
+		return pairs
+	}
+	update := func(t *testing.T, doc *document.Document, k, v string) {
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString(k, v)
+			return nil
+		}))
+	}
+
+	t.Run("recover from database errors test", func(t *testing.T) {
+		pairs := attach(t)
+		update(t, pairs[0].doc, "k1", "v1")
+
+		queue.push(faults.TargetDatabase, "CreateChangeInfos", &faults.Fault{Err: errInjected})
+		assert.Error(t, pairs[0].cli.Sync(ctx))
+		assert.NoError(t, pairs[0].cli.Sync(ctx))
+
+		queue.push(faults.TargetDatabase, "FindChangeInfosBetweenServerSeqs", &faults.Fault{Err: errInjected})
+		assert.Error(t, pairs[1].cli.Sync(ctx))
+		syncClientsThenAssertEqual(t, pairs)
+		assert.Equal(t, `{"k1":"v1"}`, pairs[1].doc.Marshal())
+	})
+
+	t.Run("recover from database latency test", func(t *testing.T) {
+		pairs := attach(t)
+		update(t, pairs[0].doc, "k1", "v1")
+
+		queue.push(faults.TargetDatabase, "CreateChangeInfos", &faults.Fault{Latency: 100 * gotime.Millisecond})
+		queue.push(faults.TargetDatabase, "FindChangeInfosBetweenServerSeqs", &faults.Fault{
+			Latency: 100 * gotime.Millisecond,
+		})
+		syncClientsThenAssertEqual(t, pairs)
+		assert.Equal(t, `{"k1":"v1"}`, pairs[1].doc.Marshal())
+	})
+
+	t.Run("recover from partial failures test", func(t *testing.T) {
+		pairs := attach(t)
+		update(t, pairs[0].doc, "k1", "v1")
+
+		// NOTE: The changes are stored, but the client sees the
+		// error and pushes the same changes again.
+		queue.push(faults.TargetDatabase, "UpdateClientInfoAfterPushPull", &faults.Fault{
+			Err:     errInjected,
+			Partial: true,
+		})
+		assert.Error(t, pairs[0].cli.Sync(ctx))
+		update(t, pairs[0].doc, "k2", "v2")
+		syncClientsThenAssertEqual(t, pairs)
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, pairs[1].doc.Marshal())
+	})
+
+	t.Run("recover from failures of multi-document updates test", func(t *testing.T) {
+		pairs := attach(t)
+		doc1 := document.New(helper.TestDocKey(t) + "-2")
+		doc2 := document.New(helper.TestDocKey(t) + "-2")
+		assert.NoError(t, pairs[0].cli.Attach(ctx, doc1))
+		assert.NoError(t, pairs[1].cli.Attach(ctx, doc2))
+
+		// 01. none of the changes is stored if storing them fails.
+		queue.push(faults.TargetDatabase, "CreateChangeInfosOfDocs", &faults.Fault{Err: errInjected})
+		assert.Error(t, pairs[0].cli.UpdateMulti(
+			ctx,
+			[]*document.Document{pairs[0].doc, doc1},
+			func(roots []*json.Object) error {
+				roots[0].SetString("k1", "v1")
+				roots[1].SetString("k2", "v2")
+				return nil
+			},
+		))
+		assert.NoError(t, pairs[1].cli.Sync(ctx))
+		assert.Equal(t, `{}`, pairs[1].doc.Marshal())
+		assert.Equal(t, `{}`, doc2.Marshal())
+
+		// 02. the local changes are pushed again by the next sync.
+		assert.NoError(t, pairs[0].cli.Sync(ctx))
+		assert.NoError(t, pairs[1].cli.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1"}`, pairs[1].doc.Marshal())
+		assert.Equal(t, `{"k2":"v2"}`, doc2.Marshal())
+	})
+
+	t.Run("recover from dropped publishes test", func(t *testing.T) {
+		pairs := attach(t)
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		wrch, err := pairs[1].cli.Watch(watchCtx, pairs[1].doc)
+		assert.NoError(t, err)
+
+		// NOTE: The event of the first change is dropped, so the
+		// watcher is notified by the event of the second change.
+		queue.push(faults.TargetPubSub, "Publish", &faults.Fault{Err: errInjected})
+		update(t, pairs[0].doc, "k1", "v1")
+		assert.NoError(t, pairs[0].cli.Sync(ctx))
+		update(t, pairs[0].doc, "k2", "v2")
+		assert.NoError(t, pairs[0].cli.Sync(ctx))
+
+		for {
+			select {
+			case wr := <-wrch:
+				assert.NoError(t, wr.Err)
+				if wr.Type != client.DocumentChanged {
+					continue
+				}
+			case <-gotime.After(5 * gotime.Second):
+				assert.Fail(t, "timeout")
+			}
+			break
+		}
+
+		assert.NoError(t, pairs[1].cli.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, pairs[1].doc.Marshal())
+	})
+}
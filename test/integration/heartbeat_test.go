@@ -0,0 +1,158 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestWatchHeartbeat(t *testing.T) {
+	heartbeatTimeout := time.Second
+	conf := helper.TestConfig()
+	conf.Backend.WatchHeartbeatTimeout = heartbeatTimeout.String()
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	t.Run("evict peer whose heartbeats stop test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. Create a live client and a ghost client that sends only the
+		// first heartbeat, like a client that crashed after watching.
+		live, err := client.Dial(svr.RPCAddr(), client.WithHeartbeatInterval(200*time.Millisecond))
+		assert.NoError(t, err)
+		ghost, err := client.Dial(svr.RPCAddr(), client.WithHeartbeatInterval(time.Hour))
+		assert.NoError(t, err)
+		clients := []*client.Client{live, ghost}
+		for _, c := range clients {
+			assert.NoError(t, c.Activate(ctx))
+		}
+		defer deactivateAndCloseClients(t, clients)
+
+		d1 := document.New(helper.TestDocKey(t))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, ghost.Attach(ctx, d2))
+		assert.NoError(t, live.Attach(ctx, d1))
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := live.Watch(watchCtx, d1)
+		assert.NoError(t, err)
+
+		// 02. Watch the document by the ghost client.
+		ghostRch, err := ghost.Watch(watchCtx, d2)
+		assert.NoError(t, err)
+		waitWatchResponse(t, rch, client.DocumentWatched)
+
+		// 03. The ghost client should be evicted after the heartbeat timeout,
+		// and the live client should be notified of it.
+		resp := waitWatchResponse(t, rch, client.DocumentUnwatched)
+		assert.Contains(t, resp.Presences, ghost.ID().String())
+		for wr := range ghostRch {
+			if wr.Err != nil {
+				assert.Equal(t, codes.Unavailable, status.Code(wr.Err))
+				break
+			}
+		}
+
+		// 04. The live client should keep watching the document.
+		select {
+		case wr := <-rch:
+			assert.NoError(t, wr.Err)
+		case <-time.After(2 * heartbeatTimeout):
+		}
+	})
+
+	t.Run("reconnect watch after eviction test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. Create a client whose watch is evicted by the heartbeat timeout
+		// and a client that updates the document meanwhile.
+		ghost, err := client.Dial(svr.RPCAddr(), client.WithHeartbeatInterval(time.Hour))
+		assert.NoError(t, err)
+		writer, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		clients := []*client.Client{ghost, writer}
+		for _, c := range clients {
+			assert.NoError(t, c.Activate(ctx))
+		}
+		defer deactivateAndCloseClients(t, clients)
+
+		d1 := document.New(helper.TestDocKey(t))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, ghost.Attach(ctx, d1))
+		assert.NoError(t, writer.Attach(ctx, d2))
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := ghost.Watch(watchCtx, d1, client.WithReconnect(client.Backoff{
+			InitialInterval: 10 * time.Millisecond,
+			MaxInterval:     100 * time.Millisecond,
+		}))
+		assert.NoError(t, err)
+
+		// 02. The watch should be reconnected after the eviction instead of
+		// being closed, and the document should be synced.
+		waitWatchResponse(t, rch, client.Disconnected)
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, writer.Sync(ctx))
+
+		waitWatchResponse(t, rch, client.Reconnected)
+		waitWatchResponse(t, rch, client.DocumentChanged)
+		assert.NoError(t, ghost.Sync(ctx))
+		assert.Equal(t, d2.Marshal(), d1.Marshal())
+	})
+}
+
+// waitWatchResponse waits for the watch response of the given type.
+func waitWatchResponse(
+	t *testing.T,
+	rch <-chan client.WatchResponse,
+	responseType client.WatchResponseType,
+) client.WatchResponse {
+	for {
+		select {
+		case wr := <-rch:
+			assert.NoError(t, wr.Err)
+			if wr.Err != nil || wr.Type == responseType {
+				return wr
+			}
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "timeout", responseType)
+			return client.WatchResponse{}
+		}
+	}
+}
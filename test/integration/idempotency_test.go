@@ -0,0 +1,126 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestPushPullIdempotency(t *testing.T) {
+	ctx := context.Background()
+	conn, err := clientConn()
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, conn.Close()) }()
+	cli := api.NewYorkieServiceClient(conn)
+
+	activated, err := cli.ActivateClient(ctx, &api.ActivateClientRequest{ClientKey: t.Name()})
+	assert.NoError(t, err)
+	actorID, err := time.ActorIDFromHex(activated.ClientId)
+	assert.NoError(t, err)
+
+	doc := document.New(helper.TestDocKey(t))
+	doc.SetActor(actorID)
+	pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+	assert.NoError(t, err)
+	attached, err := cli.AttachDocument(ctx, &api.AttachDocumentRequest{
+		ClientId:   activated.ClientId,
+		ChangePack: pbPack,
+	})
+	assert.NoError(t, err)
+	pack, err := converter.FromChangePack(attached.ChangePack)
+	assert.NoError(t, err)
+	assert.NoError(t, doc.ApplyChangePack(pack))
+
+	pushPull := func(idempotencyKey string) *api.ChangePack {
+		pack := doc.CreateChangePack()
+		pack.IdempotencyKey = idempotencyKey
+		pbPack, err := converter.ToChangePack(pack)
+		assert.NoError(t, err)
+
+		res, err := cli.PushPullChanges(ctx, &api.PushPullChangesRequest{
+			ClientId:   activated.ClientId,
+			DocumentId: attached.DocumentId,
+			ChangePack: pbPack,
+		})
+		assert.NoError(t, err)
+		return res.ChangePack
+	}
+
+	t.Run("retried push with the same idempotency key test", func(t *testing.T) {
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+
+		// 01. the response of the first push is lost.
+		first := pushPull("key-1")
+
+		// 02. another client pushes its changes in the meantime.
+		c2, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c2})
+		d2 := document.New(doc.Key())
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k0", "v0")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+
+		// 03. the same changes are pushed again with the same key, and the
+		// response of the first push is returned.
+		retried := pushPull("key-1")
+		assert.Equal(t, first.Checkpoint, retried.Checkpoint)
+		assert.Equal(t, first.Changes, retried.Changes)
+
+		pack, err := converter.FromChangePack(retried)
+		assert.NoError(t, err)
+		assert.NoError(t, doc.ApplyChangePack(pack))
+		assert.False(t, doc.HasLocalChanges())
+		assert.Equal(t, `{"k1":"v1"}`, doc.Marshal())
+	})
+
+	t.Run("push with a new idempotency key test", func(t *testing.T) {
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+
+		// 01. the push with another key is not answered from the cache, so
+		// the changes of the other client are pulled.
+		pack, err := converter.FromChangePack(pushPull("key-2"))
+		assert.NoError(t, err)
+		assert.NoError(t, doc.ApplyChangePack(pack))
+		assert.False(t, doc.HasLocalChanges())
+		assert.Equal(t, `{"k0":"v0","k1":"v1","k2":"v2"}`, doc.Marshal())
+	})
+}
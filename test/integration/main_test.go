@@ -31,7 +31,6 @@ import (
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
-	"github.com/yorkie-team/yorkie/server"
 	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
@@ -46,7 +45,7 @@ type watchResponsePair struct {
 	Presences map[string]innerpresence.Presence
 }
 
-var defaultServer *server.Yorkie
+var defaultServer *helper.Server
 
 func TestMain(m *testing.M) {
 	svr := helper.TestServer()
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -163,4 +164,42 @@ func TestObject(t *testing.T) {
 		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
 	})
 
+	t.Run("concurrent object.set with merge policy test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		err := c1.Attach(ctx, d1)
+		assert.NoError(t, err)
+
+		d2 := document.New(helper.TestDocKey(t))
+		err = c2.Attach(ctx, d2)
+		assert.NoError(t, err)
+
+		// 01. concurrent set of objects with the merge policy on the same key
+		err = d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("k1", crdt.Merge).SetString("a", "1").SetString("b", "1")
+			return nil
+		}, "set k1 by c1")
+		assert.NoError(t, err)
+		err = d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("k1", crdt.Merge).SetString("b", "2").SetString("c", "2")
+			return nil
+		}, "set k1 by c2")
+		assert.NoError(t, err)
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `{"k1":{"a":"1","b":"2","c":"2"}}`, d1.Marshal())
+
+		// 02. concurrent edits on the merged object
+		err = d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("k1").Delete("a")
+			return nil
+		}, "delete k1.a by c1")
+		assert.NoError(t, err)
+		err = d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("k1").SetString("d", "2")
+			return nil
+		}, "set k1.d by c2")
+		assert.NoError(t, err)
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `{"k1":{"b":"2","c":"2","d":"2"}}`, d1.Marshal())
+	})
 }
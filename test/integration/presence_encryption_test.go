@@ -0,0 +1,170 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestPresenceEncryption(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	ctx := context.Background()
+	project, err := adminCli.CreateProject(ctx, "presence-encryption-test")
+	assert.NoError(t, err)
+	sensitiveKeys := []string{"email"}
+
+	// NOTE: The webhook permits only the users with the trusted token to read
+	// the sensitive values in plaintext.
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewAuthWebhookRequest(r.Body)
+		assert.NoError(t, err)
+
+		res := types.AuthWebhookResponse{Allowed: true, DecryptPresence: req.Token == "trusted"}
+		_, err = res.Write(w)
+		assert.NoError(t, err)
+	}))
+	defer authServer.Close()
+
+	_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+		AuthWebhookURL:        &authServer.URL,
+		SensitivePresenceKeys: &sensitiveKeys,
+	})
+	assert.NoError(t, err)
+
+	var clients []*client.Client
+	for _, token := range []string{"writer", "trusted", "untrusted"} {
+		cli, err := client.Dial(
+			svr.RPCAddr(),
+			client.WithAPIKey(project.PublicKey),
+			client.WithToken(token),
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+		clients = append(clients, cli)
+	}
+	defer deactivateAndCloseClients(t, clients)
+	c1, c2, c3 := clients[0], clients[1], clients[2]
+
+	t.Run("sensitive values are not stored in plaintext test", func(t *testing.T) {
+		p := innerpresence.Presence{"name": `"jane"`, "email": `"jane@example.com"`}
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithPresence(p)))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+
+		// 01. the client with the permission receives the values in plaintext.
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		defer func() { assert.NoError(t, c2.Detach(ctx, d2)) }()
+		assert.Equal(t, p, d2.PresenceForTest(c1.ID().String()))
+
+		// 02. the client without the permission receives the values encrypted.
+		d3 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c3.Attach(ctx, d3))
+		defer func() { assert.NoError(t, c3.Detach(ctx, d3)) }()
+		received := d3.PresenceForTest(c1.ID().String())
+		assert.Equal(t, `"jane"`, received["name"])
+		assert.NotContains(t, received["email"], "jane@example.com")
+
+		// 03. the server stores the sensitive values encrypted.
+		snapshot, err := adminCli.GetSnapshot(ctx, project.Name, d1.Key(), math.MaxInt64)
+		assert.NoError(t, err)
+		_, presences, err := converter.BytesToSnapshot(snapshot)
+		assert.NoError(t, err)
+		stored := presences.Load(c1.ID().String())
+		assert.Equal(t, `"jane"`, stored["name"])
+		assert.NotContains(t, stored["email"], "jane@example.com")
+		assert.True(t, strings.HasPrefix(stored["email"], `"yorkie:enc:v1:`))
+	})
+
+	t.Run("sensitive values with snapshot test", func(t *testing.T) {
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		defer func() { assert.NoError(t, c2.Detach(ctx, d2)) }()
+
+		// 01. push changes more than the snapshot threshold so that the other
+		// client pulls the snapshot.
+		for i := 0; i < int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				p.Set("email", fmt.Sprintf(`"jane%d@example.com"`, i))
+				return nil
+			}))
+		}
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, innerpresence.Presence{"email": `"jane9@example.com"`}, d2.PresenceForTest(c1.ID().String()))
+
+		d3 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c3.Attach(ctx, d3))
+		defer func() { assert.NoError(t, c3.Detach(ctx, d3)) }()
+		assert.NotContains(t, d3.PresenceForTest(c1.ID().String())["email"], "jane9@example.com")
+	})
+	t.Run("sensitive values with presence updates test", func(t *testing.T) {
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// 01. the clients attach the same document and watch it.
+		var docs []*document.Document
+		var wrchs []<-chan client.WatchResponse
+		for _, cli := range clients {
+			doc := document.New(helper.TestDocKey(t))
+			assert.NoError(t, cli.Attach(ctx, doc))
+			defer func(cli *client.Client) { assert.NoError(t, cli.Detach(ctx, doc)) }(cli)
+			wrch, err := cli.Watch(watchCtx, doc)
+			assert.NoError(t, err)
+			docs = append(docs, doc)
+			wrchs = append(wrchs, wrch)
+		}
+
+		// 02. the presence update of the first client is delivered in
+		// plaintext only to the client with the permission.
+		assert.NoError(t, c1.UpdatePresence(ctx, docs[0], innerpresence.Presence{"email": `"jane@example.com"`}))
+		resp := waitWatchResponse(t, wrchs[1], client.PeersChanged)
+		assert.Equal(t, `"jane@example.com"`, resp.Presences[c1.ID().String()]["email"])
+		resp = waitWatchResponse(t, wrchs[2], client.PeersChanged)
+		assert.NotContains(t, resp.Presences[c1.ID().String()]["email"], "jane@example.com")
+	})
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
@@ -475,4 +476,114 @@ func TestPresence(t *testing.T) {
 
 		assert.Equal(t, expected, responsePairs)
 	})
+	t.Run("peers changed events test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. Two clients attach the same document and watch it.
+		d1 := document.New(helper.TestDocKey(t))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+		assert.NoError(t, c2.Attach(ctx, d2))
+		defer func() { assert.NoError(t, c2.Detach(ctx, d2)) }()
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		wrch, err := c1.Watch(watchCtx, d1)
+		assert.NoError(t, err)
+		_, err = c2.Watch(watchCtx, d2)
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Sync(ctx, client.WithDocKey(helper.TestDocKey(t))))
+		waitWatchResponse(t, wrch, client.DocumentWatched)
+
+		// 02. The second client updates its presence without a change.
+		checkpoint := d2.Checkpoint()
+		assert.NoError(t, c2.UpdatePresence(ctx, d2, innerpresence.Presence{"cursor": "1"}))
+		assert.Equal(t, "1", d2.MyPresence()["cursor"])
+		assert.False(t, d2.HasLocalChanges())
+
+		// 03. The first client receives only the changed keys.
+		resp := waitWatchResponse(t, wrch, client.PeersChanged)
+		assert.Equal(t, map[string]innerpresence.Presence{
+			c2.ID().String(): {"cursor": "1"},
+		}, resp.Presences)
+		assert.Equal(t, "1", d1.Presence(c2.ID().String())["cursor"])
+
+		// 04. The document is not written by the update.
+		assert.NoError(t, c2.Sync(ctx, client.WithDocKey(helper.TestDocKey(t))))
+		assert.Equal(t, checkpoint.ServerSeq, d2.Checkpoint().ServerSeq)
+	})
+	t.Run("update all presences test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. Two clients attach two documents and the first one watches them.
+		var wrchs []<-chan client.WatchResponse
+		for _, k := range []key.Key{
+			key.Key(helper.TestDocKey(t).String() + "-1"),
+			key.Key(helper.TestDocKey(t).String() + "-2"),
+		} {
+			d1 := document.New(k)
+			d2 := document.New(k)
+			assert.NoError(t, c1.Attach(ctx, d1))
+			defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+			assert.NoError(t, c2.Attach(ctx, d2))
+			defer func() { assert.NoError(t, c2.Detach(ctx, d2)) }()
+
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			wrch, err := c1.Watch(watchCtx, d1)
+			assert.NoError(t, err)
+			_, err = c2.Watch(watchCtx, d2)
+			assert.NoError(t, err)
+			assert.NoError(t, c1.Sync(ctx, client.WithDocKey(k)))
+			waitWatchResponse(t, wrch, client.DocumentWatched)
+			wrchs = append(wrchs, wrch)
+		}
+
+		// 02. The second client updates its presence in all the documents.
+		assert.NoError(t, c2.UpdateAllPresences(ctx, innerpresence.Presence{"name": "yorkie"}))
+
+		// 03. The first client receives the changed keys from each document.
+		for _, wrch := range wrchs {
+			resp := waitWatchResponse(t, wrch, client.PeersChanged)
+			assert.Equal(t, map[string]innerpresence.Presence{
+				c2.ID().String(): {"name": "yorkie"},
+			}, resp.Presences)
+		}
+	})
+	t.Run("set presence of document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. Two clients attach the same document and watch it.
+		d1 := document.New(helper.TestDocKey(t))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+		assert.NoError(t, c2.Attach(ctx, d2))
+		defer func() { assert.NoError(t, c2.Detach(ctx, d2)) }()
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		wrch, err := c1.Watch(watchCtx, d1)
+		assert.NoError(t, err)
+		_, err = c2.Watch(watchCtx, d2)
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Sync(ctx, client.WithDocKey(helper.TestDocKey(t))))
+		waitWatchResponse(t, wrch, client.DocumentWatched)
+
+		// 02. The second client sets its cursor many times and syncs.
+		checkpoint := d2.Checkpoint()
+		for i := 0; i < 10; i++ {
+			d2.SetPresence("cursor", strconv.Itoa(i))
+		}
+		assert.NoError(t, c2.Sync(ctx, client.WithDocKey(helper.TestDocKey(t))))
+		assert.Equal(t, checkpoint.ServerSeq, d2.Checkpoint().ServerSeq)
+
+		// 03. The first client receives only the last cursor.
+		resp := waitWatchResponse(t, wrch, client.PeersChanged)
+		assert.Equal(t, map[string]innerpresence.Presence{
+			c2.ID().String(): {"cursor": "9"},
+		}, resp.Presences)
+		assert.Equal(t, "9", d1.Presence(c2.ID().String())["cursor"])
+	})
 }
@@ -0,0 +1,104 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestWatchQuery(t *testing.T) {
+	clients := activeClients(t, 2)
+	c1, c2 := clients[0], clients[1]
+	defer deactivateAndCloseClients(t, clients)
+
+	t.Run("watch query result test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("stats").SetInteger("count", 1)
+			root.SetString("title", "a")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 01. The watcher receives the value of the query when it starts.
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := c2.Watch(watchCtx, d2, client.WithQuery("$.stats"))
+		assert.NoError(t, err)
+		resp := waitWatchResponse(t, rch, client.QueryChanged)
+		assert.Equal(t, `{"count":1}`, resp.QueryResult)
+
+		// 02. Changes that do not touch the value are not delivered.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "b")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 03. The watcher receives the changed value.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("stats").SetInteger("count", 2)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		resp = waitWatchResponse(t, rch, client.QueryChanged)
+		assert.Equal(t, `{"count":2}`, resp.QueryResult)
+		select {
+		case wr := <-rch:
+			assert.NotEqual(t, client.QueryChanged, wr.Type)
+			assert.NotEqual(t, client.DocumentChanged, wr.Type)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		// 04. The value is null when the path does not exist.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("stats")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		resp = waitWatchResponse(t, rch, client.QueryChanged)
+		assert.Equal(t, "null", resp.QueryResult)
+	})
+
+	t.Run("invalid query test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		_, err := c1.Watch(ctx, d1, client.WithQuery("stats"))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,117 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestRateLimit(t *testing.T) {
+	dial := func(t *testing.T, addr string) *client.Client {
+		cli, err := client.Dial(addr)
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(context.Background()))
+		return cli
+	}
+
+	t.Run("client rate limit test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := helper.TestConfig()
+		conf.Backend.ClientRateLimit = 1
+		conf.Backend.ClientRateLimitBurst = 2
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		c1, c2 := dial(t, svr.RPCAddr()), dial(t, svr.RPCAddr())
+		defer func() { assert.NoError(t, c1.Close()) }()
+		defer func() { assert.NoError(t, c2.Close()) }()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. calls over the burst are rejected with a retry hint.
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		err = c1.Sync(ctx)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		var throttled *client.ThrottledError
+		assert.ErrorAs(t, err, &throttled)
+		assert.Greater(t, throttled.RetryAfter(), time.Duration(0))
+		assert.Equal(t, "client:"+c1.ID().String(), throttled.Violations()[0].Subject)
+
+		// 02. other clients are not affected.
+		assert.NoError(t, c2.Sync(ctx))
+
+		// 03. calls are accepted again after the retry hint.
+		time.Sleep(throttled.RetryAfter())
+		assert.NoError(t, c1.Sync(ctx))
+	})
+
+	t.Run("project rate limit test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := helper.TestConfig()
+		conf.Backend.ProjectRateLimit = 1
+		conf.Backend.ProjectRateLimitBurst = 2
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		c1, c2 := dial(t, svr.RPCAddr()), dial(t, svr.RPCAddr())
+		defer func() { assert.NoError(t, c1.Close()) }()
+		defer func() { assert.NoError(t, c2.Close()) }()
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. the calls of the clients of the project share the limit.
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		err = c2.Sync(ctx)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		var throttled *client.ThrottledError
+		assert.ErrorAs(t, err, &throttled)
+		assert.Greater(t, throttled.RetryAfter(), time.Duration(0))
+		assert.Equal(t, "project:"+database.DefaultProjectID.String(), throttled.Violations()[0].Subject)
+
+		// 02. watching documents is limited as well.
+		_, err = c1.Watch(ctx, d1)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
@@ -23,14 +23,19 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	monkey "github.com/undefinedlabs/go-mpatch"
 
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/background"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
@@ -170,3 +175,192 @@ func TestSnapshot(t *testing.T) {
 		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
 	})
 }
+
+func TestSnapshotBuildInBatches(t *testing.T) {
+	conf := helper.TestConfig()
+	conf.Backend.SnapshotInterval = 1000
+	conf.Backend.SnapshotBuildBatchSize = 3
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	t.Run("build snapshot from changes in batches test", func(t *testing.T) {
+		ctx := context.Background()
+
+		c1, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c1.Close()) }()
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c2.Close()) }()
+		assert.NoError(t, c2.Activate(ctx))
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		// 01. Push changes over the snapshot threshold without storing a
+		// snapshot, so that the snapshot is built from the changes.
+		for i := 0; i <= 2*int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger(fmt.Sprintf("%d", i), i)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+
+		// 02. Attach the document, which pulls the snapshot built in batches.
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+}
+
+func TestSnapshotCache(t *testing.T) {
+	conf := helper.TestConfig()
+	conf.Backend.SnapshotInterval = 1000
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	t.Run("pull snapshot from cache test", func(t *testing.T) {
+		ctx := context.Background()
+
+		var clients []*client.Client
+		for i := 0; i < 3; i++ {
+			cli, err := client.Dial(svr.RPCAddr())
+			assert.NoError(t, err)
+			assert.NoError(t, cli.Activate(ctx))
+			clients = append(clients, cli)
+		}
+		defer deactivateAndCloseClients(t, clients)
+		c1, c2, c3 := clients[0], clients[1], clients[2]
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		d3 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c3.Attach(ctx, d3))
+
+		// 01. Update changes over snapshot threshold.
+		for i := 0; i <= int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger(fmt.Sprintf("%d", i), i)
+				return nil
+			}))
+		}
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 02. The first pull builds the snapshot and caches it, and the second
+		// pull of the same server seq restores the document from the cache
+		// then applies the local changes of the client to it.
+		assert.NoError(t, d3.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("key", "value")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c3.Sync(ctx))
+
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.Equal(t, d1.Marshal(), d3.Marshal())
+	})
+}
+
+func TestDeltaSnapshot(t *testing.T) {
+	conf := helper.TestConfig()
+	conf.Backend.SnapshotMode = backend.SnapshotModeDelta
+	conf.Backend.SnapshotMaxDeltas = 2
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	t.Run("pull snapshot rebuilt from deltas test", func(t *testing.T) {
+		ctx := context.Background()
+
+		c1, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c1.Close()) }()
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c2.Close()) }()
+		assert.NoError(t, c2.Activate(ctx))
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("Hello Yorkie ", 100))
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 01. Push changes over several snapshot intervals, so that the
+		// snapshots are stored as the chains of deltas.
+		for i := 0; i <= 5*int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k2", i)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+
+		// 02. Attach the document, which pulls the snapshot rebuilt from the
+		// deltas.
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Edit(0, 0, "o")
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+	})
+}
+
+func TestSnapshotCodec(t *testing.T) {
+	conf := helper.TestConfig()
+	conf.Backend.SnapshotCodec = converter.SnapshotCodecCBOR
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	t.Run("pull snapshot stored in cbor test", func(t *testing.T) {
+		ctx := context.Background()
+
+		c1, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c1.Close()) }()
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c2.Close()) }()
+		assert.NoError(t, c2.Activate(ctx))
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		// 01. Push changes over the snapshot interval, so that the snapshot
+		// is stored in CBOR.
+		for i := 0; i <= 2*int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger(fmt.Sprintf("%d", i), i)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+
+		// 02. Attach the document, which pulls the snapshot built from the
+		// stored snapshot in CBOR, sent to the client in protocol buffers.
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+}
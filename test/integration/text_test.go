@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -226,4 +227,48 @@ func TestText(t *testing.T) {
 		assert.True(t, d1.Root().GetText("k1").CheckWeight())
 		assert.True(t, d2.Root().GetText("k1").CheckWeight())
 	})
+	t.Run("annotation test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		err := c1.Attach(ctx, d1)
+		assert.NoError(t, err)
+
+		err = d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello world")
+			return nil
+		}, `set a new text with "Hello world" by c1`)
+		assert.NoError(t, err)
+		err = c1.Sync(ctx)
+		assert.NoError(t, err)
+
+		d2 := document.New(helper.TestDocKey(t))
+		err = c2.Attach(ctx, d2)
+		assert.NoError(t, err)
+
+		err = d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").AddAnnotation("comment", 6, 11, "nice")
+			return nil
+		}, `annotate "world" by c1`)
+		assert.NoError(t, err)
+
+		err = d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Edit(0, 5, "Hi")
+			return nil
+		}, `replace "Hello" with "Hi" by c2`)
+		assert.NoError(t, err)
+
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		expected := []crdt.TextAnnotationRange{{Name: "comment", From: 3, To: 8, Value: "nice"}}
+		assert.Equal(t, expected, d1.Root().GetText("k1").Annotations())
+		assert.Equal(t, expected, d2.Root().GetText("k1").Annotations())
+
+		err = d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").RemoveAnnotation("comment")
+			return nil
+		}, `remove the annotation by c2`)
+		assert.NoError(t, err)
+
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Empty(t, d1.Root().GetText("k1").Annotations())
+	})
 }
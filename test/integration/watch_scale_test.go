@@ -0,0 +1,46 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/test/loadtest"
+)
+
+func TestWatchScale(t *testing.T) {
+	t.Run("deliver events to many streams test", func(t *testing.T) {
+		scenario := loadtest.NewWatchScenario(10, 50)
+		scenario.Rounds = 3
+
+		result, err := loadtest.RunWatch(context.Background(), defaultServer.RPCAddr(), scenario)
+		assert.NoError(t, err)
+		assert.Equal(t, scenario.Streams()*scenario.Rounds, result.Events)
+		assert.Zero(t, result.Missed)
+		assert.Zero(t, result.Dropped)
+		assert.Positive(t, result.HeapPerStream)
+
+		// NOTE: The goroutines of every stream on both the client
+		// and the server should exit once the streams are disconnected.
+		assert.Zero(t, result.LeakedGoroutines)
+	})
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	gotime "time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// Result is the result of running a scenario.
+type Result struct {
+	// Scenario is the scenario that was run.
+	Scenario Scenario
+
+	// Elapsed is the time taken to run the rounds of the scenario.
+	Elapsed gotime.Duration
+
+	// Updates is the number of updates made by writers.
+	Updates int
+
+	// Syncs is the number of successful syncs.
+	Syncs int
+
+	// Churns is the number of clients that left and rejoined.
+	Churns int
+
+	// Errors is the number of failed updates, syncs and churns.
+	Errors int
+
+	// Converged is whether the clients of each document converged after the
+	// scenario.
+	Converged bool
+
+	// latencies is the sorted latencies of the syncs.
+	latencies []gotime.Duration
+}
+
+// Throughput returns the number of syncs per second.
+func (r *Result) Throughput() float64 {
+	if r.Elapsed == 0 {
+		return 0
+	}
+	return float64(r.Syncs) / r.Elapsed.Seconds()
+}
+
+// Latency returns the sync latency of the given percentile in [0, 100].
+func (r *Result) Latency(percentile float64) gotime.Duration {
+	return latencyAt(r.latencies, percentile)
+}
+
+// latencyAt returns the latency of the given percentile in [0, 100] of the
+// given sorted latencies.
+func latencyAt(latencies []gotime.Duration, percentile float64) gotime.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+
+	idx := int(float64(len(latencies)-1) * percentile / 100)
+	return latencies[idx]
+}
+
+// Report writes a human-readable report of the result to the given writer.
+func (r *Result) Report(w io.Writer) error {
+	tw := table.NewWriter()
+	tw.Style().Options.DrawBorder = false
+	tw.Style().Options.SeparateColumns = false
+	tw.Style().Options.SeparateFooter = false
+	tw.Style().Options.SeparateHeader = false
+	tw.Style().Options.SeparateRows = false
+	tw.AppendHeader(table.Row{
+		"SCENARIO",
+		"ELAPSED",
+		"UPDATES",
+		"SYNCS",
+		"SYNCS/SEC",
+		"P50",
+		"P95",
+		"P99",
+		"CHURNS",
+		"ERRORS",
+		"CONVERGED",
+	})
+	tw.AppendRow(table.Row{
+		r.Scenario.Name,
+		r.Elapsed.Round(gotime.Millisecond),
+		r.Updates,
+		r.Syncs,
+		fmt.Sprintf("%.1f", r.Throughput()),
+		r.Latency(50).Round(gotime.Microsecond),
+		r.Latency(95).Round(gotime.Microsecond),
+		r.Latency(99).Round(gotime.Microsecond),
+		r.Churns,
+		r.Errors,
+		r.Converged,
+	})
+
+	_, err := fmt.Fprintf(w, "%s\n%s\n", r.Scenario, tw.Render())
+	return err
+}
+
+// recorder records the results of clients running concurrently.
+type recorder struct {
+	mu        sync.Mutex
+	updates   int
+	churns    int
+	errors    int
+	latencies []gotime.Duration
+}
+
+func (r *recorder) recordUpdate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.updates++
+}
+
+func (r *recorder) recordSync(latency gotime.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.latencies = append(r.latencies, latency)
+}
+
+func (r *recorder) recordChurn() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.churns++
+}
+
+func (r *recorder) recordError() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors++
+}
+
+func (r *recorder) result(scenario Scenario, elapsed gotime.Duration, converged bool) *Result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	latencies := append([]gotime.Duration{}, r.latencies...)
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+
+	return &Result{
+		Scenario:  scenario,
+		Elapsed:   elapsed,
+		Updates:   r.updates,
+		Syncs:     len(latencies),
+		Churns:    r.churns,
+		Errors:    r.errors,
+		Converged: converged,
+		latencies: latencies,
+	}
+}
@@ -0,0 +1,242 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+// Run runs the given scenario against the server of the given address and
+// returns the result. The given options are used to create the clients.
+func Run(
+	ctx context.Context,
+	rpcAddr string,
+	scenario Scenario,
+	opts ...client.Option,
+) (*Result, error) {
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+
+	startedAt := gotime.Now()
+	rec := &recorder{}
+	var docs [][]*participant
+
+	// 01. create the documents and the clients of each document.
+	for i := 0; i < scenario.Documents; i++ {
+		docKey := key.Key(fmt.Sprintf("loadtest-%s-%d-%d", scenario.Name, startedAt.UnixNano(), i))
+		if err := setup(ctx, rpcAddr, docKey, scenario.Workload, opts); err != nil {
+			return nil, err
+		}
+
+		var participants []*participant
+		for j := 0; j < scenario.Clients(); j++ {
+			p := &participant{
+				rpcAddr: rpcAddr,
+				opts:    opts,
+				key:     docKey,
+				writer:  j < scenario.Writers,
+				rand:    rand.New(rand.NewSource(scenario.Seed + int64(i*scenario.Clients()+j))),
+			}
+			if err := p.join(ctx); err != nil {
+				return nil, err
+			}
+			participants = append(participants, p)
+		}
+		docs = append(docs, participants)
+	}
+	defer func() {
+		for _, participants := range docs {
+			for _, p := range participants {
+				_ = p.leave(context.Background())
+			}
+		}
+	}()
+
+	// 02. run the rounds of every client concurrently.
+	runningAt := gotime.Now()
+	wg := sync.WaitGroup{}
+	for _, participants := range docs {
+		for _, p := range participants {
+			wg.Add(1)
+			go func(p *participant) {
+				defer wg.Done()
+				p.run(ctx, scenario, rec)
+			}(p)
+		}
+	}
+	wg.Wait()
+	elapsed := gotime.Since(runningAt)
+
+	// 03. sync every client once more and check that the clients of each
+	// document converge.
+	converged := true
+	for _, participants := range docs {
+		var marshaled []string
+		for _, p := range participants {
+			// NOTE: A client that failed to rejoin is skipped.
+			if p.cli == nil {
+				continue
+			}
+			if err := p.cli.Sync(ctx); err != nil {
+				rec.recordError()
+				continue
+			}
+			marshaled = append(marshaled, p.doc.Marshal())
+		}
+
+		for _, m := range marshaled {
+			if m != marshaled[0] {
+				converged = false
+			}
+		}
+	}
+
+	return rec.result(scenario, elapsed, converged), nil
+}
+
+// setup creates the document of the given key with the container of the
+// given workload, so that writers start with the same container.
+func setup(
+	ctx context.Context,
+	rpcAddr string,
+	docKey key.Key,
+	workload Workload,
+	opts []client.Option,
+) error {
+	cli, err := client.Dial(rpcAddr, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cli.Close()
+	}()
+
+	if err := cli.Activate(ctx); err != nil {
+		return err
+	}
+	doc := document.New(docKey)
+	if err := cli.Attach(ctx, doc); err != nil {
+		return err
+	}
+	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+		workload.setup(root)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := cli.Detach(ctx, doc); err != nil {
+		return err
+	}
+	return cli.Deactivate(ctx)
+}
+
+// participant is a client that joins a document of a scenario.
+type participant struct {
+	rpcAddr string
+	opts    []client.Option
+	key     key.Key
+	writer  bool
+	rand    *rand.Rand
+
+	cli *client.Client
+	doc *document.Document
+}
+
+// join creates a new client and attaches the document to it.
+func (p *participant) join(ctx context.Context) error {
+	cli, err := client.Dial(p.rpcAddr, p.opts...)
+	if err != nil {
+		return err
+	}
+	if err := cli.Activate(ctx); err != nil {
+		_ = cli.Close()
+		return err
+	}
+
+	doc := document.New(p.key)
+	if err := cli.Attach(ctx, doc); err != nil {
+		_ = cli.Close()
+		return err
+	}
+
+	p.cli = cli
+	p.doc = doc
+	return nil
+}
+
+// leave detaches the document and closes the client.
+func (p *participant) leave(ctx context.Context) error {
+	if p.cli == nil {
+		return nil
+	}
+	defer func() {
+		_ = p.cli.Close()
+		p.cli = nil
+	}()
+
+	if err := p.cli.Detach(ctx, p.doc); err != nil {
+		return err
+	}
+	return p.cli.Deactivate(ctx)
+}
+
+// run runs the rounds of the scenario and records the results.
+func (p *participant) run(ctx context.Context, scenario Scenario, rec *recorder) {
+	for round := 0; round < scenario.Rounds; round++ {
+		if p.writer {
+			if err := p.doc.Update(func(root *json.Object, _ *presence.Presence) error {
+				scenario.Workload.update(p.rand, root)
+				return nil
+			}); err != nil {
+				rec.recordError()
+				continue
+			}
+			rec.recordUpdate()
+		}
+
+		start := gotime.Now()
+		if err := p.cli.Sync(ctx); err != nil {
+			rec.recordError()
+			continue
+		}
+		rec.recordSync(gotime.Since(start))
+
+		// NOTE: The last round does not churn so that every
+		// document keeps its clients to check the convergence.
+		if round < scenario.Rounds-1 && p.rand.Float64() < scenario.ChurnRate {
+			if err := p.leave(ctx); err != nil {
+				rec.recordError()
+			}
+			if err := p.join(ctx); err != nil {
+				rec.recordError()
+				return
+			}
+			rec.recordChurn()
+		}
+	}
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package loadtest provides a load-testing harness that runs scenarios of
+// concurrent writers and readers of documents against a Yorkie server and
+// reports the results. Scenarios can be run from Go tests and from the
+// `yorkie bench` command.
+package loadtest
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+var (
+	// ErrInvalidScenario is returned when the given scenario is invalid.
+	ErrInvalidScenario = errors.New("invalid scenario")
+
+	// ErrUnsupportedWorkload is returned when the given workload is not
+	// supported.
+	ErrUnsupportedWorkload = errors.New("unsupported workload")
+)
+
+// Workload is the type of updates that writers make to documents.
+type Workload string
+
+// The values below are types of Workload.
+const (
+	// WorkloadText is the workload that edits a text.
+	WorkloadText Workload = "text"
+
+	// WorkloadObject is the workload that sets and deletes keys of an object.
+	WorkloadObject Workload = "object"
+)
+
+// Workloads returns all workloads.
+func Workloads() []Workload {
+	return []Workload{WorkloadText, WorkloadObject}
+}
+
+var objectKeys = []string{"k0", "k1", "k2", "k3", "k4", "k5", "k6", "k7", "k8", "k9"}
+
+// setup creates the container of the workload in the given root.
+func (w Workload) setup(root *json.Object) {
+	switch w {
+	case WorkloadText:
+		root.SetNewText("text")
+	case WorkloadObject:
+		root.SetNewObject("obj")
+	}
+}
+
+// update makes an update of the workload to the given root.
+func (w Workload) update(r *rand.Rand, root *json.Object) {
+	switch w {
+	case WorkloadText:
+		text := root.GetText("text")
+		length := len(text.String())
+		if length > 0 && r.Intn(4) == 0 {
+			from := r.Intn(length)
+			text.Edit(from, from+1, "")
+			return
+		}
+		pos := r.Intn(length + 1)
+		text.Edit(pos, pos, string(rune('a'+r.Intn(26))))
+	case WorkloadObject:
+		obj := root.GetObject("obj")
+		k := objectKeys[r.Intn(len(objectKeys))]
+		if r.Intn(4) == 0 {
+			obj.Delete(k)
+			return
+		}
+		obj.SetInteger(k, r.Intn(1000))
+	}
+}
+
+// Scenario is a load-testing scenario. Each document of the scenario is
+// updated by writers and synced by readers for the given rounds.
+type Scenario struct {
+	// Name is the name of the scenario.
+	Name string
+
+	// Documents is the number of documents.
+	Documents int
+
+	// Writers is the number of clients that update and sync each document.
+	Writers int
+
+	// Readers is the number of clients that only sync each document.
+	Readers int
+
+	// Workload is the type of updates that writers make.
+	Workload Workload
+
+	// Rounds is the number of rounds that each client runs. In a round,
+	// writers make an update and every client syncs once.
+	Rounds int
+
+	// ChurnRate is the probability in [0, 1] that a client leaves after a
+	// round and a new client joins the document instead.
+	ChurnRate float64
+
+	// Seed is the seed of the random source of updates and churns.
+	Seed int64
+}
+
+// TextScenario returns a scenario in which the given number of writers and
+// readers edit a text of each document.
+func TextScenario(documents, writers, readers int) Scenario {
+	return Scenario{
+		Name:      "text",
+		Documents: documents,
+		Writers:   writers,
+		Readers:   readers,
+		Workload:  WorkloadText,
+		Rounds:    10,
+	}
+}
+
+// ObjectScenario returns a scenario in which the given number of writers and
+// readers update an object of each document.
+func ObjectScenario(documents, writers, readers int) Scenario {
+	return Scenario{
+		Name:      "object",
+		Documents: documents,
+		Writers:   writers,
+		Readers:   readers,
+		Workload:  WorkloadObject,
+		Rounds:    10,
+	}
+}
+
+// WithRounds returns a copy of the scenario with the given rounds.
+func (s Scenario) WithRounds(rounds int) Scenario {
+	s.Rounds = rounds
+	return s
+}
+
+// WithChurnRate returns a copy of the scenario with the given churn rate.
+func (s Scenario) WithChurnRate(rate float64) Scenario {
+	s.ChurnRate = rate
+	return s
+}
+
+// WithSeed returns a copy of the scenario with the given seed.
+func (s Scenario) WithSeed(seed int64) Scenario {
+	s.Seed = seed
+	return s
+}
+
+// Clients returns the number of clients of each document.
+func (s Scenario) Clients() int {
+	return s.Writers + s.Readers
+}
+
+// String returns a human-readable summary of the scenario.
+func (s Scenario) String() string {
+	return fmt.Sprintf(
+		"%s: %d docs, %d writers, %d readers, %d rounds, %.2f churn rate",
+		s.Name,
+		s.Documents,
+		s.Writers,
+		s.Readers,
+		s.Rounds,
+		s.ChurnRate,
+	)
+}
+
+// Validate validates the scenario.
+func (s Scenario) Validate() error {
+	if s.Documents < 1 {
+		return fmt.Errorf("documents %d must be positive: %w", s.Documents, ErrInvalidScenario)
+	}
+	if s.Writers < 1 {
+		return fmt.Errorf("writers %d must be positive: %w", s.Writers, ErrInvalidScenario)
+	}
+	if s.Readers < 0 {
+		return fmt.Errorf("readers %d must not be negative: %w", s.Readers, ErrInvalidScenario)
+	}
+	if s.Rounds < 1 {
+		return fmt.Errorf("rounds %d must be positive: %w", s.Rounds, ErrInvalidScenario)
+	}
+	if s.ChurnRate < 0 || s.ChurnRate > 1 {
+		return fmt.Errorf("churn rate %f must be in [0, 1]: %w", s.ChurnRate, ErrInvalidScenario)
+	}
+
+	switch s.Workload {
+	case WorkloadText, WorkloadObject:
+	default:
+		return fmt.Errorf("%s: %w", s.Workload, ErrUnsupportedWorkload)
+	}
+
+	return nil
+}
@@ -0,0 +1,423 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	gotime "time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+var (
+	// eventTimeout is the time to wait for every stream to receive the event
+	// of a round.
+	eventTimeout = 30 * gotime.Second
+
+	// settleTimeout is the time to wait for the goroutines of closed streams
+	// to exit.
+	settleTimeout = 10 * gotime.Second
+)
+
+// WatchScenario is a scenario that opens many WatchDocument streams and
+// measures the delivery of the events of document changes to them. Each
+// document is updated by a writer in every round.
+type WatchScenario struct {
+	// Documents is the number of documents.
+	Documents int
+
+	// StreamsPerDocument is the number of streams that watch each document.
+	StreamsPerDocument int
+
+	// Connections is the number of gRPC connections that the streams are
+	// multiplexed over.
+	Connections int
+
+	// Rounds is the number of changes that the writer of each document makes.
+	Rounds int
+}
+
+// NewWatchScenario returns a scenario that opens the given number of streams
+// for each of the given number of documents.
+func NewWatchScenario(documents, streamsPerDocument int) WatchScenario {
+	return WatchScenario{
+		Documents:          documents,
+		StreamsPerDocument: streamsPerDocument,
+		Connections:        16,
+		Rounds:             10,
+	}
+}
+
+// Streams returns the total number of streams of the scenario.
+func (s WatchScenario) Streams() int {
+	return s.Documents * s.StreamsPerDocument
+}
+
+// String returns a human-readable summary of the scenario.
+func (s WatchScenario) String() string {
+	return fmt.Sprintf(
+		"watch: %d docs, %d streams, %d connections, %d rounds",
+		s.Documents,
+		s.Streams(),
+		s.Connections,
+		s.Rounds,
+	)
+}
+
+// Validate validates the scenario.
+func (s WatchScenario) Validate() error {
+	if s.Documents < 1 {
+		return fmt.Errorf("documents %d must be positive: %w", s.Documents, ErrInvalidScenario)
+	}
+	if s.StreamsPerDocument < 1 {
+		return fmt.Errorf("streams %d must be positive: %w", s.StreamsPerDocument, ErrInvalidScenario)
+	}
+	if s.Connections < 1 {
+		return fmt.Errorf("connections %d must be positive: %w", s.Connections, ErrInvalidScenario)
+	}
+	if s.Rounds < 1 {
+		return fmt.Errorf("rounds %d must be positive: %w", s.Rounds, ErrInvalidScenario)
+	}
+	return nil
+}
+
+// WatchResult is the result of running a watch scenario. The memory and the
+// goroutines are of the whole process, so they are meaningful only when the
+// server runs in the same process as the scenario.
+type WatchResult struct {
+	// Scenario is the scenario that was run.
+	Scenario WatchScenario
+
+	// Events is the number of events of changes that the streams received.
+	Events int
+
+	// Missed is the number of events that the streams did not receive in
+	// time.
+	Missed int
+
+	// Dropped is the number of streams that were closed by the server before
+	// the streams are disconnected, e.g. by the max age of connections.
+	Dropped int
+
+	// HeapPerStream is the heap memory in bytes that each open stream takes
+	// on both the client and the server.
+	HeapPerStream int64
+
+	// GoroutinesPerStream is the number of goroutines that each open stream
+	// takes on both the client and the server.
+	GoroutinesPerStream float64
+
+	// LeakedGoroutines is the number of goroutines that are left after all
+	// streams are disconnected.
+	LeakedGoroutines int
+
+	// latencies is the sorted latencies of the delivery of the events.
+	latencies []gotime.Duration
+}
+
+// Latency returns the delivery latency of the given percentile in [0, 100].
+func (r *WatchResult) Latency(percentile float64) gotime.Duration {
+	return latencyAt(r.latencies, percentile)
+}
+
+// watchedDoc is a document of a watch scenario with its writer.
+type watchedDoc struct {
+	key    key.Key
+	writer *client.Client
+	doc    *document.Document
+
+	// publishedAt is the time in nanoseconds when the writer started to push
+	// the change of the current round.
+	publishedAt atomic.Int64
+}
+
+// RunWatch runs the given watch scenario against the server of the given
+// address and returns the result. The streams are disconnected all at once
+// at the end, without detaching their documents.
+func RunWatch(ctx context.Context, rpcAddr string, scenario WatchScenario) (*WatchResult, error) {
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+
+	baseGoroutines := runtime.NumGoroutine()
+
+	// 01. create the documents and their writers.
+	startedAt := gotime.Now()
+	var docs []*watchedDoc
+	defer func() {
+		for _, d := range docs {
+			_ = d.writer.Close()
+		}
+	}()
+	for i := 0; i < scenario.Documents; i++ {
+		d := &watchedDoc{key: key.Key(fmt.Sprintf("watch-%d-%d", startedAt.UnixNano(), i))}
+		cli, err := client.Dial(rpcAddr)
+		if err != nil {
+			return nil, err
+		}
+		d.writer = cli
+		docs = append(docs, d)
+
+		if err := cli.Activate(ctx); err != nil {
+			return nil, err
+		}
+		d.doc = document.New(d.key)
+		if err := cli.Attach(ctx, d.doc); err != nil {
+			return nil, err
+		}
+	}
+	writerGoroutines, writerHeap := processStats()
+
+	// 02. open the streams over the shared connections.
+	var conns []*grpc.ClientConn
+	for i := 0; i < scenario.Connections; i++ {
+		conn, err := grpc.Dial(rpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			closeConns(conns)
+			return nil, fmt.Errorf("dial to %s: %w", rpcAddr, err)
+		}
+		conns = append(conns, conn)
+	}
+
+	streamCtx, cancelStreams := context.WithCancel(ctx)
+	latencies := make(chan gotime.Duration, scenario.Streams())
+	watched := &atomic.Int64{}
+	dropped := &atomic.Int64{}
+	receivers := sync.WaitGroup{}
+	disconnect := func() {
+		cancelStreams()
+		receivers.Wait()
+		closeConns(conns)
+	}
+	for i := 0; i < scenario.Streams(); i++ {
+		d := docs[i%scenario.Documents]
+		svc := api.NewYorkieServiceClient(conns[i%scenario.Connections])
+		stream, err := openStream(streamCtx, svc, d.key, i)
+		if err != nil {
+			disconnect()
+			return nil, err
+		}
+
+		receivers.Add(1)
+		go func() {
+			defer receivers.Done()
+			receive(stream, d, latencies, watched, dropped)
+		}()
+	}
+
+	// NOTE: Each stream receives the watched events of the streams
+	// that are opened after it. Wait for them to be delivered so that the
+	// rounds measure the delivery of changes only.
+	perDocument := int64(scenario.StreamsPerDocument)
+	expected := int64(scenario.Documents) * perDocument * (perDocument - 1) / 2
+	waitUntil(func() bool { return watched.Load() >= expected }, eventTimeout)
+	openGoroutines, openHeap := processStats()
+
+	// 03. publish a change of each document for the given rounds and wait for
+	// every stream to receive the events.
+	result := &WatchResult{
+		Scenario:            scenario,
+		HeapPerStream:       (openHeap - writerHeap) / int64(scenario.Streams()),
+		GoroutinesPerStream: float64(openGoroutines-writerGoroutines) / float64(scenario.Streams()),
+	}
+	for round := 0; round < scenario.Rounds; round++ {
+		for _, d := range docs {
+			if err := publish(ctx, d, round); err != nil {
+				disconnect()
+				return nil, err
+			}
+		}
+
+		received := collect(latencies, scenario.Streams())
+		result.Events += len(received)
+		result.Missed += scenario.Streams() - len(received)
+		result.latencies = append(result.latencies, received...)
+	}
+	sort.Slice(result.latencies, func(i, j int) bool {
+		return result.latencies[i] < result.latencies[j]
+	})
+
+	// 04. disconnect every stream at once and wait for the goroutines of the
+	// streams to exit on both sides.
+	result.Dropped = int(dropped.Load())
+	disconnect()
+	for _, d := range docs {
+		_ = d.writer.Close()
+	}
+	docs = nil
+	result.LeakedGoroutines = settle(baseGoroutines)
+
+	return result, nil
+}
+
+// openStream activates a client with the given service, attaches the given
+// document and opens a stream that watches the document.
+func openStream(
+	ctx context.Context,
+	svc api.YorkieServiceClient,
+	docKey key.Key,
+	idx int,
+) (api.YorkieService_WatchDocumentClient, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, types.ShardKey, docKey.String())
+
+	activated, err := svc.ActivateClient(ctx, &api.ActivateClientRequest{
+		ClientKey: fmt.Sprintf("watcher-%d", idx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pbPack, err := converter.ToChangePack(change.NewPack(docKey, change.InitialCheckpoint, nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	attached, err := svc.AttachDocument(ctx, &api.AttachDocumentRequest{
+		ClientId:   activated.ClientId,
+		ChangePack: pbPack,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := svc.WatchDocument(ctx, &api.WatchDocumentRequest{
+		ClientId:   activated.ClientId,
+		DocumentId: attached.DocumentId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The server sends the initialization once the stream
+	// is subscribed, so the stream does not miss the events after this.
+	if _, err := stream.Recv(); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// receive receives the events of the given stream until it is closed. It
+// sends the delivery latencies of the changes of the given document and
+// counts the watched events and the streams closed by the server.
+func receive(
+	stream api.YorkieService_WatchDocumentClient,
+	d *watchedDoc,
+	latencies chan<- gotime.Duration,
+	watched *atomic.Int64,
+	dropped *atomic.Int64,
+) {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if stream.Context().Err() == nil {
+				dropped.Add(1)
+			}
+			return
+		}
+
+		event := resp.GetEvent()
+		if event == nil {
+			continue
+		}
+		switch event.Type {
+		case api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_CHANGED:
+			latencies <- gotime.Since(gotime.Unix(0, d.publishedAt.Load()))
+		case api.DocEventType_DOC_EVENT_TYPE_DOCUMENT_WATCHED:
+			watched.Add(1)
+		}
+	}
+}
+
+// publish makes a change of the given document and pushes it to the server.
+func publish(ctx context.Context, d *watchedDoc, round int) error {
+	if err := d.doc.Update(func(root *json.Object, p *presence.Presence) error {
+		root.SetInteger("round", round)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	d.publishedAt.Store(gotime.Now().UnixNano())
+	return d.writer.Sync(ctx)
+}
+
+// collect collects the given number of latencies or the latencies received
+// until the timeout.
+func collect(latencies <-chan gotime.Duration, n int) []gotime.Duration {
+	var received []gotime.Duration
+	timeout := gotime.After(eventTimeout)
+	for len(received) < n {
+		select {
+		case latency := <-latencies:
+			received = append(received, latency)
+		case <-timeout:
+			return received
+		}
+	}
+	return received
+}
+
+// closeConns closes the given connections.
+func closeConns(conns []*grpc.ClientConn) {
+	for _, conn := range conns {
+		_ = conn.Close()
+	}
+}
+
+// processStats returns the number of goroutines and the heap memory in bytes
+// of this process after collecting garbage.
+func processStats() (int, int64) {
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return runtime.NumGoroutine(), int64(stats.HeapAlloc)
+}
+
+// settle waits for the number of goroutines to get back to the given number
+// and returns the number of goroutines above it.
+func settle(goroutines int) int {
+	waitUntil(func() bool { return runtime.NumGoroutine() <= goroutines }, settleTimeout)
+	if leaked := runtime.NumGoroutine() - goroutines; leaked > 0 {
+		return leaked
+	}
+	return 0
+}
+
+// waitUntil waits until the given condition is met or the given timeout
+// elapses.
+func waitUntil(cond func() bool, timeout gotime.Duration) {
+	deadline := gotime.Now().Add(timeout)
+	for !cond() && gotime.Now().Before(deadline) {
+		gotime.Sleep(10 * gotime.Millisecond)
+	}
+}
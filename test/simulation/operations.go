@@ -0,0 +1,86 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+var objectKeys = []string{"a", "b", "c"}
+
+// DefaultOperations is the operations on the containers that every replica
+// starts with: "obj", "arr", "text" and "cnt".
+var DefaultOperations = []Operation{{
+	Name: "object set",
+	Apply: func(r *rand.Rand, root *json.Object) {
+		root.GetObject("obj").SetInteger(objectKeys[r.Intn(len(objectKeys))], r.Intn(100))
+	},
+}, {
+	Name: "object delete",
+	Apply: func(r *rand.Rand, root *json.Object) {
+		root.GetObject("obj").Delete(objectKeys[r.Intn(len(objectKeys))])
+	},
+}, {
+	Name: "array insert",
+	Apply: func(r *rand.Rand, root *json.Object) {
+		arr := root.GetArray("arr")
+		if arr.Len() == 0 {
+			arr.AddInteger(r.Intn(100))
+			return
+		}
+		arr.InsertIntegerAfter(r.Intn(arr.Len()), r.Intn(100))
+	},
+}, {
+	Name: "array delete",
+	Apply: func(r *rand.Rand, root *json.Object) {
+		arr := root.GetArray("arr")
+		if arr.Len() > 0 {
+			arr.Delete(r.Intn(arr.Len()))
+		}
+	},
+}, {
+	Name: "text edit",
+	Apply: func(r *rand.Rand, root *json.Object) {
+		text := root.GetText("text")
+		from, to := randomRange(r, len(text.String()))
+		content := ""
+		if r.Intn(2) == 0 {
+			content = string(rune('a' + r.Intn(26)))
+		}
+		text.Edit(from, to, content)
+	},
+}, {
+	Name: "text style",
+	Apply: func(r *rand.Rand, root *json.Object) {
+		text := root.GetText("text")
+		from, to := randomRange(r, len(text.String()))
+		text.Style(from, to, map[string]string{"b": string(rune('0' + r.Intn(3)))})
+	},
+}, {
+	Name: "counter increase",
+	Apply: func(r *rand.Rand, root *json.Object) {
+		root.GetCounter("cnt").Increase(r.Intn(10))
+	},
+}}
+
+// randomRange returns a random range in [0, length].
+func randomRange(r *rand.Rand, length int) (int, int) {
+	from := r.Intn(length + 1)
+	return from, from + r.Intn(length-from+1)
+}
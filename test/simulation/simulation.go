@@ -0,0 +1,377 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package simulation provides a deterministic concurrency simulation framework
+// for CRDT tests. It generates randomized schedules of concurrent operations
+// across simulated replicas from a seed, syncs the replicas in the order of
+// the schedule through an in-memory server, and checks that they converge.
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// ErrDiverged is returned when the replicas do not converge after syncing.
+var ErrDiverged = errors.New("replicas diverged")
+
+const docKey = key.Key("simulation")
+
+// Operation is an operation that can be applied to a replica. Apply should
+// pick its targets from the current state of the given root with the given
+// random source, so that the operation is still valid when a schedule is
+// shrunk.
+type Operation struct {
+	Name  string
+	Apply func(r *rand.Rand, root *json.Object)
+}
+
+// StepType is the type of Step.
+type StepType int
+
+// The values below are types of StepType.
+const (
+	StepUpdate StepType = iota
+	StepPushPull
+	StepPushOnly
+)
+
+// Step is a step of a schedule that is executed by a replica.
+type Step struct {
+	Type    StepType
+	Replica int
+
+	// Operation and Seed are used only when the type is StepUpdate.
+	Operation int
+	Seed      int64
+}
+
+// Schedule is a sequence of steps.
+type Schedule []Step
+
+// Config is the configuration of a simulation.
+type Config struct {
+	// Replicas is the number of simulated replicas.
+	Replicas int
+
+	// Steps is the number of steps of generated schedules.
+	Steps int
+
+	// PushOnly is whether generated schedules include push-only syncs.
+	PushOnly bool
+
+	// GarbageCollection is whether the server sends the min synced ticket so
+	// that replicas collect garbage.
+	GarbageCollection bool
+
+	// Operations is the operations to apply. If empty, DefaultOperations is used.
+	Operations []Operation
+}
+
+func (c Config) operations() []Operation {
+	if len(c.Operations) == 0 {
+		return DefaultOperations
+	}
+	return c.Operations
+}
+
+// Format returns a human-readable representation of the given schedule.
+func (c Config) Format(schedule Schedule) string {
+	ops := c.operations()
+
+	var sb strings.Builder
+	for i, step := range schedule {
+		switch step.Type {
+		case StepUpdate:
+			fmt.Fprintf(&sb, "%3d: r%d update %q (seed %d)\n", i, step.Replica, ops[step.Operation].Name, step.Seed)
+		case StepPushPull:
+			fmt.Fprintf(&sb, "%3d: r%d push-pull\n", i, step.Replica)
+		case StepPushOnly:
+			fmt.Fprintf(&sb, "%3d: r%d push-only\n", i, step.Replica)
+		}
+	}
+	return sb.String()
+}
+
+// Generate generates a schedule from the given seed. The same seed always
+// generates the same schedule.
+func Generate(seed int64, conf Config) Schedule {
+	r := rand.New(rand.NewSource(seed))
+	ops := conf.operations()
+
+	schedule := make(Schedule, 0, conf.Steps)
+	for i := 0; i < conf.Steps; i++ {
+		step := Step{Replica: r.Intn(conf.Replicas)}
+		switch n := r.Intn(10); {
+		case n < 6:
+			step.Type = StepUpdate
+			step.Operation = r.Intn(len(ops))
+			step.Seed = r.Int63()
+		case n < 9 || !conf.PushOnly:
+			step.Type = StepPushPull
+		default:
+			step.Type = StepPushOnly
+		}
+		schedule = append(schedule, step)
+	}
+	return schedule
+}
+
+// Execute executes the given schedule, syncs all replicas, and returns
+// ErrDiverged if the replicas do not converge.
+func Execute(conf Config, schedule Schedule) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	replicas, err := newReplicas(conf.Replicas)
+	if err != nil {
+		return err
+	}
+	srv := newServer(replicas, conf.GarbageCollection)
+
+	// 01. initialize the containers with the first replica.
+	if err := replicas[0].Update(func(root *json.Object, p *presence.Presence) error {
+		root.SetNewObject("obj")
+		root.SetNewArray("arr")
+		root.SetNewText("text")
+		root.SetNewCounter("cnt", crdt.IntegerCnt, 0)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, doc := range replicas {
+		if err := srv.pushPull(doc, false); err != nil {
+			return err
+		}
+	}
+
+	// 02. execute the steps of the schedule.
+	ops := conf.operations()
+	for i, step := range schedule {
+		doc := replicas[step.Replica]
+		switch step.Type {
+		case StepUpdate:
+			r := rand.New(rand.NewSource(step.Seed))
+			err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+				ops[step.Operation].Apply(r, root)
+				return nil
+			})
+		case StepPushPull:
+			err = srv.pushPull(doc, false)
+		case StepPushOnly:
+			err = srv.pushPull(doc, true)
+		}
+		if err != nil {
+			return fmt.Errorf("step %d: %w", i, err)
+		}
+	}
+
+	// 03. sync all replicas twice so that every replica receives all changes.
+	for round := 0; round < 2; round++ {
+		for _, doc := range replicas {
+			if err := srv.pushPull(doc, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	expected := replicas[0].Marshal()
+	for i, doc := range replicas[1:] {
+		if actual := doc.Marshal(); actual != expected {
+			return fmt.Errorf("r0 %s, r%d %s: %w", expected, i+1, actual, ErrDiverged)
+		}
+	}
+	return nil
+}
+
+// Shrink returns a minimal schedule that still fails from the given failing
+// schedule by removing steps one by one.
+func Shrink(conf Config, schedule Schedule) Schedule {
+	shrunk := append(Schedule{}, schedule...)
+	for removed := true; removed; {
+		removed = false
+		for i := len(shrunk) - 1; i >= 0; i-- {
+			candidate := append(append(Schedule{}, shrunk[:i]...), shrunk[i+1:]...)
+			if Execute(conf, candidate) != nil {
+				shrunk = candidate
+				removed = true
+			}
+		}
+	}
+	return shrunk
+}
+
+// Run generates and executes the schedules of the given seeds. If a schedule
+// fails, it reports the seed and the minimal reproducing schedule.
+func Run(t testing.TB, conf Config, seeds ...int64) {
+	t.Helper()
+
+	for _, seed := range seeds {
+		schedule := Generate(seed, conf)
+		if err := Execute(conf, schedule); err != nil {
+			shrunk := Shrink(conf, schedule)
+			t.Fatalf(
+				"seed %d: %v\nminimal schedule (%d of %d steps):\n%s",
+				seed,
+				Execute(conf, shrunk),
+				len(shrunk),
+				len(schedule),
+				conf.Format(shrunk),
+			)
+		}
+	}
+}
+
+func newReplicas(n int) ([]*document.Document, error) {
+	var replicas []*document.Document
+	for i := 0; i < n; i++ {
+		actorID, err := time.ActorIDFromHex(fmt.Sprintf("%024x", i+1))
+		if err != nil {
+			return nil, err
+		}
+		doc := document.New(docKey)
+		doc.SetActor(actorID)
+		replicas = append(replicas, doc)
+	}
+	return replicas, nil
+}
+
+// server imitates the PushPull of the server in memory. Changes are assigned
+// server sequences in the order they are pushed.
+type server struct {
+	changes    []*change.Change
+	clientSeqs map[string]uint32
+	syncedSeqs map[string]int64
+	collect    bool
+}
+
+func newServer(replicas []*document.Document, collect bool) *server {
+	syncedSeqs := make(map[string]int64)
+	for _, doc := range replicas {
+		syncedSeqs[doc.ActorID().String()] = 0
+	}
+
+	return &server{
+		clientSeqs: make(map[string]uint32),
+		syncedSeqs: syncedSeqs,
+		collect:    collect,
+	}
+}
+
+// minSyncedTicket returns the smallest ticket of the changes that replicas
+// have synced, so that garbage collection is exercised as on the server.
+func (s *server) minSyncedTicket() *time.Ticket {
+	if !s.collect {
+		return time.InitialTicket
+	}
+
+	var minTicket *time.Ticket
+	for _, seq := range s.syncedSeqs {
+		if seq == 0 {
+			return time.InitialTicket
+		}
+
+		id := s.changes[seq-1].ID()
+		ticket := time.NewTicket(id.Lamport(), time.MaxDelimiter, id.ActorID())
+		if minTicket == nil || ticket.Compare(minTicket) < 0 {
+			minTicket = ticket
+		}
+	}
+	return minTicket
+}
+
+func (s *server) pushPull(doc *document.Document, pushOnly bool) error {
+	actorID := doc.ActorID()
+	reqPack, err := roundTrip(doc.CreateChangePack())
+	if err != nil {
+		return err
+	}
+
+	// 01. push the changes that are not pushed yet.
+	for _, c := range reqPack.Changes {
+		if c.ClientSeq() <= s.clientSeqs[actorID.String()] {
+			continue
+		}
+		c.SetServerSeq(int64(len(s.changes) + 1))
+		s.changes = append(s.changes, c)
+		s.clientSeqs[actorID.String()] = c.ClientSeq()
+	}
+
+	// 02. pull the changes of the other replicas.
+	serverSeq := reqPack.Checkpoint.ServerSeq
+	var pulled []*change.Change
+	if !pushOnly {
+		for _, c := range s.changes[serverSeq:] {
+			if c.ID().ActorID().Compare(actorID) != 0 {
+				pulled = append(pulled, c)
+			}
+		}
+		serverSeq = int64(len(s.changes))
+	}
+
+	// NOTE: Like the server, the requested seq is stored as the
+	// synced seq instead of the response seq.
+	s.syncedSeqs[actorID.String()] = reqPack.Checkpoint.ServerSeq
+
+	pack := change.NewPack(
+		docKey,
+		change.NewCheckpoint(serverSeq, s.clientSeqs[actorID.String()]),
+		pulled,
+		nil,
+	)
+	pack.MinSyncedTicket = s.minSyncedTicket()
+	resPack, err := roundTrip(pack)
+	if err != nil {
+		return err
+	}
+
+	return doc.ApplyChangePack(resPack)
+}
+
+// roundTrip encodes the given pack to bytes and decodes it again, so that the
+// replicas never share changes and the converter is exercised as on the wire.
+func roundTrip(pack *change.Pack) (*change.Pack, error) {
+	pbPack, err := converter.ToChangePack(pack)
+	if err != nil {
+		return nil, err
+	}
+	bytes, err := pbPack.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &api.ChangePack{}
+	if err := decoded.Unmarshal(bytes); err != nil {
+		return nil, err
+	}
+	return converter.FromChangePack(decoded)
+}
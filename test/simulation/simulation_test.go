@@ -0,0 +1,93 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/test/simulation"
+)
+
+func TestSimulation(t *testing.T) {
+	t.Run("deterministic schedule test", func(t *testing.T) {
+		conf := simulation.Config{Replicas: 3, Steps: 50}
+		assert.Equal(t, simulation.Generate(1, conf), simulation.Generate(1, conf))
+		assert.NotEqual(t, simulation.Generate(1, conf), simulation.Generate(2, conf))
+	})
+
+	t.Run("convergence test", func(t *testing.T) {
+		var seeds []int64
+		for seed := int64(1); seed <= 30; seed++ {
+			seeds = append(seeds, seed)
+		}
+
+		simulation.Run(t, simulation.Config{Replicas: 2, Steps: 30, PushOnly: true}, seeds...)
+		simulation.Run(t, simulation.Config{Replicas: 4, Steps: 60, PushOnly: true}, seeds...)
+	})
+
+	t.Run("convergence with garbage collection test", func(t *testing.T) {
+		// NOTE(hackerwins): The min synced ticket is derived from Lamport
+		// timestamps, so it can pass the nodes that concurrent changes not yet
+		// seen by the server still refer to. Those changes then fail with
+		// "child not found" when they are applied after the collection.
+		t.Skip("min synced ticket collects nodes referenced by concurrent changes: " +
+			"https://github.com/yorkie-team/yorkie/issues/125")
+
+		var seeds []int64
+		for seed := int64(1); seed <= 30; seed++ {
+			seeds = append(seeds, seed)
+		}
+
+		simulation.Run(t, simulation.Config{
+			Replicas: 2, Steps: 30, PushOnly: true, GarbageCollection: true,
+		}, seeds...)
+		simulation.Run(t, simulation.Config{
+			Replicas: 4, Steps: 60, PushOnly: true, GarbageCollection: true,
+		}, seeds...)
+	})
+
+	t.Run("shrink failing schedule test", func(t *testing.T) {
+		conf := simulation.Config{
+			Replicas: 2,
+			Steps:    40,
+			Operations: []simulation.Operation{{
+				Name: "counter increase",
+				Apply: func(r *rand.Rand, root *json.Object) {
+					root.GetCounter("cnt").Increase(1)
+				},
+			}, {
+				Name: "broken",
+				Apply: func(r *rand.Rand, root *json.Object) {
+					panic("broken operation")
+				},
+			}},
+		}
+
+		schedule := simulation.Generate(1, conf)
+		assert.Error(t, simulation.Execute(conf, schedule))
+
+		shrunk := simulation.Shrink(conf, schedule)
+		assert.Len(t, shrunk, 1)
+		assert.Equal(t, simulation.StepUpdate, shrunk[0].Type)
+		assert.Equal(t, 1, shrunk[0].Operation)
+		assert.Error(t, simulation.Execute(conf, shrunk))
+	})
+}